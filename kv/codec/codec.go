@@ -0,0 +1,125 @@
+// Package codec encodes the keys of the ordered indexes applications build on tinykv. The values are encoded with the
+// memcomparable encodings of TiDB, so comparing the encoded keys bytewise compares the values in order, and a range
+// scan over the encoded keys visits the values in order. A composite key concatenates the encoded values of its
+// columns, so the keys sharing the leading columns sort together and can be scanned by their common prefix.
+package codec
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/types"
+	tidbcodec "github.com/pingcap/tidb/util/codec"
+)
+
+// EncodeInt appends the encoded signed integer to b, the negative integers sort before the positive ones.
+func EncodeInt(b []byte, v int64) []byte {
+	return tidbcodec.EncodeInt(b, v)
+}
+
+// DecodeInt decodes a signed integer encoded by EncodeInt at the start of b, and returns the rest of b.
+func DecodeInt(b []byte) ([]byte, int64, error) {
+	remain, v, err := tidbcodec.DecodeInt(b)
+	return remain, v, errors.Trace(err)
+}
+
+// EncodeUint appends the encoded unsigned integer to b.
+func EncodeUint(b []byte, v uint64) []byte {
+	return tidbcodec.EncodeUint(b, v)
+}
+
+// DecodeUint decodes an unsigned integer encoded by EncodeUint at the start of b, and returns the rest of b.
+func DecodeUint(b []byte) ([]byte, uint64, error) {
+	remain, v, err := tidbcodec.DecodeUint(b)
+	return remain, v, errors.Trace(err)
+}
+
+// EncodeBytes appends the encoded bytes to b. Unlike the raw bytes, the encoded bytes of a value never prefix the
+// encoded bytes of another one, so the bytes can be followed by more columns without breaking the order.
+func EncodeBytes(b []byte, v []byte) []byte {
+	return tidbcodec.EncodeBytes(b, v)
+}
+
+// DecodeBytes decodes bytes encoded by EncodeBytes at the start of b, and returns the rest of b.
+func DecodeBytes(b []byte) ([]byte, []byte, error) {
+	remain, v, err := tidbcodec.DecodeBytes(b, nil)
+	return remain, v, errors.Trace(err)
+}
+
+// EncodeDecimal appends the encoded decimal to b, rounded to frac digits after the point. The decimals only compare in
+// order when they are encoded with the same precision and frac, the ones of the column.
+func EncodeDecimal(b []byte, v *types.MyDecimal, precision, frac int) ([]byte, error) {
+	b, err := tidbcodec.EncodeDecimal(b, v, precision, frac)
+	return b, errors.Trace(err)
+}
+
+// DecodeDecimal decodes a decimal encoded by EncodeDecimal at the start of b, and returns the rest of b.
+func DecodeDecimal(b []byte) ([]byte, *types.MyDecimal, error) {
+	remain, v, _, _, err := tidbcodec.DecodeDecimal(b)
+	return remain, v, errors.Trace(err)
+}
+
+// Decimal is a column of a composite key holding a decimal, with the precision and frac of the column.
+type Decimal struct {
+	Value     *types.MyDecimal
+	Precision int
+	Frac      int
+}
+
+// EncodeKey appends the composite key of the columns to b. A column is an int64, an int, a uint64, a []byte, a
+// string or a Decimal, and the key is decoded by passing pointers to the same types to DecodeKey.
+func EncodeKey(b []byte, columns ...interface{}) ([]byte, error) {
+	for i, column := range columns {
+		switch v := column.(type) {
+		case int64:
+			b = EncodeInt(b, v)
+		case int:
+			b = EncodeInt(b, int64(v))
+		case uint64:
+			b = EncodeUint(b, v)
+		case []byte:
+			b = EncodeBytes(b, v)
+		case string:
+			b = EncodeBytes(b, []byte(v))
+		case Decimal:
+			var err error
+			if b, err = EncodeDecimal(b, v.Value, v.Precision, v.Frac); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, errors.Errorf("column %d has unsupported type %T", i, column)
+		}
+	}
+	return b, nil
+}
+
+// DecodeKey decodes the leading columns of a composite key encoded by EncodeKey into the values pointed to by
+// columns, and returns the rest of the key. A *Decimal column gets the value only, the precision and frac are the
+// ones of the column.
+func DecodeKey(key []byte, columns ...interface{}) ([]byte, error) {
+	var err error
+	for i, column := range columns {
+		switch v := column.(type) {
+		case *int64:
+			key, *v, err = DecodeInt(key)
+		case *int:
+			var n int64
+			key, n, err = DecodeInt(key)
+			*v = int(n)
+		case *uint64:
+			key, *v, err = DecodeUint(key)
+		case *[]byte:
+			key, *v, err = DecodeBytes(key)
+		case *string:
+			var s []byte
+			key, s, err = DecodeBytes(key)
+			*v = string(s)
+		case *Decimal:
+			key, v.Value, err = DecodeDecimal(key)
+		default:
+			return nil, errors.Errorf("column %d has unsupported type %T", i, column)
+		}
+		if err != nil {
+			return nil, errors.Annotatef(err, "column %d", i)
+		}
+	}
+	return key, nil
+}
@@ -0,0 +1,88 @@
+package codec
+
+import (
+	"bytes"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/pingcap/tidb/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyOrder(t *testing.T) {
+	decimal := func(s string) Decimal {
+		d := new(types.MyDecimal)
+		require.Nil(t, d.FromString([]byte(s)))
+		return Decimal{Value: d, Precision: 10, Frac: 2}
+	}
+	// The composite keys in order of the columns, the encoded keys must sort the same.
+	rows := [][]interface{}{
+		{int64(math.MinInt64), "", uint64(0), decimal("-10.5")},
+		{int64(-1), "a", uint64(math.MaxUint64), decimal("0")},
+		{int64(-1), "a\x00", uint64(0), decimal("0")},
+		{int64(-1), "ab", uint64(1), decimal("-1")},
+		{int64(-1), "ab", uint64(1), decimal("1.25")},
+		{int64(-1), "ab", uint64(2), decimal("1")},
+		{int64(0), "", uint64(0), decimal("0")},
+		{int64(math.MaxInt64), "\xff\xff\xff\xff\xff\xff\xff\xff\xff", uint64(0), decimal("99999999.99")},
+	}
+	var keys [][]byte
+	for _, row := range rows {
+		key, err := EncodeKey([]byte("idx"), row...)
+		require.Nil(t, err)
+		keys = append(keys, key)
+	}
+	assert.True(t, sort.SliceIsSorted(keys, func(i, j int) bool { return bytes.Compare(keys[i], keys[j]) < 0 }))
+
+	// The keys decode back to the columns.
+	for i, key := range keys {
+		var a int64
+		var b string
+		var c uint64
+		var d Decimal
+		remain, err := DecodeKey(key[len("idx"):], &a, &b, &c, &d)
+		require.Nil(t, err)
+		assert.Empty(t, remain)
+		assert.Equal(t, rows[i][:3], []interface{}{a, b, c})
+		assert.Equal(t, 0, d.Value.Compare(rows[i][3].(Decimal).Value))
+	}
+
+	// The leading columns can be decoded alone.
+	var a int64
+	remain, err := DecodeKey(keys[1][len("idx"):], &a)
+	require.Nil(t, err)
+	assert.Equal(t, int64(-1), a)
+	assert.NotEmpty(t, remain)
+
+	_, err = EncodeKey(nil, 1.5)
+	assert.NotNil(t, err)
+	_, err = DecodeKey([]byte{1}, &a)
+	assert.NotNil(t, err)
+}
+
+func TestPrefixRange(t *testing.T) {
+	// The range of the keys sharing the leading columns contains them and nothing else.
+	prefix, err := EncodeKey(nil, "user", int64(7))
+	require.Nil(t, err)
+	start, end := PrefixRange(prefix)
+	inside, err := EncodeKey(nil, "user", int64(7), "x")
+	require.Nil(t, err)
+	outside, err := EncodeKey(nil, "user", int64(8))
+	require.Nil(t, err)
+	assert.True(t, bytes.Compare(start, inside) <= 0 && bytes.Compare(inside, end) < 0)
+	assert.True(t, bytes.Compare(outside, end) >= 0)
+
+	assert.Equal(t, []byte{1, 3}, PrefixNext([]byte{1, 2, 0xff}))
+	assert.Nil(t, PrefixNext([]byte{0xff, 0xff}))
+
+	req := NewRawScanPrefixRequest("default", prefix, 10, false)
+	assert.Equal(t, start, req.StartKey)
+	assert.Equal(t, end, req.EndKey)
+	// A reverse scan starts at the end of the range.
+	scan := NewScanPrefixRequest(prefix, 5, 10, true)
+	assert.Equal(t, end, scan.StartKey)
+	assert.Equal(t, start, scan.EndKey)
+	assert.Equal(t, uint64(5), scan.Version)
+}
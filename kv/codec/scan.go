@@ -0,0 +1,50 @@
+package codec
+
+import (
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// PrefixNext returns the smallest key greater than all the keys starting with the prefix, or nil if there is none,
+// which leaves a scan unbounded.
+func PrefixNext(prefix []byte) []byte {
+	next := append([]byte{}, prefix...)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			return next[:i+1]
+		}
+	}
+	return nil
+}
+
+// PrefixRange returns the range [start, end) of the keys starting with the prefix, like the composite keys sharing
+// the leading columns encoded in the prefix.
+func PrefixRange(prefix []byte) (start, end []byte) {
+	return prefix, PrefixNext(prefix)
+}
+
+// NewScanPrefixRequest returns a request scanning at most limit keys starting with the prefix at the version, in the
+// descending order of the keys if reverse is set.
+func NewScanPrefixRequest(prefix []byte, version uint64, limit uint32, reverse bool) *kvrpcpb.ScanRequest {
+	req := &kvrpcpb.ScanRequest{Version: version, Limit: limit, Reverse: reverse}
+	req.StartKey, req.EndKey = scanRange(prefix, reverse)
+	return req
+}
+
+// NewRawScanPrefixRequest returns a request scanning at most limit keys of the column family starting with the prefix,
+// in the descending order of the keys if reverse is set.
+func NewRawScanPrefixRequest(cf string, prefix []byte, limit uint32, reverse bool) *kvrpcpb.RawScanRequest {
+	req := &kvrpcpb.RawScanRequest{Cf: cf, Limit: limit, Reverse: reverse}
+	req.StartKey, req.EndKey = scanRange(prefix, reverse)
+	return req
+}
+
+// scanRange returns the start and end keys of a scan request reading the keys starting with the prefix, a reverse
+// scan starts at the exclusive upper bound and ends at the prefix.
+func scanRange(prefix []byte, reverse bool) (startKey, endKey []byte) {
+	start, end := PrefixRange(prefix)
+	if reverse {
+		return end, start
+	}
+	return start, end
+}
@@ -1,8 +1,11 @@
 package config
 
 import (
+	"encoding/hex"
+	"fmt"
 	"time"
 
+	"github.com/coocood/badger"
 	"github.com/coocood/badger/options"
 	"github.com/ngaut/log"
 )
@@ -12,6 +15,10 @@ type Config struct {
 	Engine      Engine      `toml:"engine"`      // Engine options.
 	RaftStore   RaftStore   `toml:"raftstore"`   // RaftStore configs
 	Coprocessor Coprocessor `toml:"coprocessor"` // Coprocessor options
+	ReadPool    ReadPool    `toml:"readpool"`    // Read pool options
+	Scheduler   Scheduler   `toml:"scheduler"`   // Write scheduler options
+	Scan        Scan        `toml:"scan"`        // Per scan resource caps
+	GC          GC          `toml:"gc"`          // MVCC garbage collection options
 }
 
 type Server struct {
@@ -22,15 +29,50 @@ type Server struct {
 	RegionSize int64  `toml:"region-size"` // Average region size.
 	MaxProcs   int    `toml:"max-procs"`   // Max CPU cores to use, set 0 to use all CPU cores in the machine.
 	Raft       bool   `toml:"raft"`        // Enable raft.
+	// Accept SeedWrite requests which write directly to the kv engine bypassing raft. Only enable it to load the
+	// initial data of an empty cluster before it's opened for traffic.
+	SeedMode bool `toml:"seed-mode"`
+	// Fraction of the requests with a trace ID kept as the exemplars of the request latency buckets.
+	ExemplarSampleRate float64 `toml:"exemplar-sample-rate"`
+	// Address of a secondary store which ShadowSampleRate of the read requests are duplicated to once they are
+	// served, the responses are compared and the diffs logged. Leave it empty to disable shadowing.
+	ShadowAddr       string  `toml:"shadow-addr"`
+	ShadowSampleRate float64 `toml:"shadow-sample-rate"`
+	// Reject the requests which don't carry the cluster ID in their metadata, the requests carrying another cluster
+	// ID are always rejected.
+	StrictClusterCheck bool `toml:"strict-cluster-check"`
+
+	// Bytes of memory the store may hold in raft entry caches, pending proposals, scan and snapshot buffers before
+	// shedding load, set 0 for no limit.
+	MemoryBudget int64 `toml:"memory-budget"`
+	// Class of the disk the store runs on: nvme, ssd or hdd. It's registered as the disk-class label of the store,
+	// leave it empty if the store isn't in a capacity tier.
+	DiskClass string `toml:"disk-class"`
 }
 
 type RaftStore struct {
 	RaftWorkers              int    `toml:"raft-workers"`                // Number of raft workers.
+	ApplyWorkers             int    `toml:"apply-workers"`               // Number of apply workers.
 	PdHeartbeatTickInterval  string `toml:"pd-heartbeat-tick-interval"`  // pd-heartbeat-tick-interval in seconds
 	RaftStoreMaxLeaderLease  string `toml:"raft-store-max-leader-lease"` // raft-store-max-leader-lease in milliseconds
 	RaftBaseTickInterval     string `toml:"raft-base-tick-interval"`     // raft-base-tick-interval in milliseconds
 	RaftHeartbeatTicks       int    `toml:"raft-heartbeat-ticks"`        // raft-heartbeat-ticks times
 	RaftElectionTimeoutTicks int    `toml:"raft-election-timeout-ticks"` // raft-election-timeout-ticks times
+	QuorumRead               bool   `toml:"quorum-read"`                 // Serve all the reads as quorum reads.
+	QuorumReadTimeout        string `toml:"quorum-read-timeout"`         // Max time a quorum read waits for the peers.
+	RightDeriveWhenSplit     bool   `toml:"right-derive-when-split"`     // The right region keeps the id on split.
+	// The policies deciding where the regions split, in order: size, keys and table. The keys policy splits by
+	// coprocessor.region-max-keys and coprocessor.region-split-keys, the table policy at the boundaries of the tables.
+	SplitPolicies []string `toml:"split-policies"`
+	// Bytes of free disk space kept in reserve, the store rejects the writes when less is left, set 0 to disable it.
+	ReserveSpace int64 `toml:"reserve-space"`
+	// The leader compacts the raft log of a region every raft-log-gc-tick-interval, once the applied entries not
+	// compacted yet exceed raft-log-gc-count-limit or raft-log-gc-size-limit bytes, or once all the peers have
+	// replicated more than raft-log-gc-threshold entries.
+	RaftLogGCTickInterval string `toml:"raft-log-gc-tick-interval"`
+	RaftLogGCThreshold    uint64 `toml:"raft-log-gc-threshold"`
+	RaftLogGCCountLimit   uint64 `toml:"raft-log-gc-count-limit"`
+	RaftLogGCSizeLimit    uint64 `toml:"raft-log-gc-size-limit"`
 }
 
 type Coprocessor struct {
@@ -38,6 +80,48 @@ type Coprocessor struct {
 	RegionSplitKeys int64 `toml:"region-split-keys"`
 }
 
+// ReadPool configures the workers serving reads. Every class of reads has its own workers, so that long scans can't
+// occupy the workers needed by cheap point gets.
+type ReadPool struct {
+	PointGetConcurrency    int `toml:"point-get-concurrency"`   // Number of workers for point gets.
+	ScanConcurrency        int `toml:"scan-concurrency"`        // Number of workers for scans.
+	CoprocessorConcurrency int `toml:"coprocessor-concurrency"` // Number of workers for coprocessor requests.
+	MaxTasksPerWorker      int `toml:"max-tasks-per-worker"`    // Pending reads per worker before rejecting as busy.
+}
+
+// Scheduler configures the workers executing the transactional writes. The writes of the same keys are serialized by
+// latches, the others run concurrently.
+type Scheduler struct {
+	Concurrency int `toml:"concurrency"` // Number of workers.
+	// Max time a read waits for the transaction holding a lock on a key it reads to commit or roll back, before
+	// returning the lock to the client. Set 0 to return the locks at once.
+	LockWaitTimeout string `toml:"lock-wait-timeout"`
+}
+
+// Scan caps the resources a single scan may use, so that a runaway scan can't hold an engine snapshot and iterator
+// for long. A scan hitting a cap returns the pairs read so far and the key to continue from. Set 0 for no cap.
+type Scan struct {
+	MaxKeys     int    `toml:"max-keys"`     // Max number of pairs returned by a scan.
+	MaxBytes    int64  `toml:"max-bytes"`    // Max bytes of keys and values returned by a scan.
+	MaxDuration string `toml:"max-duration"` // Max time a scan may run.
+}
+
+// GC configures the collection of old MVCC versions. Besides the versions hidden by the safe point, the keys under a
+// retention keep only their newest versions, for keyspaces whose long history is never read.
+type GC struct {
+	// Interval to poll the safe point from the scheduler at, the regions led by the store are collected when it
+	// advances. Leave it empty to disable the background collection.
+	PollInterval  string             `toml:"poll-interval"`
+	KeysPerSecond int                `toml:"keys-per-second"` // Max keys scanned per second, set 0 for no limit.
+	Retentions    []VersionRetention `toml:"retention"`
+}
+
+// VersionRetention caps the versions kept for the keys with a prefix, the longest matching prefix applies.
+type VersionRetention struct {
+	Prefix      string `toml:"prefix"`       // Hex of the user key prefix.
+	MaxVersions int    `toml:"max-versions"` // Max number of versions kept per key.
+}
+
 type Engine struct {
 	DBPath           string `toml:"db-path"`             // Directory to store the data in. Should exist and be writable.
 	ValueThreshold   int    `toml:"value-threshold"`     // If value size >= this threshold, only store value offsets in tree.
@@ -78,14 +162,43 @@ var DefaultConf = Config{
 		LogLevel:   "info",
 		MaxProcs:   0,
 		Raft:       true,
+
+		ExemplarSampleRate: 0.01,
 	},
 	RaftStore: RaftStore{
 		RaftWorkers:              2,
+		ApplyWorkers:             2,
 		PdHeartbeatTickInterval:  "20s",
 		RaftStoreMaxLeaderLease:  "9s",
 		RaftBaseTickInterval:     "1s",
 		RaftHeartbeatTicks:       2,
 		RaftElectionTimeoutTicks: 10,
+		QuorumReadTimeout:        "3s",
+		RightDeriveWhenSplit:     true,
+		ReserveSpace:             1024 * MB,
+		RaftLogGCTickInterval:    "10s",
+		RaftLogGCThreshold:       50,
+		RaftLogGCCountLimit:      72 * 1024,
+		RaftLogGCSizeLimit:       72 * MB,
+	},
+	ReadPool: ReadPool{
+		PointGetConcurrency:    4,
+		ScanConcurrency:        2,
+		CoprocessorConcurrency: 2,
+		MaxTasksPerWorker:      1000,
+	},
+	Scheduler: Scheduler{
+		Concurrency:     4,
+		LockWaitTimeout: "0",
+	},
+	Scan: Scan{
+		MaxKeys:     10000,
+		MaxBytes:    64 * MB,
+		MaxDuration: "10s",
+	},
+	GC: GC{
+		PollInterval:  "1m",
+		KeysPerSecond: 10000,
 	},
 	Engine: Engine{
 		DBPath:           "/tmp/badger",
@@ -103,16 +216,79 @@ var DefaultConf = Config{
 	},
 }
 
+// Validate checks the values the server would otherwise only reject on use, often by exiting, like the durations.
+func (c *Config) Validate() error {
+	if c.Server.StoreAddr == "" || c.Server.PDAddr == "" {
+		return fmt.Errorf("server.store-addr and server.pd-addr must be set")
+	}
+	if c.Server.ExemplarSampleRate < 0 || c.Server.ExemplarSampleRate > 1 {
+		return fmt.Errorf("server.exemplar-sample-rate must be in [0, 1], not %v", c.Server.ExemplarSampleRate)
+	}
+	if c.Server.ShadowSampleRate < 0 || c.Server.ShadowSampleRate > 1 {
+		return fmt.Errorf("server.shadow-sample-rate must be in [0, 1], not %v", c.Server.ShadowSampleRate)
+	}
+	switch c.Server.DiskClass {
+	case "", "nvme", "ssd", "hdd":
+	default:
+		return fmt.Errorf("server.disk-class must be nvme, ssd or hdd, not %q", c.Server.DiskClass)
+	}
+	durations := map[string]string{
+		"raftstore.pd-heartbeat-tick-interval":  c.RaftStore.PdHeartbeatTickInterval,
+		"raftstore.raft-store-max-leader-lease": c.RaftStore.RaftStoreMaxLeaderLease,
+		"raftstore.raft-base-tick-interval":     c.RaftStore.RaftBaseTickInterval,
+		"raftstore.quorum-read-timeout":         c.RaftStore.QuorumReadTimeout,
+		"raftstore.raft-log-gc-tick-interval":   c.RaftStore.RaftLogGCTickInterval,
+		"scheduler.lock-wait-timeout":           c.Scheduler.LockWaitTimeout,
+		"scan.max-duration":                     c.Scan.MaxDuration,
+		"gc.poll-interval":                      c.GC.PollInterval,
+	}
+	for name, d := range durations {
+		if d == "" {
+			continue
+		}
+		if _, err := parseDuration(d); err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+	}
+	if c.Scheduler.Concurrency <= 0 {
+		return fmt.Errorf("scheduler.concurrency must be > 0, not %v", c.Scheduler.Concurrency)
+	}
+	if c.ReadPool.PointGetConcurrency <= 0 || c.ReadPool.ScanConcurrency <= 0 || c.ReadPool.CoprocessorConcurrency <= 0 {
+		return fmt.Errorf("readpool concurrencies must be > 0")
+	}
+	for _, r := range c.GC.Retentions {
+		if _, err := hex.DecodeString(r.Prefix); err != nil {
+			return fmt.Errorf("gc.retention prefix %q is not hex", r.Prefix)
+		}
+	}
+	if c.Engine.DBPath == "" {
+		return fmt.Errorf("engine.db-path must be set")
+	}
+	if len(c.Engine.Compression) < badger.DefaultOptions.TableBuilderOptions.MaxLevels {
+		return fmt.Errorf("engine.compression must have a type for each of the %d levels",
+			badger.DefaultOptions.TableBuilderOptions.MaxLevels)
+	}
+	return nil
+}
+
 // parseDuration parses duration argument string.
 func ParseDuration(durationStr string) time.Duration {
+	dur, err := parseDuration(durationStr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return dur
+}
+
+func parseDuration(durationStr string) (time.Duration, error) {
 	dur, err := time.ParseDuration(durationStr)
 	if err != nil {
 		dur, err = time.ParseDuration(durationStr + "s")
 	}
 	if err != nil || dur < 0 {
-		log.Fatalf("invalid duration=%v", durationStr)
+		return 0, fmt.Errorf("invalid duration=%v", durationStr)
 	}
-	return dur
+	return dur, nil
 }
 
 var globalConf = DefaultConf
@@ -0,0 +1,30 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	conf := DefaultConf
+	require.Nil(t, conf.Validate())
+
+	conf.GC.PollInterval = "soon"
+	require.NotNil(t, conf.Validate())
+	conf.GC.PollInterval = "100"
+	require.Nil(t, conf.Validate())
+
+	conf.Server.DiskClass = "tape"
+	require.NotNil(t, conf.Validate())
+	conf.Server.DiskClass = "ssd"
+	conf.Server.ShadowSampleRate = 1.5
+	require.NotNil(t, conf.Validate())
+	conf.Server.ShadowSampleRate = 0.01
+	require.Nil(t, conf.Validate())
+	conf.GC.Retentions = []VersionRetention{{Prefix: "zz", MaxVersions: 1}}
+	require.NotNil(t, conf.Validate())
+	conf.GC.Retentions = nil
+	conf.Engine.Compression = conf.Engine.Compression[:1]
+	require.NotNil(t, conf.Validate())
+}
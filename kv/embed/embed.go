@@ -0,0 +1,241 @@
+// Package embed runs a scheduler and a single store of tinykv in the process of an application, the way etcd is
+// embedded, for tests and small deployments which don't want to manage the two servers.
+package embed
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/pd"
+	"github.com/pingcap-incubator/tinykv/kv/tikv"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/exec"
+	"github.com/pingcap-incubator/tinykv/kv/util/memory"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tikvpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/server"
+	schedulerConfig "github.com/pingcap-incubator/tinykv/scheduler/server/config"
+	"github.com/pingcap/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	// Registers the schedulers the scheduler creates by default.
+	_ "github.com/pingcap-incubator/tinykv/scheduler/server/schedulers"
+)
+
+const (
+	inMemoryBufferSize   = 1 << 20
+	regionContextTimeout = 10 * time.Second
+)
+
+// Config is the configuration of an embedded tinykv.
+type Config struct {
+	// Dir is where the scheduler and the store keep their data, in the scheduler and store sub directories.
+	Dir string
+	// Store is the configuration of the store, nil means config.DefaultConf. Its scheduler address and db path are
+	// set by Start, and so is its address if the store is served in memory.
+	Store *config.Config
+	// InMemory serves the store to the clients of the process over an in-memory connection, instead of listening on
+	// the store address. The scheduler listens on two local ports picked by Start anyway, it embeds etcd.
+	InMemory bool
+}
+
+// TinyKV is a scheduler and a store running in the process.
+type TinyKV struct {
+	scheduler      *server.Server
+	cancelSchedule context.CancelFunc
+	pdClient       pd.Client
+	tikvServer     *tikv.Server
+	gcWorker       *tikv.GCWorker
+	grpcServer     *grpc.Server
+	conn           *grpc.ClientConn
+}
+
+// Start starts the scheduler and the store, and returns once the store has bootstrapped the cluster or joined it.
+// Only one store may be embedded in a process, the store configuration is global.
+func Start(cfg *Config) (_ *TinyKV, err error) {
+	if cfg.Dir == "" {
+		return nil, errors.New("embed: no data dir")
+	}
+	t := new(TinyKV)
+	defer func() {
+		if err != nil {
+			t.Close()
+		}
+	}()
+	if err = t.startScheduler(filepath.Join(cfg.Dir, "scheduler")); err != nil {
+		return nil, errors.Annotate(err, "start scheduler")
+	}
+
+	conf := config.DefaultConf
+	if cfg.Store != nil {
+		conf = *cfg.Store
+	}
+	conf.Server.PDAddr = strings.Join(t.scheduler.GetEndpoints(), ",")
+	conf.Engine.DBPath = filepath.Join(cfg.Dir, "store")
+	var l net.Listener
+	if cfg.InMemory {
+		l = bufconn.Listen(inMemoryBufferSize)
+		conf.Server.StoreAddr = "in-memory"
+	} else if l, err = net.Listen("tcp", conf.Server.StoreAddr); err != nil {
+		return nil, err
+	}
+	if err = conf.Validate(); err != nil {
+		l.Close()
+		return nil, err
+	}
+	if err = t.startStore(&conf, l); err != nil {
+		return nil, errors.Annotate(err, "start store")
+	}
+
+	opts := []grpc.DialOption{grpc.WithInsecure()}
+	if bl, ok := l.(*bufconn.Listener); ok {
+		opts = append(opts, grpc.WithDialer(func(string, time.Duration) (net.Conn, error) { return bl.Dial() }))
+	}
+	if t.conn, err = grpc.Dial(l.Addr().String(), opts...); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *TinyKV) startScheduler(dir string) error {
+	clientURL, err := localURL()
+	if err != nil {
+		return err
+	}
+	peerURL, err := localURL()
+	if err != nil {
+		return err
+	}
+	cfg := schedulerConfig.NewConfig()
+	cfg.Name = "embed"
+	cfg.DataDir = dir
+	cfg.ClientUrls, cfg.PeerUrls = clientURL, peerURL
+	if err = cfg.Adjust(nil); err != nil {
+		return err
+	}
+	if err = cfg.SetupLogger(); err != nil {
+		return err
+	}
+	if t.scheduler, err = server.CreateServer(cfg); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancelSchedule = cancel
+	return t.scheduler.Run(ctx)
+}
+
+func (t *TinyKV) startStore(conf *config.Config, l net.Listener) error {
+	if err := os.MkdirAll(conf.Engine.DBPath, os.ModePerm); err != nil {
+		return err
+	}
+	config.SetGlobalConf(conf)
+	memory.StoreBudget.SetLimit(conf.Server.MemoryBudget)
+
+	pdClient, err := pd.NewClient(strings.Split(conf.Server.PDAddr, ","), "embed")
+	if err != nil {
+		return err
+	}
+	t.pdClient = pdClient
+	var innerServer tikv.InnerServer
+	if conf.Server.Raft {
+		innerServer = inner_server.NewRaftInnerServer(conf)
+	} else {
+		innerServer = inner_server.NewStandAloneInnerServer(conf)
+	}
+	if err = innerServer.Start(pdClient); err != nil {
+		return err
+	}
+	scheduler := exec.NewLatchedScheduler(innerServer, conf.Scheduler.Concurrency)
+	readPool := exec.NewReadPool(innerServer, &conf.ReadPool)
+	t.tikvServer = tikv.NewServer(innerServer, scheduler, readPool)
+
+	var storeID uint64
+	if s, ok := innerServer.(interface{ GetStoreMeta() *metapb.Store }); ok {
+		storeID = s.GetStoreMeta().Id
+	}
+	t.gcWorker = tikv.NewGCWorker(t.tikvServer, pdClient, storeID, &conf.GC)
+	t.gcWorker.Start()
+
+	t.grpcServer = grpc.NewServer(
+		grpc.MaxRecvMsgSize(10*1024*1024),
+		grpc.UnaryInterceptor(tikv.RequestMetricsInterceptor(conf.Server.ExemplarSampleRate)),
+	)
+	tikvpb.RegisterTikvServer(t.grpcServer, t.tikvServer)
+	go t.grpcServer.Serve(l)
+	return nil
+}
+
+// Client returns a client of the store. The requests must carry the context of the region of their keys, see
+// RegionContext.
+func (t *TinyKV) Client() tikvpb.TikvClient {
+	return tikvpb.NewTikvClient(t.conn)
+}
+
+// PDClient returns a client of the scheduler, for the timestamps and the regions.
+func (t *TinyKV) PDClient() pd.Client {
+	return t.pdClient
+}
+
+// RegionContext returns the context of the requests on key, for the region containing it and its leader. The leader
+// is always the store, but the regions split as they grow, so the context of a key changes over time and a request
+// failing with a region error should be retried with a new context.
+func (t *TinyKV) RegionContext(ctx context.Context, key []byte) (*kvrpcpb.Context, error) {
+	ctx, cancel := context.WithTimeout(ctx, regionContextTimeout)
+	defer cancel()
+	for {
+		region, leader, err := t.pdClient.GetRegion(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		// The region is reported by the store once it has been elected, until then there is no leader.
+		if region != nil && leader != nil {
+			return &kvrpcpb.Context{RegionId: region.Id, RegionEpoch: region.RegionEpoch, Peer: leader}, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, errors.Errorf("no leader of the region of key %q", key)
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+}
+
+// Close stops the store and the scheduler.
+func (t *TinyKV) Close() {
+	if t.conn != nil {
+		t.conn.Close()
+	}
+	if t.grpcServer != nil {
+		t.grpcServer.Stop()
+	}
+	if t.gcWorker != nil {
+		t.gcWorker.Stop()
+	}
+	if t.tikvServer != nil {
+		t.tikvServer.Stop()
+	}
+	if t.pdClient != nil {
+		t.pdClient.Close()
+	}
+	if t.scheduler != nil {
+		t.cancelSchedule()
+		t.scheduler.Close()
+	}
+}
+
+// localURL returns the URL of a free local port.
+func localURL() (string, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	defer l.Close()
+	return fmt.Sprintf("http://%s", l.Addr()), nil
+}
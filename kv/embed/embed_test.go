@@ -0,0 +1,37 @@
+package embed
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbedInMemory(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tinykv_embed")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	kv, err := Start(&Config{Dir: dir, InMemory: true})
+	require.Nil(t, err)
+	defer kv.Close()
+
+	ctx := context.Background()
+	regionCtx, err := kv.RegionContext(ctx, []byte("k1"))
+	require.Nil(t, err)
+	client := kv.Client()
+	putResp, err := client.RawPut(ctx, &kvrpcpb.RawPutRequest{Context: regionCtx, Cf: engine_util.CF_DEFAULT, Key: []byte("k1"), Value: []byte("v1")})
+	require.Nil(t, err)
+	require.Nil(t, putResp.RegionError)
+	assert.Empty(t, putResp.Error)
+
+	getResp, err := client.RawGet(ctx, &kvrpcpb.RawGetRequest{Context: regionCtx, Cf: engine_util.CF_DEFAULT, Key: []byte("k1")})
+	require.Nil(t, err)
+	require.Nil(t, getResp.RegionError)
+	assert.Equal(t, []byte("v1"), getResp.Value)
+}
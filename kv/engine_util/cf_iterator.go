@@ -1,6 +1,10 @@
 package engine_util
 
-import "github.com/coocood/badger"
+import (
+	"bytes"
+
+	"github.com/coocood/badger"
+)
 
 type CFItem struct {
 	item      *badger.Item
@@ -17,7 +21,7 @@ func (i *CFItem) Key() []byte {
 }
 
 func (i *CFItem) KeyCopy(dst []byte) []byte {
-	return i.item.KeyCopy(dst)
+	return append(dst[:0], i.Key()...)
 }
 
 func (i *CFItem) Version() uint64 {
@@ -53,8 +57,11 @@ func (i *CFItem) UserMeta() []byte {
 }
 
 type CFIterator struct {
-	iter   *badger.Iterator
-	prefix string
+	iter    *badger.Iterator
+	prefix  string
+	reverse bool
+	// tombstones are the deleted ranges whose key versions are skipped, sorted by start key.
+	tombstones []RangeTombstone
 }
 
 func NewCFIterator(cf string, txn *badger.Txn) *CFIterator {
@@ -64,6 +71,33 @@ func NewCFIterator(cf string, txn *badger.Txn) *CFIterator {
 	}
 }
 
+// NewCFIteratorWithTombstones creates an iterator which skips the key versions deleted by the range tombstones.
+func NewCFIteratorWithTombstones(cf string, txn *badger.Txn, tombstones []RangeTombstone) *CFIterator {
+	it := NewCFIterator(cf, txn)
+	it.tombstones = tombstones
+	return it
+}
+
+// NewReverseCFIterator creates an iterator which walks the keys in descending order. Seek positions it at the
+// largest key not greater than the sought key, and an empty key seeks to the last key of the column family.
+func NewReverseCFIterator(cf string, txn *badger.Txn) *CFIterator {
+	opts := badger.DefaultIteratorOptions
+	opts.Reverse = true
+	return &CFIterator{
+		iter:    txn.NewIterator(opts),
+		prefix:  cf + "_",
+		reverse: true,
+	}
+}
+
+// NewReverseCFIteratorWithTombstones creates a reverse iterator which skips the key versions deleted by the range
+// tombstones.
+func NewReverseCFIteratorWithTombstones(cf string, txn *badger.Txn, tombstones []RangeTombstone) *CFIterator {
+	it := NewReverseCFIterator(cf, txn)
+	it.tombstones = tombstones
+	return it
+}
+
 func (it *CFIterator) Item() *CFItem {
 	return &CFItem{
 		item:      it.iter.Item(),
@@ -83,12 +117,49 @@ func (it *CFIterator) Close() {
 
 func (it *CFIterator) Next() {
 	it.iter.Next()
+	it.skipTombstones()
 }
 
 func (it *CFIterator) Seek(key []byte) {
-	it.iter.Seek(append([]byte(it.prefix), key...))
+	if it.reverse && len(key) == 0 {
+		it.seekToLast()
+	} else {
+		it.iter.Seek(append([]byte(it.prefix), key...))
+	}
+	it.skipTombstones()
 }
 
 func (it *CFIterator) Rewind() {
-	it.iter.Rewind()
+	if it.reverse {
+		it.seekToLast()
+	} else {
+		it.iter.Rewind()
+	}
+	it.skipTombstones()
+}
+
+// seekToLast positions a reverse iterator at the last key of the column family. All the keys of the column family
+// are below the prefix with its last byte incremented.
+func (it *CFIterator) seekToLast() {
+	end := []byte(it.prefix)
+	end[len(end)-1]++
+	it.iter.Seek(end)
+	if it.iter.Valid() && bytes.Equal(it.iter.Item().Key(), end) {
+		it.iter.Next()
+	}
+}
+
+// skipTombstones moves the iterator past the keys whose versions are deleted by the range tombstones. A key in a
+// deleted range may have been written again after the tombstone, so the keys are checked one by one.
+func (it *CFIterator) skipTombstones() {
+	if len(it.tombstones) == 0 {
+		return
+	}
+	for it.iter.ValidForPrefix([]byte(it.prefix)) {
+		item := it.Item()
+		if FindRangeTombstone(it.tombstones, item.Key(), item.Version()) == nil {
+			return
+		}
+		it.iter.Next()
+	}
 }
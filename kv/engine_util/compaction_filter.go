@@ -0,0 +1,100 @@
+package engine_util
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/coocood/badger"
+	"github.com/coocood/badger/y"
+)
+
+// CompactionFilter decides whether a pair of a column family is kept when it's compacted, e.g. it can drop the raw
+// keys whose TTL expired, or the versions older than the GC safe point, so that the GC work is done by the compactions
+// instead of separate scan and delete passes. The key is without the column family prefix. The values larger than the
+// ValueThreshold of the engine live in the value log, the filter gets the pointer to them instead of the value.
+type CompactionFilter interface {
+	Filter(key, value []byte) badger.Decision
+}
+
+// CompactionFilterFactory creates the filter for a compaction run into targetLevel.
+type CompactionFilterFactory func(targetLevel int) CompactionFilter
+
+type compactionFilterRegistration struct {
+	// The column family prefix of the keys in the engine.
+	prefix   []byte
+	startKey []byte
+	endKey   []byte
+	factory  CompactionFilterFactory
+}
+
+var compactionFilters struct {
+	sync.RWMutex
+	registrations []*compactionFilterRegistration
+}
+
+// RegisterCompactionFilter registers a filter for the keys of the column family in [startKey, endKey), an empty
+// endKey is unbounded. It applies to the compactions started after the registration in the kv engines created by
+// CreateDB. When several filters cover a key, the first registered one which doesn't keep it decides.
+func RegisterCompactionFilter(cf string, startKey, endKey []byte, factory CompactionFilterFactory) {
+	compactionFilters.Lock()
+	defer compactionFilters.Unlock()
+	compactionFilters.registrations = append(compactionFilters.registrations, &compactionFilterRegistration{
+		prefix:   []byte(cf + "_"),
+		startKey: startKey,
+		endKey:   endKey,
+		factory:  factory,
+	})
+}
+
+// ClearCompactionFilters removes all the registered filters.
+func ClearCompactionFilters() {
+	compactionFilters.Lock()
+	defer compactionFilters.Unlock()
+	compactionFilters.registrations = nil
+}
+
+type activeCompactionFilter struct {
+	*compactionFilterRegistration
+	filter CompactionFilter
+}
+
+// cfCompactionFilter dispatches the pairs being compacted to the filters registered for their column families and key
+// ranges.
+type cfCompactionFilter struct {
+	filters []activeCompactionFilter
+}
+
+// newCompactionFilter implements badger.Options.CompactionFilterFactory.
+func newCompactionFilter(targetLevel int, smallest, biggest []byte) badger.CompactionFilter {
+	compactionFilters.RLock()
+	defer compactionFilters.RUnlock()
+	f := new(cfCompactionFilter)
+	for _, r := range compactionFilters.registrations {
+		f.filters = append(f.filters, activeCompactionFilter{r, r.factory(targetLevel)})
+	}
+	return f
+}
+
+func (f *cfCompactionFilter) Filter(key, val, userMeta []byte) badger.Decision {
+	if len(f.filters) == 0 {
+		return badger.DecisionKeep
+	}
+	key = y.ParseKey(key)
+	for _, active := range f.filters {
+		if !bytes.HasPrefix(key, active.prefix) {
+			continue
+		}
+		cfKey := key[len(active.prefix):]
+		if bytes.Compare(cfKey, active.startKey) < 0 || (len(active.endKey) > 0 && bytes.Compare(cfKey, active.endKey) >= 0) {
+			continue
+		}
+		if decision := active.filter.Filter(cfKey, val); decision != badger.DecisionKeep {
+			return decision
+		}
+	}
+	return badger.DecisionKeep
+}
+
+func (f *cfCompactionFilter) Guards() []badger.Guard {
+	return nil
+}
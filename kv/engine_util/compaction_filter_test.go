@@ -0,0 +1,58 @@
+package engine_util
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/coocood/badger"
+	"github.com/coocood/badger/y"
+	"github.com/stretchr/testify/assert"
+)
+
+type funcCompactionFilter func(key, value []byte) badger.Decision
+
+func (f funcCompactionFilter) Filter(key, value []byte) badger.Decision {
+	return f(key, value)
+}
+
+func TestCompactionFilter(t *testing.T) {
+	defer ClearCompactionFilters()
+	var levels []int
+	// Drops the expired pairs of the default CF in [b, d).
+	RegisterCompactionFilter(CF_DEFAULT, []byte("b"), []byte("d"), func(targetLevel int) CompactionFilter {
+		levels = append(levels, targetLevel)
+		return funcCompactionFilter(func(key, value []byte) badger.Decision {
+			if bytes.Equal(value, []byte("expired")) {
+				return badger.DecisionDrop
+			}
+			return badger.DecisionKeep
+		})
+	})
+	// Marks all the pairs of the lock CF deleted.
+	RegisterCompactionFilter(CF_LOCK, nil, nil, func(targetLevel int) CompactionFilter {
+		return funcCompactionFilter(func(key, value []byte) badger.Decision {
+			return badger.DecisionMarkTombstone
+		})
+	})
+
+	filter := newCompactionFilter(3, nil, nil)
+	assert.Equal(t, []int{3}, levels)
+	decide := func(cf, key, value string) badger.Decision {
+		return filter.Filter(y.KeyWithTs([]byte(cf+"_"+key), 1), []byte(value), nil)
+	}
+	assert.Equal(t, badger.DecisionDrop, decide(CF_DEFAULT, "b", "expired"))
+	assert.Equal(t, badger.DecisionDrop, decide(CF_DEFAULT, "c", "expired"))
+	assert.Equal(t, badger.DecisionKeep, decide(CF_DEFAULT, "c", "v"))
+	// Out of the range.
+	assert.Equal(t, badger.DecisionKeep, decide(CF_DEFAULT, "a", "expired"))
+	assert.Equal(t, badger.DecisionKeep, decide(CF_DEFAULT, "d", "expired"))
+	// Other column families.
+	assert.Equal(t, badger.DecisionKeep, decide(CF_WRITE, "c", "expired"))
+	assert.Equal(t, badger.DecisionMarkTombstone, decide(CF_LOCK, "z", "v"))
+	// Not in any column family.
+	assert.Equal(t, badger.DecisionKeep, filter.Filter(y.KeyWithTs([]byte("c"), 1), []byte("expired"), nil))
+
+	ClearCompactionFilters()
+	filter = newCompactionFilter(1, nil, nil)
+	assert.Equal(t, badger.DecisionKeep, decide(CF_LOCK, "z", "v"))
+}
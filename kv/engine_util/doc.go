@@ -14,4 +14,5 @@ engine_util includes the following packages:
 * engines: a data structure for keeping engines required by unistore.
 * write_batch: code to batch writes into a single, atomic 'transaction'.
 * cf_iterator: code to iterate over a whole column family in badger.
+* compaction_filter: hooks to drop the pairs of a column family and key range while they are compacted.
 */
@@ -3,6 +3,7 @@ package engine_util
 import (
 	"bytes"
 	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/coocood/badger"
@@ -83,3 +84,72 @@ func TestEngineUtil(t *testing.T) {
 	require.False(t, lockIter.Valid())
 	lockIter.Close()
 }
+
+func TestMultiGetCFFromIterator(t *testing.T) {
+	dir, err := ioutil.TempDir("", "multi_get")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	opts := badger.DefaultOptions
+	opts.Dir = dir
+	opts.ValueDir = dir
+	db, err := badger.Open(opts)
+	require.Nil(t, err)
+	defer db.Close()
+
+	wb := new(WriteBatch)
+	for _, key := range []string{"a", "b1", "c", "cc"} {
+		wb.SetCF(CF_LOCK, []byte(key), []byte(key+"v"))
+	}
+	wb.SetCF(CF_WRITE, []byte("d"), []byte("dv"))
+	require.Nil(t, wb.WriteToDB(db))
+	// A write batch deletes the keys set to empty values.
+	require.Nil(t, db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte(CF_LOCK+"_e"), []byte{})
+	}))
+
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	it := NewCFIterator(CF_LOCK, txn)
+	defer it.Close()
+	// The values keep the order of the unsorted keys, nil for the keys missing in the CF.
+	vals, err := MultiGetCFFromIterator(it, [][]byte{[]byte("cc"), []byte("b1"), []byte("d"), []byte("a"), []byte("b"), []byte("c"), []byte("e")})
+	require.Nil(t, err)
+	require.Equal(t, [][]byte{[]byte("ccv"), []byte("b1v"), nil, []byte("av"), nil, []byte("cv"), {}}, vals)
+}
+
+func TestReverseCFIterator(t *testing.T) {
+	dir, err := ioutil.TempDir("", "reverse_iterator")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	opts := badger.DefaultOptions
+	opts.Dir = dir
+	opts.ValueDir = dir
+	db, err := badger.Open(opts)
+	require.Nil(t, err)
+	defer db.Close()
+
+	wb := new(WriteBatch)
+	for _, key := range []string{"a", "b1", "b2", "c", "d"} {
+		wb.SetCF(CF_LOCK, []byte(key), []byte("value"))
+	}
+	// The keys of the neighbouring column families are never reached.
+	wb.SetCF(CF_DEFAULT, []byte("z"), []byte("value"))
+	wb.SetCF(CF_WRITE, []byte("0"), []byte("value"))
+	require.Nil(t, wb.WriteToDB(db))
+
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	scan := func(it *CFIterator, startKey string) []string {
+		var keys []string
+		for it.Seek([]byte(startKey)); it.Valid(); it.Next() {
+			keys = append(keys, string(it.Item().Key()))
+		}
+		return keys
+	}
+	it := NewReverseCFIterator(CF_LOCK, txn)
+	require.Equal(t, []string{"d", "c", "b2", "b1", "a"}, scan(it, ""))
+	// Seek is inclusive.
+	require.Equal(t, []string{"c", "b2", "b1", "a"}, scan(it, "c"))
+	require.Equal(t, []string{"b2", "b1", "a"}, scan(it, "bz"))
+	it.Close()
+}
@@ -49,6 +49,15 @@ func (en *Engines) SyncRaftWAL() error {
 
 // CreateDB creates a new Badger DB on disk at subPath.
 func CreateDB(subPath string, conf *config.Engine) *badger.DB {
+	db, err := OpenDB(subPath, conf)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return db
+}
+
+// OpenDB opens the Badger DB on disk at subPath like CreateDB, but returns the error instead of exiting.
+func OpenDB(subPath string, conf *config.Engine) (*badger.DB, error) {
 	opts := badger.DefaultOptions
 	opts.NumCompactors = conf.NumCompactors
 	opts.ValueThreshold = conf.ValueThreshold
@@ -67,9 +76,8 @@ func CreateDB(subPath string, conf *config.Engine) *badger.DB {
 	opts.SyncWrites = conf.SyncWrite
 	opts.MaxCacheSize = conf.BlockCacheSize
 	opts.TableBuilderOptions.SuRFStartLevel = conf.SurfStartLevel
-	db, err := badger.Open(opts)
-	if err != nil {
-		log.Fatal(err)
+	if subPath != "raft" {
+		opts.CompactionFilterFactory = newCompactionFilter
 	}
-	return db
+	return badger.Open(opts)
 }
@@ -0,0 +1,226 @@
+package engine_util
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	"github.com/coocood/badger"
+	"github.com/pingcap/errors"
+)
+
+// A range tombstone marks all the data keys in a range of a region deleted at once. It only deletes the key versions
+// written up to the badger version of the tombstone, so the writes applied after it stay visible. Readers skip the
+// versions it covers, and the region worker removes them physically in the background, after which the tombstone
+// itself is deleted.
+//
+// Range tombstones are saved as local keys of their region, so they are never mixed up with data keys, which start
+// with a CF name, and a reader loads the tombstones of its own region only.
+var rangeTombstonePrefix = []byte{0x01, 0x04}
+
+// KeyRange is the range [StartKey, EndKey), an empty EndKey means no upper bound.
+type KeyRange struct {
+	StartKey []byte
+	EndKey   []byte
+}
+
+func (r *KeyRange) Contains(key []byte) bool {
+	return bytes.Compare(key, r.StartKey) >= 0 && !ExceedEndKey(key, r.EndKey)
+}
+
+type RangeTombstone struct {
+	KeyRange
+	// Index is the raft log index of the command which deleted the range, it tells the tombstones of a region apart.
+	Index uint64
+	// Version is the badger version the range is deleted at, 0 for a tombstone not written yet, which takes the
+	// version of its own write.
+	Version uint64
+}
+
+// Hides reports whether the version of the key is deleted by the tombstone.
+func (t *RangeTombstone) Hides(key []byte, version uint64) bool {
+	return version <= t.Version && t.Contains(key)
+}
+
+func RangeTombstonePrefix(regionID uint64) []byte {
+	key := make([]byte, len(rangeTombstonePrefix)+8)
+	copy(key, rangeTombstonePrefix)
+	binary.BigEndian.PutUint64(key[len(rangeTombstonePrefix):], regionID)
+	return key
+}
+
+func RangeTombstoneKey(regionID, index uint64) []byte {
+	key := RangeTombstonePrefix(regionID)
+	var suffix [8]byte
+	binary.BigEndian.PutUint64(suffix[:], index)
+	return append(key, suffix[:]...)
+}
+
+// SetRangeTombstone saves the tombstone of the region. The value is the version, the length of the start key, the
+// start key and then the end key, so it's never empty, which would mean a deletion to the write batch.
+func (wb *WriteBatch) SetRangeTombstone(regionID uint64, t RangeTombstone) {
+	val := make([]byte, 0, 2*binary.MaxVarintLen64+len(t.StartKey)+len(t.EndKey))
+	val = appendUvarint(val, t.Version)
+	val = appendUvarint(val, uint64(len(t.StartKey)))
+	val = append(append(val, t.StartKey...), t.EndKey...)
+	wb.Set(RangeTombstoneKey(regionID, t.Index), val)
+}
+
+// DeleteRangeTombstone removes the tombstone of the region written by the command at index.
+func (wb *WriteBatch) DeleteRangeTombstone(regionID, index uint64) {
+	wb.Delete(RangeTombstoneKey(regionID, index))
+}
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	return append(buf, tmp[:binary.PutUvarint(tmp[:], v)]...)
+}
+
+func decodeRangeTombstone(item *badger.Item) (RangeTombstone, error) {
+	var t RangeTombstone
+	key := item.Key()
+	val, err := item.Value()
+	if err != nil {
+		return t, errors.WithStack(err)
+	}
+	version, n := binary.Uvarint(val)
+	if n <= 0 {
+		return t, errors.Errorf("invalid range tombstone %v", key)
+	}
+	val = val[n:]
+	startLen, n := binary.Uvarint(val)
+	if n <= 0 || uint64(len(val)-n) < startLen {
+		return t, errors.Errorf("invalid range tombstone %v", key)
+	}
+	val = val[n:]
+	if version == 0 {
+		version = item.Version()
+	}
+	t.StartKey = append([]byte{}, val[:startLen]...)
+	t.EndKey = append([]byte{}, val[startLen:]...)
+	t.Index = binary.BigEndian.Uint64(key[len(key)-8:])
+	t.Version = version
+	return t, nil
+}
+
+// LoadRangeTombstones returns the range tombstones of the region visible to the txn, sorted by start key.
+func LoadRangeTombstones(txn *badger.Txn, regionID uint64) ([]RangeTombstone, error) {
+	var tombstones []RangeTombstone
+	prefix := RangeTombstonePrefix(regionID)
+	it := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer it.Close()
+	for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+		t, err := decodeRangeTombstone(it.Item())
+		if err != nil {
+			return nil, err
+		}
+		tombstones = append(tombstones, t)
+	}
+	sort.Slice(tombstones, func(i, j int) bool {
+		return bytes.Compare(tombstones[i].StartKey, tombstones[j].StartKey) < 0
+	})
+	return tombstones, nil
+}
+
+// RangeTombstoneRegions returns the IDs of the regions which have range tombstones visible to the txn.
+func RangeTombstoneRegions(txn *badger.Txn) ([]uint64, error) {
+	var regionIDs []uint64
+	it := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer it.Close()
+	for it.Seek(rangeTombstonePrefix); it.ValidForPrefix(rangeTombstonePrefix); {
+		key := it.Item().Key()
+		if len(key) != len(rangeTombstonePrefix)+16 {
+			return nil, errors.Errorf("invalid range tombstone key %v", key)
+		}
+		regionID := binary.BigEndian.Uint64(key[len(rangeTombstonePrefix):])
+		regionIDs = append(regionIDs, regionID)
+		it.Seek(RangeTombstonePrefix(regionID + 1))
+	}
+	return regionIDs, nil
+}
+
+// FindRangeTombstone returns the tombstone in tombstones which hides the version of the key, or nil if the version
+// isn't deleted. The tombstones must be sorted by start key.
+func FindRangeTombstone(tombstones []RangeTombstone, key []byte, version uint64) *RangeTombstone {
+	// Tombstones may overlap, so check every tombstone starting at or before the key.
+	n := sort.Search(len(tombstones), func(i int) bool { return bytes.Compare(tombstones[i].StartKey, key) > 0 })
+	for i := n - 1; i >= 0; i-- {
+		if tombstones[i].Hides(key, version) {
+			return &tombstones[i]
+		}
+	}
+	return nil
+}
+
+// GetCFFromTxnWithTombstones is GetCFFromTxn which doesn't find the key versions deleted by the tombstones.
+func GetCFFromTxnWithTombstones(txn *badger.Txn, cf string, key []byte, tombstones []RangeTombstone) ([]byte, error) {
+	item, err := txn.Get(append([]byte(cf+"_"), key...))
+	if err != nil {
+		return nil, err
+	}
+	if FindRangeTombstone(tombstones, key, item.Version()) != nil {
+		return nil, badger.ErrKeyNotFound
+	}
+	return item.Value()
+}
+
+// CleanUpRangeTombstone deletes the key versions hidden by the tombstone of the region physically, and then the
+// tombstone itself. The keys are deleted in batches by transactions which read them first, so a write applied into
+// the range meanwhile fails the batch with a conflict, and the retried batch keeps the new version.
+func CleanUpRangeTombstone(db *badger.DB, regionID uint64, t RangeTombstone) error {
+	for _, cf := range CFs {
+		for next := t.StartKey; next != nil; {
+			var batchNext []byte
+			err := updateOnConflict(db, func(txn *badger.Txn) (err error) {
+				batchNext, err = deleteHiddenKeys(txn, cf, next, &t)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			next = batchNext
+		}
+	}
+	key := RangeTombstoneKey(regionID, t.Index)
+	return updateOnConflict(db, func(txn *badger.Txn) error {
+		if _, err := txn.Get(key); err == badger.ErrKeyNotFound {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		return txn.Delete(key)
+	})
+}
+
+func updateOnConflict(db *badger.DB, fn func(txn *badger.Txn) error) error {
+	for {
+		err := db.Update(fn)
+		if errors.Cause(err) != badger.ErrConflict {
+			return errors.WithStack(err)
+		}
+	}
+}
+
+// deleteHiddenKeys deletes a batch of the keys of cf from start hidden by the tombstone, it returns the key to go on
+// with, or nil if all the keys in the range are done.
+func deleteHiddenKeys(txn *badger.Txn, cf string, start []byte, t *RangeTombstone) ([]byte, error) {
+	it := NewCFIterator(cf, txn)
+	defer it.Close()
+	deleted := 0
+	for it.Seek(start); it.Valid(); it.Next() {
+		item := it.Item()
+		if ExceedEndKey(item.Key(), t.EndKey) {
+			return nil, nil
+		}
+		if deleted >= deleteRangeBatchSize {
+			return item.KeyCopy(nil), nil
+		}
+		if item.Version() <= t.Version {
+			if err := txn.Delete(append([]byte(cf+"_"), item.Key()...)); err != nil {
+				return nil, err
+			}
+			deleted++
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,99 @@
+package engine_util
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/coocood/badger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRangeTombstone(t *testing.T) {
+	dir, err := ioutil.TempDir("", "range_tombstone")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	opts := badger.DefaultOptions
+	opts.Dir = dir
+	opts.ValueDir = dir
+	db, err := badger.Open(opts)
+	require.Nil(t, err)
+	defer db.Close()
+
+	wb := new(WriteBatch)
+	for _, key := range []string{"a", "b1", "b2", "c", "d1", "d2"} {
+		wb.SetCF(CF_DEFAULT, []byte(key), []byte("old"))
+	}
+	wb.SetRangeTombstone(1, RangeTombstone{KeyRange: KeyRange{StartKey: []byte("b"), EndKey: []byte("c")}, Index: 5})
+	wb.SetRangeTombstone(1, RangeTombstone{KeyRange: KeyRange{StartKey: []byte("d")}, Index: 6})
+	require.Nil(t, wb.WriteToDB(db))
+	// Written after the tombstones.
+	wb = new(WriteBatch)
+	wb.SetCF(CF_DEFAULT, []byte("b2"), []byte("new"))
+	require.Nil(t, wb.WriteToDB(db))
+
+	scan := func(regionID uint64, reverse bool) []string {
+		txn := db.NewTransaction(false)
+		defer txn.Discard()
+		tombstones, err := LoadRangeTombstones(txn, regionID)
+		require.Nil(t, err)
+		var keys []string
+		var it *CFIterator
+		if reverse {
+			it = NewReverseCFIteratorWithTombstones(CF_DEFAULT, txn, tombstones)
+		} else {
+			it = NewCFIteratorWithTombstones(CF_DEFAULT, txn, tombstones)
+		}
+		defer it.Close()
+		for it.Seek(nil); it.Valid(); it.Next() {
+			keys = append(keys, string(it.Item().Key()))
+		}
+		return keys
+	}
+	assert.Equal(t, []string{"a", "b2", "c"}, scan(1, false))
+	assert.Equal(t, []string{"c", "b2", "a"}, scan(1, true))
+	// The tombstones of a region don't hide the keys of another.
+	assert.Equal(t, []string{"a", "b1", "b2", "c", "d1", "d2"}, scan(2, false))
+
+	txn := db.NewTransaction(false)
+	tombstones, err := LoadRangeTombstones(txn, 1)
+	require.Nil(t, err)
+	regionIDs, err := RangeTombstoneRegions(txn)
+	require.Nil(t, err)
+	_, err = GetCFFromTxnWithTombstones(txn, CF_DEFAULT, []byte("b1"), tombstones)
+	assert.Equal(t, badger.ErrKeyNotFound, err)
+	val, err := GetCFFromTxnWithTombstones(txn, CF_DEFAULT, []byte("b2"), tombstones)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("new"), val)
+	txn.Discard()
+	assert.Equal(t, []uint64{1}, regionIDs)
+	require.Len(t, tombstones, 2)
+	assert.Equal(t, uint64(5), tombstones[0].Index)
+	assert.Equal(t, tombstones[0].Version, tombstones[1].Version)
+	assert.Nil(t, FindRangeTombstone(tombstones, []byte("a"), 0))
+	assert.NotNil(t, FindRangeTombstone(tombstones, []byte("b"), tombstones[0].Version))
+	assert.Nil(t, FindRangeTombstone(tombstones, []byte("b"), tombstones[0].Version+1))
+	assert.NotNil(t, FindRangeTombstone(tombstones, []byte("z"), 0))
+
+	// A tombstone written with a version deletes the versions up to it instead of its own.
+	wb = new(WriteBatch)
+	wb.SetRangeTombstone(3, RangeTombstone{KeyRange: KeyRange{StartKey: []byte("a")}, Index: 5, Version: tombstones[0].Version})
+	require.Nil(t, wb.WriteToDB(db))
+	assert.Equal(t, []string{"b2"}, scan(3, false))
+
+	// The cleanup deletes the hidden versions and then the tombstones.
+	for _, tombstone := range tombstones {
+		require.Nil(t, CleanUpRangeTombstone(db, 1, tombstone))
+	}
+	for _, key := range []string{"b1", "d1", "d2"} {
+		_, err = GetCF(db, CF_DEFAULT, []byte(key))
+		assert.Equal(t, badger.ErrKeyNotFound, err)
+	}
+	txn = db.NewTransaction(false)
+	regionIDs, err = RangeTombstoneRegions(txn)
+	txn.Discard()
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{3}, regionIDs)
+	assert.Equal(t, []string{"a", "b2", "c"}, scan(1, false))
+}
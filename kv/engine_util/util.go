@@ -2,6 +2,7 @@ package engine_util
 
 import (
 	"bytes"
+	"sort"
 
 	"github.com/coocood/badger"
 )
@@ -27,19 +28,53 @@ func GetCFFromTxn(txn *badger.Txn, cf string, key []byte) ([]byte, error) {
 	return val, err
 }
 
+// MultiGetCFFromIterator reads the values of keys with a single iterator. The keys are sought in sorted order, so
+// the seeks walk the LSM tree forward instead of each starting from scratch. The values are returned in the order of
+// keys, nil for the keys not found and empty for the keys found with an empty value.
+func MultiGetCFFromIterator(it *CFIterator, keys [][]byte) ([][]byte, error) {
+	order := make([]int, len(keys))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return bytes.Compare(keys[order[i]], keys[order[j]]) < 0
+	})
+	vals := make([][]byte, len(keys))
+	for _, i := range order {
+		it.Seek(keys[i])
+		if !it.Valid() || !bytes.Equal(it.Item().Key(), keys[i]) {
+			continue
+		}
+		val, err := it.Item().Value()
+		if err != nil {
+			return nil, err
+		}
+		// Not ValueCopy, which returns nil for an empty value.
+		vals[i] = append([]byte{}, val...)
+	}
+	return vals, nil
+}
+
+// deleteRangeBatchSize is the max number of keys deleted by one write, so that deleting a large range doesn't build
+// a transaction too big for badger.
+const deleteRangeBatchSize = 4096
+
 func DeleteRange(db *badger.DB, startKey, endKey []byte) error {
 	batch := new(WriteBatch)
 	txn := db.NewTransaction(false)
 	defer txn.Discard()
 	for _, cf := range CFs {
-		deleteRangeCF(txn, batch, cf, startKey, endKey)
+		if err := deleteRangeCF(db, txn, batch, cf, startKey, endKey); err != nil {
+			return err
+		}
 	}
 
 	return batch.WriteToDB(db)
 }
 
-func deleteRangeCF(txn *badger.Txn, batch *WriteBatch, cf string, startKey, endKey []byte) {
+func deleteRangeCF(db *badger.DB, txn *badger.Txn, batch *WriteBatch, cf string, startKey, endKey []byte) error {
 	it := NewCFIterator(cf, txn)
+	defer it.Close()
 	for it.Seek(startKey); it.Valid(); it.Next() {
 		item := it.Item()
 		key := item.KeyCopy(nil)
@@ -47,10 +82,17 @@ func deleteRangeCF(txn *badger.Txn, batch *WriteBatch, cf string, startKey, endK
 			break
 		}
 		batch.DeleteCF(cf, key)
+		if batch.Len() >= deleteRangeBatchSize {
+			if err := batch.WriteToDB(db); err != nil {
+				return err
+			}
+			batch.Reset()
+		}
 	}
-	defer it.Close()
+	return nil
 }
 
+// ExceedEndKey reports whether current is at or after endKey, an empty endKey means no upper bound.
 func ExceedEndKey(current, endKey []byte) bool {
-	return bytes.Compare(current, endKey) >= 0
+	return len(endKey) != 0 && bytes.Compare(current, endKey) >= 0
 }
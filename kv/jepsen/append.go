@@ -0,0 +1,169 @@
+package jepsen
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pingcap/errors"
+)
+
+// AppendWorkload appends elements to lists and checks that the reads of a list are consistent with a single order of
+// the appends.
+//
+// Every list has a single writer, which puts the whole list with the new element appended, so the lists written to
+// a key form a chain where each one extends the previous. When the outcome of an append is unknown, the writer moves
+// on to a new key, so the pending append can't be overwritten by a later one. The checker verifies that a read only
+// observes a list which was written, never goes back to a shorter list than a read or an acknowledged append before
+// it.
+type AppendWorkload struct {
+	mu      sync.Mutex
+	writers map[int]*appendWriter
+	keys    []string
+}
+
+type appendWriter struct {
+	gen  int
+	next int
+	list []int
+}
+
+type appendOp struct {
+	key  string
+	list []int
+}
+
+func NewAppendWorkload() *AppendWorkload {
+	return &AppendWorkload{writers: make(map[int]*appendWriter)}
+}
+
+func (w *AppendWorkload) Name() string { return "append" }
+
+func appendKey(process, gen int) string {
+	return fmt.Sprintf("append/%02d/%04d", process, gen)
+}
+
+func encodeList(list []int) []byte {
+	elems := make([]string, 0, len(list))
+	for _, e := range list {
+		elems = append(elems, strconv.Itoa(e))
+	}
+	return []byte(strings.Join(elems, ","))
+}
+
+func decodeList(val []byte) ([]int, error) {
+	var list []int
+	for _, s := range strings.Split(string(val), ",") {
+		e, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, e)
+	}
+	return list, nil
+}
+
+func (w *AppendWorkload) Setup(client *Client) {}
+
+func (w *AppendWorkload) Step(process int, client *Client, history *History, rnd *rand.Rand) {
+	w.mu.Lock()
+	writer := w.writers[process]
+	if writer == nil {
+		writer = &appendWriter{}
+		w.writers[process] = writer
+		w.keys = append(w.keys, appendKey(process, writer.gen))
+	}
+	if rnd.Intn(2) == 0 {
+		w.mu.Unlock()
+		w.append(process, writer, client, history)
+		return
+	}
+	key := w.keys[rnd.Intn(len(w.keys))]
+	w.mu.Unlock()
+
+	// A key doesn't exist until its first append is applied, the read fails then.
+	op := history.Invoke(process, "read", appendOp{key: key})
+	values, err := client.Get([][]byte{[]byte(key)})
+	if err != nil {
+		history.Complete(op, OpFail, nil)
+		return
+	}
+	list, err := decodeList(values[0])
+	if err != nil {
+		panic(err)
+	}
+	history.Complete(op, OpOK, appendOp{key: key, list: list})
+}
+
+func (w *AppendWorkload) append(process int, writer *appendWriter, client *Client, history *History) {
+	key := appendKey(process, writer.gen)
+	list := append(append([]int(nil), writer.list...), writer.next)
+	writer.next++
+
+	op := history.Invoke(process, "append", appendOp{key: key, list: list})
+	status := client.Put([][]byte{[]byte(key)}, [][]byte{encodeList(list)})
+	history.Complete(op, status, nil)
+	switch status {
+	case OpOK:
+		writer.list = list
+	case OpInfo:
+		writer.gen++
+		writer.list = nil
+		w.mu.Lock()
+		w.keys = append(w.keys, appendKey(process, writer.gen))
+		w.mu.Unlock()
+	}
+}
+
+func (w *AppendWorkload) Check(history *History) error {
+	appends := make(map[string][]*Op)
+	for _, op := range history.Ops("append") {
+		key := op.Value.(appendOp).key
+		appends[key] = append(appends[key], op)
+	}
+	reads := make(map[string][]*Op)
+	for _, op := range history.Ops("read") {
+		if op.Status == OpOK {
+			key := op.Value.(appendOp).key
+			reads[key] = append(reads[key], op)
+		}
+	}
+
+	for key, keyReads := range reads {
+		for _, read := range keyReads {
+			list := read.Value.(appendOp).list
+			written := false
+			for _, a := range appends[key] {
+				if a.Status != OpFail && a.Invoke < read.Complete && equalList(a.Value.(appendOp).list, list) {
+					written = true
+				}
+				if a.Status == OpOK && a.Precedes(read) && len(a.Value.(appendOp).list) > len(list) {
+					return errors.Errorf("read %v misses append %v completed before it", read, a)
+				}
+			}
+			if !written {
+				return errors.Errorf("read %v observes a list which is not written", read)
+			}
+			for _, prev := range keyReads {
+				if prev.Precedes(read) && len(prev.Value.(appendOp).list) > len(list) {
+					return errors.Errorf("read %v goes back from read %v", read, prev)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func equalList(l1, l2 []int) bool {
+	if len(l1) != len(l2) {
+		return false
+	}
+	for i := range l1 {
+		if l1[i] != l2[i] {
+			return false
+		}
+	}
+	return true
+}
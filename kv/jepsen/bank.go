@@ -0,0 +1,170 @@
+package jepsen
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"sync"
+
+	"github.com/pingcap/errors"
+)
+
+const bankInitialBalance = 100
+
+// BankWorkload moves money between accounts and checks that no money is created or destroyed.
+//
+// Every transfer has a slot of two keys, a debit of the source account and a credit of the destination account,
+// which are written together in one command. The keys of all the slots are written with 0 before the run, so the
+// balance of an account is its initial balance adjusted by the visible transfers. A read gets the slots of all the
+// transfers invoked so far in one command, and the checker verifies that every transfer is either fully visible or
+// not visible at all, that the total balance never changes, and that the reads observe the transfers in real-time
+// order.
+type BankWorkload struct {
+	Accounts     int
+	MaxTransfers int
+
+	mu        sync.Mutex
+	transfers []bankTransfer
+}
+
+type bankTransfer struct {
+	id       int
+	from, to int
+	amount   int64
+}
+
+type bankRead struct {
+	// values are the debits and credits of the first len(values)/2 transfers.
+	values [][]byte
+}
+
+func NewBankWorkload(accounts, maxTransfers int) *BankWorkload {
+	return &BankWorkload{Accounts: accounts, MaxTransfers: maxTransfers}
+}
+
+func (w *BankWorkload) Name() string { return "bank" }
+
+func bankDebitKey(id int) []byte {
+	return []byte(fmt.Sprintf("bank/%05d/debit", id))
+}
+
+func bankCreditKey(id int) []byte {
+	return []byte(fmt.Sprintf("bank/%05d/credit", id))
+}
+
+func (w *BankWorkload) Setup(client *Client) {
+	const batch = 64
+	for start := 0; start < w.MaxTransfers; start += batch {
+		var keys, values [][]byte
+		for id := start; id < start+batch && id < w.MaxTransfers; id++ {
+			keys = append(keys, bankDebitKey(id), bankCreditKey(id))
+			values = append(values, []byte("0"), []byte("0"))
+		}
+		client.MustPut(keys, values)
+	}
+}
+
+func (w *BankWorkload) Step(process int, client *Client, history *History, rnd *rand.Rand) {
+	w.mu.Lock()
+	if len(w.transfers) < w.MaxTransfers && rnd.Intn(2) == 0 {
+		t := bankTransfer{id: len(w.transfers), from: rnd.Intn(w.Accounts), to: rnd.Intn(w.Accounts)}
+		t.amount = 1 + rnd.Int63n(bankInitialBalance/10)
+		w.transfers = append(w.transfers, t)
+		w.mu.Unlock()
+
+		amount := []byte(strconv.FormatInt(t.amount, 10))
+		op := history.Invoke(process, "transfer", t)
+		status := client.Put([][]byte{bankDebitKey(t.id), bankCreditKey(t.id)}, [][]byte{amount, amount})
+		history.Complete(op, status, nil)
+		return
+	}
+	w.mu.Unlock()
+
+	// The read is invoked before the transfers are counted, so it covers every transfer completed before it.
+	op := history.Invoke(process, "read", nil)
+	w.mu.Lock()
+	n := len(w.transfers)
+	w.mu.Unlock()
+	if n == 0 {
+		history.Complete(op, OpFail, nil)
+		return
+	}
+	keys := make([][]byte, 0, 2*n)
+	for id := 0; id < n; id++ {
+		keys = append(keys, bankDebitKey(id), bankCreditKey(id))
+	}
+	values, err := client.Get(keys)
+	if err != nil {
+		history.Complete(op, OpFail, nil)
+		return
+	}
+	history.Complete(op, OpOK, bankRead{values: values})
+}
+
+func (w *BankWorkload) Check(history *History) error {
+	transfers := make(map[int]*Op)
+	for _, op := range history.Ops("transfer") {
+		transfers[op.Value.(bankTransfer).id] = op
+	}
+	var reads []*Op
+	for _, op := range history.Ops("read") {
+		if op.Status == OpOK {
+			reads = append(reads, op)
+		}
+	}
+
+	// visibleAt is the earliest completion of the reads which observe the transfer.
+	visibleAt := make(map[int]int64)
+	visible := make([][]bool, len(reads))
+	for i, read := range reads {
+		values := read.Value.(bankRead).values
+		visible[i] = make([]bool, len(values)/2)
+		balances := make([]int64, w.Accounts)
+		for a := range balances {
+			balances[a] = bankInitialBalance
+		}
+		for id := range visible[i] {
+			op := transfers[id]
+			t := op.Value.(bankTransfer)
+			amount := strconv.FormatInt(t.amount, 10)
+			debit, credit := string(values[2*id]), string(values[2*id+1])
+			if (debit != "0" && debit != amount) || (credit != "0" && credit != amount) {
+				return errors.Errorf("read %v observes unexpected values %q, %q of transfer %v", read, debit, credit, op)
+			}
+			if (debit == "0") != (credit == "0") {
+				return errors.Errorf("read %v observes transfer %v partially", read, op)
+			}
+			if debit == "0" {
+				if op.Status == OpOK && op.Precedes(read) {
+					return errors.Errorf("read %v misses transfer %v completed before it", read, op)
+				}
+				continue
+			}
+			if op.Status == OpFail {
+				return errors.Errorf("read %v observes failed transfer %v", read, op)
+			}
+			visible[i][id] = true
+			if at, ok := visibleAt[id]; !ok || read.Complete < at {
+				visibleAt[id] = read.Complete
+			}
+			balances[t.from] -= t.amount
+			balances[t.to] += t.amount
+		}
+		var total int64
+		for _, b := range balances {
+			total += b
+		}
+		if total != int64(w.Accounts)*bankInitialBalance {
+			return errors.Errorf("read %v observes total balance %d, balances %v", read, total, balances)
+		}
+	}
+
+	for i, read := range reads {
+		for id, ok := range visible[i] {
+			if at, seen := visibleAt[id]; !ok && seen && at < read.Invoke {
+				return errors.Errorf("read %v misses transfer %v observed by an earlier read", read, transfers[id])
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package jepsen
+
+import (
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/test_raftstore"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/pingcap/errors"
+)
+
+// Client sends raft commands to the cluster. Each command is sent once, the retries are left to the workloads, which
+// need to know whether a write may have taken effect.
+type Client struct {
+	cluster *test_raftstore.Cluster
+	timeout time.Duration
+}
+
+func NewClient(cluster *test_raftstore.Cluster, timeout time.Duration) *Client {
+	return &Client{cluster: cluster, timeout: timeout}
+}
+
+func (c *Client) call(key []byte, reqs []*raft_cmdpb.Request) (*raft_cmdpb.RaftCmdResponse, error) {
+	region := c.cluster.GetRegion(key)
+	request := &raft_cmdpb.RaftCmdRequest{
+		Header: &raft_cmdpb.RaftRequestHeader{
+			RegionId:    region.GetId(),
+			RegionEpoch: region.GetRegionEpoch(),
+		},
+		Requests: reqs,
+	}
+	resp, err := c.cluster.CallCommandOnLeader(request, c.timeout)
+	if err != nil || resp.GetHeader().GetError() != nil {
+		// Back off, so that the clients don't spin on a store which is stopped.
+		time.Sleep(20 * time.Millisecond)
+	}
+	return resp, err
+}
+
+// Get reads the keys in one command, so the values are taken from the same snapshot of the region. All the keys must
+// exist and belong to the same region.
+func (c *Client) Get(keys [][]byte) ([][]byte, error) {
+	reqs := make([]*raft_cmdpb.Request, 0, len(keys))
+	for _, key := range keys {
+		reqs = append(reqs, &raft_cmdpb.Request{
+			CmdType: raft_cmdpb.CmdType_Get,
+			Get:     &raft_cmdpb.GetRequest{Cf: engine_util.CF_DEFAULT, Key: key},
+		})
+	}
+	resp, err := c.call(keys[0], reqs)
+	if err != nil {
+		return nil, err
+	}
+	if respErr := resp.GetHeader().GetError(); respErr != nil {
+		return nil, errors.New(respErr.String())
+	}
+	if len(resp.Responses) != len(keys) {
+		return nil, errors.Errorf("expect %d responses, got %d", len(keys), len(resp.Responses))
+	}
+	values := make([][]byte, 0, len(keys))
+	for _, r := range resp.Responses {
+		values = append(values, r.GetGet().GetValue())
+	}
+	return values, nil
+}
+
+// Put writes the pairs in one command, so either all of them or none of them take effect. It returns OpFail only if
+// the command is known not to be applied.
+func (c *Client) Put(keys, values [][]byte) OpStatus {
+	reqs := make([]*raft_cmdpb.Request, 0, len(keys))
+	for i, key := range keys {
+		reqs = append(reqs, &raft_cmdpb.Request{
+			CmdType: raft_cmdpb.CmdType_Put,
+			Put:     &raft_cmdpb.PutRequest{Cf: engine_util.CF_DEFAULT, Key: key, Value: values[i]},
+		})
+	}
+	resp, err := c.call(keys[0], reqs)
+	if err != nil {
+		return OpInfo
+	}
+	if respErr := resp.GetHeader().GetError(); respErr != nil {
+		if isDefiniteFailure(respErr) {
+			return OpFail
+		}
+		return OpInfo
+	}
+	return OpOK
+}
+
+// MustPut retries the put until it succeeds, it's used to set up the data before a run.
+func (c *Client) MustPut(keys, values [][]byte) {
+	start := time.Now()
+	for c.Put(keys, values) != OpOK {
+		if time.Since(start) > 10*c.timeout {
+			panic("put timeout")
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+// isDefiniteFailure returns true if the error is returned before the command is proposed or when it's applied, so
+// the command doesn't take effect. A stale command may still be committed by the new leader of the region.
+func isDefiniteFailure(err *errorpb.Error) bool {
+	return err.NotLeader != nil || err.RegionNotFound != nil || err.KeyNotInRegion != nil ||
+		err.EpochNotMatch != nil || err.ServerIsBusy != nil || err.StoreNotMatch != nil ||
+		err.RaftEntryTooLarge != nil
+}
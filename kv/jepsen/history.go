@@ -0,0 +1,139 @@
+// Package jepsen runs Jepsen-style correctness workloads against the in-process test cluster of test_raftstore.
+//
+// A workload is driven by several concurrent client processes while a nemesis injects faults into the cluster:
+// network partitions, an isolated leader, dropped messages and restarted stores. Every operation is recorded in a
+// History together with its outcome, and the workload checks its invariants against the whole history once the run
+// is over.
+//
+// An operation which times out may still take effect at any later time, so its outcome is indeterminate. The
+// workloads are designed so that every write is idempotent and atomic on its own, which lets the checkers treat
+// indeterminate writes as either applied or not, without tracking when they could have been applied.
+//
+// The tests are behind the jepsen build tag:
+//
+//	go test -tags jepsen ./kv/jepsen/ -jepsen.duration 30s
+package jepsen
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// OpStatus is the outcome of an operation.
+type OpStatus int
+
+const (
+	// OpPending means the operation hasn't completed yet.
+	OpPending OpStatus = iota
+	// OpOK means the operation took effect.
+	OpOK
+	// OpFail means the operation definitely didn't take effect.
+	OpFail
+	// OpInfo means the outcome is unknown, the operation may take effect at any time.
+	OpInfo
+)
+
+func (s OpStatus) String() string {
+	switch s {
+	case OpOK:
+		return "ok"
+	case OpFail:
+		return "fail"
+	case OpInfo:
+		return "info"
+	default:
+		return "pending"
+	}
+}
+
+// Op is an operation of a client process. Invoke and Complete are taken from the logical clock of the history, so
+// op1 precedes op2 in real time iff op1.Complete < op2.Invoke.
+type Op struct {
+	Process  int
+	Kind     string
+	Invoke   int64
+	Complete int64
+	Status   OpStatus
+	// Value is the input of the operation when it's invoked and may be replaced by its output on completion.
+	Value interface{}
+}
+
+func (op *Op) String() string {
+	return fmt.Sprintf("{process: %d, kind: %s, invoke: %d, complete: %d, status: %v, value: %v}",
+		op.Process, op.Kind, op.Invoke, op.Complete, op.Status, op.Value)
+}
+
+// Precedes returns true if op completes before other is invoked.
+func (op *Op) Precedes(other *Op) bool {
+	return op.Status != OpPending && op.Complete < other.Invoke
+}
+
+// History records the operations of all the client processes of a run.
+type History struct {
+	clock int64
+
+	mu  sync.Mutex
+	ops []*Op
+}
+
+func NewHistory() *History {
+	return &History{}
+}
+
+func (h *History) tick() int64 {
+	return atomic.AddInt64(&h.clock, 1)
+}
+
+// Invoke records the start of an operation.
+func (h *History) Invoke(process int, kind string, value interface{}) *Op {
+	op := &Op{Process: process, Kind: kind, Value: value}
+	h.mu.Lock()
+	op.Invoke = h.tick()
+	h.ops = append(h.ops, op)
+	h.mu.Unlock()
+	return op
+}
+
+// Complete records the outcome of an operation, a nil value keeps the value the operation was invoked with.
+func (h *History) Complete(op *Op, status OpStatus, value interface{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	op.Complete = h.tick()
+	op.Status = status
+	if value != nil {
+		op.Value = value
+	}
+}
+
+// Ops returns the operations of the given kind in the order they were invoked, an empty kind returns all of them.
+func (h *History) Ops(kind string) []*Op {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	ops := make([]*Op, 0, len(h.ops))
+	for _, op := range h.ops {
+		if kind == "" || op.Kind == kind {
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
+
+// Summary counts the operations of each kind by their outcomes.
+func (h *History) Summary() string {
+	counts := make(map[string]map[OpStatus]int)
+	var kinds []string
+	for _, op := range h.Ops("") {
+		if counts[op.Kind] == nil {
+			counts[op.Kind] = make(map[OpStatus]int)
+			kinds = append(kinds, op.Kind)
+		}
+		counts[op.Kind][op.Status]++
+	}
+	s := ""
+	for _, kind := range kinds {
+		c := counts[kind]
+		s += fmt.Sprintf("%s: ok %d, fail %d, info %d; ", kind, c[OpOK], c[OpFail], c[OpInfo])
+	}
+	return s
+}
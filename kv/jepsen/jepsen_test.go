@@ -0,0 +1,40 @@
+//go:build jepsen
+// +build jepsen
+
+package jepsen
+
+import (
+	"flag"
+	"testing"
+	"time"
+)
+
+var (
+	duration = flag.Duration("jepsen.duration", 10*time.Second, "how long the nemesis is active in each workload")
+	seed     = flag.Int64("jepsen.seed", 0, "the seed of the run, 0 picks one from the current time")
+)
+
+func runWorkload(t *testing.T, workload Workload) {
+	opts := DefaultOptions()
+	opts.Duration = *duration
+	if *seed != 0 {
+		opts.Seed = *seed
+	}
+	history, err := Run(workload, opts)
+	if err != nil {
+		t.Fatalf("seed %d: %v", opts.Seed, err)
+	}
+	t.Log(history.Summary())
+}
+
+func TestBank(t *testing.T) {
+	runWorkload(t, NewBankWorkload(5, 500))
+}
+
+func TestLongFork(t *testing.T) {
+	runWorkload(t, NewLongForkWorkload(100, 4))
+}
+
+func TestAppend(t *testing.T) {
+	runWorkload(t, NewAppendWorkload())
+}
@@ -0,0 +1,147 @@
+package jepsen
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+
+	"github.com/pingcap/errors"
+)
+
+// LongForkWorkload checks that the reads observe the writes in a single order.
+//
+// The keys are divided into groups and every key is written only once, from 0 to 1. A read gets all the keys of a
+// group in one command. If two reads of a group observe different sets of writes, one set must contain the other,
+// otherwise the two reads see the writes in different orders, which is a long fork.
+type LongForkWorkload struct {
+	Groups    int
+	GroupSize int
+
+	mu   sync.Mutex
+	next int
+}
+
+type longForkRead struct {
+	group   int
+	written []bool
+}
+
+func NewLongForkWorkload(groups, groupSize int) *LongForkWorkload {
+	return &LongForkWorkload{Groups: groups, GroupSize: groupSize}
+}
+
+func (w *LongForkWorkload) Name() string { return "long-fork" }
+
+func (w *LongForkWorkload) key(index int) []byte {
+	return []byte(fmt.Sprintf("long-fork/%04d/%02d", index/w.GroupSize, index%w.GroupSize))
+}
+
+func (w *LongForkWorkload) Setup(client *Client) {
+	for g := 0; g < w.Groups; g++ {
+		var keys, values [][]byte
+		for i := g * w.GroupSize; i < (g+1)*w.GroupSize; i++ {
+			keys = append(keys, w.key(i))
+			values = append(values, []byte("0"))
+		}
+		client.MustPut(keys, values)
+	}
+}
+
+func (w *LongForkWorkload) Step(process int, client *Client, history *History, rnd *rand.Rand) {
+	w.mu.Lock()
+	next := w.next
+	if next < w.Groups*w.GroupSize && rnd.Intn(2) == 0 {
+		w.next++
+		w.mu.Unlock()
+
+		op := history.Invoke(process, "write", next)
+		history.Complete(op, client.Put([][]byte{w.key(next)}, [][]byte{[]byte("1")}), nil)
+		return
+	}
+	w.mu.Unlock()
+
+	group := rnd.Intn(next/w.GroupSize + 1)
+	if group >= w.Groups {
+		group = w.Groups - 1
+	}
+	op := history.Invoke(process, "read", longForkRead{group: group})
+	keys := make([][]byte, 0, w.GroupSize)
+	for i := group * w.GroupSize; i < (group+1)*w.GroupSize; i++ {
+		keys = append(keys, w.key(i))
+	}
+	values, err := client.Get(keys)
+	if err != nil {
+		history.Complete(op, OpFail, nil)
+		return
+	}
+	written := make([]bool, len(values))
+	for i, v := range values {
+		written[i] = string(v) == "1"
+	}
+	history.Complete(op, OpOK, longForkRead{group: group, written: written})
+}
+
+func (w *LongForkWorkload) Check(history *History) error {
+	writes := make(map[int]*Op)
+	for _, op := range history.Ops("write") {
+		writes[op.Value.(int)] = op
+	}
+	readsOfGroup := make(map[int][]*Op)
+	// visibleAt is the earliest completion of the reads which observe the write.
+	visibleAt := make(map[int]int64)
+	for _, read := range history.Ops("read") {
+		if read.Status != OpOK {
+			continue
+		}
+		r := read.Value.(longForkRead)
+		readsOfGroup[r.group] = append(readsOfGroup[r.group], read)
+		for i, written := range r.written {
+			index := r.group*w.GroupSize + i
+			write, invoked := writes[index]
+			if !written {
+				if invoked && write.Status == OpOK && write.Precedes(read) {
+					return errors.Errorf("read %v misses write %v completed before it", read, write)
+				}
+				continue
+			}
+			if !invoked || write.Status == OpFail || read.Complete < write.Invoke {
+				return errors.Errorf("read %v observes key %d which is not written", read, index)
+			}
+			if at, ok := visibleAt[index]; !ok || read.Complete < at {
+				visibleAt[index] = read.Complete
+			}
+		}
+	}
+
+	for _, reads := range readsOfGroup {
+		for i, r1 := range reads {
+			w1 := r1.Value.(longForkRead).written
+			for j, written := range w1 {
+				index := r1.Value.(longForkRead).group*w.GroupSize + j
+				if at, ok := visibleAt[index]; !written && ok && at < r1.Invoke {
+					return errors.Errorf("read %v misses key %d observed by an earlier read", r1, index)
+				}
+			}
+			for _, r2 := range reads[i+1:] {
+				if !ordered(w1, r2.Value.(longForkRead).written) {
+					return errors.Errorf("long fork between read %v and read %v", r1, r2)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ordered returns true if one of the sets of writes contains the other.
+func ordered(w1, w2 []bool) bool {
+	less, greater := false, false
+	for i := range w1 {
+		if w1[i] && !w2[i] {
+			greater = true
+		}
+		if !w1[i] && w2[i] {
+			less = true
+		}
+	}
+	return !(less && greater)
+}
@@ -0,0 +1,109 @@
+package jepsen
+
+import (
+	"math/rand"
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/kv/test_raftstore"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+)
+
+// Nemesis injects a fault into the cluster and recovers from it. Only one nemesis is active at a time.
+type Nemesis interface {
+	Name() string
+	Invoke(cluster *test_raftstore.Cluster, regionID uint64, rnd *rand.Rand)
+	Recover(cluster *test_raftstore.Cluster)
+}
+
+// PartitionNemesis splits the stores into a majority and a minority which can't talk to each other.
+type PartitionNemesis struct{}
+
+func (PartitionNemesis) Name() string { return "partition" }
+
+func (PartitionNemesis) Invoke(cluster *test_raftstore.Cluster, regionID uint64, rnd *rand.Rand) {
+	stores := cluster.GetStoreIDs()
+	rnd.Shuffle(len(stores), func(i, j int) { stores[i], stores[j] = stores[j], stores[i] })
+	minority := 1 + rnd.Intn((len(stores)-1)/2)
+	cluster.AddFilter(test_raftstore.NewPartitionFilter(stores[:minority], stores[minority:]))
+}
+
+func (PartitionNemesis) Recover(cluster *test_raftstore.Cluster) {
+	cluster.ClearFilters()
+}
+
+// LeaderIsolationNemesis cuts the leader of the region off from the other stores, the clients may keep sending their
+// commands to it until they find the new leader.
+type LeaderIsolationNemesis struct{}
+
+func (LeaderIsolationNemesis) Name() string { return "isolate-leader" }
+
+func (LeaderIsolationNemesis) Invoke(cluster *test_raftstore.Cluster, regionID uint64, rnd *rand.Rand) {
+	leader := cluster.GetLeader(regionID)
+	if leader == nil {
+		return
+	}
+	var others []uint64
+	for _, storeID := range cluster.GetStoreIDs() {
+		if storeID != leader.GetStoreId() {
+			others = append(others, storeID)
+		}
+	}
+	cluster.AddFilter(test_raftstore.NewPartitionFilter([]uint64{leader.GetStoreId()}, others))
+}
+
+func (LeaderIsolationNemesis) Recover(cluster *test_raftstore.Cluster) {
+	cluster.ClearFilters()
+}
+
+// DropNemesis drops a fraction of the raft messages at random.
+type DropNemesis struct {
+	Rate float64
+}
+
+func (n DropNemesis) Name() string { return "drop" }
+
+func (n DropNemesis) Invoke(cluster *test_raftstore.Cluster, regionID uint64, rnd *rand.Rand) {
+	cluster.AddFilter(&randomDropFilter{rate: n.Rate, rnd: rand.New(rand.NewSource(rnd.Int63()))})
+}
+
+func (n DropNemesis) Recover(cluster *test_raftstore.Cluster) {
+	cluster.ClearFilters()
+}
+
+type randomDropFilter struct {
+	rate float64
+
+	mu  sync.Mutex
+	rnd *rand.Rand
+}
+
+func (f *randomDropFilter) Before(msg *rspb.RaftMessage) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.rnd.Float64() >= f.rate
+}
+
+// RestartNemesis stops a random store and starts it again with its data on recovery.
+type RestartNemesis struct {
+	stopped uint64
+}
+
+func (n *RestartNemesis) Name() string { return "restart" }
+
+func (n *RestartNemesis) Invoke(cluster *test_raftstore.Cluster, regionID uint64, rnd *rand.Rand) {
+	stores := cluster.GetStoreIDs()
+	n.stopped = stores[rnd.Intn(len(stores))]
+	cluster.StopServer(n.stopped)
+}
+
+func (n *RestartNemesis) Recover(cluster *test_raftstore.Cluster) {
+	if n.stopped != 0 {
+		cluster.StartServer(n.stopped)
+		n.stopped = 0
+	}
+}
+
+// AllNemeses returns one nemesis of each kind.
+func AllNemeses() []Nemesis {
+	return []Nemesis{PartitionNemesis{}, LeaderIsolationNemesis{}, DropNemesis{Rate: 0.3}, &RestartNemesis{}}
+}
@@ -0,0 +1,110 @@
+package jepsen
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/ngaut/log"
+	"github.com/pingcap-incubator/tinykv/kv/test_raftstore"
+)
+
+// Workload generates the operations of the client processes and checks the history they produce.
+type Workload interface {
+	Name() string
+	// Setup writes the initial data, it runs before the clients and the nemesis are started.
+	Setup(client *Client)
+	// Step runs one operation of the process and records it in the history.
+	Step(process int, client *Client, history *History, rnd *rand.Rand)
+	// Check verifies the invariants of the workload against the history of a run.
+	Check(history *History) error
+}
+
+// Options configures a run of a workload.
+type Options struct {
+	Stores  int
+	Clients int
+	// Duration is how long the nemesis is active. The clients keep running for another NemesisInterval after it,
+	// so that the operations on the healed cluster are checked too.
+	Duration time.Duration
+	// NemesisInterval is how long a fault lasts, and how long the cluster runs healthy between two faults.
+	NemesisInterval time.Duration
+	Nemeses         []Nemesis
+	ClientTimeout   time.Duration
+	Seed            int64
+}
+
+func DefaultOptions() Options {
+	return Options{
+		Stores:          5,
+		Clients:         5,
+		Duration:        10 * time.Second,
+		NemesisInterval: time.Second,
+		Nemeses:         AllNemeses(),
+		ClientTimeout:   time.Second,
+		Seed:            time.Now().UnixNano(),
+	}
+}
+
+// Run starts a cluster with the region replicated to all the stores, runs the workload on it with the nemesis and
+// checks the history.
+func Run(workload Workload, opts Options) (*History, error) {
+	cluster := test_raftstore.NewCluster(opts.Stores, test_raftstore.NewTestConfig())
+	cluster.Start()
+	defer cluster.Shutdown()
+
+	region := cluster.GetRegion([]byte(""))
+	for _, storeID := range cluster.GetStoreIDs() {
+		if storeID != region.GetPeers()[0].GetStoreId() {
+			cluster.MustAddPeer(region.GetId(), cluster.AllocPeer(storeID))
+		}
+	}
+	client := NewClient(cluster, opts.ClientTimeout)
+	workload.Setup(client)
+	log.Infof("run %s with seed %d", workload.Name(), opts.Seed)
+
+	history := NewHistory()
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < opts.Clients; i++ {
+		wg.Add(1)
+		go func(process int) {
+			defer wg.Done()
+			rnd := rand.New(rand.NewSource(opts.Seed + int64(process)))
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				workload.Step(process, client, history, rnd)
+			}
+		}(i)
+	}
+
+	runNemesis(cluster, region.GetId(), opts)
+	time.Sleep(opts.NemesisInterval)
+	close(stop)
+	wg.Wait()
+	log.Infof("%s finished, %s", workload.Name(), history.Summary())
+	return history, workload.Check(history)
+}
+
+// runNemesis alternates between injecting a random fault and leaving the cluster healthy until the duration is
+// reached. The cluster is always healed when it returns.
+func runNemesis(cluster *test_raftstore.Cluster, regionID uint64, opts Options) {
+	rnd := rand.New(rand.NewSource(opts.Seed - 1))
+	deadline := time.Now().Add(opts.Duration)
+	for time.Now().Before(deadline) {
+		time.Sleep(opts.NemesisInterval)
+		if len(opts.Nemeses) == 0 || !time.Now().Before(deadline) {
+			continue
+		}
+		nemesis := opts.Nemeses[rnd.Intn(len(opts.Nemeses))]
+		log.Infof("nemesis %s start", nemesis.Name())
+		nemesis.Invoke(cluster, regionID, rnd)
+		time.Sleep(opts.NemesisInterval)
+		nemesis.Recover(cluster)
+		log.Infof("nemesis %s recover", nemesis.Name())
+	}
+}
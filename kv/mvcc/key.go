@@ -0,0 +1,68 @@
+// Package mvcc defines how transactional data is laid out in the underlying key/value store. The encodings are
+// compatible with TiKV and are meant to be shared by the storage layer and by external tools (backup, ctl, checkers),
+// so any change here is a change of the on-disk format.
+package mvcc
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/util/codec"
+)
+
+// tsLen is the length of an encoded timestamp.
+const tsLen = 8
+
+// EncodeKey encodes a user key with a timestamp. The user key is memcomparable encoded and the timestamp is appended
+// in descending order, so that newer versions of a key sort before older ones.
+func EncodeKey(key []byte, ts uint64) []byte {
+	return codec.EncodeUintDesc(codec.EncodeBytes(nil, key), ts)
+}
+
+// DecodeKey decodes a key encoded by EncodeKey to the user key and the timestamp.
+func DecodeKey(encodedKey []byte) ([]byte, uint64, error) {
+	if len(encodedKey) < tsLen {
+		return nil, 0, errors.Errorf("invalid encoded key %v", encodedKey)
+	}
+	remain, key, err := codec.DecodeBytes(encodedKey, nil)
+	if err != nil {
+		return nil, 0, errors.Trace(err)
+	}
+	if len(remain) != tsLen {
+		return nil, 0, errors.Errorf("invalid encoded key %v", encodedKey)
+	}
+	ts, err := DecodeTs(remain)
+	if err != nil {
+		return nil, 0, err
+	}
+	return key, ts, nil
+}
+
+// EncodeLockKey encodes a user key for the lock column family. A key has at most one lock, so no timestamp is appended.
+func EncodeLockKey(key []byte) []byte {
+	return codec.EncodeBytes(nil, key)
+}
+
+// DecodeLockKey decodes a key encoded by EncodeLockKey to the user key.
+func DecodeLockKey(encodedKey []byte) ([]byte, error) {
+	remain, key, err := codec.DecodeBytes(encodedKey, nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(remain) != 0 {
+		return nil, errors.Errorf("invalid encoded lock key %v", encodedKey)
+	}
+	return key, nil
+}
+
+// EncodeTs encodes a timestamp in descending order.
+func EncodeTs(ts uint64) []byte {
+	return codec.EncodeUintDesc(nil, ts)
+}
+
+// DecodeTs decodes a timestamp encoded by EncodeTs.
+func DecodeTs(encodedTs []byte) (uint64, error) {
+	if len(encodedTs) != tsLen {
+		return 0, errors.Errorf("invalid encoded ts %v", encodedTs)
+	}
+	_, ts, err := codec.DecodeUintDesc(encodedTs)
+	return ts, errors.Trace(err)
+}
@@ -0,0 +1,81 @@
+package mvcc
+
+import (
+	"math"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/util/codec"
+)
+
+// LockType is the type of a lock in the lock column family.
+type LockType byte
+
+const (
+	LockTypePut         LockType = 'P'
+	LockTypeDelete      LockType = 'D'
+	LockTypeLock        LockType = 'L'
+	LockTypePessimistic LockType = 'S'
+)
+
+// physicalShiftBits is the number of bits of the logical part of a timestamp, the rest is the physical time in
+// milliseconds.
+const physicalShiftBits = 18
+
+// ComposeTS composes a timestamp from its physical and logical parts, as allocated by the scheduler.
+func ComposeTS(physical, logical int64) uint64 {
+	return uint64(physical)<<physicalShiftBits + uint64(logical)
+}
+
+// MaxShortValueLen is the maximum length of a value which can be stored inline in a lock or a write record.
+const MaxShortValueLen = math.MaxUint8
+
+// Lock is a lock of a key, it is stored in the lock column family under the user key encoded without ts.
+type Lock struct {
+	Type       LockType
+	Primary    []byte
+	StartTS    uint64
+	TTL        uint64
+	ShortValue []byte
+}
+
+// EncodeLockCFValue encodes a lock. The short value must be no longer than MaxShortValueLen.
+func EncodeLockCFValue(lock *Lock) []byte {
+	data := codec.EncodeCompactBytes([]byte{byte(lock.Type)}, lock.Primary)
+	data = codec.EncodeUvarint(data, lock.StartTS)
+	data = codec.EncodeUvarint(data, lock.TTL)
+	return appendShortValue(data, lock.ShortValue)
+}
+
+// IsExpired returns whether the TTL of the lock, in milliseconds since the physical time of its start ts, has passed
+// at currentTS.
+func (l *Lock) IsExpired(currentTS uint64) bool {
+	return currentTS>>physicalShiftBits >= l.StartTS>>physicalShiftBits+l.TTL
+}
+
+// DecodeLock decodes a lock encoded by EncodeLockCFValue.
+func DecodeLock(data []byte) (*Lock, error) {
+	if len(data) == 0 {
+		return nil, errors.New("invalid lock: empty")
+	}
+	lock := &Lock{Type: LockType(data[0])}
+	switch lock.Type {
+	case LockTypePut, LockTypeDelete, LockTypeLock, LockTypePessimistic:
+	default:
+		return nil, errors.Errorf("invalid lock type %v", data[0])
+	}
+	data, primary, err := codec.DecodeCompactBytes(data[1:])
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	lock.Primary = primary
+	if data, lock.StartTS, err = codec.DecodeUvarint(data); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if data, lock.TTL, err = codec.DecodeUvarint(data); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if lock.ShortValue, err = decodeShortValue(data); err != nil {
+		return nil, err
+	}
+	return lock, nil
+}
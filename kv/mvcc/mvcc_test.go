@@ -0,0 +1,121 @@
+package mvcc
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeKeyOrder(t *testing.T) {
+	// Versions of the same key are ordered from the newest to the oldest, and all versions of a key sort before the
+	// versions of a larger key.
+	keys := [][]byte{
+		EncodeKey([]byte("a"), 20),
+		EncodeKey([]byte("a"), 10),
+		EncodeKey([]byte("a\x00"), 30),
+		EncodeKey([]byte("b"), 40),
+	}
+	for i := 1; i < len(keys); i++ {
+		assert.True(t, bytes.Compare(keys[i-1], keys[i]) < 0)
+	}
+
+	key, ts, err := DecodeKey(keys[2])
+	require.Nil(t, err)
+	assert.Equal(t, []byte("a\x00"), key)
+	assert.Equal(t, uint64(30), ts)
+
+	_, _, err = DecodeKey([]byte("a"))
+	assert.NotNil(t, err)
+}
+
+func TestWriteCFValue(t *testing.T) {
+	w, err := DecodeWriteCFValue(EncodeWriteCFValue(WriteTypePut, 42, []byte("value")))
+	require.Nil(t, err)
+	assert.Equal(t, &Write{Type: WriteTypePut, StartTS: 42, ShortValue: []byte("value")}, w)
+
+	w, err = DecodeWriteCFValue(EncodeWriteCFValue(WriteTypeRollback, 7, nil))
+	require.Nil(t, err)
+	assert.Equal(t, &Write{Type: WriteTypeRollback, StartTS: 7}, w)
+
+	hinted := &Write{Type: WriteTypeLock, StartTS: 50, LastChangeTS: 30, VersionsToLastChange: 12}
+	w, err = DecodeWriteCFValue(EncodeWrite(hinted))
+	require.Nil(t, err)
+	assert.Equal(t, hinted, w)
+
+	_, err = DecodeWriteCFValue([]byte{'X', 1})
+	assert.NotNil(t, err)
+}
+
+func TestLockCFValue(t *testing.T) {
+	lock := &Lock{Type: LockTypePut, Primary: []byte("pk"), StartTS: 100, TTL: 3000, ShortValue: []byte("v")}
+	decoded, err := DecodeLock(EncodeLockCFValue(lock))
+	require.Nil(t, err)
+	assert.Equal(t, lock, decoded)
+
+	_, err = DecodeLock(EncodeLockCFValue(lock)[:3])
+	assert.NotNil(t, err)
+}
+
+func TestLockIsExpired(t *testing.T) {
+	lock := &Lock{Type: LockTypePut, StartTS: 100 << physicalShiftBits, TTL: 3000}
+	assert.False(t, lock.IsExpired(100<<physicalShiftBits))
+	assert.False(t, lock.IsExpired(3099<<physicalShiftBits|1))
+	assert.True(t, lock.IsExpired(3100<<physicalShiftBits))
+}
+
+func FuzzDecodeKey(f *testing.F) {
+	f.Add([]byte("key"), uint64(1))
+	f.Add([]byte{}, uint64(0))
+	f.Add([]byte("\x00\x00\x00\x00\x00\x00\x00\x00\xff"), ^uint64(0))
+	f.Fuzz(func(t *testing.T, key []byte, ts uint64) {
+		decodedKey, decodedTs, err := DecodeKey(EncodeKey(key, ts))
+		require.Nil(t, err)
+		assert.True(t, bytes.Equal(key, decodedKey))
+		assert.Equal(t, ts, decodedTs)
+
+		// Arbitrary input must not panic.
+		DecodeKey(key)
+	})
+}
+
+func FuzzWriteCFValue(f *testing.F) {
+	f.Add(byte(WriteTypePut), uint64(1), []byte("value"))
+	f.Add(byte(WriteTypeDelete), uint64(0), []byte{})
+	f.Fuzz(func(t *testing.T, tp byte, startTS uint64, shortValue []byte) {
+		if len(shortValue) > MaxShortValueLen {
+			shortValue = shortValue[:MaxShortValueLen]
+		}
+		data := EncodeWriteCFValue(WriteType(tp), startTS, shortValue)
+		w, err := DecodeWriteCFValue(data)
+		if err != nil {
+			return
+		}
+		assert.Equal(t, WriteType(tp), w.Type)
+		assert.Equal(t, startTS, w.StartTS)
+		assert.True(t, bytes.Equal(shortValue, w.ShortValue))
+		assert.Equal(t, data, EncodeWriteCFValue(w.Type, w.StartTS, w.ShortValue))
+	})
+}
+
+func FuzzLockCFValue(f *testing.F) {
+	f.Add(byte(LockTypePut), []byte("pk"), uint64(1), uint64(3000), []byte("value"))
+	f.Add(byte(LockTypePessimistic), []byte{}, uint64(0), uint64(0), []byte{})
+	f.Fuzz(func(t *testing.T, tp byte, primary []byte, startTS, ttl uint64, shortValue []byte) {
+		if len(shortValue) > MaxShortValueLen {
+			shortValue = shortValue[:MaxShortValueLen]
+		}
+		data := EncodeLockCFValue(&Lock{LockType(tp), primary, startTS, ttl, shortValue})
+		lock, err := DecodeLock(data)
+		if err != nil {
+			return
+		}
+		assert.Equal(t, LockType(tp), lock.Type)
+		assert.True(t, bytes.Equal(primary, lock.Primary))
+		assert.Equal(t, startTS, lock.StartTS)
+		assert.Equal(t, ttl, lock.TTL)
+		assert.True(t, bytes.Equal(shortValue, lock.ShortValue))
+		assert.Equal(t, data, EncodeLockCFValue(lock))
+	})
+}
@@ -0,0 +1,101 @@
+package mvcc
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/util/codec"
+)
+
+// WriteType is the type of a write record in the write column family.
+type WriteType byte
+
+const (
+	WriteTypePut      WriteType = 'P'
+	WriteTypeDelete   WriteType = 'D'
+	WriteTypeLock     WriteType = 'L'
+	WriteTypeRollback WriteType = 'R'
+)
+
+// shortValuePrefix marks a value which is small enough to be stored inline in a write record or a lock instead of in
+// the default column family.
+const shortValuePrefix = 'v'
+
+// lastChangePrefix marks the last change hint of a write record, it comes before the short value.
+const lastChangePrefix = 'l'
+
+// Write is a write record, it is stored in the write column family under the key encoded with the commit ts.
+type Write struct {
+	Type       WriteType
+	StartTS    uint64
+	ShortValue []byte
+	// LastChangeTS is only set on lock and rollback records, which don't change the value of the key. It's the
+	// commit ts of the newest put or delete below the record, so a read of a key with a long run of such records can
+	// seek to it instead of stepping over the run. 0 if it's unknown.
+	LastChangeTS uint64
+	// VersionsToLastChange is the number of records below this one down to the last change, counting the change.
+	VersionsToLastChange uint64
+}
+
+// EncodeWriteCFValue encodes a write record without a last change hint. The short value must be no longer than
+// MaxShortValueLen.
+func EncodeWriteCFValue(t WriteType, startTS uint64, shortValue []byte) []byte {
+	return EncodeWrite(&Write{Type: t, StartTS: startTS, ShortValue: shortValue})
+}
+
+// EncodeWrite encodes a write record. The short value must be no longer than MaxShortValueLen.
+func EncodeWrite(w *Write) []byte {
+	data := codec.EncodeUvarint([]byte{byte(w.Type)}, w.StartTS)
+	if w.LastChangeTS != 0 {
+		data = append(data, lastChangePrefix)
+		data = codec.EncodeUvarint(data, w.LastChangeTS)
+		data = codec.EncodeUvarint(data, w.VersionsToLastChange)
+	}
+	return appendShortValue(data, w.ShortValue)
+}
+
+// DecodeWriteCFValue decodes a write record encoded by EncodeWriteCFValue or EncodeWrite.
+func DecodeWriteCFValue(data []byte) (*Write, error) {
+	if len(data) == 0 {
+		return nil, errors.New("invalid write record: empty")
+	}
+	w := &Write{Type: WriteType(data[0])}
+	switch w.Type {
+	case WriteTypePut, WriteTypeDelete, WriteTypeLock, WriteTypeRollback:
+	default:
+		return nil, errors.Errorf("invalid write type %v", data[0])
+	}
+	data, startTS, err := codec.DecodeUvarint(data[1:])
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	w.StartTS = startTS
+	if len(data) > 0 && data[0] == lastChangePrefix {
+		if data, w.LastChangeTS, err = codec.DecodeUvarint(data[1:]); err != nil {
+			return nil, errors.Trace(err)
+		}
+		if data, w.VersionsToLastChange, err = codec.DecodeUvarint(data); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	if w.ShortValue, err = decodeShortValue(data); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func appendShortValue(data, shortValue []byte) []byte {
+	if len(shortValue) == 0 {
+		return data
+	}
+	data = append(data, shortValuePrefix, byte(len(shortValue)))
+	return append(data, shortValue...)
+}
+
+func decodeShortValue(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	if len(data) < 2 || data[0] != shortValuePrefix || int(data[1]) != len(data)-2 || data[1] == 0 {
+		return nil, errors.Errorf("invalid short value %v", data)
+	}
+	return data[2:], nil
+}
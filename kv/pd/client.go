@@ -27,6 +27,7 @@ import (
 
 	"github.com/juju/errors"
 	"github.com/ngaut/log"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
 	"google.golang.org/grpc"
@@ -44,7 +45,11 @@ type Client interface {
 	GetRegion(ctx context.Context, key []byte) (*metapb.Region, *metapb.Peer, error)
 	GetRegionByID(ctx context.Context, regionID uint64) (*metapb.Region, *metapb.Peer, error)
 	AskBatchSplit(ctx context.Context, region *metapb.Region, count int) (*pdpb.AskBatchSplitResponse, error)
-	StoreHeartbeat(ctx context.Context, stats *pdpb.StoreStats) error
+	StoreHeartbeat(ctx context.Context, stats *pdpb.StoreStats, results []*pdpb.OperatorResult) (*pdpb.StoreHeartbeatResponse, error)
+	// GetTS allocates a batch of count consecutive timestamps and returns the last one.
+	GetTS(ctx context.Context, count uint32) (uint64, error)
+	// GetGCSafePoint returns the ts before which the old MVCC versions may be collected.
+	GetGCSafePoint(ctx context.Context) (uint64, error)
 	RegionHeartbeat(*pdpb.RegionHeartbeatRequest)
 	SetRegionHeartbeatResponseHandler(storeID uint64, h func(*pdpb.RegionHeartbeatResponse))
 	Close()
@@ -559,23 +564,65 @@ func (c *client) AskBatchSplit(ctx context.Context, region *metapb.Region, count
 	return resp, nil
 }
 
-func (c *client) StoreHeartbeat(ctx context.Context, stats *pdpb.StoreStats) error {
+func (c *client) StoreHeartbeat(ctx context.Context, stats *pdpb.StoreStats, results []*pdpb.OperatorResult) (*pdpb.StoreHeartbeatResponse, error) {
 	var resp *pdpb.StoreHeartbeatResponse
 	err := c.doRequest(ctx, func(ctx context.Context, client pdpb.PDClient) error {
 		var err1 error
 		resp, err1 = client.StoreHeartbeat(ctx, &pdpb.StoreHeartbeatRequest{
+			Header:          c.requestHeader(),
+			Stats:           stats,
+			OperatorResults: results,
+		})
+		return err1
+	})
+	if err != nil {
+		return nil, err
+	}
+	if herr := resp.Header.GetError(); herr != nil {
+		return nil, errors.New(herr.String())
+	}
+	return resp, nil
+}
+
+func (c *client) GetTS(ctx context.Context, count uint32) (uint64, error) {
+	var resp *pdpb.TsoResponse
+	err := c.doRequest(ctx, func(ctx context.Context, client pdpb.PDClient) error {
+		stream, err1 := client.Tso(ctx)
+		if err1 != nil {
+			return err1
+		}
+		defer stream.CloseSend()
+		if err1 = stream.Send(&pdpb.TsoRequest{Header: c.requestHeader(), Count: count}); err1 != nil {
+			return err1
+		}
+		resp, err1 = stream.Recv()
+		return err1
+	})
+	if err != nil {
+		return 0, err
+	}
+	if herr := resp.Header.GetError(); herr != nil {
+		return 0, errors.New(herr.String())
+	}
+	return mvcc.ComposeTS(resp.Timestamp.GetPhysical(), resp.Timestamp.GetLogical()), nil
+}
+
+func (c *client) GetGCSafePoint(ctx context.Context) (uint64, error) {
+	var resp *pdpb.GetGCSafePointResponse
+	err := c.doRequest(ctx, func(ctx context.Context, client pdpb.PDClient) error {
+		var err1 error
+		resp, err1 = client.GetGCSafePoint(ctx, &pdpb.GetGCSafePointRequest{
 			Header: c.requestHeader(),
-			Stats:  stats,
 		})
 		return err1
 	})
 	if err != nil {
-		return err
+		return 0, err
 	}
 	if herr := resp.Header.GetError(); herr != nil {
-		return errors.New(herr.String())
+		return 0, errors.New(herr.String())
 	}
-	return nil
+	return resp.SafePoint, nil
 }
 
 func (c *client) RegionHeartbeat(request *pdpb.RegionHeartbeatRequest) {
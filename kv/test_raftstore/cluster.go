@@ -0,0 +1,524 @@
+package test_raftstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/coocood/badger"
+	"github.com/ngaut/log"
+	kvConfig "github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/config"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/util/codec"
+)
+
+// NewTestConfig returns a raftstore config with short ticks, so that elections, heartbeats and conf changes finish
+// quickly in tests.
+func NewTestConfig() *config.Config {
+	cfg := config.NewDefaultConfig()
+	cfg.RaftBaseTickInterval = 10 * time.Millisecond
+	cfg.RaftHeartbeatTicks = 2
+	cfg.RaftElectionTimeoutTicks = 10
+	// With such tight timeouts the leadership can ping-pong between peers, keep it where it is unless it's
+	// transferred explicitly.
+	cfg.RaftLeaderStickiness = true
+	cfg.RaftCampaignHoldTicks = cfg.RaftElectionTimeoutTicks * 2
+	cfg.RaftStoreMaxLeaderLease = 80 * time.Millisecond
+	cfg.PdHeartbeatTickInterval = 100 * time.Millisecond
+	cfg.PdStoreHeartbeatTickInterval = 500 * time.Millisecond
+	cfg.RaftLogGCTickInterval = 50 * time.Millisecond
+	cfg.SplitRegionCheckTickInterval = time.Hour
+	cfg.MergeCheckTickInterval = 100 * time.Millisecond
+	cfg.PeerStaleStateCheckInterval = 500 * time.Millisecond
+	cfg.AbnormalLeaderMissingDuration = 1500 * time.Millisecond
+	cfg.MaxLeaderMissingDuration = 3 * time.Second
+	return cfg
+}
+
+// Cluster is a cluster of raftstores running in the same process for integration tests. The stores share a
+// MockPDClient and exchange raft messages through the MockTransport of the simulator, so a test can drop, hold and
+// reorder messages with filters and pause the apply of a peer to construct the interleavings it wants.
+type Cluster struct {
+	count     int
+	cfg       *config.Config
+	pdClient  *MockPDClient
+	simulator *NodeSimulator
+
+	dirs    []string
+	engines map[uint64]*engine_util.Engines
+	cfgs    map[uint64]*config.Config
+}
+
+func NewCluster(count int, cfg *config.Config) *Cluster {
+	pdClient := NewMockPDClient(0, 1)
+	return &Cluster{
+		count:     count,
+		cfg:       cfg,
+		pdClient:  pdClient,
+		simulator: NewNodeSimulator(pdClient),
+		engines:   make(map[uint64]*engine_util.Engines),
+		cfgs:      make(map[uint64]*config.Config),
+	}
+}
+
+// Start bootstraps the stores and the first region, which has a single peer on the first store, then runs all the
+// stores.
+func (c *Cluster) Start() {
+	ctx := context.TODO()
+	clusterID := c.pdClient.GetClusterID(ctx)
+	storeIDs := make([]uint64, 0, c.count)
+	for i := 0; i < c.count; i++ {
+		dir, err := ioutil.TempDir("", "test-raftstore")
+		if err != nil {
+			panic(err)
+		}
+		c.dirs = append(c.dirs, dir)
+		engines := newTestEngines(dir)
+
+		storeID, _ := c.pdClient.AllocID(ctx)
+		if err = raftstore.BootstrapStore(engines, clusterID, storeID); err != nil {
+			panic(err)
+		}
+		cfg := *c.cfg
+		cfg.SnapPath = filepath.Join(dir, "snap")
+		c.engines[storeID] = engines
+		c.cfgs[storeID] = &cfg
+		storeIDs = append(storeIDs, storeID)
+	}
+
+	firstStoreID := storeIDs[0]
+	regionID, _ := c.pdClient.AllocID(ctx)
+	peerID, _ := c.pdClient.AllocID(ctx)
+	firstRegion, err := raftstore.PrepareBootstrap(c.engines[firstStoreID], firstStoreID, regionID, peerID)
+	if err != nil {
+		panic(err)
+	}
+	resp, err := c.pdClient.Bootstrap(ctx, &metapb.Store{Id: firstStoreID}, firstRegion)
+	if err != nil {
+		panic(err)
+	}
+	if resp.GetHeader().GetError() != nil {
+		panic(resp.GetHeader().GetError())
+	}
+	if err = raftstore.ClearPrepareBootstrapState(c.engines[firstStoreID]); err != nil {
+		panic(err)
+	}
+
+	for _, storeID := range storeIDs {
+		if err = c.simulator.RunStore(c.cfgs[storeID], c.engines[storeID], storeID); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func newTestEngines(dir string) *engine_util.Engines {
+	engineConf := kvConfig.DefaultConf.Engine
+	engineConf.DBPath = dir
+	engineConf.MaxTableSize = 4 * kvConfig.MB
+	engineConf.VlogFileSize = 16 * kvConfig.MB
+	kvPath := filepath.Join(dir, "kv")
+	raftPath := filepath.Join(dir, "raft")
+	os.MkdirAll(kvPath, os.ModePerm)
+	os.MkdirAll(raftPath, os.ModePerm)
+	os.MkdirAll(filepath.Join(dir, "snap"), os.ModePerm)
+
+	kvDB := engine_util.CreateDB("kv", &engineConf)
+	raftDB := engine_util.CreateDB("raft", &engineConf)
+	return engine_util.NewEngines(kvDB, raftDB, kvPath, raftPath)
+}
+
+func (c *Cluster) Shutdown() {
+	for _, storeID := range c.simulator.GetStoreIDs() {
+		c.simulator.StopStore(storeID)
+	}
+	for _, engines := range c.engines {
+		engines.Kv.Close()
+		engines.Raft.Close()
+	}
+	for _, dir := range c.dirs {
+		os.RemoveAll(dir)
+	}
+}
+
+// StopServer stops the raftstore of the store. The engines are kept, so the store can be started again by
+// StartServer with its data.
+func (c *Cluster) StopServer(storeID uint64) {
+	c.simulator.StopStore(storeID)
+}
+
+func (c *Cluster) StartServer(storeID uint64) {
+	if err := c.simulator.RunStore(c.cfgs[storeID], c.engines[storeID], storeID); err != nil {
+		panic(err)
+	}
+}
+
+func (c *Cluster) GetStoreIDs() []uint64 {
+	storeIDs := make([]uint64, 0, len(c.engines))
+	for storeID := range c.engines {
+		storeIDs = append(storeIDs, storeID)
+	}
+	return storeIDs
+}
+
+func (c *Cluster) AddFilter(filter Filter) {
+	c.simulator.trans.AddFilter(filter)
+}
+
+func (c *Cluster) ClearFilters() {
+	c.simulator.trans.ClearFilters()
+}
+
+// Deliver sends the messages in order bypassing the filters, usually the ones taken from a HoldFilter.
+func (c *Cluster) Deliver(msgs []*rspb.RaftMessage) {
+	for _, msg := range msgs {
+		if err := c.simulator.trans.Deliver(msg); err != nil {
+			log.Warnf("deliver message to store %d failed, err: %v", msg.GetToPeer().GetStoreId(), err)
+		}
+	}
+}
+
+// PauseApply stops the peer of the region on the store from applying committed entries until ResumeApply is called.
+func (c *Cluster) PauseApply(storeID, regionID uint64) {
+	if err := c.simulator.GetRouter(storeID).PauseApply(regionID); err != nil {
+		panic(err)
+	}
+}
+
+func (c *Cluster) ResumeApply(storeID, regionID uint64) {
+	if err := c.simulator.GetRouter(storeID).ResumeApply(regionID); err != nil {
+		panic(err)
+	}
+}
+
+func (c *Cluster) GetRegion(key []byte) *metapb.Region {
+	for i := 0; i < 100; i++ {
+		region, _, _ := c.pdClient.GetRegion(context.TODO(), key)
+		if region != nil {
+			return region
+		}
+		// The region may be not reported to PD yet after a split.
+		time.Sleep(20 * time.Millisecond)
+	}
+	panic(fmt.Sprintf("find no region for %v", key))
+}
+
+func (c *Cluster) GetRegionByID(regionID uint64) *metapb.Region {
+	region, _, _ := c.pdClient.GetRegionByID(context.TODO(), regionID)
+	return region
+}
+
+// GetLeader returns the leader of the region last reported to PD, it may be stale.
+func (c *Cluster) GetLeader(regionID uint64) *metapb.Peer {
+	_, leader, _ := c.pdClient.GetRegionByID(context.TODO(), regionID)
+	return leader
+}
+
+// CallCommandOnLeader sends the request to the leader of the region, which is looked up in PD and redirected by the
+// NotLeader errors, until it gets a response other than NotLeader or the timeout is reached.
+func (c *Cluster) CallCommandOnLeader(request *raft_cmdpb.RaftCmdRequest, timeout time.Duration) (*raft_cmdpb.RaftCmdResponse, error) {
+	regionID := request.Header.RegionId
+	_, leader, _ := c.pdClient.GetRegionByID(context.TODO(), regionID)
+	start := time.Now()
+	for i := 0; time.Since(start) < timeout; i++ {
+		if leader == nil {
+			region := c.GetRegionByID(regionID)
+			if region == nil {
+				return nil, errors.Errorf("region %d not found", regionID)
+			}
+			leader = region.Peers[i%len(region.Peers)]
+		}
+		request.Header.Peer = leader
+		resp, err := c.CallCommand(leader.GetStoreId(), request, timeout-time.Since(start))
+		if err != nil {
+			return nil, err
+		}
+		notLeader := resp.GetHeader().GetError().GetNotLeader()
+		if notLeader == nil {
+			return resp, nil
+		}
+		leader = notLeader.GetLeader()
+		if leader == nil {
+			time.Sleep(20 * time.Millisecond)
+		}
+	}
+	return nil, errors.Errorf("can't find the leader of region %d", regionID)
+}
+
+// CallCommand sends the request to the store and waits for the response.
+func (c *Cluster) CallCommand(storeID uint64, request *raft_cmdpb.RaftCmdRequest, timeout time.Duration) (*raft_cmdpb.RaftCmdResponse, error) {
+	router := c.simulator.GetRouter(storeID)
+	if router == nil {
+		return nil, errors.Errorf("store %d is not running", storeID)
+	}
+	done := make(chan *raft_cmdpb.RaftCmdResponse, 1)
+	cb := message.NewNotifyCallback(func(resp *raft_cmdpb.RaftCmdResponse) {
+		done <- resp
+	})
+	if err := router.SendRaftCommand(request, cb); err != nil {
+		return nil, err
+	}
+	select {
+	case resp := <-done:
+		return resp, nil
+	case <-time.After(timeout):
+		return nil, errors.Errorf("request to store %d timeout", storeID)
+	}
+}
+
+// Request sends the requests to the region containing the key and retries on the region errors, which happen when
+// the region is split or its leader is changed meanwhile.
+func (c *Cluster) Request(key []byte, reqs []*raft_cmdpb.Request, timeout time.Duration) *raft_cmdpb.RaftCmdResponse {
+	start := time.Now()
+	for time.Since(start) < timeout {
+		region := c.GetRegion(key)
+		request := &raft_cmdpb.RaftCmdRequest{
+			Header: &raft_cmdpb.RaftRequestHeader{
+				RegionId:    region.GetId(),
+				RegionEpoch: region.GetRegionEpoch(),
+			},
+			Requests: reqs,
+		}
+		resp, err := c.CallCommandOnLeader(request, timeout-time.Since(start))
+		if err != nil {
+			log.Warnf("request region %d failed, err: %v", region.GetId(), err)
+			continue
+		}
+		if resp.GetHeader().GetError() != nil {
+			log.Debugf("request region %d failed, err: %v", region.GetId(), resp.GetHeader().GetError())
+			time.Sleep(20 * time.Millisecond)
+			continue
+		}
+		return resp
+	}
+	panic(fmt.Sprintf("request timeout, key: %v", key))
+}
+
+func (c *Cluster) MustPut(key, value []byte) {
+	req := &raft_cmdpb.Request{
+		CmdType: raft_cmdpb.CmdType_Put,
+		Put:     &raft_cmdpb.PutRequest{Cf: engine_util.CF_DEFAULT, Key: key, Value: value},
+	}
+	c.Request(key, []*raft_cmdpb.Request{req}, 5*time.Second)
+}
+
+func (c *Cluster) MustGet(key, value []byte) {
+	req := &raft_cmdpb.Request{
+		CmdType: raft_cmdpb.CmdType_Get,
+		Get:     &raft_cmdpb.GetRequest{Cf: engine_util.CF_DEFAULT, Key: key},
+	}
+	resp := c.Request(key, []*raft_cmdpb.Request{req}, 5*time.Second)
+	if len(resp.Responses) != 1 || !bytes.Equal(resp.Responses[0].GetGet().GetValue(), value) {
+		panic(fmt.Sprintf("expect %v for key %v, got %v", value, key, resp.Responses))
+	}
+}
+
+// MustGetOnStore waits until the value of the key in the kv engine of the store is the expected one, a nil value
+// means the key doesn't exist.
+func (c *Cluster) MustGetOnStore(storeID uint64, key, value []byte) {
+	var val []byte
+	for i := 0; i < 250; i++ {
+		val, _ = engine_util.GetCF(c.engines[storeID].Kv, engine_util.CF_DEFAULT, key)
+		if bytes.Equal(val, value) {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	panic(fmt.Sprintf("expect %v for key %v on store %d, got %v", value, key, storeID, val))
+}
+
+// MustGetRegionOnStore waits until the region state persisted on the store satisfies the check.
+func (c *Cluster) MustGetRegionOnStore(storeID, regionID uint64, check func(state *rspb.RegionLocalState) bool) {
+	var state *rspb.RegionLocalState
+	for i := 0; i < 250; i++ {
+		state = new(rspb.RegionLocalState)
+		err := c.engines[storeID].Kv.View(func(txn *badger.Txn) error {
+			item, err := txn.Get(raftstore.RegionStateKey(regionID))
+			if err != nil {
+				return err
+			}
+			val, err := item.Value()
+			if err != nil {
+				return err
+			}
+			return state.Unmarshal(val)
+		})
+		if err == nil && check(state) {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	panic(fmt.Sprintf("unexpected state of region %d on store %d: %v", regionID, storeID, state))
+}
+
+// AsyncSplit asks the leader of the region to split at the key and returns the channel of the response.
+func (c *Cluster) AsyncSplit(region *metapb.Region, splitKey []byte) <-chan *raft_cmdpb.RaftCmdResponse {
+	_, leader, _ := c.pdClient.GetRegionByID(context.TODO(), region.GetId())
+	if leader == nil {
+		leader = region.GetPeers()[0]
+	}
+	done := make(chan *raft_cmdpb.RaftCmdResponse, 1)
+	cb := message.NewNotifyCallback(func(resp *raft_cmdpb.RaftCmdResponse) {
+		done <- resp
+	})
+	c.simulator.GetRouter(leader.GetStoreId()).Send(region.GetId(), message.Msg{
+		Type:     message.MsgTypeSplitRegion,
+		RegionID: region.GetId(),
+		Data: &raftstore.MsgSplitRegion{
+			RegionEpoch: region.GetRegionEpoch(),
+			SplitKeys:   [][]byte{splitKey},
+			Callback:    cb,
+		},
+	})
+	return done
+}
+
+// MustSplitRegion splits the region containing the split key and waits until PD knows the split. The region is split
+// at the encoded key, like the split keys found by the split checker.
+func (c *Cluster) MustSplitRegion(splitKey []byte) {
+	encodedKey := codec.EncodeBytes(nil, splitKey)
+	for i := 0; i < 100; i++ {
+		region := c.GetRegion(encodedKey)
+		if bytes.Equal(region.GetStartKey(), encodedKey) {
+			return
+		}
+		if resp := <-c.AsyncSplit(region, encodedKey); resp.GetHeader().GetError() != nil {
+			log.Debugf("split region %d failed, err: %v", region.GetId(), resp.GetHeader().GetError())
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	panic(fmt.Sprintf("split at %v timeout", splitKey))
+}
+
+// PrepareMerge asks the leader of the source region to merge it into the target region, the merge is committed
+// asynchronously once the source is prepared.
+func (c *Cluster) PrepareMerge(source, target *metapb.Region) *raft_cmdpb.RaftCmdResponse {
+	request := &raft_cmdpb.RaftCmdRequest{
+		Header: &raft_cmdpb.RaftRequestHeader{
+			RegionId:    source.GetId(),
+			RegionEpoch: source.GetRegionEpoch(),
+		},
+		AdminRequest: &raft_cmdpb.AdminRequest{
+			CmdType:      raft_cmdpb.AdminCmdType_PrepareMerge,
+			PrepareMerge: &raft_cmdpb.PrepareMergeRequest{Target: target},
+		},
+	}
+	resp, err := c.CallCommandOnLeader(request, 5*time.Second)
+	if err != nil {
+		panic(err)
+	}
+	return resp
+}
+
+// MustMergeRegion merges the source region into the target region, and waits until PD knows the merge.
+func (c *Cluster) MustMergeRegion(source, target *metapb.Region) {
+	if resp := c.PrepareMerge(source, target); resp.GetHeader().GetError() != nil {
+		panic(fmt.Sprintf("prepare merge of region %d failed, err: %v", source.GetId(), resp.GetHeader().GetError()))
+	}
+	for i := 0; i < 250; i++ {
+		if region := c.GetRegion(source.GetStartKey()); region.GetId() == target.GetId() {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	panic(fmt.Sprintf("merge region %d into %d timeout", source.GetId(), target.GetId()))
+}
+
+// DeleteRange asks the leader of the region to delete the keys of [startKey, endKey) inside the region at the epoch.
+func (c *Cluster) DeleteRange(region *metapb.Region, startKey, endKey []byte) *raft_cmdpb.RaftCmdResponse {
+	request := &raft_cmdpb.RaftCmdRequest{
+		Header: &raft_cmdpb.RaftRequestHeader{
+			RegionId:    region.GetId(),
+			RegionEpoch: region.GetRegionEpoch(),
+		},
+		AdminRequest: &raft_cmdpb.AdminRequest{
+			CmdType:     raft_cmdpb.AdminCmdType_DeleteRange,
+			DeleteRange: &raft_cmdpb.DeleteRangeRequest{StartKey: startKey, EndKey: endKey},
+		},
+	}
+	resp, err := c.CallCommandOnLeader(request, 5*time.Second)
+	if err != nil {
+		panic(err)
+	}
+	return resp
+}
+
+// AllocPeer allocates a new peer on the store.
+func (c *Cluster) AllocPeer(storeID uint64) *metapb.Peer {
+	id, _ := c.pdClient.AllocID(context.TODO())
+	return &metapb.Peer{Id: id, StoreId: storeID}
+}
+
+func (c *Cluster) AddPeer(regionID uint64, peer *metapb.Peer) {
+	c.pdClient.AddPeer(regionID, *peer)
+}
+
+func (c *Cluster) RemovePeer(regionID uint64, peer *metapb.Peer) {
+	c.pdClient.RemovePeer(regionID, *peer)
+}
+
+// MustAddPeer schedules the peer to be added and waits until PD knows the region has the peer.
+func (c *Cluster) MustAddPeer(regionID uint64, peer *metapb.Peer) {
+	c.AddPeer(regionID, peer)
+	c.MustHavePeer(regionID, peer)
+}
+
+func (c *Cluster) MustRemovePeer(regionID uint64, peer *metapb.Peer) {
+	c.RemovePeer(regionID, peer)
+	c.MustNonePeer(regionID, peer)
+}
+
+func (c *Cluster) MustHavePeer(regionID uint64, peer *metapb.Peer) {
+	for i := 0; i < 250; i++ {
+		if region := c.GetRegionByID(regionID); region != nil && findPeerOnStore(region, peer.GetStoreId()) != nil {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	panic(fmt.Sprintf("region %d doesn't have peer %v", regionID, peer))
+}
+
+func (c *Cluster) MustNonePeer(regionID uint64, peer *metapb.Peer) {
+	for i := 0; i < 250; i++ {
+		if region := c.GetRegionByID(regionID); region != nil && findPeerOnStore(region, peer.GetStoreId()) == nil {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	panic(fmt.Sprintf("region %d still has peer %v", regionID, peer))
+}
+
+func (c *Cluster) TransferLeader(regionID uint64, leader *metapb.Peer) {
+	c.pdClient.TransferLeader(regionID, *leader)
+}
+
+// MustTransferLeader schedules the leader to be transferred and waits until PD knows the new leader.
+func (c *Cluster) MustTransferLeader(regionID uint64, leader *metapb.Peer) {
+	c.TransferLeader(regionID, leader)
+	for i := 0; i < 250; i++ {
+		if c.GetLeader(regionID).GetId() == leader.GetId() {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	panic(fmt.Sprintf("leader of region %d is not transferred to %v", regionID, leader))
+}
+
+func findPeerOnStore(region *metapb.Region, storeID uint64) *metapb.Peer {
+	for _, peer := range region.GetPeers() {
+		if peer.GetStoreId() == storeID {
+			return peer
+		}
+	}
+	return nil
+}
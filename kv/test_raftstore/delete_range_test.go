@@ -0,0 +1,46 @@
+package test_raftstore
+
+import (
+	"testing"
+)
+
+func TestDeleteRange(t *testing.T) {
+	cluster, stores := newReplicatedCluster(t, 3)
+	defer cluster.Shutdown()
+
+	region := cluster.GetRegion([]byte(""))
+	for _, storeID := range stores[1:] {
+		cluster.MustAddPeer(region.GetId(), cluster.AllocPeer(storeID))
+	}
+	for _, key := range []string{"k1", "k2", "k3", "k5"} {
+		cluster.MustPut([]byte(key), []byte("v"))
+	}
+	stale := cluster.GetRegion([]byte(""))
+	cluster.MustSplitRegion([]byte("k4"))
+
+	// A range at a stale epoch may cover the keys of another region now.
+	resp := cluster.DeleteRange(stale, []byte("k2"), nil)
+	if resp.GetHeader().GetError().GetEpochNotMatch() == nil {
+		t.Fatalf("expect epoch not match, got %v", resp)
+	}
+
+	// The range is clipped by the region, the keys of the other region are kept.
+	resp = cluster.DeleteRange(cluster.GetRegion([]byte("k1")), []byte("k2"), nil)
+	if resp.GetHeader().GetError() != nil {
+		t.Fatalf("delete range failed: %v", resp.GetHeader().GetError())
+	}
+	for _, storeID := range stores {
+		cluster.MustGetOnStore(storeID, []byte("k1"), []byte("v"))
+		cluster.MustGetOnStore(storeID, []byte("k2"), nil)
+		cluster.MustGetOnStore(storeID, []byte("k3"), nil)
+		cluster.MustGetOnStore(storeID, []byte("k5"), []byte("v"))
+	}
+
+	// A write applied after the delete range is kept on every store.
+	cluster.MustPut([]byte("k2"), []byte("v2"))
+	cluster.MustGet([]byte("k2"), []byte("v2"))
+	for _, storeID := range stores {
+		cluster.MustGetOnStore(storeID, []byte("k2"), []byte("v2"))
+		cluster.MustGetOnStore(storeID, []byte("k3"), nil)
+	}
+}
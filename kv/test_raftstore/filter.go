@@ -0,0 +1,122 @@
+package test_raftstore
+
+import (
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+)
+
+// Filter decides whether a raft message is delivered by the transport of the cluster.
+type Filter interface {
+	// Before returns false if the message should not be delivered now.
+	Before(msg *rspb.RaftMessage) bool
+}
+
+// PartitionFilter drops the messages between the two groups of stores.
+type PartitionFilter struct {
+	s1 map[uint64]struct{}
+	s2 map[uint64]struct{}
+}
+
+func NewPartitionFilter(s1, s2 []uint64) *PartitionFilter {
+	f := &PartitionFilter{
+		s1: make(map[uint64]struct{}),
+		s2: make(map[uint64]struct{}),
+	}
+	for _, id := range s1 {
+		f.s1[id] = struct{}{}
+	}
+	for _, id := range s2 {
+		f.s2[id] = struct{}{}
+	}
+	return f
+}
+
+func (f *PartitionFilter) Before(msg *rspb.RaftMessage) bool {
+	from, to := msg.GetFromPeer().GetStoreId(), msg.GetToPeer().GetStoreId()
+	if _, ok := f.s1[from]; ok {
+		if _, ok := f.s2[to]; ok {
+			return false
+		}
+	}
+	if _, ok := f.s2[from]; ok {
+		if _, ok := f.s1[to]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+// MessageMatcher selects raft messages, a zero field matches everything.
+type MessageMatcher struct {
+	RegionID  uint64
+	ToStoreID uint64
+	MsgTypes  []eraftpb.MessageType
+}
+
+func (m *MessageMatcher) Match(msg *rspb.RaftMessage) bool {
+	if m.RegionID != 0 && msg.GetRegionId() != m.RegionID {
+		return false
+	}
+	if m.ToStoreID != 0 && msg.GetToPeer().GetStoreId() != m.ToStoreID {
+		return false
+	}
+	if len(m.MsgTypes) == 0 {
+		return true
+	}
+	for _, tp := range m.MsgTypes {
+		if msg.GetMessage().GetMsgType() == tp {
+			return true
+		}
+	}
+	return false
+}
+
+// DropFilter drops the matched messages.
+type DropFilter struct {
+	MessageMatcher
+}
+
+func (f *DropFilter) Before(msg *rspb.RaftMessage) bool {
+	return !f.Match(msg)
+}
+
+// HoldFilter holds the matched messages instead of delivering them, the test decides when and in which order they
+// are delivered. The messages released are delivered in the order they were held.
+type HoldFilter struct {
+	MessageMatcher
+
+	mu   sync.Mutex
+	held []*rspb.RaftMessage
+}
+
+func NewHoldFilter(matcher MessageMatcher) *HoldFilter {
+	return &HoldFilter{MessageMatcher: matcher}
+}
+
+func (f *HoldFilter) Before(msg *rspb.RaftMessage) bool {
+	if !f.Match(msg) {
+		return true
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.held = append(f.held, msg)
+	return false
+}
+
+// Len returns the number of messages being held.
+func (f *HoldFilter) Len() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.held)
+}
+
+// Take removes the held messages and returns them, so they can be delivered by the transport.
+func (f *HoldFilter) Take() []*rspb.RaftMessage {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	held := f.held
+	f.held = nil
+	return held
+}
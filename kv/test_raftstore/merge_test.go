@@ -0,0 +1,88 @@
+package test_raftstore
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+)
+
+func newSplitCluster(t *testing.T) (*Cluster, []uint64, *metapb.Region, *metapb.Region) {
+	cluster, stores := newReplicatedCluster(t, 3)
+	region := cluster.GetRegion([]byte(""))
+	for _, storeID := range stores[1:] {
+		cluster.MustAddPeer(region.GetId(), cluster.AllocPeer(storeID))
+	}
+	cluster.MustPut([]byte("k1"), []byte("v1"))
+	cluster.MustPut([]byte("k3"), []byte("v3"))
+	cluster.MustSplitRegion([]byte("k2"))
+	left, right := cluster.GetRegion([]byte("k1")), cluster.GetRegion([]byte("k3"))
+	if left.GetId() == right.GetId() {
+		t.Fatalf("region %d is not split", left.GetId())
+	}
+	return cluster, stores, left, right
+}
+
+func TestMergeRegion(t *testing.T) {
+	cluster, stores, left, right := newSplitCluster(t)
+	defer cluster.Shutdown()
+
+	cluster.MustMergeRegion(left, right)
+	merged := cluster.GetRegion([]byte("k1"))
+	if merged.GetId() != right.GetId() || !bytes.Equal(merged.GetStartKey(), left.GetStartKey()) ||
+		!bytes.Equal(merged.GetEndKey(), right.GetEndKey()) {
+		t.Fatalf("unexpected merged region %v", merged)
+	}
+	for _, storeID := range stores {
+		cluster.MustGetRegionOnStore(storeID, left.GetId(), func(state *rspb.RegionLocalState) bool {
+			return state.State == rspb.PeerState_Tombstone
+		})
+		cluster.MustGetRegionOnStore(storeID, right.GetId(), func(state *rspb.RegionLocalState) bool {
+			return state.State == rspb.PeerState_Normal && bytes.Equal(state.Region.StartKey, left.GetStartKey())
+		})
+	}
+	cluster.MustGet([]byte("k1"), []byte("v1"))
+	cluster.MustGet([]byte("k3"), []byte("v3"))
+	cluster.MustPut([]byte("k1"), []byte("v2"))
+	for _, storeID := range stores {
+		cluster.MustGetOnStore(storeID, []byte("k1"), []byte("v2"))
+	}
+}
+
+// The source is prepared while a follower misses the PrepareMerge, so the merge isn't committed until the target
+// splits, and then the merge is rolled back.
+func TestRollbackMerge(t *testing.T) {
+	cluster, stores, left, right := newSplitCluster(t)
+	defer cluster.Shutdown()
+
+	filter := NewHoldFilter(MessageMatcher{
+		RegionID:  left.GetId(),
+		ToStoreID: stores[2],
+		MsgTypes:  []eraftpb.MessageType{eraftpb.MessageType_MsgAppend},
+	})
+	cluster.AddFilter(filter)
+	if resp := cluster.PrepareMerge(left, right); resp.GetHeader().GetError() != nil {
+		t.Fatalf("prepare merge failed, err: %v", resp.GetHeader().GetError())
+	}
+	cluster.MustGetRegionOnStore(stores[0], left.GetId(), func(state *rspb.RegionLocalState) bool {
+		return state.State == rspb.PeerState_Merging
+	})
+	cluster.MustSplitRegion([]byte("k4"))
+	cluster.ClearFilters()
+	cluster.Deliver(filter.Take())
+
+	for _, storeID := range stores {
+		cluster.MustGetRegionOnStore(storeID, left.GetId(), func(state *rspb.RegionLocalState) bool {
+			return state.State == rspb.PeerState_Normal && state.Region.RegionEpoch.Version > left.GetRegionEpoch().GetVersion()
+		})
+	}
+	cluster.MustPut([]byte("k1"), []byte("v2"))
+	for _, storeID := range stores {
+		cluster.MustGetOnStore(storeID, []byte("k1"), []byte("v2"))
+	}
+	if region := cluster.GetRegion([]byte("k1")); region.GetId() != left.GetId() {
+		t.Fatalf("region %d is merged into %d", left.GetId(), region.GetId())
+	}
+}
@@ -0,0 +1,209 @@
+package test_raftstore
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/ngaut/log"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/config"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/snap"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/worker"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap-incubator/tinykv/raft"
+	"github.com/pingcap/errors"
+)
+
+// MockTransport delivers the raft messages among the stores of a process directly through their routers, the
+// filters decide which messages are delivered.
+type MockTransport struct {
+	sync.RWMutex
+
+	filters  []Filter
+	routers  map[uint64]*raftstore.RaftstoreRouter
+	snapMgrs map[uint64]*snap.SnapManager
+}
+
+func NewMockTransport() *MockTransport {
+	return &MockTransport{
+		routers:  make(map[uint64]*raftstore.RaftstoreRouter),
+		snapMgrs: make(map[uint64]*snap.SnapManager),
+	}
+}
+
+func (t *MockTransport) AddStore(storeID uint64, router *raftstore.RaftstoreRouter, snapMgr *snap.SnapManager) {
+	t.Lock()
+	defer t.Unlock()
+	t.routers[storeID] = router
+	t.snapMgrs[storeID] = snapMgr
+}
+
+func (t *MockTransport) RemoveStore(storeID uint64) {
+	t.Lock()
+	defer t.Unlock()
+	delete(t.routers, storeID)
+	delete(t.snapMgrs, storeID)
+}
+
+func (t *MockTransport) AddFilter(filter Filter) {
+	t.Lock()
+	defer t.Unlock()
+	t.filters = append(t.filters, filter)
+}
+
+func (t *MockTransport) ClearFilters() {
+	t.Lock()
+	defer t.Unlock()
+	t.filters = nil
+}
+
+func (t *MockTransport) Send(msg *rspb.RaftMessage) error {
+	t.RLock()
+	for _, filter := range t.filters {
+		if !filter.Before(msg) {
+			t.RUnlock()
+			return nil
+		}
+	}
+	t.RUnlock()
+	return t.Deliver(msg)
+}
+
+// Deliver sends the message to the target store without consulting the filters, it is used to release the messages
+// held by a filter.
+func (t *MockTransport) Deliver(msg *rspb.RaftMessage) error {
+	t.RLock()
+	defer t.RUnlock()
+
+	fromStore, toStore := msg.GetFromPeer().GetStoreId(), msg.GetToPeer().GetStoreId()
+	router, ok := t.routers[toStore]
+	if !ok {
+		return errors.Errorf("store %d is closed", toStore)
+	}
+	if msg.GetMessage().GetSnapshot() != nil {
+		err := t.copySnapshot(t.snapMgrs[fromStore], t.snapMgrs[toStore], msg)
+		status := raft.SnapshotFinish
+		if err != nil {
+			log.Errorf("copy snapshot from store %d to store %d failed, err: %v", fromStore, toStore, err)
+			status = raft.SnapshotFailure
+		}
+		if fromRouter, ok := t.routers[fromStore]; ok {
+			fromRouter.ReportSnapshotStatus(msg.GetRegionId(), msg.GetToPeer().GetId(), status)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	router.SendRaftMessage(msg)
+	return nil
+}
+
+// copySnapshot copies the snapshot files of the message as if they were sent by the snap worker.
+func (t *MockTransport) copySnapshot(fromMgr, toMgr *snap.SnapManager, msg *rspb.RaftMessage) error {
+	if fromMgr == nil || toMgr == nil {
+		return errors.New("snap manager not found")
+	}
+	msgSnap := msg.GetMessage().GetSnapshot()
+	snapKey, err := snap.SnapKeyFromSnap(msgSnap)
+	if err != nil {
+		return err
+	}
+
+	fromMgr.Register(snapKey, snap.SnapEntrySending)
+	defer fromMgr.Deregister(snapKey, snap.SnapEntrySending)
+	fromSnap, err := fromMgr.GetSnapshotForSending(snapKey)
+	if err != nil {
+		return err
+	}
+	if !fromSnap.Exists() {
+		return errors.Errorf("missing snap file: %v", fromSnap.Path())
+	}
+
+	toSnap, err := toMgr.GetSnapshotForReceiving(snapKey, msgSnap.GetData())
+	if err != nil {
+		return err
+	}
+	if toSnap.Exists() {
+		return nil
+	}
+	toMgr.Register(snapKey, snap.SnapEntryReceiving)
+	defer toMgr.Deregister(snapKey, snap.SnapEntryReceiving)
+	if _, err = io.CopyN(toSnap, fromSnap, int64(fromSnap.TotalSize())); err != nil {
+		return err
+	}
+	return toSnap.Save()
+}
+
+// NodeSimulator runs the raftstores of a cluster in the same process, connected by a MockTransport.
+type NodeSimulator struct {
+	sync.RWMutex
+
+	trans    *MockTransport
+	pdClient *MockPDClient
+	nodes    map[uint64]*raftstore.Node
+	routers  map[uint64]*raftstore.RaftstoreRouter
+}
+
+func NewNodeSimulator(pdClient *MockPDClient) *NodeSimulator {
+	return &NodeSimulator{
+		trans:    NewMockTransport(),
+		pdClient: pdClient,
+		nodes:    make(map[uint64]*raftstore.Node),
+		routers:  make(map[uint64]*raftstore.RaftstoreRouter),
+	}
+}
+
+// RunStore starts the raftstore of a store which is already bootstrapped in the engines.
+func (s *NodeSimulator) RunStore(cfg *config.Config, engines *engine_util.Engines, storeID uint64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	router, batchSystem := raftstore.CreateRaftBatchSystem(cfg)
+	raftRouter := raftstore.NewRaftstoreRouter(router)
+	snapMgr := snap.NewSnapManager(cfg.SnapPath)
+	pdWorker := worker.NewWorker("pd-worker", new(sync.WaitGroup))
+
+	node := raftstore.NewNode(batchSystem, &metapb.Store{}, cfg, s.pdClient)
+	s.trans.AddStore(storeID, raftRouter, snapMgr)
+	if err := node.Start(context.TODO(), engines, s.trans, snapMgr, pdWorker, raftRouter); err != nil {
+		s.trans.RemoveStore(storeID)
+		return err
+	}
+	s.nodes[storeID] = node
+	s.routers[storeID] = raftRouter
+	return nil
+}
+
+// StopStore stops the raftstore, the messages to the store are dropped afterwards.
+func (s *NodeSimulator) StopStore(storeID uint64) {
+	s.Lock()
+	defer s.Unlock()
+
+	node, ok := s.nodes[storeID]
+	if !ok {
+		return
+	}
+	s.trans.RemoveStore(storeID)
+	node.Stop()
+	delete(s.nodes, storeID)
+	delete(s.routers, storeID)
+}
+
+func (s *NodeSimulator) GetStoreIDs() []uint64 {
+	s.RLock()
+	defer s.RUnlock()
+	storeIDs := make([]uint64, 0, len(s.nodes))
+	for storeID := range s.nodes {
+		storeIDs = append(storeIDs, storeID)
+	}
+	return storeIDs
+}
+
+func (s *NodeSimulator) GetRouter(storeID uint64) *raftstore.RaftstoreRouter {
+	s.RLock()
+	defer s.RUnlock()
+	return s.routers[storeID]
+}
@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"context"
 	"sync"
+	"time"
 
 	"github.com/google/btree"
 	"github.com/ngaut/log"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
@@ -66,7 +68,7 @@ type Store struct {
 func NewStore(store *metapb.Store) *Store {
 	return &Store{
 		store:                    *store,
-		heartbeatResponseHandler: nil,
+		heartbeatResponseHandler: func(*pdpb.RegionHeartbeatResponse) {},
 	}
 }
 
@@ -77,10 +79,14 @@ type MockPDClient struct {
 
 	meta         metapb.Cluster
 	stores       map[uint64]*Store
-	regionsRange btree.BTree       // key -> region
+	regionsRange *btree.BTree      // key -> region
 	regionsKey   map[uint64][]byte // regionID -> startKey
 
 	baseID uint64
+	// The last allocated timestamp.
+	lastTS uint64
+	// The GC safe point returned to the stores.
+	gcSafePoint uint64
 
 	operators    map[uint64]*Operator
 	leaders      map[uint64]*metapb.Peer // regionID -> peer
@@ -89,6 +95,20 @@ type MockPDClient struct {
 	bootstrapped bool
 }
 
+func NewMockPDClient(clusterID uint64, baseID uint64) *MockPDClient {
+	return &MockPDClient{
+		clusterID:    clusterID,
+		meta:         metapb.Cluster{Id: clusterID},
+		stores:       make(map[uint64]*Store),
+		regionsRange: btree.New(2),
+		regionsKey:   make(map[uint64][]byte),
+		baseID:       baseID,
+		operators:    make(map[uint64]*Operator),
+		leaders:      make(map[uint64]*metapb.Peer),
+		pendingPeers: make(map[uint64]*metapb.Peer),
+	}
+}
+
 // Implement PDClient interface
 func (m *MockPDClient) GetClusterID(ctx context.Context) uint64 {
 	m.RLock()
@@ -148,6 +168,9 @@ func (m *MockPDClient) PutStore(ctx context.Context, store *metapb.Store) error
 	defer m.Unlock()
 
 	s := NewStore(store)
+	if old, ok := m.stores[store.GetId()]; ok {
+		s.heartbeatResponseHandler = old.heartbeatResponseHandler
+	}
 	m.stores[store.GetId()] = s
 	return nil
 }
@@ -226,15 +249,45 @@ func (m *MockPDClient) AskBatchSplit(ctx context.Context, region *metapb.Region,
 	return resp, nil
 }
 
-func (m *MockPDClient) StoreHeartbeat(ctx context.Context, stats *pdpb.StoreStats) error {
+func (m *MockPDClient) StoreHeartbeat(ctx context.Context, stats *pdpb.StoreStats, results []*pdpb.OperatorResult) (*pdpb.StoreHeartbeatResponse, error) {
 	if err := m.checkBootstrap(); err != nil {
-		return err
+		return nil, err
 	}
 	// nothing need to do
-	return nil
+	return &pdpb.StoreHeartbeatResponse{}, nil
+}
+
+func (m *MockPDClient) GetTS(ctx context.Context, count uint32) (uint64, error) {
+	m.Lock()
+	defer m.Unlock()
+	ts := mvcc.ComposeTS(time.Now().UnixNano()/int64(time.Millisecond), 0)
+	if ts < m.lastTS {
+		ts = m.lastTS
+	}
+	m.lastTS = ts + uint64(count)
+	return m.lastTS, nil
+}
+
+func (m *MockPDClient) GetGCSafePoint(ctx context.Context) (uint64, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return m.gcSafePoint, nil
 }
 
-func (m *MockPDClient) RegionHeartbeat(req *pdpb.RegionHeartbeatRequest) error {
+// SetGCSafePoint sets the GC safe point returned to the stores.
+func (m *MockPDClient) SetGCSafePoint(safePoint uint64) {
+	m.Lock()
+	m.gcSafePoint = safePoint
+	m.Unlock()
+}
+
+func (m *MockPDClient) RegionHeartbeat(req *pdpb.RegionHeartbeatRequest) {
+	if err := m.regionHeartbeat(req); err != nil {
+		log.Warnf("[region %d] handle heartbeat failed, err: %v", req.Region.GetId(), err)
+	}
+}
+
+func (m *MockPDClient) regionHeartbeat(req *pdpb.RegionHeartbeatRequest) error {
 	if err := m.checkBootstrap(); err != nil {
 		return err
 	}
@@ -273,13 +326,14 @@ func (m *MockPDClient) RegionHeartbeat(req *pdpb.RegionHeartbeatRequest) error {
 		log.Debugf("[region %d] schedule %v", regionID, op)
 	}
 
-	store := m.stores[req.Leader.GetStoreId()]
-	store.heartbeatResponseHandler(resp)
+	if store := m.stores[req.Leader.GetStoreId()]; store != nil {
+		store.heartbeatResponseHandler(resp)
+	}
 	return nil
 }
 
 func (m *MockPDClient) handleHeartbeatVersion(region *metapb.Region) error {
-	if bytes.Compare(region.GetStartKey(), region.GetEndKey()) > 0 {
+	if len(region.GetEndKey()) > 0 && bytes.Compare(region.GetStartKey(), region.GetEndKey()) > 0 {
 		panic("start key > end key")
 	}
 
@@ -302,7 +356,7 @@ func (m *MockPDClient) handleHeartbeatVersion(region *metapb.Region) error {
 				return nil
 			}
 
-			if bytes.Compare(searchRegion.GetStartKey(), region.GetEndKey()) > 0 {
+			if len(region.GetEndKey()) > 0 && bytes.Compare(searchRegion.GetStartKey(), region.GetEndKey()) > 0 {
 				// No range covers [start, end) now, insert directly.
 				m.addRegionLocked(region)
 				return nil
@@ -333,7 +387,7 @@ func (m *MockPDClient) handleHeartbeatConfVersion(region *metapb.Region) error {
 		// So scheduler and TinyKV can't have same peer count and can only have
 		// only one different peer.
 		if searchRegionPeerLen > regionPeerLen {
-			if regionPeerLen-searchRegionPeerLen != 1 {
+			if searchRegionPeerLen-regionPeerLen != 1 {
 				panic("should only one conf change")
 			}
 			if len(GetDiffPeers(searchRegion, region)) != 1 {
@@ -375,22 +429,23 @@ func (m *MockPDClient) handleHeartbeatConfVersion(region *metapb.Region) error {
 func (m *MockPDClient) tryFinished(op *Operator, region *metapb.Region, leader *metapb.Peer) bool {
 	switch op.Type {
 	case OperatorTypeAddPeer:
-		add := op.Data.(OpAddPeer)
+		add := op.Data.(*OpAddPeer)
 		if !add.pending {
 			for _, p := range region.GetPeers() {
 				if add.peer.GetId() == p.GetId() {
 					add.pending = true
-				} else {
-					// TinyKV rejects AddNode.
-					return false
+					break
 				}
 			}
-		} else {
-			_, found := m.pendingPeers[add.peer.GetId()]
-			return !found
+			if !add.pending {
+				// The peer hasn't been added yet, or TinyKV rejects AddNode.
+				return false
+			}
 		}
+		_, found := m.pendingPeers[add.peer.GetId()]
+		return !found
 	case OperatorTypeRemovePeer:
-		remove := op.Data.(OpRemovePeer)
+		remove := op.Data.(*OpRemovePeer)
 		for _, p := range region.GetPeers() {
 			if remove.peer.GetId() == p.GetId() {
 				return false
@@ -398,7 +453,7 @@ func (m *MockPDClient) tryFinished(op *Operator, region *metapb.Region, leader *
 		}
 		return true
 	case OperatorTypeTransferLeader:
-		transfer := op.Data.(OpTransferLeader)
+		transfer := op.Data.(*OpTransferLeader)
 		return leader.GetId() == transfer.peer.GetId()
 	}
 	panic("unreachable")
@@ -407,7 +462,7 @@ func (m *MockPDClient) tryFinished(op *Operator, region *metapb.Region, leader *
 func (m *MockPDClient) makeRegionHeartbeatResponse(op *Operator, resp *pdpb.RegionHeartbeatResponse) {
 	switch op.Type {
 	case OperatorTypeAddPeer:
-		add := op.Data.(OpAddPeer)
+		add := op.Data.(*OpAddPeer)
 		if !add.pending {
 			resp.ChangePeer = &pdpb.ChangePeer{
 				ChangeType: eraftpb.ConfChangeType_AddNode,
@@ -415,13 +470,13 @@ func (m *MockPDClient) makeRegionHeartbeatResponse(op *Operator, resp *pdpb.Regi
 			}
 		}
 	case OperatorTypeRemovePeer:
-		remove := op.Data.(OpRemovePeer)
+		remove := op.Data.(*OpRemovePeer)
 		resp.ChangePeer = &pdpb.ChangePeer{
 			ChangeType: eraftpb.ConfChangeType_RemoveNode,
 			Peer:       &remove.peer,
 		}
 	case OperatorTypeTransferLeader:
-		transfer := op.Data.(OpTransferLeader)
+		transfer := op.Data.(*OpTransferLeader)
 		resp.TransferLeader = &pdpb.TransferLeader{
 			Peer: &transfer.peer,
 		}
@@ -459,6 +514,22 @@ func (m *MockPDClient) findRegion(key []byte) *regionItem {
 }
 
 func (m *MockPDClient) addRegionLocked(region *metapb.Region) {
+	// A merged region covers the older regions merged into it, including its own old range.
+	var covered []*regionItem
+	m.regionsRange.AscendGreaterOrEqual(&regionItem{region: metapb.Region{StartKey: region.GetStartKey()}}, func(i btree.Item) bool {
+		item := i.(*regionItem)
+		if len(region.GetEndKey()) > 0 && bytes.Compare(item.region.GetStartKey(), region.GetEndKey()) >= 0 {
+			return false
+		}
+		if item.region.GetRegionEpoch().GetVersion() < region.GetRegionEpoch().GetVersion() {
+			covered = append(covered, item)
+		}
+		return true
+	})
+	for _, item := range covered {
+		m.regionsRange.Delete(item)
+		delete(m.regionsKey, item.region.GetId())
+	}
 	m.regionsKey[region.GetId()] = region.GetStartKey()
 	m.regionsRange.ReplaceOrInsert(&regionItem{region: *region})
 }
@@ -527,7 +598,7 @@ func MustSamePeers(left *metapb.Region, right *metapb.Region) {
 }
 
 func GetDiffPeers(left *metapb.Region, right *metapb.Region) []*metapb.Peer {
-	peers := make([]*metapb.Peer, 1)
+	peers := make([]*metapb.Peer, 0, 1)
 	for _, p := range left.GetPeers() {
 		found := false
 		for _, p1 := range right.GetPeers() {
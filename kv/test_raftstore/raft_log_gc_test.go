@@ -0,0 +1,66 @@
+package test_raftstore
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/coocood/badger"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore"
+)
+
+// countRaftLogs returns the number of the raft log entries of the region in the raft engine of the store.
+func (c *Cluster) countRaftLogs(storeID, regionID uint64) int {
+	prefix := raftstore.RaftLogKey(regionID, 0)
+	prefix = prefix[:len(prefix)-8]
+	count := 0
+	err := c.engines[storeID].Raft.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		for it.Seek(prefix); it.Valid() && bytes.HasPrefix(it.Item().Key(), prefix); it.Next() {
+			count++
+		}
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+func TestRaftLogGC(t *testing.T) {
+	cfg := NewTestConfig()
+	cfg.RaftLogGcThreshold = 1000
+	cfg.RaftLogGcCountLimit = 20
+	cluster := NewCluster(3, cfg)
+	cluster.Start()
+	defer cluster.Shutdown()
+
+	region := cluster.GetRegion([]byte(""))
+	for _, storeID := range cluster.GetStoreIDs() {
+		if storeID != region.Peers[0].StoreId {
+			cluster.MustAddPeer(region.GetId(), cluster.AllocPeer(storeID))
+		}
+	}
+	for i := 0; i < 100; i++ {
+		cluster.MustPut([]byte(fmt.Sprintf("k%03d", i)), []byte("v"))
+	}
+
+	// The count limit triggers the compaction, the threshold of replicated entries is never reached. The entries up to
+	// the count limit are left, and the CompactLog appended after them.
+	maxLogs := 21
+	for _, storeID := range cluster.GetStoreIDs() {
+		var count int
+		for i := 0; i < 250; i++ {
+			if count = cluster.countRaftLogs(storeID, region.GetId()); count <= maxLogs {
+				break
+			}
+			time.Sleep(20 * time.Millisecond)
+		}
+		if count > maxLogs {
+			t.Fatalf("%d raft logs are left on store %d", count, storeID)
+		}
+		cluster.MustGetOnStore(storeID, []byte("k099"), []byte("v"))
+	}
+}
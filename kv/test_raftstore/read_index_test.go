@@ -0,0 +1,100 @@
+package test_raftstore
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+)
+
+// readIndexHeartbeatFilter holds the heartbeats of the read index rounds, the heartbeats without a context are
+// delivered so that the followers don't campaign.
+type readIndexHeartbeatFilter struct {
+	mu   sync.Mutex
+	held []*rspb.RaftMessage
+}
+
+func (f *readIndexHeartbeatFilter) Before(msg *rspb.RaftMessage) bool {
+	if msg.GetMessage().GetMsgType() != eraftpb.MessageType_MsgHeartbeat || len(msg.GetMessage().GetContext()) == 0 {
+		return true
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.held = append(f.held, msg)
+	return false
+}
+
+func (f *readIndexHeartbeatFilter) take() []*rspb.RaftMessage {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	held := f.held
+	f.held = nil
+	return held
+}
+
+// The reads arriving while a read index round waits for its heartbeat responses share the next round, and no read
+// is served before a quorum confirms the leadership.
+func TestReadIndexBatchesConcurrentReads(t *testing.T) {
+	cluster, stores := newReplicatedCluster(t, 3)
+	defer cluster.Shutdown()
+
+	region := cluster.GetRegion([]byte(""))
+	for _, storeID := range stores[1:] {
+		cluster.MustAddPeer(region.GetId(), cluster.AllocPeer(storeID))
+	}
+	cluster.MustPut([]byte("k1"), []byte("v1"))
+	for _, storeID := range stores {
+		cluster.MustGetOnStore(storeID, []byte("k1"), []byte("v1"))
+	}
+
+	filter := new(readIndexHeartbeatFilter)
+	cluster.AddFilter(filter)
+	start := time.Now()
+	const reads = 50
+	var wg sync.WaitGroup
+	values := make(chan []byte, reads)
+	for i := 0; i < reads; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req := &raft_cmdpb.Request{
+				CmdType: raft_cmdpb.CmdType_Get,
+				Get:     &raft_cmdpb.GetRequest{Cf: engine_util.CF_DEFAULT, Key: []byte("k1")},
+			}
+			resp := cluster.Request([]byte("k1"), []*raft_cmdpb.Request{req}, 5*time.Second)
+			values <- resp.Responses[0].GetGet().GetValue()
+		}()
+	}
+	time.Sleep(200 * time.Millisecond)
+	if len(values) != 0 {
+		t.Fatalf("%d reads are served before the read index is confirmed", len(values))
+	}
+
+	cluster.ClearFilters()
+	held := filter.take()
+	// The heartbeats of a round carry its context, the periodic heartbeats carry the one of the last pending round too.
+	// A round is issued only once the last one has waited for its heartbeat responses for a heartbeat interval,
+	// however many reads arrive.
+	ctxs := make(map[string]struct{})
+	for _, msg := range held {
+		ctxs[string(msg.GetMessage().GetContext())] = struct{}{}
+	}
+	interval := cluster.cfg.RaftBaseTickInterval * time.Duration(cluster.cfg.RaftHeartbeatTicks)
+	maxRounds := int(time.Since(start)/interval) + 1
+	if rounds := len(ctxs); rounds == 0 || rounds > maxRounds {
+		t.Fatalf("%d read index rounds for %d reads in %v", rounds, reads, time.Since(start))
+	}
+	cluster.Deliver(held)
+	wg.Wait()
+	close(values)
+	for val := range values {
+		if !bytes.Equal(val, []byte("v1")) {
+			t.Fatalf("expect v1, got %q", val)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package test_raftstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+)
+
+func newReplicatedCluster(t *testing.T, count int) (*Cluster, []uint64) {
+	cluster := NewCluster(count, NewTestConfig())
+	cluster.Start()
+	region := cluster.GetRegion([]byte(""))
+	storeIDs := []uint64{region.Peers[0].StoreId}
+	for _, storeID := range cluster.GetStoreIDs() {
+		if storeID != storeIDs[0] {
+			storeIDs = append(storeIDs, storeID)
+		}
+	}
+	return cluster, storeIDs
+}
+
+func TestClusterBasic(t *testing.T) {
+	cluster, stores := newReplicatedCluster(t, 3)
+	defer cluster.Shutdown()
+
+	region := cluster.GetRegion([]byte(""))
+	cluster.MustPut([]byte("k1"), []byte("v1"))
+	for _, storeID := range stores[1:] {
+		cluster.MustAddPeer(region.GetId(), cluster.AllocPeer(storeID))
+	}
+	for _, storeID := range stores {
+		cluster.MustGetOnStore(storeID, []byte("k1"), []byte("v1"))
+	}
+
+	cluster.MustSplitRegion([]byte("k2"))
+	cluster.MustPut([]byte("k3"), []byte("v3"))
+	cluster.MustGet([]byte("k1"), []byte("v1"))
+	for _, storeID := range stores {
+		cluster.MustGetOnStore(storeID, []byte("k3"), []byte("v3"))
+	}
+}
+
+// The new peer is added while the messages to it are held, so it gets the messages of the region only after the
+// region is split.
+func TestSplitRacingWithAddPeer(t *testing.T) {
+	cluster, stores := newReplicatedCluster(t, 3)
+	defer cluster.Shutdown()
+
+	region := cluster.GetRegion([]byte(""))
+	cluster.MustAddPeer(region.GetId(), cluster.AllocPeer(stores[1]))
+	cluster.MustPut([]byte("k1"), []byte("v1"))
+	cluster.MustPut([]byte("k3"), []byte("v3"))
+
+	filter := NewHoldFilter(MessageMatcher{ToStoreID: stores[2]})
+	cluster.AddFilter(filter)
+	cluster.MustAddPeer(region.GetId(), cluster.AllocPeer(stores[2]))
+	cluster.MustSplitRegion([]byte("k2"))
+	cluster.MustPut([]byte("k4"), []byte("v4"))
+
+	cluster.ClearFilters()
+	cluster.Deliver(filter.Take())
+	cluster.MustGetOnStore(stores[2], []byte("k1"), []byte("v1"))
+	cluster.MustGetOnStore(stores[2], []byte("k3"), []byte("v3"))
+	cluster.MustGetOnStore(stores[2], []byte("k4"), []byte("v4"))
+}
+
+// The peer removed from the left region hasn't applied the split yet, so the peer of the right region on the store
+// is created by the messages of the right region before the split is applied.
+func TestSplitRacingWithRemovePeer(t *testing.T) {
+	cluster, stores := newReplicatedCluster(t, 3)
+	defer cluster.Shutdown()
+
+	region := cluster.GetRegion([]byte(""))
+	for _, storeID := range stores[1:] {
+		cluster.MustAddPeer(region.GetId(), cluster.AllocPeer(storeID))
+	}
+	cluster.MustPut([]byte("k1"), []byte("v1"))
+	cluster.MustPut([]byte("k3"), []byte("v3"))
+	cluster.MustGetOnStore(stores[2], []byte("k3"), []byte("v3"))
+
+	cluster.PauseApply(stores[2], region.GetId())
+	cluster.MustSplitRegion([]byte("k2"))
+	left := cluster.GetRegion([]byte("k1"))
+	right := cluster.GetRegion([]byte("k3"))
+	cluster.MustPut([]byte("k4"), []byte("v4"))
+	cluster.MustRemovePeer(left.GetId(), findPeerOnStore(left, stores[2]))
+	cluster.ResumeApply(stores[2], region.GetId())
+
+	cluster.MustGetRegionOnStore(stores[2], left.GetId(), func(state *rspb.RegionLocalState) bool {
+		return state.State == rspb.PeerState_Tombstone
+	})
+	cluster.MustGetOnStore(stores[2], []byte("k1"), nil)
+	cluster.MustGetOnStore(stores[2], []byte("k3"), []byte("v3"))
+	cluster.MustGetOnStore(stores[2], []byte("k4"), []byte("v4"))
+
+	cluster.MustPut([]byte("k5"), []byte("v5"))
+	cluster.MustGetOnStore(stores[2], []byte("k5"), []byte("v5"))
+	cluster.MustGetRegionOnStore(stores[2], right.GetId(), func(state *rspb.RegionLocalState) bool {
+		return state.State == rspb.PeerState_Normal
+	})
+}
+
+// The snapshot of the region generated before the split is delivered to the new peer after the split, together with
+// the snapshot of the right region.
+func TestSnapshotRacingWithSplit(t *testing.T) {
+	cluster, stores := newReplicatedCluster(t, 3)
+	defer cluster.Shutdown()
+
+	region := cluster.GetRegion([]byte(""))
+	cluster.MustAddPeer(region.GetId(), cluster.AllocPeer(stores[1]))
+	cluster.MustPut([]byte("k1"), []byte("v1"))
+	cluster.MustPut([]byte("k3"), []byte("v3"))
+
+	filter := NewHoldFilter(MessageMatcher{ToStoreID: stores[2], MsgTypes: []eraftpb.MessageType{eraftpb.MessageType_MsgSnapshot}})
+	cluster.AddFilter(filter)
+	cluster.AddPeer(region.GetId(), cluster.AllocPeer(stores[2]))
+	waitHeld(t, filter)
+	cluster.MustSplitRegion([]byte("k2"))
+	cluster.MustPut([]byte("k4"), []byte("v4"))
+
+	cluster.ClearFilters()
+	cluster.Deliver(filter.Take())
+	cluster.MustGetOnStore(stores[2], []byte("k1"), []byte("v1"))
+	cluster.MustGetOnStore(stores[2], []byte("k3"), []byte("v3"))
+	cluster.MustGetOnStore(stores[2], []byte("k4"), []byte("v4"))
+}
+
+func waitHeld(t *testing.T, filter *HoldFilter) {
+	for i := 0; i < 250; i++ {
+		if filter.Len() > 0 {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatal("no message is held")
+}
@@ -0,0 +1,35 @@
+package test_raftstore
+
+import (
+	"testing"
+	"time"
+)
+
+// The transferee takes the leadership only after it has applied the entries committed before the transfer, so it can
+// serve reads as soon as it becomes the leader.
+func TestTransferLeaderWaitsForApply(t *testing.T) {
+	cluster, stores := newReplicatedCluster(t, 3)
+	defer cluster.Shutdown()
+
+	region := cluster.GetRegion([]byte(""))
+	for _, storeID := range stores[1:] {
+		cluster.MustAddPeer(region.GetId(), cluster.AllocPeer(storeID))
+	}
+	cluster.MustPut([]byte("k1"), []byte("v1"))
+	cluster.MustGetOnStore(stores[1], []byte("k1"), []byte("v1"))
+	transferee := findPeerOnStore(cluster.GetRegionByID(region.GetId()), stores[1])
+
+	cluster.PauseApply(stores[1], region.GetId())
+	cluster.MustPut([]byte("k2"), []byte("v2"))
+	cluster.TransferLeader(region.GetId(), transferee)
+	time.Sleep(500 * time.Millisecond)
+	if leader := cluster.GetLeader(region.GetId()); leader.GetId() == transferee.GetId() {
+		t.Fatalf("leader is transferred to %v before it applies the committed entries", transferee)
+	}
+
+	cluster.ResumeApply(stores[1], region.GetId())
+	cluster.MustTransferLeader(region.GetId(), transferee)
+	cluster.MustGetOnStore(stores[1], []byte("k2"), []byte("v2"))
+	cluster.MustPut([]byte("k3"), []byte("v3"))
+	cluster.MustGet([]byte("k3"), []byte("v3"))
+}
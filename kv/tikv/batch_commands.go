@@ -0,0 +1,196 @@
+package tikv
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tikvpb"
+	"github.com/pingcap/errors"
+)
+
+const (
+	// batchCommandsMaxResponses caps the responses sent in one BatchCommandsResponse.
+	batchCommandsMaxResponses = 128
+	// batchCommandsConcurrency caps the requests of a stream handled at the same time, the stream isn't received from
+	// while they're all busy.
+	batchCommandsConcurrency = 128
+)
+
+type batchCommand struct {
+	id  uint64
+	cmd *tikvpb.BatchCommand
+}
+
+type batchCommandResult struct {
+	id   uint64
+	resp *tikvpb.BatchCommandResponse
+	err  error
+}
+
+// BatchCommands runs the requests received on the stream concurrently on their unary handlers, by a pool of
+// batchCommandsConcurrency workers, and sends back the responses as the requests complete, the ones completed meanwhile
+// in the same batch. Like a failed unary call, a request failing with an error ends the stream. Once the client closes
+// its side, the stream ends after the pending requests respond.
+func (svr *Server) BatchCommands(stream tikvpb.Tikv_BatchCommandsServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	cmds := make(chan batchCommand)
+	results := make(chan batchCommandResult, batchCommandsMaxResponses)
+	var wg sync.WaitGroup
+	for i := 0; i < batchCommandsConcurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for cmd := range cmds {
+				resp, err := svr.handleBatchCommand(ctx, cmd.cmd)
+				select {
+				case results <- batchCommandResult{id: cmd.id, resp: resp, err: err}:
+				case <-ctx.Done():
+				}
+			}
+		}()
+	}
+	recvErr := make(chan error, 1)
+	go func() {
+		recvErr <- svr.recvBatchCommands(ctx, stream, cmds)
+		close(cmds)
+		wg.Wait()
+		close(results)
+	}()
+
+	for result := range results {
+		batch := new(tikvpb.BatchCommandsResponse)
+		more := true
+		for more {
+			if result.err != nil {
+				return result.err
+			}
+			batch.Responses = append(batch.Responses, result.resp)
+			batch.RequestIds = append(batch.RequestIds, result.id)
+			if len(batch.Responses) >= batchCommandsMaxResponses {
+				break
+			}
+			select {
+			case result, more = <-results:
+			default:
+				more = false
+			}
+		}
+		if err := stream.Send(batch); err != nil {
+			return err
+		}
+	}
+	return <-recvErr
+}
+
+// recvBatchCommands receives the requests until the client closes its side of the stream, and hands them to the
+// workers through cmds.
+func (svr *Server) recvBatchCommands(ctx context.Context, stream tikvpb.Tikv_BatchCommandsServer,
+	cmds chan<- batchCommand) error {
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(req.Requests) != len(req.RequestIds) {
+			return errors.Errorf("%d requests with %d request ids", len(req.Requests), len(req.RequestIds))
+		}
+		for i, cmd := range req.Requests {
+			select {
+			case cmds <- batchCommand{id: req.RequestIds[i], cmd: cmd}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// handleBatchCommand runs a request of a BatchCommands stream on its unary handler, and observes its duration under
+// the name of the unary method, like the unary requests.
+func (svr *Server) handleBatchCommand(ctx context.Context, cmd *tikvpb.BatchCommand) (*tikvpb.BatchCommandResponse, error) {
+	start := time.Now()
+	resp := new(tikvpb.BatchCommandResponse)
+	var method string
+	var req interface{}
+	var err error
+	switch {
+	case cmd.Get != nil:
+		method, req = "KvGet", cmd.Get
+		resp.Get, err = svr.KvGet(ctx, cmd.Get)
+	case cmd.Scan != nil:
+		method, req = "KvScan", cmd.Scan
+		resp.Scan, err = svr.KvScan(ctx, cmd.Scan)
+	case cmd.Prewrite != nil:
+		method, req = "KvPrewrite", cmd.Prewrite
+		resp.Prewrite, err = svr.KvPrewrite(ctx, cmd.Prewrite)
+	case cmd.Commit != nil:
+		method, req = "KvCommit", cmd.Commit
+		resp.Commit, err = svr.KvCommit(ctx, cmd.Commit)
+	case cmd.CheckTxnStatus != nil:
+		method, req = "KvCheckTxnStatus", cmd.CheckTxnStatus
+		resp.CheckTxnStatus, err = svr.KvCheckTxnStatus(ctx, cmd.CheckTxnStatus)
+	case cmd.TxnHeartBeat != nil:
+		method, req = "KvTxnHeartBeat", cmd.TxnHeartBeat
+		resp.TxnHeartBeat, err = svr.KvTxnHeartBeat(ctx, cmd.TxnHeartBeat)
+	case cmd.Cleanup != nil:
+		method, req = "KvCleanup", cmd.Cleanup
+		resp.Cleanup, err = svr.KvCleanup(ctx, cmd.Cleanup)
+	case cmd.BatchGet != nil:
+		method, req = "KvBatchGet", cmd.BatchGet
+		resp.BatchGet, err = svr.KvBatchGet(ctx, cmd.BatchGet)
+	case cmd.CheckConflicts != nil:
+		method, req = "KvCheckConflicts", cmd.CheckConflicts
+		resp.CheckConflicts, err = svr.KvCheckConflicts(ctx, cmd.CheckConflicts)
+	case cmd.GetCommitTs != nil:
+		method, req = "KvGetCommitTs", cmd.GetCommitTs
+		resp.GetCommitTs, err = svr.KvGetCommitTs(ctx, cmd.GetCommitTs)
+	case cmd.BatchRollback != nil:
+		method, req = "KvBatchRollback", cmd.BatchRollback
+		resp.BatchRollback, err = svr.KvBatchRollback(ctx, cmd.BatchRollback)
+	case cmd.ScanLock != nil:
+		method, req = "KvScanLock", cmd.ScanLock
+		resp.ScanLock, err = svr.KvScanLock(ctx, cmd.ScanLock)
+	case cmd.ResolveLock != nil:
+		method, req = "KvResolveLock", cmd.ResolveLock
+		resp.ResolveLock, err = svr.KvResolveLock(ctx, cmd.ResolveLock)
+	case cmd.GC != nil:
+		method, req = "KvGC", cmd.GC
+		resp.GC, err = svr.KvGC(ctx, cmd.GC)
+	case cmd.DeleteRange != nil:
+		method, req = "KvDeleteRange", cmd.DeleteRange
+		resp.DeleteRange, err = svr.KvDeleteRange(ctx, cmd.DeleteRange)
+	case cmd.RawGet != nil:
+		method, req = "RawGet", cmd.RawGet
+		resp.RawGet, err = svr.RawGet(ctx, cmd.RawGet)
+	case cmd.RawPut != nil:
+		method, req = "RawPut", cmd.RawPut
+		resp.RawPut, err = svr.RawPut(ctx, cmd.RawPut)
+	case cmd.RawDelete != nil:
+		method, req = "RawDelete", cmd.RawDelete
+		resp.RawDelete, err = svr.RawDelete(ctx, cmd.RawDelete)
+	case cmd.RawScan != nil:
+		method, req = "RawScan", cmd.RawScan
+		resp.RawScan, err = svr.RawScan(ctx, cmd.RawScan)
+	case cmd.RawBatchGet != nil:
+		method, req = "RawBatchGet", cmd.RawBatchGet
+		resp.RawBatchGet, err = svr.RawBatchGet(ctx, cmd.RawBatchGet)
+	case cmd.RawBatchPut != nil:
+		method, req = "RawBatchPut", cmd.RawBatchPut
+		resp.RawBatchPut, err = svr.RawBatchPut(ctx, cmd.RawBatchPut)
+	case cmd.RawBatchDelete != nil:
+		method, req = "RawBatchDelete", cmd.RawBatchDelete
+		resp.RawBatchDelete, err = svr.RawBatchDelete(ctx, cmd.RawBatchDelete)
+	case cmd.Coprocessor != nil:
+		method, req = "Coprocessor", cmd.Coprocessor
+		resp.Coprocessor, err = svr.Coprocessor(ctx, cmd.Coprocessor)
+	default:
+		return nil, errors.New("batch command without a request")
+	}
+	observeRequest(method, req, start)
+	return resp, err
+}
@@ -10,6 +10,7 @@ import (
 const (
 	KB          uint64 = 1024
 	MB          uint64 = 1024 * 1024
+	GB          uint64 = 1024 * 1024 * 1024
 	SplitSizeMb uint64 = 96
 )
 
@@ -29,6 +30,11 @@ type Config struct {
 	RaftMaxElectionTimeoutTicks int
 	RaftMaxSizePerMsg           uint64
 	RaftMaxInflightMsgs         int
+	// When enabled, a follower that has heard from the leader within the election timeout ignores
+	// the vote requests of normal campaigns, vote requests of leader transfers are still granted.
+	RaftLeaderStickiness bool
+	// A peer that has just transferred its leadership away doesn't campaign on its own for this many ticks.
+	RaftCampaignHoldTicks int
 
 	// When the entry exceed the max size, reject to propose it.
 	RaftEntryMaxSize uint64
@@ -52,6 +58,11 @@ type Config struct {
 	/// When size change of region exceed the diff since last check, it
 	/// will be checked again whether it should be split.
 	RegionSplitCheckDiff uint64
+	// The region statistics are maintained from the applied writes, and reconciled by a scan of the region at most
+	// once every RegionStatsReconcileInterval, or whenever the split check scans the region.
+	RegionStatsReconcileInterval time.Duration
+	// Interval to check whether a merging region can commit the merge into its target region.
+	MergeCheckTickInterval time.Duration
 	// delay time before deleting a stale peer
 	PdHeartbeatTickInterval      time.Duration
 	PdStoreHeartbeatTickInterval time.Duration
@@ -81,23 +92,76 @@ type Config struct {
 
 	AllowRemoveLeader bool
 
+	// When a follower receives a write, forward the proposal to the current
+	// leader through raft instead of returning NotLeader to the client.
+	ForwardProposalToLeader bool
+
+	// Serve all the reads as quorum reads, which any peer can serve without
+	// the leader, see kvrpcpb.Context.QuorumRead. A quorum read fails if the
+	// peers don't answer and the local peer doesn't catch up in
+	// QuorumReadTimeout.
+	QuorumRead        bool
+	QuorumReadTimeout time.Duration
+
+	// When a region splits, the right-most new region keeps the id and the
+	// peers of the original region if true, otherwise the left-most one does.
+	RightDeriveWhenSplit bool
+
+	// A command tagged with a uuid is applied at most once among the last
+	// AppliedCmdWindow log entries of a region, a duplicate gets the result of
+	// the first one. 0 disables the check. The window decides which commands
+	// are applied, so it must be the same on all the stores.
+	AppliedCmdWindow uint64
+
 	ApplyMaxBatchSize uint64
 	ApplyPoolSize     uint64
 
 	StoreMaxBatchSize uint64
 	RaftWorkerCnt     int
 
+	// The store is busy when more than StoreBusyRaftMsgs messages are queued for the raft workers, or more than
+	// StoreBusyApplyMsgs for the apply workers, 0 disables the check. A busy store tells the scheduler and the leaders
+	// of its peers to back off for StoreBusyBackoff, so that they stop adding replicas to it and slow down replicating
+	// to it instead of timing out.
+	StoreBusyRaftMsgs  uint64
+	StoreBusyApplyMsgs uint64
+	StoreBusyBackoff   time.Duration
+	// The store is write stalled when the kv engine has at least StoreWriteStallL0Tables level 0 tables, 0 disables
+	// the check. Badger stops the writes once the compactions fall behind by NumL0TablesStall tables, so it shouldn't
+	// be larger than that. A write stalled store is busy, and it rejects the write proposals with ServerIsBusy.
+	StoreWriteStallL0Tables     int
+	WriteStallCheckTickInterval time.Duration
+	// The store is disk full when less than ReserveSpace bytes of the disk are free, 0 disables the check. A disk full
+	// store rejects the write proposals with ServerIsBusy, but keeps serving the reads and voting in the raft groups,
+	// and reports itself busy to the scheduler, so that the replicas are moved away before the engine runs out of
+	// space in the middle of a compaction.
+	ReserveSpace uint64
+	// A peer has at most PeerMailboxCapacity messages queued for its raft worker, 0 disables the limit. Once the
+	// mailbox of a peer is full, the ticks and the housekeeping messages sent to it are dropped and the proposals are
+	// rejected with ServerIsBusy, only the raft messages are still queued, so that a stuck peer flooded with messages
+	// doesn't take up the queue its raft worker shares with the other peers.
+	PeerMailboxCapacity uint64
+
+	// Only the RegionMetricsTopN most active regions are exported with their own region label in the per-region raft
+	// metrics, the others are summed up, so that the number of series doesn't grow with the number of regions.
+	RegionMetricsTopN int
+
 	ConcurrentSendSnapLimit uint64
 	ConcurrentRecvSnapLimit uint64
+	// The snapshots not larger than SnapInlineMaxSize are sent inline with the raft message over the BatchRaft stream,
+	// which saves the round trips to set up a Snapshot stream. 0 disables it.
+	SnapInlineMaxSize uint64
 
 	GrpcInitialWindowSize uint64
 	GrpcKeepAliveTime     time.Duration
 	GrpcKeepAliveTimeout  time.Duration
-	GrpcRaftConnNum       uint64
 
 	Addr          string
 	AdvertiseAddr string
 	Labels        []StoreLabel
+	// The ID of the cluster, learned from the scheduler when the store starts. It's attached to the requests sent to
+	// the other stores, which reject the requests of another cluster.
+	ClusterID uint64
 
 	SplitCheck *SplitCheckConfig
 }
@@ -112,8 +176,25 @@ type SplitCheckConfig struct {
 	// [b,c), [c,d) will be regionSplitSize (maybe a little larger).
 	RegionMaxSize   uint64
 	RegionSplitSize uint64
+
+	// Like RegionMaxSize and RegionSplitSize, by the number of keys.
+	RegionMaxKeys   uint64
+	RegionSplitKeys uint64
+
+	// The policies looking for the split keys of a region, see the SplitPolicy constants. The region is split at the
+	// keys of the first policy in the list which finds any.
+	Policies []string
 }
 
+const (
+	// SplitPolicySize splits a region whose size exceeds RegionMaxSize into regions of RegionSplitSize.
+	SplitPolicySize = "size"
+	// SplitPolicyKeys splits a region whose number of keys exceeds RegionMaxKeys into regions of RegionSplitKeys.
+	SplitPolicyKeys = "keys"
+	// SplitPolicyTable splits a region at the boundaries of the tables in it, so that no region spans two tables.
+	SplitPolicyTable = "table"
+)
+
 type StoreLabel struct {
 	LabelKey, LabelValue string
 }
@@ -141,6 +222,8 @@ func NewDefaultConfig() *Config {
 		RaftRejectTransferLeaderDuration: 3 * time.Second,
 		SplitRegionCheckTickInterval:     10 * time.Second,
 		RegionSplitCheckDiff:             splitSize / 8,
+		RegionStatsReconcileInterval:     30 * time.Minute,
+		MergeCheckTickInterval:           2 * time.Second,
 		PdHeartbeatTickInterval:          20 * time.Second,
 		PdStoreHeartbeatTickInterval:     10 * time.Second,
 		NotifyCapacity:                   40960,
@@ -154,20 +237,33 @@ func NewDefaultConfig() *Config {
 		LeaderTransferMaxLogLag:          10,
 		// Disable consistency check by default as it will hurt performance.
 		// We should turn on this only in our tests.
-		RaftStoreMaxLeaderLease: 9 * time.Second,
-		AllowRemoveLeader:       false,
-		ApplyMaxBatchSize:       1024,
-		ApplyPoolSize:           2,
-		StoreMaxBatchSize:       1024,
-		RaftWorkerCnt:           2,
-		ConcurrentSendSnapLimit: 32,
-		ConcurrentRecvSnapLimit: 32,
-		GrpcInitialWindowSize:   2 * 1024 * 1024,
-		GrpcKeepAliveTime:       3 * time.Second,
-		GrpcKeepAliveTimeout:    60 * time.Second,
-		GrpcRaftConnNum:         1,
-		Addr:                    "127.0.0.1:20160",
-		SplitCheck:              NewDefaultSplitCheckConfig(),
+		RaftStoreMaxLeaderLease:     9 * time.Second,
+		AllowRemoveLeader:           false,
+		ForwardProposalToLeader:     false,
+		QuorumRead:                  false,
+		QuorumReadTimeout:           3 * time.Second,
+		RightDeriveWhenSplit:        true,
+		AppliedCmdWindow:            1024,
+		ApplyMaxBatchSize:           1024,
+		ApplyPoolSize:               2,
+		StoreMaxBatchSize:           1024,
+		RaftWorkerCnt:               2,
+		StoreBusyRaftMsgs:           2048,
+		StoreBusyApplyMsgs:          8192,
+		StoreBusyBackoff:            3 * time.Second,
+		StoreWriteStallL0Tables:     8,
+		WriteStallCheckTickInterval: 1 * time.Second,
+		ReserveSpace:                1 * GB,
+		PeerMailboxCapacity:         1024,
+		RegionMetricsTopN:           20,
+		ConcurrentSendSnapLimit:     32,
+		ConcurrentRecvSnapLimit:     32,
+		SnapInlineMaxSize:           256 * KB,
+		GrpcInitialWindowSize:       2 * 1024 * 1024,
+		GrpcKeepAliveTime:           3 * time.Second,
+		GrpcKeepAliveTimeout:        60 * time.Second,
+		Addr:                        "127.0.0.1:20160",
+		SplitCheck:                  NewDefaultSplitCheckConfig(),
 	}
 }
 
@@ -186,6 +282,9 @@ func NewDefaultSplitCheckConfig() *SplitCheckConfig {
 		BatchSplitLimit: batchSplitLimit,
 		RegionSplitSize: splitSize,
 		RegionMaxSize:   splitSize / 2 * 3,
+		RegionSplitKeys: splitKeys,
+		RegionMaxKeys:   splitKeys / 2 * 3,
+		Policies:        []string{SplitPolicySize},
 	}
 }
 
@@ -217,6 +316,15 @@ func (c *Config) Validate() error {
 			c.RaftMinElectionTimeoutTicks, c.RaftMaxElectionTimeoutTicks, c.RaftElectionTimeoutTicks)
 	}
 
+	if c.RaftCampaignHoldTicks < 0 {
+		return fmt.Errorf("raft campaign hold ticks must >= 0, not %v", c.RaftCampaignHoldTicks)
+	}
+
+	// An inline snapshot must fit in a BatchRaftMessage.
+	if c.SnapInlineMaxSize > 4*MB {
+		return fmt.Errorf("snap inline max size must <= 4MB, not %v", c.SnapInlineMaxSize)
+	}
+
 	if c.RaftLogGcThreshold < 1 {
 		return fmt.Errorf("raft log gc threshold must >= 1, not %v", c.RaftLogGcThreshold)
 	}
@@ -261,5 +369,30 @@ func (c *Config) Validate() error {
 	if c.StoreMaxBatchSize == 0 {
 		return fmt.Errorf("store-max-batch-size should be greater than 0")
 	}
+	return c.SplitCheck.Validate()
+}
+
+func (c *SplitCheckConfig) Validate() error {
+	if len(c.Policies) == 0 {
+		return fmt.Errorf("no split policy")
+	}
+	for _, policy := range c.Policies {
+		switch policy {
+		case SplitPolicySize:
+			if c.RegionSplitSize == 0 || c.RegionMaxSize < c.RegionSplitSize {
+				return fmt.Errorf("region max size %v is less than region split size %v", c.RegionMaxSize, c.RegionSplitSize)
+			}
+		case SplitPolicyKeys:
+			if c.RegionSplitKeys == 0 || c.RegionMaxKeys < c.RegionSplitKeys {
+				return fmt.Errorf("region max keys %v is less than region split keys %v", c.RegionMaxKeys, c.RegionSplitKeys)
+			}
+		case SplitPolicyTable:
+		default:
+			return fmt.Errorf("unknown split policy %q", policy)
+		}
+	}
+	if c.BatchSplitLimit == 0 {
+		return fmt.Errorf("batch split limit should be greater than 0")
+	}
 	return nil
 }
@@ -38,6 +38,14 @@ func TestConfigValidate(t *testing.T) {
 	cfg.RaftLogGcSizeLimit = 0
 	require.NotNil(t, cfg.Validate())
 
+	cfg = NewDefaultConfig()
+	cfg.RaftCampaignHoldTicks = -1
+	require.NotNil(t, cfg.Validate())
+
+	cfg = NewDefaultConfig()
+	cfg.SnapInlineMaxSize = 5 * MB
+	require.NotNil(t, cfg.Validate())
+
 	cfg = NewDefaultConfig()
 	cfg.RaftBaseTickInterval = 1 * time.Second
 	cfg.RaftElectionTimeoutTicks = 10
@@ -67,4 +75,17 @@ func TestConfigValidate(t *testing.T) {
 	cfg = NewDefaultConfig()
 	cfg.ApplyPoolSize = 0
 	require.NotNil(t, cfg.Validate())
+
+	cfg = NewDefaultConfig()
+	cfg.SplitCheck.Policies = []string{SplitPolicyTable, SplitPolicyKeys, SplitPolicySize}
+	require.Nil(t, cfg.Validate())
+	cfg.SplitCheck.Policies = []string{"rows"}
+	require.NotNil(t, cfg.Validate())
+	cfg.SplitCheck.Policies = nil
+	require.NotNil(t, cfg.Validate())
+
+	cfg = NewDefaultConfig()
+	cfg.SplitCheck.Policies = []string{SplitPolicyKeys}
+	cfg.SplitCheck.RegionMaxKeys = cfg.SplitCheck.RegionSplitKeys - 1
+	require.NotNil(t, cfg.Validate())
 }
@@ -0,0 +1,229 @@
+// Package coprocessor evaluates the DAG requests TiDB pushes down to the store. Only the simple requests are
+// supported: a table scan, filtered by selections, and optionally ended by an aggregation of COUNT and SUM, a TopN or
+// a limit. The rows are read with the same MVCC rules as KvScan, so the result reflects the snapshot at the start ts
+// of the request, and a lock which may be committed before it fails the request with the lock.
+package coprocessor
+
+import (
+	"math"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/commands"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/kvstore"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/coprocessor"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/codec"
+	"github.com/pingcap/tipb/go-tipb"
+)
+
+// ReqTypeDAG is the coprocessor request type TiDB uses for DAG requests.
+const ReqTypeDAG = 103
+
+// rowsPerChunk is the number of rows encoded in each chunk of the response.
+const rowsPerChunk = 64
+
+// DAG implements the Command interface for coprocessor DAG requests.
+type DAG struct {
+	request  *coprocessor.Request
+	response coprocessor.Response
+}
+
+func NewDAG(request *coprocessor.Request) DAG {
+	return DAG{request, coprocessor.Response{}}
+}
+
+func (d *DAG) BuildTxn(txn *kvstore.Txn) error {
+	var req tipb.DAGRequest
+	if err := req.Unmarshal(d.request.Data); err != nil {
+		return err
+	}
+	sc := &stmtctx.StatementContext{TimeZone: timeZone(&req)}
+	execs, err := buildExecutors(sc, req.Executors)
+	if err != nil {
+		d.response.OtherError = err.Error()
+		return nil
+	}
+
+	startTS := d.request.StartTs
+	if startTS == 0 {
+		startTS = req.StartTs
+	}
+	for _, r := range d.request.Ranges {
+		more, err := d.scanRange(txn, execs, r, startTS)
+		if err != nil {
+			return err
+		}
+		if d.response.Locked != nil {
+			return nil
+		}
+		if !more {
+			break
+		}
+	}
+
+	rows, err := execs.coll.rows()
+	if err != nil {
+		return err
+	}
+	resp, err := encodeRows(sc, rows, req.OutputOffsets)
+	if err != nil {
+		return err
+	}
+	d.response.Data, err = resp.Marshal()
+	return err
+}
+
+// executors are the executors of a DAG request, the rows read by the table scan flow through the selections into the
+// collector.
+type executors struct {
+	sc         *stmtctx.StatementContext
+	decoder    *rowDecoder
+	selections []expr
+	coll       collector
+}
+
+// scanRange feeds the rows of r passing the selections to the collector, and returns whether the collector takes more
+// rows. It stops at the first lock blocking the read, and sets it in the response.
+func (d *DAG) scanRange(txn *kvstore.Txn, execs *executors, r *coprocessor.KeyRange, startTS uint64) (bool, error) {
+	more := true
+	var err error
+	scan := commands.NewScan(&kvrpcpb.ScanRequest{StartKey: r.Start, EndKey: r.End, Version: startTS})
+	scanErr := scan.Each(txn, func(pair *kvrpcpb.KvPair) bool {
+		if pair.Error != nil {
+			d.response.Locked = pair.Error.Locked
+			return false
+		}
+		var row []types.Datum
+		if row, err = execs.decoder.decode(pair.Key, pair.Value); err != nil {
+			return false
+		}
+		for _, cond := range execs.selections {
+			var ok bool
+			if ok, err = isTrue(execs.sc, cond, row); err != nil || !ok {
+				return err == nil
+			}
+		}
+		more, err = execs.coll.add(row)
+		return more && err == nil
+	})
+	if scanErr != nil {
+		return false, scanErr
+	}
+	return more, err
+}
+
+// buildExecutors checks the executors of a request are supported, and builds them. The collector of a request
+// without an aggregation, a TopN or a limit keeps all the rows.
+func buildExecutors(sc *stmtctx.StatementContext, pbs []*tipb.Executor) (*executors, error) {
+	if len(pbs) == 0 || pbs[0].Tp != tipb.ExecType_TypeTableScan {
+		return nil, errors.New("the first executor must be a table scan")
+	}
+	if pbs[0].TblScan.Desc {
+		return nil, errors.New("descending table scan is not supported")
+	}
+	decoder, err := newRowDecoder(pbs[0].TblScan.Columns, sc.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	execs := &executors{sc: sc, decoder: decoder}
+	for i, pb := range pbs[1:] {
+		switch pb.Tp {
+		case tipb.ExecType_TypeSelection:
+			for _, cond := range pb.Selection.Conditions {
+				e, err := newExpr(cond)
+				if err != nil {
+					return nil, err
+				}
+				execs.selections = append(execs.selections, e)
+			}
+			continue
+		case tipb.ExecType_TypeAggregation:
+			execs.coll, err = newAggCollector(sc, pb.Aggregation)
+		case tipb.ExecType_TypeStreamAgg:
+			execs.coll, err = newAggCollector(sc, pb.StreamAgg)
+		case tipb.ExecType_TypeTopN:
+			execs.coll, err = newTopNCollector(sc, pb.TopN)
+		case tipb.ExecType_TypeLimit:
+			execs.coll = &limitCollector{limit: pb.Limit.Limit}
+		default:
+			return nil, errors.Errorf("unsupported executor %v", pb.Tp)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if i != len(pbs)-2 {
+			return nil, errors.Errorf("executor %v must be the last one", pb.Tp)
+		}
+		return execs, nil
+	}
+	execs.coll = &limitCollector{limit: math.MaxUint64}
+	return execs, nil
+}
+
+// isTrue returns whether the condition holds for row, a null result doesn't.
+func isTrue(sc *stmtctx.StatementContext, cond expr, row []types.Datum) (bool, error) {
+	v, err := cond.eval(sc, row)
+	if err != nil || v.IsNull() {
+		return false, err
+	}
+	b, err := v.ToBool(sc)
+	return b != 0, err
+}
+
+func timeZone(req *tipb.DAGRequest) *time.Location {
+	if req.TimeZoneName != "" {
+		if loc, err := time.LoadLocation(req.TimeZoneName); err == nil {
+			return loc
+		}
+	}
+	return time.FixedZone("", int(req.TimeZoneOffset))
+}
+
+// encodeRows encodes the columns at the output offsets of rows, all the columns if there are no offsets, into the
+// chunks of a response.
+func encodeRows(sc *stmtctx.StatementContext, rows [][]types.Datum, offsets []uint32) (*tipb.SelectResponse, error) {
+	resp := new(tipb.SelectResponse)
+	var chunk *tipb.Chunk
+	for i, row := range rows {
+		if i%rowsPerChunk == 0 {
+			resp.Chunks = append(resp.Chunks, tipb.Chunk{})
+			chunk = &resp.Chunks[len(resp.Chunks)-1]
+		}
+		output := row
+		if len(offsets) > 0 {
+			output = make([]types.Datum, len(offsets))
+			for j, offset := range offsets {
+				if int(offset) >= len(row) {
+					return nil, errors.Errorf("output offset %d out of range of %d columns", offset, len(row))
+				}
+				output[j] = row[offset]
+			}
+		}
+		var err error
+		if chunk.RowsData, err = codec.EncodeValue(sc, chunk.RowsData, output...); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (d *DAG) Context() *kvrpcpb.Context {
+	return d.request.Context
+}
+
+func (d *DAG) Response() (interface{}, error) {
+	return &d.response, nil
+}
+
+func (d *DAG) RegionError(err *errorpb.Error) interface{} {
+	if err == nil {
+		return nil
+	}
+
+	d.response.RegionError = err
+	return &d.response
+}
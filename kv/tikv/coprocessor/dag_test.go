@@ -0,0 +1,169 @@
+package coprocessor
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/coocood/badger"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/rowcodec"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/dbreader"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/kvstore"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/coprocessor"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap/parser/mysql"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/codec"
+	"github.com/pingcap/tipb/go-tipb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDAG(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tinykv_dag")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	opts := badger.DefaultOptions
+	opts.Dir = dir
+	opts.ValueDir = dir
+	db, err := badger.Open(opts)
+	require.Nil(t, err)
+	defer db.Close()
+
+	// Table 1 has the integer primary key id, and the columns a and b. The odd rows are written in the old format, the
+	// even ones in the new format.
+	const tableID = 1
+	sc := new(stmtctx.StatementContext)
+	wb := new(engine_util.WriteBatch)
+	rows := []struct {
+		a int64
+		b string
+	}{{5, "x"}, {1, "y"}, {4, "x"}, {2, "y"}, {3, "x"}}
+	for i, r := range rows {
+		handle := int64(i + 1)
+		values := types.MakeDatums(r.a, r.b)
+		var value []byte
+		if handle%2 == 1 {
+			value, err = tablecodec.EncodeRow(sc, values, []int64{2, 3}, nil, nil)
+		} else {
+			var encoder rowcodec.Encoder
+			value, err = encoder.Encode([]int64{2, 3}, values, nil)
+		}
+		require.Nil(t, err)
+		key := tablecodec.EncodeRowKeyWithHandle(tableID, handle)
+		wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey(key, 20), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, 10, value))
+	}
+	require.Nil(t, wb.WriteToDB(db))
+
+	columns := []*tipb.ColumnInfo{
+		{ColumnId: 1, Tp: int32(mysql.TypeLonglong), PkHandle: true},
+		{ColumnId: 2, Tp: int32(mysql.TypeLonglong)},
+		{ColumnId: 3, Tp: int32(mysql.TypeVarchar)},
+	}
+	scan := &tipb.Executor{Tp: tipb.ExecType_TypeTableScan, TblScan: &tipb.TableScan{TableId: tableID, Columns: columns}}
+	column := func(offset int64) *tipb.Expr {
+		return &tipb.Expr{Tp: tipb.ExprType_ColumnRef, Val: codec.EncodeInt(nil, offset)}
+	}
+	// a > 1
+	aGreaterThan1 := &tipb.Executor{Tp: tipb.ExecType_TypeSelection, Selection: &tipb.Selection{Conditions: []*tipb.Expr{{
+		Tp:       tipb.ExprType_ScalarFunc,
+		Sig:      tipb.ScalarFuncSig_GTInt,
+		Children: []*tipb.Expr{column(1), {Tp: tipb.ExprType_Int64, Val: codec.EncodeInt(nil, 1)}},
+	}}}}
+	run := func(startTS uint64, offsets []uint32, executors ...*tipb.Executor) (*coprocessor.Response, [][]types.Datum) {
+		data, err := (&tipb.DAGRequest{Executors: executors, OutputOffsets: offsets}).Marshal()
+		require.Nil(t, err)
+		badgerTxn := db.NewTransaction(false)
+		defer badgerTxn.Discard()
+		reader := dbreader.NewRegionReader(badgerTxn, metapb.Region{})
+		txn := kvstore.NewTxn(reader)
+		cmd := NewDAG(&coprocessor.Request{
+			Tp:      ReqTypeDAG,
+			Data:    data,
+			StartTs: startTS,
+			Ranges:  []*coprocessor.KeyRange{{Start: tablecodec.GenTableRecordPrefix(tableID), End: tablecodec.GenTableRecordPrefix(tableID + 1)}},
+		})
+		require.Nil(t, cmd.BuildTxn(&txn))
+		resp, _ := cmd.Response()
+		copResp := resp.(*coprocessor.Response)
+		if copResp.OtherError != "" || copResp.Locked != nil {
+			return copResp, nil
+		}
+		var sel tipb.SelectResponse
+		require.Nil(t, sel.Unmarshal(copResp.Data))
+		width := len(offsets)
+		var rows [][]types.Datum
+		for _, chunk := range sel.Chunks {
+			datums, err := codec.Decode(chunk.RowsData, 0)
+			require.Nil(t, err)
+			for len(datums) > 0 {
+				rows = append(rows, datums[:width])
+				datums = datums[width:]
+			}
+		}
+		return copResp, rows
+	}
+
+	// Selection, with the rows in the order of handles.
+	_, result := run(30, []uint32{0, 1}, scan, aGreaterThan1)
+	assert.Equal(t, [][]types.Datum{
+		types.MakeDatums(1, 5), types.MakeDatums(3, 4), types.MakeDatums(4, 2), types.MakeDatums(5, 3),
+	}, result)
+
+	// Nothing is visible before the rows are committed.
+	_, result = run(15, []uint32{0}, scan)
+	assert.Empty(t, result)
+
+	// COUNT(*) and SUM(a) over the selection.
+	agg := &tipb.Executor{Tp: tipb.ExecType_TypeAggregation, Aggregation: &tipb.Aggregation{AggFunc: []*tipb.Expr{
+		{Tp: tipb.ExprType_Count},
+		{Tp: tipb.ExprType_Sum, Children: []*tipb.Expr{column(1)}},
+	}}}
+	_, result = run(30, []uint32{0, 1}, scan, aGreaterThan1, agg)
+	require.Len(t, result, 1)
+	assert.Equal(t, int64(4), result[0][0].GetInt64())
+	assert.Equal(t, "14", result[0][1].GetMysqlDecimal().String())
+
+	// COUNT(a) grouped by b, the group by values follow the counts.
+	agg = &tipb.Executor{Tp: tipb.ExecType_TypeAggregation, Aggregation: &tipb.Aggregation{
+		GroupBy: []*tipb.Expr{column(2)},
+		AggFunc: []*tipb.Expr{{Tp: tipb.ExprType_Count, Children: []*tipb.Expr{column(1)}}},
+	}}
+	_, result = run(30, []uint32{0, 1}, scan, agg)
+	assert.Equal(t, [][]types.Datum{types.MakeDatums(3, []byte("x")), types.MakeDatums(2, []byte("y"))}, result)
+
+	// TopN of a, descending.
+	topN := &tipb.Executor{Tp: tipb.ExecType_TypeTopN, TopN: &tipb.TopN{
+		OrderBy: []*tipb.ByItem{{Expr: column(1), Desc: true}},
+		Limit:   3,
+	}}
+	_, result = run(30, []uint32{1}, scan, topN)
+	assert.Equal(t, [][]types.Datum{types.MakeDatums(5), types.MakeDatums(4), types.MakeDatums(3)}, result)
+
+	// Limit.
+	limit := &tipb.Executor{Tp: tipb.ExecType_TypeLimit, Limit: &tipb.Limit{Limit: 2}}
+	_, result = run(30, []uint32{0}, scan, aGreaterThan1, limit)
+	assert.Equal(t, [][]types.Datum{types.MakeDatums(1), types.MakeDatums(3)}, result)
+
+	// Unsupported requests are rejected.
+	resp, _ := run(30, nil, &tipb.Executor{Tp: tipb.ExecType_TypeIndexScan})
+	assert.NotEmpty(t, resp.OtherError)
+	resp, _ = run(30, nil, scan, limit, aGreaterThan1)
+	assert.NotEmpty(t, resp.OtherError)
+
+	// A lock which may commit before the start ts fails the request.
+	wb = new(engine_util.WriteBatch)
+	lockedKey := tablecodec.EncodeRowKeyWithHandle(tableID, 3)
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey(lockedKey), mvcc.EncodeLockCFValue(&mvcc.Lock{
+		Type: mvcc.LockTypePut, Primary: lockedKey, StartTS: 25, TTL: 100}))
+	require.Nil(t, wb.WriteToDB(db))
+	resp, _ = run(30, []uint32{0}, scan)
+	require.NotNil(t, resp.Locked)
+	assert.Equal(t, uint64(25), resp.Locked.LockVersion)
+	_, result = run(22, []uint32{0}, scan)
+	assert.Len(t, result, 5)
+}
@@ -0,0 +1,362 @@
+package coprocessor
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/rowcodec"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/parser/mysql"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/tablecodec"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/chunk"
+	"github.com/pingcap/tidb/util/codec"
+	"github.com/pingcap/tipb/go-tipb"
+)
+
+// extraHandleID is the column id TiDB uses for the handle of a table without an integer primary key.
+const extraHandleID = -1
+
+// rowDecoder decodes the records of a table scan into the datums of the scanned columns. Both the datum encoded rows
+// written by TiDB and the rows of the new format of rowcodec are understood.
+type rowDecoder struct {
+	columns []*tipb.ColumnInfo
+	fts     []*types.FieldType
+	loc     *time.Location
+	decoder *rowcodec.Decoder
+	// oldCols are the field types of the columns which are not the handle, by column id.
+	oldCols map[int64]*types.FieldType
+}
+
+func newRowDecoder(columns []*tipb.ColumnInfo, loc *time.Location) (*rowDecoder, error) {
+	d := &rowDecoder{
+		columns: columns,
+		fts:     make([]*types.FieldType, len(columns)),
+		loc:     loc,
+		oldCols: make(map[int64]*types.FieldType),
+	}
+	colIDs := make([]int64, len(columns))
+	defaults := make([][]byte, len(columns))
+	var handleColID int64 = extraHandleID
+	for i, col := range columns {
+		ft := types.NewFieldType(byte(col.Tp))
+		ft.Flag = uint(col.Flag)
+		ft.Flen = int(col.ColumnLen)
+		ft.Decimal = int(col.Decimal)
+		ft.Elems = col.Elems
+		d.fts[i] = ft
+		colIDs[i] = col.ColumnId
+		defaults[i] = col.DefaultVal
+		if col.PkHandle {
+			handleColID = col.ColumnId
+		} else if col.ColumnId != extraHandleID {
+			d.oldCols[col.ColumnId] = ft
+		}
+	}
+	var err error
+	if d.decoder, err = rowcodec.NewDecoder(colIDs, handleColID, d.fts, defaults, loc); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *rowDecoder) decode(key, value []byte) ([]types.Datum, error) {
+	handle, err := tablecodec.DecodeRowKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(value) > 0 && value[0] == rowcodec.CodecVer {
+		chk := chunk.NewChunkWithCapacity(d.fts, 1)
+		if err := d.decoder.Decode(value, handle, chk); err != nil {
+			return nil, err
+		}
+		return chk.GetRow(0).GetDatumRow(d.fts), nil
+	}
+
+	values, err := tablecodec.DecodeRow(value, d.oldCols, d.loc)
+	if err != nil {
+		return nil, err
+	}
+	row := make([]types.Datum, len(d.columns))
+	for i, col := range d.columns {
+		if col.PkHandle || col.ColumnId == extraHandleID {
+			if mysql.HasUnsignedFlag(uint(col.Flag)) {
+				row[i].SetUint64(uint64(handle))
+			} else {
+				row[i].SetInt64(handle)
+			}
+			continue
+		}
+		if v, ok := values[col.ColumnId]; ok {
+			row[i] = v
+			continue
+		}
+		// The column was added after the row was written.
+		if len(col.DefaultVal) > 0 {
+			if _, row[i], err = codec.DecodeOne(col.DefaultVal); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return row, nil
+}
+
+// collector is the last executor of a DAG request, it takes the rows passing the selections.
+type collector interface {
+	// add takes a row, it returns false once it needs no more rows.
+	add(row []types.Datum) (bool, error)
+	// rows returns the result.
+	rows() ([][]types.Datum, error)
+}
+
+// limitCollector keeps the first limit rows.
+type limitCollector struct {
+	limit  uint64
+	result [][]types.Datum
+}
+
+func (c *limitCollector) add(row []types.Datum) (bool, error) {
+	if uint64(len(c.result)) < c.limit {
+		c.result = append(c.result, row)
+	}
+	return uint64(len(c.result)) < c.limit, nil
+}
+
+func (c *limitCollector) rows() ([][]types.Datum, error) {
+	return c.result, nil
+}
+
+// topNCollector keeps the first limit rows in the order of the order by items. Instead of sorting all the rows, it
+// sorts whenever twice as many rows as the limit are buffered, and drops the ones beyond the limit.
+type topNCollector struct {
+	sc      *stmtctx.StatementContext
+	orderBy []expr
+	desc    []bool
+	limit   uint64
+	// keys are the values of the order by items of the rows, in the same order.
+	keys   [][]types.Datum
+	result [][]types.Datum
+}
+
+func newTopNCollector(sc *stmtctx.StatementContext, topN *tipb.TopN) (*topNCollector, error) {
+	c := &topNCollector{sc: sc, limit: topN.Limit}
+	for _, item := range topN.OrderBy {
+		e, err := newExpr(item.Expr)
+		if err != nil {
+			return nil, err
+		}
+		c.orderBy = append(c.orderBy, e)
+		c.desc = append(c.desc, item.Desc)
+	}
+	return c, nil
+}
+
+func (c *topNCollector) add(row []types.Datum) (bool, error) {
+	key := make([]types.Datum, len(c.orderBy))
+	for i, e := range c.orderBy {
+		v, err := e.eval(c.sc, row)
+		if err != nil {
+			return false, err
+		}
+		key[i] = v
+	}
+	c.keys = append(c.keys, key)
+	c.result = append(c.result, row)
+	if uint64(len(c.result)) >= 2*c.limit {
+		return true, c.truncate()
+	}
+	return true, nil
+}
+
+func (c *topNCollector) rows() ([][]types.Datum, error) {
+	return c.result, c.truncate()
+}
+
+// truncate sorts the buffered rows and keeps the first limit of them. Nulls sort first, like in MySQL.
+func (c *topNCollector) truncate() error {
+	var err error
+	sort.Stable(&sortedRows{c, func(e error) {
+		if err == nil {
+			err = e
+		}
+	}})
+	if err != nil {
+		return err
+	}
+	if uint64(len(c.result)) > c.limit {
+		c.keys, c.result = c.keys[:c.limit], c.result[:c.limit]
+	}
+	return nil
+}
+
+// sortedRows sorts the buffered rows of a topNCollector, the first error of a comparison is passed to onErr.
+type sortedRows struct {
+	c     *topNCollector
+	onErr func(error)
+}
+
+func (s *sortedRows) Len() int {
+	return len(s.c.result)
+}
+
+func (s *sortedRows) Less(i, j int) bool {
+	for k := range s.c.orderBy {
+		res, err := s.c.keys[i][k].CompareDatum(s.c.sc, &s.c.keys[j][k])
+		if err != nil {
+			s.onErr(err)
+			return false
+		}
+		if s.c.desc[k] {
+			res = -res
+		}
+		if res != 0 {
+			return res < 0
+		}
+	}
+	return false
+}
+
+func (s *sortedRows) Swap(i, j int) {
+	s.c.keys[i], s.c.keys[j] = s.c.keys[j], s.c.keys[i]
+	s.c.result[i], s.c.result[j] = s.c.result[j], s.c.result[i]
+}
+
+// aggCollector computes the partial results of COUNT and SUM for each group. A result row holds the result of every
+// aggregate function followed by the values of the group by items, in the layout TiDB merges the partial results.
+type aggCollector struct {
+	sc      *stmtctx.StatementContext
+	groupBy []expr
+	funcs   []aggFunc
+	// groups are the results of the groups by the encoded group by values, order keeps the groups in the order they
+	// are seen, so the result is deterministic.
+	groups map[string]*aggGroup
+	order  []*aggGroup
+}
+
+type aggGroup struct {
+	values  []types.Datum
+	results []types.Datum
+}
+
+type aggFunc struct {
+	tp  tipb.ExprType
+	arg expr
+}
+
+func newAggCollector(sc *stmtctx.StatementContext, agg *tipb.Aggregation) (*aggCollector, error) {
+	c := &aggCollector{sc: sc, groups: make(map[string]*aggGroup)}
+	for _, item := range agg.GroupBy {
+		e, err := newExpr(item)
+		if err != nil {
+			return nil, err
+		}
+		c.groupBy = append(c.groupBy, e)
+	}
+	for _, f := range agg.AggFunc {
+		var arg expr
+		switch {
+		case f.Tp == tipb.ExprType_Count && len(f.Children) == 0:
+		case (f.Tp == tipb.ExprType_Count || f.Tp == tipb.ExprType_Sum) && len(f.Children) == 1:
+			var err error
+			if arg, err = newExpr(f.Children[0]); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, errors.Errorf("unsupported aggregate function %v with %d arguments", f.Tp, len(f.Children))
+		}
+		c.funcs = append(c.funcs, aggFunc{tp: f.Tp, arg: arg})
+	}
+	return c, nil
+}
+
+func (c *aggCollector) add(row []types.Datum) (bool, error) {
+	values := make([]types.Datum, len(c.groupBy))
+	for i, e := range c.groupBy {
+		v, err := e.eval(c.sc, row)
+		if err != nil {
+			return false, err
+		}
+		values[i] = v
+	}
+	key, err := codec.EncodeValue(c.sc, nil, values...)
+	if err != nil {
+		return false, err
+	}
+	group, ok := c.groups[string(key)]
+	if !ok {
+		group = c.newGroup(values)
+		c.groups[string(key)] = group
+	}
+
+	for i, f := range c.funcs {
+		v := types.NewIntDatum(1)
+		if f.arg != nil {
+			if v, err = f.arg.eval(c.sc, row); err != nil {
+				return false, err
+			}
+		}
+		if v.IsNull() {
+			continue
+		}
+		if f.tp == tipb.ExprType_Count {
+			group.results[i].SetInt64(group.results[i].GetInt64() + 1)
+		} else if err := c.sum(&group.results[i], v); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// newGroup starts a group with the group by values, counts start at 0, and sums at null.
+func (c *aggCollector) newGroup(values []types.Datum) *aggGroup {
+	group := &aggGroup{values: values, results: make([]types.Datum, len(c.funcs))}
+	for i, f := range c.funcs {
+		if f.tp == tipb.ExprType_Count {
+			group.results[i].SetInt64(0)
+		}
+	}
+	c.order = append(c.order, group)
+	return group
+}
+
+// sum adds v to the sum, reals are summed as floats, and the other numbers as decimals.
+func (c *aggCollector) sum(sum *types.Datum, v types.Datum) error {
+	switch v.Kind() {
+	case types.KindFloat32, types.KindFloat64:
+		f, err := v.ToFloat64(c.sc)
+		if err != nil {
+			return err
+		}
+		if !sum.IsNull() {
+			f += sum.GetFloat64()
+		}
+		sum.SetFloat64(f)
+	default:
+		d, err := v.ToDecimal(c.sc)
+		if err != nil {
+			return err
+		}
+		if !sum.IsNull() {
+			to := new(types.MyDecimal)
+			if err := types.DecimalAdd(sum.GetMysqlDecimal(), d, to); err != nil {
+				return err
+			}
+			d = to
+		}
+		sum.SetMysqlDecimal(d)
+	}
+	return nil
+}
+
+func (c *aggCollector) rows() ([][]types.Datum, error) {
+	// Without group by items, an aggregation over no rows still has a result.
+	if len(c.groupBy) == 0 && len(c.order) == 0 {
+		c.newGroup(nil)
+	}
+	rows := make([][]types.Datum, 0, len(c.order))
+	for _, group := range c.order {
+		rows = append(rows, append(append([]types.Datum{}, group.results...), group.values...))
+	}
+	return rows, nil
+}
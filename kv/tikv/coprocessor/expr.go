@@ -0,0 +1,195 @@
+package coprocessor
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/types"
+	"github.com/pingcap/tidb/util/codec"
+	"github.com/pingcap/tipb/go-tipb"
+)
+
+// expr is an expression pushed down by a DAG request, evaluated over a row of the executor it belongs to.
+type expr interface {
+	eval(sc *stmtctx.StatementContext, row []types.Datum) (types.Datum, error)
+}
+
+// columnRef is the column at the offset in the row.
+type columnRef int
+
+func (c columnRef) eval(sc *stmtctx.StatementContext, row []types.Datum) (types.Datum, error) {
+	if int(c) >= len(row) {
+		return types.Datum{}, errors.Errorf("column offset %d out of range of %d columns", c, len(row))
+	}
+	return row[c], nil
+}
+
+type constant struct {
+	value types.Datum
+}
+
+func (c *constant) eval(sc *stmtctx.StatementContext, row []types.Datum) (types.Datum, error) {
+	return c.value, nil
+}
+
+// compare is a comparison function, cmp tells whether the result of comparing the arguments satisfies it. It's null
+// if either argument is null, like in MySQL.
+type compare struct {
+	cmp  func(int) bool
+	args [2]expr
+}
+
+func (c *compare) eval(sc *stmtctx.StatementContext, row []types.Datum) (types.Datum, error) {
+	var d types.Datum
+	left, err := c.args[0].eval(sc, row)
+	if err != nil || left.IsNull() {
+		return d, err
+	}
+	right, err := c.args[1].eval(sc, row)
+	if err != nil || right.IsNull() {
+		return d, err
+	}
+	res, err := left.CompareDatum(sc, &right)
+	if err != nil {
+		return d, err
+	}
+	d.SetInt64(boolToInt64(c.cmp(res)))
+	return d, nil
+}
+
+// logic is a logical AND or OR, with the three-valued logic of MySQL: a null argument makes the result null unless
+// the other argument decides it.
+type logic struct {
+	and  bool
+	args [2]expr
+}
+
+func (l *logic) eval(sc *stmtctx.StatementContext, row []types.Datum) (types.Datum, error) {
+	var d types.Datum
+	hasNull := false
+	for _, arg := range l.args {
+		v, err := arg.eval(sc, row)
+		if err != nil {
+			return d, err
+		}
+		if v.IsNull() {
+			hasNull = true
+			continue
+		}
+		b, err := v.ToBool(sc)
+		if err != nil {
+			return d, err
+		}
+		// false decides an AND and true decides an OR.
+		if (b != 0) != l.and {
+			d.SetInt64(b)
+			return d, nil
+		}
+	}
+	if !hasNull {
+		d.SetInt64(boolToInt64(l.and))
+	}
+	return d, nil
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+var comparisons = make(map[tipb.ScalarFuncSig]func(int) bool)
+
+func init() {
+	register := func(cmp func(int) bool, sigs ...tipb.ScalarFuncSig) {
+		for _, sig := range sigs {
+			comparisons[sig] = cmp
+		}
+	}
+	register(func(res int) bool { return res < 0 },
+		tipb.ScalarFuncSig_LTInt, tipb.ScalarFuncSig_LTReal, tipb.ScalarFuncSig_LTDecimal, tipb.ScalarFuncSig_LTString)
+	register(func(res int) bool { return res <= 0 },
+		tipb.ScalarFuncSig_LEInt, tipb.ScalarFuncSig_LEReal, tipb.ScalarFuncSig_LEDecimal, tipb.ScalarFuncSig_LEString)
+	register(func(res int) bool { return res > 0 },
+		tipb.ScalarFuncSig_GTInt, tipb.ScalarFuncSig_GTReal, tipb.ScalarFuncSig_GTDecimal, tipb.ScalarFuncSig_GTString)
+	register(func(res int) bool { return res >= 0 },
+		tipb.ScalarFuncSig_GEInt, tipb.ScalarFuncSig_GEReal, tipb.ScalarFuncSig_GEDecimal, tipb.ScalarFuncSig_GEString)
+	register(func(res int) bool { return res == 0 },
+		tipb.ScalarFuncSig_EQInt, tipb.ScalarFuncSig_EQReal, tipb.ScalarFuncSig_EQDecimal, tipb.ScalarFuncSig_EQString)
+	register(func(res int) bool { return res != 0 },
+		tipb.ScalarFuncSig_NEInt, tipb.ScalarFuncSig_NEReal, tipb.ScalarFuncSig_NEDecimal, tipb.ScalarFuncSig_NEString)
+}
+
+// newExpr builds an expression from its protobuf, only column references, constants, comparisons and logical
+// AND/OR are supported.
+func newExpr(e *tipb.Expr) (expr, error) {
+	switch e.Tp {
+	case tipb.ExprType_ColumnRef:
+		_, offset, err := codec.DecodeInt(e.Val)
+		if err != nil {
+			return nil, err
+		}
+		return columnRef(offset), nil
+	case tipb.ExprType_ScalarFunc:
+		return newScalarFunc(e)
+	}
+	c := new(constant)
+	switch e.Tp {
+	case tipb.ExprType_Null:
+	case tipb.ExprType_Int64:
+		_, v, err := codec.DecodeInt(e.Val)
+		if err != nil {
+			return nil, err
+		}
+		c.value.SetInt64(v)
+	case tipb.ExprType_Uint64:
+		_, v, err := codec.DecodeUint(e.Val)
+		if err != nil {
+			return nil, err
+		}
+		c.value.SetUint64(v)
+	case tipb.ExprType_Float64:
+		_, v, err := codec.DecodeFloat(e.Val)
+		if err != nil {
+			return nil, err
+		}
+		c.value.SetFloat64(v)
+	case tipb.ExprType_String:
+		c.value.SetString(string(e.Val))
+	case tipb.ExprType_Bytes:
+		c.value.SetBytes(e.Val)
+	case tipb.ExprType_MysqlDecimal:
+		_, v, _, _, err := codec.DecodeDecimal(e.Val)
+		if err != nil {
+			return nil, err
+		}
+		c.value.SetMysqlDecimal(v)
+	default:
+		return nil, errors.Errorf("unsupported expression type %v", e.Tp)
+	}
+	return c, nil
+}
+
+func newScalarFunc(e *tipb.Expr) (expr, error) {
+	if len(e.Children) != 2 {
+		return nil, errors.Errorf("function %v takes 2 arguments, got %d", e.Sig, len(e.Children))
+	}
+	var args [2]expr
+	for i, child := range e.Children {
+		arg, err := newExpr(child)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = arg
+	}
+	switch e.Sig {
+	case tipb.ScalarFuncSig_LogicalAnd:
+		return &logic{and: true, args: args}, nil
+	case tipb.ScalarFuncSig_LogicalOr:
+		return &logic{and: false, args: args}, nil
+	}
+	if cmp, ok := comparisons[e.Sig]; ok {
+		return &compare{cmp: cmp, args: args}, nil
+	}
+	return nil, errors.Errorf("unsupported function %v", e.Sig)
+}
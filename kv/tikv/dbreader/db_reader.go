@@ -8,27 +8,56 @@ import (
 
 type DBReader interface {
 	GetCF(cf string, key []byte) ([]byte, error)
+	// MultiGetCF returns the values of keys in the order of keys, nil for the keys not found. It's cheaper than
+	// calling GetCF for each key, as the keys are read in sorted order in one pass.
+	MultiGetCF(cf string, keys [][]byte) ([][]byte, error)
 	IterCF(cf string) *engine_util.CFIterator
+	// ReverseIterCF returns an iterator which walks the keys of cf in descending order.
+	ReverseIterCF(cf string) *engine_util.CFIterator
 }
 
 type RegionReader struct {
 	txn    *badger.Txn
 	region *metapb.Region
+	// tombstones are the ranges of the region deleted by DeletePrefix and DeleteRange which haven't been cleaned up
+	// yet.
+	tombstones []engine_util.RangeTombstone
 }
 
+// NewRegionReader creates a reader of the region on the txn, along with the range tombstones of the region.
 func NewRegionReader(txn *badger.Txn, region metapb.Region) *RegionReader {
+	tombstones, err := engine_util.LoadRangeTombstones(txn, region.Id)
+	if err != nil {
+		panic(err)
+	}
 	return &RegionReader{
-		txn:    txn,
-		region: &region,
+		txn:        txn,
+		region:     &region,
+		tombstones: tombstones,
 	}
 }
 
 func (r *RegionReader) GetCF(cf string, key []byte) ([]byte, error) {
-	return engine_util.GetCFFromTxn(r.txn, cf, key)
+	return engine_util.GetCFFromTxnWithTombstones(r.txn, cf, key, r.tombstones)
+}
+
+func (r *RegionReader) MultiGetCF(cf string, keys [][]byte) ([][]byte, error) {
+	it := r.IterCF(cf)
+	defer it.Close()
+	return engine_util.MultiGetCFFromIterator(it, keys)
 }
 
 func (r *RegionReader) IterCF(cf string) *engine_util.CFIterator {
-	return engine_util.NewCFIterator(cf, r.txn)
+	return engine_util.NewCFIteratorWithTombstones(cf, r.txn, r.tombstones)
+}
+
+func (r *RegionReader) ReverseIterCF(cf string) *engine_util.CFIterator {
+	return engine_util.NewReverseCFIteratorWithTombstones(cf, r.txn, r.tombstones)
+}
+
+// Region returns the region the reader reads.
+func (r *RegionReader) Region() *metapb.Region {
+	return r.region
 }
 
 func (r *RegionReader) Close() {
@@ -103,6 +103,17 @@ func (e *ErrTxnNotFound) Error() string {
 	return "txn not found"
 }
 
+// ErrSafePointExpired is returned if the start ts of a transaction is older than the GC safe point, the versions it
+// reads may have been collected.
+type ErrSafePointExpired struct {
+	StartTS   uint64
+	SafePoint uint64
+}
+
+func (e *ErrSafePointExpired) Error() string {
+	return fmt.Sprintf("start ts %d is older than the gc safe point %d", e.StartTS, e.SafePoint)
+}
+
 func convertToKeyError(err error) *kvrpcpb.KeyError {
 	if err == nil {
 		return nil
@@ -161,6 +172,13 @@ func convertToKeyError(err error) *kvrpcpb.KeyError {
 				PrimaryKey: x.PrimaryKey,
 			},
 		}
+	case *ErrSafePointExpired:
+		return &kvrpcpb.KeyError{
+			SafePointExpired: &kvrpcpb.SafePointExpired{
+				StartTs:   x.StartTS,
+				SafePoint: x.SafePoint,
+			},
+		}
 	case *ErrCommitPessimisticLock:
 		return &kvrpcpb.KeyError{
 			Abort: x.Error(),
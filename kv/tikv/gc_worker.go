@@ -0,0 +1,185 @@
+package tikv
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/juju/ratelimit"
+	"github.com/ngaut/log"
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/pd"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/commands"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/errors"
+)
+
+// GCWorker collects the old MVCC versions in the background. It polls the GC safe point from the scheduler, and each
+// time it advances, runs KvGC over the regions led by the store, in batches limited to the configured keys per
+// second so that the collection doesn't compete with the foreground requests.
+type GCWorker struct {
+	svr      *Server
+	pdClient pd.Client
+	// The store whose regions are collected, 0 for a standalone store, which holds the whole key space.
+	storeID  uint64
+	interval time.Duration
+	batch    uint32
+	limit    *ratelimit.Bucket
+
+	lastSafePoint uint64
+	closeCh       chan struct{}
+	wg            sync.WaitGroup
+}
+
+func NewGCWorker(svr *Server, pdClient pd.Client, storeID uint64, conf *config.GC) *GCWorker {
+	w := &GCWorker{
+		svr:      svr,
+		pdClient: pdClient,
+		storeID:  storeID,
+		batch:    commands.DefaultGCLimit,
+		closeCh:  make(chan struct{}),
+	}
+	if conf.PollInterval != "" {
+		w.interval = config.ParseDuration(conf.PollInterval)
+	}
+	if conf.KeysPerSecond > 0 {
+		if conf.KeysPerSecond < int(w.batch) {
+			w.batch = uint32(conf.KeysPerSecond)
+		}
+		w.limit = ratelimit.NewBucketWithRate(float64(conf.KeysPerSecond), int64(w.batch))
+	}
+	return w
+}
+
+// Start polls the safe point until Stop, it does nothing if the poll interval isn't set.
+func (w *GCWorker) Start() {
+	if w.interval == 0 {
+		return
+	}
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-w.closeCh:
+				return
+			case <-ticker.C:
+			}
+			safePoint, err := w.pdClient.GetGCSafePoint(context.TODO())
+			if err != nil {
+				log.Warnf("get gc safe point failed: %s", err)
+				continue
+			}
+			// The store rejects the transactions older than the safe point even if it leads no region to collect.
+			w.svr.advanceSafePoint(safePoint)
+			if safePoint <= w.lastSafePoint {
+				continue
+			}
+			if err := w.Collect(safePoint); err != nil {
+				log.Warnf("gc at safe point %d failed: %s", safePoint, err)
+				continue
+			}
+			w.lastSafePoint = safePoint
+		}
+	}()
+}
+
+func (w *GCWorker) Stop() {
+	close(w.closeCh)
+	w.wg.Wait()
+}
+
+// Collect runs a round of GC at the safe point over the regions led by the store. The regions whose leader moves
+// during the round are left to the new leader.
+func (w *GCWorker) Collect(safePoint uint64) error {
+	var deleted uint64
+	var err error
+	if w.storeID == 0 {
+		deleted, err = w.collectRange(&kvrpcpb.Context{}, nil, nil, safePoint)
+	} else {
+		deleted, err = w.collectRegions(safePoint)
+	}
+	if err != nil {
+		return err
+	}
+	log.Infof("gc at safe point %d collected %d versions", safePoint, deleted)
+	return nil
+}
+
+// collectRegions walks the regions of the cluster, and collects the ones led by the store.
+func (w *GCWorker) collectRegions(safePoint uint64) (uint64, error) {
+	var deleted uint64
+	for key := []byte{}; ; {
+		region, leader, err := w.pdClient.GetRegion(context.TODO(), key)
+		if err != nil {
+			return deleted, err
+		}
+		if region == nil {
+			return deleted, errors.Errorf("region of key %q is not found", key)
+		}
+		if leader != nil && leader.StoreId == w.storeID {
+			// The boundaries of the region are encoded user keys.
+			var start, end []byte
+			if len(region.StartKey) > 0 {
+				if start, err = mvcc.DecodeLockKey(region.StartKey); err != nil {
+					return deleted, err
+				}
+			}
+			if len(region.EndKey) > 0 {
+				if end, err = mvcc.DecodeLockKey(region.EndKey); err != nil {
+					return deleted, err
+				}
+			}
+			ctx := &kvrpcpb.Context{RegionId: region.Id, RegionEpoch: region.RegionEpoch, Peer: leader}
+			n, err := w.collectRange(ctx, start, end, safePoint)
+			deleted += n
+			if err != nil {
+				return deleted, err
+			}
+		}
+		if len(region.EndKey) == 0 {
+			return deleted, nil
+		}
+		key = region.EndKey
+	}
+}
+
+// collectRange runs KvGC over [start, end) batch by batch, and returns the number of versions deleted.
+func (w *GCWorker) collectRange(ctx *kvrpcpb.Context, start, end []byte, safePoint uint64) (uint64, error) {
+	var deleted uint64
+	for {
+		select {
+		case <-w.closeCh:
+			return deleted, errors.New("gc worker is stopped")
+		default:
+		}
+		if w.limit != nil {
+			w.limit.Wait(int64(w.batch))
+		}
+		resp, err := w.svr.KvGC(context.TODO(), &kvrpcpb.GCRequest{
+			Context:   ctx,
+			StartKey:  start,
+			EndKey:    end,
+			SafePoint: safePoint,
+			Limit:     w.batch,
+		})
+		if err != nil {
+			return deleted, err
+		}
+		if resp.RegionError != nil {
+			log.Infof("skip gc of region %d: %s", ctx.RegionId, resp.RegionError)
+			return deleted, nil
+		}
+		if resp.Error != "" {
+			return deleted, errors.New(resp.Error)
+		}
+		deleted += resp.VersionsDeleted
+		if len(resp.NextKey) == 0 {
+			return deleted, nil
+		}
+		start = resp.NextKey
+	}
+}
@@ -24,6 +24,10 @@ func (is *MemInnerServer) Raft(stream tikvpb.Tikv_RaftServer) error {
 	return nil
 }
 
+func (is *MemInnerServer) BatchRaft(stream tikvpb.Tikv_BatchRaftServer) error {
+	return nil
+}
+
 func (is *MemInnerServer) Snapshot(stream tikvpb.Tikv_SnapshotServer) error {
 	return nil
 }
@@ -53,6 +57,18 @@ func (mr *memReader) GetCF(cf string, key []byte) ([]byte, error) {
 	return mr.inner.Data[key[0]], nil
 }
 
+func (mr *memReader) MultiGetCF(cf string, keys [][]byte) ([][]byte, error) {
+	vals := make([][]byte, len(keys))
+	for i, key := range keys {
+		vals[i] = mr.inner.Data[key[0]]
+	}
+	return vals, nil
+}
+
 func (mr *memReader) IterCF(cf string) *engine_util.CFIterator {
 	return nil
 }
+
+func (mr *memReader) ReverseIterCF(cf string) *engine_util.CFIterator {
+	return nil
+}
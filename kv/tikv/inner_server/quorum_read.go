@@ -0,0 +1,196 @@
+package inner_server
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/worker"
+	"github.com/pingcap-incubator/tinykv/kv/util/clusterid"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tikvpb"
+	"github.com/pingcap/errors"
+)
+
+// quorumReadProgress collects the raft log progress of the peers of a region to decide the read index of a quorum
+// read. An entry is committed once it's persisted by a majority of the peers, and any two majorities overlap, so every
+// entry committed before the read started is at or below the largest last index of any majority. Unlike the commit
+// index, which the followers learn from the leader, the last indexes need no leader to be up to date.
+type quorumReadProgress struct {
+	// The conf version of the region the majority is counted for.
+	confVer uint64
+	peers   int
+	quorum  int
+
+	acked     int
+	failed    int
+	readIndex uint64
+	lastErr   error
+}
+
+func newQuorumReadProgress(region *metapb.Region) *quorumReadProgress {
+	return &quorumReadProgress{
+		confVer: region.GetRegionEpoch().GetConfVer(),
+		peers:   len(region.Peers),
+		quorum:  len(region.Peers)/2 + 1,
+	}
+}
+
+// add records the answer of a peer, it returns true once the read index is decided or can't be anymore.
+func (p *quorumReadProgress) add(resp *raft_cmdpb.RaftCmdResponse, err error) bool {
+	if err == nil {
+		err = checkRaftLogResponse(resp, p.confVer)
+	}
+	if err != nil {
+		p.failed++
+		p.lastErr = err
+	} else {
+		p.acked++
+		if index := resp.StatusResponse.RaftLog.LastIndex; index > p.readIndex {
+			p.readIndex = index
+		}
+	}
+	return p.acked >= p.quorum || p.failed > p.peers-p.quorum
+}
+
+func (p *quorumReadProgress) result() (uint64, error) {
+	if p.acked < p.quorum {
+		return 0, errors.Annotatef(p.lastErr, "only %d of %d peers answered the quorum read", p.acked, p.peers)
+	}
+	return p.readIndex, nil
+}
+
+func checkRaftLogResponse(resp *raft_cmdpb.RaftCmdResponse, confVer uint64) error {
+	if err := resp.GetHeader().GetError(); err != nil {
+		return &RegionError{RequestErr: err}
+	}
+	raftLog := resp.GetStatusResponse().GetRaftLog()
+	if raftLog == nil {
+		return errors.New("missing raft log status")
+	}
+	if v := raftLog.GetRegion().GetRegionEpoch().GetConfVer(); v != confVer {
+		return errors.Errorf("peer has conf version %d, expect %d", v, confVer)
+	}
+	return nil
+}
+
+func newRaftLogRequest(regionID uint64, peer *metapb.Peer) *raft_cmdpb.RaftCmdRequest {
+	return &raft_cmdpb.RaftCmdRequest{
+		Header: &raft_cmdpb.RaftRequestHeader{
+			RegionId: regionID,
+			Peer:     peer,
+		},
+		StatusRequest: &raft_cmdpb.StatusRequest{
+			CmdType: raft_cmdpb.StatusCmdType_RaftLog,
+			RaftLog: &raft_cmdpb.RaftLogRequest{},
+		},
+	}
+}
+
+// RaftLogStatus reports the raft log progress of the peer of this store to the store coordinating a quorum read.
+func (ris *RaftInnerServer) RaftLogStatus(req *raft_cmdpb.RaftCmdRequest) (*raft_cmdpb.RaftCmdResponse, error) {
+	if req.GetStatusRequest().GetCmdType() != raft_cmdpb.StatusCmdType_RaftLog ||
+		len(req.Requests) != 0 || req.AdminRequest != nil {
+		return nil, errors.New("not a raft log status request")
+	}
+	cb := message.NewCallback()
+	if err := ris.raftRouter.SendRaftCommand(req, cb); err != nil {
+		return nil, err
+	}
+	cb.Wg.Wait()
+	return cb.Resp, nil
+}
+
+// quorumReadIndex asks a majority of the peers of the region of ctx for their raft log progress, and returns the read
+// index of a quorum read, see quorumReadProgress.
+func (ris *RaftInnerServer) quorumReadIndex(ctx *kvrpcpb.Context, deadline time.Time) (uint64, error) {
+	local, err := ris.RaftLogStatus(newRaftLogRequest(ctx.RegionId, ctx.Peer))
+	if err != nil {
+		return 0, err
+	}
+	if err := ris.checkResponse(local, 0); err != nil {
+		return 0, err
+	}
+	// The epoch of ctx is checked by the snapshot read at the read index.
+	region := local.StatusResponse.RaftLog.Region
+	progress := newQuorumReadProgress(region)
+	if progress.add(local, nil) {
+		return progress.result()
+	}
+
+	rpcCtx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	type result struct {
+		resp *raft_cmdpb.RaftCmdResponse
+		err  error
+	}
+	results := make(chan result, len(region.Peers))
+	for _, peer := range region.Peers {
+		if peer.Id == ctx.Peer.GetId() {
+			continue
+		}
+		go func(peer *metapb.Peer) {
+			resp, err := ris.remoteRaftLogStatus(rpcCtx, region.Id, peer)
+			results <- result{resp: resp, err: err}
+		}(peer)
+	}
+	for {
+		r := <-results
+		if progress.add(r.resp, r.err) {
+			return progress.result()
+		}
+	}
+}
+
+func (ris *RaftInnerServer) remoteRaftLogStatus(ctx context.Context, regionID uint64,
+	peer *metapb.Peer) (*raft_cmdpb.RaftCmdResponse, error) {
+	addr, err := ris.resolveStoreAddr(ctx, peer.StoreId)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := ris.raftClient.getConn(addr)
+	if err != nil {
+		return nil, err
+	}
+	ctx = clusterid.NewOutgoingContext(ctx, ris.raftConfig.ClusterID, peer.StoreId)
+	return tikvpb.NewTikvClient(conn.cc).RaftLogStatus(ctx, newRaftLogRequest(regionID, peer))
+}
+
+// resolveStoreAddr returns the address of the store, it gives up once ctx is done.
+func (ris *RaftInnerServer) resolveStoreAddr(ctx context.Context, storeID uint64) (string, error) {
+	if addr := ris.raftClient.GetAddr(storeID); addr != "" {
+		return addr, nil
+	}
+	type result struct {
+		addr string
+		err  error
+	}
+	ch := make(chan result, 1)
+	task := worker.Task{
+		Tp: worker.TaskTypeResolveAddr,
+		Data: resolveAddrTask{
+			storeID: storeID,
+			callback: func(addr string, err error) {
+				ch <- result{addr: addr, err: err}
+			},
+		},
+	}
+	select {
+	case ris.resolveWorker.Sender() <- task:
+	case <-ctx.Done():
+		return "", errors.Annotatef(ctx.Err(), "resolve address of store %d", storeID)
+	}
+	var r result
+	select {
+	case r = <-ch:
+	case <-ctx.Done():
+		return "", errors.Annotatef(ctx.Err(), "resolve address of store %d", storeID)
+	}
+	if r.err != nil {
+		return "", r.err
+	}
+	ris.raftClient.InsertAddr(storeID, r.addr)
+	return r.addr, nil
+}
@@ -0,0 +1,86 @@
+package inner_server
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/tikv/config"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/worker"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/pingcap/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRegion(peers int, confVer uint64) *metapb.Region {
+	region := &metapb.Region{Id: 1, RegionEpoch: &metapb.RegionEpoch{ConfVer: confVer, Version: 1}}
+	for i := 1; i <= peers; i++ {
+		region.Peers = append(region.Peers, &metapb.Peer{Id: uint64(i), StoreId: uint64(i)})
+	}
+	return region
+}
+
+func newRaftLogResponse(region *metapb.Region, lastIndex uint64) *raft_cmdpb.RaftCmdResponse {
+	return &raft_cmdpb.RaftCmdResponse{
+		Header: &raft_cmdpb.RaftResponseHeader{},
+		StatusResponse: &raft_cmdpb.StatusResponse{
+			CmdType: raft_cmdpb.StatusCmdType_RaftLog,
+			RaftLog: &raft_cmdpb.RaftLogResponse{Region: region, LastIndex: lastIndex},
+		},
+	}
+}
+
+// TestQuorumReadIndex tests that the read index is the largest last index of the first majority which answers.
+func TestQuorumReadIndex(t *testing.T) {
+	region := newTestRegion(5, 1)
+	progress := newQuorumReadProgress(region)
+	assert.False(t, progress.add(newRaftLogResponse(region, 10), nil))
+	assert.False(t, progress.add(newRaftLogResponse(region, 12), nil))
+	assert.True(t, progress.add(newRaftLogResponse(region, 11), nil))
+	index, err := progress.result()
+	require.Nil(t, err)
+	assert.Equal(t, uint64(12), index)
+}
+
+// TestQuorumReadFailures tests that the peers which fail or have another conf version don't count for the majority.
+func TestQuorumReadFailures(t *testing.T) {
+	region := newTestRegion(3, 2)
+	progress := newQuorumReadProgress(region)
+	assert.False(t, progress.add(newRaftLogResponse(region, 10), nil))
+	assert.False(t, progress.add(newRaftLogResponse(newTestRegion(3, 1), 20), nil))
+	assert.True(t, progress.add(nil, errors.New("unreachable")))
+	_, err := progress.result()
+	assert.NotNil(t, err)
+
+	progress = newQuorumReadProgress(region)
+	resp := newRaftLogResponse(region, 30)
+	resp.Header.Error = &errorpb.Error{RegionNotFound: &errorpb.RegionNotFound{RegionId: 1}}
+	assert.False(t, progress.add(resp, nil))
+	assert.False(t, progress.add(newRaftLogResponse(region, 10), nil))
+	assert.True(t, progress.add(newRaftLogResponse(region, 8), nil))
+	index, err := progress.result()
+	require.Nil(t, err)
+	assert.Equal(t, uint64(10), index)
+}
+
+// TestResolveStoreAddrDeadline tests that resolving the address of a peer gives up at the deadline of the quorum read.
+func TestResolveStoreAddrDeadline(t *testing.T) {
+	ris := &RaftInnerServer{
+		raftClient:    newRaftClient(config.NewDefaultConfig()),
+		resolveWorker: worker.NewWorker("resolver", new(sync.WaitGroup)),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	// The resolve worker isn't started, so the address is never resolved.
+	_, err := ris.resolveStoreAddr(ctx, 2)
+	assert.Equal(t, context.DeadlineExceeded, errors.Cause(err))
+
+	ris.raftClient.InsertAddr(2, "127.0.0.1:20160")
+	addr, err := ris.resolveStoreAddr(ctx, 2)
+	require.Nil(t, err)
+	assert.Equal(t, "127.0.0.1:20160", addr)
+}
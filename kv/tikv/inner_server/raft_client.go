@@ -2,81 +2,266 @@ package inner_server
 
 import (
 	"context"
+	"math/rand"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/ngaut/log"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/config"
+	"github.com/pingcap-incubator/tinykv/kv/util/clusterid"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/tikvpb"
+	"github.com/pingcap/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
 )
 
+const (
+	// raftConnMaxPending is the max number of messages buffered for a store.
+	raftConnMaxPending = 4096
+	// raftBatchMaxSize limits the size of a BatchRaftMessage, it must be less than the max message size of the gRPC
+	// server.
+	raftBatchMaxSize = 4 * 1024 * 1024
+	// raftConnMaxRetry is the number of times a batch is sent before it's dropped.
+	raftConnMaxRetry = 3
+	raftConnBackoff  = 100 * time.Millisecond
+)
+
+var (
+	errRaftConnFull   = errors.New("raft connection buffer is full")
+	errRaftConnBroken = errors.New("raft connection is broken")
+)
+
+// raftConn is the connection to another store. The messages of all the regions sent to the store are buffered, and a
+// background goroutine sends them in batches over a single BatchRaft stream, so the messages buffered while a batch
+// is being sent go out together in the next one.
+//
+// If a batch fails to be sent, the stream is reconnected and the batch is sent again. After raftConnMaxRetry
+// failures the batch is dropped and the connection is marked broken, so that the RaftClient resolves the address of
+// the store again. Raft retransmits the dropped messages.
+//
+// The batches carry a checksum, and a sequence in the stream of the connection which a batch keeps when it's sent
+// again, so that the receiver detects the corrupted and the replayed batches, see raftStreamChecker.
 type raftConn struct {
-	streamMu sync.Mutex
-	stream   tikvpb.Tikv_RaftClient
+	addr     string
+	cc       *grpc.ClientConn
 	ctx      context.Context
 	cancel   context.CancelFunc
+	broken   int32
+	streamID uint64
+	seq      uint64
+
+	mu      sync.Mutex
+	pending []*raft_serverpb.RaftMessage
+	notify  chan struct{}
 }
 
 func newRaftConn(addr string, cfg *config.Config) (*raftConn, error) {
-	cc, err := grpc.Dial(addr, grpc.WithInsecure(),
+	opts := append(clusterid.DialOptions(cfg.ClusterID), grpc.WithInsecure(),
 		grpc.WithInitialWindowSize(int32(cfg.GrpcInitialWindowSize)),
 		grpc.WithKeepaliveParams(keepalive.ClientParameters{
 			Time:                cfg.GrpcKeepAliveTime,
 			Timeout:             cfg.GrpcKeepAliveTimeout,
 			PermitWithoutStream: true,
 		}))
+	cc, err := grpc.Dial(addr, opts...)
 	if err != nil {
 		return nil, err
 	}
 	ctx, cancel := context.WithCancel(context.Background())
-	stream, err := tikvpb.NewTikvClient(cc).Raft(ctx)
-	if err != nil {
-		cancel()
-		return nil, err
+	c := &raftConn{
+		addr:     addr,
+		cc:       cc,
+		ctx:      ctx,
+		cancel:   cancel,
+		streamID: rand.Uint64(),
+		notify:   make(chan struct{}, 1),
 	}
-	return &raftConn{
-		stream: stream,
-		ctx:    ctx,
-		cancel: cancel,
-	}, nil
+	go c.run()
+	return c, nil
 }
 
 func (c *raftConn) Stop() {
 	c.cancel()
+	c.cc.Close()
 }
 
+// Send buffers the message to be sent by the background goroutine.
 func (c *raftConn) Send(msg *raft_serverpb.RaftMessage) error {
-	c.streamMu.Lock()
-	defer c.streamMu.Unlock()
-	return c.stream.Send(msg)
+	if atomic.LoadInt32(&c.broken) != 0 {
+		return errRaftConnBroken
+	}
+	c.mu.Lock()
+	if len(c.pending) >= raftConnMaxPending {
+		c.mu.Unlock()
+		return errRaftConnFull
+	}
+	c.pending = append(c.pending, msg)
+	c.mu.Unlock()
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (c *raftConn) run() {
+	var stream tikvpb.Tikv_BatchRaftClient
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-c.notify:
+		}
+		c.mu.Lock()
+		msgs := c.pending
+		c.pending = nil
+		c.mu.Unlock()
+
+		for len(msgs) > 0 {
+			var batch *raft_serverpb.BatchRaftMessage
+			batch, msgs = nextRaftBatch(msgs)
+			c.seq++
+			sealRaftBatch(batch, c.streamID, c.seq)
+			stream = c.sendBatch(stream, batch)
+		}
+	}
+}
+
+// nextRaftBatch takes the messages of the next batch, a batch has at least one message even if it's larger than
+// raftBatchMaxSize. The plain heartbeats are merged into the compact form, see raftHeartbeat.
+func nextRaftBatch(msgs []*raft_serverpb.RaftMessage) (*raft_serverpb.BatchRaftMessage, []*raft_serverpb.RaftMessage) {
+	batch := new(raft_serverpb.BatchRaftMessage)
+	size, n := 0, 0
+	for ; n < len(msgs); n++ {
+		msg := msgs[n]
+		hb := raftHeartbeat(msg)
+		if hb != nil && len(batch.Heartbeats) > 0 &&
+			(msg.FromPeer.StoreId != batch.FromStoreId || msg.ToPeer.StoreId != batch.ToStoreId) {
+			hb = nil
+		}
+		if hb != nil {
+			size += hb.Size()
+		} else {
+			size += msg.Size()
+		}
+		if n > 0 && size > raftBatchMaxSize {
+			break
+		}
+		if hb == nil {
+			batch.Msgs = append(batch.Msgs, msg)
+			continue
+		}
+		batch.FromStoreId, batch.ToStoreId = msg.FromPeer.StoreId, msg.ToPeer.StoreId
+		batch.Heartbeats = append(batch.Heartbeats, hb)
+	}
+	return batch, msgs[n:]
 }
 
-type connKey struct {
-	addr  string
-	index int
+// raftHeartbeat returns the compact form of a plain heartbeat or heartbeat response, or nil if the message carries
+// more than the compact form holds, like the range of the region, or the context of a read index.
+func raftHeartbeat(msg *raft_serverpb.RaftMessage) *raft_serverpb.RaftHeartbeat {
+	m := msg.GetMessage()
+	if m.GetMsgType() != eraftpb.MessageType_MsgHeartbeat && m.GetMsgType() != eraftpb.MessageType_MsgHeartbeatResponse {
+		return nil
+	}
+	if msg.FromPeer == nil || msg.ToPeer == nil || msg.RegionEpoch == nil || msg.IsTombstone ||
+		len(msg.StartKey) > 0 || len(msg.EndKey) > 0 || msg.Repair || len(msg.InlineSnapshot) > 0 ||
+		msg.BusyBackoffMs != 0 {
+		return nil
+	}
+	if m.From != msg.FromPeer.Id || m.To != msg.ToPeer.Id || m.LogTerm != 0 || m.Index != 0 || len(m.Entries) > 0 ||
+		m.Snapshot != nil || m.Reject || m.RejectHint != 0 || len(m.Context) > 0 {
+		return nil
+	}
+	return &raft_serverpb.RaftHeartbeat{
+		RegionId:   msg.RegionId,
+		FromPeerId: m.From,
+		ToPeerId:   m.To,
+		ConfVer:    msg.RegionEpoch.ConfVer,
+		Version:    msg.RegionEpoch.Version,
+		Response:   m.MsgType == eraftpb.MessageType_MsgHeartbeatResponse,
+		Term:       m.Term,
+		Commit:     m.Commit,
+	}
 }
 
+// heartbeatRaftMessage restores the raft message of a heartbeat merged into the batch.
+func heartbeatRaftMessage(batch *raft_serverpb.BatchRaftMessage, hb *raft_serverpb.RaftHeartbeat) *raft_serverpb.RaftMessage {
+	msgType := eraftpb.MessageType_MsgHeartbeat
+	if hb.Response {
+		msgType = eraftpb.MessageType_MsgHeartbeatResponse
+	}
+	return &raft_serverpb.RaftMessage{
+		RegionId:    hb.RegionId,
+		FromPeer:    &metapb.Peer{Id: hb.FromPeerId, StoreId: batch.FromStoreId},
+		ToPeer:      &metapb.Peer{Id: hb.ToPeerId, StoreId: batch.ToStoreId},
+		RegionEpoch: &metapb.RegionEpoch{ConfVer: hb.ConfVer, Version: hb.Version},
+		Message: &eraftpb.Message{
+			MsgType: msgType,
+			To:      hb.ToPeerId,
+			From:    hb.FromPeerId,
+			Term:    hb.Term,
+			Commit:  hb.Commit,
+		},
+	}
+}
+
+// sendBatch sends the batch over the stream, the stream is created or reconnected when needed. It returns the stream
+// to send the next batch.
+func (c *raftConn) sendBatch(stream tikvpb.Tikv_BatchRaftClient, batch *raft_serverpb.BatchRaftMessage) tikvpb.Tikv_BatchRaftClient {
+	for i := 0; i < raftConnMaxRetry; i++ {
+		if i > 0 {
+			select {
+			case <-c.ctx.Done():
+				return nil
+			case <-time.After(raftConnBackoff):
+			}
+		}
+		if stream == nil {
+			var err error
+			stream, err = tikvpb.NewTikvClient(c.cc).BatchRaft(c.ctx)
+			if err != nil {
+				log.Warnf("create raft stream to %s failed, err: %v", c.addr, err)
+				stream = nil
+				continue
+			}
+		}
+		err := stream.Send(batch)
+		if err == nil {
+			return stream
+		}
+		log.Warnf("send %d raft messages to %s failed, err: %v", len(batch.Msgs)+len(batch.Heartbeats), c.addr, err)
+		stream = nil
+	}
+	log.Errorf("drop %d raft messages to %s", len(batch.Msgs)+len(batch.Heartbeats), c.addr)
+	atomic.StoreInt32(&c.broken, 1)
+	return nil
+}
+
+// RaftClient sends the raft messages to other stores. There is a single raftConn to each store, which carries the
+// messages of all the regions.
 type RaftClient struct {
 	config *config.Config
 	sync.RWMutex
-	conns map[connKey]*raftConn
+	conns map[string]*raftConn
 	addrs map[uint64]string
 }
 
 func newRaftClient(config *config.Config) *RaftClient {
 	return &RaftClient{
 		config: config,
-		conns:  make(map[connKey]*raftConn),
+		conns:  make(map[string]*raftConn),
 		addrs:  make(map[uint64]string),
 	}
 }
 
-func (c *RaftClient) getConn(addr string, regionID uint64) (*raftConn, error) {
+func (c *RaftClient) getConn(addr string) (*raftConn, error) {
 	c.RLock()
-	key := connKey{addr, int(regionID % c.config.GrpcRaftConnNum)}
-	conn, ok := c.conns[key]
+	conn, ok := c.conns[addr]
 	if ok {
 		c.RUnlock()
 		return conn, nil
@@ -88,30 +273,31 @@ func (c *RaftClient) getConn(addr string, regionID uint64) (*raftConn, error) {
 	}
 	c.Lock()
 	defer c.Unlock()
-	if conn, ok := c.conns[key]; ok {
+	if conn, ok := c.conns[addr]; ok {
 		newConn.Stop()
 		return conn, nil
 	}
-	c.conns[key] = newConn
+	c.conns[addr] = newConn
 	return newConn, nil
 }
 
 func (c *RaftClient) Send(storeID uint64, addr string, msg *raft_serverpb.RaftMessage) error {
-	conn, err := c.getConn(addr, msg.GetRegionId())
+	conn, err := c.getConn(addr)
 	if err != nil {
 		return err
 	}
 	err = conn.Send(msg)
-	if err == nil {
-		return nil
+	if err != errRaftConnBroken {
+		return err
 	}
 
 	log.Error("raft client failed to send")
 	c.Lock()
 	defer c.Unlock()
 	conn.Stop()
-	key := connKey{addr, int(msg.GetRegionId() % c.config.GrpcRaftConnNum)}
-	delete(c.conns, key)
+	if c.conns[addr] == conn {
+		delete(c.conns, addr)
+	}
 	if oldAddr, ok := c.addrs[storeID]; ok && oldAddr == addr {
 		delete(c.addrs, storeID)
 	}
@@ -132,5 +318,5 @@ func (c *RaftClient) InsertAddr(storeID uint64, addr string) {
 }
 
 func (c *RaftClient) Flush() {
-	// Not support BufferHint
+	// The messages are sent as soon as the connection is idle, so there is nothing to flush.
 }
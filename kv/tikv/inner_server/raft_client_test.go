@@ -0,0 +1,199 @@
+package inner_server
+
+import (
+	"io"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/tikv/config"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tikvpb"
+	"github.com/pingcap/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type batchRaftServer struct {
+	tikvpb.TikvServer
+	streams   int32
+	snapshots int32
+	msgs      chan *raft_serverpb.RaftMessage
+	lastSeq   uint64
+}
+
+func (s *batchRaftServer) BatchRaft(stream tikvpb.Tikv_BatchRaftServer) error {
+	atomic.AddInt32(&s.streams, 1)
+	for {
+		batch, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if batch.Seq <= s.lastSeq || batch.Checksum != raftBatchChecksum(batch) {
+			return errors.Errorf("batch %d is not sealed after batch %d", batch.Seq, s.lastSeq)
+		}
+		s.lastSeq = batch.Seq
+		for _, msg := range batch.GetMsgs() {
+			s.msgs <- msg
+		}
+	}
+}
+
+func (s *batchRaftServer) Snapshot(stream tikvpb.Tikv_SnapshotServer) error {
+	atomic.AddInt32(&s.snapshots, 1)
+	for {
+		_, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&raft_serverpb.Done{})
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func startBatchRaftServer(t *testing.T, addr string) (*grpc.Server, *batchRaftServer, string) {
+	l, err := net.Listen("tcp", addr)
+	require.Nil(t, err)
+	svr := &batchRaftServer{msgs: make(chan *raft_serverpb.RaftMessage, 1024)}
+	grpcServer := grpc.NewServer()
+	tikvpb.RegisterTikvServer(grpcServer, svr)
+	go grpcServer.Serve(l)
+	return grpcServer, svr, l.Addr().String()
+}
+
+func newTestRaftMessage(regionID, index uint64) *raft_serverpb.RaftMessage {
+	return &raft_serverpb.RaftMessage{
+		RegionId: regionID,
+		ToPeer:   &metapb.Peer{Id: regionID, StoreId: 2},
+		Message:  &eraftpb.Message{MsgType: eraftpb.MessageType_MsgAppend, Index: index},
+	}
+}
+
+func TestRaftClientMultiplexRegions(t *testing.T) {
+	grpcServer, svr, addr := startBatchRaftServer(t, "127.0.0.1:0")
+	defer grpcServer.Stop()
+
+	client := newRaftClient(config.NewDefaultConfig())
+	for i := uint64(0); i < 200; i++ {
+		require.Nil(t, client.Send(2, addr, newTestRaftMessage(i%10+1, i)))
+	}
+	for i := uint64(0); i < 200; i++ {
+		select {
+		case msg := <-svr.msgs:
+			assert.Equal(t, i, msg.GetMessage().GetIndex())
+			assert.Equal(t, i%10+1, msg.GetRegionId())
+		case <-time.After(5 * time.Second):
+			t.Fatalf("message %d is not received", i)
+		}
+	}
+	// All the regions share the same stream.
+	assert.Equal(t, int32(1), atomic.LoadInt32(&svr.streams))
+	assert.Len(t, client.conns, 1)
+}
+
+func TestRaftClientReconnect(t *testing.T) {
+	grpcServer, svr, addr := startBatchRaftServer(t, "127.0.0.1:0")
+	client := newRaftClient(config.NewDefaultConfig())
+	require.Nil(t, client.Send(2, addr, newTestRaftMessage(1, 1)))
+	<-svr.msgs
+	grpcServer.Stop()
+
+	grpcServer, svr, _ = startBatchRaftServer(t, addr)
+	defer grpcServer.Stop()
+	// The messages sent before the broken stream is noticed may be lost, raft retransmits them.
+	for i := uint64(2); ; i++ {
+		client.Send(2, addr, newTestRaftMessage(1, i))
+		select {
+		case <-svr.msgs:
+			return
+		case <-time.After(50 * time.Millisecond):
+		}
+		if i > 100 {
+			t.Fatal("stream is not reconnected")
+		}
+	}
+}
+
+func TestNextRaftBatch(t *testing.T) {
+	big := newTestRaftMessage(1, 1)
+	big.Message.Entries = []*eraftpb.Entry{{Data: make([]byte, raftBatchMaxSize)}}
+	msgs := []*raft_serverpb.RaftMessage{newTestRaftMessage(1, 1), newTestRaftMessage(2, 1), big, newTestRaftMessage(3, 1)}
+
+	batch, rest := nextRaftBatch(msgs)
+	assert.Len(t, batch.Msgs, 2)
+	batch, rest = nextRaftBatch(rest)
+	assert.Equal(t, []*raft_serverpb.RaftMessage{big}, batch.Msgs)
+	batch, rest = nextRaftBatch(rest)
+	assert.Len(t, batch.Msgs, 1)
+	assert.Len(t, rest, 0)
+}
+
+func TestMergeRaftHeartbeats(t *testing.T) {
+	heartbeat := func(regionID uint64, msgType eraftpb.MessageType, commit uint64) *raft_serverpb.RaftMessage {
+		return &raft_serverpb.RaftMessage{
+			RegionId:    regionID,
+			FromPeer:    &metapb.Peer{Id: regionID*10 + 1, StoreId: 1},
+			ToPeer:      &metapb.Peer{Id: regionID*10 + 2, StoreId: 2},
+			RegionEpoch: &metapb.RegionEpoch{ConfVer: 2, Version: 3},
+			Message: &eraftpb.Message{
+				MsgType: msgType, From: regionID*10 + 1, To: regionID*10 + 2, Term: 5, Commit: commit,
+			},
+		}
+	}
+	plain := []*raft_serverpb.RaftMessage{
+		heartbeat(1, eraftpb.MessageType_MsgHeartbeat, 7),
+		heartbeat(2, eraftpb.MessageType_MsgHeartbeatResponse, 0),
+	}
+	// A heartbeat of a read index carries a context, and a heartbeat to an unknown peer carries the region range.
+	readIndex := heartbeat(3, eraftpb.MessageType_MsgHeartbeat, 7)
+	readIndex.Message.Context = []byte("ctx")
+	initial := heartbeat(4, eraftpb.MessageType_MsgHeartbeat, 0)
+	initial.StartKey, initial.EndKey = []byte("a"), []byte("b")
+	// A heartbeat from another store can't share the stores of the batch.
+	otherStore := heartbeat(5, eraftpb.MessageType_MsgHeartbeat, 7)
+	otherStore.FromPeer.StoreId = 3
+	msgs := []*raft_serverpb.RaftMessage{plain[0], readIndex, newTestRaftMessage(1, 1), plain[1], initial, otherStore}
+
+	batch, rest := nextRaftBatch(msgs)
+	assert.Len(t, rest, 0)
+	assert.Equal(t, []*raft_serverpb.RaftMessage{readIndex, msgs[2], initial, otherStore}, batch.Msgs)
+	require.Len(t, batch.Heartbeats, 2)
+	assert.Equal(t, uint64(1), batch.FromStoreId)
+	assert.Equal(t, uint64(2), batch.ToStoreId)
+	for i, hb := range batch.Heartbeats {
+		assert.Equal(t, plain[i], heartbeatRaftMessage(batch, hb))
+	}
+}
+
+func TestRaftStreamChecker(t *testing.T) {
+	checker := newRaftStreamChecker()
+	batch := func(streamID, seq uint64) *raft_serverpb.BatchRaftMessage {
+		b := &raft_serverpb.BatchRaftMessage{Msgs: []*raft_serverpb.RaftMessage{newTestRaftMessage(1, seq)}}
+		sealRaftBatch(b, streamID, seq)
+		return b
+	}
+	assert.True(t, checker.check(batch(1, 1)))
+	assert.True(t, checker.check(batch(1, 3)))
+	// A batch sent again after a reconnect is a replay if it has been received.
+	assert.False(t, checker.check(batch(1, 3)))
+	assert.False(t, checker.check(batch(1, 2)))
+	assert.True(t, checker.check(batch(2, 1)))
+
+	corrupted := batch(1, 4)
+	corrupted.Msgs[0].Message.Index++
+	assert.False(t, checker.check(corrupted))
+	corrupted = batch(1, 4)
+	corrupted.Seq = 0
+	assert.False(t, checker.check(corrupted))
+	assert.True(t, checker.check(batch(1, 4)))
+
+	// The batches of a sender which doesn't seal them are not checked.
+	unsealed := &raft_serverpb.BatchRaftMessage{Msgs: []*raft_serverpb.RaftMessage{newTestRaftMessage(1, 1)}}
+	assert.True(t, checker.check(unsealed))
+	assert.True(t, checker.check(unsealed))
+}
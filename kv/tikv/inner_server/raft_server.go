@@ -2,6 +2,8 @@ package inner_server
 
 import (
 	"context"
+	"crypto/rand"
+	"github.com/ngaut/log"
 	kvConfig "github.com/pingcap-incubator/tinykv/kv/config"
 	"github.com/pingcap-incubator/tinykv/kv/engine_util"
 	"github.com/pingcap-incubator/tinykv/kv/pd"
@@ -15,11 +17,13 @@ import (
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/tikvpb"
 	"github.com/pingcap/errors"
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 )
 
 // RaftInnerServer is an InnerServer (see tikv/server.go) backed by a Raft node. It is part of a Raft network.
@@ -32,6 +36,8 @@ type RaftInnerServer struct {
 	node          *raftstore.Node
 	snapManager   *snap.SnapManager
 	raftRouter    *raftstore.RaftstoreRouter
+	raftClient    *RaftClient
+	streamChecker *raftStreamChecker
 	batchSystem   *raftstore.RaftBatchSystem
 	pdWorker      *worker.Worker
 	resolveWorker *worker.Worker
@@ -69,19 +75,28 @@ func NewRaftInnerServer(conf *kvConfig.Config) *RaftInnerServer {
 	os.Mkdir(snapPath, os.ModePerm)
 
 	raftDB := engine_util.CreateDB("raft", &conf.Engine)
-	raftConf := config.NewDefaultConfig()
-	raftConf.SnapPath = snapPath
-	setupRaftStoreConf(raftConf, conf)
+	raftConf := NewRaftStoreConfig(conf)
 
 	kvDB := engine_util.CreateDB("kv", &conf.Engine)
 	engines := engine_util.NewEngines(kvDB, raftDB, kvPath, raftPath)
 
-	return &RaftInnerServer{engines: engines, raftConfig: raftConf}
+	return &RaftInnerServer{engines: engines, raftConfig: raftConf, streamChecker: newRaftStreamChecker()}
+}
+
+// NewRaftStoreConfig returns the raftstore configuration of the store configured by conf.
+func NewRaftStoreConfig(conf *kvConfig.Config) *config.Config {
+	raftConf := config.NewDefaultConfig()
+	raftConf.SnapPath = filepath.Join(conf.Engine.DBPath, "snap")
+	setupRaftStoreConf(raftConf, conf)
+	return raftConf
 }
 
 func setupRaftStoreConf(raftConf *config.Config, conf *kvConfig.Config) {
 	raftConf.Addr = conf.Server.StoreAddr
 	raftConf.RaftWorkerCnt = conf.RaftStore.RaftWorkers
+	if conf.RaftStore.ApplyWorkers > 0 {
+		raftConf.ApplyPoolSize = uint64(conf.RaftStore.ApplyWorkers)
+	}
 
 	// raftstore block
 	raftConf.PdHeartbeatTickInterval = kvConfig.ParseDuration(conf.RaftStore.PdHeartbeatTickInterval)
@@ -89,6 +104,34 @@ func setupRaftStoreConf(raftConf *config.Config, conf *kvConfig.Config) {
 	raftConf.RaftBaseTickInterval = kvConfig.ParseDuration(conf.RaftStore.RaftBaseTickInterval)
 	raftConf.RaftHeartbeatTicks = conf.RaftStore.RaftHeartbeatTicks
 	raftConf.RaftElectionTimeoutTicks = conf.RaftStore.RaftElectionTimeoutTicks
+	raftConf.QuorumRead = conf.RaftStore.QuorumRead
+	raftConf.QuorumReadTimeout = kvConfig.ParseDuration(conf.RaftStore.QuorumReadTimeout)
+	raftConf.RightDeriveWhenSplit = conf.RaftStore.RightDeriveWhenSplit
+	raftConf.ReserveSpace = uint64(conf.RaftStore.ReserveSpace)
+	if conf.RaftStore.RaftLogGCTickInterval != "" {
+		raftConf.RaftLogGCTickInterval = kvConfig.ParseDuration(conf.RaftStore.RaftLogGCTickInterval)
+	}
+	if conf.RaftStore.RaftLogGCThreshold > 0 {
+		raftConf.RaftLogGcThreshold = conf.RaftStore.RaftLogGCThreshold
+	}
+	if conf.RaftStore.RaftLogGCCountLimit > 0 {
+		raftConf.RaftLogGcCountLimit = conf.RaftStore.RaftLogGCCountLimit
+	}
+	if conf.RaftStore.RaftLogGCSizeLimit > 0 {
+		raftConf.RaftLogGcSizeLimit = conf.RaftStore.RaftLogGCSizeLimit
+	}
+	if len(conf.RaftStore.SplitPolicies) > 0 {
+		raftConf.SplitCheck.Policies = conf.RaftStore.SplitPolicies
+	}
+	if conf.Coprocessor.RegionSplitKeys > 0 {
+		raftConf.SplitCheck.RegionSplitKeys = uint64(conf.Coprocessor.RegionSplitKeys)
+	}
+	if conf.Coprocessor.RegionMaxKeys > 0 {
+		raftConf.SplitCheck.RegionMaxKeys = uint64(conf.Coprocessor.RegionMaxKeys)
+	}
+	if conf.Server.DiskClass != "" {
+		raftConf.Labels = append(raftConf.Labels, config.StoreLabel{LabelKey: "disk-class", LabelValue: conf.Server.DiskClass})
+	}
 }
 
 func (ris *RaftInnerServer) Write(ctx *kvrpcpb.Context, batch []Modify) error {
@@ -115,31 +158,119 @@ func (ris *RaftInnerServer) Write(ctx *kvrpcpb.Context, batch []Modify) error {
 		}
 	}
 
-	header := &raft_cmdpb.RaftRequestHeader{
+	request := &raft_cmdpb.RaftCmdRequest{
+		Header:   writeHeader(ctx),
+		Requests: reqs,
+	}
+	cb := message.NewCallback()
+	if err := ris.raftRouter.SendRaftCommand(request, cb); err != nil {
+		return err
+	}
+	cb.Wg.Wait()
+	return ris.checkResponse(cb.Resp, len(reqs))
+}
+
+// writeHeader returns the header of a write proposed to the region of ctx. The write is tagged with a random uuid, so
+// the applier applies it once even if it's proposed more than once, and a follower forwarding it to the leader finds
+// its callback by the uuid.
+func writeHeader(ctx *kvrpcpb.Context) *raft_cmdpb.RaftRequestHeader {
+	uuid := make([]byte, 16)
+	if _, err := rand.Read(uuid); err != nil {
+		panic(err)
+	}
+	return &raft_cmdpb.RaftRequestHeader{
 		RegionId:    ctx.RegionId,
 		Peer:        ctx.Peer,
 		RegionEpoch: ctx.RegionEpoch,
 		Term:        ctx.Term,
+		Uuid:        uuid,
 	}
+}
+
+// DeletePrefix deletes all the keys starting with the prefix inside the region of ctx with a single admin command.
+// The keys disappear from readers once the command is applied and are removed from the disk in the background.
+func (ris *RaftInnerServer) DeletePrefix(ctx *kvrpcpb.Context, prefix []byte) error {
+	return ris.runAdmin(ctx, &raft_cmdpb.AdminRequest{
+		CmdType:      raft_cmdpb.AdminCmdType_DeletePrefix,
+		DeletePrefix: &raft_cmdpb.DeletePrefixRequest{Prefix: prefix},
+	})
+}
+
+// DeleteRange deletes all the keys of [startKey, endKey) inside the region of ctx with a single admin command, like
+// DeletePrefix.
+func (ris *RaftInnerServer) DeleteRange(ctx *kvrpcpb.Context, startKey, endKey []byte) error {
+	return ris.runAdmin(ctx, &raft_cmdpb.AdminRequest{
+		CmdType:     raft_cmdpb.AdminCmdType_DeleteRange,
+		DeleteRange: &raft_cmdpb.DeleteRangeRequest{StartKey: startKey, EndKey: endKey},
+	})
+}
+
+// runAdmin proposes the admin request to the region of ctx and waits until it's applied.
+func (ris *RaftInnerServer) runAdmin(ctx *kvrpcpb.Context, admin *raft_cmdpb.AdminRequest) error {
 	request := &raft_cmdpb.RaftCmdRequest{
-		Header:   header,
-		Requests: reqs,
+		Header:       writeHeader(ctx),
+		AdminRequest: admin,
 	}
 	cb := message.NewCallback()
 	if err := ris.raftRouter.SendRaftCommand(request, cb); err != nil {
 		return err
 	}
 	cb.Wg.Wait()
-	return ris.checkResponse(cb.Resp, len(reqs))
+	return ris.checkResponse(cb.Resp, 0)
+}
+
+// SplitRegion splits the region of ctx at the split keys, which are encoded like the region boundaries, and waits
+// until the split is applied. It returns the regions the region is split into.
+func (ris *RaftInnerServer) SplitRegion(ctx *kvrpcpb.Context, splitKeys [][]byte) ([]*metapb.Region, error) {
+	if ctx.GetRegionEpoch() == nil {
+		return nil, errors.New("region epoch is required to split a region")
+	}
+	cb := message.NewCallback()
+	if err := ris.raftRouter.SplitRegion(ctx.RegionId, ctx.RegionEpoch, splitKeys, cb); err != nil {
+		return nil, err
+	}
+	cb.Wg.Wait()
+	if err := ris.checkResponse(cb.Resp, 0); err != nil {
+		return nil, err
+	}
+	return cb.Resp.GetAdminResponse().GetSplits().GetRegions(), nil
 }
 
 func (ris *RaftInnerServer) Reader(ctx *kvrpcpb.Context) (dbreader.DBReader, error) {
+	snap, err := ris.RegionSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return dbreader.NewRegionReader(snap.Txn, snap.Region), nil
+}
+
+// RegionSnapshot reads the region of ctx through raft, the returned snapshot sees all the data applied up to its
+// index and nothing after it. The caller must discard the txn of the snapshot.
+//
+// A quorum read is served by the peer of this store once it applies the read index decided by a majority of the
+// peers, instead of by the leader, see quorumReadIndex.
+func (ris *RaftInnerServer) RegionSnapshot(ctx *kvrpcpb.Context) (*message.RegionSnapshot, error) {
 	header := &raft_cmdpb.RaftRequestHeader{
 		RegionId:    ctx.RegionId,
 		Peer:        ctx.Peer,
 		RegionEpoch: ctx.RegionEpoch,
 		Term:        ctx.Term,
 	}
+	if d := ctx.MaxExecutionDurationMs; d > 0 {
+		header.DeadlineMs = uint64(time.Now().UnixNano()/int64(time.Millisecond)) + d
+	}
+	if ctx.QuorumRead || ris.raftConfig.QuorumRead {
+		deadline := time.Now().Add(ris.raftConfig.QuorumReadTimeout)
+		if header.DeadlineMs == 0 || header.DeadlineMs > uint64(deadline.UnixNano()/int64(time.Millisecond)) {
+			header.DeadlineMs = uint64(deadline.UnixNano() / int64(time.Millisecond))
+		}
+		index, err := ris.quorumReadIndex(ctx, deadline)
+		if err != nil {
+			return nil, err
+		}
+		header.ReadQuorum = true
+		header.AppliedIndex = index
+	}
 	request := &raft_cmdpb.RaftCmdRequest{
 		Header: header,
 		Requests: []*raft_cmdpb.Request{{
@@ -158,7 +289,7 @@ func (ris *RaftInnerServer) Reader(ctx *kvrpcpb.Context) (dbreader.DBReader, err
 		}
 		return nil, err
 	}
-	return dbreader.NewRegionReader(cb.RegionSnap.Txn, cb.RegionSnap.Region), nil
+	return &cb.RegionSnap, nil
 }
 
 func (ris *RaftInnerServer) Raft(stream tikvpb.Tikv_RaftServer) error {
@@ -167,7 +298,44 @@ func (ris *RaftInnerServer) Raft(stream tikvpb.Tikv_RaftServer) error {
 		if err != nil {
 			return err
 		}
+		ris.handleRaftMessage(msg)
+	}
+}
+
+// BatchRaft receives the raft messages of all the regions sent by another store, see RaftClient.
+func (ris *RaftInnerServer) BatchRaft(stream tikvpb.Tikv_BatchRaftServer) error {
+	for {
+		batch, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if !ris.streamChecker.check(batch) {
+			continue
+		}
+		for _, msg := range batch.GetMsgs() {
+			ris.handleRaftMessage(msg)
+		}
+		for _, hb := range batch.GetHeartbeats() {
+			ris.handleRaftMessage(heartbeatRaftMessage(batch, hb))
+		}
+	}
+}
+
+// handleRaftMessage sends the message to raftstore. A message with an inline snapshot is sent after the snapshot is
+// saved by the snap worker. If the snap worker is busy the message is dropped rather than blocking the stream, which
+// carries the messages of all the other regions, and the leader sends the snapshot again.
+func (ris *RaftInnerServer) handleRaftMessage(msg *raft_serverpb.RaftMessage) {
+	if len(msg.GetInlineSnapshot()) == 0 {
 		ris.raftRouter.SendRaftMessage(msg)
+		return
+	}
+	select {
+	case ris.snapWorker.Sender() <- worker.Task{
+		Tp:   worker.TaskTypeSnapRecvInline,
+		Data: recvInlineSnapTask{msg: msg},
+	}:
+	default:
+		log.Warnf("snap worker is busy, drop inline snapshot of region %v", msg.GetRegionId())
 	}
 }
 
@@ -188,6 +356,38 @@ func (ris *RaftInnerServer) Snapshot(stream tikvpb.Tikv_SnapshotServer) error {
 	return err
 }
 
+// WatchRegions streams the events of the watched regions led by this store, until the client goes away or falls
+// behind.
+func (ris *RaftInnerServer) WatchRegions(req *kvrpcpb.WatchRegionsRequest, stream tikvpb.Tikv_WatchRegionsServer) error {
+	hub := ris.batchSystem.RegionEvents()
+	w := hub.Watch(req.Ranges)
+	defer hub.Unwatch(w)
+	if err := stream.Send(&kvrpcpb.WatchRegionsResponse{}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case event, ok := <-w.Events:
+			if !ok {
+				return stream.Send(&kvrpcpb.WatchRegionsResponse{Error: "region watcher fell behind"})
+			}
+			resp := &kvrpcpb.WatchRegionsResponse{Events: []*kvrpcpb.RegionEvent{event}}
+			// Send the events queued meanwhile together.
+			for n := len(w.Events); n > 0; n-- {
+				if event, ok = <-w.Events; !ok {
+					break
+				}
+				resp.Events = append(resp.Events, event)
+			}
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func (ris *RaftInnerServer) GetRaftstoreRouter() *raftstore.RaftstoreRouter {
 	return ris.raftRouter
 }
@@ -203,6 +403,7 @@ func (ris *RaftInnerServer) Start(pdClient pd.Client) error {
 	ris.snapWorker = worker.NewWorker("snap-worker", &wg)
 
 	cfg := ris.raftConfig
+	cfg.ClusterID = pdClient.GetClusterID(context.TODO())
 	router, batchSystem := raftstore.CreateRaftBatchSystem(cfg)
 
 	ris.snapManager = snap.NewSnapManager(cfg.SnapPath)
@@ -211,8 +412,8 @@ func (ris *RaftInnerServer) Start(pdClient pd.Client) error {
 	ris.node = raftstore.NewNode(ris.batchSystem, &ris.storeMeta, ris.raftConfig, pdClient)
 
 	resolveSender := ris.resolveWorker.Sender()
-	raftClient := newRaftClient(ris.raftConfig)
-	trans := NewServerTransport(raftClient, resolveSender, ris.raftRouter, resolveSender)
+	ris.raftClient = newRaftClient(ris.raftConfig)
+	trans := NewServerTransport(ris.raftClient, resolveSender, ris.raftRouter, resolveSender)
 
 	resolveRunner := newResolverRunner(pdClient)
 
@@ -221,7 +422,7 @@ func (ris *RaftInnerServer) Start(pdClient pd.Client) error {
 	if err != nil {
 		return err
 	}
-	snapRunner := newSnapRunner(ris.snapManager, ris.raftConfig, ris.raftRouter)
+	snapRunner := newSnapRunner(ris.snapManager, ris.raftConfig, ris.raftRouter, ris.raftClient)
 	ris.snapWorker.Start(snapRunner)
 
 	return nil
@@ -0,0 +1,95 @@
+package inner_server
+
+import (
+	"hash/crc64"
+
+	"github.com/coocood/badger"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/errors"
+)
+
+var seedCrcTable = crc64.MakeTable(crc64.ECMA)
+
+// SeedWrite writes the pairs directly into the kv engine, bypassing raft. The raft logs of the regions don't know
+// about the pairs, so it's only safe for loading the initial data of an empty cluster, with every store seeded with
+// the same pairs. The seed is rejected if any region of the store already has data when it starts.
+func (ris *RaftInnerServer) SeedWrite(pairs []*kvrpcpb.ExportedPair) error {
+	return seedWrite(ris.engines.Kv, pairs)
+}
+
+// SeedChecksum computes the checksum of all the data in the kv engine, the seeded stores must have the same one.
+func (ris *RaftInnerServer) SeedChecksum() (*kvrpcpb.SeedChecksumResponse, error) {
+	return seedChecksum(ris.engines.Kv)
+}
+
+func seedWrite(db *badger.DB, pairs []*kvrpcpb.ExportedPair) error {
+	started, err := checkSeedStarted(db)
+	if err != nil {
+		return err
+	}
+	wb := new(engine_util.WriteBatch)
+	if !started {
+		wb.Set(raftstore.SeedStartedKey, []byte{1})
+	}
+	for _, pair := range pairs {
+		cf := pair.Cf
+		if cf == "" {
+			cf = engine_util.CF_DEFAULT
+		}
+		wb.SetCF(cf, pair.Key, pair.Value)
+	}
+	return wb.WriteToDB(db)
+}
+
+// checkSeedStarted returns whether the seed has started. If it hasn't, every column family must be empty, the data
+// written through raft would be missing from the other stores.
+func checkSeedStarted(db *badger.DB) (bool, error) {
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	if _, err := txn.Get(raftstore.SeedStartedKey); err == nil {
+		return true, nil
+	} else if err != badger.ErrKeyNotFound {
+		return false, err
+	}
+	for _, cf := range engine_util.CFs {
+		iter := engine_util.NewCFIterator(cf, txn)
+		iter.Seek(nil)
+		hasData := iter.Valid()
+		iter.Close()
+		if hasData {
+			return false, errors.Errorf("the regions of the store have data in the %s column family, only an empty store can be seeded", cf)
+		}
+	}
+	return false, nil
+}
+
+// seedChecksum digests the column family, key and value of every pair by CRC64 and xor-s the digests together, so
+// the result doesn't depend on the order the pairs are written. The raft states of the regions are left out, they
+// differ between the stores.
+func seedChecksum(db *badger.DB) (*kvrpcpb.SeedChecksumResponse, error) {
+	resp := new(kvrpcpb.SeedChecksumResponse)
+	txn := db.NewTransaction(false)
+	defer txn.Discard()
+	for _, cf := range engine_util.CFs {
+		iter := engine_util.NewCFIterator(cf, txn)
+		for iter.Seek(nil); iter.Valid(); iter.Next() {
+			item := iter.Item()
+			value, err := item.Value()
+			if err != nil {
+				iter.Close()
+				return nil, err
+			}
+			digest := crc64.New(seedCrcTable)
+			digest.Write([]byte(cf))
+			digest.Write(item.Key())
+			digest.Write(value)
+			resp.Checksum ^= digest.Sum64()
+			resp.TotalKvs++
+			resp.TotalBytes += uint64(len(item.Key()) + len(value))
+		}
+		iter.Close()
+	}
+	return resp, nil
+}
@@ -0,0 +1,84 @@
+package inner_server
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/coocood/badger"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openSeedTestDB(t *testing.T) (*badger.DB, func()) {
+	dir, err := ioutil.TempDir("", "tinykv_seed")
+	require.Nil(t, err)
+	opts := badger.DefaultOptions
+	opts.Dir = dir
+	opts.ValueDir = dir
+	db, err := badger.Open(opts)
+	require.Nil(t, err)
+	return db, func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestSeedChecksum(t *testing.T) {
+	db1, clean1 := openSeedTestDB(t)
+	defer clean1()
+	db2, clean2 := openSeedTestDB(t)
+	defer clean2()
+
+	pairs := []*kvrpcpb.ExportedPair{
+		{Key: []byte("a"), Value: []byte("1")},
+		{Cf: engine_util.CF_WRITE, Key: []byte("a"), Value: []byte("2")},
+		{Cf: engine_util.CF_LOCK, Key: []byte("b"), Value: []byte("3")},
+	}
+	require.Nil(t, seedWrite(db1, pairs))
+	// The order of the pairs doesn't matter.
+	require.Nil(t, seedWrite(db2, []*kvrpcpb.ExportedPair{pairs[2], pairs[0], pairs[1]}))
+	// Neither do the raft states, which aren't in any column family.
+	wb := new(engine_util.WriteBatch)
+	wb.Set([]byte{0x01, 0x02}, []byte("state"))
+	require.Nil(t, wb.WriteToDB(db2))
+
+	sum1, err := seedChecksum(db1)
+	require.Nil(t, err)
+	sum2, err := seedChecksum(db2)
+	require.Nil(t, err)
+	assert.Equal(t, sum1, sum2)
+	assert.Equal(t, uint64(3), sum1.TotalKvs)
+	assert.Equal(t, uint64(6), sum1.TotalBytes)
+
+	// The same pair in another column family is different data.
+	require.Nil(t, seedWrite(db2, []*kvrpcpb.ExportedPair{{Cf: engine_util.CF_WRITE, Key: []byte("b"), Value: []byte("3")}}))
+	sum2, err = seedChecksum(db2)
+	require.Nil(t, err)
+	assert.NotEqual(t, sum1.Checksum, sum2.Checksum)
+}
+
+func TestSeedWriteEmptyStore(t *testing.T) {
+	db, clean := openSeedTestDB(t)
+	defer clean()
+
+	// The seed may take several writes once it has started.
+	require.Nil(t, seedWrite(db, []*kvrpcpb.ExportedPair{{Key: []byte("a"), Value: []byte("1")}}))
+	require.Nil(t, seedWrite(db, []*kvrpcpb.ExportedPair{{Key: []byte("b"), Value: []byte("2")}}))
+	sum, err := seedChecksum(db)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(2), sum.TotalKvs)
+
+	// A store which has data written otherwise can't be seeded.
+	db2, clean2 := openSeedTestDB(t)
+	defer clean2()
+	wb := new(engine_util.WriteBatch)
+	wb.SetCF(engine_util.CF_WRITE, []byte("a"), []byte("w"))
+	require.Nil(t, wb.WriteToDB(db2))
+	assert.NotNil(t, seedWrite(db2, []*kvrpcpb.ExportedPair{{Key: []byte("b"), Value: []byte("2")}}))
+	sum, err = seedChecksum(db2)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), sum.TotalKvs)
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/message"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/snap"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/worker"
+	"github.com/pingcap-incubator/tinykv/kv/util/clusterid"
+	"github.com/pingcap-incubator/tinykv/kv/util/memory"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/tikvpb"
 	"github.com/pingcap/errors"
@@ -30,19 +32,26 @@ type recvSnapTask struct {
 	callback func(error)
 }
 
+// recvInlineSnapTask saves the snapshot carried by the message, then sends the message to raftstore.
+type recvInlineSnapTask struct {
+	msg *raft_serverpb.RaftMessage
+}
+
 type snapRunner struct {
 	config         *config.Config
 	snapManager    *snap.SnapManager
 	router         message.RaftRouter
+	raftClient     *RaftClient
 	sendingCount   int64
 	receivingCount int64
 }
 
-func newSnapRunner(snapManager *snap.SnapManager, config *config.Config, router message.RaftRouter) *snapRunner {
+func newSnapRunner(snapManager *snap.SnapManager, config *config.Config, router message.RaftRouter, raftClient *RaftClient) *snapRunner {
 	return &snapRunner{
 		config:      config,
 		snapManager: snapManager,
 		router:      router,
+		raftClient:  raftClient,
 	}
 }
 
@@ -52,6 +61,8 @@ func (r *snapRunner) Handle(t worker.Task) {
 		r.send(t.Data.(sendSnapTask))
 	case worker.TaskTypeSnapRecv:
 		r.recv(t.Data.(recvSnapTask))
+	case worker.TaskTypeSnapRecvInline:
+		r.recvInline(t.Data.(recvInlineSnapTask))
 	}
 }
 
@@ -69,6 +80,10 @@ func (r *snapRunner) send(t sendSnapTask) {
 
 const snapChunkLen = 1024 * 1024
 
+// snapPipelineDepth is the number of chunks buffered between reading a snapshot and sending it, and between receiving
+// a snapshot and writing it, so that disk IO and checksum computation overlap with network IO.
+const snapPipelineDepth = 4
+
 func (r *snapRunner) sendSnap(addr string, msg *raft_serverpb.RaftMessage) error {
 	start := time.Now()
 	msgSnap := msg.GetMessage().GetSnapshot()
@@ -87,18 +102,22 @@ func (r *snapRunner) sendSnap(addr string, msg *raft_serverpb.RaftMessage) error
 	if !snap.Exists() {
 		return errors.Errorf("missing snap file: %v", snap.Path())
 	}
+	if r.raftClient != nil && snap.TotalSize() <= r.config.SnapInlineMaxSize {
+		return r.sendInlineSnap(addr, msg, snap)
+	}
 
-	cc, err := grpc.Dial(addr, grpc.WithInsecure(),
+	opts := append(clusterid.DialOptions(r.config.ClusterID), grpc.WithInsecure(),
 		grpc.WithInitialWindowSize(int32(r.config.GrpcInitialWindowSize)),
 		grpc.WithKeepaliveParams(keepalive.ClientParameters{
 			Time:    r.config.GrpcKeepAliveTime,
 			Timeout: r.config.GrpcKeepAliveTimeout,
 		}))
+	cc, err := grpc.Dial(addr, opts...)
 	if err != nil {
 		return err
 	}
 	client := tikvpb.NewTikvClient(cc)
-	stream, err := client.Snapshot(context.TODO())
+	stream, err := client.Snapshot(clusterid.NewOutgoingContext(context.TODO(), r.config.ClusterID, msg.ToPeer.GetStoreId()))
 	if err != nil {
 		return err
 	}
@@ -107,20 +126,25 @@ func (r *snapRunner) sendSnap(addr string, msg *raft_serverpb.RaftMessage) error
 		return err
 	}
 
-	buf := make([]byte, snapChunkLen)
-	for remain := snap.TotalSize(); remain > 0; remain -= uint64(len(buf)) {
-		if remain < uint64(len(buf)) {
-			buf = buf[:remain]
-		}
-		_, err := io.ReadFull(snap, buf)
-		if err != nil {
-			return errors.Errorf("failed to read snapshot chunk: %v", err)
-		}
-		err = stream.Send(&raft_serverpb.SnapshotChunk{Data: buf})
+	bufSize := pipelineBufSize(snap.TotalSize())
+	memory.StoreBudget.Consume(memory.SnapshotBuffer, bufSize)
+	defer memory.StoreBudget.Release(memory.SnapshotBuffer, bufSize)
+	chunks := make(chan []byte, snapPipelineDepth)
+	readErr := make(chan error, 1)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		readErr <- readSnapChunks(snap, snap.TotalSize(), chunks, done)
+	}()
+	for chunk := range chunks {
+		err = stream.Send(&raft_serverpb.SnapshotChunk{Data: chunk})
 		if err != nil {
 			return err
 		}
 	}
+	if err = <-readErr; err != nil {
+		return err
+	}
 	_, err = stream.CloseAndRecv()
 	if err != nil {
 		return err
@@ -130,6 +154,55 @@ func (r *snapRunner) sendSnap(addr string, msg *raft_serverpb.RaftMessage) error
 	return nil
 }
 
+// sendInlineSnap sends the snapshot with the message over the BatchRaft stream. It returns once the message is
+// buffered, a lost message is recovered by raft, which sends the snapshot again.
+func (r *snapRunner) sendInlineSnap(addr string, msg *raft_serverpb.RaftMessage, snapshot snap.Snapshot) error {
+	data := make([]byte, snapshot.TotalSize())
+	if _, err := io.ReadFull(snapshot, data); err != nil {
+		return errors.Errorf("failed to read snapshot: %v", err)
+	}
+	inlineMsg := *msg
+	inlineMsg.InlineSnapshot = data
+	if err := r.raftClient.Send(msg.GetToPeer().GetStoreId(), addr, &inlineMsg); err != nil {
+		return err
+	}
+	log.Infof("sent inline snapshot. regionID: %v, size: %v", msg.GetRegionId(), len(data))
+	return nil
+}
+
+// pipelineBufSize returns the most memory held by the chunks of a snapshot of the size while it is being sent or
+// received: the chunks queued in the pipeline plus one on each end.
+func pipelineBufSize(size uint64) int64 {
+	bufSize := uint64(snapPipelineDepth+2) * snapChunkLen
+	if size < bufSize {
+		bufSize = size
+	}
+	return int64(bufSize)
+}
+
+// readSnapChunks reads size bytes from snap and passes them to chunks until done is closed.
+func readSnapChunks(snap io.Reader, size uint64, chunks chan<- []byte, done <-chan struct{}) error {
+	defer close(chunks)
+	for remain := size; remain > 0; {
+		n := uint64(snapChunkLen)
+		if remain < n {
+			n = remain
+		}
+		// The chunk may still be referenced by the stream after it is sent, so it can't be reused.
+		buf := make([]byte, n)
+		if _, err := io.ReadFull(snap, buf); err != nil {
+			return errors.Errorf("failed to read snapshot chunk: %v", err)
+		}
+		select {
+		case chunks <- buf:
+		case <-done:
+			return nil
+		}
+		remain -= n
+	}
+	return nil
+}
+
 func (r *snapRunner) recv(t recvSnapTask) {
 	if n := atomic.LoadInt64(&r.receivingCount); n > int64(r.config.ConcurrentRecvSnapLimit) {
 		log.Warnf("too many recving snapshot tasks, ignore")
@@ -172,29 +245,96 @@ func (r *snapRunner) recvSnap(stream tikvpb.Tikv_SnapshotServer) (*raft_serverpb
 	r.snapManager.Register(snapKey, snap.SnapEntryReceiving)
 	defer r.snapManager.Deregister(snapKey, snap.SnapEntryReceiving)
 
+	bufSize := pipelineBufSize(snapshot.TotalSize())
+	memory.StoreBudget.Consume(memory.SnapshotBuffer, bufSize)
+	defer memory.StoreBudget.Release(memory.SnapshotBuffer, bufSize)
+	chunks := make(chan []byte, snapPipelineDepth)
+	writeErr := make(chan error, 1)
+	go func() {
+		writeErr <- writeSnapChunks(snapshot, chunks)
+	}()
+	err = recvSnapChunks(stream, chunks)
+	if wErr := <-writeErr; wErr != nil {
+		return nil, errors.Errorf("%v failed to write snapshot file %v: %v", snapKey, snapshot.Path(), wErr)
+	}
+	if err != nil {
+		return nil, errors.Errorf("%v %v", snapKey, err)
+	}
+
+	err = snapshot.Save()
+	if err != nil {
+		return nil, err
+	}
+
+	stream.SendAndClose(&raft_serverpb.Done{})
+	return head.GetMessage(), nil
+}
+
+func (r *snapRunner) recvInline(t recvInlineSnapTask) {
+	if n := atomic.LoadInt64(&r.receivingCount); n > int64(r.config.ConcurrentRecvSnapLimit) {
+		log.Warnf("too many recving snapshot tasks, drop inline snapshot of region %v", t.msg.GetRegionId())
+		return
+	}
+	atomic.AddInt64(&r.receivingCount, 1)
+	defer atomic.AddInt64(&r.receivingCount, -1)
+	if err := r.recvInlineSnap(t.msg); err != nil {
+		log.Errorf("failed to receive inline snapshot of region %v: %v", t.msg.GetRegionId(), err)
+		return
+	}
+	t.msg.InlineSnapshot = nil
+	r.router.SendRaftMessage(t.msg)
+}
+
+func (r *snapRunner) recvInlineSnap(msg *raft_serverpb.RaftMessage) error {
+	msgSnap := msg.GetMessage().GetSnapshot()
+	snapKey, err := snap.SnapKeyFromSnap(msgSnap)
+	if err != nil {
+		return errors.Errorf("failed to create snap key: %v", err)
+	}
+	snapshot, err := r.snapManager.GetSnapshotForReceiving(snapKey, msgSnap.GetData())
+	if err != nil {
+		return errors.Errorf("%v failed to create snapshot file: %v", snapKey, err)
+	}
+	if snapshot.Exists() {
+		log.Infof("snapshot file already exists, skip receiving. snapKey: %v, file: %v", snapKey, snapshot.Path())
+		return nil
+	}
+	r.snapManager.Register(snapKey, snap.SnapEntryReceiving)
+	defer r.snapManager.Deregister(snapKey, snap.SnapEntryReceiving)
+
+	if _, err = bytes.NewReader(msg.InlineSnapshot).WriteTo(snapshot); err != nil {
+		return errors.Errorf("%v failed to write snapshot file %v: %v", snapKey, snapshot.Path(), err)
+	}
+	return snapshot.Save()
+}
+
+// recvSnapChunks receives the chunks of a snapshot from stream and passes them to chunks.
+func recvSnapChunks(stream tikvpb.Tikv_SnapshotServer, chunks chan<- []byte) error {
+	defer close(chunks)
 	for {
 		chunk, err := stream.Recv()
 		if err != nil {
 			if err == io.EOF {
-				break
+				return nil
 			}
-			return nil, err
+			return err
 		}
 		data := chunk.GetData()
 		if len(data) == 0 {
-			return nil, errors.Errorf("%v receive chunk with empty data", snapKey)
-		}
-		_, err = bytes.NewReader(data).WriteTo(snapshot)
-		if err != nil {
-			return nil, errors.Errorf("%v failed to write snapshot file %v: %v", snapKey, snapshot.Path(), err)
+			return errors.New("receive chunk with empty data")
 		}
+		chunks <- data
 	}
+}
 
-	err = snapshot.Save()
-	if err != nil {
-		return nil, err
+// writeSnapChunks writes the chunks to snapshot, which also computes the checksum. After a failure it keeps draining
+// chunks so that the receiving side is not blocked.
+func writeSnapChunks(snapshot io.Writer, chunks <-chan []byte) error {
+	var err error
+	for data := range chunks {
+		if err == nil {
+			_, err = bytes.NewReader(data).WriteTo(snapshot)
+		}
 	}
-
-	stream.SendAndClose(&raft_serverpb.Done{})
-	return head.GetMessage(), nil
+	return err
 }
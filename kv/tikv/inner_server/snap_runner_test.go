@@ -0,0 +1,304 @@
+package inner_server
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/coocood/badger"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/config"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/snap"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/worker"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tikvpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type captureRouter struct {
+	message.RaftRouter
+	msgs []*raft_serverpb.RaftMessage
+}
+
+func (r *captureRouter) SendRaftMessage(msg *raft_serverpb.RaftMessage) {
+	r.msgs = append(r.msgs, msg)
+}
+
+// buildTestSnap builds a snapshot of a region with a few keys and returns the raft message carrying it.
+func buildTestSnap(t *testing.T, mgr *snap.SnapManager, dir string) *raft_serverpb.RaftMessage {
+	opts := badger.DefaultOptions
+	opts.Dir = dir
+	opts.ValueDir = dir
+	db, err := badger.Open(opts)
+	require.Nil(t, err)
+	defer db.Close()
+	wb := new(engine_util.WriteBatch)
+	for _, cf := range engine_util.CFs {
+		wb.SetCF(cf, []byte("k"), make([]byte, 32))
+	}
+	require.Nil(t, wb.WriteToDB(db))
+
+	region := &metapb.Region{
+		Id:          1,
+		EndKey:      []byte("z"),
+		RegionEpoch: &metapb.RegionEpoch{Version: 1, ConfVer: 1},
+		Peers:       []*metapb.Peer{{Id: 1, StoreId: 1}, {Id: 2, StoreId: 2}},
+	}
+	key := snap.SnapKey{RegionID: 1, Term: 1, Index: 10}
+	s, err := mgr.GetSnapshotForBuilding(key)
+	require.Nil(t, err)
+	snapData := &raft_serverpb.RaftSnapshotData{Region: region}
+	require.Nil(t, s.Build(db.NewTransaction(false), region, snapData, new(snap.SnapStatistics), mgr, nil))
+	data, err := snapData.Marshal()
+	require.Nil(t, err)
+
+	return &raft_serverpb.RaftMessage{
+		RegionId: 1,
+		FromPeer: region.Peers[0],
+		ToPeer:   region.Peers[1],
+		Message: &eraftpb.Message{
+			MsgType: eraftpb.MessageType_MsgSnapshot,
+			Snapshot: &eraftpb.Snapshot{
+				Data:     data,
+				Metadata: &eraftpb.SnapshotMetadata{Index: key.Index, Term: key.Term},
+			},
+		},
+	}
+}
+
+func TestInlineSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "inline_snapshot")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	srcMgr := snap.NewSnapManager(dir + "/src")
+	require.Nil(t, srcMgr.Init())
+	dstMgr := snap.NewSnapManager(dir + "/dst")
+	require.Nil(t, dstMgr.Init())
+	msg := buildTestSnap(t, srcMgr, dir+"/db")
+
+	grpcServer, svr, addr := startBatchRaftServer(t, "127.0.0.1:0")
+	defer grpcServer.Stop()
+	cfg := config.NewDefaultConfig()
+	sender := newSnapRunner(srcMgr, cfg, nil, newRaftClient(cfg))
+	require.Nil(t, sender.sendSnap(addr, msg))
+
+	var received *raft_serverpb.RaftMessage
+	select {
+	case received = <-svr.msgs:
+	case <-time.After(5 * time.Second):
+		t.Fatal("inline snapshot is not received")
+	}
+	require.NotEmpty(t, received.InlineSnapshot)
+	assert.Empty(t, msg.InlineSnapshot)
+
+	router := new(captureRouter)
+	receiver := newSnapRunner(dstMgr, cfg, router, nil)
+	receiver.recvInline(recvInlineSnapTask{msg: received})
+	require.Len(t, router.msgs, 1)
+	assert.Empty(t, router.msgs[0].InlineSnapshot)
+	snapKey, err := snap.SnapKeyFromSnap(msg.Message.Snapshot)
+	require.Nil(t, err)
+	s, err := dstMgr.GetSnapshotForApplying(snapKey)
+	require.Nil(t, err)
+	assert.True(t, s.Exists())
+
+	// The snapshots larger than the limit are sent over a Snapshot stream.
+	cfg.SnapInlineMaxSize = 0
+	require.Nil(t, sender.sendSnap(addr, msg))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&svr.snapshots))
+}
+
+// TestInlineSnapshotBusy tests that an inline snapshot is dropped instead of blocking the raft stream when the snap
+// worker is busy.
+func TestInlineSnapshotBusy(t *testing.T) {
+	ris := &RaftInnerServer{snapWorker: worker.NewWorker("snap-worker", new(sync.WaitGroup))}
+	msg := &raft_serverpb.RaftMessage{RegionId: 1, InlineSnapshot: []byte{1}}
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 1000; i++ {
+			ris.handleRaftMessage(msg)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the raft stream is blocked by the snap worker")
+	}
+}
+
+// snapRecvServer receives the snapshots sent over Snapshot streams with a snapRunner.
+type snapRecvServer struct {
+	tikvpb.TikvServer
+	runner *snapRunner
+	msgs   chan *raft_serverpb.RaftMessage
+}
+
+func (s *snapRecvServer) Snapshot(stream tikvpb.Tikv_SnapshotServer) error {
+	msg, err := s.runner.recvSnap(stream)
+	if err != nil {
+		return err
+	}
+	s.msgs <- msg
+	return nil
+}
+
+// fakeSnapStream replays the chunks of a Snapshot stream.
+type fakeSnapStream struct {
+	tikvpb.Tikv_SnapshotServer
+	chunks []*raft_serverpb.SnapshotChunk
+	closed bool
+}
+
+func (s *fakeSnapStream) Recv() (*raft_serverpb.SnapshotChunk, error) {
+	if len(s.chunks) == 0 {
+		return nil, io.EOF
+	}
+	chunk := s.chunks[0]
+	s.chunks = s.chunks[1:]
+	return chunk, nil
+}
+
+func (s *fakeSnapStream) SendAndClose(*raft_serverpb.Done) error {
+	s.closed = true
+	return nil
+}
+
+// TestSnapshotStream tests that a snapshot sent over a Snapshot stream is received and saved.
+func TestSnapshotStream(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshot_stream")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	srcMgr := snap.NewSnapManager(dir + "/src")
+	require.Nil(t, srcMgr.Init())
+	dstMgr := snap.NewSnapManager(dir + "/dst")
+	require.Nil(t, dstMgr.Init())
+	msg := buildTestSnap(t, srcMgr, dir+"/db")
+
+	cfg := config.NewDefaultConfig()
+	svr := &snapRecvServer{runner: newSnapRunner(dstMgr, cfg, nil, nil), msgs: make(chan *raft_serverpb.RaftMessage, 1)}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	grpcServer := grpc.NewServer()
+	tikvpb.RegisterTikvServer(grpcServer, svr)
+	go grpcServer.Serve(l)
+	defer grpcServer.Stop()
+
+	sender := newSnapRunner(srcMgr, cfg, nil, nil)
+	require.Nil(t, sender.sendSnap(l.Addr().String(), msg))
+	select {
+	case received := <-svr.msgs:
+		assert.Equal(t, msg.GetMessage().GetSnapshot().GetMetadata(), received.GetMessage().GetSnapshot().GetMetadata())
+	case <-time.After(5 * time.Second):
+		t.Fatal("snapshot is not received")
+	}
+	snapKey, err := snap.SnapKeyFromSnap(msg.Message.Snapshot)
+	require.Nil(t, err)
+	s, err := dstMgr.GetSnapshotForApplying(snapKey)
+	require.Nil(t, err)
+	assert.True(t, s.Exists())
+}
+
+// TestRecvSnapCorrupted tests that a snapshot received truncated or with corrupted data isn't saved.
+func TestRecvSnapCorrupted(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recv_snap_corrupted")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	srcMgr := snap.NewSnapManager(dir + "/src")
+	require.Nil(t, srcMgr.Init())
+	msg := buildTestSnap(t, srcMgr, dir+"/db")
+	snapKey, err := snap.SnapKeyFromSnap(msg.Message.Snapshot)
+	require.Nil(t, err)
+	s, err := srcMgr.GetSnapshotForSending(snapKey)
+	require.Nil(t, err)
+	data := make([]byte, s.TotalSize())
+	_, err = io.ReadFull(s, data)
+	require.Nil(t, err)
+
+	recv := func(name string, data []byte) error {
+		dstMgr := snap.NewSnapManager(dir + "/" + name)
+		require.Nil(t, dstMgr.Init())
+		stream := &fakeSnapStream{chunks: []*raft_serverpb.SnapshotChunk{{Message: msg}, {Data: data}}}
+		_, err := newSnapRunner(dstMgr, config.NewDefaultConfig(), nil, nil).recvSnap(stream)
+		s, sErr := dstMgr.GetSnapshotForApplying(snapKey)
+		if err == nil {
+			require.Nil(t, sErr)
+			assert.True(t, s.Exists())
+			assert.True(t, stream.closed)
+		} else {
+			assert.True(t, sErr != nil || !s.Exists())
+			assert.False(t, stream.closed)
+		}
+		return err
+	}
+
+	assert.Nil(t, recv("intact", data))
+	err = recv("truncated", data[:len(data)-1])
+	require.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), "size mismatch"), err.Error())
+	corrupted := append([]byte(nil), data...)
+	corrupted[len(corrupted)-1] ^= 0xff
+	err = recv("corrupted", corrupted)
+	require.NotNil(t, err)
+	assert.True(t, strings.Contains(err.Error(), "checksum mismatch"), err.Error())
+}
+
+// TestReadSnapChunks tests that a snapshot is split into chunks, that a short read fails, and that the chunks left
+// after a failed write are drained.
+func TestReadSnapChunks(t *testing.T) {
+	data := make([]byte, 2*snapChunkLen+10)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	read := func(r io.Reader, size uint64) ([][]byte, error) {
+		chunks := make(chan []byte, snapPipelineDepth)
+		readErr := make(chan error, 1)
+		go func() {
+			readErr <- readSnapChunks(r, size, chunks, make(chan struct{}))
+		}()
+		var res [][]byte
+		for chunk := range chunks {
+			res = append(res, chunk)
+		}
+		return res, <-readErr
+	}
+
+	chunks, err := read(bytes.NewReader(data), uint64(len(data)))
+	require.Nil(t, err)
+	require.Len(t, chunks, 3)
+	assert.Len(t, chunks[0], snapChunkLen)
+	assert.Len(t, chunks[2], 10)
+	assert.Equal(t, data, bytes.Join(chunks, nil))
+
+	chunks, err = read(bytes.NewReader(data[:snapChunkLen+5]), uint64(len(data)))
+	assert.NotNil(t, err)
+	assert.Len(t, chunks, 1)
+
+	// The chunks written after a failure are drained.
+	ch := make(chan []byte, 3)
+	ch <- []byte{1}
+	ch <- []byte{2}
+	ch <- []byte{3}
+	close(ch)
+	assert.NotNil(t, writeSnapChunks(failingWriter{}, ch))
+	assert.Len(t, ch, 0)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
@@ -27,6 +27,10 @@ func (is *StandAloneInnerServer) Raft(stream tikvpb.Tikv_RaftServer) error {
 	return nil
 }
 
+func (is *StandAloneInnerServer) BatchRaft(stream tikvpb.Tikv_BatchRaftServer) error {
+	return nil
+}
+
 func (is *StandAloneInnerServer) Snapshot(stream tikvpb.Tikv_SnapshotServer) error {
 	return nil
 }
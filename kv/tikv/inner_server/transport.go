@@ -99,6 +99,9 @@ func (t *ServerTransport) SendSnapshotSock(addr string, msg *raft_serverpb.RaftM
 			callback: callback,
 		},
 	}
+	if msg.Repair {
+		task.Priority = worker.TaskPriorityHigh
+	}
 	t.snapScheduler <- task
 }
 
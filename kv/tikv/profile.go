@@ -0,0 +1,123 @@
+package tikv
+
+import (
+	"bufio"
+	"runtime"
+	"runtime/pprof"
+	"sync"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tikvpb"
+)
+
+const (
+	// profileMaxDuration caps the duration of a sampled profile.
+	profileMaxDuration = 5 * time.Minute
+	// profileChunkSize is the max size of the data of a ProfileResponse.
+	profileChunkSize = 64 * 1024
+)
+
+// blockProfile tracks the block profile rate, the runtime doesn't report it.
+var blockProfile struct {
+	sync.Mutex
+	// The rate set by SetBlockProfileRate.
+	rate int
+	// The number of the block profiles being sampled.
+	sampling int
+}
+
+// SetBlockProfileRate sets the block profile rate of the runtime like runtime.SetBlockProfileRate, the rate is
+// restored once the block profiles sampled by Profile are done. It's 0 by default.
+func SetBlockProfileRate(rate int) {
+	blockProfile.Lock()
+	defer blockProfile.Unlock()
+	blockProfile.rate = rate
+	if blockProfile.sampling == 0 {
+		runtime.SetBlockProfileRate(rate)
+	}
+}
+
+// startBlockProfile records every blocking event until the block profiles sampled at the same time are all stopped.
+func startBlockProfile() {
+	blockProfile.Lock()
+	defer blockProfile.Unlock()
+	if blockProfile.sampling == 0 {
+		runtime.SetBlockProfileRate(1)
+	}
+	blockProfile.sampling++
+}
+
+func stopBlockProfile() {
+	blockProfile.Lock()
+	defer blockProfile.Unlock()
+	blockProfile.sampling--
+	if blockProfile.sampling == 0 {
+		runtime.SetBlockProfileRate(blockProfile.rate)
+	}
+}
+
+// profileWriter splits the profile written to it over the responses of the stream.
+type profileWriter struct {
+	stream tikvpb.Tikv_ProfileServer
+}
+
+func (w profileWriter) Write(p []byte) (int, error) {
+	data := make([]byte, len(p))
+	copy(data, p)
+	if err := w.stream.Send(&kvrpcpb.ProfileResponse{Data: data}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Profile streams a pprof profile of the server, so it can be collected through the gRPC port of a store when its
+// status port isn't reachable. The CPU, mutex and block profiles are sampled for the duration of the request, or until
+// the client cancels it.
+func (svr *Server) Profile(req *kvrpcpb.ProfileRequest, stream tikvpb.Tikv_ProfileServer) error {
+	duration := time.Duration(req.DurationSecs) * time.Second
+	if duration > profileMaxDuration {
+		duration = profileMaxDuration
+	}
+	w := bufio.NewWriterSize(profileWriter{stream}, profileChunkSize)
+	var err error
+	switch req.Type {
+	case kvrpcpb.ProfileType_ProfileCPU:
+		if err = pprof.StartCPUProfile(w); err != nil {
+			break
+		}
+		waitProfile(stream, duration)
+		pprof.StopCPUProfile()
+	case kvrpcpb.ProfileType_ProfileHeap:
+		err = pprof.Lookup("heap").WriteTo(w, 0)
+	case kvrpcpb.ProfileType_ProfileMutex:
+		prev := runtime.SetMutexProfileFraction(1)
+		waitProfile(stream, duration)
+		err = pprof.Lookup("mutex").WriteTo(w, 0)
+		runtime.SetMutexProfileFraction(prev)
+	case kvrpcpb.ProfileType_ProfileBlock:
+		startBlockProfile()
+		waitProfile(stream, duration)
+		err = pprof.Lookup("block").WriteTo(w, 0)
+		stopBlockProfile()
+	case kvrpcpb.ProfileType_ProfileGoroutine:
+		err = pprof.Lookup("goroutine").WriteTo(w, 0)
+	default:
+		return stream.Send(&kvrpcpb.ProfileResponse{Error: "unknown profile type " + req.Type.String()})
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		return stream.Send(&kvrpcpb.ProfileResponse{Error: err.Error()})
+	}
+	return nil
+}
+
+// waitProfile waits for the duration of a sampled profile, or until the client cancels the request.
+func waitProfile(stream tikvpb.Tikv_ProfileServer, duration time.Duration) {
+	select {
+	case <-time.After(duration):
+	case <-stream.Context().Done():
+	}
+}
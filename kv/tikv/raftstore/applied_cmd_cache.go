@@ -0,0 +1,174 @@
+package raftstore
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/coocood/badger"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap/errors"
+)
+
+// appliedCmd is a command tagged with a uuid which has been applied.
+type appliedCmd struct {
+	uuid  string
+	term  uint64
+	index uint64
+	// The response of the command, nil if the command was applied before the
+	// cache was loaded from the engine.
+	resp *raft_cmdpb.RaftCmdResponse
+}
+
+// appliedCmdCache records the commands tagged with a uuid applied within the
+// last `window` log entries of a region. A command re-proposed after an
+// ambiguous leader change carries the same uuid, the applier skips it and
+// returns the cached response instead of applying it twice.
+//
+// Every peer must make the same decision for an entry, so the commands are
+// part of the state machine: they are written to the kv engine along with the
+// applied data, loaded when the applier is registered and carried in the
+// snapshots. The responses are only kept in memory.
+type appliedCmdCache struct {
+	regionID uint64
+	window   uint64
+	cmds     map[string]*appliedCmd
+	// Commands in the order of index, used to evict the ones out of the window.
+	order []*appliedCmd
+}
+
+func newAppliedCmdCache(regionID, window uint64) *appliedCmdCache {
+	return &appliedCmdCache{
+		regionID: regionID,
+		window:   window,
+		cmds:     make(map[string]*appliedCmd),
+	}
+}
+
+// get returns the command applied before the entry at index with the same uuid.
+func (c *appliedCmdCache) get(uuid []byte, index uint64) *appliedCmd {
+	if len(uuid) == 0 || c.window == 0 {
+		return nil
+	}
+	cmd := c.cmds[string(uuid)]
+	if cmd == nil || cmd.index+c.window <= index {
+		return nil
+	}
+	return cmd
+}
+
+func (c *appliedCmdCache) put(wb *engine_util.WriteBatch, uuid []byte, term, index uint64, resp *raft_cmdpb.RaftCmdResponse) {
+	if len(uuid) == 0 || c.window == 0 {
+		return
+	}
+	c.add(&appliedCmd{uuid: string(uuid), term: term, index: index, resp: resp})
+	wb.Set(AppliedCmdKey(c.regionID, index), encodeAppliedCmd(term, uuid))
+}
+
+func (c *appliedCmdCache) add(cmd *appliedCmd) {
+	c.cmds[cmd.uuid] = cmd
+	c.order = append(c.order, cmd)
+}
+
+// evict drops the commands which are out of the window of the entry at index.
+func (c *appliedCmdCache) evict(wb *engine_util.WriteBatch, index uint64) {
+	i := 0
+	for ; i < len(c.order) && c.order[i].index+c.window <= index; i++ {
+		if c.cmds[c.order[i].uuid] == c.order[i] {
+			delete(c.cmds, c.order[i].uuid)
+		}
+		wb.Delete(AppliedCmdKey(c.regionID, c.order[i].index))
+	}
+	c.order = c.order[i:]
+}
+
+func encodeAppliedCmd(term uint64, uuid []byte) []byte {
+	val := make([]byte, 8+len(uuid))
+	binary.BigEndian.PutUint64(val, term)
+	copy(val[8:], uuid)
+	return val
+}
+
+func decodeAppliedCmd(key, val []byte) (*appliedCmd, error) {
+	if len(key) != RegionRaftLogLen || len(val) <= 8 {
+		return nil, errors.Errorf("invalid applied command %v: %v", key, val)
+	}
+	return &appliedCmd{
+		uuid:  string(val[8:]),
+		term:  binary.BigEndian.Uint64(val),
+		index: binary.BigEndian.Uint64(key[RegionRaftPrefixLen:]),
+	}, nil
+}
+
+// scanAppliedCmds calls f with the key and value of every applied command of the region in the order of index.
+func scanAppliedCmds(txn *badger.Txn, regionID uint64, f func(key, val []byte) error) error {
+	prefix := AppliedCmdPrefixKey(regionID)
+	it := txn.NewIterator(badger.DefaultIteratorOptions)
+	defer it.Close()
+	for it.Seek(prefix); it.Valid() && bytes.HasPrefix(it.Item().Key(), prefix); it.Next() {
+		item := it.Item()
+		val, err := item.Value()
+		if err != nil {
+			return err
+		}
+		if err = f(item.KeyCopy(nil), append([]byte(nil), val...)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadAppliedCmdCache loads the applied commands of the region from the engine.
+func loadAppliedCmdCache(engine *badger.DB, regionID, window uint64) (*appliedCmdCache, error) {
+	cache := newAppliedCmdCache(regionID, window)
+	if window == 0 {
+		return cache, nil
+	}
+	err := engine.View(func(txn *badger.Txn) error {
+		return scanAppliedCmds(txn, regionID, func(key, val []byte) error {
+			cmd, err := decodeAppliedCmd(key, val)
+			if err != nil {
+				return err
+			}
+			// The ones out of the window are skipped by get and deleted by the next eviction.
+			cache.add(cmd)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cache, nil
+}
+
+// appliedCmdsForSnapshot returns the applied commands of the region to carry in a snapshot, keyed by index.
+func appliedCmdsForSnapshot(txn *badger.Txn, regionID uint64) ([]*rspb.KeyValue, error) {
+	var kvs []*rspb.KeyValue
+	err := scanAppliedCmds(txn, regionID, func(key, val []byte) error {
+		kvs = append(kvs, &rspb.KeyValue{Key: key[RegionRaftPrefixLen:], Value: val})
+		return nil
+	})
+	return kvs, err
+}
+
+// writeAppliedCmds writes the applied commands carried in a snapshot.
+func writeAppliedCmds(kvWB *engine_util.WriteBatch, regionID uint64, kvs []*rspb.KeyValue) error {
+	for _, kv := range kvs {
+		if len(kv.Key) != 8 || len(kv.Value) <= 8 {
+			return errors.Errorf("invalid applied command in snapshot %v: %v", kv.Key, kv.Value)
+		}
+		kvWB.Set(AppliedCmdKey(regionID, binary.BigEndian.Uint64(kv.Key)), kv.Value)
+	}
+	return nil
+}
+
+// deleteAppliedCmds deletes all the applied commands of the region.
+func deleteAppliedCmds(engine *badger.DB, kvWB *engine_util.WriteBatch, regionID uint64) error {
+	return engine.View(func(txn *badger.Txn) error {
+		return scanAppliedCmds(txn, regionID, func(key, _ []byte) error {
+			kvWB.Delete(key)
+			return nil
+		})
+	})
+}
@@ -3,9 +3,11 @@ package raftstore
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	"github.com/coocood/badger"
 	"github.com/coocood/badger/y"
+	"github.com/golang/protobuf/proto"
 	"github.com/ngaut/log"
 	"github.com/pingcap-incubator/tinykv/kv/engine_util"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/config"
@@ -29,12 +31,15 @@ const (
 type pendingCmd struct {
 	index uint64
 	term  uint64
+	uuid  []byte
 	cb    *message.Callback
 }
 
 type pendingCmdQueue struct {
 	normals    []pendingCmd
 	confChange *pendingCmd
+	// Commands forwarded to the leader, they are matched by uuid instead of index.
+	forwarded []pendingCmd
 }
 
 func (q *pendingCmdQueue) popNormal(term uint64) *pendingCmd {
@@ -53,6 +58,36 @@ func (q *pendingCmdQueue) appendNormal(cmd pendingCmd) {
 	q.normals = append(q.normals, cmd)
 }
 
+func (q *pendingCmdQueue) appendForwarded(cmd pendingCmd) {
+	q.forwarded = append(q.forwarded, cmd)
+}
+
+func (q *pendingCmdQueue) takeForwarded(uuid []byte) *pendingCmd {
+	for i := range q.forwarded {
+		if bytes.Equal(q.forwarded[i].uuid, uuid) {
+			cmd := q.forwarded[i]
+			q.forwarded = append(q.forwarded[:i], q.forwarded[i+1:]...)
+			return &cmd
+		}
+	}
+	return nil
+}
+
+// popStaleForwarded pops the forwarded commands proposed before the term.
+func (q *pendingCmdQueue) popStaleForwarded(term uint64) []pendingCmd {
+	var stale []pendingCmd
+	retained := q.forwarded[:0]
+	for _, cmd := range q.forwarded {
+		if cmd.term < term {
+			stale = append(stale, cmd)
+		} else {
+			retained = append(retained, cmd)
+		}
+	}
+	q.forwarded = retained
+	return stale
+}
+
 func (q *pendingCmdQueue) takeConfChange() *pendingCmd {
 	// conf change will not be affected when changing between follower and leader,
 	// so there is no need to check term.
@@ -70,6 +105,8 @@ type changePeer struct {
 	confChange *eraftpb.ConfChange
 	peer       *metapb.Peer
 	region     *metapb.Region
+	// repair means the peer is added to repair an under-replicated region.
+	repair bool
 }
 
 type keyRange struct {
@@ -89,6 +126,7 @@ type applyTaskRes struct {
 	appliedIndexTerm uint64
 	execResults      []execResult
 	sizeDiffHint     uint64
+	statsDelta       regionStats
 
 	destroyPeerID uint64
 }
@@ -107,6 +145,25 @@ type execResultSplitRegion struct {
 	derived *metapb.Region
 }
 
+type execResultDeleteRange struct {
+	deleted engine_util.KeyRange
+}
+
+type execResultPrepareMerge struct {
+	region *metapb.Region
+	state  *rspb.MergeState
+}
+
+type execResultCommitMerge struct {
+	region *metapb.Region
+	source *metapb.Region
+}
+
+type execResultRollbackMerge struct {
+	region *metapb.Region
+	commit uint64
+}
+
 type execResult = interface{}
 
 type applyResultType int
@@ -114,6 +171,8 @@ type applyResultType int
 const (
 	applyResultTypeNone       applyResultType = 0
 	applyResultTypeExecResult applyResultType = 1
+	// The entry is a CommitMerge whose source peer on the store hasn't applied the PrepareMerge yet.
+	applyResultTypeWaitMergeSource applyResultType = 2
 )
 
 type applyResult struct {
@@ -135,7 +194,7 @@ type applyCallback struct {
 func (c *applyCallback) invokeAll() {
 	for _, cb := range c.cbs {
 		if cb != nil {
-			cb.Wg.Done()
+			cb.Done(cb.Resp)
 		}
 	}
 }
@@ -151,7 +210,9 @@ type proposal struct {
 	isConfChange bool
 	index        uint64
 	term         uint64
-	cb           *message.Callback
+	// Set if the proposal is forwarded to the leader by this follower.
+	uuid []byte
+	cb   *message.Callback
 }
 
 type regionProposal struct {
@@ -174,15 +235,21 @@ type registration struct {
 	applyState       applyState
 	appliedIndexTerm uint64
 	region           *metapb.Region
+	appliedCmds      *appliedCmdCache
 }
 
 func newRegistration(peer *Peer) *registration {
+	appliedCmds, err := loadAppliedCmdCache(peer.Store().Engines.Kv, peer.regionId, peer.appliedCmdWindow)
+	if err != nil {
+		panic(fmt.Sprintf("%s failed to load applied commands: %v", peer.Tag, err))
+	}
 	return &registration{
 		id:               peer.PeerId(),
 		term:             peer.Term(),
 		applyState:       peer.Store().applyState,
 		appliedIndexTerm: peer.Store().appliedIndexTerm,
 		region:           peer.Region(),
+		appliedCmds:      appliedCmds,
 	}
 }
 
@@ -202,7 +269,7 @@ func newApplyMsg(apply *apply) message.Msg {
 
 type applyContext struct {
 	tag              string
-	notifier         chan<- message.Msg
+	router           *router
 	engines          *engine_util.Engines
 	cbs              []applyCallback
 	applyTaskResList []*applyTaskRes
@@ -213,15 +280,20 @@ type applyContext struct {
 }
 
 func newApplyContext(tag string, engines *engine_util.Engines,
-	notifier chan<- message.Msg, cfg *config.Config) *applyContext {
+	router *router, cfg *config.Config) *applyContext {
 	return &applyContext{
-		tag:      tag,
-		engines:  engines,
-		notifier: notifier,
-		wb:       new(engine_util.WriteBatch),
+		tag:     tag,
+		engines: engines,
+		router:  router,
+		wb:      new(engine_util.WriteBatch),
 	}
 }
 
+// notify sends the apply result to the raft worker of the region.
+func (ac *applyContext) notify(regionID uint64, res *applyTaskRes) {
+	ac.router.workerSender(regionID).send(message.NewPeerMsg(message.MsgTypeApplyRes, regionID, res))
+}
+
 /// Prepares for applying entries for `applier`.
 ///
 /// A general apply progress for an applier is:
@@ -278,7 +350,10 @@ func (ac *applyContext) finishFor(d *applier, results []execResult) {
 		applyState:       d.applyState,
 		execResults:      results,
 		appliedIndexTerm: d.appliedIndexTerm,
+		sizeDiffHint:     d.sizeDiffHint,
+		statsDelta:       d.statsDelta,
 	}
+	d.sizeDiffHint, d.statsDelta = 0, regionStats{}
 	ac.applyTaskResList = append(ac.applyTaskResList, res)
 }
 
@@ -287,7 +362,7 @@ func (ac *applyContext) flush() {
 	ac.writeToDB()
 	if len(ac.applyTaskResList) > 0 {
 		for _, res := range ac.applyTaskResList {
-			ac.notifier <- message.NewPeerMsg(message.MsgTypeApplyRes, res.regionID, res)
+			ac.notify(res.regionID, res)
 		}
 		ac.applyTaskResList = ac.applyTaskResList[:0]
 	}
@@ -352,7 +427,17 @@ type applier struct {
 	/// The term of the raft log at applied index.
 	appliedIndexTerm uint64
 
+	/// Recently applied commands tagged with a uuid, to skip the duplicates.
+	appliedCmds *appliedCmdCache
+
+	/// The bytes written and the change of the region statistics since the last apply result.
 	sizeDiffHint uint64
+	statsDelta   regionStats
+
+	/// Set when the apply is paused for tests or waits for the source of a merge, the tasks received meanwhile are
+	/// handled in order on resume.
+	paused      bool
+	pausedTasks []message.Msg
 }
 
 func newApplier(reg *registration) *applier {
@@ -363,6 +448,7 @@ func newApplier(reg *registration) *applier {
 		applyState:       reg.applyState,
 		appliedIndexTerm: reg.appliedIndexTerm,
 		term:             reg.term,
+		appliedCmds:      reg.appliedCmds,
 	}
 }
 
@@ -395,6 +481,10 @@ func (a *applier) handleRaftCommittedEntries(aCtx *applyContext, committedEntrie
 		case eraftpb.EntryType_EntryConfChange:
 			res = a.handleRaftEntryConfChange(aCtx, entry)
 		}
+		if res.tp == applyResultTypeWaitMergeSource {
+			a.waitMergeSource(committedEntries[i:])
+			break
+		}
 		switch res.tp {
 		case applyResultTypeNone:
 		case applyResultTypeExecResult:
@@ -412,11 +502,13 @@ func (a *applier) handleRaftEntryNormal(aCtx *applyContext, entry *eraftpb.Entry
 	index := entry.Index
 	term := entry.Term
 	if len(entry.Data) > 0 {
-		cmd := new(raft_cmdpb.RaftCmdRequest)
-		err := cmd.Unmarshal(entry.Data)
+		cmd, err := decodeRaftCmd(entry.Data)
 		if err != nil {
 			panic(err)
 		}
+		if a.isMergeSourcePending(aCtx, cmd) {
+			return applyResult{tp: applyResultTypeWaitMergeSource}
+		}
 		return a.processRaftCmd(aCtx, index, term, cmd)
 	}
 
@@ -430,9 +522,12 @@ func (a *applier) handleRaftEntryNormal(aCtx *applyContext, entry *eraftpb.Entry
 			break
 		}
 		// apparently, all the callbacks whose term is less than entry's term are stale.
-		cb := &aCtx.cbs[len(aCtx.cbs)-1]
-		cmd.cb.Resp = ErrRespStaleCommand(term)
-		cb.cbs = append(cb.cbs, cmd.cb)
+		aCtx.cbs[len(aCtx.cbs)-1].push(cmd.cb, ErrRespStaleCommand(term))
+	}
+	// Forwarded commands which are not applied before the new leader's empty entry
+	// have been dropped.
+	for _, cmd := range a.pendingCmds.popStaleForwarded(term) {
+		aCtx.cbs[len(aCtx.cbs)-1].push(cmd.cb, ErrRespStaleCommand(term))
 	}
 	return applyResult{}
 }
@@ -444,8 +539,8 @@ func (a *applier) handleRaftEntryConfChange(aCtx *applyContext, entry *eraftpb.E
 	if err := confChange.Unmarshal(entry.Data); err != nil {
 		panic(err)
 	}
-	cmd := new(raft_cmdpb.RaftCmdRequest)
-	if err := cmd.Unmarshal(confChange.Context); err != nil {
+	cmd, err := decodeRaftCmd(confChange.Context)
+	if err != nil {
 		panic(err)
 	}
 	result := a.processRaftCmd(aCtx, index, term, cmd)
@@ -462,7 +557,7 @@ func (a *applier) handleRaftEntryConfChange(aCtx *applyContext, entry *eraftpb.E
 	}
 }
 
-func (a *applier) findCallback(index, term uint64, isConfChange bool) *message.Callback {
+func (a *applier) findCallback(index, term uint64, isConfChange bool, uuid []byte) *message.Callback {
 	regionID := a.region.Id
 	peerID := a.id
 	if isConfChange {
@@ -476,7 +571,12 @@ func (a *applier) findCallback(index, term uint64, isConfChange bool) *message.C
 		notifyStaleCommand(regionID, peerID, term, *cmd)
 		return nil
 	}
-	for {
+	for len(a.pendingCmds.normals) > 0 {
+		front := a.pendingCmds.normals[0]
+		if front.term == term && front.index > index {
+			// The entry is not proposed by this peer, it may be forwarded by a follower.
+			break
+		}
 		head := a.pendingCmds.popNormal(term)
 		if head == nil {
 			break
@@ -488,6 +588,11 @@ func (a *applier) findCallback(index, term uint64, isConfChange bool) *message.C
 		// coprocessor here.
 		notifyStaleCommand(regionID, peerID, term, *head)
 	}
+	if len(uuid) > 0 {
+		if cmd := a.pendingCmds.takeForwarded(uuid); cmd != nil {
+			return cmd.cb
+		}
+	}
 	return nil
 }
 
@@ -496,22 +601,72 @@ func (a *applier) processRaftCmd(aCtx *applyContext, index, term uint64, cmd *ra
 		panic(fmt.Sprintf("%s process raft cmd need a none zero index", a.tag))
 	}
 	isConfChange := GetChangePeerCmd(cmd) != nil
+	uuid := cmd.Header.GetUuid()
+	if !isConfChange {
+		a.appliedCmds.evict(aCtx.wb, index)
+		if dup := a.appliedCmds.get(uuid, index); dup != nil {
+			return a.skipDuplicateCmd(aCtx, index, term, uuid, dup)
+		}
+	}
+	if isExpiredRead(cmd, time.Now()) {
+		return a.skipExpiredRead(aCtx, index, term, uuid)
+	}
 	resp, txn, result := a.applyRaftCmd(aCtx, index, term, cmd)
+	if !isConfChange {
+		a.appliedCmds.put(aCtx.wb, uuid, term, index, resp)
+	}
 	log.Debugf("applied command. region_id %d, peer_id %d, index %d", a.region.Id, a.id, index)
 
 	// TODO: if we have exec_result, maybe we should return this callback too. Outer
 	// store will call it after handing exec result.
 	BindRespTerm(resp, term)
-	cmdCB := a.findCallback(index, term, isConfChange)
-	cmdCB.RegionSnap = message.RegionSnapshot{
-		Region: *a.region,
-		Txn:    txn,
+	cmdCB := a.findCallback(index, term, isConfChange, uuid)
+	if cmdCB != nil {
+		cmdCB.RegionSnap = message.RegionSnapshot{
+			Region: *a.region,
+			Txn:    txn,
+			Index:  index,
+		}
+	} else if txn != nil {
+		txn.Discard()
 	}
 
 	aCtx.cbs[len(aCtx.cbs)-1].push(cmdCB, resp)
 	return result
 }
 
+// skipDuplicateCmd skips a command which has been applied at an earlier index,
+// and returns the response of the earlier one to the callback.
+func (a *applier) skipDuplicateCmd(aCtx *applyContext, index, term uint64, uuid []byte, dup *appliedCmd) applyResult {
+	log.Infof("%s skip duplicate command at index %d, applied at term %d index %d",
+		a.tag, index, dup.term, dup.index)
+	a.applyState.appliedIndex = index
+	a.appliedIndexTerm = term
+
+	var resp *raft_cmdpb.RaftCmdResponse
+	if dup.resp != nil {
+		resp = proto.Clone(dup.resp).(*raft_cmdpb.RaftCmdResponse)
+	} else {
+		resp = ErrResp(errors.Errorf("command has been applied at index %d, the result is unknown", dup.index))
+	}
+	BindRespTerm(resp, term)
+	aCtx.cbs[len(aCtx.cbs)-1].push(a.findCallback(index, term, false, uuid), resp)
+	return applyResult{}
+}
+
+// skipExpiredRead drops a read command whose deadline has passed without executing it, and returns DeadlineExceeded to
+// the callback, so that timed out reads don't add more load to a slow store.
+func (a *applier) skipExpiredRead(aCtx *applyContext, index, term uint64, uuid []byte) applyResult {
+	log.Debugf("%s skip expired read at index %d", a.tag, index)
+	a.applyState.appliedIndex = index
+	a.appliedIndexTerm = term
+
+	resp := ErrResp(&ErrDeadlineExceeded{RegionId: a.region.Id})
+	BindRespTerm(resp, term)
+	aCtx.cbs[len(aCtx.cbs)-1].push(a.findCallback(index, term, false, uuid), resp)
+	return applyResult{}
+}
+
 /// Applies raft command.
 ///
 /// An apply operation can fail in the following situations:
@@ -553,6 +708,12 @@ func (a *applier) applyRaftCmd(aCtx *applyContext, index, term uint64,
 			a.region = x.cp.region
 		case *execResultSplitRegion:
 			a.region = x.derived
+		case *execResultPrepareMerge:
+			a.region = x.region
+		case *execResultCommitMerge:
+			a.region = x.region
+		case *execResultRollbackMerge:
+			a.region = x.region
 		default:
 		}
 	}
@@ -564,6 +725,10 @@ func (a *applier) clearAllCommandsAsStale() {
 		notifyStaleCommand(a.region.Id, a.id, a.term, cmd)
 	}
 	a.pendingCmds.normals = a.pendingCmds.normals[:0]
+	for _, cmd := range a.pendingCmds.forwarded {
+		notifyStaleCommand(a.region.Id, a.id, a.term, cmd)
+	}
+	a.pendingCmds.forwarded = nil
 	if cmd := a.pendingCmds.takeConfChange(); cmd != nil {
 		notifyStaleCommand(a.region.Id, a.id, a.term, *cmd)
 	}
@@ -607,6 +772,16 @@ func (a *applier) execAdminCmd(aCtx *applyContext, req *raft_cmdpb.RaftCmdReques
 		adminResp, result, err = a.execBatchSplit(aCtx, adminReq)
 	case raft_cmdpb.AdminCmdType_CompactLog:
 		adminResp, result, err = a.execCompactLog(aCtx, adminReq)
+	case raft_cmdpb.AdminCmdType_DeletePrefix:
+		adminResp, result, err = a.execDeletePrefix(aCtx, adminReq)
+	case raft_cmdpb.AdminCmdType_DeleteRange:
+		adminResp, result, err = a.execDeleteRange(aCtx, adminReq)
+	case raft_cmdpb.AdminCmdType_PrepareMerge:
+		adminResp, result, err = a.execPrepareMerge(aCtx, adminReq)
+	case raft_cmdpb.AdminCmdType_CommitMerge:
+		adminResp, result, err = a.execCommitMerge(aCtx, adminReq)
+	case raft_cmdpb.AdminCmdType_RollbackMerge:
+		adminResp, result, err = a.execRollbackMerge(aCtx, adminReq)
 	case raft_cmdpb.AdminCmdType_TransferLeader:
 		err = errors.New("transfer leader won't execute")
 	case raft_cmdpb.AdminCmdType_InvalidAdmin:
@@ -626,13 +801,14 @@ func (a *applier) execNormalCmd(aCtx *applyContext, req *raft_cmdpb.RaftCmdReque
 	requests := req.GetRequests()
 	resps := make([]*raft_cmdpb.Response, 0, len(requests))
 	hasWrite, hasRead := false, false
+	var writeStats writeBatchStats
 	for _, req := range requests {
 		switch req.CmdType {
 		case raft_cmdpb.CmdType_Put:
-			resps = append(resps, a.handlePut(aCtx, req.GetPut()))
+			resps = append(resps, a.handlePut(aCtx, req.GetPut(), &writeStats))
 			hasWrite = true
 		case raft_cmdpb.CmdType_Delete:
-			resps = append(resps, a.handleDelete(aCtx, req.GetDelete()))
+			resps = append(resps, a.handleDelete(aCtx, req.GetDelete(), &writeStats))
 			hasWrite = true
 		case raft_cmdpb.CmdType_Get:
 			var r *raft_cmdpb.Response
@@ -650,32 +826,39 @@ func (a *applier) execNormalCmd(aCtx *applyContext, req *raft_cmdpb.RaftCmdReque
 	if hasWrite && hasRead {
 		panic("mixed write and read in one batch")
 	}
+	writeStats.observe()
 	resp = newCmdRespForReq(req)
 	resp.Responses = resps
 	return
 }
 
-func (a *applier) handlePut(aCtx *applyContext, req *raft_cmdpb.PutRequest) *raft_cmdpb.Response {
+func (a *applier) handlePut(aCtx *applyContext, req *raft_cmdpb.PutRequest,
+	writeStats *writeBatchStats) *raft_cmdpb.Response {
 	key, value := req.GetKey(), req.GetValue()
-
-	if cf := req.GetCf(); len(cf) != 0 {
-		aCtx.wb.SetCF(cf, key, value)
-	} else {
-		aCtx.wb.SetCF(engine_util.CF_DEFAULT, key, value)
-	}
+	cf := req.GetCf()
+	if len(cf) == 0 {
+		cf = engine_util.CF_DEFAULT
+	}
+	aCtx.wb.SetCF(cf, key, value)
+	a.sizeDiffHint += uint64(len(key) + len(value))
+	a.statsDelta.onPut(cf, key, value)
+	writeStats.onPut(cf, key, value)
 	return &raft_cmdpb.Response{
 		CmdType: raft_cmdpb.CmdType_Put,
 	}
 }
 
-func (a *applier) handleDelete(aCtx *applyContext, req *raft_cmdpb.DeleteRequest) *raft_cmdpb.Response {
+func (a *applier) handleDelete(aCtx *applyContext, req *raft_cmdpb.DeleteRequest,
+	writeStats *writeBatchStats) *raft_cmdpb.Response {
 	key := req.GetKey()
-
-	if cf := req.GetCf(); len(cf) != 0 {
-		aCtx.wb.DeleteCF(cf, key)
-	} else {
-		aCtx.wb.DeleteCF(engine_util.CF_DEFAULT, key)
-	}
+	cf := req.GetCf()
+	if len(cf) == 0 {
+		cf = engine_util.CF_DEFAULT
+	}
+	aCtx.wb.DeleteCF(cf, key)
+	a.sizeDiffHint += uint64(len(key))
+	a.statsDelta.onDelete(cf, key)
+	writeStats.onDelete(cf, key)
 	return &raft_cmdpb.Response{
 		CmdType: raft_cmdpb.CmdType_Delete,
 	}
@@ -765,6 +948,7 @@ func (a *applier) execChangePeer(aCtx *applyContext, req *raft_cmdpb.AdminReques
 				confChange: new(eraftpb.ConfChange),
 				region:     region,
 				peer:       peer,
+				repair:     request.Repair,
 			},
 		},
 	}
@@ -808,14 +992,21 @@ func (a *applier) execBatchSplit(aCtx *applyContext, req *raft_cmdpb.AdminReques
 	if err != nil {
 		return
 	}
-	log.Infof("%s split region %s, keys %v", a.tag, a.region, keys)
+	log.Infof("%s split region %s, keys %v, left derive %v", a.tag, a.region, keys, splitReqs.LeftDerive)
 	derived.RegionEpoch.Version += uint64(newRegionCnt)
+	// The derived region takes the first or the last range, the new regions take the others in order.
+	offset := 0
+	if splitReqs.LeftDerive {
+		derived.EndKey = keys[1]
+		regions = append(regions, derived)
+		offset = 1
+	}
 	for i, request := range splitReqs.Requests {
 		newRegion := &metapb.Region{
 			Id:          request.NewRegionId,
 			RegionEpoch: derived.RegionEpoch,
-			StartKey:    keys[i],
-			EndKey:      keys[i+1],
+			StartKey:    keys[i+offset],
+			EndKey:      keys[i+offset+1],
 		}
 		newRegion.Peers = make([]*metapb.Peer, len(derived.Peers))
 		for j := range newRegion.Peers {
@@ -828,9 +1019,12 @@ func (a *applier) execBatchSplit(aCtx *applyContext, req *raft_cmdpb.AdminReques
 		writeInitialApplyState(aCtx.wb, newRegion.Id)
 		regions = append(regions, newRegion)
 	}
-	derived.StartKey = keys[len(keys)-2]
-	regions = append(regions, derived)
+	if !splitReqs.LeftDerive {
+		derived.StartKey = keys[len(keys)-2]
+		regions = append(regions, derived)
+	}
 	WritePeerState(aCtx.wb, derived, rspb.PeerState_Normal)
+	a.handOverRangeTombstones(aCtx, a.region.Id, regions)
 
 	resp = &raft_cmdpb.AdminResponse{
 		Splits: &raft_cmdpb.BatchSplitResponse{
@@ -874,6 +1068,102 @@ func (a *applier) execCompactLog(aCtx *applyContext, req *raft_cmdpb.AdminReques
 	return
 }
 
+// execDeletePrefix marks the keys starting with the prefix inside the region deleted with a single range tombstone,
+// the keys are deleted physically by the region worker later.
+func (a *applier) execDeletePrefix(aCtx *applyContext, req *raft_cmdpb.AdminRequest) (
+	resp *raft_cmdpb.AdminResponse, result applyResult, err error) {
+	prefix := req.DeletePrefix.GetPrefix()
+	if len(prefix) == 0 {
+		err = errors.New("missing prefix")
+		return
+	}
+	deleted, err := a.deleteRange(aCtx, engine_util.KeyRange{StartKey: prefix, EndKey: prefixNext(prefix)})
+	if err != nil {
+		return
+	}
+	log.Infof("%s delete prefix %v, range [%v, %v)", a.tag, prefix, deleted.StartKey, deleted.EndKey)
+	resp = &raft_cmdpb.AdminResponse{
+		DeletePrefix: &raft_cmdpb.DeletePrefixResponse{},
+	}
+	result = applyResult{tp: applyResultTypeExecResult, data: &execResultDeleteRange{
+		deleted: deleted,
+	}}
+	return
+}
+
+// execDeleteRange marks the keys of the range inside the region deleted with a single range tombstone, like
+// execDeletePrefix.
+func (a *applier) execDeleteRange(aCtx *applyContext, req *raft_cmdpb.AdminRequest) (
+	resp *raft_cmdpb.AdminResponse, result applyResult, err error) {
+	r := engine_util.KeyRange{StartKey: req.DeleteRange.GetStartKey(), EndKey: req.DeleteRange.GetEndKey()}
+	if len(r.EndKey) != 0 && bytes.Compare(r.StartKey, r.EndKey) >= 0 {
+		err = errors.Errorf("invalid range [%v, %v)", r.StartKey, r.EndKey)
+		return
+	}
+	deleted, err := a.deleteRange(aCtx, r)
+	if err != nil {
+		return
+	}
+	log.Infof("%s delete range [%v, %v)", a.tag, deleted.StartKey, deleted.EndKey)
+	resp = &raft_cmdpb.AdminResponse{
+		DeleteRange: &raft_cmdpb.DeleteRangeResponse{},
+	}
+	result = applyResult{tp: applyResultTypeExecResult, data: &execResultDeleteRange{
+		deleted: deleted,
+	}}
+	return
+}
+
+// deleteRange clips the range by the region and writes a range tombstone for it, it returns the clipped range. The
+// write batch is written along, so the tombstone takes a badger version of its own: the writes of the entries before
+// it are deleted, and the ones of the entries after it are kept. The keys are deleted physically by the region worker
+// later.
+func (a *applier) deleteRange(aCtx *applyContext, r engine_util.KeyRange) (engine_util.KeyRange, error) {
+	r, ok := clipRange(r, a.region)
+	if !ok {
+		return r, errors.Errorf("range is out of region %d", a.region.Id)
+	}
+	aCtx.wb.SetRangeTombstone(a.region.Id, engine_util.RangeTombstone{KeyRange: r, Index: aCtx.execCtx.index})
+	aCtx.commit(a)
+	return r, nil
+}
+
+// clipRange returns the part of the range inside the region, false if there is none.
+func clipRange(r engine_util.KeyRange, region *metapb.Region) (engine_util.KeyRange, bool) {
+	if bytes.Compare(r.StartKey, region.StartKey) < 0 {
+		r.StartKey = region.StartKey
+	}
+	if len(region.EndKey) != 0 && (len(r.EndKey) == 0 || bytes.Compare(region.EndKey, r.EndKey) < 0) {
+		r.EndKey = region.EndKey
+	}
+	return r, len(r.EndKey) == 0 || bytes.Compare(r.StartKey, r.EndKey) < 0
+}
+
+// handOverRangeTombstones moves the range tombstones of the region to the regions taking over its range by a split or
+// a merge, each of them keeps the part inside its own range. The tombstones keep their versions, so they delete the
+// same key versions as before.
+func (a *applier) handOverRangeTombstones(aCtx *applyContext, regionID uint64, regions []*metapb.Region) {
+	txn := aCtx.engines.Kv.NewTransaction(false)
+	tombstones, err := engine_util.LoadRangeTombstones(txn, regionID)
+	txn.Discard()
+	if err != nil {
+		panic(err)
+	}
+	if len(tombstones) == 0 {
+		return
+	}
+	for _, t := range tombstones {
+		aCtx.wb.DeleteRangeTombstone(regionID, t.Index)
+		for _, region := range regions {
+			if r, ok := clipRange(t.KeyRange, region); ok {
+				aCtx.wb.SetRangeTombstone(region.Id, engine_util.RangeTombstone{KeyRange: r, Index: t.Index, Version: t.Version})
+			}
+		}
+	}
+	// The tombstones are loaded from the engine, a following split or merge in the same batch must see them.
+	aCtx.commit(a)
+}
+
 func newApplierFromPeer(peer *peerFsm) *applier {
 	reg := newRegistration(peer.peer)
 	return newApplier(reg)
@@ -913,8 +1203,10 @@ func (a *applier) handleProposal(regionProposal *regionProposal) {
 		return
 	}
 	for _, p := range regionProposal.Props {
-		cmd := pendingCmd{index: p.index, term: p.term, cb: p.cb}
-		if p.isConfChange {
+		cmd := pendingCmd{index: p.index, term: p.term, uuid: p.uuid, cb: p.cb}
+		if len(p.uuid) > 0 {
+			a.pendingCmds.appendForwarded(cmd)
+		} else if p.isConfChange {
 			if confCmd := a.pendingCmds.takeConfChange(); confCmd != nil {
 				// if it loses leadership before conf change is replicated, there may be
 				// a stale pending conf change before next conf change is applied. If it
@@ -943,6 +1235,10 @@ func (a *applier) destroy(aCtx *applyContext) {
 		notifyRegionRemoved(a.region.Id, a.id, cmd)
 	}
 	a.pendingCmds.normals = nil
+	for _, cmd := range a.pendingCmds.forwarded {
+		notifyRegionRemoved(a.region.Id, a.id, cmd)
+	}
+	a.pendingCmds.forwarded = nil
 	if cmd := a.pendingCmds.takeConfChange(); cmd != nil {
 		notifyRegionRemoved(a.region.Id, a.id, *cmd)
 	}
@@ -952,7 +1248,7 @@ func (a *applier) destroy(aCtx *applyContext) {
 func (a *applier) handleDestroy(aCtx *applyContext, regionID uint64) {
 	if !a.stopped {
 		a.destroy(aCtx)
-		aCtx.notifier <- message.NewPeerMsg(message.MsgTypeApplyRes, a.region.Id, &applyTaskRes{
+		aCtx.notify(a.region.Id, &applyTaskRes{
 			regionID:      a.region.Id,
 			destroyPeerID: a.id,
 		})
@@ -960,6 +1256,10 @@ func (a *applier) handleDestroy(aCtx *applyContext, regionID uint64) {
 }
 
 func (a *applier) handleTask(aCtx *applyContext, msg message.Msg) {
+	if a.paused && msg.Type != message.MsgTypeApplyResume {
+		a.pausedTasks = append(a.pausedTasks, msg)
+		return
+	}
 	switch msg.Type {
 	case message.MsgTypeApply:
 		a.handleApply(aCtx, msg.Data.(*apply))
@@ -969,5 +1269,22 @@ func (a *applier) handleTask(aCtx *applyContext, msg message.Msg) {
 		a.handleRegistration(msg.Data.(*registration))
 	case message.MsgTypeApplyDestroy:
 		a.handleDestroy(aCtx, msg.RegionID)
+	case message.MsgTypeApplyPause:
+		a.paused = true
+	case message.MsgTypeApplyResume:
+		a.handleResume(aCtx)
+	}
+}
+
+/// Handles the tasks received while the apply is paused.
+func (a *applier) handleResume(aCtx *applyContext) {
+	if !a.paused {
+		return
+	}
+	log.Infof("%s resume apply with %d pending tasks", a.tag, len(a.pausedTasks))
+	tasks := a.pausedTasks
+	a.paused, a.pausedTasks = false, nil
+	for _, task := range tasks {
+		a.handleTask(aCtx, task)
 	}
 }
@@ -0,0 +1,348 @@
+package raftstore
+
+import (
+	"testing"
+
+	"github.com/coocood/badger"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindForwardedCallback(t *testing.T) {
+	a := &applier{id: 1, region: &metapb.Region{Id: 1}}
+	local := message.NewCallback()
+	forwarded := message.NewCallback()
+	a.pendingCmds.appendNormal(pendingCmd{index: 10, term: 5, cb: local})
+	a.pendingCmds.appendForwarded(pendingCmd{term: 5, uuid: []byte("uuid"), cb: forwarded})
+
+	// An entry proposed by another peer must not make the local command stale.
+	assert.Nil(t, a.findCallback(9, 5, false, nil))
+	assert.Len(t, a.pendingCmds.normals, 1)
+
+	assert.Equal(t, forwarded, a.findCallback(9, 5, false, []byte("uuid")))
+	assert.Len(t, a.pendingCmds.forwarded, 0)
+	assert.Equal(t, local, a.findCallback(10, 5, false, nil))
+
+	stale := message.NewCallback()
+	a.pendingCmds.appendForwarded(pendingCmd{term: 5, uuid: []byte("stale"), cb: stale})
+	assert.Len(t, a.pendingCmds.popStaleForwarded(5), 0)
+	assert.Len(t, a.pendingCmds.popStaleForwarded(6), 1)
+	assert.Len(t, a.pendingCmds.forwarded, 0)
+}
+
+func TestAppliedCmdCache(t *testing.T) {
+	wb := new(engine_util.WriteBatch)
+	c := newAppliedCmdCache(1, 3)
+	c.put(wb, nil, 1, 10, nil)
+	assert.Nil(t, c.get(nil, 11))
+	assert.Equal(t, 0, wb.Len())
+
+	resp := &raft_cmdpb.RaftCmdResponse{Header: &raft_cmdpb.RaftResponseHeader{CurrentTerm: 1}}
+	c.put(wb, []byte("a"), 1, 10, resp)
+	c.put(wb, []byte("b"), 1, 11, nil)
+	assert.Equal(t, 2, wb.Len())
+	dup := c.get([]byte("a"), 12)
+	if assert.NotNil(t, dup) {
+		assert.Equal(t, uint64(10), dup.index)
+		assert.Equal(t, resp, dup.resp)
+	}
+	// Out of the window.
+	assert.Nil(t, c.get([]byte("a"), 13))
+	assert.NotNil(t, c.get([]byte("b"), 13))
+	assert.Nil(t, c.get([]byte("b"), 14))
+	c.evict(wb, 14)
+	assert.Len(t, c.order, 0)
+	assert.Equal(t, 4, wb.Len())
+
+	disabled := newAppliedCmdCache(1, 0)
+	disabled.put(wb, []byte("a"), 1, 10, resp)
+	assert.Nil(t, disabled.get([]byte("a"), 11))
+}
+
+func TestAppliedCmdCachePersisted(t *testing.T) {
+	engines := newTestEngines(t)
+	defer cleanUpTestEngineData(engines)
+
+	wb := new(engine_util.WriteBatch)
+	c := newAppliedCmdCache(1, 3)
+	c.put(wb, []byte("a"), 1, 10, nil)
+	c.put(wb, []byte("b"), 2, 11, nil)
+	c.evict(wb, 13)
+	require.Nil(t, wb.WriteToDB(engines.Kv))
+
+	// A restarted peer loads the same commands.
+	loaded, err := loadAppliedCmdCache(engines.Kv, 1, 3)
+	require.Nil(t, err)
+	assert.Nil(t, loaded.get([]byte("a"), 13))
+	dup := loaded.get([]byte("b"), 13)
+	if assert.NotNil(t, dup) {
+		assert.Equal(t, uint64(11), dup.index)
+		assert.Equal(t, uint64(2), dup.term)
+		assert.Nil(t, dup.resp)
+	}
+
+	// A peer restored from a snapshot gets the same commands as well.
+	txn := engines.Kv.NewTransaction(false)
+	kvs, err := appliedCmdsForSnapshot(txn, 1)
+	txn.Discard()
+	require.Nil(t, err)
+	assert.Len(t, kvs, 1)
+	other := newTestEngines(t)
+	defer cleanUpTestEngineData(other)
+	wb = new(engine_util.WriteBatch)
+	require.Nil(t, writeAppliedCmds(wb, 1, kvs))
+	require.Nil(t, wb.WriteToDB(other.Kv))
+	restored, err := loadAppliedCmdCache(other.Kv, 1, 3)
+	require.Nil(t, err)
+	assert.NotNil(t, restored.get([]byte("b"), 13))
+
+	wb = new(engine_util.WriteBatch)
+	require.Nil(t, deleteAppliedCmds(other.Kv, wb, 1))
+	require.Nil(t, wb.WriteToDB(other.Kv))
+	restored, err = loadAppliedCmdCache(other.Kv, 1, 3)
+	require.Nil(t, err)
+	assert.Len(t, restored.order, 0)
+}
+
+func TestSkipDuplicateCmd(t *testing.T) {
+	a := &applier{id: 1, region: &metapb.Region{Id: 1}, appliedCmds: newAppliedCmdCache(1, 16)}
+	resp := &raft_cmdpb.RaftCmdResponse{Header: &raft_cmdpb.RaftResponseHeader{CurrentTerm: 5}}
+	a.appliedCmds.put(new(engine_util.WriteBatch), []byte("uuid"), 5, 10, resp)
+
+	// The command is re-proposed by the new leader after a leader change.
+	cb := message.NewCallback()
+	a.pendingCmds.appendNormal(pendingCmd{index: 12, term: 6, cb: cb})
+	aCtx := &applyContext{cbs: []applyCallback{{region: a.region}}}
+	dup := a.appliedCmds.get([]byte("uuid"), 12)
+	a.skipDuplicateCmd(aCtx, 12, 6, []byte("uuid"), dup)
+
+	assert.Equal(t, uint64(12), a.applyState.appliedIndex)
+	assert.Equal(t, uint64(6), a.appliedIndexTerm)
+	assert.Equal(t, []*message.Callback{cb}, aCtx.cbs[0].cbs)
+	assert.Nil(t, cb.Resp.Header.Error)
+	assert.Equal(t, uint64(6), cb.Resp.Header.CurrentTerm)
+	// The cached response is not modified.
+	assert.Equal(t, uint64(5), resp.Header.CurrentTerm)
+}
+
+func TestSkipExpiredRead(t *testing.T) {
+	a := &applier{id: 1, region: &metapb.Region{Id: 1}, appliedCmds: newAppliedCmdCache(1, 16)}
+	cb := message.NewCallback()
+	a.pendingCmds.appendNormal(pendingCmd{index: 12, term: 6, cb: cb})
+	aCtx := &applyContext{cbs: []applyCallback{{region: a.region}}}
+	req := &raft_cmdpb.RaftCmdRequest{
+		Header:   &raft_cmdpb.RaftRequestHeader{RegionId: 1, DeadlineMs: 1},
+		Requests: []*raft_cmdpb.Request{{CmdType: raft_cmdpb.CmdType_Snap, Snap: &raft_cmdpb.SnapRequest{}}},
+	}
+	a.processRaftCmd(aCtx, 12, 6, req)
+
+	assert.Equal(t, uint64(12), a.applyState.appliedIndex)
+	assert.Equal(t, uint64(6), a.appliedIndexTerm)
+	assert.Equal(t, []*message.Callback{cb}, aCtx.cbs[0].cbs)
+	assert.Equal(t, uint64(1), cb.Resp.Header.Error.GetDeadlineExceeded().GetRegionId())
+	assert.Nil(t, cb.RegionSnap.Txn)
+}
+
+func TestExecDeletePrefix(t *testing.T) {
+	engines := newTestEngines(t)
+	defer cleanUpTestEngineData(engines)
+	a := &applier{id: 1, region: &metapb.Region{Id: 1, StartKey: []byte("b"), EndKey: []byte("e")}}
+	deletePrefix := func(prefix string) (engine_util.KeyRange, error) {
+		aCtx := &applyContext{engines: engines, wb: new(engine_util.WriteBatch), execCtx: &applyExecContext{index: 10}}
+		req := &raft_cmdpb.AdminRequest{
+			CmdType:      raft_cmdpb.AdminCmdType_DeletePrefix,
+			DeletePrefix: &raft_cmdpb.DeletePrefixRequest{Prefix: []byte(prefix)},
+		}
+		_, result, err := a.execDeletePrefix(aCtx, req)
+		if err != nil {
+			assert.Equal(t, 0, aCtx.wb.Len())
+			return engine_util.KeyRange{}, err
+		}
+		return result.data.(*execResultDeleteRange).deleted, nil
+	}
+
+	deleted, err := deletePrefix("c")
+	assert.Nil(t, err)
+	assert.Equal(t, engine_util.KeyRange{StartKey: []byte("c"), EndKey: []byte("d")}, deleted)
+
+	// The range is clipped by the region.
+	_, err = deletePrefix("")
+	assert.NotNil(t, err)
+	a.region.StartKey = []byte("c1")
+	deleted, err = deletePrefix("c")
+	assert.Nil(t, err)
+	assert.Equal(t, engine_util.KeyRange{StartKey: []byte("c1"), EndKey: []byte("d")}, deleted)
+	_, err = deletePrefix("a")
+	assert.NotNil(t, err)
+	_, err = deletePrefix("e")
+	assert.NotNil(t, err)
+
+	a.region.EndKey = nil
+	deleted, err = deletePrefix("\xff")
+	assert.Nil(t, err)
+	assert.Equal(t, engine_util.KeyRange{StartKey: []byte("\xff")}, deleted)
+}
+
+func TestExecDeleteRange(t *testing.T) {
+	engines := newTestEngines(t)
+	defer cleanUpTestEngineData(engines)
+	a := &applier{id: 1, region: &metapb.Region{Id: 1, StartKey: []byte("b"), EndKey: []byte("e")}}
+	deleteRange := func(start, end string) (engine_util.KeyRange, error) {
+		aCtx := &applyContext{engines: engines, wb: new(engine_util.WriteBatch), execCtx: &applyExecContext{index: 10}}
+		req := &raft_cmdpb.AdminRequest{
+			CmdType:     raft_cmdpb.AdminCmdType_DeleteRange,
+			DeleteRange: &raft_cmdpb.DeleteRangeRequest{StartKey: []byte(start), EndKey: []byte(end)},
+		}
+		_, result, err := a.execDeleteRange(aCtx, req)
+		if err != nil {
+			assert.Equal(t, 0, aCtx.wb.Len())
+			return engine_util.KeyRange{}, err
+		}
+		return result.data.(*execResultDeleteRange).deleted, nil
+	}
+
+	deleted, err := deleteRange("c", "d")
+	assert.Nil(t, err)
+	assert.Equal(t, engine_util.KeyRange{StartKey: []byte("c"), EndKey: []byte("d")}, deleted)
+	_, err = deleteRange("d", "c")
+	assert.NotNil(t, err)
+
+	// The range is clipped by the region.
+	deleted, err = deleteRange("", "")
+	assert.Nil(t, err)
+	assert.Equal(t, engine_util.KeyRange{StartKey: []byte("b"), EndKey: []byte("e")}, deleted)
+	deleted, err = deleteRange("a", "c")
+	assert.Nil(t, err)
+	assert.Equal(t, engine_util.KeyRange{StartKey: []byte("b"), EndKey: []byte("c")}, deleted)
+	_, err = deleteRange("a", "b")
+	assert.NotNil(t, err)
+	_, err = deleteRange("e", "")
+	assert.NotNil(t, err)
+}
+
+func TestExecBatchSplit(t *testing.T) {
+	engines := newTestEngines(t)
+	defer cleanUpTestEngineData(engines)
+	split := func(leftDerive bool) []*metapb.Region {
+		a := &applier{id: 1, region: &metapb.Region{
+			Id:          1,
+			StartKey:    []byte("a"),
+			EndKey:      []byte("z"),
+			RegionEpoch: &metapb.RegionEpoch{ConfVer: 1, Version: 1},
+			Peers:       []*metapb.Peer{{Id: 1, StoreId: 1}},
+		}}
+		aCtx := &applyContext{engines: engines, wb: new(engine_util.WriteBatch)}
+		req := &raft_cmdpb.AdminRequest{
+			CmdType: raft_cmdpb.AdminCmdType_BatchSplit,
+			Splits: &raft_cmdpb.BatchSplitRequest{
+				Requests: []*raft_cmdpb.SplitRequest{
+					{SplitKey: []byte("h"), NewRegionId: 2, NewPeerIds: []uint64{2}},
+					{SplitKey: []byte("p"), NewRegionId: 3, NewPeerIds: []uint64{3}},
+				},
+				LeftDerive: leftDerive,
+			},
+		}
+		resp, result, err := a.execBatchSplit(aCtx, req)
+		assert.Nil(t, err)
+		regions := resp.Splits.Regions
+		assert.Equal(t, regions, result.data.(*execResultSplitRegion).regions)
+		assert.Len(t, regions, 3)
+		for _, region := range regions {
+			assert.Equal(t, uint64(3), region.RegionEpoch.Version)
+		}
+		return regions
+	}
+
+	regions := split(false)
+	assert.Equal(t, []uint64{2, 3, 1}, []uint64{regions[0].Id, regions[1].Id, regions[2].Id})
+	assert.Equal(t, []byte("a"), regions[0].StartKey)
+	assert.Equal(t, []byte("p"), regions[2].StartKey)
+	assert.Equal(t, []byte("z"), regions[2].EndKey)
+
+	regions = split(true)
+	assert.Equal(t, []uint64{1, 2, 3}, []uint64{regions[0].Id, regions[1].Id, regions[2].Id})
+	assert.Equal(t, []byte("a"), regions[0].StartKey)
+	assert.Equal(t, []byte("h"), regions[0].EndKey)
+	assert.Equal(t, []byte("h"), regions[1].StartKey)
+	assert.Equal(t, []byte("z"), regions[2].EndKey)
+}
+
+func TestRangeTombstoneLifecycle(t *testing.T) {
+	engines := newTestEngines(t)
+	defer cleanUpTestEngineData(engines)
+	region := &metapb.Region{
+		Id:          1,
+		StartKey:    []byte("a"),
+		EndKey:      []byte("z"),
+		RegionEpoch: &metapb.RegionEpoch{ConfVer: 1, Version: 1},
+		Peers:       []*metapb.Peer{{Id: 1, StoreId: 1}},
+	}
+	a := &applier{id: 1, region: region}
+	wb := new(engine_util.WriteBatch)
+	for _, key := range []string{"b", "c1", "c2", "d"} {
+		wb.SetCF(engine_util.CF_DEFAULT, []byte(key), []byte("old"))
+	}
+	require.Nil(t, wb.WriteToDB(engines.Kv))
+
+	aCtx := &applyContext{engines: engines, wb: new(engine_util.WriteBatch), execCtx: &applyExecContext{index: 10}}
+	// Written by an entry before the command in the same batch.
+	aCtx.wb.SetCF(engine_util.CF_DEFAULT, []byte("c3"), []byte("old"))
+	_, err := a.deleteRange(aCtx, engine_util.KeyRange{StartKey: []byte("c"), EndKey: []byte("e")})
+	require.Nil(t, err)
+	// Written by an entry after the command.
+	aCtx.wb.SetCF(engine_util.CF_DEFAULT, []byte("c2"), []byte("new"))
+	aCtx.writeToDB()
+
+	scan := func(region *metapb.Region) []string {
+		txn := engines.Kv.NewTransaction(false)
+		defer txn.Discard()
+		tombstones, err := engine_util.LoadRangeTombstones(txn, region.Id)
+		require.Nil(t, err)
+		var pairs []string
+		it := engine_util.NewCFIteratorWithTombstones(engine_util.CF_DEFAULT, txn, tombstones)
+		defer it.Close()
+		for it.Seek(region.StartKey); it.Valid(); it.Next() {
+			item := it.Item()
+			if engine_util.ExceedEndKey(item.Key(), region.EndKey) {
+				break
+			}
+			val, err := item.Value()
+			require.Nil(t, err)
+			pairs = append(pairs, string(item.Key())+"="+string(val))
+		}
+		return pairs
+	}
+	assert.Equal(t, []string{"b=old", "c2=new"}, scan(region))
+
+	// The regions split from the region keep the parts of the tombstone inside their ranges.
+	aCtx.execCtx = &applyExecContext{index: 11}
+	_, result, err := a.execBatchSplit(aCtx, &raft_cmdpb.AdminRequest{
+		CmdType: raft_cmdpb.AdminCmdType_BatchSplit,
+		Splits: &raft_cmdpb.BatchSplitRequest{
+			Requests: []*raft_cmdpb.SplitRequest{{SplitKey: []byte("c2"), NewRegionId: 2, NewPeerIds: []uint64{2}}},
+		},
+	})
+	require.Nil(t, err)
+	aCtx.writeToDB()
+	regions := result.data.(*execResultSplitRegion).regions
+	assert.Equal(t, []string{"b=old"}, scan(regions[0]))
+	assert.Equal(t, []string{"c2=new"}, scan(regions[1]))
+
+	// The cleanup deletes the hidden versions and the tombstones, and keeps the new version.
+	snapCtx := &snapContext{engines: engines}
+	snapCtx.cleanUpTombstones()
+	txn := engines.Kv.NewTransaction(false)
+	regionIDs, err := engine_util.RangeTombstoneRegions(txn)
+	txn.Discard()
+	require.Nil(t, err)
+	assert.Len(t, regionIDs, 0)
+	for _, key := range []string{"c1", "c3", "d"} {
+		_, err = engine_util.GetCF(engines.Kv, engine_util.CF_DEFAULT, []byte(key))
+		assert.Equal(t, badger.ErrKeyNotFound, err)
+	}
+	assert.Equal(t, []string{"b=old", "c2=new"}, scan(region))
+}
@@ -9,8 +9,9 @@ import (
 )
 
 type ErrNotLeader struct {
-	RegionId uint64
-	Leader   *metapb.Peer
+	RegionId    uint64
+	Leader      *metapb.Peer
+	RegionEpoch *metapb.RegionEpoch
 }
 
 func (e *ErrNotLeader) Error() string {
@@ -76,11 +77,27 @@ func (e *ErrRaftEntryTooLarge) Error() string {
 	return fmt.Sprintf("raft entry too large, region_id: %v, len: %v", e.RegionId, e.EntrySize)
 }
 
+type ErrDeadlineExceeded struct {
+	RegionId uint64
+}
+
+func (e *ErrDeadlineExceeded) Error() string {
+	return fmt.Sprintf("deadline exceeded, region_id: %v", e.RegionId)
+}
+
+type ErrReadOnly struct {
+	RegionId uint64
+}
+
+func (e *ErrReadOnly) Error() string {
+	return fmt.Sprintf("region %v is read-only", e.RegionId)
+}
+
 func RaftstoreErrToPbError(e error) *errorpb.Error {
 	ret := new(errorpb.Error)
 	switch err := errors.Cause(e).(type) {
 	case *ErrNotLeader:
-		ret.NotLeader = &errorpb.NotLeader{RegionId: err.RegionId, Leader: err.Leader}
+		ret.NotLeader = &errorpb.NotLeader{RegionId: err.RegionId, Leader: err.Leader, RegionEpoch: err.RegionEpoch}
 	case *ErrRegionNotFound:
 		ret.RegionNotFound = &errorpb.RegionNotFound{RegionId: err.RegionId}
 	case *ErrKeyNotInRegion:
@@ -96,6 +113,10 @@ func RaftstoreErrToPbError(e error) *errorpb.Error {
 		ret.StoreNotMatch = &errorpb.StoreNotMatch{RequestStoreId: err.RequestStoreId, ActualStoreId: err.ActualStoreId}
 	case *ErrRaftEntryTooLarge:
 		ret.RaftEntryTooLarge = &errorpb.RaftEntryTooLarge{RegionId: err.RegionId, EntrySize: err.EntrySize}
+	case *ErrDeadlineExceeded:
+		ret.DeadlineExceeded = &errorpb.DeadlineExceeded{RegionId: err.RegionId}
+	case *ErrReadOnly:
+		ret.ReadOnly = &errorpb.ReadOnly{RegionId: err.RegionId}
 	default:
 		ret.Message = e.Error()
 	}
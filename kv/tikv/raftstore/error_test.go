@@ -15,6 +15,14 @@ func TestRaftstoreErrToPbError(t *testing.T) {
 	require.NotNil(t, pbErr.NotLeader)
 	assert.Equal(t, pbErr.NotLeader.RegionId, regionId)
 
+	leader := &metapb.Peer{Id: 2, StoreId: 2}
+	epoch := &metapb.RegionEpoch{ConfVer: 2, Version: 3}
+	notLeader = &ErrNotLeader{RegionId: regionId, Leader: leader, RegionEpoch: epoch}
+	pbErr = RaftstoreErrToPbError(notLeader)
+	require.NotNil(t, pbErr.NotLeader)
+	assert.Equal(t, pbErr.NotLeader.Leader, leader)
+	assert.Equal(t, pbErr.NotLeader.RegionEpoch, epoch)
+
 	regionNotFound := &ErrRegionNotFound{RegionId: regionId}
 	pbErr = RaftstoreErrToPbError(regionNotFound)
 	require.NotNil(t, pbErr.RegionNotFound)
@@ -58,4 +66,14 @@ func TestRaftstoreErrToPbError(t *testing.T) {
 	require.NotNil(t, pbErr.RaftEntryTooLarge)
 	assert.Equal(t, pbErr.RaftEntryTooLarge.RegionId, regionId)
 	assert.Equal(t, pbErr.RaftEntryTooLarge.EntrySize, entrySize)
+
+	deadlineExceeded := &ErrDeadlineExceeded{RegionId: regionId}
+	pbErr = RaftstoreErrToPbError(deadlineExceeded)
+	require.NotNil(t, pbErr.DeadlineExceeded)
+	assert.Equal(t, pbErr.DeadlineExceeded.RegionId, regionId)
+
+	readOnly := &ErrReadOnly{RegionId: regionId}
+	pbErr = RaftstoreErrToPbError(readOnly)
+	require.NotNil(t, pbErr.ReadOnly)
+	assert.Equal(t, pbErr.ReadOnly.RegionId, regionId)
 }
@@ -28,6 +28,7 @@ const (
 	PeerTickRaftLogGC        PeerTick = 1
 	PeerTickSplitRegionCheck PeerTick = 2
 	PeerTickPdHeartbeat      PeerTick = 3
+	PeerTickCheckMerge       PeerTick = 4
 )
 
 type peerFsm struct {
@@ -40,17 +41,18 @@ type peerFsm struct {
 // If we create the peer actively, like bootstrap/split/merge region, we should
 // use this function to create the peer. The region must contain the peer info
 // for this store.
-func createPeerFsm(storeID uint64, cfg *config.Config, sched chan<- worker.Task,
-	engines *engine_util.Engines, region *metapb.Region) (*peerFsm, error) {
+func createPeerFsm(storeID uint64, cfg *config.Config, sched, snapGenSched chan<- worker.Task,
+	engines *engine_util.Engines, region *metapb.Region, leaders *leaderCache) (*peerFsm, error) {
 	metaPeer := findPeer(region, storeID)
 	if metaPeer == nil {
 		return nil, errors.Errorf("find no peer for store %d in region %v", storeID, region)
 	}
 	log.Infof("region %v create peer with ID %d", region, metaPeer.Id)
-	peer, err := NewPeer(storeID, cfg, engines, region, sched, metaPeer)
+	peer, err := NewPeer(storeID, cfg, engines, region, sched, snapGenSched, metaPeer)
 	if err != nil {
 		return nil, err
 	}
+	peer.leaderCache = leaders
 	return &peerFsm{
 		peer:   peer,
 		ticker: newTicker(region.GetId(), cfg),
@@ -60,18 +62,19 @@ func createPeerFsm(storeID uint64, cfg *config.Config, sched chan<- worker.Task,
 // The peer can be created from another node with raft membership changes, and we only
 // know the region_id and peer_id when creating this replicated peer, the region info
 // will be retrieved later after applying snapshot.
-func replicatePeerFsm(storeID uint64, cfg *config.Config, sched chan<- worker.Task,
-	engines *engine_util.Engines, regionID uint64, metaPeer *metapb.Peer) (*peerFsm, error) {
+func replicatePeerFsm(storeID uint64, cfg *config.Config, sched, snapGenSched chan<- worker.Task,
+	engines *engine_util.Engines, regionID uint64, metaPeer *metapb.Peer, leaders *leaderCache) (*peerFsm, error) {
 	// We will remove tombstone key when apply snapshot
 	log.Infof("[region %v] replicates peer with ID %d", regionID, metaPeer.GetId())
 	region := &metapb.Region{
 		Id:          regionID,
 		RegionEpoch: &metapb.RegionEpoch{},
 	}
-	peer, err := NewPeer(storeID, cfg, engines, region, sched, metaPeer)
+	peer, err := NewPeer(storeID, cfg, engines, region, sched, snapGenSched, metaPeer)
 	if err != nil {
 		return nil, err
 	}
+	peer.leaderCache = leaders
 	return &peerFsm{
 		peer:   peer,
 		ticker: newTicker(region.GetId(), cfg),
@@ -134,6 +137,12 @@ type MsgSplitRegion struct {
 	Callback  *message.Callback
 }
 
+// MsgMergeResult tells the source peer of a merge the merge is done, by the target peer on the store having applied
+// the CommitMerge or taken a snapshot of the merged region.
+type MsgMergeResult struct {
+	Target *metapb.Region
+}
+
 type MsgGCSnap struct {
 	Snaps []snap.SnapKeyWithSending
 }
@@ -160,14 +169,19 @@ func (d *peerMsgHandler) HandleMsgs(msgs ...message.Msg) {
 			split := msg.Data.(*MsgSplitRegion)
 			log.Infof("%s on split with %v", d.peer.Tag, split.SplitKeys)
 			d.onPrepareSplitRegion(split.RegionEpoch, split.SplitKeys, split.Callback)
-		// TODO: should update approximate size in split checker
 		case message.MsgTypeRegionApproximateSize:
-			d.onApproximateRegionSize(msg.Data.(uint64))
+			d.onRegionStats(msg.Data.(*regionStats))
 		case message.MsgTypeGcSnap:
 			gcSnap := msg.Data.(*MsgGCSnap)
 			d.onGCSnap(gcSnap.Snaps)
 		case message.MsgTypeStart:
 			d.startTicker()
+		case message.MsgTypeApplyPause, message.MsgTypeApplyResume:
+			d.ctx.applyMsgs.appendMsg(d.regionID(), msg)
+		case message.MsgTypeCommitMergeResp:
+			d.onCommitMergeResp(msg.Data.(*raft_cmdpb.RaftCmdResponse))
+		case message.MsgTypeMergeResult:
+			d.onMergeResult(msg.Data.(*MsgMergeResult))
 		case message.MsgTypeNoop:
 		}
 	}
@@ -190,6 +204,9 @@ func (d *peerMsgHandler) onTick() {
 	if d.ticker.isOnTick(PeerTickSplitRegionCheck) {
 		d.onSplitRegionCheckTick()
 	}
+	if d.ticker.isOnTick(PeerTickCheckMerge) {
+		d.onCheckMergeTick()
+	}
 	d.ctx.tickDriverSender <- d.regionID()
 }
 
@@ -200,6 +217,9 @@ func (d *peerMsgHandler) startTicker() {
 	d.ticker.schedule(PeerTickRaftLogGC)
 	d.ticker.schedule(PeerTickSplitRegionCheck)
 	d.ticker.schedule(PeerTickPdHeartbeat)
+	if d.peer.pendingMergeState != nil {
+		d.ticker.schedule(PeerTickCheckMerge)
+	}
 }
 
 func (d *peerMsgHandler) onGCSnap(snaps []snap.SnapKeyWithSending) {
@@ -238,8 +258,10 @@ func (d *peerMsgHandler) onGCSnap(snaps []snap.SnapKeyWithSending) {
 	}
 }
 
-func (d *peerMsgHandler) onClearRegionSize() {
-	d.peer.ApproximateSize = nil
+// onClearRegionStats drops the statistics when the data of the region is changed other than by write commands, the
+// next split check tick reconciles them.
+func (d *peerMsgHandler) onClearRegionStats() {
+	d.peer.stats = nil
 }
 
 func (d *peerMsgHandler) onSignificantMsg(msg *MsgSignificant) {
@@ -264,6 +286,9 @@ func (d *peerMsgHandler) reportSnapshotStatus(toPeerID uint64, status raft.Snaps
 }
 
 func (d *peerMsgHandler) HandleRaftReadyAppend(proposals []*regionProposal) []*regionProposal {
+	if !d.stopped && d.issueReadIndex(time.Now()) {
+		d.hasReady = true
+	}
 	hasReady := d.hasReady
 	d.hasReady = false
 	if !hasReady || d.stopped {
@@ -277,9 +302,18 @@ func (d *peerMsgHandler) HandleRaftReadyAppend(proposals []*regionProposal) []*r
 	readyRes := d.peer.HandleRaftReadyAppend(d.ctx.trans, d.ctx.applyMsgs, d.ctx.kvWB, d.ctx.raftWB)
 	if readyRes != nil {
 		d.ctx.ReadyRes = append(d.ctx.ReadyRes, readyRes)
+		d.onReadStates(readyRes.Ready.ReadStates)
 		ss := readyRes.Ready.SoftState
+		if ss != nil && ss.Lead != raft.None {
+			d.ctx.leaderCache.observe(d.regionID(), d.peer.getPeerFromCache(ss.Lead))
+			d.ctx.regionMetrics.observeLeader(d.regionID(), ss.Lead)
+		}
 		if ss != nil && ss.RaftState == raft.StateLeader {
 			d.peer.HeartbeatPd(d.ctx.pdTaskSender)
+			d.ctx.regionEvents.publishLeaderChange(d.region(), d.peer.Meta)
+		} else if ss != nil {
+			// Only the leader sends snapshots, the one being generated is of no use anymore.
+			d.peer.Store().CancelGeneratingSnap()
 		}
 	}
 	return proposals
@@ -297,6 +331,8 @@ func (d *peerMsgHandler) onRaftBaseTick() {
 	if d.peer.PendingRemove {
 		return
 	}
+	d.handleQuorumReads(time.Now())
+	d.handleReadIndexReads(time.Now())
 	// When having pending snapshot, if election timeout is met, it can't pass
 	// the pending conf change check because first index has been updated to
 	// a value that is larger than last index.
@@ -306,6 +342,7 @@ func (d *peerMsgHandler) onRaftBaseTick() {
 		d.ticker.schedule(PeerTickRaft)
 		return
 	}
+	d.peer.checkBusyPeers()
 	// TODO: make Tick returns bool to indicate if there is ready.
 	d.peer.RaftGroup.Tick()
 	d.hasReady = d.peer.RaftGroup.HasReady()
@@ -315,16 +352,19 @@ func (d *peerMsgHandler) onRaftBaseTick() {
 func (d *peerMsgHandler) onApplyResult(res *applyTaskRes) {
 	if res.destroyPeerID != 0 {
 		y.Assert(res.destroyPeerID == d.peerID())
-		d.destroyPeer(false)
+		// A merging peer is only destroyed once it's merged.
+		d.destroyPeer(d.peer.pendingMergeState != nil)
 	} else {
 		log.Debugf("%s async apply finished %v", d.tag(), res)
 		res.execResults = d.onReadyResult(res.execResults)
 		if d.stopped {
 			return
 		}
-		if d.peer.PostApply(d.ctx.engine.Kv, res.applyState, res.appliedIndexTerm, res.sizeDiffHint) {
+		if d.peer.PostApply(d.ctx.engine.Kv, res.applyState, res.appliedIndexTerm, res.sizeDiffHint, res.statsDelta) {
 			d.hasReady = true
 		}
+		d.handleQuorumReads(time.Now())
+		d.handleReadIndexReads(time.Now())
 	}
 }
 
@@ -337,6 +377,7 @@ func (d *peerMsgHandler) onRaftMsg(msg *rspb.RaftMessage) error {
 	if d.peer.PendingRemove || d.stopped {
 		return nil
 	}
+	d.peer.observeEpoch(msg.RegionEpoch)
 	if msg.GetIsTombstone() {
 		// we receive a message tells us to remove self.
 		d.handleGCPeerMsg(msg)
@@ -362,10 +403,18 @@ func (d *peerMsgHandler) onRaftMsg(msg *rspb.RaftMessage) error {
 		return nil
 	}
 	d.peer.insertPeerCache(msg.GetFromPeer())
+	if msg.GetMessage().GetMsgType() == eraftpb.MessageType_MsgTransferLeader && !d.peer.IsLeader() {
+		// The leader asks the peer to take the leadership, rather than forwarding a transfer to it.
+		if d.peer.onPreTransferLeader(msg.GetMessage()) {
+			d.hasReady = true
+		}
+		return nil
+	}
 	err = d.peer.Step(msg.GetMessage())
 	if err != nil {
 		return err
 	}
+	d.peer.observePeerBusy(msg.FromPeer.Id, time.Duration(msg.BusyBackoffMs)*time.Millisecond)
 	if d.peer.AnyNewPeerCatchUp(msg.FromPeer.Id) {
 		d.peer.HeartbeatPd(d.ctx.pdTaskSender)
 	}
@@ -452,10 +501,11 @@ func handleStaleMsg(trans Transport, msg *rspb.RaftMessage, curEpoch *metapb.Reg
 			regionID, msgType, curEpoch)
 		return
 	}
+	// The gc message is sent back to the stale peer.
 	gcMsg := &rspb.RaftMessage{
 		RegionId:    regionID,
-		FromPeer:    fromPeer,
-		ToPeer:      toPeer,
+		FromPeer:    toPeer,
+		ToPeer:      fromPeer,
 		RegionEpoch: curEpoch,
 		IsTombstone: true,
 	}
@@ -524,12 +574,13 @@ func (d *peerMsgHandler) checkSnapshot(msg *rspb.RaftMessage) (*snap.SnapKey, er
 	existRegions := d.findOverlapRegions(meta, snapRegion)
 	for _, existRegion := range existRegions {
 		log.Infof("%s region overlapped %s %s", d.tag(), existRegion, snapRegion)
+		d.destroyMergedSource(existRegion, snapRegion)
 		return &key, nil
 	}
 
 	for _, region := range meta.pendingSnapshotRegions {
-		if bytes.Compare(region.StartKey, snapRegion.EndKey) < 0 &&
-			bytes.Compare(region.EndKey, snapRegion.StartKey) > 0 &&
+		if bytes.Compare(DataKey(region.StartKey), DataEndKey(snapRegion.EndKey)) < 0 &&
+			bytes.Compare(DataEndKey(region.EndKey), DataKey(snapRegion.StartKey)) > 0 &&
 			// Same region can overlap, we will apply the latest version of snapshot.
 			region.Id != snapRegion.Id {
 			log.Infof("pending region overlapped regionID %d peerID %d region %s snap %s",
@@ -549,20 +600,19 @@ func (d *peerMsgHandler) checkSnapshot(msg *rspb.RaftMessage) (*snap.SnapKey, er
 }
 
 func (d *peerMsgHandler) findOverlapRegions(storeMeta *storeMeta, snapRegion *metapb.Region) (result []*metapb.Region) {
+	startKey, endKey := DataKey(snapRegion.StartKey), DataEndKey(snapRegion.EndKey)
 	it := storeMeta.regionRanges.NewIterator()
-	it.Seek(snapRegion.StartKey)
-	for it.Valid() {
+	it.Seek(startKey)
+	for ; it.Valid(); it.Next() {
 		regionID := regionIDFromBytes(it.Value())
-		if bytes.Equal(it.Key(), snapRegion.StartKey) || regionID == snapRegion.Id {
-			it.Next()
+		if bytes.Equal(it.Key(), startKey) || regionID == snapRegion.Id {
 			continue
 		}
 		region := storeMeta.regions[regionID]
-		if bytes.Compare(region.StartKey, snapRegion.EndKey) < 0 {
-			result = append(result, region)
-		} else {
+		if bytes.Compare(DataKey(region.StartKey), endKey) >= 0 {
 			return
 		}
+		result = append(result, region)
 	}
 	return
 }
@@ -575,7 +625,7 @@ func (d *peerMsgHandler) handleDestroyPeer(job *DestroyPeerJob) bool {
 		log.Infof("[region %d] %d is destroyed asynchronously", job.RegionId, job.Peer.Id)
 		return false
 	}
-	d.destroyPeer(false)
+	d.destroyPeer(d.peer.pendingMergeState != nil)
 	return true
 }
 
@@ -592,7 +642,8 @@ func (d *peerMsgHandler) destroyPeer(mergeByTarget bool) {
 	}()
 	meta := d.ctx.storeMeta
 	isInitialized := d.peer.isInitialized()
-	if err := d.peer.Destroy(d.ctx.engine, false); err != nil {
+	// The data of a merged peer belongs to the target region now.
+	if err := d.peer.Destroy(d.ctx.engine, mergeByTarget); err != nil {
 		// If not panic here, the peer will be recreated in the next restart,
 		// then it will be gc again. But if some overlap region is created
 		// before restarting, the gc action will delete the overlap region's
@@ -601,13 +652,21 @@ func (d *peerMsgHandler) destroyPeer(mergeByTarget bool) {
 	}
 	d.ctx.router.close(regionID)
 	d.stop()
-	if isInitialized && !meta.regionRanges.Delete(d.region().EndKey) {
+	endKey := EncEndKey(d.region())
+	if mergeByTarget {
+		// The target region takes the range of the merged peer once it applies the CommitMerge, rather than a snapshot.
+		if id := meta.regionRanges.Get(endKey, nil); id != nil && regionIDFromBytes(id) == regionID {
+			meta.regionRanges.Delete(endKey)
+		}
+	} else if isInitialized && !meta.regionRanges.Delete(endKey) {
 		panic(d.tag() + " meta corruption detected")
 	}
 	if _, ok := meta.regions[regionID]; !ok {
 		panic(d.tag() + " meta corruption detected")
 	}
 	delete(meta.regions, regionID)
+	d.ctx.leaderCache.remove(regionID)
+	d.ctx.regionMetrics.remove(regionID)
 }
 
 func (d *peerMsgHandler) onReadyChangePeer(cp changePeer) {
@@ -628,6 +687,9 @@ func (d *peerMsgHandler) onReadyChangePeer(cp changePeer) {
 		d.peer.PeerHeartbeats[peerID] = now
 		if d.peer.IsLeader() {
 			d.peer.PeersStartPendingTime[peerID] = now
+			if cp.repair {
+				d.peer.addRepairPeer(peerID)
+			}
 		}
 		d.peer.insertPeerCache(cp.peer)
 	case eraftpb.ConfChangeType_RemoveNode:
@@ -635,6 +697,7 @@ func (d *peerMsgHandler) onReadyChangePeer(cp changePeer) {
 		delete(d.peer.PeerHeartbeats, peerID)
 		if d.peer.IsLeader() {
 			delete(d.peer.PeersStartPendingTime, peerID)
+			d.peer.removeRepairPeer(peerID)
 		}
 		d.peer.removePeerCache(peerID)
 	}
@@ -648,6 +711,7 @@ func (d *peerMsgHandler) onReadyChangePeer(cp changePeer) {
 		// Notify pd immediately.
 		log.Infof("%s notify pd with change peer region %s", d.tag(), d.region())
 		d.peer.HeartbeatPd(d.ctx.pdTaskSender)
+		d.ctx.regionEvents.publishConfChange(d.region(), d.peer.Meta)
 	}
 	myPeerID := d.peerID()
 
@@ -665,7 +729,11 @@ func (d *peerMsgHandler) onReadyCompactLog(firstIndex uint64, truncatedIndex uin
 	totalCnt := d.peer.LastApplyingIdx - firstIndex
 	// the size of current CompactLog command can be ignored.
 	remainCnt := d.peer.LastApplyingIdx - truncatedIndex - 1
-	d.peer.RaftLogSizeHint *= remainCnt / totalCnt
+	if remainCnt < totalCnt {
+		d.peer.RaftLogSizeHint = d.peer.RaftLogSizeHint * remainCnt / totalCnt
+	} else {
+		d.peer.RaftLogSizeHint = 0
+	}
 	raftLogGCTask := &raftLogGCTask{
 		raftEngine: d.ctx.engine.Raft,
 		regionID:   d.regionID(),
@@ -680,6 +748,14 @@ func (d *peerMsgHandler) onReadyCompactLog(firstIndex uint64, truncatedIndex uin
 	}
 }
 
+func (d *peerMsgHandler) onReadyDeleteRange() {
+	d.onClearRegionStats()
+	d.ctx.regionTaskSender <- worker.Task{
+		Tp:   worker.TaskTypeRegionCleanUpTombstone,
+		Data: &regionTask{regionId: d.regionID()},
+	}
+}
+
 func (d *peerMsgHandler) onReadySplitRegion(derived *metapb.Region, regions []*metapb.Region) {
 	d.ctx.storeMetaLock.Lock()
 	defer d.ctx.storeMetaLock.Unlock()
@@ -692,19 +768,20 @@ func (d *peerMsgHandler) onReadySplitRegion(derived *metapb.Region, regions []*m
 		d.peer.HeartbeatPd(d.ctx.pdTaskSender)
 		// Notify pd immediately to let it update the region meta.
 		log.Infof("%s notify pd with split count %d", d.tag(), len(regions))
+		d.ctx.regionEvents.publishSplit(regions, d.peer.Meta)
 	}
 
 	lastRegion := regions[len(regions)-1]
-	if !meta.regionRanges.Delete(lastRegion.EndKey) {
+	if !meta.regionRanges.Delete(EncEndKey(lastRegion)) {
 		panic(d.tag() + " original region should exist")
 	}
 	// It's not correct anymore, so set it to None to let split checker update it.
-	d.peer.ApproximateSize = nil
+	d.onClearRegionStats()
 	lastRegionID := lastRegion.Id
 
 	for _, newRegion := range regions {
 		newRegionID := newRegion.Id
-		notExist := meta.regionRanges.Insert(newRegion.EndKey, regionIDToBytes(newRegionID))
+		notExist := meta.regionRanges.Insert(EncEndKey(newRegion), regionIDToBytes(newRegionID))
 		y.Assert(notExist)
 		if newRegionID == regionID {
 			continue
@@ -726,7 +803,7 @@ func (d *peerMsgHandler) onReadySplitRegion(derived *metapb.Region, regions []*m
 			d.ctx.router.close(newRegionID)
 		}
 
-		newPeer, err := createPeerFsm(d.ctx.store.Id, d.ctx.cfg, d.ctx.regionTaskSender, d.ctx.engine, newRegion)
+		newPeer, err := createPeerFsm(d.ctx.store.Id, d.ctx.cfg, d.ctx.regionTaskSender, d.ctx.snapGenTaskSender, d.ctx.engine, newRegion, d.ctx.leaderCache)
 		if err != nil {
 			// peer information is already written into db, can't recover.
 			// there is probably a bug.
@@ -775,16 +852,17 @@ func (d *peerMsgHandler) onReadyApplySnapshot(applyResult *ApplySnapResult) {
 	region := applyResult.Region
 
 	log.Infof("%s snapshot for region %s is applied", d.tag(), region)
+	d.onClearRegionStats()
 	d.ctx.storeMetaLock.Lock()
 	defer d.ctx.storeMetaLock.Unlock()
 	meta := d.ctx.storeMeta
 	initialized := len(prevRegion.Peers) > 0
 	if initialized {
 		log.Infof("%s region changed from %s -> %s after applying snapshot", d.tag(), prevRegion, region)
-		meta.regionRanges.Delete(prevRegion.EndKey)
+		meta.regionRanges.Delete(EncEndKey(prevRegion))
 	}
-	if !meta.regionRanges.Insert(region.EndKey, regionIDToBytes(region.Id)) {
-		oldRegionID := regionIDFromBytes(meta.regionRanges.Get(region.EndKey, nil))
+	if !meta.regionRanges.Insert(EncEndKey(region), regionIDToBytes(region.Id)) {
+		oldRegionID := regionIDFromBytes(meta.regionRanges.Get(EncEndKey(region), nil))
 		panic(fmt.Sprintf("%s unexpected old region %d", d.tag(), oldRegionID))
 	}
 	meta.regions[region.Id] = region
@@ -804,6 +882,14 @@ func (d *peerMsgHandler) onReadyResult(execResults []execResult) []execResult {
 			d.onReadyCompactLog(x.firstIndex, x.truncatedIndex)
 		case *execResultSplitRegion:
 			d.onReadySplitRegion(x.derived, x.regions)
+		case *execResultDeleteRange:
+			d.onReadyDeleteRange()
+		case *execResultPrepareMerge:
+			d.onReadyPrepareMerge(x.region, x.state)
+		case *execResultCommitMerge:
+			d.onReadyCommitMerge(x.region, x.source)
+		case *execResultRollbackMerge:
+			d.onReadyRollbackMerge(x.region, x.commit)
 		}
 	}
 	return nil
@@ -821,11 +907,12 @@ func (d *peerMsgHandler) preProposeRaftCommand(req *raft_cmdpb.RaftCmdRequest) (
 	}
 
 	// Check whether the store has the right peer to handle the request.
-	regionID := d.regionID()
 	leaderID := d.peer.LeaderId()
-	if !d.peer.IsLeader() {
+	if !d.peer.IsLeader() && !isQuorumRead(req) {
 		leader := d.peer.getPeerFromCache(leaderID)
-		return nil, &ErrNotLeader{regionID, leader}
+		if !d.canForwardProposal(req, leader) {
+			return nil, d.peer.notLeaderErr()
+		}
 	}
 	// peer_id must be the same as peer's.
 	if err := checkPeerID(req, d.peerID()); err != nil {
@@ -835,6 +922,17 @@ func (d *peerMsgHandler) preProposeRaftCommand(req *raft_cmdpb.RaftCmdRequest) (
 	if err := checkTerm(req, d.peer.Term()); err != nil {
 		return nil, err
 	}
+	// Writes proposed to a write stalled store would only time out, reject them early so that the client backs off.
+	if hasWriteRequest(req) && d.ctx.storeBusy.isWriteStalled() {
+		return nil, &ErrServerIsBusy{Reason: "write stall", BackoffMs: uint64(d.ctx.cfg.StoreBusyBackoff / time.Millisecond)}
+	}
+	// The admin requests changing the region meta, which may move the replicas away, are still taken when the disk is full.
+	if hasWriteRequest(req) && d.ctx.storeBusy.isDiskFull() {
+		return nil, &ErrServerIsBusy{Reason: "disk full", BackoffMs: uint64(d.ctx.cfg.StoreBusyBackoff / time.Millisecond)}
+	}
+	if hasWriteRequest(req) && d.ctx.readOnly.isReadOnly(d.region()) {
+		return nil, &ErrReadOnly{RegionId: d.regionID()}
+	}
 	err := checkRegionEpoch(req, d.region(), true)
 	if errEpochNotMatching, ok := err.(*ErrEpochNotMatch); ok {
 		// Attach the region which might be split from the current region. But it doesn't
@@ -847,9 +945,35 @@ func (d *peerMsgHandler) preProposeRaftCommand(req *raft_cmdpb.RaftCmdRequest) (
 		}
 		return nil, errEpochNotMatching
 	}
+	if err == nil && req.AdminRequest.GetCmdType() == raft_cmdpb.AdminCmdType_BatchSplit {
+		err = checkBatchSplit(req.AdminRequest.Splits, d.region())
+	}
+	if err == nil {
+		err = d.checkMergeProposal(req)
+	}
 	return nil, err
 }
 
+// canForwardProposal checks whether a follower can forward the request to the leader
+// instead of rejecting it. Only plain writes are forwarded, reads on a follower may
+// be stale and admin commands must be proposed by the leader itself.
+func (d *peerMsgHandler) canForwardProposal(req *raft_cmdpb.RaftCmdRequest, leader *metapb.Peer) bool {
+	if !d.ctx.cfg.ForwardProposalToLeader || leader == nil {
+		return false
+	}
+	if req.AdminRequest != nil || len(req.Requests) == 0 {
+		return false
+	}
+	for _, r := range req.Requests {
+		switch r.CmdType {
+		case raft_cmdpb.CmdType_Put, raft_cmdpb.CmdType_Delete:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 func (d *peerMsgHandler) proposeRaftCommand(msg *raft_cmdpb.RaftCmdRequest, cb *message.Callback) {
 	resp, err := d.preProposeRaftCommand(msg)
 	if err != nil {
@@ -865,12 +989,21 @@ func (d *peerMsgHandler) proposeRaftCommand(msg *raft_cmdpb.RaftCmdRequest, cb *
 		NotifyReqRegionRemoved(d.regionID(), cb)
 		return
 	}
+	if isQuorumRead(msg) {
+		d.onQuorumRead(msg, cb)
+		return
+	}
+	if isReadIndexRead(msg) && d.peer.IsLeader() {
+		d.onReadIndexRead(msg, cb)
+		return
+	}
 
 	// Note:
 	// The peer that is being checked is a leader. It might step down to be a follower later. It
 	// doesn't matter whether the peer is a leader or not. If it's not a leader, the proposing
 	// command log entry can't be committed.
 
+	d.ctx.regionMetrics.observePropose(d.regionID())
 	resp = &raft_cmdpb.RaftCmdResponse{}
 	BindRespTerm(resp, d.peer.Term())
 	if d.peer.Propose(d.ctx.engine.Kv, d.ctx.cfg, cb, msg, resp) {
@@ -888,7 +1021,7 @@ func (d *peerMsgHandler) findSiblingRegion() *metapb.Region {
 	defer d.ctx.storeMetaLock.Unlock()
 	meta := d.ctx.storeMeta
 	it := meta.regionRanges.NewIterator()
-	it.Seek(start)
+	it.Seek(DataKey(start))
 	if !it.Valid() {
 		return nil
 	}
@@ -969,6 +1102,10 @@ func (d *peerMsgHandler) onRaftGCLogTick() {
 		// In case compact_idx == first_idx before subtraction.
 		return
 	}
+	// The CompactLog proposed before isn't applied yet, wait for the applied index to advance past it.
+	if compactIdx <= d.peer.proposedCompactIdx && d.peer.proposedCompactTerm == d.peer.Term() {
+		return
+	}
 
 	totalGCLogs += compactIdx - firstIdx
 
@@ -980,6 +1117,7 @@ func (d *peerMsgHandler) onRaftGCLogTick() {
 	// Create a compact log request and notify directly.
 	regionID := d.regionID()
 	request := newCompactLogRequest(regionID, d.peer.Meta, compactIdx, term)
+	d.peer.proposedCompactIdx, d.peer.proposedCompactTerm = compactIdx, d.peer.Term()
 	d.proposeRaftCommand(request, nil)
 }
 
@@ -994,7 +1132,10 @@ func (d *peerMsgHandler) onSplitRegionCheckTick() {
 	if !d.peer.IsLeader() {
 		return
 	}
-	if d.peer.SizeDiffHint < d.ctx.cfg.RegionSplitCheckDiff {
+	// Besides checking the regions which may have grown enough to split, the scan reconciles the statistics of the
+	// region when they are unknown or haven't been reconciled for a while.
+	reconcile := d.peer.stats == nil || time.Since(d.peer.statsReconciledAt) >= d.ctx.cfg.RegionStatsReconcileInterval
+	if d.peer.SizeDiffHint < d.ctx.cfg.RegionSplitCheckDiff && !reconcile {
 		return
 	}
 	d.ctx.splitCheckTaskSender <- worker.Task{
@@ -1027,34 +1168,20 @@ func (d *peerMsgHandler) onPrepareSplitRegion(regionEpoch *metapb.RegionEpoch, s
 	d.ctx.pdTaskSender <- worker.Task{
 		Tp: worker.TaskTypePDAskBatchSplit,
 		Data: &pdAskBatchSplitTask{
-			region:    region,
-			splitKeys: splitKeys,
-			peer:      d.peer.Meta,
-			callback:  cb,
+			region:     region,
+			splitKeys:  splitKeys,
+			leftDerive: !d.ctx.cfg.RightDeriveWhenSplit,
+			peer:       d.peer.Meta,
+			callback:   cb,
 		},
 	}
 }
 
 func (d *peerMsgHandler) validateSplitRegion(epoch *metapb.RegionEpoch, splitKeys [][]byte) error {
-	if len(splitKeys) == 0 {
-		err := errors.Errorf("%s no split key is specified", d.tag())
-		log.Error(err)
-		return err
-	}
-	for _, key := range splitKeys {
-		if len(key) == 0 {
-			err := errors.Errorf("%s split key should not be empty", d.tag())
-			log.Error(err)
-			return err
-		}
-	}
 	if !d.peer.IsLeader() {
 		// region on this store is no longer leader, skipped.
 		log.Infof("%s not leader, skip", d.tag())
-		return &ErrNotLeader{
-			RegionId: d.regionID(),
-			Leader:   d.peer.getPeerFromCache(d.peer.LeaderId()),
-		}
+		return d.peer.notLeaderErr()
 	}
 
 	region := d.region()
@@ -1071,16 +1198,24 @@ func (d *peerMsgHandler) validateSplitRegion(epoch *metapb.RegionEpoch, splitKey
 			Regions: []*metapb.Region{region},
 		}
 	}
+	if err := checkSplitKeys(splitKeys, region); err != nil {
+		log.Errorf("%s invalid split keys, err: %v", d.tag(), err)
+		return err
+	}
 	return nil
 }
 
-func (d *peerMsgHandler) onApproximateRegionSize(size uint64) {
-	d.peer.ApproximateSize = &size
+func (d *peerMsgHandler) onRegionStats(stats *regionStats) {
+	log.Debugf("%s reconciled region stats, [size: %d, keys: %d, versions: %d]",
+		d.tag(), stats.size, stats.keys, stats.versions)
+	d.peer.stats = stats
+	d.peer.statsReconciledAt = time.Now()
 }
 
 func (d *peerMsgHandler) onPDHeartbeatTick() {
 	d.ticker.schedule(PeerTickPdHeartbeat)
 	d.peer.CheckPeers()
+	d.observeRaftState()
 
 	if !d.peer.IsLeader() {
 		return
@@ -1088,6 +1223,15 @@ func (d *peerMsgHandler) onPDHeartbeatTick() {
 	d.peer.HeartbeatPd(d.ctx.pdTaskSender)
 }
 
+func (d *peerMsgHandler) observeRaftState() {
+	lastIndex, err := d.peer.Store().LastIndex()
+	if err != nil {
+		return
+	}
+	status := d.peer.GetRaftStatus()
+	d.ctx.regionMetrics.observeRaftState(d.regionID(), lastIndex, status.Commit, d.peer.Store().AppliedIndex())
+}
+
 func newAdminRequest(regionID uint64, peer *metapb.Peer) *raft_cmdpb.RaftCmdRequest {
 	return &raft_cmdpb.RaftCmdRequest{
 		Header: &raft_cmdpb.RaftRequestHeader{
@@ -1125,6 +1269,12 @@ func (d *peerMsgHandler) executeStatusCommand(request *raft_cmdpb.RaftCmdRequest
 		if err != nil {
 			return nil, err
 		}
+	case raft_cmdpb.StatusCmdType_RaftLog:
+		var err error
+		response, err = d.executeRaftLog(request)
+		if err != nil {
+			return nil, err
+		}
 	case raft_cmdpb.StatusCmdType_InvalidStatus:
 		return nil, errors.New("invalid status command!")
 	}
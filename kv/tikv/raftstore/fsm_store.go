@@ -27,6 +27,7 @@ type StoreTick int
 const (
 	StoreTickPdStoreHeartbeat StoreTick = 1
 	StoreTickSnapGC           StoreTick = 2
+	StoreTickWriteStallCheck  StoreTick = 3
 )
 
 type storeMeta struct {
@@ -59,15 +60,21 @@ type GlobalContext struct {
 	store                *metapb.Store
 	storeMeta            *storeMeta
 	storeMetaLock        *sync.RWMutex
+	leaderCache          *leaderCache
 	snapMgr              *snap.SnapManager
 	router               *router
 	trans                Transport
 	pdTaskSender         chan<- worker.Task
 	regionTaskSender     chan<- worker.Task
+	snapGenTaskSender    chan<- worker.Task
 	raftLogGCTaskSender  chan<- worker.Task
 	splitCheckTaskSender chan<- worker.Task
 	pdClient             pd.Client
 	tickDriverSender     chan uint64
+	storeBusy            *storeBusy
+	regionMetrics        *regionMetrics
+	readOnly             *readOnlyState
+	regionEvents         *RegionEventHub
 }
 
 type StoreContext struct {
@@ -95,17 +102,17 @@ type storeFsm struct {
 	lastCompactCheckKey []byte
 	stopped             bool
 	startTime           *time.Time
-	receiver            <-chan message.Msg
+	receiver            *msgQueue
 	ticker              *ticker
 }
 
-func newStoreFsm(cfg *config.Config) (chan<- message.Msg, *storeFsm) {
-	ch := make(chan message.Msg, cfg.NotifyCapacity)
+func newStoreFsm(cfg *config.Config) (*msgQueue, *storeFsm) {
+	q := newMsgQueue(int(cfg.NotifyCapacity))
 	fsm := &storeFsm{
-		receiver: (<-chan message.Msg)(ch),
+		receiver: q,
 		ticker:   newStoreTicker(cfg),
 	}
-	return (chan<- message.Msg)(ch), fsm
+	return q, fsm
 }
 
 type storeMsgHandler struct {
@@ -123,6 +130,8 @@ func (d *storeMsgHandler) onTick(tick StoreTick) {
 		d.onPDStoreHearbeatTick()
 	case StoreTickSnapGC:
 		d.onSnapMgrGC()
+	case StoreTickWriteStallCheck:
+		d.onWriteStallCheckTick()
 	}
 }
 
@@ -148,6 +157,7 @@ func (d *storeMsgHandler) start(store *metapb.Store) {
 	d.startTime = &now
 	d.ticker.scheduleStore(StoreTickPdStoreHeartbeat)
 	d.ticker.scheduleStore(StoreTickSnapGC)
+	d.ticker.scheduleStore(StoreTickWriteStallCheck)
 }
 
 /// loadPeers loads peers in this store. It scans the db engine, loads all regions
@@ -211,11 +221,16 @@ func (bs *RaftBatchSystem) loadPeers() ([]*peerFsm, error) {
 				continue
 			}
 
-			peer, err := createPeerFsm(storeID, ctx.cfg, ctx.regionTaskSender, ctx.engine, region)
+			peer, err := createPeerFsm(storeID, ctx.cfg, ctx.regionTaskSender, ctx.snapGenTaskSender, ctx.engine, region, ctx.leaderCache)
 			if err != nil {
 				return err
 			}
-			meta.regionRanges.Insert(region.EndKey, regionIDToBytes(regionID))
+			if localState.State == rspb.PeerState_Merging {
+				log.Infof("region %d is merging into region %d", regionID, localState.MergeState.GetTarget().GetId())
+				mergingCount++
+				peer.peer.pendingMergeState = localState.MergeState
+			}
+			meta.regionRanges.Insert(EncEndKey(region), regionIDToBytes(regionID))
 			meta.regions[regionID] = region
 			// No need to check duplicated here, because we use region id as the key
 			// in DB.
@@ -232,12 +247,12 @@ func (bs *RaftBatchSystem) loadPeers() ([]*peerFsm, error) {
 	// schedule applying snapshot after raft write batch were written.
 	for _, region := range applyingRegions {
 		log.Infof("region %d is applying snapshot", region.Id)
-		peer, err := createPeerFsm(storeID, ctx.cfg, ctx.regionTaskSender, ctx.engine, region)
+		peer, err := createPeerFsm(storeID, ctx.cfg, ctx.regionTaskSender, ctx.snapGenTaskSender, ctx.engine, region, ctx.leaderCache)
 		if err != nil {
 			return nil, err
 		}
 		peer.scheduleApplyingSnapshot()
-		meta.regionRanges.Insert(region.EndKey, regionIDToBytes(region.Id))
+		meta.regionRanges.Insert(EncEndKey(region), regionIDToBytes(region.Id))
 		meta.regions[region.Id] = region
 		regionPeers = append(regionPeers, peer)
 	}
@@ -271,6 +286,7 @@ type workers struct {
 	pdWorker         *worker.Worker
 	splitCheckWorker *worker.Worker
 	regionWorker     *worker.Worker
+	snapGenWorker    *worker.Worker
 	wg               *sync.WaitGroup
 }
 
@@ -278,9 +294,19 @@ type RaftBatchSystem struct {
 	ctx        *GlobalContext
 	router     *router
 	workers    *workers
+	applyPool  *applyPool
 	tickDriver *tickDriver
 	closeCh    chan struct{}
 	wg         *sync.WaitGroup
+	// The tick driver is stopped after the raft workers, which may be blocked registering their regions to it.
+	tickDriverCloseCh chan struct{}
+	tickDriverWg      *sync.WaitGroup
+	regionEvents      *RegionEventHub
+}
+
+// RegionEvents returns the hub the leaders on this store publish the changes of their regions to.
+func (bs *RaftBatchSystem) RegionEvents() *RegionEventHub {
+	return bs.regionEvents
 }
 
 func (bs *RaftBatchSystem) start(
@@ -304,25 +330,33 @@ func (bs *RaftBatchSystem) start(
 	bs.workers = &workers{
 		splitCheckWorker: worker.NewWorker("split-check", wg),
 		regionWorker:     worker.NewWorker("snapshot-worker", wg),
+		snapGenWorker:    worker.NewWorker("snap-generator", wg),
 		raftLogGCWorker:  worker.NewWorker("raft-gc-worker", wg),
 		pdWorker:         pdWorker,
 		wg:               wg,
 	}
+	storeBusy := newStoreBusy(cfg, bs.router)
 	bs.ctx = &GlobalContext{
 		cfg:                  cfg,
 		engine:               engines,
 		store:                meta,
 		storeMeta:            newStoreMeta(),
 		storeMetaLock:        new(sync.RWMutex),
+		leaderCache:          newLeaderCache(),
 		snapMgr:              snapMgr,
 		router:               bs.router,
-		trans:                trans,
+		trans:                &busyTransport{Transport: trans, busy: storeBusy},
 		pdTaskSender:         bs.workers.pdWorker.Sender(),
 		regionTaskSender:     bs.workers.regionWorker.Sender(),
+		snapGenTaskSender:    bs.workers.snapGenWorker.Sender(),
 		splitCheckTaskSender: bs.workers.splitCheckWorker.Sender(),
 		raftLogGCTaskSender:  bs.workers.raftLogGCWorker.Sender(),
 		pdClient:             pdClient,
 		tickDriverSender:     bs.tickDriver.newRegionCh,
+		storeBusy:            storeBusy,
+		regionMetrics:        newRegionMetrics(cfg.RegionMetricsTopN),
+		readOnly:             new(readOnlyState),
+		regionEvents:         bs.regionEvents,
 	}
 	regionPeers, err := bs.loadPeers()
 	if err != nil {
@@ -340,8 +374,10 @@ func (bs *RaftBatchSystem) startWorkers(peers []*peerFsm) {
 	ctx := bs.ctx
 	workers := bs.workers
 	router := bs.router
+	bs.applyPool = newApplyPool(ctx, router)
+	bs.applyPool.start()
 	for i := 0; i < ctx.cfg.RaftWorkerCnt; i++ {
-		rw := newRaftWorker(ctx, router.workerSenders[i], router)
+		rw := newRaftWorker(ctx, router.workerSenders[i], router, bs.applyPool)
 		bs.wg.Add(1)
 		go rw.run(bs.closeCh, bs.wg)
 	}
@@ -360,10 +396,13 @@ func (bs *RaftBatchSystem) startWorkers(peers []*peerFsm) {
 	cfg := ctx.cfg
 	workers.splitCheckWorker.Start(newSplitCheckHandler(engines.Kv, router, cfg.SplitCheck))
 	workers.regionWorker.Start(newRegionTaskHandler(engines, ctx.snapMgr))
+	workers.snapGenWorker.Start(newSnapGenTaskHandler(engines, ctx.snapMgr))
 	workers.raftLogGCWorker.Start(&raftLogGCTaskHandler{})
-	workers.pdWorker.Start(newPDTaskHandler(ctx.store.Id, ctx.pdClient, NewRaftstoreRouter(bs.router)))
-	bs.wg.Add(1)
-	go bs.tickDriver.run(bs.closeCh, bs.wg) // TODO: temp workaround.
+	pdTaskHandler := newPDTaskHandler(ctx.store.Id, ctx.pdClient, NewRaftstoreRouter(bs.router), ctx.readOnly, ctx.storeBusy)
+	pdTaskHandler.start()
+	workers.pdWorker.Start(pdTaskHandler)
+	bs.tickDriverWg.Add(1)
+	go bs.tickDriver.run(bs.tickDriverCloseCh, bs.tickDriverWg) // TODO: temp workaround.
 }
 
 func (bs *RaftBatchSystem) shutDown() {
@@ -372,11 +411,15 @@ func (bs *RaftBatchSystem) shutDown() {
 	}
 	close(bs.closeCh)
 	bs.wg.Wait()
+	bs.applyPool.stop()
+	close(bs.tickDriverCloseCh)
+	bs.tickDriverWg.Wait()
 	workers := bs.workers
 	bs.workers = nil
 	stopTask := worker.Task{Tp: worker.TaskTypeStop}
 	workers.splitCheckWorker.Sender() <- stopTask
 	workers.regionWorker.Sender() <- stopTask
+	workers.snapGenWorker.Sender() <- stopTask
 	workers.raftLogGCWorker.Sender() <- stopTask
 	workers.pdWorker.Sender() <- stopTask
 	workers.wg.Wait()
@@ -384,12 +427,16 @@ func (bs *RaftBatchSystem) shutDown() {
 
 func CreateRaftBatchSystem(cfg *config.Config) (*router, *RaftBatchSystem) {
 	storeSender, storeFsm := newStoreFsm(cfg)
-	router := newRouter(cfg.RaftWorkerCnt, storeSender, storeFsm)
+	router := newRouter(cfg, storeSender, storeFsm)
 	raftBatchSystem := &RaftBatchSystem{
 		router:     router,
 		tickDriver: newTickDriver(cfg.RaftBaseTickInterval, router, storeFsm.ticker),
 		closeCh:    make(chan struct{}),
 		wg:         new(sync.WaitGroup),
+
+		tickDriverCloseCh: make(chan struct{}),
+		tickDriverWg:      new(sync.WaitGroup),
+		regionEvents:      NewRegionEventHub(),
 	}
 	return router, raftBatchSystem
 }
@@ -433,7 +480,7 @@ func (d *storeMsgHandler) checkMsg(msg *rspb.RaftMessage) (bool, error) {
 	}
 	log.Debugf("region %d in tombstone state: %s", regionID, localState)
 	region := localState.Region
-	regionEpoch := region.RegionEpoch
+	regionEpoch := mergeEpoch(region.RegionEpoch, localState.MaxEpoch)
 	// The region in this peer is already destroyed
 	if IsEpochStale(fromEpoch, regionEpoch) {
 		log.Infof("tombstone peer receives a stale message. region_id:%d, from_region_epoch:%s, current_region_epoch:%s, msg_type:%s",
@@ -455,7 +502,7 @@ func (d *storeMsgHandler) onRaftMessage(msg *rspb.RaftMessage) error {
 		return nil
 	}
 	log.Debugf("handle raft message. from_peer:%d, to_peer:%d, store:%d, region:%d, msg_type:%s",
-		msg.FromPeer.Id, msg.ToPeer.Id, d.storeFsm.id, regionID, msg.Message.MsgType)
+		msg.FromPeer.Id, msg.ToPeer.Id, d.storeFsm.id, regionID, msg.GetMessage().GetMsgType())
 	if msg.ToPeer.StoreId != d.ctx.store.Id {
 		log.Warnf("store not match, ignore it. store_id:%d, to_store_id:%d, region_id:%d",
 			d.ctx.store.Id, msg.ToPeer.StoreId, regionID)
@@ -508,15 +555,16 @@ func (d *storeMsgHandler) maybeCreatePeer(regionID uint64, msg *rspb.RaftMessage
 		return false, nil
 	}
 
+	startKey, endKey := DataKey(msg.StartKey), DataEndKey(msg.EndKey)
 	it := meta.regionRanges.NewIterator()
-	it.Seek(msg.StartKey)
-	if it.Valid() && bytes.Equal(msg.StartKey, it.Key()) {
+	it.Seek(startKey)
+	if it.Valid() && bytes.Equal(startKey, it.Key()) {
 		it.Next()
 	}
 	for ; it.Valid(); it.Next() {
 		regionID := regionIDFromBytes(it.Value())
 		existRegion := meta.regions[regionID]
-		if bytes.Compare(existRegion.StartKey, msg.EndKey) >= 0 {
+		if bytes.Compare(DataKey(existRegion.StartKey), endKey) >= 0 {
 			break
 		}
 		log.Debugf("msg %s is overlapped with exist region %s", msg, existRegion)
@@ -527,7 +575,7 @@ func (d *storeMsgHandler) maybeCreatePeer(regionID uint64, msg *rspb.RaftMessage
 	}
 
 	peer, err := replicatePeerFsm(
-		d.ctx.store.Id, d.ctx.cfg, d.ctx.regionTaskSender, d.ctx.engine, regionID, msg.ToPeer)
+		d.ctx.store.Id, d.ctx.cfg, d.ctx.regionTaskSender, d.ctx.snapGenTaskSender, d.ctx.engine, regionID, msg.ToPeer, d.ctx.leaderCache)
 	if err != nil {
 		return false, err
 	}
@@ -545,6 +593,10 @@ func (d *storeMsgHandler) storeHeartbeatPD() {
 	d.ctx.storeMetaLock.RLock()
 	stats.RegionCount = uint32(len(d.ctx.storeMeta.regions))
 	d.ctx.storeMetaLock.RUnlock()
+	if backoff := d.ctx.storeBusy.backoff(); backoff > 0 {
+		stats.IsBusy = true
+		stats.BusyBackoffMs = uint64(backoff / time.Millisecond)
+	}
 	storeInfo := &pdStoreHeartbeatTask{
 		stats:    stats,
 		engine:   d.ctx.engine.Kv,
@@ -556,9 +608,15 @@ func (d *storeMsgHandler) storeHeartbeatPD() {
 
 func (d *storeMsgHandler) onPDStoreHearbeatTick() {
 	d.storeHeartbeatPD()
+	d.ctx.regionMetrics.export()
 	d.ticker.scheduleStore(StoreTickPdStoreHeartbeat)
 }
 
+func (d *storeMsgHandler) onWriteStallCheckTick() {
+	d.ctx.storeBusy.checkWriteStall(d.ctx.engine.Kv)
+	d.ticker.scheduleStore(StoreTickWriteStallCheck)
+}
+
 func (d *storeMsgHandler) handleSnapMgrGC() error {
 	mgr := d.ctx.snapMgr
 	snapKeys, err := mgr.ListIdleSnap()
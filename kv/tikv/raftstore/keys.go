@@ -30,6 +30,7 @@ const (
 	RaftStateSuffix         byte = 0x02
 	ApplyStateSuffix        byte = 0x03
 	SnapshotRaftStateSuffix byte = 0x04
+	AppliedCmdSuffix        byte = 0x05
 
 	// For region meta
 	RegionStateSuffix byte = 0x01
@@ -48,6 +49,8 @@ var (
 	// Following keys are all local keys, so the first byte must be 0x01.
 	prepareBootstrapKey = []byte{LocalPrefix, 0x01}
 	storeIdentKey       = []byte{LocalPrefix, 0x02}
+	// SeedStartedKey marks the kv engine as being seeded, once the first pairs are written directly to it.
+	SeedStartedKey = []byte{LocalPrefix, 0x04}
 )
 
 func makeRegionPrefix(regionID uint64, suffix byte) []byte {
@@ -93,6 +96,15 @@ func SnapshotRaftStateKey(regionID uint64) []byte {
 	return makeRegionPrefix(regionID, SnapshotRaftStateSuffix)
 }
 
+// AppliedCmdKey is the key of the command tagged with a uuid applied at index, it's stored in the kv engine.
+func AppliedCmdKey(regionID, index uint64) []byte {
+	return makeRegionKey(regionID, AppliedCmdSuffix, index)
+}
+
+func AppliedCmdPrefixKey(regionID uint64) []byte {
+	return makeRegionPrefix(regionID, AppliedCmdSuffix)
+}
+
 func IsRaftStateKey(key []byte) bool {
 	return len(key) == 11 && key[0] == LocalPrefix && key[1] == RegionRaftPrefix
 }
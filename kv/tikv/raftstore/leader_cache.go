@@ -0,0 +1,41 @@
+package raftstore
+
+import (
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+)
+
+// leaderCache records the leaders recently observed by the peers on this store.
+// A peer which doesn't know its leader, e.g. during an election or just after
+// restart, can still suggest one to the client instead of making it retry
+// every peer of the region.
+type leaderCache struct {
+	mu      sync.RWMutex
+	leaders map[uint64]*metapb.Peer
+}
+
+func newLeaderCache() *leaderCache {
+	return &leaderCache{leaders: make(map[uint64]*metapb.Peer)}
+}
+
+func (c *leaderCache) observe(regionID uint64, leader *metapb.Peer) {
+	if leader == nil {
+		return
+	}
+	c.mu.Lock()
+	c.leaders[regionID] = leader
+	c.mu.Unlock()
+}
+
+func (c *leaderCache) get(regionID uint64) *metapb.Peer {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.leaders[regionID]
+}
+
+func (c *leaderCache) remove(regionID uint64) {
+	c.mu.Lock()
+	delete(c.leaders, regionID)
+	c.mu.Unlock()
+}
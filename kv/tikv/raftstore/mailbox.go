@@ -0,0 +1,108 @@
+package raftstore
+
+import (
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/message"
+	"go.uber.org/atomic"
+)
+
+// mailboxPolicy is what happens to a message sent to a peer whose mailbox is full.
+type mailboxPolicy int
+
+const (
+	// mailboxForce queues the message anyway, the raft messages are never dropped by the mailbox because the raft
+	// groups of the other peers wait for them.
+	mailboxForce mailboxPolicy = iota
+	// mailboxDrop drops the message silently, for the messages which are sent again periodically.
+	mailboxDrop
+	// mailboxReject fails the send with ServerIsBusy, so that the sender backs off.
+	mailboxReject
+)
+
+func mailboxPolicyOf(msg message.Msg) mailboxPolicy {
+	if msg.Type == message.MsgTypeTick {
+		return mailboxDrop
+	}
+	switch priorityOf(msg) {
+	case msgPriorityRaft:
+		return mailboxForce
+	case msgPriorityHousekeeping:
+		return mailboxDrop
+	default:
+		return mailboxReject
+	}
+}
+
+// mailbox bounds the messages a peer has queued for its raft worker. The worker queue is shared by the peers of the
+// worker, without the bound a stuck peer flooded with messages would fill it up and block the senders of all the
+// others. The apply results are sent to the worker queue by the apply workers directly and aren't counted.
+type mailbox struct {
+	queue    *msgQueue
+	capacity int64
+	backoff  time.Duration
+	pending  *atomic.Int64
+	dropped  *atomic.Uint64
+}
+
+func newMailbox(queue *msgQueue, capacity uint64, backoff time.Duration) *mailbox {
+	return &mailbox{
+		queue:    queue,
+		capacity: int64(capacity),
+		backoff:  backoff,
+		pending:  atomic.NewInt64(0),
+		dropped:  atomic.NewUint64(0),
+	}
+}
+
+func (m *mailbox) full() bool {
+	return m.capacity > 0 && m.pending.Load() >= m.capacity
+}
+
+// send queues the message by the policy of its type if the mailbox is full. Otherwise it blocks while the worker
+// queue is full, except that a message it may drop is dropped instead of waiting.
+func (m *mailbox) send(msg message.Msg) error {
+	policy := mailboxPolicyOf(msg)
+	if policy != mailboxForce && m.full() {
+		if policy == mailboxDrop {
+			m.dropped.Inc()
+			return nil
+		}
+		return &ErrServerIsBusy{Reason: "peer mailbox full", BackoffMs: uint64(m.backoff / time.Millisecond)}
+	}
+	m.pending.Inc()
+	if policy == mailboxDrop {
+		if !m.queue.trySend(msg) {
+			m.pending.Dec()
+			m.dropped.Inc()
+		}
+		return nil
+	}
+	m.queue.send(msg)
+	return nil
+}
+
+// release is called by the raft worker for every message of the peer it takes from the worker queue. A peer
+// registered again for a region gets a new mailbox whose counter starts from 0, while the messages counted by the
+// mailbox of the peer before may still be queued, so the counter never goes below 0 or it would raise the capacity.
+func (m *mailbox) release(msg message.Msg) {
+	if msg.Type == message.MsgTypeApplyRes {
+		return
+	}
+	for {
+		pending := m.pending.Load()
+		if pending <= 0 || m.pending.CAS(pending, pending-1) {
+			return
+		}
+	}
+}
+
+// wake queues a noop so that the raft worker handles the peer again. It's sent by the raft worker itself, so it never
+// blocks, the message is dropped if the worker queue is full.
+func (m *mailbox) wake(regionID uint64) {
+	m.pending.Inc()
+	if !m.queue.trySend(message.NewPeerMsg(message.MsgTypeNoop, regionID, nil)) {
+		m.pending.Dec()
+		m.dropped.Inc()
+	}
+}
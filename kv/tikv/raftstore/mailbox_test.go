@@ -0,0 +1,48 @@
+package raftstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMailbox(t *testing.T) {
+	q := newMsgQueue(4)
+	mb := newMailbox(q, 2, time.Second)
+
+	require.Nil(t, mb.send(message.NewPeerMsg(message.MsgTypeRaftCmd, 1, nil)))
+	require.Nil(t, mb.send(message.NewPeerMsg(message.MsgTypeTick, 1, nil)))
+	assert.True(t, mb.full())
+
+	// Once full, the proposals are rejected, the ticks and housekeeping messages are dropped, and the raft messages are
+	// still queued.
+	err := mb.send(message.NewPeerMsg(message.MsgTypeRaftCmd, 1, nil))
+	assert.Equal(t, &ErrServerIsBusy{Reason: "peer mailbox full", BackoffMs: 1000}, err)
+	assert.Nil(t, mb.send(message.NewPeerMsg(message.MsgTypeTick, 1, nil)))
+	assert.Nil(t, mb.send(message.NewPeerMsg(message.MsgTypeGcSnap, 1, nil)))
+	assert.Equal(t, uint64(2), mb.dropped.Load())
+	assert.Nil(t, mb.send(message.NewPeerMsg(message.MsgTypeRaftMessage, 1, nil)))
+	assert.Equal(t, 3, q.len())
+
+	// The apply results aren't counted.
+	for _, msg := range q.fetch(append(q.fetch(nil), message.NewPeerMsg(message.MsgTypeApplyRes, 1, nil))) {
+		mb.release(msg)
+	}
+	assert.Equal(t, int64(0), mb.pending.Load())
+	assert.Nil(t, mb.send(message.NewPeerMsg(message.MsgTypeRaftCmd, 1, nil)))
+
+	// The messages counted by the mailbox of a peer registered before don't raise the capacity.
+	mb.release(message.NewPeerMsg(message.MsgTypeRaftCmd, 1, nil))
+	mb.release(message.NewPeerMsg(message.MsgTypeRaftCmd, 1, nil))
+	assert.Equal(t, int64(0), mb.pending.Load())
+
+	// A message which may be dropped isn't waiting for the worker queue.
+	mb = newMailbox(newMsgQueue(1), 0, time.Second)
+	assert.Nil(t, mb.send(message.NewPeerMsg(message.MsgTypeSplitRegion, 1, nil)))
+	assert.Nil(t, mb.send(message.NewPeerMsg(message.MsgTypeSplitRegion, 1, nil)))
+	assert.Equal(t, int64(1), mb.pending.Load())
+	assert.Equal(t, uint64(1), mb.dropped.Load())
+}
@@ -0,0 +1,401 @@
+package raftstore
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/ngaut/log"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/pingcap/errors"
+)
+
+// A region, the source, is merged into an adjacent region, the target, whose peers are on the same stores:
+//   1. The leader of the source proposes PrepareMerge. Once it's applied the source is merging, it records the target
+//      and the index of the PrepareMerge, and rejects the proposals but reads and RollbackMerge.
+//   2. Once all the peers of the source have the PrepareMerge in their logs, the leader of the source proposes
+//      CommitMerge to the target peer on its store. If that peer isn't the leader of the target, the leadership of the
+//      source is transferred to the store of the leader of the target first.
+//   3. A target peer applies the CommitMerge once the source peer on its store has applied the PrepareMerge, until
+//      then its apply is paused. The target takes the range of the source, and the source peer is destroyed, keeping
+//      its data which belongs to the target now.
+// The data keys are not prefixed by the region, so the merge only changes the metadata of the regions. If the target
+// changed since the PrepareMerge, the CommitMerge is rejected with an epoch error and the source proposes RollbackMerge
+// to serve again.
+
+// isAdjacent returns whether the region ends where the other one starts, or starts where it ends.
+func isAdjacent(region, other *metapb.Region) bool {
+	return (len(region.EndKey) > 0 && bytes.Equal(region.EndKey, other.StartKey)) ||
+		(len(other.EndKey) > 0 && bytes.Equal(other.EndKey, region.StartKey))
+}
+
+// regionCovers returns whether the range of region covers the one of other.
+func regionCovers(region, other *metapb.Region) bool {
+	return bytes.Compare(region.StartKey, other.StartKey) <= 0 &&
+		(len(region.EndKey) == 0 || (len(other.EndKey) > 0 && bytes.Compare(other.EndKey, region.EndKey) <= 0))
+}
+
+// hasPeersOnSameStores returns whether the peers of the regions are on the same stores.
+func hasPeersOnSameStores(region, other *metapb.Region) bool {
+	if len(region.Peers) != len(other.Peers) {
+		return false
+	}
+	for _, peer := range region.Peers {
+		if findPeer(other, peer.StoreId) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *applier) execPrepareMerge(aCtx *applyContext, req *raft_cmdpb.AdminRequest) (
+	resp *raft_cmdpb.AdminResponse, result applyResult, err error) {
+	target := req.PrepareMerge.GetTarget()
+	if target == nil {
+		err = errors.New("missing merge target")
+		return
+	}
+	region := new(metapb.Region)
+	if err := CloneMsg(a.region, region); err != nil {
+		panic(err)
+	}
+	// The conf version is bumped too, so that the conf changes proposed before are rejected.
+	region.RegionEpoch.Version++
+	region.RegionEpoch.ConfVer++
+	state := &rspb.MergeState{Target: target, Commit: aCtx.execCtx.index}
+	writeMergingState(aCtx.wb, region, state)
+	log.Infof("%s prepare merge into region %d at index %d", a.tag, target.Id, state.Commit)
+
+	resp = &raft_cmdpb.AdminResponse{PrepareMerge: &raft_cmdpb.PrepareMergeResponse{}}
+	result = applyResult{tp: applyResultTypeExecResult, data: &execResultPrepareMerge{
+		region: region,
+		state:  state,
+	}}
+	return
+}
+
+// mergeSourceState returns the local state of the source region of the CommitMerge, or nil if the source peer on the
+// store hasn't applied the PrepareMerge yet.
+func (a *applier) mergeSourceState(aCtx *applyContext, req *raft_cmdpb.CommitMergeRequest) *rspb.RegionLocalState {
+	state, err := getRegionLocalState(aCtx.engines.Kv, req.GetSource().GetId())
+	if err != nil || state.State != rspb.PeerState_Merging {
+		return nil
+	}
+	mergeState := state.MergeState
+	if mergeState.GetCommit() != req.Commit || mergeState.GetTarget().GetId() != a.region.Id {
+		return nil
+	}
+	return state
+}
+
+// isMergeSourcePending returns whether cmd is a CommitMerge which has to wait for the source peer on the store to
+// apply the PrepareMerge.
+func (a *applier) isMergeSourcePending(aCtx *applyContext, cmd *raft_cmdpb.RaftCmdRequest) bool {
+	req := cmd.AdminRequest.GetCommitMerge()
+	if cmd.AdminRequest.GetCmdType() != raft_cmdpb.AdminCmdType_CommitMerge || req == nil {
+		return false
+	}
+	// A command failing the epoch check is rejected anyway.
+	if checkRegionEpoch(cmd, a.region, false) != nil {
+		return false
+	}
+	return a.mergeSourceState(aCtx, req) == nil
+}
+
+// waitMergeSource pauses the apply at a CommitMerge until the source peer on the store has applied the PrepareMerge,
+// the source peer resumes it then. The entries from the CommitMerge on are applied on resume.
+func (a *applier) waitMergeSource(entries []eraftpb.Entry) {
+	log.Infof("%s wait for the merge source to apply PrepareMerge, index %d", a.tag, entries[0].Index)
+	pending := make([]eraftpb.Entry, len(entries))
+	copy(pending, entries)
+	a.paused = true
+	a.pausedTasks = append(a.pausedTasks, newApplyMsg(&apply{regionId: a.region.Id, term: a.term, entries: pending}))
+}
+
+func (a *applier) execCommitMerge(aCtx *applyContext, req *raft_cmdpb.AdminRequest) (
+	resp *raft_cmdpb.AdminResponse, result applyResult, err error) {
+	state := a.mergeSourceState(aCtx, req.CommitMerge)
+	if state == nil {
+		// Only a command rejected by the epoch check skips waiting for the source.
+		panic(fmt.Sprintf("%s merge source %d is not ready", a.tag, req.CommitMerge.GetSource().GetId()))
+	}
+	source := state.Region
+	region := new(metapb.Region)
+	if err := CloneMsg(a.region, region); err != nil {
+		panic(err)
+	}
+	switch {
+	case len(source.EndKey) > 0 && bytes.Equal(source.EndKey, region.StartKey):
+		region.StartKey = source.StartKey
+	case len(region.EndKey) > 0 && bytes.Equal(source.StartKey, region.EndKey):
+		region.EndKey = source.EndKey
+	default:
+		err = errors.Errorf("region %s is not adjacent to %s", source, region)
+		return
+	}
+	// The version is larger than the ones of both regions, the requests sent to either of them are rejected.
+	version := region.RegionEpoch.Version
+	if source.RegionEpoch.Version > version {
+		version = source.RegionEpoch.Version
+	}
+	region.RegionEpoch.Version = version + 1
+	WritePeerState(aCtx.wb, region, rspb.PeerState_Normal)
+	// The source peer is marked destroyed along, it's not loaded again if the store restarts before it destroys itself.
+	writeTombstoneState(aCtx.wb, source, nil)
+	a.handOverRangeTombstones(aCtx, source.Id, []*metapb.Region{region})
+	log.Infof("%s merge region %d, the region is %s now", a.tag, source.Id, region)
+
+	resp = &raft_cmdpb.AdminResponse{CommitMerge: &raft_cmdpb.CommitMergeResponse{}}
+	result = applyResult{tp: applyResultTypeExecResult, data: &execResultCommitMerge{
+		region: region,
+		source: source,
+	}}
+	return
+}
+
+func (a *applier) execRollbackMerge(aCtx *applyContext, req *raft_cmdpb.AdminRequest) (
+	resp *raft_cmdpb.AdminResponse, result applyResult, err error) {
+	region := new(metapb.Region)
+	if err := CloneMsg(a.region, region); err != nil {
+		panic(err)
+	}
+	region.RegionEpoch.Version++
+	WritePeerState(aCtx.wb, region, rspb.PeerState_Normal)
+	log.Infof("%s roll back the merge prepared at index %d", a.tag, req.RollbackMerge.Commit)
+
+	resp = &raft_cmdpb.AdminResponse{RollbackMerge: &raft_cmdpb.RollbackMergeResponse{}}
+	result = applyResult{tp: applyResultTypeExecResult, data: &execResultRollbackMerge{
+		region: region,
+		commit: req.RollbackMerge.Commit,
+	}}
+	return
+}
+
+// checkMergeProposal rejects the proposals of a merging region but the reads and RollbackMerge, and checks whether
+// the region can be merged into the target of a PrepareMerge.
+func (d *peerMsgHandler) checkMergeProposal(req *raft_cmdpb.RaftCmdRequest) error {
+	cmdType := req.AdminRequest.GetCmdType()
+	if state := d.peer.pendingMergeState; state != nil {
+		if isReadIndexRead(req) || cmdType == raft_cmdpb.AdminCmdType_TransferLeader ||
+			(cmdType == raft_cmdpb.AdminCmdType_RollbackMerge && req.AdminRequest.RollbackMerge.GetCommit() == state.Commit) {
+			return nil
+		}
+		return &ErrServerIsBusy{Reason: "region is merging", BackoffMs: uint64(d.ctx.cfg.StoreBusyBackoff / time.Millisecond)}
+	}
+	switch cmdType {
+	case raft_cmdpb.AdminCmdType_PrepareMerge:
+		return d.checkPrepareMerge(req.AdminRequest.PrepareMerge.GetTarget())
+	case raft_cmdpb.AdminCmdType_RollbackMerge:
+		return errors.Errorf("%s is not merging", d.tag())
+	}
+	return nil
+}
+
+func (d *peerMsgHandler) checkPrepareMerge(target *metapb.Region) error {
+	region := d.region()
+	if target == nil || target.Id == region.Id {
+		return errors.Errorf("%s invalid merge target %s", d.tag(), target)
+	}
+	if !isAdjacent(region, target) {
+		return errors.Errorf("%s is not adjacent to the merge target %s", d.tag(), target)
+	}
+	if !hasPeersOnSameStores(region, target) {
+		return errors.Errorf("%s has peers on other stores than the merge target %s", d.tag(), target)
+	}
+	if d.peer.RaftGroup.Raft.PendingConfIndex > d.peer.Store().AppliedIndex() {
+		return errors.Errorf("%s there is a pending conf change, try later", d.tag())
+	}
+	d.ctx.storeMetaLock.RLock()
+	local := d.ctx.storeMeta.regions[target.Id]
+	d.ctx.storeMetaLock.RUnlock()
+	// The CommitMerge is proposed to the target peer on the store, it must know the target.
+	if local == nil || !RegionEqual(local, target) {
+		return errors.Errorf("%s merge target %s doesn't match the local one %s", d.tag(), target, local)
+	}
+	return nil
+}
+
+// notifyMergeTarget resumes the apply of the target peer on the store, which may wait for the PrepareMerge to be
+// applied.
+func (d *peerMsgHandler) notifyMergeTarget() {
+	targetID := d.peer.pendingMergeState.Target.Id
+	if err := d.ctx.router.send(targetID, message.NewPeerMsg(message.MsgTypeApplyResume, targetID, nil)); err != nil {
+		log.Debugf("%s merge target %d is not on the store", d.tag(), targetID)
+	}
+}
+
+func (d *peerMsgHandler) onReadyPrepareMerge(region *metapb.Region, state *rspb.MergeState) {
+	d.ctx.storeMetaLock.Lock()
+	d.ctx.storeMeta.setRegion(region, d.peer)
+	d.ctx.storeMetaLock.Unlock()
+	d.peer.pendingMergeState = state
+	d.notifyMergeTarget()
+	d.ticker.schedule(PeerTickCheckMerge)
+	if d.peer.IsLeader() {
+		d.peer.HeartbeatPd(d.ctx.pdTaskSender)
+	}
+}
+
+func (d *peerMsgHandler) onReadyCommitMerge(region, source *metapb.Region) {
+	d.ctx.storeMetaLock.Lock()
+	meta := d.ctx.storeMeta
+	meta.regionRanges.Delete(EncEndKey(source))
+	meta.regionRanges.Delete(EncEndKey(d.region()))
+	meta.regionRanges.Insert(EncEndKey(region), regionIDToBytes(region.Id))
+	meta.setRegion(region, d.peer)
+	d.ctx.storeMetaLock.Unlock()
+	d.onClearRegionStats()
+	if d.peer.IsLeader() {
+		d.peer.HeartbeatPd(d.ctx.pdTaskSender)
+		d.ctx.regionEvents.publishMerge(region, source, d.peer.Meta)
+	}
+	msg := message.NewPeerMsg(message.MsgTypeMergeResult, source.Id, &MsgMergeResult{Target: region})
+	if err := d.ctx.router.send(source.Id, msg); err != nil {
+		log.Warnf("%s merged region %d is not on the store", d.tag(), source.Id)
+	}
+}
+
+func (d *peerMsgHandler) onReadyRollbackMerge(region *metapb.Region, commit uint64) {
+	d.ctx.storeMetaLock.Lock()
+	d.ctx.storeMeta.setRegion(region, d.peer)
+	d.ctx.storeMetaLock.Unlock()
+	if state := d.peer.pendingMergeState; state != nil && state.Commit == commit {
+		d.peer.pendingMergeState = nil
+	}
+	if d.peer.IsLeader() {
+		d.peer.HeartbeatPd(d.ctx.pdTaskSender)
+	}
+}
+
+// onMergeResult destroys the merged source peer, once its applier is stopped.
+func (d *peerMsgHandler) onMergeResult(result *MsgMergeResult) {
+	if d.stopped || d.peer.pendingMergeState == nil || d.peer.pendingMergeState.Target.Id != result.Target.Id {
+		return
+	}
+	log.Infof("%s is merged into %s", d.tag(), result.Target)
+	if job := d.peer.MaybeDestroy(); job != nil {
+		d.handleDestroyPeer(job)
+	}
+}
+
+// destroyMergedSource asks the merging source region overlapped by the snapshot of its target to destroy itself, the
+// snapshot is taken after the target has merged it. The snapshot is rejected meanwhile, raft sends it again.
+func (d *peerMsgHandler) destroyMergedSource(source, snapRegion *metapb.Region) {
+	state, err := getRegionLocalState(d.ctx.engine.Kv, source.Id)
+	if err != nil || state.State != rspb.PeerState_Merging {
+		return
+	}
+	target := state.MergeState.GetTarget()
+	if target.GetId() != snapRegion.Id || snapRegion.RegionEpoch.Version <= target.GetRegionEpoch().GetVersion() {
+		return
+	}
+	msg := message.NewPeerMsg(message.MsgTypeMergeResult, source.Id, &MsgMergeResult{Target: snapRegion})
+	if err := d.ctx.router.send(source.Id, msg); err != nil {
+		log.Warnf("%s merged region %d is not on the store", d.tag(), source.Id)
+	}
+}
+
+func (d *peerMsgHandler) onCheckMergeTick() {
+	state := d.peer.pendingMergeState
+	if state == nil {
+		return
+	}
+	d.ticker.schedule(PeerTickCheckMerge)
+	// The target peer on the store may have missed the notification of the PrepareMerge being applied.
+	d.notifyMergeTarget()
+	if !d.peer.IsLeader() {
+		return
+	}
+	// A source peer which misses the PrepareMerge can't catch up once the other peers are destroyed, and the target
+	// peer on its store would wait for it forever.
+	for id, pr := range d.peer.RaftGroup.Status().Progress {
+		if pr.Match < state.Commit {
+			log.Infof("%s peer %d hasn't appended the PrepareMerge, match %d", d.tag(), id, pr.Match)
+			return
+		}
+	}
+	d.proposeCommitMerge(state)
+}
+
+// proposeCommitMerge proposes CommitMerge to the target peer on the store. The response is sent back to the source.
+func (d *peerMsgHandler) proposeCommitMerge(state *rspb.MergeState) {
+	target := state.Target
+	peer := findPeer(target, d.storeID())
+	if peer == nil {
+		log.Warnf("%s merge target %s has no peer on the store", d.tag(), target)
+		return
+	}
+	req := newAdminRequest(target.Id, peer)
+	req.Header.RegionEpoch = target.RegionEpoch
+	req.AdminRequest = &raft_cmdpb.AdminRequest{
+		CmdType: raft_cmdpb.AdminCmdType_CommitMerge,
+		CommitMerge: &raft_cmdpb.CommitMergeRequest{
+			Source: d.region(),
+			Commit: state.Commit,
+		},
+	}
+	sourceID, router := d.regionID(), d.ctx.router
+	cb := message.NewNotifyCallback(func(resp *raft_cmdpb.RaftCmdResponse) {
+		if resp.GetHeader().GetError() != nil {
+			router.send(sourceID, message.NewPeerMsg(message.MsgTypeCommitMergeResp, sourceID, resp))
+		}
+	})
+	if err := router.sendRaftCommand(&message.MsgRaftCmd{Request: req, Callback: cb}); err != nil {
+		log.Warnf("%s merge target %d is not on the store", d.tag(), target.Id)
+	}
+}
+
+// onCommitMergeResp handles the error the CommitMerge proposed by the source leader failed with.
+func (d *peerMsgHandler) onCommitMergeResp(resp *raft_cmdpb.RaftCmdResponse) {
+	state := d.peer.pendingMergeState
+	if d.stopped || state == nil || !d.peer.IsLeader() {
+		return
+	}
+	respErr := resp.GetHeader().GetError()
+	if notLeader := respErr.GetNotLeader(); notLeader != nil {
+		// Take the leadership of the source to the store of the target leader, to propose CommitMerge there.
+		leader := notLeader.GetLeader()
+		if leader == nil {
+			return
+		}
+		if peer := findPeer(d.region(), leader.StoreId); peer != nil && peer.Id != d.peerID() &&
+			d.peer.readyToTransferLeader(d.ctx.cfg, peer) {
+			log.Infof("%s transfer leader to %s to commit merge", d.tag(), peer)
+			d.peer.preTransferLeader(peer)
+			d.hasReady = true
+		}
+		return
+	}
+	epochNotMatch := respErr.GetEpochNotMatch()
+	if epochNotMatch == nil {
+		return
+	}
+	// The target is rejected by the epoch check when proposing, the current target is attached then. Only a target
+	// which doesn't cover the source has changed since the PrepareMerge, rather than merged the source.
+	var current *metapb.Region
+	for _, region := range epochNotMatch.CurrentRegions {
+		if region.Id == state.Target.Id {
+			current = region
+		}
+	}
+	if current == nil || regionCovers(current, d.region()) {
+		return
+	}
+	// The target peer on the store marks the source destroyed when it applies the CommitMerge.
+	if localState, err := getRegionLocalState(d.ctx.engine.Kv, d.regionID()); err != nil ||
+		localState.State != rspb.PeerState_Merging {
+		return
+	}
+	log.Infof("%s merge target changed to %s, roll back the merge", d.tag(), current)
+	req := newAdminRequest(d.regionID(), d.peer.Meta)
+	req.Header.RegionEpoch = d.region().RegionEpoch
+	req.AdminRequest = &raft_cmdpb.AdminRequest{
+		CmdType:       raft_cmdpb.AdminCmdType_RollbackMerge,
+		RollbackMerge: &raft_cmdpb.RollbackMergeRequest{Commit: state.Commit},
+	}
+	d.proposeRaftCommand(req, message.NewCallback())
+}
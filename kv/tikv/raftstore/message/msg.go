@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	"github.com/coocood/badger"
+	"github.com/pingcap-incubator/tinykv/kv/util/memory"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
 )
@@ -22,6 +23,8 @@ const (
 	MsgTypeStart                 MsgType = 14
 	MsgTypeApplyRes              MsgType = 15
 	MsgTypeNoop                  MsgType = 16
+	MsgTypeCommitMergeResp       MsgType = 17
+	MsgTypeMergeResult           MsgType = 18
 
 	MsgTypeStoreRaftMessage MsgType = 101
 	MsgTypeStoreTick        MsgType = 106
@@ -34,6 +37,8 @@ const (
 	MsgTypeApplyRegistration MsgType = 302
 	MsgTypeApplyProposal     MsgType = 303
 	MsgTypeApplyDestroy      MsgType = 306
+	MsgTypeApplyPause        MsgType = 307
+	MsgTypeApplyResume       MsgType = 308
 
 	msgDefaultChanSize = 1024
 )
@@ -61,22 +66,51 @@ type Callback struct {
 	Resp       *raft_cmdpb.RaftCmdResponse
 	RegionSnap RegionSnapshot // used for GetSnap
 	Wg         sync.WaitGroup
+
+	pendingBytes int64 // charged to the pending proposals of the store memory budget until done
+	notify       func(resp *raft_cmdpb.RaftCmdResponse)
 }
 
 type RegionSnapshot struct {
 	Region metapb.Region
 	Txn    *badger.Txn
+	// The applied index of the region the snapshot is taken at.
+	Index uint64
 }
 
 func (cb *Callback) Done(resp *raft_cmdpb.RaftCmdResponse) {
 	if cb != nil {
+		if cb.pendingBytes != 0 {
+			memory.StoreBudget.Release(memory.PendingProposals, cb.pendingBytes)
+			cb.pendingBytes = 0
+		}
 		cb.Resp = resp
+		if cb.notify != nil {
+			cb.notify(resp)
+		}
 		cb.Wg.Done()
 	}
 }
 
+// ChargeMemory charges the size of a proposed request to the store memory budget, it is released when the callback
+// is done.
+func (cb *Callback) ChargeMemory(size int64) {
+	if cb != nil {
+		memory.StoreBudget.Consume(memory.PendingProposals, size)
+		cb.pendingBytes += size
+	}
+}
+
 func NewCallback() *Callback {
 	cb := &Callback{}
 	cb.Wg.Add(1)
 	return cb
 }
+
+// NewNotifyCallback creates a callback which calls notify with the response when it's done, for the callers that
+// don't wait for it.
+func NewNotifyCallback(notify func(resp *raft_cmdpb.RaftCmdResponse)) *Callback {
+	cb := NewCallback()
+	cb.notify = notify
+	return cb
+}
@@ -0,0 +1,106 @@
+package raftstore
+
+import (
+	"sort"
+
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/message"
+)
+
+// msgPriority is the priority class of a message sent to a raft worker or the store worker. The queued messages of a
+// higher class are handled first, so that the raft messages which elections and replication wait for are not delayed
+// by the housekeeping tasks under load.
+type msgPriority int
+
+const (
+	// msgPriorityRaft is for the raft messages from other stores and the reports about sending them.
+	msgPriorityRaft msgPriority = iota
+	// msgPriorityNormal is for the proposals, ticks and apply results.
+	msgPriorityNormal
+	// msgPriorityHousekeeping is for split checks, size updates, snapshot gc and store ticks.
+	msgPriorityHousekeeping
+
+	msgPriorityCount
+)
+
+// maxHousekeepingMsgsPerFetch limits the housekeeping messages handled in a loop of a worker, the rest are left in the
+// queue for the next loop, so a burst of them doesn't hold back the raft messages that arrive meanwhile.
+const maxHousekeepingMsgsPerFetch = 64
+
+func priorityOf(msg message.Msg) msgPriority {
+	switch msg.Type {
+	case message.MsgTypeRaftMessage, message.MsgTypeSignificantMsg, message.MsgTypeStart,
+		message.MsgTypeStoreRaftMessage, message.MsgTypeStoreStart:
+		return msgPriorityRaft
+	case message.MsgTypeSplitRegion, message.MsgTypeRegionApproximateSize, message.MsgTypeGcSnap,
+		message.MsgTypeStoreTick:
+		return msgPriorityHousekeeping
+	default:
+		return msgPriorityNormal
+	}
+}
+
+// msgQueue is a message queue with a channel for each priority class.
+type msgQueue [msgPriorityCount]chan message.Msg
+
+func newMsgQueue(capacity int) *msgQueue {
+	q := new(msgQueue)
+	for i := range q {
+		q[i] = make(chan message.Msg, capacity)
+	}
+	return q
+}
+
+func (q *msgQueue) send(msg message.Msg) {
+	q[priorityOf(msg)] <- msg
+}
+
+// trySend queues the message unless the channel of its class is full, it returns whether the message is queued.
+func (q *msgQueue) trySend(msg message.Msg) bool {
+	select {
+	case q[priorityOf(msg)] <- msg:
+		return true
+	default:
+		return false
+	}
+}
+
+// len returns the number of the queued messages.
+func (q *msgQueue) len() int {
+	n := 0
+	for _, ch := range q {
+		n += len(ch)
+	}
+	return n
+}
+
+// wait blocks until a message of any class is queued and returns it, it returns false if closeCh is closed.
+func (q *msgQueue) wait(closeCh <-chan struct{}) (message.Msg, bool) {
+	select {
+	case <-closeCh:
+		return message.Msg{}, false
+	case msg := <-q[msgPriorityRaft]:
+		return msg, true
+	case msg := <-q[msgPriorityNormal]:
+		return msg, true
+	case msg := <-q[msgPriorityHousekeeping]:
+		return msg, true
+	}
+}
+
+// fetch appends the queued messages to msgs and sorts them by priority class, the messages of the same class keep
+// their order.
+func (q *msgQueue) fetch(msgs []message.Msg) []message.Msg {
+	for p, ch := range q {
+		pending := len(ch)
+		if msgPriority(p) == msgPriorityHousekeeping && pending > maxHousekeepingMsgsPerFetch {
+			pending = maxHousekeepingMsgsPerFetch
+		}
+		for i := 0; i < pending; i++ {
+			msgs = append(msgs, <-ch)
+		}
+	}
+	sort.SliceStable(msgs, func(i, j int) bool {
+		return priorityOf(msgs[i]) < priorityOf(msgs[j])
+	})
+	return msgs
+}
@@ -0,0 +1,55 @@
+package raftstore
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgQueueFetchByPriority(t *testing.T) {
+	q := newMsgQueue(16)
+	q.send(message.NewPeerMsg(message.MsgTypeGcSnap, 1, nil))
+	q.send(message.NewPeerMsg(message.MsgTypeTick, 1, nil))
+	q.send(message.NewPeerMsg(message.MsgTypeRaftMessage, 1, nil))
+	q.send(message.NewPeerMsg(message.MsgTypeSplitRegion, 2, nil))
+	q.send(message.NewPeerMsg(message.MsgTypeRaftCmd, 2, nil))
+	q.send(message.NewPeerMsg(message.MsgTypeRaftMessage, 2, nil))
+
+	msg, ok := q.wait(nil)
+	require.True(t, ok)
+	msgs := q.fetch([]message.Msg{msg})
+	var got []message.MsgType
+	var regions []uint64
+	for _, m := range msgs {
+		got = append(got, m.Type)
+		regions = append(regions, m.RegionID)
+	}
+	assert.Equal(t, []message.MsgType{
+		message.MsgTypeRaftMessage, message.MsgTypeRaftMessage,
+		message.MsgTypeTick, message.MsgTypeRaftCmd,
+		message.MsgTypeGcSnap, message.MsgTypeSplitRegion,
+	}, got)
+	// The messages of the same class keep their order.
+	assert.Equal(t, []uint64{1, 2, 1, 2, 1, 2}, regions)
+
+	closeCh := make(chan struct{})
+	close(closeCh)
+	_, ok = q.wait(closeCh)
+	assert.False(t, ok)
+}
+
+func TestMsgQueueLimitHousekeeping(t *testing.T) {
+	q := newMsgQueue(maxHousekeepingMsgsPerFetch * 2)
+	for i := 0; i < maxHousekeepingMsgsPerFetch*2; i++ {
+		q.send(message.NewPeerMsg(message.MsgTypeRegionApproximateSize, 1, nil))
+	}
+	q.send(message.NewPeerMsg(message.MsgTypeRaftMessage, 1, nil))
+
+	msgs := q.fetch(nil)
+	assert.Len(t, msgs, maxHousekeepingMsgsPerFetch+1)
+	assert.Equal(t, message.MsgTypeRaftMessage, msgs[0].Type)
+	msgs = q.fetch(nil)
+	assert.Len(t, msgs, maxHousekeepingMsgsPerFetch)
+}
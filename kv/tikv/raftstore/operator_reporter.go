@@ -0,0 +1,115 @@
+package raftstore
+
+import (
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+)
+
+// maxOperatorResults is the max number of results kept for the next store heartbeat, and of the steps remembered
+// to report only the first result of each.
+const maxOperatorResults = 1024
+
+// operatorStep identifies a step of an operator of the scheduler.
+type operatorStep struct {
+	operatorID uint64
+	step       uint32
+}
+
+// operatorReporter collects the results of the commands the scheduler sends for its operators, they are reported in
+// the next store heartbeat. Not every step is done when its command succeeds, e.g. a transfer leader step is done once
+// the new leader is elected, so the scheduler waits for the region heartbeat to show those, but a failure still
+// cancels the operator at once.
+//
+// The scheduler resends the command of a step on every region heartbeat until it sees the step finished, and the
+// extra commands usually fail because the step has been done, so only the first result of a step is reported.
+// Errors that go away by retrying, like not leader or epoch not match, aren't reported at all, the scheduler
+// retries the step or cancels the stale operator by itself.
+type operatorReporter struct {
+	mu       sync.Mutex
+	results  []*pdpb.OperatorResult
+	reported map[operatorStep]struct{}
+	order    []operatorStep
+}
+
+func newOperatorReporter() *operatorReporter {
+	return &operatorReporter{
+		reported: make(map[operatorStep]struct{}),
+	}
+}
+
+// callback returns the callback of the command sent for the heartbeat response.
+func (r *operatorReporter) callback(resp *pdpb.RegionHeartbeatResponse) *message.Callback {
+	if resp.OperatorId == 0 {
+		return message.NewCallback()
+	}
+	regionID := resp.RegionId
+	step := operatorStep{operatorID: resp.OperatorId, step: resp.OperatorStep}
+	return message.NewNotifyCallback(func(cmdResp *raft_cmdpb.RaftCmdResponse) {
+		r.report(regionID, step, cmdResp)
+	})
+}
+
+func (r *operatorReporter) report(regionID uint64, step operatorStep, resp *raft_cmdpb.RaftCmdResponse) {
+	result := &pdpb.OperatorResult{
+		RegionId:     regionID,
+		OperatorId:   step.operatorID,
+		OperatorStep: step.step,
+		Success:      true,
+	}
+	if err := resp.GetHeader().GetError(); err != nil {
+		if isRetryableError(err) {
+			return
+		}
+		result.Success = false
+		result.Error = err.GetMessage()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.reported[step]; ok {
+		return
+	}
+	r.reported[step] = struct{}{}
+	r.order = append(r.order, step)
+	if len(r.order) > maxOperatorResults {
+		delete(r.reported, r.order[0])
+		r.order = r.order[1:]
+	}
+	r.appendResults(result)
+}
+
+// take returns the results collected since the last call.
+func (r *operatorReporter) take() []*pdpb.OperatorResult {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	results := r.results
+	r.results = nil
+	return results
+}
+
+// putBack keeps the results failed to be reported for the next store heartbeat.
+func (r *operatorReporter) putBack(results []*pdpb.OperatorResult) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	pending := r.results
+	r.results = nil
+	r.appendResults(results...)
+	r.appendResults(pending...)
+}
+
+func (r *operatorReporter) appendResults(results ...*pdpb.OperatorResult) {
+	r.results = append(r.results, results...)
+	if n := len(r.results) - maxOperatorResults; n > 0 {
+		// The scheduler is unreachable for a long time, the operators of the oldest results have timed out anyway.
+		r.results = r.results[n:]
+	}
+}
+
+func isRetryableError(err *errorpb.Error) bool {
+	return err.NotLeader != nil || err.RegionNotFound != nil || err.EpochNotMatch != nil ||
+		err.ServerIsBusy != nil || err.StaleCommand != nil
+}
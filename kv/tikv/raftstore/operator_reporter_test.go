@@ -0,0 +1,52 @@
+package raftstore
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperatorReporter(t *testing.T) {
+	r := newOperatorReporter()
+	resp := &pdpb.RegionHeartbeatResponse{RegionId: 1, OperatorId: 10, OperatorStep: 1}
+
+	// Commands not sent for an operator aren't reported.
+	r.callback(&pdpb.RegionHeartbeatResponse{RegionId: 1}).Done(&raft_cmdpb.RaftCmdResponse{})
+	assert.Len(t, r.take(), 0)
+
+	// Retryable errors aren't reported.
+	r.callback(resp).Done(ErrResp(&ErrNotLeader{RegionId: 1}))
+	assert.Len(t, r.take(), 0)
+
+	// Only the first result of a step is reported.
+	r.callback(resp).Done(ErrResp(&ErrStaleCommand{}))
+	r.callback(resp).Done(&raft_cmdpb.RaftCmdResponse{Header: &raft_cmdpb.RaftResponseHeader{}})
+	r.callback(resp).Done(&raft_cmdpb.RaftCmdResponse{Header: &raft_cmdpb.RaftResponseHeader{
+		Error: &errorpb.Error{Message: "duplicated peer"},
+	}})
+	results := r.take()
+	assert.Len(t, results, 1)
+	assert.Equal(t, uint64(1), results[0].RegionId)
+	assert.Equal(t, uint64(10), results[0].OperatorId)
+	assert.Equal(t, uint32(1), results[0].OperatorStep)
+	assert.True(t, results[0].Success)
+	assert.Len(t, r.take(), 0)
+
+	r.callback(&pdpb.RegionHeartbeatResponse{RegionId: 2, OperatorId: 11}).Done(ErrResp(errors.New("key not in region")))
+	failed := r.take()
+	assert.Len(t, failed, 1)
+	assert.False(t, failed[0].Success)
+	assert.NotEmpty(t, failed[0].Error)
+
+	// Results put back are reported before the new ones.
+	r.callback(&pdpb.RegionHeartbeatResponse{RegionId: 3, OperatorId: 12}).Done(&raft_cmdpb.RaftCmdResponse{})
+	r.putBack(failed)
+	results = r.take()
+	assert.Len(t, results, 2)
+	assert.Equal(t, uint64(11), results[0].OperatorId)
+	assert.Equal(t, uint64(12), results[1].OperatorId)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/kv/pd"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/message"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/worker"
+	"github.com/pingcap-incubator/tinykv/kv/util/resource"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
@@ -14,16 +15,23 @@ import (
 )
 
 type pdTaskHandler struct {
-	storeID  uint64
-	pdClient pd.Client
-	router   message.RaftRouter
+	storeID   uint64
+	pdClient  pd.Client
+	router    message.RaftRouter
+	operators *operatorReporter
+	readOnly  *readOnlyState
+	busy      *storeBusy
 }
 
-func newPDTaskHandler(storeID uint64, pdClient pd.Client, router message.RaftRouter) *pdTaskHandler {
+func newPDTaskHandler(storeID uint64, pdClient pd.Client, router message.RaftRouter, readOnly *readOnlyState,
+	busy *storeBusy) *pdTaskHandler {
 	return &pdTaskHandler{
-		storeID:  storeID,
-		pdClient: pdClient,
-		router:   router,
+		storeID:   storeID,
+		pdClient:  pdClient,
+		router:    router,
+		operators: newOperatorReporter(),
+		readOnly:  readOnly,
+		busy:      busy,
 	}
 }
 
@@ -50,15 +58,37 @@ func (r *pdTaskHandler) onRegionHeartbeatResponse(resp *pdpb.RegionHeartbeatResp
 			ChangePeer: &raft_cmdpb.ChangePeerRequest{
 				ChangeType: changePeer.ChangeType,
 				Peer:       changePeer.Peer,
+				Repair:     changePeer.Repair,
 			},
-		}, message.NewCallback())
+		}, r.operators.callback(resp))
 	} else if transferLeader := resp.GetTransferLeader(); transferLeader != nil {
 		r.sendAdminRequest(resp.RegionId, resp.RegionEpoch, resp.TargetPeer, &raft_cmdpb.AdminRequest{
 			CmdType: raft_cmdpb.AdminCmdType_TransferLeader,
 			TransferLeader: &raft_cmdpb.TransferLeaderRequest{
 				Peer: transferLeader.Peer,
 			},
-		}, message.NewCallback())
+		}, r.operators.callback(resp))
+	} else if merge := resp.GetMerge(); merge != nil {
+		r.sendAdminRequest(resp.RegionId, resp.RegionEpoch, resp.TargetPeer, &raft_cmdpb.AdminRequest{
+			CmdType: raft_cmdpb.AdminCmdType_PrepareMerge,
+			PrepareMerge: &raft_cmdpb.PrepareMergeRequest{
+				Target: merge.Target,
+			},
+		}, r.operators.callback(resp))
+	} else if splitRegion := resp.GetSplitRegion(); splitRegion != nil {
+		if splitRegion.Policy != pdpb.CheckPolicy_USEKEY {
+			log.Warnf("unsupported split policy %v, [regionId: %d]", splitRegion.Policy, resp.RegionId)
+			return
+		}
+		r.router.Send(resp.RegionId, message.Msg{
+			Type:     message.MsgTypeSplitRegion,
+			RegionID: resp.RegionId,
+			Data: &MsgSplitRegion{
+				RegionEpoch: resp.RegionEpoch,
+				SplitKeys:   splitRegion.Keys,
+				Callback:    r.operators.callback(resp),
+			},
+		})
 	}
 }
 
@@ -66,6 +96,7 @@ func (r *pdTaskHandler) onAskBatchSplit(t *pdAskBatchSplitTask) {
 	resp, err := r.pdClient.AskBatchSplit(context.TODO(), t.region, len(t.splitKeys))
 	if err != nil {
 		log.Error(err)
+		t.callback.Done(ErrResp(err))
 		return
 	}
 	srs := make([]*raft_cmdpb.SplitRequest, len(resp.Ids))
@@ -79,24 +110,23 @@ func (r *pdTaskHandler) onAskBatchSplit(t *pdAskBatchSplitTask) {
 	aq := &raft_cmdpb.AdminRequest{
 		CmdType: raft_cmdpb.AdminCmdType_BatchSplit,
 		Splits: &raft_cmdpb.BatchSplitRequest{
-			Requests: srs,
+			Requests:   srs,
+			LeftDerive: t.leftDerive,
 		},
 	}
 	r.sendAdminRequest(t.region.GetId(), t.region.GetRegionEpoch(), t.peer, aq, t.callback)
 }
 
 func (r *pdTaskHandler) onHeartbeat(t *pdRegionHeartbeatTask) {
-	var size int64
-	if t.approximateSize != nil {
-		size = int64(*t.approximateSize)
-	}
-
 	req := &pdpb.RegionHeartbeatRequest{
-		Region:          t.region,
-		Leader:          t.peer,
-		DownPeers:       t.downPeers,
-		PendingPeers:    t.pendingPeers,
-		ApproximateSize: uint64(size),
+		Region:       t.region,
+		Leader:       t.peer,
+		DownPeers:    t.downPeers,
+		PendingPeers: t.pendingPeers,
+	}
+	if t.stats != nil {
+		req.ApproximateSize = uint64(t.stats.size)
+		req.ApproximateKeys = uint64(t.stats.keys)
 	}
 	r.pdClient.RegionHeartbeat(req)
 }
@@ -122,8 +152,24 @@ func (r *pdTaskHandler) onStoreHeartbeat(t *pdStoreHeartbeatTask) {
 	t.stats.Capacity = capacity
 	t.stats.UsedSize = usedSize
 	t.stats.Available = available
+	// The capacity may be configured smaller than the disk, and the disk may be shared, so both are checked.
+	free := diskStat.Free
+	if available < free {
+		free = available
+	}
+	if r.busy.checkDiskFull(free) {
+		t.stats.IsBusy = true
+	}
 
-	r.pdClient.StoreHeartbeat(context.TODO(), t.stats)
+	results := r.operators.take()
+	resp, err := r.pdClient.StoreHeartbeat(context.TODO(), t.stats, results)
+	if err != nil {
+		log.Warnf("store heartbeat failed, [storeId: %d, err: %v]", r.storeID, err)
+		r.operators.putBack(results)
+		return
+	}
+	r.readOnly.update(resp)
+	resource.StoreGroups.Update(resp.ResourceGroups)
 }
 
 func (r *pdTaskHandler) sendAdminRequest(regionID uint64, epoch *metapb.RegionEpoch, peer *metapb.Peer, req *raft_cmdpb.AdminRequest, callback *message.Callback) {
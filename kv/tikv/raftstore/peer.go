@@ -1,6 +1,8 @@
 package raftstore
 
 import (
+	"crypto/rand"
+	"encoding/binary"
 	"fmt"
 	"math"
 	"time"
@@ -99,10 +101,20 @@ type Peer struct {
 	/// Remove them after they are not pending any more.
 	PeersStartPendingTime map[uint64]time.Time
 
+	/// Record the pending peers added to repair an under-replicated region, the snapshots for them go before
+	/// the ones for rebalancing.
+	RepairPeers map[uint64]struct{}
+
+	/// Record the instants the backoff of the peers on busy stores ends, the leader slows down replicating
+	/// to them meanwhile.
+	busyPeers map[uint64]time.Time
+
 	/// an inaccurate difference in region size since last reset.
 	SizeDiffHint uint64
-	/// approximate size of the region.
-	ApproximateSize *uint64
+	/// approximate statistics of the region, nil until a scan reconciles them.
+	stats *regionStats
+	/// the instant the statistics were last reconciled.
+	statsReconciledAt time.Time
 
 	Tag string
 
@@ -113,21 +125,53 @@ type Peer struct {
 	lastCommittedSplitIdx uint64
 	// Approximate size of logs that is applied but not compacted yet.
 	RaftLogSizeHint uint64
+	// The compact index of the last CompactLog the leader proposed and the term it was proposed in.
+	proposedCompactIdx  uint64
+	proposedCompactTerm uint64
 
 	PendingRemove bool
 
-	// If a snapshot is being applied asynchronously, messages should not be sent.
+	// Messages to send at the next ready. If a snapshot is being applied asynchronously, messages should not be
+	// sent, they are held here until it's applied.
 	pendingMessages []eraftpb.Message
+
+	// The commit index of the leader which asked the peer to take the leadership, in the term it asked. The peer asks
+	// for the leadership once it has applied up to it. See preTransferLeader.
+	pendingTransferLeaderIdx  uint64
+	pendingTransferLeaderTerm uint64
+
+	// See config.AppliedCmdWindow.
+	appliedCmdWindow uint64
+
+	// Leaders recently observed on this store, shared by all the peers.
+	leaderCache *leaderCache
+
+	// The largest region epoch carried by the messages the peer received, it's recorded in the tombstone when the
+	// peer is destroyed, so that a stale peer which only knows an older epoch can't recreate it.
+	maxSeenEpoch *metapb.RegionEpoch
+
+	// The quorum reads waiting for the peer to apply up to their read indexes.
+	pendingQuorumReads []*pendingRead
+
+	// The reads on the leader waiting for the next read index round, and the rounds issued and not served yet, the
+	// oldest first. See read_index.go.
+	readIndexBatch  []*pendingRead
+	readIndexRounds []*readIndexRound
+	// Sequence used to tag the read index rounds.
+	readIndexSeq uint64
+
+	// Set while the region is merging into its target, see merge.go.
+	pendingMergeState *rspb.MergeState
 }
 
-func NewPeer(storeId uint64, cfg *config.Config, engines *engine_util.Engines, region *metapb.Region, regionSched chan<- worker.Task,
-	peer *metapb.Peer) (*Peer, error) {
+func NewPeer(storeId uint64, cfg *config.Config, engines *engine_util.Engines, region *metapb.Region,
+	regionSched, snapGenSched chan<- worker.Task, peer *metapb.Peer) (*Peer, error) {
 	if peer.GetId() == InvalidID {
 		return nil, fmt.Errorf("invalid peer id")
 	}
 	tag := fmt.Sprintf("[region %v] %v", region.GetId(), peer.GetId())
 
-	ps, err := NewPeerStorage(engines, region, regionSched, peer.GetId(), tag)
+	ps, err := NewPeerStorage(engines, region, regionSched, snapGenSched, peer.GetId(), tag)
 	if err != nil {
 		return nil, err
 	}
@@ -142,6 +186,10 @@ func NewPeer(storeId uint64, cfg *config.Config, engines *engine_util.Engines, r
 		MaxInflightMsgs: cfg.RaftMaxInflightMsgs,
 		Applied:         appliedIndex,
 		Storage:         ps,
+
+		DisableProposalForwarding: !cfg.ForwardProposalToLeader,
+		LeaderStickiness:          cfg.RaftLeaderStickiness,
+		CampaignHoldTicks:         cfg.RaftCampaignHoldTicks,
 	}
 
 	raftGroup, err := raft.NewRawNode(raftCfg, nil)
@@ -156,8 +204,11 @@ func NewPeer(storeId uint64, cfg *config.Config, engines *engine_util.Engines, r
 		peerCache:             make(map[uint64]*metapb.Peer),
 		PeerHeartbeats:        make(map[uint64]time.Time),
 		PeersStartPendingTime: make(map[uint64]time.Time),
+		RepairPeers:           make(map[uint64]struct{}),
+		busyPeers:             make(map[uint64]time.Time),
 		Tag:                   tag,
 		LastApplyingIdx:       appliedIndex,
+		appliedCmdWindow:      cfg.AppliedCmdWindow,
 	}
 
 	// If this region has only one peer and I am the one, campaign directly.
@@ -238,6 +289,7 @@ func (p *Peer) Destroy(engine *engine_util.Engines, keepData bool) error {
 	start := time.Now()
 	region := p.Region()
 	log.Infof("%v begin to destroy", p.Tag)
+	p.Store().CancelGeneratingSnap()
 
 	// Set Tombstone state explicitly
 	kvWB := new(engine_util.WriteBatch)
@@ -245,7 +297,7 @@ func (p *Peer) Destroy(engine *engine_util.Engines, keepData bool) error {
 	if err := p.Store().clearMeta(kvWB, raftWB); err != nil {
 		return err
 	}
-	WritePeerState(kvWB, region, rspb.PeerState_Tombstone)
+	writeTombstoneState(kvWB, region, p.maxSeenEpoch)
 	// write kv rocksdb first in case of restart happen between two write
 	// Todo: sync = ctx.cfg.sync_log
 	if err := kvWB.WriteToDB(engine.Kv); err != nil {
@@ -267,11 +319,30 @@ func (p *Peer) Destroy(engine *engine_util.Engines, keepData bool) error {
 		NotifyReqRegionRemoved(region.Id, proposal.cb)
 	}
 	p.applyProposals = nil
+	for _, read := range p.pendingQuorumReads {
+		NotifyReqRegionRemoved(region.Id, read.cb)
+	}
+	p.pendingQuorumReads = nil
+	for _, read := range p.readIndexBatch {
+		NotifyReqRegionRemoved(region.Id, read.cb)
+	}
+	p.readIndexBatch = nil
+	for _, round := range p.readIndexRounds {
+		for _, read := range round.reads {
+			NotifyReqRegionRemoved(region.Id, read.cb)
+		}
+	}
+	p.readIndexRounds = nil
+	p.Store().cache.clear()
 
 	log.Infof("%v destroy itself, takes %v", p.Tag, time.Now().Sub(start))
 	return nil
 }
 
+func (p *Peer) observeEpoch(epoch *metapb.RegionEpoch) {
+	p.maxSeenEpoch = mergeEpoch(p.maxSeenEpoch, epoch)
+}
+
 func (p *Peer) isInitialized() bool {
 	return p.peerStorage.isInitialized()
 }
@@ -300,6 +371,31 @@ func (p *Peer) LeaderId() uint64 {
 	return p.RaftGroup.Raft.Lead
 }
 
+// leaderHint returns the best known leader of the region. If raft doesn't know
+// the leader, the one recently observed on this store is returned.
+func (p *Peer) leaderHint() *metapb.Peer {
+	if leader := p.getPeerFromCache(p.LeaderId()); leader != nil {
+		return leader
+	}
+	if p.leaderCache == nil {
+		return nil
+	}
+	leader := p.leaderCache.get(p.regionId)
+	if leader == nil || leader.GetId() == p.PeerId() {
+		return nil
+	}
+	// The leader may have been removed from the region since it was observed.
+	return p.getPeerFromCache(leader.GetId())
+}
+
+func (p *Peer) notLeaderErr() *ErrNotLeader {
+	return &ErrNotLeader{
+		RegionId:    p.regionId,
+		Leader:      p.leaderHint(),
+		RegionEpoch: p.Region().GetRegionEpoch(),
+	}
+}
+
 func (p *Peer) IsLeader() bool {
 	return p.RaftGroup.Raft.State == raft.StateLeader
 }
@@ -360,6 +456,33 @@ func (p *Peer) CheckPeers() {
 	}
 }
 
+/// Records the backoff suggested by a peer whose store is busy, the leader keeps at most one append in
+/// flight to the peer until the backoff ends.
+func (p *Peer) observePeerBusy(peerID uint64, backoff time.Duration) {
+	if backoff == 0 || !p.IsLeader() {
+		return
+	}
+	if _, ok := p.busyPeers[peerID]; !ok {
+		log.Infof("%v store of peer %d is busy, slow down replicating to it for %v", p.Tag, peerID, backoff)
+	}
+	p.busyPeers[peerID] = time.Now().Add(backoff)
+	p.RaftGroup.ReportBusy(peerID, true)
+}
+
+/// Resumes replicating to the peers whose backoff has ended.
+func (p *Peer) checkBusyPeers() {
+	if len(p.busyPeers) == 0 {
+		return
+	}
+	now := time.Now()
+	for peerID, until := range p.busyPeers {
+		if now.After(until) || !p.IsLeader() {
+			delete(p.busyPeers, peerID)
+			p.RaftGroup.ReportBusy(peerID, false)
+		}
+	}
+}
+
 /// Collects all down peers.
 func (p *Peer) CollectDownPeers(maxDuration time.Duration) []*pdpb.PeerStats {
 	downPeers := make([]*pdpb.PeerStats, 0)
@@ -409,6 +532,21 @@ func (p *Peer) clearPeersStartPendingTime() {
 	for id := range p.PeersStartPendingTime {
 		delete(p.PeersStartPendingTime, id)
 	}
+	for id := range p.RepairPeers {
+		p.removeRepairPeer(id)
+	}
+}
+
+func (p *Peer) addRepairPeer(peerID uint64) {
+	p.RepairPeers[peerID] = struct{}{}
+	p.Store().snapPriority = worker.TaskPriorityHigh
+}
+
+func (p *Peer) removeRepairPeer(peerID uint64) {
+	delete(p.RepairPeers, peerID)
+	if len(p.RepairPeers) == 0 {
+		p.Store().snapPriority = worker.TaskPriorityNormal
+	}
 }
 
 /// Returns `true` if any new peer catches up with the leader in replicating logs.
@@ -427,6 +565,7 @@ func (p *Peer) AnyNewPeerCatchUp(peerId uint64) bool {
 		if ok {
 			if progress.Match >= truncatedIdx {
 				delete(p.PeersStartPendingTime, peerId)
+				p.removeRepairPeer(peerId)
 				elapsed := time.Since(startPendingTime)
 				log.Debugf("%v peer %v has caught up logs, elapsed: %v", p.Tag, peerId, elapsed)
 				return true
@@ -557,9 +696,16 @@ func (p *Peer) Term() uint64 {
 
 func (p *Peer) Stop() {
 	p.Store().CancelApplyingSnap()
+	p.Store().CancelGeneratingSnap()
 }
 
 func (p *Peer) HeartbeatPd(pdScheduler chan<- worker.Task) {
+	// The statistics keep changing as the peer applies, the pd worker gets a copy.
+	var stats *regionStats
+	if p.stats != nil {
+		copied := *p.stats
+		stats = &copied
+	}
 	pdScheduler <- worker.Task{
 		Tp: worker.TaskTypePDHeartbeat,
 		Data: &pdRegionHeartbeatTask{
@@ -567,7 +713,7 @@ func (p *Peer) HeartbeatPd(pdScheduler chan<- worker.Task) {
 			peer:            p.Meta,
 			downPeers:       p.CollectDownPeers(time.Minute * 5),
 			pendingPeers:    p.CollectPendingPeers(),
-			approximateSize: p.ApproximateSize,
+			stats:           stats,
 		},
 	}
 }
@@ -590,6 +736,9 @@ func (p *Peer) sendRaftMessage(msg eraftpb.Message, trans Transport) error {
 
 	sendMsg.FromPeer = &fromPeer
 	sendMsg.ToPeer = toPeer
+	if msg.MsgType == eraftpb.MessageType_MsgSnapshot {
+		_, sendMsg.Repair = p.RepairPeers[msg.To]
+	}
 
 	// There could be two cases:
 	// 1. Target peer already exists but has not established communication with leader yet
@@ -655,7 +804,7 @@ func (p *Peer) HandleRaftReadyApply(kv *badger.DB, applyMsgs *applyMsgs, ready *
 		}
 	}
 
-	p.RaftGroup.Advance(*ready)
+	p.RaftGroup.AdvanceAppend(*ready)
 	if p.IsApplyingSnapshot() {
 		// Because we only handle raft ready when not applying snapshot, so following
 		// line won't be called twice for the same snapshot.
@@ -663,7 +812,8 @@ func (p *Peer) HandleRaftReadyApply(kv *badger.DB, applyMsgs *applyMsgs, ready *
 	}
 }
 
-func (p *Peer) PostApply(kv *badger.DB, applyState applyState, appliedIndexTerm uint64, sizeDiffHint uint64) bool {
+func (p *Peer) PostApply(kv *badger.DB, applyState applyState, appliedIndexTerm uint64, sizeDiffHint uint64,
+	statsDelta regionStats) bool {
 	hasReady := false
 	if p.IsApplyingSnapshot() {
 		panic("should not applying snapshot")
@@ -679,10 +829,16 @@ func (p *Peer) PostApply(kv *badger.DB, applyState applyState, appliedIndexTerm
 	} else {
 		p.SizeDiffHint = 0
 	}
+	if p.stats != nil {
+		p.stats.add(statsDelta)
+	}
 
 	if p.HasPendingSnapshot() && p.ReadyToHandlePendingSnap() {
 		hasReady = true
 	}
+	if p.maybeTakeLeadership() {
+		hasReady = true
+	}
 
 	return hasReady
 }
@@ -708,9 +864,17 @@ func (p *Peer) Propose(kv *badger.DB, cfg *config.Config, cb *message.Callback,
 		cb.Done(errResp)
 		return false
 	}
+	if isExpiredRead(req, time.Now()) {
+		BindRespError(errResp, &ErrDeadlineExceeded{RegionId: p.regionId})
+		cb.Done(errResp)
+		return false
+	}
 	var idx uint64
 	switch policy {
 	case RequestPolicy_ProposeNormal:
+		if !p.IsLeader() {
+			return p.ProposeForward(cfg, req, cb, errResp)
+		}
 		idx, err = p.ProposeNormal(cfg, req)
 	case RequestPolicy_ProposeTransferLeader:
 		return p.ProposeTransferLeader(cfg, req, cb)
@@ -725,6 +889,7 @@ func (p *Peer) Propose(kv *badger.DB, cfg *config.Config, cb *message.Callback,
 		return false
 	}
 
+	cb.ChargeMemory(int64(req.Size()))
 	p.PostPropose(idx, p.Term(), isConfChange, cb)
 	return true
 }
@@ -814,10 +979,52 @@ func Quorum(total int) int {
 	return total/2 + 1
 }
 
-func (p *Peer) transferLeader(peer *metapb.Peer) {
-	log.Infof("%v transfer leader to %v", p.Tag, peer)
+// preTransferLeader asks the transferee to take the leadership once it has applied the entries committed so far,
+// instead of transferring it right away. A transferee with a backlog to apply would serve its first reads as the
+// leader only after applying it, which shows as a read latency spike after every transfer. The transferee replies
+// with a MsgTransferLeader forwarded to the leader, which then transfers the leadership to it as usual.
+func (p *Peer) preTransferLeader(peer *metapb.Peer) {
+	log.Infof("%v pre-transfer leader to %v", p.Tag, peer)
+
+	p.pendingMessages = append(p.pendingMessages, eraftpb.Message{
+		MsgType: eraftpb.MessageType_MsgTransferLeader,
+		From:    p.PeerId(),
+		To:      peer.GetId(),
+		Term:    p.Term(),
+		Index:   p.RaftGroup.Status().Commit,
+	})
+}
 
-	p.RaftGroup.TransferLeader(peer.GetId())
+// onPreTransferLeader handles the request of the leader to take the leadership. It returns true if the peer asks
+// for the leadership right away.
+func (p *Peer) onPreTransferLeader(m *eraftpb.Message) bool {
+	if m.GetFrom() != p.LeaderId() || m.GetTerm() != p.Term() {
+		log.Infof("%v ignore stale pre-transfer leader message %v", p.Tag, m)
+		return false
+	}
+	p.pendingTransferLeaderIdx, p.pendingTransferLeaderTerm = m.GetIndex(), m.GetTerm()
+	return p.maybeTakeLeadership()
+}
+
+// maybeTakeLeadership asks the leader for the leadership if it was asked to take it, and has applied the entries
+// committed by the time the leader asked.
+func (p *Peer) maybeTakeLeadership() bool {
+	if p.pendingTransferLeaderIdx == 0 {
+		return false
+	}
+	// The request is dropped once the term changes, a new leader didn't ask for it.
+	if p.IsLeader() || p.Term() != p.pendingTransferLeaderTerm {
+		p.pendingTransferLeaderIdx = 0
+		return false
+	}
+	if p.IsApplyingSnapshot() || p.Store().AppliedIndex() < p.pendingTransferLeaderIdx {
+		return false
+	}
+	p.pendingTransferLeaderIdx = 0
+	log.Infof("%v applied to %d, ask the leader for the leadership", p.Tag, p.Store().AppliedIndex())
+	// Stepped on a follower, the transfer is forwarded to the leader on behalf of the peer.
+	p.RaftGroup.TransferLeader(p.PeerId())
+	return true
 }
 
 func (p *Peer) readyToTransferLeader(cfg *config.Config, peer *metapb.Peer) bool {
@@ -880,7 +1087,7 @@ func (p *Peer) ProposeNormal(cfg *config.Config, req *raft_cmdpb.RaftCmdRequest)
 		log.Warnf("%v skip proposal: %v", p.Tag, err)
 		return 0, err
 	}
-	data, err := req.Marshal()
+	data, err := encodeRaftCmd(req)
 	if err != nil {
 		return 0, err
 	}
@@ -898,12 +1105,64 @@ func (p *Peer) ProposeNormal(cfg *config.Config, req *raft_cmdpb.RaftCmdRequest)
 	if proposeIndex == p.nextProposalIndex() {
 		// The message is dropped silently, this usually due to leader absence
 		// or transferring leader. Both cases can be considered as NotLeader error.
-		return 0, &ErrNotLeader{RegionId: p.regionId}
+		return 0, p.notLeaderErr()
 	}
 
 	return proposeIndex, nil
 }
 
+// ProposeForward proposes a write on a follower, raft forwards it to the leader.
+// The follower doesn't know the index the leader assigns to the entry, so the
+// request is tagged with a uuid which the applier uses to find the callback.
+func (p *Peer) ProposeForward(cfg *config.Config, req *raft_cmdpb.RaftCmdRequest, cb *message.Callback, errResp *raft_cmdpb.RaftCmdResponse) bool {
+	if len(req.Header.Uuid) == 0 {
+		req.Header.Uuid = p.nextForwardUuid()
+	}
+	ctx, err := p.PrePropose(cfg, req)
+	if err != nil {
+		BindRespError(errResp, err)
+		cb.Done(errResp)
+		return false
+	}
+	data, err := encodeRaftCmd(req)
+	if err != nil {
+		BindRespError(errResp, err)
+		cb.Done(errResp)
+		return false
+	}
+	if uint64(len(data)) > cfg.RaftEntryMaxSize {
+		log.Errorf("entry is too large, entry size %v", len(data))
+		BindRespError(errResp, &ErrRaftEntryTooLarge{RegionId: p.regionId, EntrySize: uint64(len(data))})
+		cb.Done(errResp)
+		return false
+	}
+	if err = p.RaftGroup.Propose(ctx.ToBytes(), data); err != nil {
+		// The leader is lost in the meantime.
+		BindRespError(errResp, p.notLeaderErr())
+		cb.Done(errResp)
+		return false
+	}
+	log.Debugf("%v forward proposal to leader %v", p.Tag, p.LeaderId())
+	cb.ChargeMemory(int64(len(data)))
+	p.applyProposals = append(p.applyProposals, &proposal{
+		term: p.Term(),
+		uuid: req.Header.Uuid,
+		cb:   cb,
+	})
+	return true
+}
+
+// nextForwardUuid tags a proposal forwarded to the leader. The random part keeps the uuids of a peer unique across
+// restarts.
+func (p *Peer) nextForwardUuid() []byte {
+	uuid := make([]byte, 16)
+	binary.BigEndian.PutUint64(uuid, p.PeerId())
+	if _, err := rand.Read(uuid[8:]); err != nil {
+		panic(err)
+	}
+	return uuid
+}
+
 // Return true if the transfer leader request is accepted.
 func (p *Peer) ProposeTransferLeader(cfg *config.Config, req *raft_cmdpb.RaftCmdRequest, cb *message.Callback) bool {
 	transferLeader := getTransferLeaderCmd(req)
@@ -911,7 +1170,7 @@ func (p *Peer) ProposeTransferLeader(cfg *config.Config, req *raft_cmdpb.RaftCmd
 
 	transferred := false
 	if p.readyToTransferLeader(cfg, peer) {
-		p.transferLeader(peer)
+		p.preTransferLeader(peer)
 		transferred = true
 	} else {
 		log.Infof("%v transfer leader message %v ignored directly", p.Tag, req)
@@ -940,7 +1199,7 @@ func (p *Peer) ProposeConfChange(cfg *config.Config, req *raft_cmdpb.RaftCmdRequ
 		return 0, err
 	}
 
-	data, err := req.Marshal()
+	data, err := encodeRaftCmd(req)
 	if err != nil {
 		return 0, err
 	}
@@ -961,7 +1220,7 @@ func (p *Peer) ProposeConfChange(cfg *config.Config, req *raft_cmdpb.RaftCmdRequ
 	if p.nextProposalIndex() == proposeIndex {
 		// The message is dropped silently, this usually due to leader absence
 		// or transferring leader. Both cases can be considered as NotLeader error.
-		return 0, &ErrNotLeader{RegionId: p.regionId}
+		return 0, p.notLeaderErr()
 	}
 
 	return proposeIndex, nil
@@ -1015,7 +1274,10 @@ func getSyncLogFromRequest(req *raft_cmdpb.RaftCmdRequest) bool {
 	if req.AdminRequest != nil {
 		switch req.AdminRequest.GetCmdType() {
 		case raft_cmdpb.AdminCmdType_ChangePeer,
-			raft_cmdpb.AdminCmdType_BatchSplit:
+			raft_cmdpb.AdminCmdType_BatchSplit,
+			raft_cmdpb.AdminCmdType_PrepareMerge,
+			raft_cmdpb.AdminCmdType_CommitMerge,
+			raft_cmdpb.AdminCmdType_RollbackMerge:
 			return true
 		default:
 			return false
@@ -11,9 +11,9 @@ import (
 // peerState contains the peer states that needs to run raft command and apply command.
 // It binds to a worker to make sure the commands are always executed on a same goroutine.
 type peerState struct {
-	msgCh chan message.Msg
-	peer  *peerFsm
-	apply *applier
+	mailbox *mailbox
+	peer    *peerFsm
+	apply   *applier
 
 	closed *atomic.Bool
 }
@@ -22,8 +22,7 @@ func (np *peerState) send(msg message.Msg) error {
 	if np.closed.Load() {
 		return errPeerNotFound
 	}
-	np.msgCh <- msg
-	return nil
+	return np.mailbox.send(msg)
 }
 
 func (np *peerState) close() {
@@ -36,20 +35,19 @@ type applyBatch struct {
 	proposals []*regionProposal
 }
 
-// raftWorker is responsible for run raft commands and apply raft logs.
+// raftWorker is responsible for run raft commands, the raft logs are applied by the apply pool.
 type raftWorker struct {
 	pr *router
 
-	raftCh  chan message.Msg
+	raftCh  *msgQueue
 	raftCtx *RaftContext
 
-	applyCh  chan *applyBatch
-	applyCtx *applyContext
+	applyPool *applyPool
 
 	closeCh <-chan struct{}
 }
 
-func newRaftWorker(ctx *GlobalContext, ch chan message.Msg, pm *router) *raftWorker {
+func newRaftWorker(ctx *GlobalContext, ch *msgQueue, pm *router, applyPool *applyPool) *raftWorker {
 	raftCtx := &RaftContext{
 		GlobalContext: ctx,
 		applyMsgs:     new(applyMsgs),
@@ -58,33 +56,25 @@ func newRaftWorker(ctx *GlobalContext, ch chan message.Msg, pm *router) *raftWor
 		raftWB:        new(engine_util.WriteBatch),
 	}
 	return &raftWorker{
-		raftCh:   ch,
-		raftCtx:  raftCtx,
-		pr:       pm,
-		applyCh:  make(chan *applyBatch, 1),
-		applyCtx: newApplyContext("", ctx.engine, ch, ctx.cfg),
+		raftCh:    ch,
+		raftCtx:   raftCtx,
+		pr:        pm,
+		applyPool: applyPool,
 	}
 }
 
 // run runs raft commands.
-// On each loop, raft commands are batched by channel buffer.
-// After commands are handled, we collect apply messages by peers, make a applyBatch, send it to apply channel.
+// On each loop, raft commands are batched by channel buffer, the batch is ordered by the priority class of the messages.
+// After commands are handled, we collect apply messages by peers, make a applyBatch, send it to the apply pool.
 func (rw *raftWorker) run(closeCh <-chan struct{}, wg *sync.WaitGroup) {
-	go rw.runApply(wg)
+	defer wg.Done()
 	var msgs []message.Msg
 	for {
-		msgs = msgs[:0]
-		select {
-		case <-closeCh:
-			rw.applyCh <- nil
+		msg, ok := rw.raftCh.wait(closeCh)
+		if !ok {
 			return
-		case msg := <-rw.raftCh:
-			msgs = append(msgs, msg)
-		}
-		pending := len(rw.raftCh)
-		for i := 0; i < pending; i++ {
-			msgs = append(msgs, <-rw.raftCh)
 		}
+		msgs = rw.raftCh.fetch(append(msgs[:0], msg))
 		peerStateMap := make(map[uint64]*peerState)
 		rw.raftCtx.pendingCount = 0
 		rw.raftCtx.hasReady = false
@@ -93,6 +83,11 @@ func (rw *raftWorker) run(closeCh <-chan struct{}, wg *sync.WaitGroup) {
 		}
 		for _, msg := range msgs {
 			peerState := rw.getPeerState(peerStateMap, msg.RegionID)
+			if peerState == nil {
+				// The peer is destroyed, the messages it left in the queue are dropped.
+				continue
+			}
+			peerState.mailbox.release(msg)
 			newRaftMsgHandler(peerState.peer, rw.raftCtx).HandleMsgs(msg)
 		}
 		for _, peerState := range peerStateMap {
@@ -105,7 +100,8 @@ func (rw *raftWorker) run(closeCh <-chan struct{}, wg *sync.WaitGroup) {
 		batch.msgs = append(batch.msgs, applyMsgs.msgs...)
 		applyMsgs.msgs = applyMsgs.msgs[:0]
 		rw.removeQueuedSnapshots()
-		rw.applyCh <- batch
+		rw.raftCtx.storeBusy.pendingApplyMsgs.Add(int64(len(batch.msgs)))
+		rw.applyPool.schedule(batch)
 	}
 }
 
@@ -113,7 +109,9 @@ func (rw *raftWorker) getPeerState(peersMap map[uint64]*peerState, regionID uint
 	peer, ok := peersMap[regionID]
 	if !ok {
 		peer = rw.pr.get(regionID)
-		peersMap[regionID] = peer
+		if peer != nil {
+			peersMap[regionID] = peer
+		}
 	}
 	return peer
 }
@@ -155,23 +153,85 @@ func (rw *raftWorker) removeQueuedSnapshots() {
 	}
 }
 
-// runApply runs apply tasks, since it is already batched by raftCh, we don't need to batch it here.
-func (rw *raftWorker) runApply(wg *sync.WaitGroup) {
-	for {
-		batch := <-rw.applyCh
-		if batch == nil {
-			wg.Done()
-			return
+// applyPool applies the raft logs of the regions on a pool of apply workers. A region is bound to an apply worker by
+// its ID like to a raft worker, so its apply messages are handled in order, and a region busy applying only holds up
+// the regions sharing its apply worker instead of all the regions of its raft worker. The apply results are routed
+// back to the raft workers of the regions.
+type applyPool struct {
+	pr      *router
+	workers []*applyWorker
+	wg      sync.WaitGroup
+}
+
+func newApplyPool(ctx *GlobalContext, pr *router) *applyPool {
+	pool := &applyPool{pr: pr}
+	for i := uint64(0); i < ctx.cfg.ApplyPoolSize; i++ {
+		pool.workers = append(pool.workers, &applyWorker{
+			// Every raft worker may have a batch queued, like on a channel of its own.
+			applyCh:   make(chan *applyBatch, ctx.cfg.RaftWorkerCnt),
+			applyCtx:  newApplyContext("", ctx.engine, pr, ctx.cfg),
+			storeBusy: ctx.storeBusy,
+		})
+	}
+	return pool
+}
+
+func (p *applyPool) start() {
+	for _, w := range p.workers {
+		p.wg.Add(1)
+		go w.run(&p.wg)
+	}
+}
+
+// stop stops the apply workers after the batches already scheduled are applied, the raft workers must be stopped
+// first.
+func (p *applyPool) stop() {
+	for _, w := range p.workers {
+		close(w.applyCh)
+	}
+	p.wg.Wait()
+}
+
+// schedule splits the apply messages of the batch by the apply workers of their regions. The peers are looked up
+// here, a peer destroyed by the batch is already removed from the router when its last messages are applied.
+func (p *applyPool) schedule(batch *applyBatch) {
+	batches := make([]*applyBatch, len(p.workers))
+	for _, msg := range batch.msgs {
+		ps := batch.peers[msg.RegionID]
+		if ps == nil {
+			ps = p.pr.get(msg.RegionID)
+			batch.peers[msg.RegionID] = ps
 		}
+		idx := int(msg.RegionID) % len(p.workers)
+		if batches[idx] == nil {
+			batches[idx] = &applyBatch{peers: make(map[uint64]*peerState)}
+		}
+		batches[idx].msgs = append(batches[idx].msgs, msg)
+		batches[idx].peers[msg.RegionID] = ps
+	}
+	for idx, b := range batches {
+		if b != nil {
+			p.workers[idx].applyCh <- b
+		}
+	}
+}
+
+// applyWorker applies the batches of the regions bound to it.
+type applyWorker struct {
+	applyCh   chan *applyBatch
+	applyCtx  *applyContext
+	storeBusy *storeBusy
+}
+
+// run runs apply tasks, since it is already batched by raftCh, we don't need to batch it here.
+func (aw *applyWorker) run(wg *sync.WaitGroup) {
+	defer wg.Done()
+	for batch := range aw.applyCh {
 		for _, msg := range batch.msgs {
-			ps := batch.peers[msg.RegionID]
-			if ps == nil {
-				ps = rw.pr.get(msg.RegionID)
-				batch.peers[msg.RegionID] = ps
-			}
-			ps.apply.handleTask(rw.applyCtx, msg)
+			batch.peers[msg.RegionID].apply.handleTask(aw.applyCtx, msg)
 		}
-		rw.applyCtx.flush()
+		aw.applyCtx.flush()
+		aw.storeBusy.pendingApplyMsgs.Sub(int64(len(batch.msgs)))
 	}
 }
 
@@ -181,14 +241,16 @@ type storeWorker struct {
 }
 
 func (sw *storeWorker) run(closeCh <-chan struct{}, wg *sync.WaitGroup) {
+	var msgs []message.Msg
 	for {
-		var msg message.Msg
-		select {
-		case <-closeCh:
+		msg, ok := sw.store.receiver.wait(closeCh)
+		if !ok {
 			wg.Done()
 			return
-		case msg = <-sw.store.receiver:
 		}
-		sw.store.handleMsg(msg)
+		msgs = sw.store.receiver.fetch(append(msgs[:0], msg))
+		for _, msg := range msgs {
+			sw.store.handleMsg(msg)
+		}
 	}
 }
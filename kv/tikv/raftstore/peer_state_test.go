@@ -0,0 +1,34 @@
+package raftstore
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/message"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyPoolSchedule(t *testing.T) {
+	pool := &applyPool{}
+	for i := 0; i < 2; i++ {
+		pool.workers = append(pool.workers, &applyWorker{applyCh: make(chan *applyBatch, 1)})
+	}
+	peers := map[uint64]*peerState{1: {}, 2: {}, 3: {}}
+	batch := &applyBatch{peers: peers}
+	for i, regionID := range []uint64{1, 2, 3, 1} {
+		batch.msgs = append(batch.msgs, message.Msg{RegionID: regionID, Data: i})
+	}
+	pool.schedule(batch)
+
+	// The messages of a region go to the same worker in order, along with the peers.
+	even := <-pool.workers[0].applyCh
+	assert.Equal(t, []message.Msg{{RegionID: 2, Data: 1}}, even.msgs)
+	assert.Equal(t, map[uint64]*peerState{2: peers[2]}, even.peers)
+	odd := <-pool.workers[1].applyCh
+	assert.Equal(t, []message.Msg{{RegionID: 1, Data: 0}, {RegionID: 3, Data: 2}, {RegionID: 1, Data: 3}}, odd.msgs)
+	assert.Equal(t, map[uint64]*peerState{1: peers[1], 3: peers[3]}, odd.peers)
+
+	// A worker without messages gets no batch.
+	pool.schedule(&applyBatch{peers: peers, msgs: []message.Msg{{RegionID: 3}}})
+	assert.Empty(t, pool.workers[0].applyCh)
+	assert.Len(t, pool.workers[1].applyCh, 1)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/kv/engine_util"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/snap"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/worker"
+	"github.com/pingcap-incubator/tinykv/kv/util/memory"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
@@ -103,19 +104,20 @@ func (ec *EntryCache) append(tag string, entries []eraftpb.Entry) {
 		cacheLastIndex := ec.back().Index
 		if cacheLastIndex >= firstIndex {
 			if ec.front().Index >= firstIndex {
-				ec.cache = ec.cache[:0]
+				ec.truncateTo(0)
 			} else {
 				left := ec.length() - int(cacheLastIndex-firstIndex+1)
-				ec.cache = ec.cache[:left]
+				ec.truncateTo(left)
 			}
 		} else if cacheLastIndex+1 < firstIndex {
 			panic(fmt.Sprintf("%s unexpected hole %d < %d", tag, cacheLastIndex, firstIndex))
 		}
 	}
+	memory.StoreBudget.Consume(memory.RaftEntryCache, entriesSize(entries))
 	ec.cache = append(ec.cache, entries...)
 	if ec.length() > MaxCacheCapacity {
 		extraSize := ec.length() - MaxCacheCapacity
-		ec.cache = ec.cache[extraSize:]
+		ec.dropFront(extraSize)
 	}
 }
 
@@ -128,7 +130,30 @@ func (ec *EntryCache) compactTo(idx uint64) {
 		return
 	}
 	pos := mathutil.Min(int(idx-firstIdx), ec.length())
-	ec.cache = ec.cache[pos:]
+	ec.dropFront(pos)
+}
+
+// clear drops all the entries, it must be called before the cache is discarded to release its memory.
+func (ec *EntryCache) clear() {
+	ec.truncateTo(0)
+}
+
+func (ec *EntryCache) dropFront(n int) {
+	memory.StoreBudget.Release(memory.RaftEntryCache, entriesSize(ec.cache[:n]))
+	ec.cache = ec.cache[n:]
+}
+
+func (ec *EntryCache) truncateTo(n int) {
+	memory.StoreBudget.Release(memory.RaftEntryCache, entriesSize(ec.cache[n:]))
+	ec.cache = ec.cache[:n]
+}
+
+func entriesSize(entries []eraftpb.Entry) int64 {
+	var size int64
+	for i := range entries {
+		size += int64(entries[i].Size())
+	}
+	return size
 }
 
 type ApplySnapResult struct {
@@ -220,7 +245,10 @@ type PeerStorage struct {
 
 	snapState    snap.SnapState
 	regionSched  chan<- worker.Task
+	snapGenSched chan<- worker.Task
 	snapTriedCnt int
+	// snapPriority is the priority of generating snapshots, it's high while a peer repairing the region waits for one.
+	snapPriority worker.TaskPriority
 
 	cache *EntryCache
 	stats *CacheQueryStats
@@ -228,7 +256,8 @@ type PeerStorage struct {
 	Tag string
 }
 
-func NewPeerStorage(engines *engine_util.Engines, region *metapb.Region, regionSched chan<- worker.Task, peerID uint64, tag string) (*PeerStorage, error) {
+func NewPeerStorage(engines *engine_util.Engines, region *metapb.Region, regionSched, snapGenSched chan<- worker.Task,
+	peerID uint64, tag string) (*PeerStorage, error) {
 	log.Debugf("%s creating storage for %s", tag, region.String())
 	raftState, err := initRaftState(engines.Raft, region)
 	if err != nil {
@@ -247,16 +276,17 @@ func NewPeerStorage(engines *engine_util.Engines, region *metapb.Region, regionS
 		return nil, err
 	}
 	return &PeerStorage{
-		Engines:     engines,
-		peerID:      peerID,
-		region:      region,
-		Tag:         tag,
-		raftState:   raftState,
-		applyState:  applyState,
-		lastTerm:    lastTerm,
-		regionSched: regionSched,
-		cache:       &EntryCache{},
-		stats:       &CacheQueryStats{},
+		Engines:      engines,
+		peerID:       peerID,
+		region:       region,
+		Tag:          tag,
+		raftState:    raftState,
+		applyState:   applyState,
+		lastTerm:     lastTerm,
+		regionSched:  regionSched,
+		snapGenSched: snapGenSched,
+		cache:        &EntryCache{},
+		stats:        &CacheQueryStats{},
 	}, nil
 }
 
@@ -574,6 +604,13 @@ func (ps *PeerStorage) Snapshot() (eraftpb.Snapshot, error) {
 		return snapshot, err
 	}
 
+	if memory.StoreBudget.Exceeded() {
+		// Generating and sending a snapshot holds a lot of memory, pause it until the store is below its budget.
+		// The retry count is left alone, raft asks again later.
+		log.Warnf("memory budget exceeded, delay generating snapshot, regionID: %d, peerID: %d", ps.region.GetId(), ps.peerID)
+		return snapshot, raft.ErrSnapshotTemporarilyUnavailable
+	}
+
 	log.Infof("requesting snapshot, regionID: %d, peerID: %d", ps.region.GetId(), ps.peerID)
 	ps.snapTriedCnt++
 	ps.ScheduleGenerateSnapshot()
@@ -583,19 +620,37 @@ func (ps *PeerStorage) Snapshot() (eraftpb.Snapshot, error) {
 
 func (ps *PeerStorage) ScheduleGenerateSnapshot() {
 	ch := make(chan *eraftpb.Snapshot, 1)
+	status := snap.JobStatus_Pending
 	ps.snapState = snap.SnapState{
 		StateType: snap.SnapState_Generating,
+		Status:    &status,
 		Receiver:  ch,
 	}
-	ps.regionSched <- worker.Task{
+	ps.snapGenSched <- worker.Task{
 		Tp: worker.TaskTypeRegionGen,
 		Data: &regionTask{
 			regionId: ps.region.GetId(),
 			notifier: ch,
+			status:   &status,
 		},
+		Priority: ps.snapPriority,
 	}
 }
 
+// CancelGeneratingSnap cancels the snapshot being generated, it's only sent by a leader. The generator gives the
+// snapshot up at its next check, and raft asks for a new one if the peer becomes the leader again.
+func (ps *PeerStorage) CancelGeneratingSnap() {
+	if ps.snapState.StateType != snap.SnapState_Generating {
+		return
+	}
+	if atomic.CompareAndSwapUint32(ps.snapState.Status, snap.JobStatus_Pending, snap.JobStatus_Cancelling) ||
+		atomic.CompareAndSwapUint32(ps.snapState.Status, snap.JobStatus_Running, snap.JobStatus_Cancelling) {
+		log.Infof("%s cancel generating snapshot", ps.Tag)
+	}
+	ps.snapState = snap.SnapState{StateType: snap.SnapState_Relax}
+	ps.snapTriedCnt = 0
+}
+
 // Append the given entries to the raft log using previous last index or self.last_index.
 // Return the new last index for later update. After we commit in engine, we can set last_index
 // to the return one.
@@ -767,6 +822,9 @@ func ClearMeta(engines *engine_util.Engines, kvWB, raftWB *engine_util.WriteBatc
 	start := time.Now()
 	kvWB.Delete(RegionStateKey(regionID))
 	kvWB.Delete(ApplyStateKey(regionID))
+	if err := deleteAppliedCmds(engines.Kv, kvWB, regionID); err != nil {
+		return err
+	}
 
 	firstIndex := lastIndex + 1
 	beginLogKey := RaftLogKey(regionID, 0)
@@ -809,6 +867,26 @@ func WritePeerState(kvWB *engine_util.WriteBatch, region *metapb.Region, state r
 	kvWB.Set(RegionStateKey(regionID), data)
 }
 
+// writeTombstoneState marks the peer of region destroyed, and records the largest epoch it has seen.
+func writeTombstoneState(kvWB *engine_util.WriteBatch, region *metapb.Region, maxEpoch *metapb.RegionEpoch) {
+	regionState := new(rspb.RegionLocalState)
+	regionState.State = rspb.PeerState_Tombstone
+	regionState.Region = region
+	regionState.MaxEpoch = mergeEpoch(region.RegionEpoch, maxEpoch)
+	data, _ := regionState.Marshal()
+	kvWB.Set(RegionStateKey(region.Id), data)
+}
+
+// writeMergingState marks the peer of region merging into the target of state.
+func writeMergingState(kvWB *engine_util.WriteBatch, region *metapb.Region, state *rspb.MergeState) {
+	regionState := new(rspb.RegionLocalState)
+	regionState.State = rspb.PeerState_Merging
+	regionState.Region = region
+	regionState.MergeState = state
+	data, _ := regionState.Marshal()
+	kvWB.Set(RegionStateKey(region.Id), data)
+}
+
 // Apply the peer with given snapshot.
 func (ps *PeerStorage) ApplySnapshot(ctx *InvokeContext, snap *eraftpb.Snapshot, kvWB *engine_util.WriteBatch, raftWB *engine_util.WriteBatch) error {
 	log.Infof("%v begin to apply snapshot", ps.Tag)
@@ -830,6 +908,9 @@ func (ps *PeerStorage) ApplySnapshot(ctx *InvokeContext, snap *eraftpb.Snapshot,
 	}
 
 	WritePeerState(kvWB, snapData.Region, rspb.PeerState_Applying)
+	if err := writeAppliedCmds(kvWB, snapData.Region.Id, snapData.Data); err != nil {
+		return err
+	}
 
 	lastIdx := snap.Metadata.Index
 
@@ -955,8 +1036,16 @@ func (ps *PeerStorage) SetRegion(region *metapb.Region) {
 	ps.region = region
 }
 
+// ClearData schedules the region worker to delete all the data of the region.
 func (ps *PeerStorage) ClearData() error {
-	// Todo: currently it is a place holder
+	ps.regionSched <- worker.Task{
+		Tp: worker.TaskTypeRegionDestroy,
+		Data: &regionTask{
+			regionId: ps.region.GetId(),
+			startKey: ps.region.GetStartKey(),
+			endKey:   ps.region.GetEndKey(),
+		},
+	}
 	return nil
 }
 
@@ -1006,7 +1095,9 @@ func getAppliedIdxTermForSnapshot(raft *badger.DB, kv *badger.Txn, regionId uint
 	return idx, term, nil
 }
 
-func doSnapshot(engines *engine_util.Engines, mgr *snap.SnapManager, regionId uint64) (*eraftpb.Snapshot, error) {
+// doSnapshot builds the snapshot of the region from a consistent view of the engine, it's aborted once the status is
+// cancelling.
+func doSnapshot(engines *engine_util.Engines, mgr *snap.SnapManager, regionId uint64, status *snap.JobStatus) (*eraftpb.Snapshot, error) {
 	log.Debugf("begin to generate a snapshot. [regionId: %d]", regionId)
 
 	txn := engines.Kv.NewTransaction(false)
@@ -1048,9 +1139,17 @@ func doSnapshot(engines *engine_util.Engines, mgr *snap.SnapManager, regionId ui
 	}
 	// Set snapshot data
 	snapshotData := &rspb.RaftSnapshotData{Region: region}
+	// The applied commands are part of the state machine, see appliedCmdCache.
+	if snapshotData.Data, err = appliedCmdsForSnapshot(txn, regionId); err != nil {
+		return nil, err
+	}
 	snapshotStatics := snap.SnapStatistics{}
-	err = s.Build(txn, region, snapshotData, &snapshotStatics, mgr)
+	err = s.Build(txn, region, snapshotData, &snapshotStatics, mgr, status)
 	if err != nil {
+		if _, ok := err.(snap.ApplySnapAbortError); ok {
+			// The files of the aborted snapshot are incomplete.
+			s.Delete()
+		}
 		return nil, err
 	}
 	snapshot.Data, err = snapshotData.Marshal()
@@ -2,11 +2,17 @@ package raftstore
 
 import (
 	"bytes"
+	"io/ioutil"
 	"math"
+	"os"
+	"sync/atomic"
 	"testing"
 
 	"github.com/coocood/badger"
 	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/snap"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/worker"
+	"github.com/pingcap-incubator/tinykv/kv/util/memory"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
 	"github.com/pingcap-incubator/tinykv/raft"
 	"github.com/stretchr/testify/assert"
@@ -389,3 +395,60 @@ func TestPeerStorageCacheUpdate(t *testing.T) {
 	// invalid compaction should be ignored.
 	peerStore.CompactTo(capacity)
 }
+
+func TestEntryCacheMemory(t *testing.T) {
+	used := func() int64 {
+		return memory.StoreBudget.Used(memory.RaftEntryCache)
+	}
+	base := used()
+	entry := newTestEntry(1, 1)
+	entrySize := int64(entry.Size())
+	cache := &EntryCache{}
+
+	cache.append("test", []eraftpb.Entry{newTestEntry(1, 1), newTestEntry(2, 1), newTestEntry(3, 1)})
+	assert.Equal(t, base+3*entrySize, used())
+
+	// Rewritten entries are released.
+	cache.append("test", []eraftpb.Entry{newTestEntry(3, 2)})
+	assert.Equal(t, base+3*entrySize, used())
+	cache.append("test", []eraftpb.Entry{newTestEntry(2, 3)})
+	assert.Equal(t, base+2*entrySize, used())
+
+	cache.compactTo(2)
+	assert.Equal(t, base+entrySize, used())
+
+	cache.clear()
+	assert.Equal(t, base, used())
+}
+
+func TestPeerStorageGenerateSnapshot(t *testing.T) {
+	peerStore := newTestPeerStorage(t)
+	defer cleanUpTestData(peerStore)
+	snapPath, err := ioutil.TempDir("", "tinykv_snap")
+	require.Nil(t, err)
+	defer os.RemoveAll(snapPath)
+	mgr := snap.NewSnapManager(snapPath)
+	require.Nil(t, mgr.Init())
+	genSched := make(chan worker.Task, 1)
+	peerStore.snapGenSched = genSched
+	handler := newSnapGenTaskHandler(peerStore.Engines, mgr)
+
+	// The snapshot is requested from the generator, raft gets it once it's generated.
+	_, err = peerStore.Snapshot()
+	assert.Equal(t, raft.ErrSnapshotTemporarilyUnavailable, err)
+	handler.Handle(<-genSched)
+	snapshot, err := peerStore.Snapshot()
+	require.Nil(t, err)
+	assert.Equal(t, peerStore.truncatedIndex(), snapshot.GetMetadata().GetIndex())
+
+	// A cancelled snapshot is not generated.
+	_, err = peerStore.Snapshot()
+	assert.Equal(t, raft.ErrSnapshotTemporarilyUnavailable, err)
+	status := peerStore.snapState.Status
+	peerStore.CancelGeneratingSnap()
+	assert.Equal(t, snap.SnapState_Relax, peerStore.snapState.StateType)
+	task := <-genSched
+	handler.Handle(task)
+	assert.Equal(t, snap.JobStatus_Cancelled, atomic.LoadUint32(status))
+	assert.Empty(t, task.Data.(*regionTask).notifier)
+}
@@ -3,8 +3,11 @@ package raftstore
 import (
 	"testing"
 
+	"github.com/pingcap-incubator/tinykv/kv/tikv/config"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetSyncLogFromRequest(t *testing.T) {
@@ -45,3 +48,32 @@ func TestEntryCtx(t *testing.T) {
 		}
 	}
 }
+
+func TestNotLeaderErrHint(t *testing.T) {
+	engines := newTestEngines(t)
+	defer cleanUpTestEngineData(engines)
+	require.Nil(t, BootstrapStore(engines, 1, 1))
+	region, err := PrepareBootstrap(engines, 1, 1, 1)
+	require.Nil(t, err)
+	region.Peers = append(region.Peers, &metapb.Peer{Id: 2, StoreId: 2}, &metapb.Peer{Id: 3, StoreId: 3})
+	peer, err := NewPeer(1, config.NewDefaultConfig(), engines, region, nil, nil, region.Peers[0])
+	require.Nil(t, err)
+	peer.leaderCache = newLeaderCache()
+
+	// No leader is known yet.
+	notLeader := peer.notLeaderErr()
+	assert.Nil(t, notLeader.Leader)
+	assert.Equal(t, region.RegionEpoch, notLeader.RegionEpoch)
+
+	// Fall back to the leader observed on this store.
+	peer.leaderCache.observe(region.Id, region.Peers[1])
+	assert.Equal(t, region.Peers[1], peer.notLeaderErr().Leader)
+
+	// The peer itself is never suggested.
+	peer.leaderCache.observe(region.Id, region.Peers[0])
+	assert.Nil(t, peer.notLeaderErr().Leader)
+
+	// Neither is a peer which is no longer a member of the region.
+	peer.leaderCache.observe(region.Id, &metapb.Peer{Id: 4, StoreId: 4})
+	assert.Nil(t, peer.notLeaderErr().Leader)
+}
@@ -0,0 +1,129 @@
+package raftstore
+
+import (
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/pingcap/errors"
+)
+
+// pendingRead is a read waiting for the peer to apply up to its read index.
+type pendingRead struct {
+	req *raft_cmdpb.RaftCmdRequest
+	cb  *message.Callback
+}
+
+// isQuorumRead returns whether req is a snapshot read at the index in its header. The store coordinating the read
+// takes the largest last index of the logs of a majority of the peers as the read index, every entry committed before
+// the read started is in at least one of them. So any peer which has applied up to the read index can serve the read,
+// without asking the leader.
+func isQuorumRead(req *raft_cmdpb.RaftCmdRequest) bool {
+	if !req.GetHeader().GetReadQuorum() || req.AdminRequest != nil || len(req.Requests) != 1 {
+		return false
+	}
+	return req.Requests[0].CmdType == raft_cmdpb.CmdType_Snap
+}
+
+// onQuorumRead serves the read once the peer has applied up to its read index. The peer may apply more before the
+// snapshot is taken, the read still sees everything committed before it started.
+func (d *peerMsgHandler) onQuorumRead(req *raft_cmdpb.RaftCmdRequest, cb *message.Callback) {
+	d.peer.pendingQuorumReads = append(d.peer.pendingQuorumReads, &pendingRead{req: req, cb: cb})
+	d.handleQuorumReads(time.Now())
+}
+
+// handleQuorumReads serves the pending reads whose read index is applied, and drops the expired ones.
+func (d *peerMsgHandler) handleQuorumReads(now time.Time) {
+	if len(d.peer.pendingQuorumReads) == 0 {
+		return
+	}
+	// The data of a snapshot being applied is incomplete.
+	applying := d.peer.IsApplyingSnapshot()
+	applied := d.peer.Store().AppliedIndex()
+	pending := d.peer.pendingQuorumReads[:0]
+	for _, read := range d.peer.pendingQuorumReads {
+		switch {
+		case !applying && read.req.Header.AppliedIndex <= applied:
+			d.serveRead(read, applied)
+		case isExpiredRead(read.req, now):
+			read.cb.Done(ErrResp(&ErrDeadlineExceeded{RegionId: d.regionID()}))
+		default:
+			pending = append(pending, read)
+		}
+	}
+	for i := len(pending); i < len(d.peer.pendingQuorumReads); i++ {
+		d.peer.pendingQuorumReads[i] = nil
+	}
+	d.peer.pendingQuorumReads = pending
+}
+
+// serveRead executes the reads of the request on the kv engine, which the peer has applied up to applied.
+func (d *peerMsgHandler) serveRead(read *pendingRead, applied uint64) {
+	// The region may have been split while the read was waiting.
+	if err := checkRegionEpoch(read.req, d.region(), true); err != nil {
+		read.cb.Done(ErrResp(err))
+		return
+	}
+	txn := d.ctx.engine.Kv.NewTransaction(false)
+	resp := newCmdRespForReq(read.req)
+	hasSnap := false
+	for _, r := range read.req.Requests {
+		switch r.CmdType {
+		case raft_cmdpb.CmdType_Get:
+			cf := r.Get.GetCf()
+			if len(cf) == 0 {
+				cf = engine_util.CF_DEFAULT
+			}
+			val, err := engine_util.GetCFFromTxn(txn, cf, r.Get.GetKey())
+			if err != nil {
+				txn.Discard()
+				read.cb.Done(ErrResp(err))
+				return
+			}
+			resp.Responses = append(resp.Responses, &raft_cmdpb.Response{
+				CmdType: raft_cmdpb.CmdType_Get,
+				Get:     &raft_cmdpb.GetResponse{Value: val},
+			})
+		case raft_cmdpb.CmdType_Snap:
+			resp.Responses = append(resp.Responses, new(raft_cmdpb.Response))
+			hasSnap = true
+		}
+	}
+	BindRespTerm(resp, d.peer.Term())
+	if hasSnap {
+		read.cb.RegionSnap = message.RegionSnapshot{
+			Region: *d.region(),
+			Txn:    txn,
+			Index:  applied,
+		}
+	} else {
+		txn.Discard()
+	}
+	read.cb.Done(resp)
+}
+
+// executeRaftLog reports the progress of the raft log of the peer to the store coordinating a quorum read. Any peer
+// answers it, the coordinator checks that the region of every answer has the conf version it counts the majority of.
+func (d *peerMsgHandler) executeRaftLog(request *raft_cmdpb.RaftCmdRequest) (*raft_cmdpb.StatusResponse, error) {
+	if !d.peer.isInitialized() {
+		return nil, errors.Errorf("region %d not initialized", request.Header.RegionId)
+	}
+	lastIndex, err := d.peer.Store().LastIndex()
+	if err != nil {
+		return nil, err
+	}
+	lastTerm, err := d.peer.Store().Term(lastIndex)
+	if err != nil {
+		return nil, err
+	}
+	return &raft_cmdpb.StatusResponse{
+		RaftLog: &raft_cmdpb.RaftLogResponse{
+			Region:       d.region(),
+			LastIndex:    lastIndex,
+			LastTerm:     lastTerm,
+			CommitIndex:  d.peer.GetRaftStatus().Commit,
+			AppliedIndex: d.peer.Store().AppliedIndex(),
+		},
+	}, nil
+}
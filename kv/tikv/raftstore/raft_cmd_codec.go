@@ -0,0 +1,62 @@
+package raftstore
+
+import (
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/pingcap/errors"
+)
+
+// A raft command is stored in the log as a payload of `raftCmdMagic | version | body`. Payloads written before the
+// versioning was introduced are a bare RaftCmdRequest, which never starts with raftCmdMagic since 0 is not a valid
+// protobuf field tag, so they are decoded as version 0. When the encoding of a command changes, bump
+// raftCmdVersionCurrent and keep the decoders of the previous versions, so that entries written before an upgrade can
+// still be applied after it.
+const (
+	raftCmdMagic byte = 0
+
+	// raftCmdVersionLegacy is a bare RaftCmdRequest without the header.
+	raftCmdVersionLegacy byte = 0
+	// raftCmdVersionV1 is a RaftCmdRequest behind the header.
+	raftCmdVersionV1 byte = 1
+
+	raftCmdVersionCurrent = raftCmdVersionV1
+)
+
+var raftCmdDecoders = map[byte]func(body []byte, cmd *raft_cmdpb.RaftCmdRequest) error{
+	raftCmdVersionLegacy: decodeRaftCmdPb,
+	raftCmdVersionV1:     decodeRaftCmdPb,
+}
+
+func decodeRaftCmdPb(body []byte, cmd *raft_cmdpb.RaftCmdRequest) error {
+	return cmd.Unmarshal(body)
+}
+
+// encodeRaftCmd encodes a raft command of the current version to be proposed.
+func encodeRaftCmd(cmd *raft_cmdpb.RaftCmdRequest) ([]byte, error) {
+	data := make([]byte, 2+cmd.Size())
+	data[0] = raftCmdMagic
+	data[1] = raftCmdVersionCurrent
+	if _, err := cmd.MarshalTo(data[2:]); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// decodeRaftCmd decodes a raft command of any known version from the log.
+func decodeRaftCmd(data []byte) (*raft_cmdpb.RaftCmdRequest, error) {
+	version, body := raftCmdVersionLegacy, data
+	if len(data) > 0 && data[0] == raftCmdMagic {
+		if len(data) < 2 {
+			return nil, errors.Errorf("truncated raft command header %v", data)
+		}
+		version, body = data[1], data[2:]
+	}
+	decode, ok := raftCmdDecoders[version]
+	if !ok {
+		return nil, errors.Errorf("unsupported raft command version %d, the store may need an upgrade", version)
+	}
+	cmd := new(raft_cmdpb.RaftCmdRequest)
+	if err := decode(body, cmd); err != nil {
+		return nil, err
+	}
+	return cmd, nil
+}
@@ -0,0 +1,41 @@
+package raftstore
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRaftCmdCodec(t *testing.T) {
+	req := &raft_cmdpb.RaftCmdRequest{
+		Header: &raft_cmdpb.RaftRequestHeader{RegionId: 1},
+		Requests: []*raft_cmdpb.Request{{
+			CmdType: raft_cmdpb.CmdType_Put,
+			Put:     &raft_cmdpb.PutRequest{Key: []byte("k"), Value: []byte("v")},
+		}},
+	}
+
+	data, err := encodeRaftCmd(req)
+	require.Nil(t, err)
+	assert.Equal(t, []byte{raftCmdMagic, raftCmdVersionCurrent}, data[:2])
+	cmd, err := decodeRaftCmd(data)
+	require.Nil(t, err)
+	assert.Equal(t, req, cmd)
+
+	// Commands written before versioning are still decodable.
+	legacy, err := req.Marshal()
+	require.Nil(t, err)
+	cmd, err = decodeRaftCmd(legacy)
+	require.Nil(t, err)
+	assert.Equal(t, req, cmd)
+	cmd, err = decodeRaftCmd(nil)
+	require.Nil(t, err)
+	assert.Equal(t, &raft_cmdpb.RaftCmdRequest{}, cmd)
+
+	_, err = decodeRaftCmd([]byte{raftCmdMagic})
+	assert.NotNil(t, err)
+	_, err = decodeRaftCmd([]byte{raftCmdMagic, raftCmdVersionCurrent + 1})
+	assert.NotNil(t, err)
+}
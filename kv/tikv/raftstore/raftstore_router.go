@@ -2,6 +2,7 @@ package raftstore
 
 import (
 	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
 	"github.com/pingcap-incubator/tinykv/raft"
@@ -44,8 +45,18 @@ func (r *RaftstoreRouter) SendRaftCommand(req *raft_cmdpb.RaftCmdRequest, cb *me
 	return r.router.sendRaftCommand(msg)
 }
 
+// SplitRegion asks the leader of the region to split it at the encoded keys, the callback is done once the split is
+// applied or fails.
+func (r *RaftstoreRouter) SplitRegion(regionID uint64, epoch *metapb.RegionEpoch, splitKeys [][]byte, cb *message.Callback) error {
+	return r.router.send(regionID, message.NewPeerMsg(message.MsgTypeSplitRegion, regionID, &MsgSplitRegion{
+		RegionEpoch: epoch,
+		SplitKeys:   splitKeys,
+		Callback:    cb,
+	}))
+}
+
 func (r *RaftstoreRouter) SignificantSend(regionID uint64, msg message.Msg) error {
-	// TODO: no capacity check now, so no difference between send and SignificantSend.
+	// The significant messages are in the raft class, which a full mailbox still queues.
 	return r.router.send(regionID, msg)
 }
 
@@ -63,3 +74,14 @@ func (r *RaftstoreRouter) ReportSnapshotStatus(regionID uint64, toPeerID uint64,
 		SnapshotStatus: status,
 	}))
 }
+
+// PauseApply stops the region from applying committed entries until ResumeApply is called, the raft peer keeps
+// running meanwhile. It is used by tests to control the order of applying among peers.
+func (r *RaftstoreRouter) PauseApply(regionID uint64) error {
+	return r.router.send(regionID, message.NewPeerMsg(message.MsgTypeApplyPause, regionID, nil))
+}
+
+// ResumeApply applies the entries committed while the region is paused and continues applying.
+func (r *RaftstoreRouter) ResumeApply(regionID uint64) error {
+	return r.router.send(regionID, message.NewPeerMsg(message.MsgTypeApplyResume, regionID, nil))
+}
@@ -0,0 +1,170 @@
+package raftstore
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/pingcap-incubator/tinykv/raft"
+)
+
+// readIndexRound is a raft read index round the leader issued for a batch of reads. The leader takes its commit
+// index as the read index and confirms it's still the leader by a round of heartbeats. The reads are served once the
+// peer has applied up to the read index, without appending them to the raft log.
+type readIndexRound struct {
+	id     uint64
+	term   uint64
+	issued time.Time
+	// The confirmed read index, 0 until a quorum confirms the round.
+	index uint64
+	reads []*pendingRead
+}
+
+// isReadIndexRead returns whether req only reads, so that the leader may serve it by a read index round.
+func isReadIndexRead(req *raft_cmdpb.RaftCmdRequest) bool {
+	if req.AdminRequest != nil || len(req.Requests) == 0 {
+		return false
+	}
+	for _, r := range req.Requests {
+		if r.CmdType != raft_cmdpb.CmdType_Get && r.CmdType != raft_cmdpb.CmdType_Snap {
+			return false
+		}
+	}
+	return true
+}
+
+// onReadIndexRead queues the read for the next read index round of the leader. A read never joins a round already
+// issued, the commit index the round took may miss the writes finished before the read arrived.
+func (d *peerMsgHandler) onReadIndexRead(req *raft_cmdpb.RaftCmdRequest, cb *message.Callback) {
+	if isExpiredRead(req, time.Now()) {
+		cb.Done(ErrResp(&ErrDeadlineExceeded{RegionId: d.regionID()}))
+		return
+	}
+	d.peer.readIndexBatch = append(d.peer.readIndexBatch, &pendingRead{req: req, cb: cb})
+	d.hasReady = true
+}
+
+// readIndexInterval is how long a round may wait for its heartbeat responses before the next batch is issued anyway.
+func (d *peerMsgHandler) readIndexInterval() time.Duration {
+	return d.ctx.cfg.RaftBaseTickInterval * time.Duration(d.ctx.cfg.RaftHeartbeatTicks)
+}
+
+// issueReadIndex issues the batched reads as one read index round, unless the last round is still waiting for its
+// heartbeat responses, so that the reads arriving meanwhile share the next round instead of each sending heartbeats.
+// It returns whether a round is issued.
+func (d *peerMsgHandler) issueReadIndex(now time.Time) bool {
+	p := d.peer
+	if len(p.readIndexBatch) == 0 {
+		return false
+	}
+	if !p.IsLeader() {
+		err := p.notLeaderErr()
+		for _, read := range p.readIndexBatch {
+			read.cb.Done(ErrResp(err))
+		}
+		p.readIndexBatch = nil
+		return false
+	}
+	if n := len(p.readIndexRounds); n > 0 {
+		last := p.readIndexRounds[n-1]
+		if last.index == 0 && now.Sub(last.issued) < d.readIndexInterval() {
+			return false
+		}
+	}
+	// The leader rejects read index rounds until it has committed an entry of its term, the batch waits for it.
+	commit := p.GetRaftStatus().Commit
+	if term, err := p.RaftGroup.Raft.RaftLog.Term(commit); err != nil || term != p.Term() {
+		return false
+	}
+	p.readIndexSeq++
+	round := &readIndexRound{
+		id:     p.readIndexSeq,
+		term:   p.Term(),
+		issued: now,
+		reads:  p.readIndexBatch,
+	}
+	p.readIndexBatch = nil
+	p.readIndexRounds = append(p.readIndexRounds, round)
+	ctx := make([]byte, 8)
+	binary.BigEndian.PutUint64(ctx, round.id)
+	p.RaftGroup.ReadIndex(ctx)
+	return true
+}
+
+// onReadStates records the read indexes of the rounds confirmed in the ready, and serves their reads.
+func (d *peerMsgHandler) onReadStates(states []raft.ReadState) {
+	for _, state := range states {
+		if len(state.RequestCtx) != 8 {
+			continue
+		}
+		id := binary.BigEndian.Uint64(state.RequestCtx)
+		for _, round := range d.peer.readIndexRounds {
+			if round.id == id {
+				round.index = state.Index
+				break
+			}
+		}
+	}
+	d.handleReadIndexReads(time.Now())
+	if len(d.peer.readIndexBatch) > 0 {
+		// The batch waited for the confirmed round, have the raft worker issue it without waiting for a tick.
+		if ps := d.ctx.router.get(d.regionID()); ps != nil {
+			ps.mailbox.wake(d.regionID())
+		}
+	}
+}
+
+// handleReadIndexReads serves the reads of the confirmed rounds whose read index is applied, fails the rounds which
+// can't be confirmed any more, and drops the expired reads.
+func (d *peerMsgHandler) handleReadIndexReads(now time.Time) {
+	p := d.peer
+	if len(p.readIndexRounds) == 0 && len(p.readIndexBatch) == 0 {
+		return
+	}
+	p.readIndexBatch = dropExpiredReads(p.readIndexBatch, now, d.regionID())
+	// The data of a snapshot being applied is incomplete.
+	applying := p.IsApplyingSnapshot()
+	applied := p.Store().AppliedIndex()
+	rounds := p.readIndexRounds[:0]
+	for _, round := range p.readIndexRounds {
+		switch {
+		case round.index != 0 && !applying && round.index <= applied:
+			for _, read := range round.reads {
+				d.serveRead(read, applied)
+			}
+		case round.index == 0 && (round.term != p.Term() || !p.IsLeader()):
+			// Raft drops the pending rounds when the leader steps down.
+			var err error = &ErrStaleCommand{}
+			if !p.IsLeader() {
+				err = p.notLeaderErr()
+			}
+			for _, read := range round.reads {
+				read.cb.Done(ErrResp(err))
+			}
+		default:
+			if round.reads = dropExpiredReads(round.reads, now, d.regionID()); len(round.reads) > 0 {
+				rounds = append(rounds, round)
+			}
+		}
+	}
+	for i := len(rounds); i < len(p.readIndexRounds); i++ {
+		p.readIndexRounds[i] = nil
+	}
+	p.readIndexRounds = rounds
+}
+
+func dropExpiredReads(reads []*pendingRead, now time.Time, regionID uint64) []*pendingRead {
+	pending := reads[:0]
+	for _, read := range reads {
+		if isExpiredRead(read.req, now) {
+			read.cb.Done(ErrResp(&ErrDeadlineExceeded{RegionId: regionID}))
+			continue
+		}
+		pending = append(pending, read)
+	}
+	for i := len(pending); i < len(reads); i++ {
+		reads[i] = nil
+	}
+	return pending
+}
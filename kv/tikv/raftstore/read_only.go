@@ -0,0 +1,43 @@
+package raftstore
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+)
+
+// readOnlyState is the read-only mode set through the scheduler, the store learns it from the store heartbeat
+// responses and rejects the writes to the whole cluster or to the regions overlapping the read-only ranges.
+type readOnlyState struct {
+	mu      sync.RWMutex
+	cluster bool
+	ranges  []*pdpb.KeyRange
+}
+
+func (s *readOnlyState) update(resp *pdpb.StoreHeartbeatResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cluster = resp.ClusterReadOnly
+	s.ranges = resp.ReadOnlyRanges
+}
+
+func (s *readOnlyState) isReadOnly(region *metapb.Region) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.cluster {
+		return true
+	}
+	for _, r := range s.ranges {
+		if rangeOverlaps(r.StartKey, r.EndKey, region.StartKey, region.EndKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// rangeOverlaps returns whether [start1, end1) and [start2, end2) overlap, an empty end key is unbounded.
+func rangeOverlaps(start1, end1, start2, end2 []byte) bool {
+	return (len(end2) == 0 || bytes.Compare(start1, end2) < 0) && (len(end1) == 0 || bytes.Compare(start2, end1) < 0)
+}
@@ -0,0 +1,44 @@
+package raftstore
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadOnlyState(t *testing.T) {
+	state := new(readOnlyState)
+	region := &metapb.Region{StartKey: []byte("b"), EndKey: []byte("d")}
+	assert.False(t, state.isReadOnly(region))
+
+	state.update(&pdpb.StoreHeartbeatResponse{ClusterReadOnly: true})
+	assert.True(t, state.isReadOnly(region))
+
+	cases := []struct {
+		start, end []byte
+		readOnly   bool
+	}{
+		{[]byte("a"), []byte("b"), false},
+		{[]byte("a"), []byte("c"), true},
+		{[]byte("c"), nil, true},
+		{[]byte("d"), nil, false},
+		{nil, nil, true},
+	}
+	for _, c := range cases {
+		state.update(&pdpb.StoreHeartbeatResponse{
+			ReadOnlyRanges: []*pdpb.KeyRange{{StartKey: c.start, EndKey: c.end}},
+		})
+		assert.Equal(t, c.readOnly, state.isReadOnly(region), "range [%q, %q)", c.start, c.end)
+	}
+
+	// The region without an end key spans to the end.
+	region.EndKey = nil
+	state.update(&pdpb.StoreHeartbeatResponse{ReadOnlyRanges: []*pdpb.KeyRange{{StartKey: []byte("x")}}})
+	assert.True(t, state.isReadOnly(region))
+
+	// An empty response leaves the read-only mode.
+	state.update(&pdpb.StoreHeartbeatResponse{})
+	assert.False(t, state.isReadOnly(region))
+}
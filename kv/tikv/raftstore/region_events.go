@@ -0,0 +1,116 @@
+package raftstore
+
+import (
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+)
+
+// regionEventBufferSize is the number of events buffered for a watcher before it's dropped for falling behind.
+const regionEventBufferSize = 256
+
+// RegionWatcher receives the events of the regions overlapping the ranges it watches. Events is closed once the
+// watcher falls behind, the client has to reload the regions and watch again.
+type RegionWatcher struct {
+	Events chan *kvrpcpb.RegionEvent
+	ranges []*kvrpcpb.KeyRange
+}
+
+func (w *RegionWatcher) interested(event *kvrpcpb.RegionEvent) bool {
+	if len(w.ranges) == 0 {
+		return true
+	}
+	for _, region := range event.Regions {
+		for _, r := range w.ranges {
+			if rangeOverlaps(r.StartKey, r.EndKey, region.StartKey, region.EndKey) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// RegionEventHub pushes the changes of the regions led by this store to the clients watching them, so they can update
+// their region caches right away instead of finding out from NotLeader and EpochNotMatch errors. Publishing never
+// blocks the raft workers, a watcher whose buffer is full is dropped.
+type RegionEventHub struct {
+	mu       sync.Mutex
+	watchers map[*RegionWatcher]struct{}
+}
+
+func NewRegionEventHub() *RegionEventHub {
+	return &RegionEventHub{watchers: make(map[*RegionWatcher]struct{})}
+}
+
+// Watch registers a watcher of the regions overlapping the ranges, no ranges watch all the regions.
+func (h *RegionEventHub) Watch(ranges []*kvrpcpb.KeyRange) *RegionWatcher {
+	w := &RegionWatcher{Events: make(chan *kvrpcpb.RegionEvent, regionEventBufferSize), ranges: ranges}
+	h.mu.Lock()
+	h.watchers[w] = struct{}{}
+	h.mu.Unlock()
+	return w
+}
+
+// Unwatch unregisters the watcher if it's not dropped yet.
+func (h *RegionEventHub) Unwatch(w *RegionWatcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.removeLocked(w)
+}
+
+func (h *RegionEventHub) removeLocked(w *RegionWatcher) {
+	if _, ok := h.watchers[w]; ok {
+		delete(h.watchers, w)
+		close(w.Events)
+	}
+}
+
+func (h *RegionEventHub) publish(event *kvrpcpb.RegionEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for w := range h.watchers {
+		if !w.interested(event) {
+			continue
+		}
+		select {
+		case w.Events <- event:
+		default:
+			h.removeLocked(w)
+		}
+	}
+}
+
+func (h *RegionEventHub) publishLeaderChange(region *metapb.Region, leader *metapb.Peer) {
+	h.publish(&kvrpcpb.RegionEvent{
+		Type:    kvrpcpb.RegionEventType_RegionLeaderChange,
+		Regions: []*metapb.Region{region},
+		Leader:  leader,
+	})
+}
+
+func (h *RegionEventHub) publishConfChange(region *metapb.Region, leader *metapb.Peer) {
+	h.publish(&kvrpcpb.RegionEvent{
+		Type:    kvrpcpb.RegionEventType_RegionConfChange,
+		Regions: []*metapb.Region{region},
+		Leader:  leader,
+	})
+}
+
+// publishSplit publishes a split with the leader of the split region, which is likely to lead the new regions too.
+func (h *RegionEventHub) publishSplit(regions []*metapb.Region, leader *metapb.Peer) {
+	h.publish(&kvrpcpb.RegionEvent{
+		Type:    kvrpcpb.RegionEventType_RegionSplit,
+		Regions: regions,
+		Leader:  leader,
+	})
+}
+
+// publishMerge publishes a merge with the merged region and then the source region merged into it, which is gone.
+func (h *RegionEventHub) publishMerge(region, source *metapb.Region, leader *metapb.Peer) {
+	h.publish(&kvrpcpb.RegionEvent{
+		Type:    kvrpcpb.RegionEventType_RegionMerge,
+		Regions: []*metapb.Region{region, source},
+		Leader:  leader,
+	})
+}
@@ -0,0 +1,54 @@
+package raftstore
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegionEventHub(t *testing.T) {
+	hub := NewRegionEventHub()
+	all := hub.Watch(nil)
+	ranged := hub.Watch([]*kvrpcpb.KeyRange{{StartKey: []byte("m"), EndKey: []byte("p")}})
+
+	leader := &metapb.Peer{Id: 2, StoreId: 1}
+	hub.publishLeaderChange(&metapb.Region{Id: 1, StartKey: []byte("a"), EndKey: []byte("c")}, leader)
+	hub.publishSplit([]*metapb.Region{
+		{Id: 2, StartKey: []byte("k"), EndKey: []byte("n")},
+		{Id: 3, StartKey: []byte("n")},
+	}, leader)
+	hub.publishMerge(&metapb.Region{Id: 3, StartKey: []byte("k")}, &metapb.Region{Id: 2, StartKey: []byte("k"), EndKey: []byte("n")}, leader)
+
+	// A watcher gets only the events of the regions overlapping its ranges.
+	require.Len(t, all.Events, 3)
+	assert.Equal(t, kvrpcpb.RegionEventType_RegionLeaderChange, (<-all.Events).Type)
+	assert.Equal(t, kvrpcpb.RegionEventType_RegionSplit, (<-all.Events).Type)
+	assert.Equal(t, kvrpcpb.RegionEventType_RegionMerge, (<-all.Events).Type)
+	require.Len(t, ranged.Events, 2)
+	event := <-ranged.Events
+	assert.Len(t, event.Regions, 2)
+	assert.Equal(t, leader, event.Leader)
+	event = <-ranged.Events
+	assert.Equal(t, kvrpcpb.RegionEventType_RegionMerge, event.Type)
+	assert.Equal(t, []uint64{3, 2}, []uint64{event.Regions[0].Id, event.Regions[1].Id})
+
+	// A watcher which falls behind is dropped and its events are closed.
+	region := &metapb.Region{Id: 1, StartKey: []byte("a"), EndKey: []byte("c")}
+	for i := 0; i <= regionEventBufferSize; i++ {
+		hub.publishConfChange(region, leader)
+	}
+	assert.Len(t, ranged.Events, 0)
+	n := 0
+	for range all.Events {
+		n++
+	}
+	assert.Equal(t, regionEventBufferSize, n)
+
+	hub.Unwatch(all)
+	hub.Unwatch(ranged)
+	_, ok := <-ranged.Events
+	assert.False(t, ok)
+}
@@ -0,0 +1,161 @@
+package raftstore
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/kv/util/metrics"
+)
+
+// regionOtherLabel is the region label of the series which aggregates the regions not among the most active ones.
+const regionOtherLabel = "other"
+
+var (
+	regionProposalsPendingGauge = metrics.NewGaugeVec(metrics.Desc{
+		Subsystem: "raftstore",
+		Name:      "region_proposals_pending",
+		Help:      "Number of proposed but not yet committed raft log entries of the region.",
+		Labels:    []string{"region"},
+	}, "region")
+
+	regionCommitApplyGapGauge = metrics.NewGaugeVec(metrics.Desc{
+		Subsystem: "raftstore",
+		Name:      "region_commit_apply_gap",
+		Help:      "Number of committed but not yet applied raft log entries of the region.",
+		Labels:    []string{"region"},
+	}, "region")
+
+	regionLeaderChangesGauge = metrics.NewGaugeVec(metrics.Desc{
+		Subsystem: "raftstore",
+		Name:      "region_leader_changes",
+		Help:      "Number of leader changes of the region observed by this store.",
+		Labels:    []string{"region"},
+	}, "region")
+)
+
+type regionStat struct {
+	proposalsPending uint64
+	commitApplyGap   uint64
+	leaderChanges    uint64
+	leader           uint64
+	// The proposals and leader changes since the last export, it ranks the regions by activity.
+	activity uint64
+}
+
+// regionMetrics exports raft metrics per region. Labelling every region would explode the number of series on a store
+// with many regions, so only the topN most active regions since the last export get their own series, the rest are
+// summed up under the "other" region.
+type regionMetrics struct {
+	mu       sync.Mutex
+	topN     int
+	stats    map[uint64]*regionStat
+	exported map[uint64]struct{}
+}
+
+func newRegionMetrics(topN int) *regionMetrics {
+	return &regionMetrics{
+		topN:     topN,
+		stats:    make(map[uint64]*regionStat),
+		exported: make(map[uint64]struct{}),
+	}
+}
+
+func (m *regionMetrics) getStat(regionID uint64) *regionStat {
+	stat, ok := m.stats[regionID]
+	if !ok {
+		stat = new(regionStat)
+		m.stats[regionID] = stat
+	}
+	return stat
+}
+
+// observeRaftState records the raft log progress of the region.
+func (m *regionMetrics) observeRaftState(regionID, lastIndex, committed, applied uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stat := m.getStat(regionID)
+	stat.proposalsPending, stat.commitApplyGap = 0, 0
+	if lastIndex > committed {
+		stat.proposalsPending = lastIndex - committed
+	}
+	if committed > applied {
+		stat.commitApplyGap = committed - applied
+	}
+}
+
+func (m *regionMetrics) observePropose(regionID uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.getStat(regionID).activity++
+}
+
+func (m *regionMetrics) observeLeader(regionID, leader uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stat := m.getStat(regionID)
+	if stat.leader != leader {
+		if stat.leader != 0 {
+			stat.leaderChanges++
+			stat.activity++
+		}
+		stat.leader = leader
+	}
+}
+
+func (m *regionMetrics) remove(regionID uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.stats, regionID)
+}
+
+// export updates the gauges and resets the activity of the regions.
+func (m *regionMetrics) export() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	regionIDs := make([]uint64, 0, len(m.stats))
+	for regionID := range m.stats {
+		regionIDs = append(regionIDs, regionID)
+	}
+	sort.Slice(regionIDs, func(i, j int) bool {
+		si, sj := m.stats[regionIDs[i]], m.stats[regionIDs[j]]
+		if si.activity+si.commitApplyGap != sj.activity+sj.commitApplyGap {
+			return si.activity+si.commitApplyGap > sj.activity+sj.commitApplyGap
+		}
+		return regionIDs[i] < regionIDs[j]
+	})
+
+	exported := make(map[uint64]struct{}, m.topN)
+	var other regionStat
+	for i, regionID := range regionIDs {
+		stat := m.stats[regionID]
+		stat.activity = 0
+		if i >= m.topN {
+			other.proposalsPending += stat.proposalsPending
+			other.commitApplyGap += stat.commitApplyGap
+			other.leaderChanges += stat.leaderChanges
+			continue
+		}
+		exported[regionID] = struct{}{}
+		setRegionGauges(strconv.FormatUint(regionID, 10), stat)
+	}
+	for regionID := range m.exported {
+		if _, ok := exported[regionID]; !ok {
+			deleteRegionGauges(strconv.FormatUint(regionID, 10))
+		}
+	}
+	m.exported = exported
+	setRegionGauges(regionOtherLabel, &other)
+}
+
+func setRegionGauges(label string, stat *regionStat) {
+	regionProposalsPendingGauge.WithLabelValues(label).Set(float64(stat.proposalsPending))
+	regionCommitApplyGapGauge.WithLabelValues(label).Set(float64(stat.commitApplyGap))
+	regionLeaderChangesGauge.WithLabelValues(label).Set(float64(stat.leaderChanges))
+}
+
+func deleteRegionGauges(label string) {
+	regionProposalsPendingGauge.DeleteLabelValues(label)
+	regionCommitApplyGapGauge.DeleteLabelValues(label)
+	regionLeaderChangesGauge.DeleteLabelValues(label)
+}
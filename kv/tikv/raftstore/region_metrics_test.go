@@ -0,0 +1,39 @@
+package raftstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegionMetrics(t *testing.T) {
+	m := newRegionMetrics(2)
+	for regionID := uint64(1); regionID <= 4; regionID++ {
+		m.observeRaftState(regionID, 10, 8, 8)
+		m.observeLeader(regionID, 1)
+	}
+	// The first leader observed is not a change.
+	assert.Equal(t, uint64(0), m.stats[1].leaderChanges)
+	assert.Equal(t, uint64(2), m.stats[1].proposalsPending)
+
+	// Region 3 is the most active, region 4 has the largest apply backlog.
+	m.observePropose(3)
+	m.observePropose(3)
+	m.observeLeader(3, 2)
+	m.observeLeader(3, 2)
+	assert.Equal(t, uint64(1), m.stats[3].leaderChanges)
+	m.observeRaftState(4, 10, 10, 7)
+	m.export()
+	assert.Equal(t, map[uint64]struct{}{3: {}, 4: {}}, m.exported)
+	assert.Equal(t, uint64(0), m.stats[3].activity)
+
+	// The activity is ranked since the last export only.
+	m.observePropose(1)
+	m.observeRaftState(4, 10, 10, 10)
+	m.export()
+	assert.Equal(t, map[uint64]struct{}{1: {}, 2: {}}, m.exported)
+
+	m.remove(1)
+	m.export()
+	assert.Equal(t, map[uint64]struct{}{2: {}, 3: {}}, m.exported)
+}
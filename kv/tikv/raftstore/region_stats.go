@@ -0,0 +1,56 @@
+package raftstore
+
+import (
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+)
+
+// regionStats are the approximate statistics of the data of a region. Instead of scanning the region for them, the
+// leader adds up the deltas of the write commands it applies. The deltas can't tell an overwrite from a new key, nor
+// the size of a deleted value, so the statistics drift, and a scan of the region reconciles them once in a while.
+type regionStats struct {
+	// Bytes of the keys and values in all the column families.
+	size int64
+	// Number of the entries in all the column families.
+	keys int64
+	// Number of the MVCC versions, the entries in the write column family.
+	versions int64
+}
+
+func (s *regionStats) onPut(cf string, key, value []byte) {
+	s.size += int64(len(key) + len(value))
+	s.keys++
+	if cf == engine_util.CF_WRITE {
+		s.versions++
+	}
+}
+
+func (s *regionStats) onDelete(cf string, key []byte) {
+	s.size -= int64(len(key))
+	s.keys--
+	if cf == engine_util.CF_WRITE {
+		s.versions--
+	}
+}
+
+// add adds the delta, a delta overcounting the deletes doesn't take the statistics below 0.
+func (s *regionStats) add(delta regionStats) {
+	s.size = addNonNegative(s.size, delta.size)
+	s.keys = addNonNegative(s.keys, delta.keys)
+	s.versions = addNonNegative(s.versions, delta.versions)
+}
+
+func addNonNegative(a, b int64) int64 {
+	if a+b < 0 {
+		return 0
+	}
+	return a + b
+}
+
+// onScan adds an entry found by a scan reconciling the statistics, only the key and the value size are read.
+func (s *regionStats) onScan(cf string, item *engine_util.CFItem) {
+	s.size += int64(len(item.Key()) + item.ValueSize())
+	s.keys++
+	if cf == engine_util.CF_WRITE {
+		s.versions++
+	}
+}
@@ -0,0 +1,47 @@
+package raftstore
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegionStats(t *testing.T) {
+	engines := newTestEngines(t)
+	defer cleanUpTestEngineData(engines)
+
+	// The deltas of the writes.
+	var delta regionStats
+	delta.onPut(engine_util.CF_DEFAULT, []byte("k1"), []byte("value"))
+	delta.onPut(engine_util.CF_WRITE, []byte("k1"), []byte("w"))
+	delta.onPut(engine_util.CF_WRITE, []byte("k2"), []byte("w"))
+	delta.onDelete(engine_util.CF_LOCK, []byte("k1"))
+	assert.Equal(t, regionStats{size: 7 + 3 + 3 - 2, keys: 2, versions: 2}, delta)
+
+	// A delta overcounting the deletes doesn't take the statistics below 0.
+	stats := regionStats{size: 4, keys: 1, versions: 0}
+	stats.add(regionStats{size: -5, keys: -1, versions: -1})
+	assert.Equal(t, regionStats{}, stats)
+
+	wb := new(engine_util.WriteBatch)
+	wb.SetCF(engine_util.CF_DEFAULT, []byte("a"), []byte("outside"))
+	wb.SetCF(engine_util.CF_DEFAULT, []byte("b1"), []byte("value"))
+	wb.SetCF(engine_util.CF_WRITE, []byte("b1"), []byte("w"))
+	wb.SetCF(engine_util.CF_WRITE, []byte("b2"), []byte("w"))
+	wb.SetCF(engine_util.CF_LOCK, []byte("b3"), []byte("lock"))
+	wb.SetCF(engine_util.CF_WRITE, []byte("d"), []byte("outside"))
+	require.Nil(t, wb.WriteToDB(engines.Kv))
+
+	// The split check counts the keys of the range in all the column families, unless it finds split keys.
+	handler := newSplitCheckHandler(engines.Kv, nil, config.NewDefaultSplitCheckConfig())
+	keys, scanned := handler.splitCheck(nil, []byte("b"), []byte("d"))
+	assert.Nil(t, keys)
+	assert.Equal(t, &regionStats{size: 7 + 3 + 3 + 6, keys: 4, versions: 2}, scanned)
+	checker := newKeysSplitChecker(0, 0, 1)
+	keys, scanned = handler.splitCheck([]splitChecker{checker}, []byte("b"), []byte("d"))
+	assert.Equal(t, [][]byte{[]byte("b1")}, keys)
+	assert.Nil(t, scanned)
+}
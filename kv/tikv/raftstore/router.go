@@ -5,6 +5,7 @@ import (
 
 	"go.uber.org/atomic"
 
+	"github.com/pingcap-incubator/tinykv/kv/tikv/config"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/message"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
 
@@ -13,24 +14,36 @@ import (
 
 // router routes a message to a peer.
 type router struct {
+	cfg           *config.Config
 	peers         sync.Map
-	workerSenders []chan message.Msg
-	storeSender   chan<- message.Msg
+	workerSenders []*msgQueue
+	storeSender   *msgQueue
 	storeFsm      *storeFsm
 }
 
-func newRouter(workerSize int, storeSender chan<- message.Msg, storeFsm *storeFsm) *router {
+func newRouter(cfg *config.Config, storeSender *msgQueue, storeFsm *storeFsm) *router {
+	workerSize := cfg.RaftWorkerCnt
 	pm := &router{
-		workerSenders: make([]chan message.Msg, workerSize),
+		cfg:           cfg,
+		workerSenders: make([]*msgQueue, workerSize),
 		storeSender:   storeSender,
 		storeFsm:      storeFsm,
 	}
 	for i := 0; i < workerSize; i++ {
-		pm.workerSenders[i] = make(chan message.Msg, 4096)
+		pm.workerSenders[i] = newMsgQueue(4096)
 	}
 	return pm
 }
 
+// pendingMsgs returns the number of the messages queued for the raft workers.
+func (pr *router) pendingMsgs() int {
+	n := 0
+	for _, q := range pr.workerSenders {
+		n += q.len()
+	}
+	return n
+}
+
 func (pr *router) get(regionID uint64) *peerState {
 	v, ok := pr.peers.Load(regionID)
 	if ok {
@@ -39,15 +52,19 @@ func (pr *router) get(regionID uint64) *peerState {
 	return nil
 }
 
+// workerSender returns the queue of the raft worker the region is bound to.
+func (pr *router) workerSender(regionID uint64) *msgQueue {
+	return pr.workerSenders[int(regionID)%len(pr.workerSenders)]
+}
+
 func (pr *router) register(peer *peerFsm) {
 	id := peer.peer.regionId
-	idx := int(id) % len(pr.workerSenders)
 	apply := newApplierFromPeer(peer)
 	newPeer := &peerState{
-		msgCh:  pr.workerSenders[idx],
-		closed: atomic.NewBool(false),
-		peer:   peer,
-		apply:  apply,
+		mailbox: newMailbox(pr.workerSender(id), pr.cfg.PeerMailboxCapacity, pr.cfg.StoreBusyBackoff),
+		closed:  atomic.NewBool(false),
+		peer:    peer,
+		apply:   apply,
 	}
 	pr.peers.Store(id, newPeer)
 }
@@ -84,7 +101,7 @@ func (pr *router) sendRaftMessage(msg *raft_serverpb.RaftMessage) error {
 }
 
 func (pr *router) sendStore(msg message.Msg) {
-	pr.storeSender <- msg
+	pr.storeSender.send(msg)
 }
 
 var errPeerNotFound = errors.New("peer not found")
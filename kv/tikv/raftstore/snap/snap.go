@@ -132,7 +132,8 @@ func NewApplyOptions(db *badger.DB, region *metapb.Region, abort *uint32) *Apply
 type Snapshot interface {
 	io.Reader
 	io.Writer
-	Build(dbSnap *badger.Txn, region *metapb.Region, snapData *rspb.RaftSnapshotData, stat *SnapStatistics, deleter SnapshotDeleter) error
+	Build(dbSnap *badger.Txn, region *metapb.Region, snapData *rspb.RaftSnapshotData, stat *SnapStatistics, deleter SnapshotDeleter,
+		status *JobStatus) error
 	Path() string
 	Exists() bool
 	Delete()
@@ -536,10 +537,17 @@ func (s *Snap) saveMetaFile() error {
 	return nil
 }
 
-func (s *Snap) Build(dbSnap *badger.Txn, region *metapb.Region, snapData *rspb.RaftSnapshotData, stat *SnapStatistics, deleter SnapshotDeleter) error {
+// Build builds the snapshot files of the region from dbSnap, it's aborted with an ApplySnapAbortError once the status
+// is cancelling. A nil status never aborts.
+func (s *Snap) Build(dbSnap *badger.Txn, region *metapb.Region, snapData *rspb.RaftSnapshotData, stat *SnapStatistics, deleter SnapshotDeleter,
+	status *JobStatus) error {
 	if s.Exists() {
 		err := s.validate()
 		if err == nil {
+			// Set snapshot meta data of the existing snapshot.
+			snapData.FileSize = s.TotalSize()
+			snapData.Version = snapshotVersion
+			snapData.Meta = s.MetaFile.Meta
 			return nil
 		}
 		log.Errorf("[region %d] file %s is corrupted, will rebuild: %v", region.Id, s.Path(), err)
@@ -554,7 +562,7 @@ func (s *Snap) Build(dbSnap *badger.Txn, region *metapb.Region, snapData *rspb.R
 		}
 	}
 
-	builder := newSnapBuilder(s.CFFiles, dbSnap, region)
+	builder := newSnapBuilder(s.CFFiles, dbSnap, region, status)
 	err := builder.build()
 	if err != nil {
 		return err
@@ -7,33 +7,56 @@ import (
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 )
 
+// abortCheckInterval is the number of the keys the builder scans between two checks of its status.
+const abortCheckInterval = 1024
+
 // snapBuilder builds snapshot files.
 type snapBuilder struct {
 	region  *metapb.Region
 	txn     *badger.Txn
 	cfFiles []*CFFile
+	status  *JobStatus
 	kvCount int
 	size    int
 }
 
-func newSnapBuilder(cfFiles []*CFFile, dbSnap *badger.Txn, region *metapb.Region) *snapBuilder {
+func newSnapBuilder(cfFiles []*CFFile, dbSnap *badger.Txn, region *metapb.Region, status *JobStatus) *snapBuilder {
 	return &snapBuilder{
 		region:  region,
 		cfFiles: cfFiles,
 		txn:     dbSnap,
+		status:  status,
+	}
+}
+
+func (b *snapBuilder) checkAbort() error {
+	if b.status == nil {
+		return nil
 	}
+	return CheckAbort(b.status)
 }
 
 func (b *snapBuilder) build() error {
 	defer b.txn.Discard()
 	startKey, endKey := b.region.StartKey, b.region.EndKey
+	// The keys deleted by range tombstones are left out, the receiver doesn't need the tombstones then.
+	tombstones, err := engine_util.LoadRangeTombstones(b.txn, b.region.Id)
+	if err != nil {
+		return err
+	}
 
 	for _, file := range b.cfFiles {
 		cf := file.CF
 		sstWriter := file.SstWriter
 
-		it := engine_util.NewCFIterator(cf, b.txn)
+		it := engine_util.NewCFIteratorWithTombstones(cf, b.txn, tombstones)
 		for it.Seek(startKey); it.Valid(); it.Next() {
+			if file.KVCount%abortCheckInterval == 0 {
+				if err := b.checkAbort(); err != nil {
+					it.Close()
+					return err
+				}
+			}
 			item := it.Item()
 			key := item.Key()
 			if engine_util.ExceedEndKey(key, endKey) {
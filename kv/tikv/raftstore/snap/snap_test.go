@@ -166,7 +166,7 @@ func doTestSnapFile(t *testing.T, dbHasData bool) {
 	snapData := new(rspb.RaftSnapshotData)
 	snapData.Region = region
 	stat := new(SnapStatistics)
-	assert.Nil(t, s1.Build(db.NewTransaction(false), region, snapData, stat, deleter))
+	assert.Nil(t, s1.Build(db.NewTransaction(false), region, snapData, stat, deleter, nil))
 
 	// Ensure that this snapshot file does exist after being built.
 	assert.True(t, s1.Exists())
@@ -273,13 +273,13 @@ func doTestSnapValidation(t *testing.T, dbHasData bool) {
 	snapData := new(rspb.RaftSnapshotData)
 	snapData.Region = region
 	stat := new(SnapStatistics)
-	assert.Nil(t, s1.Build(dbBundle, region, snapData, stat, deleter))
+	assert.Nil(t, s1.Build(dbBundle, region, snapData, stat, deleter, nil))
 	assert.True(t, s1.Exists())
 
 	s2, err := NewSnapForBuilding(snapDir, key, sizeTrack, deleter)
 	require.Nil(t, err)
 	assert.True(t, s2.Exists())
-	assert.Nil(t, s2.Build(dbBundle, region, snapData, stat, deleter))
+	assert.Nil(t, s2.Build(dbBundle, region, snapData, stat, deleter, nil))
 	assert.True(t, s2.Exists())
 }
 
@@ -303,7 +303,7 @@ func TestSnapshotCorruptionSizeOrChecksum(t *testing.T) {
 	snapData := new(rspb.RaftSnapshotData)
 	snapData.Region = region
 	stat := new(SnapStatistics)
-	assert.Nil(t, s1.Build(dbBundle, region, snapData, stat, deleter))
+	assert.Nil(t, s1.Build(dbBundle, region, snapData, stat, deleter, nil))
 
 	corruptSnapSizeIn(t, snapDir)
 	_, err = NewSnapForSending(snapDir, key, sizeTrack, deleter)
@@ -311,7 +311,7 @@ func TestSnapshotCorruptionSizeOrChecksum(t *testing.T) {
 
 	s2, err := NewSnapForBuilding(snapDir, key, sizeTrack, deleter)
 	assert.False(t, s2.Exists())
-	assert.Nil(t, s2.Build(dbBundle, region, snapData, stat, deleter))
+	assert.Nil(t, s2.Build(dbBundle, region, snapData, stat, deleter, nil))
 	assert.True(t, s2.Exists())
 
 	dstDir, err := ioutil.TempDir("", "snapshot")
@@ -446,7 +446,7 @@ func TestSnapshotCorruptionOnMetaFile(t *testing.T) {
 	snapData := new(rspb.RaftSnapshotData)
 	snapData.Region = region
 	stat := new(SnapStatistics)
-	assert.Nil(t, s1.Build(dbBundle, region, snapData, stat, deleter))
+	assert.Nil(t, s1.Build(dbBundle, region, snapData, stat, deleter, nil))
 
 	assert.Equal(t, 1, corruptSnapshotMetaFile(t, snapDir))
 	_, err = NewSnapForSending(snapDir, key, sizeTrack, deleter)
@@ -454,7 +454,7 @@ func TestSnapshotCorruptionOnMetaFile(t *testing.T) {
 
 	s2, err := NewSnapForBuilding(snapDir, key, sizeTrack, deleter)
 	assert.False(t, s2.Exists())
-	assert.Nil(t, s2.Build(dbBundle, region, snapData, stat, deleter))
+	assert.Nil(t, s2.Build(dbBundle, region, snapData, stat, deleter, nil))
 	assert.True(t, s2.Exists())
 
 	dstDir, err := ioutil.TempDir("", "snapshot")
@@ -517,7 +517,7 @@ func TestSnapMgrV2(t *testing.T) {
 	snapData := new(rspb.RaftSnapshotData)
 	snapData.Region = region
 	stat := new(SnapStatistics)
-	require.Nil(t, s1.Build(dbBundle, region, snapData, stat, deleter))
+	require.Nil(t, s1.Build(dbBundle, region, snapData, stat, deleter, nil))
 
 	s, err := NewSnapForSending(tempDir, key1, sizeTrack, deleter)
 	require.Nil(t, err)
@@ -598,7 +598,7 @@ func TestSnapDeletionOnRegistry(t *testing.T) {
 	snapData := new(rspb.RaftSnapshotData)
 	snapData.Region = region
 	stat := new(SnapStatistics)
-	require.Nil(t, s1.Build(dbBundle, region, snapData, stat, srcMgr))
+	require.Nil(t, s1.Build(dbBundle, region, snapData, stat, srcMgr, nil))
 
 	snapBin, err := snapData.Marshal()
 	require.Nil(t, err)
@@ -668,7 +668,7 @@ func TestSnapMaxTotalSize(t *testing.T) {
 	snapData := new(rspb.RaftSnapshotData)
 	s, err := snapMgr.GetSnapshotForBuilding(recvKey)
 	require.Nil(t, err)
-	require.Nil(t, s.Build(dbBundle, genTestRegion(100, 1, 1), snapData, stat, snapMgr))
+	require.Nil(t, s.Build(dbBundle, genTestRegion(100, 1, 1), snapData, stat, snapMgr, nil))
 	recvHead, err := snapData.Marshal()
 	require.Nil(t, err)
 	s, err = snapMgr.GetSnapshotForSending(recvKey)
@@ -690,7 +690,7 @@ func TestSnapMaxTotalSize(t *testing.T) {
 		require.Nil(t, err)
 		stat = new(SnapStatistics)
 		snapData = new(rspb.RaftSnapshotData)
-		require.Nil(t, s.Build(dbBundle, region, snapData, stat, snapMgr))
+		require.Nil(t, s.Build(dbBundle, region, snapData, stat, snapMgr, nil))
 
 		// TODO: this size may change in different RocksDB version.
 		snapSize := uint64(1617)
@@ -0,0 +1,106 @@
+package raftstore
+
+import (
+	"time"
+
+	"github.com/coocood/badger"
+	"github.com/ngaut/log"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/config"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"go.uber.org/atomic"
+)
+
+// storeBusy detects whether the store is busy by the messages queued for its raft workers and apply workers, by the
+// compaction backlog of the kv engine, and by the free disk space. A busy store reports it to the scheduler in the store heartbeats, and to
+// the leaders of its peers in the raft messages, so that they back off instead of piling more work on it.
+type storeBusy struct {
+	cfg    *config.Config
+	router *router
+	// The number of the messages sent to the apply workers but not handled yet.
+	pendingApplyMsgs *atomic.Int64
+	// Whether the kv engine is stalling the writes, it's refreshed by checkWriteStall.
+	writeStalled *atomic.Bool
+	// Whether the free disk space is below the reserve, it's refreshed by checkDiskFull on every store heartbeat.
+	diskFull *atomic.Bool
+}
+
+func newStoreBusy(cfg *config.Config, router *router) *storeBusy {
+	return &storeBusy{
+		cfg:              cfg,
+		router:           router,
+		pendingApplyMsgs: atomic.NewInt64(0),
+		writeStalled:     atomic.NewBool(false),
+		diskFull:         atomic.NewBool(false),
+	}
+}
+
+// checkWriteStall counts the level 0 tables of the kv engine, too many of them means the compactions fall behind and
+// badger is going to stall the writes.
+func (b *storeBusy) checkWriteStall(db *badger.DB) {
+	limit := b.cfg.StoreWriteStallL0Tables
+	if limit <= 0 {
+		b.writeStalled.Store(false)
+		return
+	}
+	l0Tables := 0
+	for _, table := range db.Tables() {
+		if table.Level == 0 {
+			l0Tables++
+		}
+	}
+	b.writeStalled.Store(l0Tables >= limit)
+}
+
+func (b *storeBusy) isWriteStalled() bool {
+	return b.writeStalled.Load()
+}
+
+// checkDiskFull compares the free disk space with the reserve, and returns whether the disk is full.
+func (b *storeBusy) checkDiskFull(free uint64) bool {
+	reserve := b.cfg.ReserveSpace
+	full := reserve > 0 && free < reserve
+	if b.diskFull.Swap(full) != full {
+		if full {
+			log.Warnf("disk full, reject writes, [free: %d, reserve: %d]", free, reserve)
+		} else {
+			log.Infof("disk no longer full, accept writes, [free: %d, reserve: %d]", free, reserve)
+		}
+	}
+	return full
+}
+
+func (b *storeBusy) isDiskFull() bool {
+	return b.diskFull.Load()
+}
+
+func (b *storeBusy) isBusy() bool {
+	if b.isWriteStalled() {
+		return true
+	}
+	if limit := b.cfg.StoreBusyApplyMsgs; limit > 0 && uint64(b.pendingApplyMsgs.Load()) > limit {
+		return true
+	}
+	if limit := b.cfg.StoreBusyRaftMsgs; limit > 0 && uint64(b.router.pendingMsgs()) > limit {
+		return true
+	}
+	return false
+}
+
+// backoff returns how long the others should back off from the store, it's 0 if the store is not busy.
+func (b *storeBusy) backoff() time.Duration {
+	if b.isBusy() {
+		return b.cfg.StoreBusyBackoff
+	}
+	return 0
+}
+
+// busyTransport attaches the backoff to the raft messages sent when the store is busy.
+type busyTransport struct {
+	Transport
+	busy *storeBusy
+}
+
+func (t *busyTransport) Send(msg *rspb.RaftMessage) error {
+	msg.BusyBackoffMs = uint64(t.busy.backoff() / time.Millisecond)
+	return t.Transport.Send(msg)
+}
@@ -0,0 +1,95 @@
+package raftstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/tikv/config"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/message"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/stretchr/testify/assert"
+)
+
+type captureTransport struct {
+	msgs []*rspb.RaftMessage
+}
+
+func (t *captureTransport) Send(msg *rspb.RaftMessage) error {
+	t.msgs = append(t.msgs, msg)
+	return nil
+}
+
+func TestStoreBusy(t *testing.T) {
+	cfg := config.NewDefaultConfig()
+	cfg.StoreBusyRaftMsgs = 2
+	cfg.StoreBusyApplyMsgs = 10
+	cfg.StoreBusyBackoff = time.Second
+	router := newRouter(cfg, newMsgQueue(16), nil)
+	busy := newStoreBusy(cfg, router)
+	trans := &captureTransport{}
+	busyTrans := &busyTransport{Transport: trans, busy: busy}
+
+	assert.Equal(t, time.Duration(0), busy.backoff())
+	assert.Nil(t, busyTrans.Send(new(rspb.RaftMessage)))
+	assert.Equal(t, uint64(0), trans.msgs[0].BusyBackoffMs)
+
+	// The messages queued for all the raft workers are counted.
+	router.workerSenders[0].send(message.NewPeerMsg(message.MsgTypeRaftMessage, 1, nil))
+	router.workerSenders[1].send(message.NewPeerMsg(message.MsgTypeTick, 2, nil))
+	assert.False(t, busy.isBusy())
+	router.workerSenders[1].send(message.NewPeerMsg(message.MsgTypeGcSnap, 2, nil))
+	assert.Equal(t, time.Second, busy.backoff())
+	assert.Nil(t, busyTrans.Send(new(rspb.RaftMessage)))
+	assert.Equal(t, uint64(1000), trans.msgs[1].BusyBackoffMs)
+
+	router.workerSenders[1].fetch(nil)
+	assert.False(t, busy.isBusy())
+	busy.pendingApplyMsgs.Add(11)
+	assert.True(t, busy.isBusy())
+
+	// 0 disables the check.
+	cfg.StoreBusyApplyMsgs = 0
+	assert.False(t, busy.isBusy())
+}
+
+func TestStoreWriteStall(t *testing.T) {
+	engines := newTestEngines(t)
+	defer cleanUpTestEngineData(engines)
+	cfg := config.NewDefaultConfig()
+	cfg.StoreWriteStallL0Tables = 1
+	busy := newStoreBusy(cfg, newRouter(cfg, newMsgQueue(16), nil))
+
+	// The engine has no level 0 tables yet.
+	busy.checkWriteStall(engines.Kv)
+	assert.False(t, busy.isWriteStalled())
+	assert.False(t, busy.isBusy())
+
+	busy.writeStalled.Store(true)
+	assert.True(t, busy.isBusy())
+	assert.Equal(t, cfg.StoreBusyBackoff, busy.backoff())
+
+	// 0 disables the check.
+	cfg.StoreWriteStallL0Tables = 0
+	busy.checkWriteStall(engines.Kv)
+	assert.False(t, busy.isWriteStalled())
+}
+
+func TestStoreDiskFull(t *testing.T) {
+	cfg := config.NewDefaultConfig()
+	cfg.ReserveSpace = 100
+	busy := newStoreBusy(cfg, newRouter(cfg, newMsgQueue(16), nil))
+
+	assert.False(t, busy.checkDiskFull(100))
+	assert.False(t, busy.isDiskFull())
+	assert.True(t, busy.checkDiskFull(99))
+	assert.True(t, busy.isDiskFull())
+	// A full disk only rejects the writes, the raft messages are sent without a backoff.
+	assert.False(t, busy.isBusy())
+
+	assert.False(t, busy.checkDiskFull(200))
+	assert.False(t, busy.isDiskFull())
+
+	// 0 disables the check.
+	cfg.ReserveSpace = 0
+	assert.False(t, busy.checkDiskFull(0))
+}
@@ -0,0 +1,58 @@
+package raftstore
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeEpoch(t *testing.T) {
+	assert.Equal(t, &metapb.RegionEpoch{ConfVer: 3, Version: 5},
+		mergeEpoch(&metapb.RegionEpoch{ConfVer: 3, Version: 2}, &metapb.RegionEpoch{ConfVer: 1, Version: 5}))
+	assert.Equal(t, &metapb.RegionEpoch{ConfVer: 3, Version: 2}, mergeEpoch(nil, &metapb.RegionEpoch{ConfVer: 3, Version: 2}))
+}
+
+func TestTombstoneRejectsStaleEpoch(t *testing.T) {
+	engines := newTestEngines(t)
+	defer cleanUpTestEngineData(engines)
+	trans := &captureTransport{}
+	d := &storeMsgHandler{ctx: &StoreContext{GlobalContext: &GlobalContext{engine: engines, trans: trans}}}
+
+	// The peer on store 1 was removed at conf version 2, but it had seen conf version 4 before it was destroyed.
+	region := &metapb.Region{
+		Id:          1,
+		RegionEpoch: &metapb.RegionEpoch{ConfVer: 2, Version: 1},
+		Peers:       []*metapb.Peer{{Id: 2, StoreId: 2}},
+	}
+	kvWB := new(engine_util.WriteBatch)
+	writeTombstoneState(kvWB, region, &metapb.RegionEpoch{ConfVer: 4, Version: 1})
+	require.Nil(t, engines.WriteKV(kvWB))
+
+	msg := func(confVer uint64) *rspb.RaftMessage {
+		return &rspb.RaftMessage{
+			RegionId:    1,
+			FromPeer:    &metapb.Peer{Id: 3, StoreId: 3},
+			ToPeer:      &metapb.Peer{Id: 1, StoreId: 1},
+			RegionEpoch: &metapb.RegionEpoch{ConfVer: confVer, Version: 1},
+			Message:     &eraftpb.Message{MsgType: eraftpb.MessageType_MsgRequestVote},
+		}
+	}
+
+	// A peer which only knows the epoch the region had when it was destroyed is stale.
+	drop, err := d.checkMsg(msg(3))
+	assert.Nil(t, err)
+	assert.True(t, drop)
+	require.Len(t, trans.msgs, 1)
+	assert.True(t, trans.msgs[0].IsTombstone)
+	assert.Equal(t, uint64(4), trans.msgs[0].RegionEpoch.ConfVer)
+
+	// The peer may be added back at a newer epoch.
+	drop, err = d.checkMsg(msg(5))
+	assert.Nil(t, err)
+	assert.False(t, drop)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/ngaut/log"
@@ -11,6 +12,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/util/codec"
 )
 
 const RaftInvalidIndex uint64 = 0
@@ -58,11 +60,60 @@ func CheckKeyInRegionInclusive(key []byte, region *metapb.Region) error {
 	}
 }
 
+// checkSplitKeys checks that the split keys are memcomparable encoded like the region boundaries, and strictly
+// increasing inside the range (`start_key`, `end_key`) of the region. A split with bad keys fails to apply, so it's
+// rejected before it's proposed.
+func checkSplitKeys(splitKeys [][]byte, region *metapb.Region) error {
+	if len(splitKeys) == 0 {
+		return errors.New("no split key is specified")
+	}
+	for i, key := range splitKeys {
+		if len(key) == 0 {
+			return errors.New("split key should not be empty")
+		}
+		if remain, _, err := codec.DecodeBytes(key, nil); err != nil || len(remain) != 0 {
+			return errors.Errorf("split key %x is not encoded", key)
+		}
+		if i > 0 && bytes.Compare(key, splitKeys[i-1]) <= 0 {
+			return errors.Errorf("split key %x is not greater than the previous one %x", key, splitKeys[i-1])
+		}
+		if err := CheckKeyInRegionExclusive(key, region); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkBatchSplit checks a split before it's proposed, the same as it's checked when it's applied.
+func checkBatchSplit(splits *raft_cmdpb.BatchSplitRequest, region *metapb.Region) error {
+	keys := make([][]byte, 0, len(splits.GetRequests()))
+	for _, request := range splits.GetRequests() {
+		if len(request.NewPeerIds) != len(region.Peers) {
+			return errors.Errorf("invalid new peer id count, need %d but got %d",
+				len(region.Peers), len(request.NewPeerIds))
+		}
+		keys = append(keys, request.SplitKey)
+	}
+	return checkSplitKeys(keys, region)
+}
+
 /// check whether epoch is staler than check_epoch.
 func IsEpochStale(epoch *metapb.RegionEpoch, checkEpoch *metapb.RegionEpoch) bool {
 	return epoch.Version < checkEpoch.Version || epoch.ConfVer < checkEpoch.ConfVer
 }
 
+// mergeEpoch returns the epoch with the larger version and the larger conf version of the two, either may be nil.
+func mergeEpoch(epoch, other *metapb.RegionEpoch) *metapb.RegionEpoch {
+	merged := &metapb.RegionEpoch{ConfVer: epoch.GetConfVer(), Version: epoch.GetVersion()}
+	if other.GetConfVer() > merged.ConfVer {
+		merged.ConfVer = other.GetConfVer()
+	}
+	if other.GetVersion() > merged.Version {
+		merged.Version = other.GetVersion()
+	}
+	return merged
+}
+
 func CheckRegionEpoch(req *raft_cmdpb.RaftCmdRequest, region *metapb.Region, includeRegion bool) error {
 	checkVer, checkConfVer := false, false
 	if req.AdminRequest == nil {
@@ -72,6 +123,9 @@ func CheckRegionEpoch(req *raft_cmdpb.RaftCmdRequest, region *metapb.Region, inc
 		case raft_cmdpb.AdminCmdType_CompactLog, raft_cmdpb.AdminCmdType_InvalidAdmin:
 		case raft_cmdpb.AdminCmdType_ChangePeer:
 			checkConfVer = true
+		case raft_cmdpb.AdminCmdType_DeletePrefix, raft_cmdpb.AdminCmdType_DeleteRange:
+			// the deleted range is clipped by the region, like the keys of a write.
+			checkVer = true
 		case raft_cmdpb.AdminCmdType_BatchSplit, raft_cmdpb.AdminCmdType_TransferLeader:
 			checkVer = true
 			checkConfVer = true
@@ -170,8 +224,14 @@ func checkRegionEpoch(req *raft_cmdpb.RaftCmdRequest, region *metapb.Region, inc
 		case raft_cmdpb.AdminCmdType_CompactLog, raft_cmdpb.AdminCmdType_InvalidAdmin:
 		case raft_cmdpb.AdminCmdType_ChangePeer:
 			checkConfVer = true
+		case raft_cmdpb.AdminCmdType_DeletePrefix, raft_cmdpb.AdminCmdType_DeleteRange:
+			// the deleted range is clipped by the region, like the keys of a write.
+			checkVer = true
 		case raft_cmdpb.AdminCmdType_BatchSplit,
-			raft_cmdpb.AdminCmdType_TransferLeader:
+			raft_cmdpb.AdminCmdType_TransferLeader,
+			raft_cmdpb.AdminCmdType_PrepareMerge,
+			raft_cmdpb.AdminCmdType_CommitMerge,
+			raft_cmdpb.AdminCmdType_RollbackMerge:
 			checkVer = true
 			checkConfVer = true
 		}
@@ -234,6 +294,38 @@ func checkPeerID(req *raft_cmdpb.RaftCmdRequest, peerID uint64) error {
 	return errors.Errorf("mismatch peer id %d != %d", peer.Id, peerID)
 }
 
+// isExpiredRead returns whether req is a read request whose deadline has passed at now. Reads don't change the state
+// machine, so an expired one can be dropped without executing it.
+func isExpiredRead(req *raft_cmdpb.RaftCmdRequest, now time.Time) bool {
+	deadline := req.GetHeader().GetDeadlineMs()
+	if deadline == 0 || req.AdminRequest != nil || len(req.Requests) == 0 {
+		return false
+	}
+	for _, r := range req.Requests {
+		if r.CmdType != raft_cmdpb.CmdType_Get && r.CmdType != raft_cmdpb.CmdType_Snap {
+			return false
+		}
+	}
+	return uint64(now.UnixNano()/int64(time.Millisecond)) >= deadline
+}
+
+// hasWriteRequest returns whether req writes to the data of the kv engine. Every request but a read is a write, and
+// so are the admin requests deleting the keys of a range.
+func hasWriteRequest(req *raft_cmdpb.RaftCmdRequest) bool {
+	if req.AdminRequest != nil {
+		switch req.AdminRequest.CmdType {
+		case raft_cmdpb.AdminCmdType_DeletePrefix, raft_cmdpb.AdminCmdType_DeleteRange:
+			return true
+		}
+	}
+	for _, r := range req.Requests {
+		if r.CmdType != raft_cmdpb.CmdType_Get && r.CmdType != raft_cmdpb.CmdType_Snap {
+			return true
+		}
+	}
+	return false
+}
+
 func CloneMsg(origin, cloned proto.Message) error {
 	data, err := proto.Marshal(origin)
 	if err != nil {
@@ -245,3 +337,15 @@ func CloneMsg(origin, cloned proto.Message) error {
 func safeCopy(b []byte) []byte {
 	return append([]byte{}, b...)
 }
+
+// prefixNext returns the smallest key greater than all the keys starting with the prefix, or nil if there is none.
+func prefixNext(prefix []byte) []byte {
+	next := append([]byte{}, prefix...)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			return next[:i+1]
+		}
+	}
+	return nil
+}
@@ -2,10 +2,12 @@ package raftstore
 
 import (
 	"testing"
+	"time"
 
 	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+	"github.com/pingcap/tidb/util/codec"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -191,3 +193,69 @@ func cloneEpoch(epoch *metapb.RegionEpoch) *metapb.RegionEpoch {
 		Version: epoch.Version,
 	}
 }
+
+func TestIsExpiredRead(t *testing.T) {
+	now := time.Unix(100, 0)
+	read := func(deadlineMs uint64, cmdTypes ...raft_cmdpb.CmdType) *raft_cmdpb.RaftCmdRequest {
+		req := &raft_cmdpb.RaftCmdRequest{Header: &raft_cmdpb.RaftRequestHeader{DeadlineMs: deadlineMs}}
+		for _, tp := range cmdTypes {
+			req.Requests = append(req.Requests, &raft_cmdpb.Request{CmdType: tp})
+		}
+		return req
+	}
+
+	assert.False(t, isExpiredRead(read(0, raft_cmdpb.CmdType_Snap), now))
+	assert.False(t, isExpiredRead(read(100001, raft_cmdpb.CmdType_Snap), now))
+	assert.True(t, isExpiredRead(read(100000, raft_cmdpb.CmdType_Snap), now))
+	assert.True(t, isExpiredRead(read(99999, raft_cmdpb.CmdType_Get, raft_cmdpb.CmdType_Get), now))
+	// Writes are always executed.
+	assert.False(t, isExpiredRead(read(99999, raft_cmdpb.CmdType_Put), now))
+	assert.False(t, isExpiredRead(read(99999), now))
+}
+
+func TestHasWriteRequest(t *testing.T) {
+	req := func(cmdTypes ...raft_cmdpb.CmdType) *raft_cmdpb.RaftCmdRequest {
+		req := new(raft_cmdpb.RaftCmdRequest)
+		for _, tp := range cmdTypes {
+			req.Requests = append(req.Requests, &raft_cmdpb.Request{CmdType: tp})
+		}
+		return req
+	}
+	admin := func(tp raft_cmdpb.AdminCmdType) *raft_cmdpb.RaftCmdRequest {
+		return &raft_cmdpb.RaftCmdRequest{AdminRequest: &raft_cmdpb.AdminRequest{CmdType: tp}}
+	}
+
+	assert.False(t, hasWriteRequest(req(raft_cmdpb.CmdType_Get, raft_cmdpb.CmdType_Snap)))
+	assert.True(t, hasWriteRequest(req(raft_cmdpb.CmdType_Get, raft_cmdpb.CmdType_Put)))
+	assert.True(t, hasWriteRequest(req(raft_cmdpb.CmdType_Delete)))
+	assert.True(t, hasWriteRequest(admin(raft_cmdpb.AdminCmdType_DeletePrefix)))
+	assert.True(t, hasWriteRequest(admin(raft_cmdpb.AdminCmdType_DeleteRange)))
+	// The admin requests changing the region meta only are taken by a busy or read only store.
+	assert.False(t, hasWriteRequest(admin(raft_cmdpb.AdminCmdType_TransferLeader)))
+	assert.False(t, hasWriteRequest(admin(raft_cmdpb.AdminCmdType_CompactLog)))
+}
+
+func TestCheckSplitKeys(t *testing.T) {
+	enc := func(key string) []byte {
+		return codec.EncodeBytes(nil, []byte(key))
+	}
+	region := &metapb.Region{Id: 1, StartKey: enc("b"), EndKey: enc("e")}
+	assert.Nil(t, checkSplitKeys([][]byte{enc("c")}, region))
+	assert.Nil(t, checkSplitKeys([][]byte{enc("c"), enc("d")}, region))
+
+	assert.NotNil(t, checkSplitKeys(nil, region))
+	assert.NotNil(t, checkSplitKeys([][]byte{{}}, region))
+	// Not encoded.
+	assert.NotNil(t, checkSplitKeys([][]byte{[]byte("c")}, region))
+	assert.NotNil(t, checkSplitKeys([][]byte{append(enc("c"), 0)}, region))
+	// Not strictly inside the region.
+	assert.NotNil(t, checkSplitKeys([][]byte{enc("b")}, region))
+	assert.NotNil(t, checkSplitKeys([][]byte{enc("e")}, region))
+	assert.NotNil(t, checkSplitKeys([][]byte{enc("a")}, region))
+	// Not increasing.
+	assert.NotNil(t, checkSplitKeys([][]byte{enc("d"), enc("c")}, region))
+	assert.NotNil(t, checkSplitKeys([][]byte{enc("c"), enc("c")}, region))
+
+	region.EndKey = nil
+	assert.Nil(t, checkSplitKeys([][]byte{enc("z")}, region))
+}
@@ -1,6 +1,7 @@
 package raftstore
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"sync/atomic"
@@ -10,6 +11,7 @@ import (
 	"github.com/coocood/badger/y"
 	"github.com/ngaut/log"
 	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/config"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/message"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/snap"
@@ -19,6 +21,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
 	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/tablecodec"
 	"github.com/pingcap/tidb/util/codec"
 )
 
@@ -42,18 +45,19 @@ type splitCheckTask struct {
 }
 
 type pdAskBatchSplitTask struct {
-	region    *metapb.Region
-	splitKeys [][]byte
-	peer      *metapb.Peer
-	callback  *message.Callback
+	region     *metapb.Region
+	splitKeys  [][]byte
+	leftDerive bool
+	peer       *metapb.Peer
+	callback   *message.Callback
 }
 
 type pdRegionHeartbeatTask struct {
-	region          *metapb.Region
-	peer            *metapb.Peer
-	downPeers       []*pdpb.PeerStats
-	pendingPeers    []*metapb.Peer
-	approximateSize *uint64
+	region       *metapb.Region
+	peer         *metapb.Peer
+	downPeers    []*pdpb.PeerStats
+	pendingPeers []*metapb.Peer
+	stats        *regionStats
 }
 
 type pdStoreHeartbeatTask struct {
@@ -64,23 +68,46 @@ type pdStoreHeartbeatTask struct {
 }
 
 type splitCheckHandler struct {
-	engine  *badger.DB
-	router  *router
-	config  *config.SplitCheckConfig
-	checker *sizeSplitChecker
+	engine *badger.DB
+	router *router
+	config *config.SplitCheckConfig
 }
 
 func newSplitCheckHandler(engine *badger.DB, router *router, config *config.SplitCheckConfig) *splitCheckHandler {
 	runner := &splitCheckHandler{
-		engine:  engine,
-		router:  router,
-		config:  config,
-		checker: newSizeSplitChecker(config.RegionMaxSize, config.RegionSplitSize, config.BatchSplitLimit),
+		engine: engine,
+		router: router,
+		config: config,
 	}
 	return runner
 }
 
-/// run checks a region with split checkers to produce split keys and generates split admin command.
+// splitChecker looks for the split keys of a region by a split policy, it's fed with the keys of the region in order.
+type splitChecker interface {
+	// onKv returns true if the checker needs no more keys.
+	onKv(key []byte, item *engine_util.CFItem) bool
+	getSplitKeys() [][]byte
+}
+
+// newSplitCheckers creates the checkers of the configured policies for a check of the region starting at startKey.
+func newSplitCheckers(cfg *config.SplitCheckConfig, startKey []byte) []splitChecker {
+	checkers := make([]splitChecker, 0, len(cfg.Policies))
+	for _, policy := range cfg.Policies {
+		switch policy {
+		case config.SplitPolicySize:
+			checkers = append(checkers, newSizeSplitChecker(cfg.RegionMaxSize, cfg.RegionSplitSize, cfg.BatchSplitLimit))
+		case config.SplitPolicyKeys:
+			checkers = append(checkers, newKeysSplitChecker(cfg.RegionMaxKeys, cfg.RegionSplitKeys, cfg.BatchSplitLimit))
+		case config.SplitPolicyTable:
+			checkers = append(checkers, newTableSplitChecker(startKey, cfg.BatchSplitLimit))
+		default:
+			log.Warnf("unknown split policy %s", policy)
+		}
+	}
+	return checkers
+}
+
+// run checks a region with split checkers to produce split keys and generates split admin command.
 func (r *splitCheckHandler) Handle(t worker.Task) {
 	spCheckTask := t.Data.(*splitCheckTask)
 	region := spCheckTask.region
@@ -97,7 +124,7 @@ func (r *splitCheckHandler) Handle(t worker.Task) {
 	}
 	log.Debugf("executing split check worker.Task: [regionId: %d, startKey: %s, endKey: %s]", regionId,
 		hex.EncodeToString(startKey), hex.EncodeToString(endKey))
-	keys := r.splitCheck(startKey, endKey)
+	keys, stats := r.splitCheck(newSplitCheckers(r.config, startKey), startKey, endKey)
 	if len(keys) != 0 {
 		regionEpoch := region.GetRegionEpoch()
 		for i, k := range keys {
@@ -115,41 +142,68 @@ func (r *splitCheckHandler) Handle(t worker.Task) {
 		if err != nil {
 			log.Warnf("failed to send check result: [regionId: %d, err: %v]", regionId, err)
 		}
-	} else {
-		log.Debugf("no need to send, split key not found: [regionId: %v]", regionId)
+		return
+	}
+	log.Debugf("no need to send, split key not found: [regionId: %v]", regionId)
+	// The region isn't going to split, reconcile its statistics.
+	err = r.router.send(regionId, message.NewPeerMsg(message.MsgTypeRegionApproximateSize, regionId, stats))
+	if err != nil {
+		log.Warnf("failed to send region stats: [regionId: %d, err: %v]", regionId, err)
 	}
 }
 
-/// SplitCheck gets the split keys by scanning the range.
-func (r *splitCheckHandler) splitCheck(startKey, endKey []byte) [][]byte {
+// SplitCheck gets the split keys by scanning the range, they are the keys of the first checker finding any. If none
+// is found, the scan goes on through all the column families and returns the statistics of the range too.
+func (r *splitCheckHandler) splitCheck(checkers []splitChecker, startKey, endKey []byte) ([][]byte, *regionStats) {
 	txn := r.engine.NewTransaction(false)
 	defer txn.Discard()
 
-	it := engine_util.NewCFIterator(engine_util.CF_DEFAULT, txn)
-	defer it.Close()
-	for it.Seek(startKey); it.Valid(); it.Next() {
-		item := it.Item()
-		key := item.Key()
-		if engine_util.ExceedEndKey(key, endKey) {
-			break
+	stats := new(regionStats)
+	done := make([]bool, len(checkers))
+	remaining := len(checkers)
+	for _, cf := range engine_util.CFs {
+		it := engine_util.NewCFIterator(cf, txn)
+		for it.Seek(startKey); it.Valid(); it.Next() {
+			item := it.Item()
+			key := item.Key()
+			if engine_util.ExceedEndKey(key, endKey) {
+				break
+			}
+			stats.onScan(cf, item)
+			if cf != engine_util.CF_DEFAULT || remaining == 0 {
+				continue
+			}
+			for i, checker := range checkers {
+				if !done[i] && checker.onKv(key, item) {
+					done[i] = true
+					remaining--
+				}
+			}
+			if remaining == 0 {
+				break
+			}
 		}
-		if r.checker.onKv(key, item) {
-			break
+		it.Close()
+		if cf == engine_util.CF_DEFAULT {
+			for _, checker := range checkers {
+				if keys := checker.getSplitKeys(); len(keys) > 0 {
+					return keys, nil
+				}
+			}
 		}
 	}
-	keys := r.checker.getSplitKeys()
-	if len(keys) > 0 {
-		return keys
-	}
-	return nil
+	return nil, stats
 }
 
+// sizeSplitChecker splits a region into parts of splitSize once it exceeds maxSize. The size of a key is measured by
+// sizeOf, which is the size of the key and its value unless the keys are counted.
 type sizeSplitChecker struct {
 	maxSize         uint64
 	splitSize       uint64
 	currentSize     uint64
 	splitKeys       [][]byte
 	batchSplitLimit uint64
+	sizeOf          func(key []byte, item *engine_util.CFItem) uint64
 }
 
 func newSizeSplitChecker(maxSize, splitSize, batchSplitLimit uint64) *sizeSplitChecker {
@@ -157,12 +211,27 @@ func newSizeSplitChecker(maxSize, splitSize, batchSplitLimit uint64) *sizeSplitC
 		maxSize:         maxSize,
 		splitSize:       splitSize,
 		batchSplitLimit: batchSplitLimit,
+		sizeOf: func(key []byte, item *engine_util.CFItem) uint64 {
+			return uint64(len(key)) + uint64(item.ValueSize())
+		},
+	}
+}
+
+// newKeysSplitChecker creates a checker which splits a region into parts of splitKeys keys once it has more than
+// maxKeys keys.
+func newKeysSplitChecker(maxKeys, splitKeys, batchSplitLimit uint64) *sizeSplitChecker {
+	return &sizeSplitChecker{
+		maxSize:         maxKeys,
+		splitSize:       splitKeys,
+		batchSplitLimit: batchSplitLimit,
+		sizeOf: func([]byte, *engine_util.CFItem) uint64 {
+			return 1
+		},
 	}
 }
 
 func (checker *sizeSplitChecker) onKv(key []byte, item *engine_util.CFItem) bool {
-	valueSize := uint64(item.ValueSize())
-	size := uint64(len(key)) + valueSize
+	size := checker.sizeOf(key, item)
 	checker.currentSize += size
 	overLimit := uint64(len(checker.splitKeys)) >= checker.batchSplitLimit
 	if checker.currentSize > checker.splitSize && !overLimit {
@@ -195,20 +264,74 @@ func (checker *sizeSplitChecker) getSplitKeys() [][]byte {
 	return keys
 }
 
+// tableSplitChecker splits a region at the first key of every table in it, but the table the region starts in.
+type tableSplitChecker struct {
+	lastKey         []byte
+	splitKeys       [][]byte
+	batchSplitLimit uint64
+}
+
+func newTableSplitChecker(startKey []byte, batchSplitLimit uint64) *tableSplitChecker {
+	return &tableSplitChecker{
+		lastKey:         startKey,
+		batchSplitLimit: batchSplitLimit,
+	}
+}
+
+func (checker *tableSplitChecker) onKv(key []byte, _ *engine_util.CFItem) bool {
+	// The versions of the transactional keys are memcomparable encoded, the table prefix is taken from the user key.
+	if userKey, _, err := mvcc.DecodeKey(key); err == nil {
+		key = userKey
+	}
+	if isTableKey(key) && len(key) >= tablecodec.TableSplitKeyLen && !isSameTable(checker.lastKey, key) {
+		splitKey := safeCopy(key[:tablecodec.TableSplitKeyLen])
+		// The region may start at the prefix of the table, it's not split there.
+		if bytes.Compare(splitKey, checker.lastKey) > 0 {
+			checker.splitKeys = append(checker.splitKeys, splitKey)
+		}
+		checker.lastKey = splitKey
+	}
+	return uint64(len(checker.splitKeys)) >= checker.batchSplitLimit
+}
+
+func (checker *tableSplitChecker) getSplitKeys() [][]byte {
+	keys := checker.splitKeys
+	checker.splitKeys = nil
+	return keys
+}
+
 type snapContext struct {
 	engines   *engine_util.Engines
 	batchSize uint64
 	mgr       *snap.SnapManager
 }
 
-// handleGen handles the task of generating snapshot of the Region.
-func (snapCtx *snapContext) handleGen(regionId uint64, notifier chan<- *eraftpb.Snapshot) {
-	snap, err := doSnapshot(snapCtx.engines, snapCtx.mgr, regionId)
-	if err != nil {
+// handleGen handles the task of generating snapshot of the Region. A failed generation is notified with an empty
+// snapshot so that raft asks for another one, a cancelled one isn't notified at all.
+func (snapCtx *snapContext) handleGen(regionId uint64, notifier chan<- *eraftpb.Snapshot, status *snap.JobStatus) {
+	if !atomic.CompareAndSwapUint32(status, snap.JobStatus_Pending, snap.JobStatus_Running) {
+		log.Infof("generating snapshot is cancelled before it starts. [regionId: %d]", regionId)
+		atomic.StoreUint32(status, snap.JobStatus_Cancelled)
+		return
+	}
+	s, err := doSnapshot(snapCtx.engines, snapCtx.mgr, regionId, status)
+	switch err.(type) {
+	case nil:
+		if atomic.CompareAndSwapUint32(status, snap.JobStatus_Running, snap.JobStatus_Finished) {
+			notifier <- s
+			return
+		}
+		log.Infof("generating snapshot is cancelled. [regionId: %d]", regionId)
+	case snap.ApplySnapAbortError:
+		log.Infof("generating snapshot is cancelled. [regionId: %d]", regionId)
+	default:
 		log.Errorf("failed to generate snapshot!!!, [regionId: %d, err : %v]", regionId, err)
-	} else {
-		notifier <- snap
+		if atomic.CompareAndSwapUint32(status, snap.JobStatus_Running, snap.JobStatus_Failed) {
+			notifier <- new(eraftpb.Snapshot)
+			return
+		}
 	}
+	atomic.StoreUint32(status, snap.JobStatus_Cancelled)
 }
 
 // cleanUpOriginData clear up the region data before applying snapshot
@@ -302,6 +425,47 @@ func (snapCtx *snapContext) cleanUpRange(regionId uint64, startKey, endKey []byt
 	}
 }
 
+// cleanUpTombstones deletes the key versions hidden by the range tombstones of all the regions physically, and then
+// the tombstones. It goes on until no tombstone is left, as a split or a merge may hand a tombstone over to another
+// region meanwhile. A tombstone failing to be cleaned up is kept, it's cleaned up again by the next task or when the
+// store restarts.
+func (snapCtx *snapContext) cleanUpTombstones() {
+	for {
+		type regionTombstone struct {
+			regionId  uint64
+			tombstone engine_util.RangeTombstone
+		}
+		var tombstones []regionTombstone
+		txn := snapCtx.engines.Kv.NewTransaction(false)
+		regionIds, err := engine_util.RangeTombstoneRegions(txn)
+		for i := 0; err == nil && i < len(regionIds); i++ {
+			var ts []engine_util.RangeTombstone
+			ts, err = engine_util.LoadRangeTombstones(txn, regionIds[i])
+			for _, t := range ts {
+				tombstones = append(tombstones, regionTombstone{regionId: regionIds[i], tombstone: t})
+			}
+		}
+		txn.Discard()
+		if err != nil {
+			log.Errorf("failed to load range tombstones, err: %v", err)
+			return
+		}
+		if len(tombstones) == 0 {
+			return
+		}
+		for _, rt := range tombstones {
+			startKey, endKey := rt.tombstone.StartKey, rt.tombstone.EndKey
+			if err := engine_util.CleanUpRangeTombstone(snapCtx.engines.Kv, rt.regionId, rt.tombstone); err != nil {
+				log.Errorf("failed to clean up range tombstone, [regionId: %d, startKey: %s, endKey: %s, err: %v]",
+					rt.regionId, hex.EncodeToString(startKey), hex.EncodeToString(endKey), err)
+				return
+			}
+			log.Infof("succeed in cleaning up range tombstone. [regionId: %d, startKey: %s, endKey: %s]", rt.regionId,
+				hex.EncodeToString(startKey), hex.EncodeToString(endKey))
+		}
+	}
+}
+
 type regionApplyState struct {
 	localState *rspb.RegionLocalState
 	tableCount int
@@ -324,17 +488,42 @@ func newRegionTaskHandler(engines *engine_util.Engines, mgr *snap.SnapManager) *
 func (r *regionTaskHandler) Handle(t worker.Task) {
 	task := t.Data.(*regionTask)
 	switch t.Tp {
-	case worker.TaskTypeRegionGen:
-		// It is safe for now to handle generating and applying snapshot concurrently,
-		// but it may not when merge is implemented.
-		r.ctx.handleGen(task.regionId, task.notifier)
 	case worker.TaskTypeRegionApply:
 		r.ctx.handleApply(task.regionId, task.status)
 	case worker.TaskTypeRegionDestroy:
 		r.ctx.cleanUpRange(task.regionId, task.startKey, task.endKey)
+	case worker.TaskTypeRegionCleanUpTombstone:
+		r.ctx.cleanUpTombstones()
 	}
 }
 
+// start cleans up the range tombstones left by the last run before handling any task.
+func (r *regionTaskHandler) start() {
+	r.ctx.cleanUpTombstones()
+}
+
+// snapGenTaskHandler generates snapshots on a worker of their own. Building the snapshot of a large region takes a
+// while, on the region worker it would hold up applying snapshots and cleaning up ranges behind it.
+type snapGenTaskHandler struct {
+	ctx *snapContext
+}
+
+func newSnapGenTaskHandler(engines *engine_util.Engines, mgr *snap.SnapManager) *snapGenTaskHandler {
+	return &snapGenTaskHandler{
+		ctx: &snapContext{
+			engines: engines,
+			mgr:     mgr,
+		},
+	}
+}
+
+func (r *snapGenTaskHandler) Handle(t worker.Task) {
+	task := t.Data.(*regionTask)
+	// It is safe for now to generate and apply snapshots concurrently, the snapshot is built from a consistent
+	// view of the engine.
+	r.ctx.handleGen(task.regionId, task.notifier, task.status)
+}
+
 func (r *regionTaskHandler) shutdown() {
 	// todo, currently it is a a place holder.
 }
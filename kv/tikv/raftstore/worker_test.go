@@ -10,12 +10,15 @@ import (
 
 	"github.com/coocood/badger"
 	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/config"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/snap"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/worker"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	rspb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/tablecodec"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -130,16 +133,20 @@ func TestApplies(t *testing.T) {
 	regionWorker := worker.NewWorker("snap-manager", wg)
 	regionRunner := newRegionTaskHandler(engines, mgr)
 	regionWorker.Start(regionRunner)
+	snapGenWorker := worker.NewWorker("snap-generator", wg)
+	snapGenWorker.Start(newSnapGenTaskHandler(engines, mgr))
 	genAndApplySnap := func(regionId uint64) {
 		tx := make(chan *eraftpb.Snapshot, 1)
+		genStatus := snap.JobStatus_Pending
 		tsk := &worker.Task{
 			Tp: worker.TaskTypeRegionGen,
 			Data: &regionTask{
 				regionId: regionId,
 				notifier: tx,
+				status:   &genStatus,
 			},
 		}
-		regionWorker.Sender() <- *tsk
+		snapGenWorker.Sender() <- *tsk
 		s1 := <-tx
 		data := s1.Data
 		key := snap.SnapKeyFromRegionSnap(regionId, s1)
@@ -307,3 +314,71 @@ func raftLogMustExist(t *testing.T, db *badger.DB, regionId, startIdx, endIdx ui
 		})
 	}
 }
+
+func TestSplitPolicies(t *testing.T) {
+	engines := newTestEngines(t)
+	defer cleanUpTestEngineData(engines)
+
+	// 10 rows of table 1 and 10 rows of table 2, 100 bytes each.
+	wb := new(engine_util.WriteBatch)
+	for tableID := int64(1); tableID <= 2; tableID++ {
+		for handle := int64(0); handle < 10; handle++ {
+			wb.SetCF(engine_util.CF_DEFAULT, tablecodec.EncodeRowKeyWithHandle(tableID, handle), make([]byte, 100))
+		}
+	}
+	require.Nil(t, wb.WriteToDB(engines.Kv))
+
+	cfg := config.NewDefaultSplitCheckConfig()
+	cfg.RegionMaxKeys, cfg.RegionSplitKeys = 15, 10
+	cfg.RegionMaxSize, cfg.RegionSplitSize = 30*120, 20*120
+	handler := newSplitCheckHandler(engines.Kv, nil, cfg)
+	check := func(policies ...string) [][]byte {
+		cfg.Policies = policies
+		keys, _ := handler.splitCheck(newSplitCheckers(cfg, nil), nil, nil)
+		return keys
+	}
+	checkRange := func(start []byte) [][]byte {
+		keys, _ := handler.splitCheck(newSplitCheckers(cfg, start), start, nil)
+		return keys
+	}
+
+	// The region is smaller than the max size.
+	assert.Nil(t, check(config.SplitPolicySize))
+	keys := check(config.SplitPolicyKeys)
+	require.Len(t, keys, 1)
+	assert.Equal(t, []byte(tablecodec.EncodeRowKeyWithHandle(2, 0)), keys[0])
+	table2 := []byte(tablecodec.GenTablePrefix(2))
+	assert.Equal(t, [][]byte{tablecodec.GenTablePrefix(1), table2}, check(config.SplitPolicyTable))
+	// The keys of the first policy finding any.
+	assert.Equal(t, keys, check(config.SplitPolicySize, config.SplitPolicyKeys, config.SplitPolicyTable))
+
+	// A region starting in a table isn't split at the prefix of the table.
+	cfg.Policies = []string{config.SplitPolicyTable}
+	start := []byte(tablecodec.EncodeRowKeyWithHandle(1, 5))
+	assert.Equal(t, [][]byte{table2}, checkRange(start))
+	assert.Nil(t, checkRange(table2))
+}
+
+func TestTableSplitTransactionalKeys(t *testing.T) {
+	engines := newTestEngines(t)
+	defer cleanUpTestEngineData(engines)
+
+	// The rows of tables 1 and 2 are versioned, the table prefixes are taken from the user keys.
+	wb := new(engine_util.WriteBatch)
+	for tableID := int64(1); tableID <= 2; tableID++ {
+		for handle := int64(0); handle < 3; handle++ {
+			key := tablecodec.EncodeRowKeyWithHandle(tableID, handle)
+			wb.SetCF(engine_util.CF_DEFAULT, mvcc.EncodeKey(key, 10), []byte("v"))
+		}
+	}
+	require.Nil(t, wb.WriteToDB(engines.Kv))
+
+	cfg := config.NewDefaultSplitCheckConfig()
+	cfg.Policies = []string{config.SplitPolicyTable}
+	handler := newSplitCheckHandler(engines.Kv, nil, cfg)
+	keys, _ := handler.splitCheck(newSplitCheckers(cfg, nil), nil, nil)
+	assert.Equal(t, [][]byte{tablecodec.GenTablePrefix(1), tablecodec.GenTablePrefix(2)}, keys)
+	start := []byte(tablecodec.EncodeRowKeyWithHandle(1, 1))
+	keys, _ = handler.splitCheck(newSplitCheckers(cfg, start), nil, nil)
+	assert.Equal(t, [][]byte{tablecodec.GenTablePrefix(2)}, keys)
+}
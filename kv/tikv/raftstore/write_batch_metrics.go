@@ -0,0 +1,119 @@
+package raftstore
+
+import (
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/util/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// The types of the write commands, told apart by the column families they write.
+const (
+	writeCmdPrewrite = "prewrite"
+	writeCmdCommit   = "commit"
+	writeCmdGC       = "gc"
+	writeCmdRaw      = "raw"
+	writeCmdOther    = "other"
+)
+
+var (
+	writeBatchBytesHistogram = metrics.NewHistogramVec(metrics.Desc{
+		Subsystem: "raftstore",
+		Name:      "apply_write_batch_bytes",
+		Help:      "Bytes of the keys and values written by an applied write command.",
+		Unit:      metrics.UnitBytes,
+		Panel:     metrics.PanelHeatmap,
+		Labels:    []string{"type"},
+	}, prometheus.ExponentialBuckets(64, 4, 10), "type")
+
+	writeBatchKeysHistogram = metrics.NewHistogramVec(metrics.Desc{
+		Subsystem: "raftstore",
+		Name:      "apply_write_batch_keys",
+		Help:      "Number of the keys written by an applied write command.",
+		Panel:     metrics.PanelQuantiles,
+		Labels:    []string{"type"},
+	}, prometheus.ExponentialBuckets(1, 2, 16), "type")
+
+	writeBatchCFBytesHistogram = metrics.NewHistogramVec(metrics.Desc{
+		Subsystem: "raftstore",
+		Name:      "apply_write_batch_cf_bytes",
+		Help:      "Bytes of the keys and values written to a column family by an applied write command.",
+		Unit:      metrics.UnitBytes,
+		Panel:     metrics.PanelQuantiles,
+		Labels:    []string{"type", "cf"},
+	}, prometheus.ExponentialBuckets(64, 4, 10), "type", "cf")
+)
+
+// writeBatchStats sums up the writes of a command to the write batch by column family, in the order of
+// engine_util.CFs.
+type writeBatchStats struct {
+	bytes   [len(engine_util.CFs)]int
+	puts    [len(engine_util.CFs)]int
+	deletes [len(engine_util.CFs)]int
+}
+
+func cfIndex(cf string) int {
+	for i, name := range engine_util.CFs {
+		if name == cf {
+			return i
+		}
+	}
+	// An unknown column family is counted as the default one.
+	return 0
+}
+
+func (s *writeBatchStats) onPut(cf string, key, value []byte) {
+	i := cfIndex(cf)
+	s.bytes[i] += len(key) + len(value)
+	s.puts[i]++
+}
+
+func (s *writeBatchStats) onDelete(cf string, key []byte) {
+	i := cfIndex(cf)
+	s.bytes[i] += len(key)
+	s.deletes[i]++
+}
+
+func (s *writeBatchStats) keys() int {
+	keys := 0
+	for i := range s.puts {
+		keys += s.puts[i] + s.deletes[i]
+	}
+	return keys
+}
+
+// cmdType tells the type of the command by the column families it writes. The commands don't carry their type: a
+// prewrite puts locks, a commit or a rollback puts write records and deletes the locks, a GC only deletes old versions,
+// and a raw command only writes the default column family.
+func (s *writeBatchStats) cmdType() string {
+	def, lock, write := cfIndex(engine_util.CF_DEFAULT), cfIndex(engine_util.CF_LOCK), cfIndex(engine_util.CF_WRITE)
+	switch {
+	case s.puts[lock] > 0:
+		return writeCmdPrewrite
+	case s.puts[write] > 0:
+		return writeCmdCommit
+	case s.deletes[write] > 0 && s.deletes[lock] == 0 && s.puts[def] == 0:
+		return writeCmdGC
+	case s.keys() == s.puts[def]+s.deletes[def]:
+		return writeCmdRaw
+	}
+	return writeCmdOther
+}
+
+// observe observes the size of the write command, the column families it doesn't write are left out.
+func (s *writeBatchStats) observe() {
+	keys := s.keys()
+	if keys == 0 {
+		return
+	}
+	cmdType := s.cmdType()
+	total := 0
+	for i, cf := range engine_util.CFs {
+		if s.puts[i]+s.deletes[i] == 0 {
+			continue
+		}
+		total += s.bytes[i]
+		writeBatchCFBytesHistogram.WithLabelValues(cmdType, cf).Observe(float64(s.bytes[i]))
+	}
+	writeBatchBytesHistogram.WithLabelValues(cmdType).Observe(float64(total))
+	writeBatchKeysHistogram.WithLabelValues(cmdType).Observe(float64(keys))
+}
@@ -0,0 +1,38 @@
+package raftstore
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteBatchStats(t *testing.T) {
+	var prewrite writeBatchStats
+	prewrite.onPut(engine_util.CF_DEFAULT, []byte("k1"), []byte("value"))
+	prewrite.onPut(engine_util.CF_LOCK, []byte("k1"), []byte("lock"))
+	assert.Equal(t, writeCmdPrewrite, prewrite.cmdType())
+	assert.Equal(t, 2, prewrite.keys())
+	assert.Equal(t, 7, prewrite.bytes[cfIndex(engine_util.CF_DEFAULT)])
+	assert.Equal(t, 6, prewrite.bytes[cfIndex(engine_util.CF_LOCK)])
+
+	var commit writeBatchStats
+	commit.onPut(engine_util.CF_WRITE, []byte("k1"), []byte("w"))
+	commit.onDelete(engine_util.CF_LOCK, []byte("k1"))
+	assert.Equal(t, writeCmdCommit, commit.cmdType())
+
+	var gc writeBatchStats
+	gc.onDelete(engine_util.CF_WRITE, []byte("k1"))
+	gc.onDelete(engine_util.CF_DEFAULT, []byte("k1"))
+	assert.Equal(t, writeCmdGC, gc.cmdType())
+
+	var raw writeBatchStats
+	raw.onPut(engine_util.CF_DEFAULT, []byte("k1"), []byte("v"))
+	raw.onDelete(engine_util.CF_DEFAULT, []byte("k2"))
+	assert.Equal(t, writeCmdRaw, raw.cmdType())
+
+	// A command only deleting locks is neither.
+	var other writeBatchStats
+	other.onDelete(engine_util.CF_LOCK, []byte("k1"))
+	assert.Equal(t, writeCmdOther, other.cmdType())
+}
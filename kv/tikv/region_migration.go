@@ -0,0 +1,106 @@
+package tikv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/dbreader"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/commands"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tikvpb"
+)
+
+// exportBatchSize is the max number of pairs sent in one ExportRegionResponse.
+const exportBatchSize = 1024
+
+// regionSnapshotter is implemented by the inner servers which can read a region at a consistent applied index.
+type regionSnapshotter interface {
+	RegionSnapshot(ctx *kvrpcpb.Context) (*message.RegionSnapshot, error)
+}
+
+// ExportRegion streams all the data of a region in all the column families. The data is read from a single snapshot,
+// so it's consistent at the applied index sent in the first response.
+func (svr *Server) ExportRegion(req *kvrpcpb.ExportRegionRequest, stream tikvpb.Tikv_ExportRegionServer) error {
+	snapshotter, ok := svr.innerServer.(regionSnapshotter)
+	if !ok {
+		return stream.Send(&kvrpcpb.ExportRegionResponse{Error: "export region is not supported by the inner server"})
+	}
+	snap, err := snapshotter.RegionSnapshot(req.Context)
+	if err != nil {
+		if regErr := ExtractRegionError(err); regErr != nil {
+			return stream.Send(&kvrpcpb.ExportRegionResponse{RegionError: regErr})
+		}
+		return stream.Send(&kvrpcpb.ExportRegionResponse{Error: err.Error()})
+	}
+	reader := dbreader.NewRegionReader(snap.Txn, snap.Region)
+	defer reader.Close()
+
+	resp := &kvrpcpb.ExportRegionResponse{Region: &snap.Region, AppliedIndex: snap.Index}
+	for _, cf := range engine_util.CFs {
+		iter := reader.IterCF(cf)
+		for iter.Seek(snap.Region.StartKey); iter.Valid(); iter.Next() {
+			item := iter.Item()
+			if len(snap.Region.EndKey) > 0 && bytes.Compare(item.Key(), snap.Region.EndKey) >= 0 {
+				break
+			}
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				iter.Close()
+				return stream.Send(&kvrpcpb.ExportRegionResponse{Error: err.Error()})
+			}
+			resp.Pairs = append(resp.Pairs, &kvrpcpb.ExportedPair{Cf: cf, Key: item.KeyCopy(nil), Value: value})
+			if len(resp.Pairs) >= exportBatchSize {
+				if err := stream.Send(resp); err != nil {
+					iter.Close()
+					return err
+				}
+				resp = new(kvrpcpb.ExportRegionResponse)
+			}
+		}
+		iter.Close()
+	}
+	if len(resp.Pairs) > 0 || resp.Region != nil {
+		return stream.Send(resp)
+	}
+	return nil
+}
+
+// ImportRegion writes the exported pairs into the region of the context through raft, so they are replicated like
+// any other write. The pairs may come from a region of another cluster with a different ID. If the commit ts is set,
+// the pairs are user keys and values, written as a transaction committed at it, which must be newer than the versions
+// the keys already have.
+func (svr *Server) ImportRegion(ctx context.Context, req *kvrpcpb.ImportRegionRequest) (*kvrpcpb.ImportRegionResponse, error) {
+	resp := &kvrpcpb.ImportRegionResponse{}
+	if len(req.Pairs) == 0 {
+		return resp, nil
+	}
+	if req.CommitTs != 0 {
+		cmd := commands.NewImportCommitted(req)
+		result := <-svr.scheduler.Run(&cmd)
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		return result.Response.(*kvrpcpb.ImportRegionResponse), nil
+	}
+	batch := make([]inner_server.Modify, 0, len(req.Pairs))
+	for _, pair := range req.Pairs {
+		batch = append(batch, inner_server.Modify{
+			Type: inner_server.ModifyTypePut,
+			Data: inner_server.Put{
+				Key:   pair.Key,
+				Value: pair.Value,
+				Cf:    pair.Cf,
+			}})
+	}
+	if err := svr.innerServer.Write(req.Context, batch); err != nil {
+		if regErr := ExtractRegionError(err); regErr != nil {
+			resp.RegionError = regErr
+		} else {
+			resp.Error = err.Error()
+		}
+	}
+	return resp, nil
+}
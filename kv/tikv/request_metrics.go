@@ -0,0 +1,42 @@
+package tikv
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/util/metrics"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+)
+
+var requestDurationHistogram = metrics.NewExemplarHistogramVec(metrics.Desc{
+	Subsystem: "grpc",
+	Name:      "request_duration_seconds",
+	Help:      "Duration of the gRPC requests handled by the server.",
+	Unit:      metrics.UnitSeconds,
+	Panel:     metrics.PanelHeatmap,
+	Labels:    []string{"type"},
+}, prometheus.ExponentialBuckets(0.0005, 2, 20), "type")
+
+// RequestMetricsInterceptor observes the duration of the unary requests by type. The requests carrying a trace ID
+// in their context are kept as the exemplars of their latency buckets at sampleRate.
+func RequestMetricsInterceptor(sampleRate float64) grpc.UnaryServerInterceptor {
+	requestDurationHistogram.SetSampleRate(sampleRate)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observeRequest(info.FullMethod[strings.LastIndexByte(info.FullMethod, '/')+1:], req, start)
+		return resp, err
+	}
+}
+
+// observeRequest observes the duration of a request of the method which started at start.
+func observeRequest(method string, req interface{}, start time.Time) {
+	var traceID string
+	if r, ok := req.(interface{ GetContext() *kvrpcpb.Context }); ok {
+		traceID = r.GetContext().GetTraceId()
+	}
+	requestDurationHistogram.ObserveWithTrace(time.Since(start).Seconds(), traceID, method)
+}
@@ -0,0 +1,50 @@
+package tikv
+
+import (
+	"context"
+
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/commands"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// seeder is implemented by the inner servers which can write the initial data of an empty cluster directly to their
+// engines.
+type seeder interface {
+	SeedWrite(pairs []*kvrpcpb.ExportedPair) error
+	SeedChecksum() (*kvrpcpb.SeedChecksumResponse, error)
+}
+
+// SeedWrite writes the pairs directly to the kv engine of the store, it's rejected unless the store is started in the
+// seed mode. If the commit ts is set, the pairs are user keys and values, written as a transaction committed at it.
+func (svr *Server) SeedWrite(ctx context.Context, req *kvrpcpb.SeedWriteRequest) (*kvrpcpb.SeedWriteResponse, error) {
+	s, ok := svr.innerServer.(seeder)
+	if !ok || !config.GetGlobalConf().Server.SeedMode {
+		return &kvrpcpb.SeedWriteResponse{Error: "the store is not in the seed mode"}, nil
+	}
+	pairs := req.Pairs
+	if req.CommitTs != 0 {
+		var err error
+		if pairs, err = commands.CommittedPairs(pairs, req.CommitTs); err != nil {
+			return &kvrpcpb.SeedWriteResponse{Error: err.Error()}, nil
+		}
+	}
+	if err := s.SeedWrite(pairs); err != nil {
+		return &kvrpcpb.SeedWriteResponse{Error: err.Error()}, nil
+	}
+	return &kvrpcpb.SeedWriteResponse{}, nil
+}
+
+// SeedChecksum computes the checksum of all the data of the store, so that the seeded stores can be verified to hold
+// the same data before the cluster is opened for traffic.
+func (svr *Server) SeedChecksum(ctx context.Context, req *kvrpcpb.SeedChecksumRequest) (*kvrpcpb.SeedChecksumResponse, error) {
+	s, ok := svr.innerServer.(seeder)
+	if !ok {
+		return &kvrpcpb.SeedChecksumResponse{Error: "seed checksum is not supported by the inner server"}, nil
+	}
+	resp, err := s.SeedChecksum()
+	if err != nil {
+		return &kvrpcpb.SeedChecksumResponse{Error: err.Error()}, nil
+	}
+	return resp, nil
+}
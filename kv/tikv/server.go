@@ -1,18 +1,29 @@
 package tikv
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
+	"fmt"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/kvstore"
+	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
 	"github.com/pingcap-incubator/tinykv/kv/pd"
+	copr "github.com/pingcap-incubator/tinykv/kv/tikv/coprocessor"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/dbreader"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/commands"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/lockwait"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/coprocessor"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/tikvpb"
+	"github.com/pingcap/errors"
 )
 
 var _ tikvpb.TikvServer = new(Server)
@@ -21,8 +32,16 @@ var _ tikvpb.TikvServer = new(Server)
 type Server struct {
 	innerServer InnerServer
 	scheduler   Scheduler
+	readPool    ReadPool
 	refCount    int32
 	stopped     int32
+
+	// The commands waiting for the locks of other transactions, woken up as the locks are committed or rolled back.
+	lockWaits *lockwait.Manager
+	// The newest GC safe point known to the store, the versions older than it may have been collected.
+	safePoint uint64
+	// The caps of a raw scan, parsed from the config once the server is created.
+	scanLimits commands.ScanLimits
 }
 
 // InnerServer represents the internal-facing server part of TinyKV, it handles sending and receiving from other
@@ -33,6 +52,7 @@ type InnerServer interface {
 	Write(ctx *kvrpcpb.Context, batch []inner_server.Modify) error
 	Reader(ctx *kvrpcpb.Context) (dbreader.DBReader, error)
 	Raft(stream tikvpb.Tikv_RaftServer) error
+	BatchRaft(stream tikvpb.Tikv_BatchRaftServer) error
 	Snapshot(stream tikvpb.Tikv_SnapshotServer) error
 }
 
@@ -42,6 +62,22 @@ type Scheduler interface {
 	Stop()
 }
 
+// ReadClass classifies read-only Commands by their cost.
+type ReadClass int
+
+const (
+	ReadClassPointGet ReadClass = iota
+	ReadClassScan
+	ReadClassCoprocessor
+)
+
+// ReadPool runs read-only Commands. Each ReadClass is limited independently, so that expensive reads can't starve
+// cheap ones. Since reads take no latches, they don't go through the Scheduler.
+type ReadPool interface {
+	Run(ReadClass, Command) <-chan RespResult
+	Stop()
+}
+
 // RespResult is a 'generic' result type for responses. It is used to return a Response/error pair over channels where
 // we can't use Go's multiple return values.
 type RespResult struct {
@@ -72,10 +108,20 @@ type Command interface {
 	RegionError(*errorpb.Error) interface{}
 }
 
-func NewServer(innerServer InnerServer, scheduler Scheduler) *Server {
+// KeyedCommand is a Command which declares the keys it may write before it's run, so that a Scheduler can serialize
+// the commands writing the same keys and run the others concurrently.
+type KeyedCommand interface {
+	Command
+	WillWrite() [][]byte
+}
+
+func NewServer(innerServer InnerServer, scheduler Scheduler, readPool ReadPool) *Server {
 	return &Server{
 		innerServer: innerServer,
 		scheduler:   scheduler,
+		readPool:    readPool,
+		lockWaits:   lockwait.NewManager(),
+		scanLimits:  newScanLimits(&config.GetGlobalConf().Scan),
 	}
 }
 
@@ -97,12 +143,31 @@ func (svr *Server) Stop() error {
 	for {
 		if atomic.LoadInt32(&svr.refCount) == 0 {
 			svr.scheduler.Stop()
+			svr.readPool.Stop()
 			return svr.innerServer.Stop()
 		}
 		time.Sleep(time.Millisecond * 10)
 	}
 }
 
+// advanceSafePoint records a GC safe point, it's ignored if it's older than the known one.
+func (svr *Server) advanceSafePoint(safePoint uint64) {
+	for {
+		old := atomic.LoadUint64(&svr.safePoint)
+		if safePoint <= old || atomic.CompareAndSwapUint64(&svr.safePoint, old, safePoint) {
+			return
+		}
+	}
+}
+
+// checkSafePoint returns an error if the versions read by a transaction started at startTS may have been collected.
+func (svr *Server) checkSafePoint(startTS uint64) *kvrpcpb.KeyError {
+	if safePoint := atomic.LoadUint64(&svr.safePoint); startTS < safePoint {
+		return convertToKeyError(&ErrSafePointExpired{StartTS: startTS, SafePoint: safePoint})
+	}
+	return nil
+}
+
 // The below functions are Server's gRPC API (implements TikvServer).
 
 // Transactional API.
@@ -111,19 +176,51 @@ func (svr *Server) KvGet(ctx context.Context, req *kvrpcpb.GetRequest) (*kvrpcpb
 }
 
 func (svr *Server) KvScan(ctx context.Context, req *kvrpcpb.ScanRequest) (*kvrpcpb.ScanResponse, error) {
-	return nil, nil
+	if keyErr := svr.checkSafePoint(req.Version); keyErr != nil {
+		return &kvrpcpb.ScanResponse{Error: keyErr}, nil
+	}
+	cmd := commands.NewScan(req)
+	resp := <-svr.readPool.Run(ReadClassScan, &cmd)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	return resp.Response.(*kvrpcpb.ScanResponse), nil
 }
 
 func (svr *Server) KvCheckTxnStatus(ctx context.Context, req *kvrpcpb.CheckTxnStatusRequest) (*kvrpcpb.CheckTxnStatusResponse, error) {
 	return nil, nil
 }
 
+func (svr *Server) KvTxnHeartBeat(ctx context.Context, req *kvrpcpb.TxnHeartBeatRequest) (*kvrpcpb.TxnHeartBeatResponse, error) {
+	if keyErr := svr.checkSafePoint(req.StartVersion); keyErr != nil {
+		return &kvrpcpb.TxnHeartBeatResponse{Error: keyErr}, nil
+	}
+	cmd := commands.NewTxnHeartBeat(req)
+	resp := <-svr.scheduler.Run(&cmd)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	return resp.Response.(*kvrpcpb.TxnHeartBeatResponse), nil
+}
+
 func (svr *Server) KvPrewrite(ctx context.Context, req *kvrpcpb.PrewriteRequest) (*kvrpcpb.PrewriteResponse, error) {
 	return nil, nil
 }
 
 func (svr *Server) KvCommit(ctx context.Context, req *kvrpcpb.CommitRequest) (*kvrpcpb.CommitResponse, error) {
-	return nil, nil
+	if keyErr := svr.checkSafePoint(req.StartVersion); keyErr != nil {
+		return &kvrpcpb.CommitResponse{Error: keyErr}, nil
+	}
+	cmd := commands.NewCommit(req)
+	resp := <-svr.scheduler.Run(&cmd)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	commitResp := resp.Response.(*kvrpcpb.CommitResponse)
+	if commitResp.RegionError == nil && commitResp.Error == nil {
+		svr.lockWaits.WakeUp(req.StartVersion, req.CommitVersion, keysToHashVals(req.Keys...))
+	}
+	return commitResp, nil
 }
 
 func (svr *Server) KvCleanup(ctx context.Context, req *kvrpcpb.CleanupRequest) (*kvrpcpb.CleanupResponse, error) {
@@ -131,11 +228,162 @@ func (svr *Server) KvCleanup(ctx context.Context, req *kvrpcpb.CleanupRequest) (
 }
 
 func (svr *Server) KvBatchGet(ctx context.Context, req *kvrpcpb.BatchGetRequest) (*kvrpcpb.BatchGetResponse, error) {
-	return nil, nil
+	if keyErr := svr.checkSafePoint(req.Version); keyErr != nil {
+		return &kvrpcpb.BatchGetResponse{Error: keyErr}, nil
+	}
+	if len(req.RegionKeys) == 0 {
+		return svr.batchGet(req)
+	}
+
+	// The keys span several regions of this store, read every region in parallel.
+	reqs := make([]*kvrpcpb.BatchGetRequest, 0, len(req.RegionKeys)+1)
+	if len(req.Keys) > 0 {
+		reqs = append(reqs, &kvrpcpb.BatchGetRequest{Context: req.Context, Keys: req.Keys, Version: req.Version, NeedCommitTs: req.NeedCommitTs})
+	}
+	for _, rk := range req.RegionKeys {
+		reqs = append(reqs, &kvrpcpb.BatchGetRequest{Context: rk.Context, Keys: rk.Keys, Version: req.Version, NeedCommitTs: req.NeedCommitTs})
+	}
+	resps := make([]*kvrpcpb.BatchGetResponse, len(reqs))
+	errs := make([]error, len(reqs))
+	var wg sync.WaitGroup
+	for i, r := range reqs {
+		wg.Add(1)
+		go func(i int, r *kvrpcpb.BatchGetRequest) {
+			defer wg.Done()
+			resps[i], errs[i] = svr.batchGet(r)
+		}(i, r)
+	}
+	wg.Wait()
+
+	resp := &kvrpcpb.BatchGetResponse{}
+	for i, regionResp := range resps {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		if regionResp.Error != nil {
+			return &kvrpcpb.BatchGetResponse{Error: regionResp.Error}, nil
+		}
+		if regionResp.RegionError != nil {
+			for _, key := range reqs[i].Keys {
+				resp.Pairs = append(resp.Pairs, &kvrpcpb.KvPair{Key: key, RegionError: regionResp.RegionError})
+			}
+			continue
+		}
+		resp.Pairs = append(resp.Pairs, regionResp.Pairs...)
+	}
+	return resp, nil
+}
+
+// batchGet reads the keys of a region. While it finds a key locked, it waits for the transaction holding the lock to
+// commit or roll back the key and reads again, for the lock wait timeout at most. If the wait closes a deadlock and the
+// reading transaction is the one aborted, the deadlock is returned instead.
+func (svr *Server) batchGet(req *kvrpcpb.BatchGetRequest) (*kvrpcpb.BatchGetResponse, error) {
+	var deadline time.Time
+	if timeout := config.GetGlobalConf().Scheduler.LockWaitTimeout; timeout != "" {
+		deadline = time.Now().Add(config.ParseDuration(timeout))
+	}
+	var waiter *lockwait.Waiter
+	for {
+		cmd := commands.NewBatchGet(req)
+		result := <-svr.readPool.Run(ReadClassPointGet, &cmd)
+		var resp *kvrpcpb.BatchGetResponse
+		var lock *kvrpcpb.LockInfo
+		if result.Err == nil {
+			resp = result.Response.(*kvrpcpb.BatchGetResponse)
+			lock = firstLock(resp)
+		}
+		if waiter != nil && (lock == nil || lock.LockVersion != waiter.LockTS || keysToHashVals(lock.Key)[0] != waiter.KeyHash) {
+			waiter.Cancel()
+			waiter = nil
+		}
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		remaining := time.Until(deadline)
+		if lock == nil || remaining <= 0 {
+			return resp, nil
+		}
+		if waiter == nil {
+			// Read again once the wait is queued, the lock may have been released before.
+			waiter = svr.lockWaits.NewWaiter(req.Version, lock.LockVersion, keysToHashVals(lock.Key)[0])
+			continue
+		}
+		wait := waiter.Wait(remaining)
+		waiter = nil
+		switch wait.Kind {
+		case lockwait.TimedOut:
+			return resp, nil
+		case lockwait.Deadlocked:
+			return &kvrpcpb.BatchGetResponse{Error: &kvrpcpb.KeyError{Deadlock: &kvrpcpb.Deadlock{
+				LockTs:          lock.LockVersion,
+				LockKey:         lock.Key,
+				DeadlockKeyHash: wait.DeadlockKeyHash,
+			}}}, nil
+		}
+	}
+}
+
+// firstLock returns the first lock a BatchGet found, nil if it found none.
+func firstLock(resp *kvrpcpb.BatchGetResponse) *kvrpcpb.LockInfo {
+	for _, pair := range resp.Pairs {
+		if pair.Error != nil && pair.Error.Locked != nil {
+			return pair.Error.Locked
+		}
+	}
+	return nil
+}
+
+func (svr *Server) KvCheckConflicts(ctx context.Context, req *kvrpcpb.CheckConflictsRequest) (*kvrpcpb.CheckConflictsResponse, error) {
+	if keyErr := svr.checkSafePoint(req.StartVersion); keyErr != nil {
+		return &kvrpcpb.CheckConflictsResponse{Errors: []*kvrpcpb.KeyError{keyErr}}, nil
+	}
+	cmd := commands.NewCheckConflicts(req)
+	resp := <-svr.readPool.Run(ReadClassPointGet, &cmd)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	return resp.Response.(*kvrpcpb.CheckConflictsResponse), nil
+}
+
+func (svr *Server) KvGetCommitTs(ctx context.Context, req *kvrpcpb.GetCommitTsRequest) (*kvrpcpb.GetCommitTsResponse, error) {
+	cmd := commands.NewGetCommitTs(req)
+	resp := <-svr.readPool.Run(ReadClassPointGet, &cmd)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	return resp.Response.(*kvrpcpb.GetCommitTsResponse), nil
+}
+
+func (svr *Server) MvccGetByKey(ctx context.Context, req *kvrpcpb.MvccGetByKeyRequest) (*kvrpcpb.MvccGetByKeyResponse, error) {
+	cmd := commands.NewMvccGetByKey(req)
+	resp := <-svr.readPool.Run(ReadClassPointGet, &cmd)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	return resp.Response.(*kvrpcpb.MvccGetByKeyResponse), nil
+}
+
+// MvccGetByStartTs scans the whole region for the keys of the transaction, so it's classified as a scan.
+func (svr *Server) MvccGetByStartTs(ctx context.Context, req *kvrpcpb.MvccGetByStartTsRequest) (*kvrpcpb.MvccGetByStartTsResponse, error) {
+	cmd := commands.NewMvccGetByStartTs(req)
+	resp := <-svr.readPool.Run(ReadClassScan, &cmd)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	return resp.Response.(*kvrpcpb.MvccGetByStartTsResponse), nil
 }
 
 func (svr *Server) KvBatchRollback(ctx context.Context, req *kvrpcpb.BatchRollbackRequest) (*kvrpcpb.BatchRollbackResponse, error) {
-	return nil, nil
+	cmd := commands.NewBatchRollback(req)
+	resp := <-svr.scheduler.Run(&cmd)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	rollbackResp := resp.Response.(*kvrpcpb.BatchRollbackResponse)
+	if rollbackResp.RegionError == nil && rollbackResp.Error == nil {
+		svr.lockWaits.WakeUp(req.StartVersion, 0, keysToHashVals(req.Keys...))
+	}
+	return rollbackResp, nil
 }
 
 func (svr *Server) KvScanLock(ctx context.Context, req *kvrpcpb.ScanLockRequest) (*kvrpcpb.ScanLockResponse, error) {
@@ -146,10 +394,85 @@ func (svr *Server) KvResolveLock(ctx context.Context, req *kvrpcpb.ResolveLockRe
 	return nil, nil
 }
 
+func (svr *Server) KvGC(ctx context.Context, req *kvrpcpb.GCRequest) (*kvrpcpb.GCResponse, error) {
+	retentions, err := versionRetentions(&config.GetGlobalConf().GC)
+	if err != nil {
+		return &kvrpcpb.GCResponse{Error: err.Error()}, nil
+	}
+	// The versions hidden by the safe point may be gone from now on.
+	svr.advanceSafePoint(req.SafePoint)
+	// The keys to collect are found by a read, then collected under their latches.
+	scan := commands.NewGCScan(req)
+	resp := <-svr.readPool.Run(ReadClassScan, &scan)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	if scanResp := resp.Response.(*kvrpcpb.GCResponse); scanResp.RegionError != nil {
+		return scanResp, nil
+	}
+	cmd := scan.GC(retentions)
+	resp = <-svr.scheduler.Run(&cmd)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+	return resp.Response.(*kvrpcpb.GCResponse), nil
+}
+
+// rangeDeleter is implemented by the inner servers which can delete a range of keys at once.
+type rangeDeleter interface {
+	DeleteRange(ctx *kvrpcpb.Context, startKey, endKey []byte) error
+}
+
+// KvDeleteRange deletes all the versions and locks of the keys of a range inside the region. The range is deleted by
+// a single raft command, so dropping a table doesn't propose a delete for each key.
+func (svr *Server) KvDeleteRange(ctx context.Context, req *kvrpcpb.DeleteRangeRequest) (*kvrpcpb.DeleteRangeResponse, error) {
+	resp := &kvrpcpb.DeleteRangeResponse{}
+	deleter, ok := svr.innerServer.(rangeDeleter)
+	if !ok {
+		resp.Error = "delete range is not supported by the inner server"
+		return resp, nil
+	}
+	if len(req.EndKey) > 0 && bytes.Compare(req.StartKey, req.EndKey) >= 0 {
+		resp.Error = fmt.Sprintf("invalid range [%q, %q)", req.StartKey, req.EndKey)
+		return resp, nil
+	}
+	// The keys of all the CFs start with the encoded user key, the versions of a key sort right after its encoding.
+	var startKey, endKey []byte
+	if len(req.StartKey) > 0 {
+		startKey = mvcc.EncodeLockKey(req.StartKey)
+	}
+	if len(req.EndKey) > 0 {
+		endKey = mvcc.EncodeLockKey(req.EndKey)
+	}
+	if err := deleter.DeleteRange(req.Context, startKey, endKey); err != nil {
+		if regErr := ExtractRegionError(err); regErr != nil {
+			resp.RegionError = regErr
+		} else {
+			resp.Error = err.Error()
+		}
+		return resp, nil
+	}
+	// The locks in the range are gone, but not which transactions held them, so all the waiters check their locks again.
+	svr.lockWaits.WakeUpAll()
+	return resp, nil
+}
+
+func versionRetentions(conf *config.GC) ([]commands.VersionRetention, error) {
+	retentions := make([]commands.VersionRetention, 0, len(conf.Retentions))
+	for _, r := range conf.Retentions {
+		prefix, err := hex.DecodeString(r.Prefix)
+		if err != nil {
+			return nil, errors.Errorf("invalid gc retention prefix %q", r.Prefix)
+		}
+		retentions = append(retentions, commands.VersionRetention{Prefix: prefix, MaxVersions: r.MaxVersions})
+	}
+	return retentions, nil
+}
+
 // Raw API.
 func (svr *Server) RawGet(ctx context.Context, req *kvrpcpb.RawGetRequest) (*kvrpcpb.RawGetResponse, error) {
 	cmd := commands.NewRawGet(req)
-	resp := <-svr.scheduler.Run(&cmd)
+	resp := <-svr.readPool.Run(ReadClassPointGet, &cmd)
 	if resp.Err != nil {
 		return nil, resp.Err
 	}
@@ -159,72 +482,125 @@ func (svr *Server) RawGet(ctx context.Context, req *kvrpcpb.RawGetRequest) (*kvr
 
 func (svr *Server) RawPut(ctx context.Context, req *kvrpcpb.RawPutRequest) (*kvrpcpb.RawPutResponse, error) {
 	resp := &kvrpcpb.RawPutResponse{}
-	err := svr.innerServer.Write(req.Context, []inner_server.Modify{{
+	resp.RegionError, resp.Error = svr.rawWrite(req.Context, []inner_server.Modify{{
 		Type: inner_server.ModifyTypePut,
 		Data: inner_server.Put{
 			Key:   req.Key,
 			Value: req.Value,
 			Cf:    req.Cf,
 		}}})
-	if err != nil {
-		if regErr := ExtractRegionError(err); regErr != nil {
-			resp.RegionError = regErr
-		} else {
-			resp.Error = err.Error()
-		}
-	}
 	return resp, nil
 }
 
 func (svr *Server) RawDelete(ctx context.Context, req *kvrpcpb.RawDeleteRequest) (*kvrpcpb.RawDeleteResponse, error) {
 	resp := &kvrpcpb.RawDeleteResponse{}
-	err := svr.innerServer.Write(req.Context, []inner_server.Modify{{
+	resp.RegionError, resp.Error = svr.rawWrite(req.Context, []inner_server.Modify{{
 		Type: inner_server.ModifyTypeDelete,
 		Data: inner_server.Delete{
 			Key: req.Key,
 			Cf:  req.Cf,
 		}}})
-	if err != nil {
-		if regErr := ExtractRegionError(err); regErr != nil {
-			resp.RegionError = regErr
-		} else {
-			resp.Error = err.Error()
-		}
+	return resp, nil
+}
+
+func (svr *Server) RawBatchGet(ctx context.Context, req *kvrpcpb.RawBatchGetRequest) (*kvrpcpb.RawBatchGetResponse, error) {
+	cmd := commands.NewRawBatchGet(req)
+	resp := <-svr.readPool.Run(ReadClassPointGet, &cmd)
+	if resp.Err != nil {
+		return nil, resp.Err
+	}
+
+	return resp.Response.(*kvrpcpb.RawBatchGetResponse), nil
+}
+
+func (svr *Server) RawBatchPut(ctx context.Context, req *kvrpcpb.RawBatchPutRequest) (*kvrpcpb.RawBatchPutResponse, error) {
+	batch := make([]inner_server.Modify, 0, len(req.Pairs))
+	for _, pair := range req.Pairs {
+		batch = append(batch, inner_server.Modify{
+			Type: inner_server.ModifyTypePut,
+			Data: inner_server.Put{
+				Key:   pair.Key,
+				Value: pair.Value,
+				Cf:    req.Cf,
+			}})
+	}
+	resp := &kvrpcpb.RawBatchPutResponse{}
+	resp.RegionError, resp.Error = svr.rawWrite(req.Context, batch)
+	return resp, nil
+}
+
+func (svr *Server) RawBatchDelete(ctx context.Context, req *kvrpcpb.RawBatchDeleteRequest) (*kvrpcpb.RawBatchDeleteResponse, error) {
+	batch := make([]inner_server.Modify, 0, len(req.Keys))
+	for _, key := range req.Keys {
+		batch = append(batch, inner_server.Modify{
+			Type: inner_server.ModifyTypeDelete,
+			Data: inner_server.Delete{
+				Key: key,
+				Cf:  req.Cf,
+			}})
 	}
+	resp := &kvrpcpb.RawBatchDeleteResponse{}
+	resp.RegionError, resp.Error = svr.rawWrite(req.Context, batch)
 	return resp, nil
 }
 
+// rawWrite writes batch to the inner server in a single write, and returns the error in the form of a raw response.
+func (svr *Server) rawWrite(ctx *kvrpcpb.Context, batch []inner_server.Modify) (*errorpb.Error, string) {
+	if len(batch) == 0 {
+		return nil, ""
+	}
+	err := svr.innerServer.Write(ctx, batch)
+	if err == nil {
+		return nil, ""
+	}
+	if regErr := ExtractRegionError(err); regErr != nil {
+		return regErr, ""
+	}
+	return nil, err.Error()
+}
+
 func (svr *Server) RawScan(ctx context.Context, req *kvrpcpb.RawScanRequest) (*kvrpcpb.RawScanResponse, error) {
-	resp := &kvrpcpb.RawScanResponse{}
-	reader, err := svr.innerServer.Reader(req.Context)
-	if err != nil {
-		if regErr := ExtractRegionError(err); regErr != nil {
-			resp.RegionError = regErr
-		} else {
-			resp.Error = err.Error()
-		}
-		return resp, nil
+	cmd := commands.NewRawScan(req, svr.scanLimits)
+	defer cmd.Release()
+	resp := <-svr.readPool.Run(ReadClassScan, &cmd)
+	if resp.Err != nil {
+		return &kvrpcpb.RawScanResponse{Error: resp.Err.Error()}, nil
 	}
 
-	pairs := make([]*kvrpcpb.KvPair, 0)
+	return resp.Response.(*kvrpcpb.RawScanResponse), nil
+}
 
-	it := reader.IterCF(req.Cf)
-	for it.Seek(req.StartKey); it.Valid() && len(pairs) < int(req.Limit); it.Next() {
-		key := it.Item().KeyCopy(nil)
-		value, err := it.Item().ValueCopy(nil)
-		if err != nil {
-			resp.Error = err.Error()
-			return resp, nil
-		}
+func newScanLimits(conf *config.Scan) commands.ScanLimits {
+	limits := commands.ScanLimits{MaxKeys: conf.MaxKeys, MaxBytes: conf.MaxBytes}
+	if conf.MaxDuration != "" {
+		limits.MaxDuration = config.ParseDuration(conf.MaxDuration)
+	}
+	return limits
+}
 
-		pairs = append(pairs, &kvrpcpb.KvPair{
-			Key:   key,
-			Value: value,
-		})
+// SQL push down commands.
+func (svr *Server) Coprocessor(ctx context.Context, req *coprocessor.Request) (*coprocessor.Response, error) {
+	// The response has no key error, the expired safe point is reported as an other error.
+	if keyErr := svr.checkSafePoint(req.StartTs); keyErr != nil {
+		return &coprocessor.Response{OtherError: keyErr.String()}, nil
+	}
+	var cmd Command
+	switch req.Tp {
+	case commands.ReqTypeChecksum:
+		checksum := commands.NewChecksum(req)
+		cmd = &checksum
+	case copr.ReqTypeDAG:
+		dag := copr.NewDAG(req)
+		cmd = &dag
+	default:
+		return &coprocessor.Response{OtherError: fmt.Sprintf("unsupported request type %d", req.Tp)}, nil
+	}
+	resp := <-svr.readPool.Run(ReadClassCoprocessor, cmd)
+	if resp.Err != nil {
+		return nil, resp.Err
 	}
-	resp.Kvs = pairs
 
-	return resp, nil
+	return resp.Response.(*coprocessor.Response), nil
 }
 
 // Raft commands (tikv <-> tikv); these are trivially forwarded to innerServer.
@@ -232,6 +608,36 @@ func (svr *Server) Raft(stream tikvpb.Tikv_RaftServer) error {
 	return svr.innerServer.Raft(stream)
 }
 
+func (svr *Server) BatchRaft(stream tikvpb.Tikv_BatchRaftServer) error {
+	return svr.innerServer.BatchRaft(stream)
+}
+
 func (svr *Server) Snapshot(stream tikvpb.Tikv_SnapshotServer) error {
 	return svr.innerServer.Snapshot(stream)
 }
+
+// raftLogReporter is implemented by the inner servers backed by raft, which can take part in quorum reads.
+type raftLogReporter interface {
+	RaftLogStatus(req *raft_cmdpb.RaftCmdRequest) (*raft_cmdpb.RaftCmdResponse, error)
+}
+
+func (svr *Server) RaftLogStatus(ctx context.Context, req *raft_cmdpb.RaftCmdRequest) (*raft_cmdpb.RaftCmdResponse, error) {
+	reporter, ok := svr.innerServer.(raftLogReporter)
+	if !ok {
+		return nil, errors.New("raft log status is not supported by the inner server")
+	}
+	return reporter.RaftLogStatus(req)
+}
+
+// regionWatcher is implemented by the inner servers backed by raft, whose leaders publish the changes of their regions.
+type regionWatcher interface {
+	WatchRegions(req *kvrpcpb.WatchRegionsRequest, stream tikvpb.Tikv_WatchRegionsServer) error
+}
+
+func (svr *Server) WatchRegions(req *kvrpcpb.WatchRegionsRequest, stream tikvpb.Tikv_WatchRegionsServer) error {
+	watcher, ok := svr.innerServer.(regionWatcher)
+	if !ok {
+		return stream.Send(&kvrpcpb.WatchRegionsResponse{Error: "watching regions is not supported by the inner server"})
+	}
+	return watcher.WatchRegions(req, stream)
+}
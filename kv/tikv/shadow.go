@@ -0,0 +1,122 @@
+package tikv
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/ngaut/log"
+	"github.com/pingcap-incubator/tinykv/kv/util/metrics"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tikvpb"
+	"github.com/pingcap/errors"
+	"google.golang.org/grpc"
+)
+
+const (
+	// shadowTimeout bounds a shadowed request, the response to the client doesn't wait for it anyway.
+	shadowTimeout = 5 * time.Second
+	// maxShadowsInFlight bounds the shadowed requests in flight, the samples beyond it are dropped, so a slow target
+	// doesn't pile up goroutines on the store.
+	maxShadowsInFlight = 64
+)
+
+var shadowRequestCounter = metrics.NewCounterVec(metrics.Desc{
+	Subsystem: "shadow",
+	Name:      "requests_total",
+	Help:      "Number of the read requests shadowed to the target, by the result of comparing the responses.",
+	Labels:    []string{"type", "result"},
+}, "type", "result")
+
+// ShadowTarget serves the shadowed read requests, it's a secondary store or an alternate code path under test. The
+// method is the name of the gRPC method, like KvScan.
+type ShadowTarget func(ctx context.Context, method string, req interface{}) (interface{}, error)
+
+// NewStoreShadowTarget returns a target sending the shadowed requests to a secondary store. The requests keep the
+// context of the regions of this store, so the secondary store must serve the same regions, like a replica of the
+// cluster restored from its backup.
+func NewStoreShadowTarget(client tikvpb.TikvClient) ShadowTarget {
+	return func(ctx context.Context, method string, req interface{}) (interface{}, error) {
+		switch method {
+		case "KvGet":
+			return client.KvGet(ctx, req.(*kvrpcpb.GetRequest))
+		case "KvScan":
+			return client.KvScan(ctx, req.(*kvrpcpb.ScanRequest))
+		case "KvBatchGet":
+			return client.KvBatchGet(ctx, req.(*kvrpcpb.BatchGetRequest))
+		case "RawGet":
+			return client.RawGet(ctx, req.(*kvrpcpb.RawGetRequest))
+		case "RawScan":
+			return client.RawScan(ctx, req.(*kvrpcpb.RawScanRequest))
+		case "RawBatchGet":
+			return client.RawBatchGet(ctx, req.(*kvrpcpb.RawBatchGetRequest))
+		}
+		return nil, errors.Errorf("method %s is not shadowed", method)
+	}
+}
+
+// shadowedMethods are the read requests which are shadowed, they have no side effects on the target.
+var shadowedMethods = map[string]struct{}{
+	"KvGet":       {},
+	"KvScan":      {},
+	"KvBatchGet":  {},
+	"RawGet":      {},
+	"RawScan":     {},
+	"RawBatchGet": {},
+}
+
+// ShadowInterceptor duplicates sampleRate of the read requests to the target once they are served. The responses of
+// the target are discarded, they are compared with the ones sent to the clients and the diffs are logged. The
+// responses with a region error on either side are not compared, the regions may have changed in between.
+func ShadowInterceptor(target ShadowTarget, sampleRate float64) grpc.UnaryServerInterceptor {
+	inFlight := make(chan struct{}, maxShadowsInFlight)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		method := info.FullMethod[strings.LastIndexByte(info.FullMethod, '/')+1:]
+		if _, ok := shadowedMethods[method]; !ok || err != nil || sampleRate <= 0 || rand.Float64() >= sampleRate {
+			return resp, err
+		}
+		select {
+		case inFlight <- struct{}{}:
+			go func() {
+				defer func() { <-inFlight }()
+				shadowRequest(target, method, req, resp)
+			}()
+		default:
+			shadowRequestCounter.WithLabelValues(method, "dropped").Inc()
+		}
+		return resp, err
+	}
+}
+
+// shadowRequest sends the request to the target and compares the response with resp.
+func shadowRequest(target ShadowTarget, method string, req, resp interface{}) {
+	ctx, cancel := context.WithTimeout(context.Background(), shadowTimeout)
+	defer cancel()
+	shadowResp, err := target(ctx, method, req)
+	if err != nil {
+		log.Warnf("shadowed %s failed, err: %v", method, err)
+		shadowRequestCounter.WithLabelValues(method, "error").Inc()
+		return
+	}
+	if hasRegionError(resp) || hasRegionError(shadowResp) {
+		shadowRequestCounter.WithLabelValues(method, "region-error").Inc()
+		return
+	}
+	expected, ok1 := resp.(proto.Message)
+	actual, ok2 := shadowResp.(proto.Message)
+	if ok1 && ok2 && proto.Equal(expected, actual) {
+		shadowRequestCounter.WithLabelValues(method, "match").Inc()
+		return
+	}
+	log.Warnf("shadowed %s differs, request: %v, response: %v, shadow response: %v", method, req, resp, shadowResp)
+	shadowRequestCounter.WithLabelValues(method, "diff").Inc()
+}
+
+func hasRegionError(resp interface{}) bool {
+	r, ok := resp.(interface{ GetRegionError() *errorpb.Error })
+	return ok && r.GetRegionError() != nil
+}
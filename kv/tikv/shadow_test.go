@@ -0,0 +1,79 @@
+package tikv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestShadowInterceptor(t *testing.T) {
+	shadowed := make(chan interface{}, 10)
+	target := func(ctx context.Context, method string, req interface{}) (interface{}, error) {
+		shadowed <- req
+		return &kvrpcpb.RawGetResponse{Value: []byte("v")}, nil
+	}
+	interceptor := ShadowInterceptor(target, 1)
+	call := func(method string, req interface{}, err error) {
+		resp, callErr := interceptor(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/tikvpb.Tikv/" + method},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return &kvrpcpb.RawGetResponse{Value: []byte("v")}, err
+			})
+		// The client gets the response of the store whatever the target answers.
+		assert.Equal(t, err, callErr)
+		assert.Equal(t, []byte("v"), resp.(*kvrpcpb.RawGetResponse).Value)
+	}
+
+	// Only the reads served without an error are shadowed.
+	get := &kvrpcpb.RawGetRequest{Key: []byte("k")}
+	call("RawGet", get, nil)
+	call("RawPut", &kvrpcpb.RawPutRequest{Key: []byte("k")}, nil)
+	call("RawGet", &kvrpcpb.RawGetRequest{Key: []byte("failed")}, errors.New("failed"))
+	select {
+	case req := <-shadowed:
+		assert.Equal(t, get, req)
+	case <-time.After(time.Second):
+		t.Fatal("the read is not shadowed")
+	}
+	select {
+	case req := <-shadowed:
+		t.Fatalf("unexpected shadowed request %v", req)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// Nothing is shadowed at a 0 sample rate.
+	interceptor = ShadowInterceptor(target, 0)
+	call("RawGet", get, nil)
+	select {
+	case req := <-shadowed:
+		t.Fatalf("unexpected shadowed request %v", req)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestShadowRequestCompare(t *testing.T) {
+	resp := &kvrpcpb.RawGetResponse{Value: []byte("v")}
+	count := func(result string) float64 {
+		return testutil.ToFloat64(shadowRequestCounter.WithLabelValues("RawGet", result))
+	}
+	check := func(shadowResp interface{}, err error, result string) {
+		before := count(result)
+		shadowRequest(func(ctx context.Context, method string, req interface{}) (interface{}, error) {
+			return shadowResp, err
+		}, "RawGet", &kvrpcpb.RawGetRequest{Key: []byte("k")}, resp)
+		assert.Equal(t, before+1, count(result))
+	}
+
+	check(&kvrpcpb.RawGetResponse{Value: []byte("v")}, nil, "match")
+	check(&kvrpcpb.RawGetResponse{Value: []byte("w")}, nil, "diff")
+	check(&kvrpcpb.RawGetResponse{NotFound: true}, nil, "diff")
+	check(nil, errors.New("unavailable"), "error")
+	// The responses with a region error are not compared.
+	check(&kvrpcpb.RawGetResponse{RegionError: &errorpb.Error{Message: "stale"}}, nil, "region-error")
+}
@@ -0,0 +1,107 @@
+package tikv
+
+import (
+	"bytes"
+
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/dbreader"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/commands"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/kvstore"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tikvpb"
+)
+
+// defaultExportFileSize is the size of the keys and values in an exported file when the request doesn't set one.
+const defaultExportFileSize = 64 * 1024 * 1024
+
+// ExportSnapshot streams the pairs of a key range visible at a version. The pairs are read from a single snapshot of
+// the region, so the export is consistent however long it takes, and cut into files along the way. The locked keys
+// are reported with the response following them if they're skipped.
+func (svr *Server) ExportSnapshot(req *kvrpcpb.ExportSnapshotRequest, stream tikvpb.Tikv_ExportSnapshotServer) error {
+	snapshotter, ok := svr.innerServer.(regionSnapshotter)
+	if !ok {
+		return stream.Send(&kvrpcpb.ExportSnapshotResponse{Error: "export snapshot is not supported by the inner server"})
+	}
+	snap, err := snapshotter.RegionSnapshot(req.Context)
+	if err != nil {
+		if regErr := ExtractRegionError(err); regErr != nil {
+			return stream.Send(&kvrpcpb.ExportSnapshotResponse{RegionError: regErr})
+		}
+		return stream.Send(&kvrpcpb.ExportSnapshotResponse{Error: err.Error()})
+	}
+	reader := dbreader.NewRegionReader(snap.Txn, snap.Region)
+	defer reader.Close()
+
+	// Clip the range to the region, whose boundaries are encoded user keys.
+	startKey, endKey := req.StartKey, req.EndKey
+	if len(snap.Region.StartKey) > 0 {
+		regionStart, err := mvcc.DecodeLockKey(snap.Region.StartKey)
+		if err != nil {
+			return stream.Send(&kvrpcpb.ExportSnapshotResponse{Error: err.Error()})
+		}
+		if bytes.Compare(regionStart, startKey) > 0 {
+			startKey = regionStart
+		}
+	}
+	if len(snap.Region.EndKey) > 0 {
+		regionEnd, err := mvcc.DecodeLockKey(snap.Region.EndKey)
+		if err != nil {
+			return stream.Send(&kvrpcpb.ExportSnapshotResponse{Error: err.Error()})
+		}
+		if len(endKey) == 0 || bytes.Compare(regionEnd, endKey) < 0 {
+			endKey = regionEnd
+		}
+	}
+	fileSize := req.FileSize
+	if fileSize == 0 {
+		fileSize = defaultExportFileSize
+	}
+
+	txn := kvstore.NewTxn(reader)
+	scan := commands.NewScan(&kvrpcpb.ScanRequest{StartKey: startKey, EndKey: endKey, Version: req.Version})
+	resp := new(kvrpcpb.ExportSnapshotResponse)
+	var size uint64
+	var sendErr error
+	send := func() bool {
+		sendErr = stream.Send(resp)
+		resp = &kvrpcpb.ExportSnapshotResponse{File: resp.File}
+		return sendErr == nil
+	}
+	err = scan.Each(&txn, func(pair *kvrpcpb.KvPair) bool {
+		if pair.Error != nil {
+			if req.SkipLocked {
+				resp.SkippedLocks = append(resp.SkippedLocks, pair.Error)
+				return true
+			}
+			resp.Locked = pair.Error
+			return false
+		}
+		resp.Pairs = append(resp.Pairs, pair)
+		size += uint64(len(pair.Key) + len(pair.Value))
+		if size >= fileSize {
+			resp.FileEnd = true
+			if !send() {
+				return false
+			}
+			resp.File++
+			size = 0
+		} else if len(resp.Pairs) >= exportBatchSize {
+			return send()
+		}
+		return true
+	})
+	if sendErr != nil {
+		return sendErr
+	}
+	if err != nil {
+		return stream.Send(&kvrpcpb.ExportSnapshotResponse{Error: err.Error()})
+	}
+	// The last file ends with the range, no empty file is sent if the range ends with a full file.
+	if resp.Locked == nil && size > 0 {
+		resp.FileEnd = true
+	}
+	if len(resp.Pairs) > 0 || resp.FileEnd || resp.Locked != nil || len(resp.SkippedLocks) > 0 {
+		return stream.Send(resp)
+	}
+	return nil
+}
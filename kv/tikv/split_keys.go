@@ -0,0 +1,205 @@
+package tikv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/dbreader"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+)
+
+// maxApproximateSplitKeys is the max number of split keys asked in one GetRegionApproximateSplitKeysRequest.
+const maxApproximateSplitKeys = 1024
+
+// GetRegionApproximateSplitKeys returns the keys which split the data of a region into parts of about the same size,
+// read from a snapshot of the region.
+func (svr *Server) GetRegionApproximateSplitKeys(ctx context.Context,
+	req *kvrpcpb.GetRegionApproximateSplitKeysRequest) (*kvrpcpb.GetRegionApproximateSplitKeysResponse, error) {
+	resp := &kvrpcpb.GetRegionApproximateSplitKeysResponse{}
+	if req.Count == 0 || req.Count > maxApproximateSplitKeys {
+		resp.Error = "split key count must be in [1, 1024]"
+		return resp, nil
+	}
+	snapshotter, ok := svr.innerServer.(regionSnapshotter)
+	if !ok {
+		resp.Error = "approximate split keys are not supported by the inner server"
+		return resp, nil
+	}
+	snap, err := snapshotter.RegionSnapshot(req.Context)
+	if err != nil {
+		if regErr := ExtractRegionError(err); regErr != nil {
+			resp.RegionError = regErr
+		} else {
+			resp.Error = err.Error()
+		}
+		return resp, nil
+	}
+	reader := dbreader.NewRegionReader(snap.Txn, snap.Region)
+	defer reader.Close()
+
+	resp.SplitKeys, resp.ApproximateSize = approximateSplitKeys(reader, &snap.Region, int(req.Count))
+	return resp, nil
+}
+
+// regionSplitter is implemented by the inner servers which can split a region.
+type regionSplitter interface {
+	SplitRegion(ctx *kvrpcpb.Context, splitKeys [][]byte) ([]*metapb.Region, error)
+}
+
+// SplitRegion splits a region at the user keys of the request, or without them, at the approximate split keys which
+// split it into parts of about the same size. The split is done right away instead of waiting for the split checker.
+func (svr *Server) SplitRegion(ctx context.Context, req *kvrpcpb.SplitRegionRequest) (*kvrpcpb.SplitRegionResponse, error) {
+	resp := &kvrpcpb.SplitRegionResponse{}
+	splitter, ok := svr.innerServer.(regionSplitter)
+	if !ok {
+		resp.Error = "split region is not supported by the inner server"
+		return resp, nil
+	}
+	userKeys := req.SplitKeys
+	if len(userKeys) == 0 && len(req.SplitKey) > 0 {
+		userKeys = [][]byte{req.SplitKey}
+	}
+	var splitKeys [][]byte
+	for _, key := range userKeys {
+		splitKeys = append(splitKeys, mvcc.EncodeLockKey(key))
+	}
+	if len(splitKeys) == 0 {
+		keysResp, _ := svr.GetRegionApproximateSplitKeys(ctx, &kvrpcpb.GetRegionApproximateSplitKeysRequest{
+			Context: req.Context,
+			Count:   req.SplitCount,
+		})
+		if keysResp.RegionError != nil || keysResp.Error != "" {
+			resp.RegionError, resp.Error = keysResp.RegionError, keysResp.Error
+			return resp, nil
+		}
+		if len(keysResp.SplitKeys) == 0 {
+			resp.Error = "the region has too few keys to split"
+			return resp, nil
+		}
+		splitKeys = keysResp.SplitKeys
+	}
+	regions, err := splitter.SplitRegion(req.Context, splitKeys)
+	if err != nil {
+		if regErr := ExtractRegionError(err); regErr != nil {
+			resp.RegionError = regErr
+		} else {
+			resp.Error = err.Error()
+		}
+		return resp, nil
+	}
+	resp.Regions = regions
+	return resp, nil
+}
+
+// splitKeySamples is the number of keys sampled for every part of a region while looking for its split keys, a split
+// key is off by less than about 2/splitKeySamples of a part.
+const splitKeySamples = 16
+
+// keySample is a user key sampled by approximateSplitKeys, with the size of the data before it.
+type keySample struct {
+	key    []byte
+	offset uint64
+}
+
+// approximateSplitKeys scans the region once, sampling a key every step of its data, and takes the first sampled keys
+// at which the accumulated size crosses every 1/(count+1) of the total size. The step starts at a byte and doubles
+// whenever there are too many samples, dropping every other sample, so a small region is sampled at every key. The
+// data of a user key is never split, so a large key may cross several boundaries at once and fewer keys are returned.
+func approximateSplitKeys(reader dbreader.DBReader, region *metapb.Region, count int) ([][]byte, uint64) {
+	maxSamples := 2 * splitKeySamples * (count + 1)
+	var samples []keySample
+	var total, nextSample uint64
+	step := uint64(1)
+	scanUserKeys(reader, region, func(key []byte, size uint64) {
+		// The first key has nothing before it, so the region's start key is never taken.
+		if total > 0 && total >= nextSample {
+			samples = append(samples, keySample{key: key, offset: total})
+			nextSample = total + step
+			if len(samples) >= maxSamples {
+				for i := 0; i < len(samples)/2; i++ {
+					samples[i] = samples[2*i+1]
+				}
+				samples = samples[:len(samples)/2]
+				step *= 2
+			}
+		}
+		total += size
+	})
+	if total == 0 {
+		return nil, 0
+	}
+	var splitKeys [][]byte
+	next := 1
+	for _, sample := range samples {
+		if next > count {
+			break
+		}
+		if sample.offset >= total*uint64(next)/uint64(count+1) {
+			splitKeys = append(splitKeys, sample.key)
+			for next <= count && sample.offset >= total*uint64(next)/uint64(count+1) {
+				next++
+			}
+		}
+	}
+	return splitKeys, total
+}
+
+// userKeyCursor iterates the keys of a column family in the region, grouped by the encoded user keys they belong to.
+type userKeyCursor struct {
+	iter   *engine_util.CFIterator
+	cf     string
+	endKey []byte
+}
+
+func (c *userKeyCursor) valid() bool {
+	if !c.iter.Valid() {
+		return false
+	}
+	return len(c.endKey) == 0 || bytes.Compare(c.iter.Item().Key(), c.endKey) < 0
+}
+
+// userKey strips the timestamp from the keys of the default and write column families, the keys of the lock column
+// family are the encoded user keys already.
+func (c *userKeyCursor) userKey() []byte {
+	key := c.iter.Item().Key()
+	if c.cf == engine_util.CF_LOCK || len(key) < 8 {
+		return key
+	}
+	return key[:len(key)-8]
+}
+
+// scanUserKeys calls f with every encoded user key in the region in ascending order, and the size of its keys and
+// values in all the column families.
+func scanUserKeys(reader dbreader.DBReader, region *metapb.Region, f func(key []byte, size uint64)) {
+	cursors := make([]*userKeyCursor, 0, len(engine_util.CFs))
+	for _, cf := range engine_util.CFs {
+		c := &userKeyCursor{iter: reader.IterCF(cf), cf: cf, endKey: region.EndKey}
+		defer c.iter.Close()
+		c.iter.Seek(region.StartKey)
+		cursors = append(cursors, c)
+	}
+	for {
+		var key []byte
+		for _, c := range cursors {
+			if c.valid() && (key == nil || bytes.Compare(c.userKey(), key) < 0) {
+				key = c.userKey()
+			}
+		}
+		if key == nil {
+			return
+		}
+		key = append([]byte{}, key...)
+		var size uint64
+		for _, c := range cursors {
+			for c.valid() && bytes.Equal(c.userKey(), key) {
+				item := c.iter.Item()
+				size += uint64(len(item.Key()) + item.ValueSize())
+				c.iter.Next()
+			}
+		}
+		f(key, size)
+	}
+}
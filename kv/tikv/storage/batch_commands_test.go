@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/tikv"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/exec"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tikvpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// batchCommandsStream receives reqs, then the end of the stream, and records the responses sent.
+type batchCommandsStream struct {
+	grpc.ServerStream
+	reqs  []*tikvpb.BatchCommandsRequest
+	resps []*tikvpb.BatchCommandsResponse
+}
+
+func (s *batchCommandsStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *batchCommandsStream) Recv() (*tikvpb.BatchCommandsRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return req, nil
+}
+
+func (s *batchCommandsStream) Send(resp *tikvpb.BatchCommandsResponse) error {
+	s.resps = append(s.resps, resp)
+	return nil
+}
+
+// responses returns the responses sent on the stream by their request ids.
+func (s *batchCommandsStream) responses(t *testing.T) map[uint64]*tikvpb.BatchCommandResponse {
+	resps := make(map[uint64]*tikvpb.BatchCommandResponse)
+	for _, batch := range s.resps {
+		require.Equal(t, len(batch.RequestIds), len(batch.Responses))
+		for i, id := range batch.RequestIds {
+			resps[id] = batch.Responses[i]
+		}
+	}
+	return resps
+}
+
+func TestBatchCommands(t *testing.T) {
+	db, cleanUp := newTestDB(t)
+	defer cleanUp()
+
+	inner := &batchInnerServer{MemInnerServer: inner_server.NewMemInnerServer(), db: db}
+	svr := tikv.NewServer(inner, exec.NewSeqScheduler(inner), exec.NewReadPool(inner, &config.DefaultConf.ReadPool))
+	defer svr.Stop()
+	cf := engine_util.CF_DEFAULT
+	put := func(key, value string) *tikvpb.BatchCommand {
+		return &tikvpb.BatchCommand{RawPut: &kvrpcpb.RawPutRequest{Cf: cf, Key: []byte(key), Value: []byte(value)}}
+	}
+	get := func(key string) *tikvpb.BatchCommand {
+		return &tikvpb.BatchCommand{RawGet: &kvrpcpb.RawGetRequest{Cf: cf, Key: []byte(key)}}
+	}
+
+	// The requests of several messages each get the response of their unary handler, under their request id.
+	stream := &batchCommandsStream{reqs: []*tikvpb.BatchCommandsRequest{
+		{Requests: []*tikvpb.BatchCommand{put("a", "1"), put("b", "2")}, RequestIds: []uint64{1, 2}},
+		{Requests: []*tikvpb.BatchCommand{put("c", "3")}, RequestIds: []uint64{3}},
+	}}
+	require.Nil(t, svr.BatchCommands(stream))
+	resps := stream.responses(t)
+	require.Len(t, resps, 3)
+	for id := uint64(1); id <= 3; id++ {
+		require.NotNil(t, resps[id].RawPut)
+		assert.Empty(t, resps[id].RawPut.Error)
+	}
+	assert.Equal(t, 3, inner.writes)
+
+	stream = &batchCommandsStream{reqs: []*tikvpb.BatchCommandsRequest{
+		{Requests: []*tikvpb.BatchCommand{get("a"), get("x"), {RawBatchGet: &kvrpcpb.RawBatchGetRequest{
+			Cf: cf, Keys: [][]byte{[]byte("b"), []byte("c")},
+		}}}, RequestIds: []uint64{10, 11, 12}},
+	}}
+	require.Nil(t, svr.BatchCommands(stream))
+	resps = stream.responses(t)
+	require.Len(t, resps, 3)
+	assert.Equal(t, []byte("1"), resps[10].RawGet.Value)
+	assert.True(t, resps[11].RawGet.NotFound)
+	assert.Equal(t, []*kvrpcpb.KvPair{
+		{Key: []byte("b"), Value: []byte("2")},
+		{Key: []byte("c"), Value: []byte("3")},
+	}, resps[12].RawBatchGet.Pairs)
+
+	// A message with a request id missing, or a command without a request, ends the stream with an error.
+	stream = &batchCommandsStream{reqs: []*tikvpb.BatchCommandsRequest{
+		{Requests: []*tikvpb.BatchCommand{get("a"), get("b")}, RequestIds: []uint64{1}},
+	}}
+	assert.NotNil(t, svr.BatchCommands(stream))
+	stream = &batchCommandsStream{reqs: []*tikvpb.BatchCommandsRequest{
+		{Requests: []*tikvpb.BatchCommand{{}}, RequestIds: []uint64{1}},
+	}}
+	assert.NotNil(t, svr.BatchCommands(stream))
+}
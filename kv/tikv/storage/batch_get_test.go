@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/coocood/badger"
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/dbreader"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/exec"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// staleInnerServer is a MemInnerServer which reads from db, and rejects reads of one region as if it is not found on
+// this store.
+type staleInnerServer struct {
+	*inner_server.MemInnerServer
+	db          *badger.DB
+	staleRegion uint64
+}
+
+func (s *staleInnerServer) Reader(ctx *kvrpcpb.Context) (dbreader.DBReader, error) {
+	if ctx.GetRegionId() == s.staleRegion {
+		return nil, &inner_server.RegionError{RequestErr: &errorpb.Error{
+			RegionNotFound: &errorpb.RegionNotFound{RegionId: s.staleRegion},
+		}}
+	}
+	return dbreader.NewRegionReader(s.db.NewTransaction(false), metapb.Region{}), nil
+}
+
+func TestBatchGetRegionError(t *testing.T) {
+	db, cleanUp := newTestDB(t)
+	defer cleanUp()
+
+	inner := &staleInnerServer{inner_server.NewMemInnerServer(), db, 2}
+	svr := tikv.NewServer(inner, exec.NewSeqScheduler(inner), exec.NewReadPool(inner, &config.DefaultConf.ReadPool))
+	defer svr.Stop()
+
+	// A single region request reports the region error for the whole request.
+	resp, err := svr.KvBatchGet(context.Background(), &kvrpcpb.BatchGetRequest{
+		Context: &kvrpcpb.Context{RegionId: 2},
+		Keys:    [][]byte{{1}},
+	})
+	require.Nil(t, err)
+	require.NotNil(t, resp.RegionError.GetRegionNotFound())
+	assert.Empty(t, resp.Pairs)
+
+	// A cross region request reports it for every key of the region only.
+	resp, err = svr.KvBatchGet(context.Background(), &kvrpcpb.BatchGetRequest{
+		Context: &kvrpcpb.Context{RegionId: 1},
+		Keys:    [][]byte{{1}},
+		RegionKeys: []*kvrpcpb.RegionKeys{
+			{Context: &kvrpcpb.Context{RegionId: 2}, Keys: [][]byte{{2}, {3}}},
+			{Context: &kvrpcpb.Context{RegionId: 3}, Keys: [][]byte{{4}}},
+		},
+	})
+	require.Nil(t, err)
+	assert.Nil(t, resp.RegionError)
+	require.Len(t, resp.Pairs, 2)
+	for i, key := range [][]byte{{2}, {3}} {
+		assert.Equal(t, key, resp.Pairs[i].Key)
+		assert.Equal(t, uint64(2), resp.Pairs[i].RegionError.GetRegionNotFound().GetRegionId())
+	}
+}
+
+func TestBatchGetWaitsForLock(t *testing.T) {
+	db, cleanUp := newTestDB(t)
+	defer cleanUp()
+
+	conf := *config.GetGlobalConf()
+	defer config.SetGlobalConf(&conf)
+	waitConf := conf
+	waitConf.Scheduler.LockWaitTimeout = "100ms"
+	config.SetGlobalConf(&waitConf)
+
+	inner := &batchInnerServer{MemInnerServer: inner_server.NewMemInnerServer(), db: db}
+	svr := tikv.NewServer(inner, exec.NewSeqScheduler(inner), exec.NewReadPool(inner, &config.DefaultConf.ReadPool))
+	defer svr.Stop()
+	ctx := context.Background()
+	wb := new(engine_util.WriteBatch)
+	for _, key := range [][]byte{{1}, {2}} {
+		wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey(key), mvcc.EncodeLockCFValue(&mvcc.Lock{
+			Type: mvcc.LockTypePut, Primary: []byte{1}, StartTS: 10, TTL: 100, ShortValue: []byte{42}}))
+	}
+	require.Nil(t, wb.WriteToDB(db))
+
+	// The lock is returned once the wait times out.
+	start := time.Now()
+	resp, err := svr.KvBatchGet(ctx, &kvrpcpb.BatchGetRequest{Context: &kvrpcpb.Context{}, Keys: [][]byte{{1}}, Version: 20})
+	require.Nil(t, err)
+	require.Len(t, resp.Pairs, 1)
+	assert.Equal(t, uint64(10), resp.Pairs[0].Error.GetLocked().GetLockVersion())
+	assert.True(t, time.Since(start) >= 100*time.Millisecond)
+
+	// The read is woken up by the commit of the lock and reads the committed value.
+	waitConf.Scheduler.LockWaitTimeout = "10s"
+	config.SetGlobalConf(&waitConf)
+	done := make(chan *kvrpcpb.BatchGetResponse, 1)
+	go func() {
+		resp, err := svr.KvBatchGet(ctx, &kvrpcpb.BatchGetRequest{Context: &kvrpcpb.Context{}, Keys: [][]byte{{1}, {2}}, Version: 20})
+		assert.Nil(t, err)
+		done <- resp
+	}()
+	time.Sleep(50 * time.Millisecond)
+	commitResp, err := svr.KvCommit(ctx, &kvrpcpb.CommitRequest{Context: &kvrpcpb.Context{}, Keys: [][]byte{{1}, {2}}, StartVersion: 10, CommitVersion: 15})
+	require.Nil(t, err)
+	require.Nil(t, commitResp.Error)
+	select {
+	case resp = <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the read isn't woken up by the commit")
+	}
+	require.Len(t, resp.Pairs, 2)
+	for _, pair := range resp.Pairs {
+		assert.Nil(t, pair.Error)
+		assert.Equal(t, []byte{42}, pair.Value)
+	}
+
+	// The read is woken up by deleting the range of the lock, and finds nothing.
+	wb = new(engine_util.WriteBatch)
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey([]byte{3}), mvcc.EncodeLockCFValue(&mvcc.Lock{
+		Type: mvcc.LockTypePut, Primary: []byte{3}, StartTS: 16, TTL: 100, ShortValue: []byte{42}}))
+	require.Nil(t, wb.WriteToDB(db))
+	go func() {
+		resp, err := svr.KvBatchGet(ctx, &kvrpcpb.BatchGetRequest{Context: &kvrpcpb.Context{}, Keys: [][]byte{{3}}, Version: 20})
+		assert.Nil(t, err)
+		done <- resp
+	}()
+	time.Sleep(50 * time.Millisecond)
+	deleteResp, err := svr.KvDeleteRange(ctx, &kvrpcpb.DeleteRangeRequest{Context: &kvrpcpb.Context{}, StartKey: []byte{3}, EndKey: []byte{4}})
+	require.Nil(t, err)
+	require.Empty(t, deleteResp.Error)
+	select {
+	case resp = <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the read isn't woken up by deleting the range")
+	}
+	assert.Empty(t, resp.Pairs)
+}
@@ -0,0 +1,162 @@
+package commands
+
+import (
+	"bytes"
+
+	"github.com/coocood/badger"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/kvstore"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// BatchGet implements the Command interface for reading a batch of keys of a single region at a version. A
+// cross-region request is split into one BatchGet per region by the server. A key locked by a transaction which may
+// commit before the version is reported with the lock in its pair, so the client can resolve it and retry just that
+// key; keys without a visible value are left out. If the request asks for the commit ts, each pair also tells the
+// version its value was committed at and whether the read bypassed a lock of the key.
+type BatchGet struct {
+	request  *kvrpcpb.BatchGetRequest
+	response kvrpcpb.BatchGetResponse
+}
+
+func NewBatchGet(request *kvrpcpb.BatchGetRequest) BatchGet {
+	return BatchGet{request, kvrpcpb.BatchGetResponse{}}
+}
+
+func (bg *BatchGet) BuildTxn(txn *kvstore.Txn) error {
+	locks, err := getLocks(txn, bg.request.Keys)
+	if err != nil {
+		return err
+	}
+	iter := txn.Reader.IterCF(engine_util.CF_WRITE)
+	defer iter.Close()
+	for i, key := range bg.request.Keys {
+		var lock *kvrpcpb.LockInfo
+		if locks[i] != nil {
+			if lock, err = readLockInfo(key, locks[i], bg.request.Version); err != nil {
+				return err
+			}
+		}
+		if lock != nil {
+			bg.response.Pairs = append(bg.response.Pairs, &kvrpcpb.KvPair{Key: key, Error: &kvrpcpb.KeyError{Locked: lock}})
+			continue
+		}
+		value, commitTS, err := getValue(txn, iter, key, bg.request.Version)
+		if err != nil {
+			return err
+		}
+		if value == nil {
+			continue
+		}
+		pair := &kvrpcpb.KvPair{Key: key, Value: value}
+		if bg.request.NeedCommitTs {
+			pair.CommitTs, pair.LockBypassed = commitTS, locks[i] != nil
+		}
+		bg.response.Pairs = append(bg.response.Pairs, pair)
+	}
+	return nil
+}
+
+// checkReadLock returns the lock of key if it blocks reading at version, that is, if the transaction holding it may
+// commit before version. Lock and pessimistic locks never change the value, so they don't block reads.
+func checkReadLock(txn *kvstore.Txn, key []byte, version uint64) (*kvrpcpb.LockInfo, error) {
+	val, err := txn.Reader.GetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey(key))
+	if err == badger.ErrKeyNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return readLockInfo(key, val, version)
+}
+
+// readLockInfo decodes val, the lock of key, and returns it if it blocks reading at version.
+func readLockInfo(key, val []byte, version uint64) (*kvrpcpb.LockInfo, error) {
+	lock, err := mvcc.DecodeLock(val)
+	if err != nil {
+		return nil, err
+	}
+	if lock.StartTS > version || lock.Type == mvcc.LockTypeLock || lock.Type == mvcc.LockTypePessimistic {
+		return nil, nil
+	}
+	return &kvrpcpb.LockInfo{
+		PrimaryLock: lock.Primary,
+		LockVersion: lock.StartTS,
+		Key:         key,
+		LockTtl:     lock.TTL,
+		LockType:    lockTypeToOp(lock.Type),
+	}, nil
+}
+
+// getLocks reads the locks of keys in one pass, the encoded locks are returned in the order of keys, nil for the keys
+// not locked.
+func getLocks(txn *kvstore.Txn, keys [][]byte) ([][]byte, error) {
+	lockKeys := make([][]byte, len(keys))
+	for i, key := range keys {
+		lockKeys[i] = mvcc.EncodeLockKey(key)
+	}
+	return txn.Reader.MultiGetCF(engine_util.CF_LOCK, lockKeys)
+}
+
+// lastChangeSeekBound is the number of records a read steps over before it follows the last change hint of a lock or
+// rollback record instead, stepping over a few records is cheaper than a seek.
+const lastChangeSeekBound = 8
+
+// getValue returns the value of key committed most recently at or before version with its commit ts, or nil if there
+// is none or it was deleted.
+func getValue(txn *kvstore.Txn, iter *engine_util.CFIterator, key []byte, version uint64) ([]byte, uint64, error) {
+	iter.Seek(mvcc.EncodeKey(key, version))
+	for iter.Valid() {
+		item := iter.Item()
+		userKey, commitTS, err := mvcc.DecodeKey(item.Key())
+		if err != nil {
+			return nil, 0, err
+		}
+		if !bytes.Equal(userKey, key) {
+			return nil, 0, nil
+		}
+		val, err := item.Value()
+		if err != nil {
+			return nil, 0, err
+		}
+		write, err := mvcc.DecodeWriteCFValue(val)
+		if err != nil {
+			return nil, 0, err
+		}
+		switch write.Type {
+		case mvcc.WriteTypePut:
+			if write.ShortValue != nil {
+				return write.ShortValue, commitTS, nil
+			}
+			value, err := txn.Reader.GetCF(engine_util.CF_DEFAULT, mvcc.EncodeKey(key, write.StartTS))
+			return value, commitTS, err
+		case mvcc.WriteTypeDelete:
+			return nil, 0, nil
+		}
+		if write.LastChangeTS != 0 && write.VersionsToLastChange >= lastChangeSeekBound {
+			iter.Seek(mvcc.EncodeKey(key, write.LastChangeTS))
+		} else {
+			iter.Next()
+		}
+	}
+	return nil, 0, nil
+}
+
+func (bg *BatchGet) Context() *kvrpcpb.Context {
+	return bg.request.Context
+}
+
+func (bg *BatchGet) Response() (interface{}, error) {
+	return &bg.response, nil
+}
+
+func (bg *BatchGet) RegionError(err *errorpb.Error) interface{} {
+	if err == nil {
+		return nil
+	}
+
+	bg.response.RegionError = err
+	return &bg.response
+}
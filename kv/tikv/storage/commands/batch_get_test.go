@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchGet(t *testing.T) {
+	store := newTestStore(t)
+	defer store.close()
+
+	wb := new(engine_util.WriteBatch)
+	// a: a short value, overwritten after the read version.
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("a"), ts(20)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(15), []byte("a2")))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("a"), ts(5)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(4), []byte("a1")))
+	// b: a value in the default column family, below a rollback.
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("b"), ts(8)), mvcc.EncodeWriteCFValue(mvcc.WriteTypeRollback, ts(8), nil))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("b"), ts(5)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(4), nil))
+	wb.SetCF(engine_util.CF_DEFAULT, mvcc.EncodeKey([]byte("b"), ts(4)), []byte("b1"))
+	// c: deleted.
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("c"), ts(6)), mvcc.EncodeWriteCFValue(mvcc.WriteTypeDelete, ts(6), nil))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("c"), ts(5)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(4), []byte("c1")))
+	// d: locked before the read version.
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey([]byte("d")), mvcc.EncodeLockCFValue(&mvcc.Lock{
+		Type: mvcc.LockTypePut, Primary: []byte("d"), StartTS: ts(8), TTL: 100}))
+	// e: locked after the read version.
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey([]byte("e")), mvcc.EncodeLockCFValue(&mvcc.Lock{
+		Type: mvcc.LockTypePut, Primary: []byte("d"), StartTS: ts(12), TTL: 100}))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("e"), ts(5)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(4), []byte("e1")))
+	store.write(wb)
+
+	cmd := NewBatchGet(&kvrpcpb.BatchGetRequest{
+		Keys:    [][]byte{[]byte("a"), []byte("b"), []byte("c"), []byte("d"), []byte("e"), []byte("f")},
+		Version: ts(10),
+	})
+	resp, _ := store.run(&cmd)
+	pairs := resp.(*kvrpcpb.BatchGetResponse).Pairs
+
+	require.Len(t, pairs, 4)
+	assert.Equal(t, &kvrpcpb.KvPair{Key: []byte("a"), Value: []byte("a1")}, pairs[0])
+	assert.Equal(t, &kvrpcpb.KvPair{Key: []byte("b"), Value: []byte("b1")}, pairs[1])
+	assert.Equal(t, []byte("d"), pairs[2].Key)
+	assert.Equal(t, ts(8), pairs[2].Error.GetLocked().GetLockVersion())
+	assert.Equal(t, &kvrpcpb.KvPair{Key: []byte("e"), Value: []byte("e1")}, pairs[3])
+}
+
+func TestBatchGetCommitTs(t *testing.T) {
+	store := newTestStore(t)
+	defer store.close()
+
+	wb := new(engine_util.WriteBatch)
+	// a: a value in the default column family, below a rollback.
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("a"), ts(8)), mvcc.EncodeWriteCFValue(mvcc.WriteTypeRollback, ts(8), nil))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("a"), ts(5)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(4), nil))
+	wb.SetCF(engine_util.CF_DEFAULT, mvcc.EncodeKey([]byte("a"), ts(4)), []byte("a1"))
+	// b: locked after the read version.
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey([]byte("b")), mvcc.EncodeLockCFValue(&mvcc.Lock{
+		Type: mvcc.LockTypePut, Primary: []byte("b"), StartTS: ts(12), TTL: 100}))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("b"), ts(7)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(6), []byte("b1")))
+	store.write(wb)
+
+	cmd := NewBatchGet(&kvrpcpb.BatchGetRequest{
+		Keys:         [][]byte{[]byte("a"), []byte("b")},
+		Version:      ts(10),
+		NeedCommitTs: true,
+	})
+	resp, _ := store.run(&cmd)
+	pairs := resp.(*kvrpcpb.BatchGetResponse).Pairs
+
+	require.Len(t, pairs, 2)
+	assert.Equal(t, &kvrpcpb.KvPair{Key: []byte("a"), Value: []byte("a1"), CommitTs: ts(5)}, pairs[0])
+	assert.Equal(t, &kvrpcpb.KvPair{Key: []byte("b"), Value: []byte("b1"), CommitTs: ts(7), LockBypassed: true}, pairs[1])
+}
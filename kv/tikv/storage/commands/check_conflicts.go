@@ -0,0 +1,137 @@
+package commands
+
+import (
+	"bytes"
+	"math"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/kvstore"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// CheckConflicts implements the Command interface for pre-validating the keys of an optimistic transaction. A key
+// conflicts if another transaction has committed it after the start ts, or holds a lock on it. The check takes no
+// locks, so a key reported clean may still conflict by the time the transaction is prewritten.
+type CheckConflicts struct {
+	request  *kvrpcpb.CheckConflictsRequest
+	response kvrpcpb.CheckConflictsResponse
+}
+
+func NewCheckConflicts(request *kvrpcpb.CheckConflictsRequest) CheckConflicts {
+	return CheckConflicts{request, kvrpcpb.CheckConflictsResponse{}}
+}
+
+func (cc *CheckConflicts) BuildTxn(txn *kvstore.Txn) error {
+	locks, err := getLocks(txn, cc.request.Keys)
+	if err != nil {
+		return err
+	}
+	iter := txn.Reader.IterCF(engine_util.CF_WRITE)
+	defer iter.Close()
+	for i, key := range cc.request.Keys {
+		var lock *kvrpcpb.LockInfo
+		if locks[i] != nil {
+			if lock, err = cc.checkLock(key, locks[i]); err != nil {
+				return err
+			}
+		}
+		if lock != nil {
+			cc.response.Errors = append(cc.response.Errors, &kvrpcpb.KeyError{Locked: lock})
+			continue
+		}
+		conflict, err := cc.checkWrite(iter, key)
+		if err != nil {
+			return err
+		}
+		if conflict != nil {
+			cc.response.Errors = append(cc.response.Errors, &kvrpcpb.KeyError{Conflict: conflict})
+		}
+	}
+	return nil
+}
+
+// checkLock decodes val, the lock of key, and returns it if it's held by another transaction and is not expired.
+func (cc *CheckConflicts) checkLock(key, val []byte) (*kvrpcpb.LockInfo, error) {
+	lock, err := mvcc.DecodeLock(val)
+	if err != nil {
+		return nil, err
+	}
+	if lock.StartTS == cc.request.StartVersion {
+		return nil, nil
+	}
+	if cc.request.CurrentVersion != 0 && lock.IsExpired(cc.request.CurrentVersion) {
+		return nil, nil
+	}
+	return &kvrpcpb.LockInfo{
+		PrimaryLock: lock.Primary,
+		LockVersion: lock.StartTS,
+		Key:         key,
+		LockTtl:     lock.TTL,
+		LockType:    lockTypeToOp(lock.Type),
+	}, nil
+}
+
+// checkWrite returns the newest write of key committed after the start ts. Rollbacks and lock records don't change
+// the value of a key, so they don't conflict.
+func (cc *CheckConflicts) checkWrite(iter *engine_util.CFIterator, key []byte) (*kvrpcpb.WriteConflict, error) {
+	for iter.Seek(mvcc.EncodeKey(key, math.MaxUint64)); iter.Valid(); iter.Next() {
+		item := iter.Item()
+		userKey, commitTS, err := mvcc.DecodeKey(item.Key())
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(userKey, key) || commitTS <= cc.request.StartVersion {
+			return nil, nil
+		}
+		val, err := item.Value()
+		if err != nil {
+			return nil, err
+		}
+		write, err := mvcc.DecodeWriteCFValue(val)
+		if err != nil {
+			return nil, err
+		}
+		if write.Type == mvcc.WriteTypeRollback || write.Type == mvcc.WriteTypeLock {
+			continue
+		}
+		return &kvrpcpb.WriteConflict{
+			StartTs:          cc.request.StartVersion,
+			ConflictTs:       write.StartTS,
+			Key:              key,
+			ConflictCommitTs: commitTS,
+		}, nil
+	}
+	return nil, nil
+}
+
+func lockTypeToOp(tp mvcc.LockType) kvrpcpb.Op {
+	switch tp {
+	case mvcc.LockTypeDelete:
+		return kvrpcpb.Op_Del
+	case mvcc.LockTypeLock:
+		return kvrpcpb.Op_Lock
+	case mvcc.LockTypePessimistic:
+		return kvrpcpb.Op_PessimisticLock
+	default:
+		return kvrpcpb.Op_Put
+	}
+}
+
+func (cc *CheckConflicts) Context() *kvrpcpb.Context {
+	return cc.request.Context
+}
+
+func (cc *CheckConflicts) Response() (interface{}, error) {
+	return &cc.response, nil
+}
+
+func (cc *CheckConflicts) RegionError(err *errorpb.Error) interface{} {
+	if err == nil {
+		return nil
+	}
+
+	cc.response.RegionError = err
+	return &cc.response
+}
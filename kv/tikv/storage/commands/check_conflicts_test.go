@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckConflicts(t *testing.T) {
+	store := newTestStore(t)
+	defer store.close()
+
+	wb := new(engine_util.WriteBatch)
+	// a: committed before the start ts.
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("a"), ts(5)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(4), nil))
+	// b: committed after the start ts, below a rollback which doesn't conflict.
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("b"), ts(30)), mvcc.EncodeWriteCFValue(mvcc.WriteTypeRollback, ts(30), nil))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("b"), ts(20)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(15), nil))
+	// c: only locked after the start ts.
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("c"), ts(20)), mvcc.EncodeWriteCFValue(mvcc.WriteTypeLock, ts(15), nil))
+	// d: locked by another transaction.
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey([]byte("d")), mvcc.EncodeLockCFValue(&mvcc.Lock{
+		Type: mvcc.LockTypePut, Primary: []byte("d"), StartTS: ts(8), TTL: 100}))
+	// e: locked by the transaction itself.
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey([]byte("e")), mvcc.EncodeLockCFValue(&mvcc.Lock{
+		Type: mvcc.LockTypeDelete, Primary: []byte("d"), StartTS: ts(10), TTL: 100}))
+	// f: a newer version of a key which is a prefix of it doesn't conflict.
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("ff"), ts(20)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(15), nil))
+	store.write(wb)
+
+	check := func(currentTS uint64) *kvrpcpb.CheckConflictsResponse {
+		cmd := NewCheckConflicts(&kvrpcpb.CheckConflictsRequest{
+			Keys:           [][]byte{[]byte("a"), []byte("b"), []byte("c"), []byte("d"), []byte("e"), []byte("f"), []byte("g")},
+			StartVersion:   ts(10),
+			CurrentVersion: currentTS,
+		})
+		resp, _ := store.run(&cmd)
+		return resp.(*kvrpcpb.CheckConflictsResponse)
+	}
+
+	resp := check(0)
+	require.Len(t, resp.Errors, 2)
+	assert.Equal(t, &kvrpcpb.WriteConflict{
+		StartTs: ts(10), ConflictTs: ts(15), Key: []byte("b"), ConflictCommitTs: ts(20),
+	}, resp.Errors[0].Conflict)
+	assert.Equal(t, &kvrpcpb.LockInfo{
+		PrimaryLock: []byte("d"), LockVersion: ts(8), Key: []byte("d"), LockTtl: 100, LockType: kvrpcpb.Op_Put,
+	}, resp.Errors[1].Locked)
+
+	// The lock of d is still alive.
+	resp = check(ts(50))
+	require.Len(t, resp.Errors, 2)
+	assert.NotNil(t, resp.Errors[1].Locked)
+
+	// The lock of d has expired.
+	resp = check(ts(108))
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, []byte("b"), resp.Errors[0].Conflict.Key)
+}
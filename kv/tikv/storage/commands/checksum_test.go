@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"hash/crc64"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/coprocessor"
+	"github.com/pingcap/tipb/go-tipb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCrc64Xor(t *testing.T) {
+	rows := [][2][]byte{
+		{[]byte("t1_r1"), []byte("v1")},
+		{[]byte("t1_r2"), []byte("value2")},
+		{[]byte("t1_r3"), nil},
+	}
+
+	var forward, backward crc64Xor
+	for i := range rows {
+		forward.update(rows[i][0], rows[i][1])
+		backward.update(rows[len(rows)-1-i][0], rows[len(rows)-1-i][1])
+	}
+	assert.Equal(t, forward.ChecksumResponse, backward.ChecksumResponse)
+
+	var expect uint64
+	for _, row := range rows {
+		expect ^= crc64.Checksum(append(append([]byte{}, row[0]...), row[1]...), crcTable)
+	}
+	assert.Equal(t, expect, forward.Checksum)
+	assert.Equal(t, uint64(3), forward.TotalKvs)
+	assert.Equal(t, uint64(23), forward.TotalBytes)
+}
+
+func TestChecksum(t *testing.T) {
+	store := newTestStore(t)
+	defer store.close()
+
+	wb := new(engine_util.WriteBatch)
+	// a: a short value, overwritten after the start ts.
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("a"), ts(20)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(15), []byte("a2")))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("a"), ts(5)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(4), []byte("a1")))
+	// b: a value in the default column family.
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("b"), ts(5)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(4), nil))
+	wb.SetCF(engine_util.CF_DEFAULT, mvcc.EncodeKey([]byte("b"), ts(4)), []byte("b1"))
+	// c: deleted.
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("c"), ts(6)), mvcc.EncodeWriteCFValue(mvcc.WriteTypeDelete, ts(6), nil))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("c"), ts(5)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(4), []byte("c1")))
+	// e: out of the ranges.
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("e"), ts(5)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(4), []byte("e1")))
+	// g: locked after the start ts.
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey([]byte("g")), mvcc.EncodeLockCFValue(&mvcc.Lock{
+		Type: mvcc.LockTypePut, Primary: []byte("g"), StartTS: ts(12), TTL: 100}))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("g"), ts(5)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(4), []byte("g1")))
+	// h: locked before the start ts.
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey([]byte("h")), mvcc.EncodeLockCFValue(&mvcc.Lock{
+		Type: mvcc.LockTypePut, Primary: []byte("h"), StartTS: ts(8), TTL: 100}))
+	store.write(wb)
+
+	checksum := func(ranges ...*coprocessor.KeyRange) *coprocessor.Response {
+		data, err := (&tipb.ChecksumRequest{Algorithm: tipb.ChecksumAlgorithm_Crc64_Xor}).Marshal()
+		require.Nil(t, err)
+		cmd := NewChecksum(&coprocessor.Request{Tp: ReqTypeChecksum, Data: data, StartTs: ts(10), Ranges: ranges})
+		resp, _ := store.run(&cmd)
+		return resp.(*coprocessor.Response)
+	}
+
+	resp := checksum(&coprocessor.KeyRange{Start: []byte("a"), End: []byte("d")},
+		&coprocessor.KeyRange{Start: []byte("g"), End: []byte("h")})
+	require.Empty(t, resp.OtherError)
+	require.Nil(t, resp.Locked)
+	var sum tipb.ChecksumResponse
+	require.Nil(t, sum.Unmarshal(resp.Data))
+	var expect crc64Xor
+	expect.update([]byte("a"), []byte("a1"))
+	expect.update([]byte("b"), []byte("b1"))
+	expect.update([]byte("g"), []byte("g1"))
+	assert.Equal(t, expect.ChecksumResponse, sum)
+
+	// A lock which may commit before the start ts blocks the checksum.
+	resp = checksum(&coprocessor.KeyRange{Start: []byte("a"), End: []byte("z")})
+	assert.Equal(t, ts(8), resp.Locked.GetLockVersion())
+	assert.Nil(t, resp.Data)
+}
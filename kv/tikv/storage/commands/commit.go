@@ -0,0 +1,157 @@
+package commands
+
+import (
+	"bytes"
+
+	"github.com/coocood/badger"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/kvstore"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// Commit implements the Command interface for committing the prewritten keys of a transaction. Committing a key
+// which the transaction has already committed succeeds, so a client may commit secondaries in the background after
+// the primary and retry them freely.
+type Commit struct {
+	request  *kvrpcpb.CommitRequest
+	response kvrpcpb.CommitResponse
+}
+
+func NewCommit(request *kvrpcpb.CommitRequest) Commit {
+	return Commit{request, kvrpcpb.CommitResponse{}}
+}
+
+func (c *Commit) BuildTxn(txn *kvstore.Txn) error {
+	startTS, commitTS := c.request.StartVersion, c.request.CommitVersion
+	for _, key := range c.request.Keys {
+		lock, err := c.getLock(txn, key)
+		if err != nil {
+			return err
+		}
+		if lock != nil {
+			writeType, ok := lockTypeToWriteType(lock.Type)
+			if !ok {
+				c.abort("pessimistic lock not prewritten")
+				txn.Writes = nil
+				return nil
+			}
+			write := &mvcc.Write{Type: writeType, StartTS: startTS, ShortValue: lock.ShortValue}
+			if writeType == mvcc.WriteTypeLock {
+				if write.LastChangeTS, write.VersionsToLastChange, err = lastChange(txn, key, commitTS); err != nil {
+					return err
+				}
+			}
+			txn.PutCF(engine_util.CF_WRITE, mvcc.EncodeKey(key, commitTS), mvcc.EncodeWrite(write))
+			txn.DeleteCF(engine_util.CF_LOCK, mvcc.EncodeLockKey(key))
+			continue
+		}
+
+		// The lock is gone, the key is either committed already or rolled back.
+		write, err := findWrite(txn, key, startTS)
+		if err != nil {
+			return err
+		}
+		if write == nil || write.Type == mvcc.WriteTypeRollback {
+			c.abort("txn lock not found")
+			txn.Writes = nil
+			return nil
+		}
+	}
+	return nil
+}
+
+// getLock returns the lock of key if it's held by the transaction.
+func (c *Commit) getLock(txn *kvstore.Txn, key []byte) (*mvcc.Lock, error) {
+	val, err := txn.Reader.GetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey(key))
+	if err == badger.ErrKeyNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	lock, err := mvcc.DecodeLock(val)
+	if err != nil {
+		return nil, err
+	}
+	if lock.StartTS != c.request.StartVersion {
+		return nil, nil
+	}
+	return lock, nil
+}
+
+// lastChange returns the last change hint of a lock or rollback record written to key at ts, from the newest record
+// below it: the commit ts of the newest put or delete, and the number of records down to it. The hint is 0 if there
+// is no record below, or it's a lock or rollback record without a hint.
+func lastChange(txn *kvstore.Txn, key []byte, ts uint64) (uint64, uint64, error) {
+	iter := txn.Reader.IterCF(engine_util.CF_WRITE)
+	defer iter.Close()
+	for iter.Seek(mvcc.EncodeKey(key, ts)); iter.Valid(); iter.Next() {
+		item := iter.Item()
+		userKey, commitTS, err := mvcc.DecodeKey(item.Key())
+		if err != nil {
+			return 0, 0, err
+		}
+		if !bytes.Equal(userKey, key) {
+			return 0, 0, nil
+		}
+		if commitTS >= ts {
+			continue
+		}
+		val, err := item.Value()
+		if err != nil {
+			return 0, 0, err
+		}
+		write, err := mvcc.DecodeWriteCFValue(val)
+		if err != nil {
+			return 0, 0, err
+		}
+		switch write.Type {
+		case mvcc.WriteTypePut, mvcc.WriteTypeDelete:
+			return commitTS, 1, nil
+		}
+		if write.LastChangeTS == 0 {
+			return 0, 0, nil
+		}
+		return write.LastChangeTS, write.VersionsToLastChange + 1, nil
+	}
+	return 0, 0, nil
+}
+
+func (c *Commit) abort(reason string) {
+	c.response.Error = &kvrpcpb.KeyError{Abort: reason}
+}
+
+func lockTypeToWriteType(tp mvcc.LockType) (mvcc.WriteType, bool) {
+	switch tp {
+	case mvcc.LockTypePut:
+		return mvcc.WriteTypePut, true
+	case mvcc.LockTypeDelete:
+		return mvcc.WriteTypeDelete, true
+	case mvcc.LockTypeLock:
+		return mvcc.WriteTypeLock, true
+	}
+	return 0, false
+}
+
+func (c *Commit) WillWrite() [][]byte {
+	return c.request.Keys
+}
+
+func (c *Commit) Context() *kvrpcpb.Context {
+	return c.request.Context
+}
+
+func (c *Commit) Response() (interface{}, error) {
+	return &c.response, nil
+}
+
+func (c *Commit) RegionError(err *errorpb.Error) interface{} {
+	if err == nil {
+		return nil
+	}
+
+	c.response.RegionError = err
+	return &c.response
+}
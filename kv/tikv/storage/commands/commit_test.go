@@ -0,0 +1,130 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommit(t *testing.T) {
+	store := newTestStore(t)
+	defer store.close()
+
+	wb := new(engine_util.WriteBatch)
+	// a: prewritten by the transaction.
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey([]byte("a")), mvcc.EncodeLockCFValue(&mvcc.Lock{
+		Type: mvcc.LockTypePut, Primary: []byte("a"), StartTS: ts(10), TTL: 100, ShortValue: []byte("v")}))
+	// b: already committed by the transaction.
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("b"), ts(20)), mvcc.EncodeWriteCFValue(mvcc.WriteTypeDelete, ts(10), nil))
+	// c: rolled back.
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("c"), ts(10)), mvcc.EncodeWriteCFValue(mvcc.WriteTypeRollback, ts(10), nil))
+	store.write(wb)
+
+	commit := func(keys ...string) (*kvrpcpb.CommitResponse, []inner_server.Modify) {
+		req := &kvrpcpb.CommitRequest{StartVersion: ts(10), CommitVersion: ts(20)}
+		for _, key := range keys {
+			req.Keys = append(req.Keys, []byte(key))
+		}
+		cmd := NewCommit(req)
+		resp, writes := store.run(&cmd)
+		return resp.(*kvrpcpb.CommitResponse), writes
+	}
+
+	resp, writes := commit("a", "b")
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, []inner_server.Modify{
+		{Type: inner_server.ModifyTypePut, Data: inner_server.Put{Key: mvcc.EncodeKey([]byte("a"), ts(20)),
+			Value: mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(10), []byte("v")), Cf: engine_util.CF_WRITE}},
+		{Type: inner_server.ModifyTypeDelete, Data: inner_server.Delete{Key: mvcc.EncodeLockKey([]byte("a")), Cf: engine_util.CF_LOCK}},
+	}, writes)
+
+	// Committing an already committed secondary again is tolerated.
+	resp, writes = commit("b")
+	assert.Nil(t, resp.Error)
+	assert.Empty(t, writes)
+
+	// A rolled back or never prewritten key can't be committed.
+	for _, key := range []string{"c", "d"} {
+		resp, writes = commit("a", key)
+		require.NotNil(t, resp.Error)
+		assert.NotEmpty(t, resp.Error.Abort)
+		assert.Empty(t, writes)
+	}
+}
+
+func TestLastChange(t *testing.T) {
+	store := newTestStore(t)
+	defer store.close()
+
+	key := []byte("counter")
+	run := func(cmd testCommand) {
+		_, writes := store.run(cmd)
+		store.apply(writes)
+	}
+	// commit prewrites the counter with a lock of type tp at startTS and commits it right after.
+	commit := func(tp mvcc.LockType, startTS uint64, value string) {
+		wb := new(engine_util.WriteBatch)
+		wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey(key), mvcc.EncodeLockCFValue(&mvcc.Lock{
+			Type: tp, Primary: key, StartTS: startTS, TTL: 100, ShortValue: []byte(value)}))
+		store.write(wb)
+		cmd := NewCommit(&kvrpcpb.CommitRequest{Keys: [][]byte{key}, StartVersion: startTS, CommitVersion: startTS + 1})
+		run(&cmd)
+	}
+	get := func(version uint64) string {
+		cmd := NewBatchGet(&kvrpcpb.BatchGetRequest{Keys: [][]byte{key}, Version: version})
+		resp, _ := store.run(&cmd)
+		pairs := resp.(*kvrpcpb.BatchGetResponse).Pairs
+		if len(pairs) == 0 {
+			return ""
+		}
+		return string(pairs[0].Value)
+	}
+	newest := func() *mvcc.Write {
+		val, err := engine_util.GetCF(store.db, engine_util.CF_WRITE, mvcc.EncodeKey(key, ts(99)+1))
+		require.Nil(t, err)
+		write, err := mvcc.DecodeWriteCFValue(val)
+		require.Nil(t, err)
+		return write
+	}
+
+	// Records written before the counter ever changes have no hint.
+	commit(mvcc.LockTypeLock, ts(1), "")
+	// The counter is set, then locked by many transactions which don't update it, one of which rolls back.
+	commit(mvcc.LockTypePut, ts(2), "1")
+	for i := uint64(3); i < 50; i++ {
+		commit(mvcc.LockTypeLock, ts(i), "")
+	}
+	rollback := NewBatchRollback(&kvrpcpb.BatchRollbackRequest{Keys: [][]byte{key}, StartVersion: ts(50)})
+	run(&rollback)
+	commit(mvcc.LockTypePut, ts(51), "2")
+	for i := uint64(52); i < 100; i++ {
+		commit(mvcc.LockTypeLock, ts(i), "")
+	}
+
+	write := newest()
+	assert.Equal(t, mvcc.WriteTypeLock, write.Type)
+	assert.Equal(t, ts(51)+1, write.LastChangeTS)
+	assert.Equal(t, uint64(48), write.VersionsToLastChange)
+	val, err := engine_util.GetCF(store.db, engine_util.CF_WRITE, mvcc.EncodeKey(key, ts(50)))
+	require.Nil(t, err)
+	write, err = mvcc.DecodeWriteCFValue(val)
+	require.Nil(t, err)
+	assert.Equal(t, &mvcc.Write{Type: mvcc.WriteTypeRollback, StartTS: ts(50), LastChangeTS: ts(2) + 1, VersionsToLastChange: 48}, write)
+	val, err = engine_util.GetCF(store.db, engine_util.CF_WRITE, mvcc.EncodeKey(key, ts(1)+1))
+	require.Nil(t, err)
+	write, err = mvcc.DecodeWriteCFValue(val)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(0), write.LastChangeTS)
+
+	// Reads follow the hints down to the value visible at their version.
+	assert.Equal(t, "2", get(ts(200)))
+	assert.Equal(t, "2", get(ts(60)))
+	assert.Equal(t, "1", get(ts(51)))
+	assert.Equal(t, "1", get(ts(10)))
+	assert.Equal(t, "", get(ts(2)))
+}
@@ -0,0 +1,199 @@
+package commands
+
+import (
+	"bytes"
+	"math"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/kvstore"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// DefaultGCLimit is the number of keys collected by a GC request without a limit.
+const DefaultGCLimit = 1024
+
+// VersionRetention caps the number of versions kept for every key with the prefix, for keyspaces whose history is
+// never read. A put or a delete is a version, rollback records are not counted.
+type VersionRetention struct {
+	Prefix      []byte
+	MaxVersions int
+}
+
+// maxVersions returns the cap of the longest prefix matching key, 0 if there is none.
+func maxVersions(retentions []VersionRetention, key []byte) int {
+	max, prefixLen := 0, -1
+	for _, r := range retentions {
+		if len(r.Prefix) > prefixLen && bytes.HasPrefix(key, r.Prefix) {
+			max, prefixLen = r.MaxVersions, len(r.Prefix)
+		}
+	}
+	return max
+}
+
+// GCScan implements the Command interface for the first pass of a GC, finding the keys of the range which have
+// records in the write CF, up to the limit of the request. The keys are collected by the GC it returns, which holds
+// their latches, so the collection doesn't race with the transactions writing them.
+type GCScan struct {
+	request  *kvrpcpb.GCRequest
+	response kvrpcpb.GCResponse
+	keys     [][]byte
+}
+
+func NewGCScan(request *kvrpcpb.GCRequest) GCScan {
+	return GCScan{request: request}
+}
+
+func (s *GCScan) BuildTxn(txn *kvstore.Txn) error {
+	limit := int(s.request.Limit)
+	if limit == 0 {
+		limit = DefaultGCLimit
+	}
+	iter := txn.Reader.IterCF(engine_util.CF_WRITE)
+	defer iter.Close()
+	for iter.Seek(mvcc.EncodeKey(s.request.StartKey, math.MaxUint64)); iter.Valid(); iter.Next() {
+		key, _, err := mvcc.DecodeKey(iter.Item().Key())
+		if err != nil {
+			return err
+		}
+		if len(s.keys) > 0 && bytes.Equal(key, s.keys[len(s.keys)-1]) {
+			continue
+		}
+		if len(s.request.EndKey) > 0 && bytes.Compare(key, s.request.EndKey) >= 0 {
+			break
+		}
+		if len(s.keys) == limit {
+			s.response.NextKey = key
+			break
+		}
+		s.keys = append(s.keys, key)
+	}
+	return nil
+}
+
+// GC returns the command collecting the keys found by the scan.
+func (s *GCScan) GC(retentions []VersionRetention) GC {
+	return GC{
+		request:    s.request,
+		response:   kvrpcpb.GCResponse{NextKey: s.response.NextKey},
+		retentions: retentions,
+		keys:       s.keys,
+	}
+}
+
+func (s *GCScan) Context() *kvrpcpb.Context {
+	return s.request.Context
+}
+
+func (s *GCScan) Response() (interface{}, error) {
+	return &s.response, nil
+}
+
+func (s *GCScan) RegionError(err *errorpb.Error) interface{} {
+	if err == nil {
+		return nil
+	}
+
+	s.response.RegionError = err
+	return &s.response
+}
+
+// GC implements the Command interface for collecting the old versions of the keys found by a GCScan. Of the versions
+// committed at or before the safe point, only the newest put is kept, since no read at or after the safe point can
+// see the rest. The versions beyond the cap of a version retention are collected no matter how recent they are.
+type GC struct {
+	request    *kvrpcpb.GCRequest
+	response   kvrpcpb.GCResponse
+	retentions []VersionRetention
+	keys       [][]byte
+}
+
+func (gc *GC) BuildTxn(txn *kvstore.Txn) error {
+	iter := txn.Reader.IterCF(engine_util.CF_WRITE)
+	defer iter.Close()
+	for _, key := range gc.keys {
+		iter.Seek(mvcc.EncodeKey(key, math.MaxUint64))
+		if err := gc.collectKey(txn, iter, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (gc *GC) WillWrite() [][]byte {
+	return gc.keys
+}
+
+// collectKey steps iter over all the versions of key, newest first, and deletes the ones to collect.
+func (gc *GC) collectKey(txn *kvstore.Txn, iter *engine_util.CFIterator, key []byte) error {
+	max := maxVersions(gc.retentions, key)
+	versions := 0
+	// Whether the newest version at or before the safe point is passed, all the records after it are collected.
+	passedSafePoint := false
+	for ; iter.Valid(); iter.Next() {
+		item := iter.Item()
+		userKey, commitTS, err := mvcc.DecodeKey(item.Key())
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(userKey, key) {
+			return nil
+		}
+		val, err := item.Value()
+		if err != nil {
+			return err
+		}
+		write, err := mvcc.DecodeWriteCFValue(val)
+		if err != nil {
+			return err
+		}
+
+		collect := passedSafePoint
+		switch write.Type {
+		case mvcc.WriteTypePut, mvcc.WriteTypeDelete:
+			versions++
+			if max > 0 && versions > max {
+				collect = true
+			}
+			if commitTS <= gc.request.SafePoint && !passedSafePoint {
+				passedSafePoint = true
+				// Nothing is visible through a delete, so it goes with the versions before it.
+				if write.Type == mvcc.WriteTypeDelete {
+					collect = true
+				}
+			}
+		default:
+			// A rollback record is kept until the safe point passes it, to reject a late prewrite of its transaction.
+			if commitTS <= gc.request.SafePoint {
+				collect = true
+			}
+		}
+		if !collect {
+			continue
+		}
+		txn.DeleteCF(engine_util.CF_WRITE, item.KeyCopy(nil))
+		if write.Type == mvcc.WriteTypePut && write.ShortValue == nil {
+			txn.DeleteCF(engine_util.CF_DEFAULT, mvcc.EncodeKey(key, write.StartTS))
+		}
+		gc.response.VersionsDeleted++
+	}
+	return nil
+}
+
+func (gc *GC) Context() *kvrpcpb.Context {
+	return gc.request.Context
+}
+
+func (gc *GC) Response() (interface{}, error) {
+	return &gc.response, nil
+}
+
+func (gc *GC) RegionError(err *errorpb.Error) interface{} {
+	if err == nil {
+		return nil
+	}
+
+	gc.response.RegionError = err
+	return &gc.response
+}
@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGC(t *testing.T) {
+	store := newTestStore(t)
+	defer store.close()
+
+	put := func(wb *engine_util.WriteBatch, key string, commitTS uint64, shortValue []byte) {
+		wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte(key), commitTS), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, commitTS-1, shortValue))
+		if shortValue == nil {
+			wb.SetCF(engine_util.CF_DEFAULT, mvcc.EncodeKey([]byte(key), commitTS-1), []byte("v"))
+		}
+	}
+	wb := new(engine_util.WriteBatch)
+	// a: the put at 15 is visible at the safe point, the records before it are collected.
+	put(wb, "a", 30, []byte("a3"))
+	put(wb, "a", 15, nil)
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("a"), 12), mvcc.EncodeWriteCFValue(mvcc.WriteTypeRollback, 12, nil))
+	put(wb, "a", 10, []byte("a1"))
+	// b: deleted before the safe point.
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("b"), 18), mvcc.EncodeWriteCFValue(mvcc.WriteTypeDelete, 17, nil))
+	put(wb, "b", 5, nil)
+	// c: under a retention of 2 versions, the rollback record after the safe point is kept.
+	put(wb, "c", 40, []byte("c3"))
+	put(wb, "c", 35, []byte("c2"))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("c"), 33), mvcc.EncodeWriteCFValue(mvcc.WriteTypeRollback, 33, nil))
+	put(wb, "c", 30, nil)
+	store.write(wb)
+
+	retentions := []VersionRetention{{Prefix: []byte("c"), MaxVersions: 2}, {Prefix: []byte(""), MaxVersions: 10}}
+	gc := func(limit uint32) (*kvrpcpb.GCResponse, []inner_server.Modify) {
+		scan := NewGCScan(&kvrpcpb.GCRequest{SafePoint: 20, Limit: limit})
+		_, writes := store.run(&scan)
+		assert.Empty(t, writes)
+		cmd := scan.GC(retentions)
+		resp, writes := store.run(&cmd)
+		return resp.(*kvrpcpb.GCResponse), writes
+	}
+	del := func(cf, key string, ts uint64) inner_server.Modify {
+		return inner_server.Modify{Type: inner_server.ModifyTypeDelete, Data: inner_server.Delete{Key: mvcc.EncodeKey([]byte(key), ts), Cf: cf}}
+	}
+
+	resp, writes := gc(0)
+	assert.Empty(t, resp.NextKey)
+	assert.Equal(t, uint64(5), resp.VersionsDeleted)
+	assert.Equal(t, []inner_server.Modify{
+		del(engine_util.CF_WRITE, "a", 12),
+		del(engine_util.CF_WRITE, "a", 10),
+		del(engine_util.CF_WRITE, "b", 18),
+		del(engine_util.CF_WRITE, "b", 5),
+		del(engine_util.CF_DEFAULT, "b", 4),
+		del(engine_util.CF_WRITE, "c", 30),
+		del(engine_util.CF_DEFAULT, "c", 29),
+	}, writes)
+
+	// The collection stops at the limit, with the key to continue from. The keys are latched while they're collected.
+	scan := NewGCScan(&kvrpcpb.GCRequest{SafePoint: 20, Limit: 2})
+	store.run(&scan)
+	cmd := scan.GC(retentions)
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("b")}, cmd.WillWrite())
+	resp, writes = gc(2)
+	assert.Equal(t, []byte("c"), resp.NextKey)
+	assert.Len(t, writes, 5)
+
+	// The longest matching prefix applies.
+	assert.Equal(t, 2, maxVersions(retentions, []byte("cc")))
+	assert.Equal(t, 10, maxVersions(retentions, []byte("d")))
+	assert.Equal(t, 0, maxVersions(nil, []byte("d")))
+}
@@ -0,0 +1,107 @@
+package commands
+
+import (
+	"bytes"
+	"math"
+
+	"github.com/coocood/badger"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/kvstore"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// GetCommitTs implements the Command interface for looking up the commit record a transaction left on a key. It is
+// meant for verification tools which reconstruct the order of transactions from the write column family.
+type GetCommitTs struct {
+	request  *kvrpcpb.GetCommitTsRequest
+	response kvrpcpb.GetCommitTsResponse
+}
+
+func NewGetCommitTs(request *kvrpcpb.GetCommitTsRequest) GetCommitTs {
+	return GetCommitTs{request, kvrpcpb.GetCommitTsResponse{}}
+}
+
+func (g *GetCommitTs) BuildTxn(txn *kvstore.Txn) error {
+	key, startTS := g.request.Key, g.request.StartVersion
+
+	// The transaction is still in progress if it holds the lock.
+	val, err := txn.Reader.GetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey(key))
+	if err != nil && err != badger.ErrKeyNotFound {
+		return err
+	}
+	if err == nil {
+		lock, err := mvcc.DecodeLock(val)
+		if err != nil {
+			return err
+		}
+		if lock.StartTS == startTS {
+			g.response.Error = &kvrpcpb.KeyError{Locked: &kvrpcpb.LockInfo{
+				PrimaryLock: lock.Primary,
+				LockVersion: lock.StartTS,
+				Key:         key,
+				LockTtl:     lock.TTL,
+				LockType:    lockTypeToOp(lock.Type),
+			}}
+			return nil
+		}
+	}
+
+	// A transaction commits after it starts, so only the writes newer than the start ts need to be checked.
+	iter := txn.Reader.IterCF(engine_util.CF_WRITE)
+	defer iter.Close()
+	for iter.Seek(mvcc.EncodeKey(key, math.MaxUint64)); iter.Valid(); iter.Next() {
+		item := iter.Item()
+		userKey, commitTS, err := mvcc.DecodeKey(item.Key())
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(userKey, key) || commitTS < startTS {
+			return nil
+		}
+		val, err := item.Value()
+		if err != nil {
+			return err
+		}
+		write, err := mvcc.DecodeWriteCFValue(val)
+		if err != nil {
+			return err
+		}
+		if write.StartTS != startTS {
+			continue
+		}
+		switch write.Type {
+		case mvcc.WriteTypeRollback:
+			g.response.RolledBack = true
+		case mvcc.WriteTypeDelete:
+			g.response.CommitVersion = commitTS
+			g.response.WriteType = kvrpcpb.Op_Del
+		case mvcc.WriteTypeLock:
+			g.response.CommitVersion = commitTS
+			g.response.WriteType = kvrpcpb.Op_Lock
+		default:
+			g.response.CommitVersion = commitTS
+			g.response.WriteType = kvrpcpb.Op_Put
+		}
+		return nil
+	}
+	return nil
+}
+
+func (g *GetCommitTs) Context() *kvrpcpb.Context {
+	return g.request.Context
+}
+
+func (g *GetCommitTs) Response() (interface{}, error) {
+	return &g.response, nil
+}
+
+func (g *GetCommitTs) RegionError(err *errorpb.Error) interface{} {
+	if err == nil {
+		return nil
+	}
+
+	g.response.RegionError = err
+	return &g.response
+}
@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCommitTs(t *testing.T) {
+	store := newTestStore(t)
+	defer store.close()
+
+	key := []byte("k")
+	wb := new(engine_util.WriteBatch)
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey(key, 40), mvcc.EncodeWriteCFValue(mvcc.WriteTypeDelete, 35, nil))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey(key, 30), mvcc.EncodeWriteCFValue(mvcc.WriteTypeRollback, 30, nil))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey(key, 20), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, 10, []byte("v")))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("k0"), 60), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, 50, nil))
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey(key), mvcc.EncodeLockCFValue(&mvcc.Lock{
+		Type: mvcc.LockTypePut, Primary: key, StartTS: 45, TTL: 100}))
+	store.write(wb)
+
+	get := func(key []byte, startTS uint64) *kvrpcpb.GetCommitTsResponse {
+		cmd := NewGetCommitTs(&kvrpcpb.GetCommitTsRequest{Key: key, StartVersion: startTS})
+		resp, _ := store.run(&cmd)
+		return resp.(*kvrpcpb.GetCommitTsResponse)
+	}
+
+	resp := get(key, 10)
+	assert.Equal(t, uint64(20), resp.CommitVersion)
+	assert.Equal(t, kvrpcpb.Op_Put, resp.WriteType)
+	assert.False(t, resp.RolledBack)
+
+	resp = get(key, 35)
+	assert.Equal(t, uint64(40), resp.CommitVersion)
+	assert.Equal(t, kvrpcpb.Op_Del, resp.WriteType)
+
+	resp = get(key, 30)
+	assert.Equal(t, uint64(0), resp.CommitVersion)
+	assert.True(t, resp.RolledBack)
+
+	// The transaction is still in progress.
+	resp = get(key, 45)
+	assert.Equal(t, uint64(0), resp.CommitVersion)
+	require.NotNil(t, resp.Error.GetLocked())
+	assert.Equal(t, uint64(45), resp.Error.Locked.LockVersion)
+
+	// No record, the write of another key isn't mistaken for it.
+	resp = get(key, 50)
+	assert.Equal(t, &kvrpcpb.GetCommitTsResponse{}, resp)
+}
@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/kvstore"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap/errors"
+)
+
+// ImportCommitted implements the Command interface for importing user keys and values as a transaction committed at
+// the commit ts of the request. A key which already has a record at or above the commit ts is rejected: the import
+// would land below the last change hint of a lock or rollback record, so the reads following the hint would miss it.
+type ImportCommitted struct {
+	request  *kvrpcpb.ImportRegionRequest
+	response kvrpcpb.ImportRegionResponse
+}
+
+func NewImportCommitted(request *kvrpcpb.ImportRegionRequest) ImportCommitted {
+	return ImportCommitted{request, kvrpcpb.ImportRegionResponse{}}
+}
+
+func (ic *ImportCommitted) BuildTxn(txn *kvstore.Txn) error {
+	commitTS := ic.request.CommitTs
+	pairs, err := CommittedPairs(ic.request.Pairs, commitTS)
+	if err != nil {
+		ic.response.Error = err.Error()
+		return nil
+	}
+	iter := txn.Reader.IterCF(engine_util.CF_WRITE)
+	defer iter.Close()
+	for _, pair := range ic.request.Pairs {
+		iter.Seek(mvcc.EncodeKey(pair.Key, math.MaxUint64))
+		if !iter.Valid() {
+			continue
+		}
+		userKey, ts, err := mvcc.DecodeKey(iter.Item().Key())
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(userKey, pair.Key) && ts >= commitTS {
+			ic.response.Error = fmt.Sprintf("key %q has a version at %d, not older than the commit ts %d", pair.Key, ts, commitTS)
+			return nil
+		}
+	}
+	for _, pair := range pairs {
+		txn.PutCF(pair.Cf, pair.Key, pair.Value)
+	}
+	return nil
+}
+
+// CommittedPairs converts the user keys and values of the default column family into the records of a transaction
+// committed at commitTS, started at commitTS too. A value is stored inline in its write record if it's short enough,
+// otherwise in the default column family.
+func CommittedPairs(pairs []*kvrpcpb.ExportedPair, commitTS uint64) ([]*kvrpcpb.ExportedPair, error) {
+	committed := make([]*kvrpcpb.ExportedPair, 0, len(pairs))
+	for _, pair := range pairs {
+		if pair.Cf != "" && pair.Cf != engine_util.CF_DEFAULT {
+			return nil, errors.Errorf("committed pairs must be in the default column family, not %s", pair.Cf)
+		}
+		write := &mvcc.Write{Type: mvcc.WriteTypePut, StartTS: commitTS}
+		if len(pair.Value) > 0 && len(pair.Value) <= mvcc.MaxShortValueLen {
+			write.ShortValue = pair.Value
+		} else {
+			committed = append(committed, &kvrpcpb.ExportedPair{
+				Cf:    engine_util.CF_DEFAULT,
+				Key:   mvcc.EncodeKey(pair.Key, commitTS),
+				Value: pair.Value,
+			})
+		}
+		committed = append(committed, &kvrpcpb.ExportedPair{
+			Cf:    engine_util.CF_WRITE,
+			Key:   mvcc.EncodeKey(pair.Key, commitTS),
+			Value: mvcc.EncodeWrite(write),
+		})
+	}
+	return committed, nil
+}
+
+func (ic *ImportCommitted) WillWrite() [][]byte {
+	keys := make([][]byte, 0, len(ic.request.Pairs))
+	for _, pair := range ic.request.Pairs {
+		keys = append(keys, pair.Key)
+	}
+	return keys
+}
+
+func (ic *ImportCommitted) Context() *kvrpcpb.Context {
+	return ic.request.Context
+}
+
+func (ic *ImportCommitted) Response() (interface{}, error) {
+	return &ic.response, nil
+}
+
+func (ic *ImportCommitted) RegionError(err *errorpb.Error) interface{} {
+	if err == nil {
+		return nil
+	}
+
+	ic.response.RegionError = err
+	return &ic.response
+}
@@ -0,0 +1,241 @@
+package commands
+
+import (
+	"bytes"
+	"math"
+	"sort"
+
+	"github.com/coocood/badger"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/kvstore"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+)
+
+// MvccGetByKey implements the Command interface for reading the lock, all the writes and all the values of a key,
+// whatever their timestamps. It is meant for diagnosing stuck transactions.
+type MvccGetByKey struct {
+	request  *kvrpcpb.MvccGetByKeyRequest
+	response kvrpcpb.MvccGetByKeyResponse
+}
+
+func NewMvccGetByKey(request *kvrpcpb.MvccGetByKeyRequest) MvccGetByKey {
+	return MvccGetByKey{request, kvrpcpb.MvccGetByKeyResponse{}}
+}
+
+func (m *MvccGetByKey) BuildTxn(txn *kvstore.Txn) error {
+	info, err := readMvccInfo(txn, m.request.Key)
+	if err != nil {
+		return err
+	}
+	m.response.Info = info
+	return nil
+}
+
+func (m *MvccGetByKey) Context() *kvrpcpb.Context {
+	return m.request.Context
+}
+
+func (m *MvccGetByKey) Response() (interface{}, error) {
+	return &m.response, nil
+}
+
+func (m *MvccGetByKey) RegionError(err *errorpb.Error) interface{} {
+	if err == nil {
+		return nil
+	}
+
+	m.response.RegionError = err
+	return &m.response
+}
+
+// MvccGetByStartTs implements the Command interface for finding the keys of the region a transaction locked or
+// wrote, and reading them like MvccGetByKey. The whole region is scanned, so it's only for debugging.
+type MvccGetByStartTs struct {
+	request  *kvrpcpb.MvccGetByStartTsRequest
+	response kvrpcpb.MvccGetByStartTsResponse
+}
+
+func NewMvccGetByStartTs(request *kvrpcpb.MvccGetByStartTsRequest) MvccGetByStartTs {
+	return MvccGetByStartTs{request, kvrpcpb.MvccGetByStartTsResponse{}}
+}
+
+func (m *MvccGetByStartTs) BuildTxn(txn *kvstore.Txn) error {
+	keys, err := txnKeys(txn, m.request.StartTs)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		info, err := readMvccInfo(txn, key)
+		if err != nil {
+			return err
+		}
+		m.response.Keys = append(m.response.Keys, &kvrpcpb.MvccKeyInfo{Key: key, Info: info})
+	}
+	if len(m.response.Keys) > 0 {
+		m.response.Key, m.response.Info = m.response.Keys[0].Key, m.response.Keys[0].Info
+	}
+	return nil
+}
+
+func (m *MvccGetByStartTs) Context() *kvrpcpb.Context {
+	return m.request.Context
+}
+
+func (m *MvccGetByStartTs) Response() (interface{}, error) {
+	return &m.response, nil
+}
+
+func (m *MvccGetByStartTs) RegionError(err *errorpb.Error) interface{} {
+	if err == nil {
+		return nil
+	}
+
+	m.response.RegionError = err
+	return &m.response
+}
+
+// txnKeys returns the user keys in ascending order which have a lock or a write of the transaction started at
+// startTS. Only the region of the reader is scanned if the reader knows it.
+func txnKeys(txn *kvstore.Txn, startTS uint64) ([][]byte, error) {
+	var startKey, endKey []byte
+	if r, ok := txn.Reader.(interface{ Region() *metapb.Region }); ok {
+		startKey, endKey = r.Region().StartKey, r.Region().EndKey
+	}
+	found := make(map[string]struct{})
+	scan := func(cf string, f func(key, val []byte) ([]byte, error)) error {
+		iter := txn.Reader.IterCF(cf)
+		defer iter.Close()
+		for iter.Seek(startKey); iter.Valid(); iter.Next() {
+			item := iter.Item()
+			if engine_util.ExceedEndKey(item.Key(), endKey) {
+				break
+			}
+			val, err := item.Value()
+			if err != nil {
+				return err
+			}
+			key, err := f(item.Key(), val)
+			if err != nil {
+				return err
+			}
+			if key != nil {
+				found[string(key)] = struct{}{}
+			}
+		}
+		return nil
+	}
+	err := scan(engine_util.CF_LOCK, func(key, val []byte) ([]byte, error) {
+		lock, err := mvcc.DecodeLock(val)
+		if err != nil || lock.StartTS != startTS {
+			return nil, err
+		}
+		return mvcc.DecodeLockKey(key)
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = scan(engine_util.CF_WRITE, func(key, val []byte) ([]byte, error) {
+		write, err := mvcc.DecodeWriteCFValue(val)
+		if err != nil || write.StartTS != startTS {
+			return nil, err
+		}
+		userKey, _, err := mvcc.DecodeKey(key)
+		return userKey, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([][]byte, 0, len(found))
+	for key := range found {
+		keys = append(keys, []byte(key))
+	}
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i], keys[j]) < 0 })
+	return keys, nil
+}
+
+// readMvccInfo reads the lock of key, and all its writes and values, the newest first.
+func readMvccInfo(txn *kvstore.Txn, key []byte) (*kvrpcpb.MvccInfo, error) {
+	info := new(kvrpcpb.MvccInfo)
+	val, err := txn.Reader.GetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey(key))
+	if err != nil && err != badger.ErrKeyNotFound {
+		return nil, err
+	}
+	if err == nil {
+		lock, err := mvcc.DecodeLock(val)
+		if err != nil {
+			return nil, err
+		}
+		info.Lock = &kvrpcpb.MvccLock{
+			Type:       lockTypeToOp(lock.Type),
+			StartTs:    lock.StartTS,
+			Primary:    lock.Primary,
+			ShortValue: lock.ShortValue,
+		}
+	}
+
+	err = eachVersion(txn, engine_util.CF_WRITE, key, func(commitTS uint64, val []byte) error {
+		write, err := mvcc.DecodeWriteCFValue(val)
+		if err != nil {
+			return err
+		}
+		info.Writes = append(info.Writes, &kvrpcpb.MvccWrite{
+			Type:       writeTypeToOp(write.Type),
+			StartTs:    write.StartTS,
+			CommitTs:   commitTS,
+			ShortValue: write.ShortValue,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = eachVersion(txn, engine_util.CF_DEFAULT, key, func(startTS uint64, val []byte) error {
+		info.Values = append(info.Values, &kvrpcpb.MvccValue{StartTs: startTS, Value: val})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// eachVersion calls f with the timestamp and the value of every version of key in cf, the newest first.
+func eachVersion(txn *kvstore.Txn, cf string, key []byte, f func(ts uint64, val []byte) error) error {
+	iter := txn.Reader.IterCF(cf)
+	defer iter.Close()
+	for iter.Seek(mvcc.EncodeKey(key, math.MaxUint64)); iter.Valid(); iter.Next() {
+		item := iter.Item()
+		userKey, ts, err := mvcc.DecodeKey(item.Key())
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(userKey, key) {
+			return nil
+		}
+		val, err := item.ValueCopy(nil)
+		if err != nil {
+			return err
+		}
+		if err = f(ts, val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeTypeToOp(tp mvcc.WriteType) kvrpcpb.Op {
+	switch tp {
+	case mvcc.WriteTypeDelete:
+		return kvrpcpb.Op_Del
+	case mvcc.WriteTypeLock:
+		return kvrpcpb.Op_Lock
+	case mvcc.WriteTypeRollback:
+		return kvrpcpb.Op_Rollback
+	default:
+		return kvrpcpb.Op_Put
+	}
+}
@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMvccInfo(t *testing.T) {
+	store := newTestStore(t)
+	defer store.close()
+
+	// Transaction 10 committed a and b at 20, transaction 30 rolled back on a, transaction 40 is stuck with the locks
+	// of a and c, and its value of c.
+	a, b, c := []byte("a"), []byte("b"), []byte("c")
+	wb := new(engine_util.WriteBatch)
+	wb.SetCF(engine_util.CF_DEFAULT, mvcc.EncodeKey(a, 10), []byte("long value"))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey(a, 20), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, 10, nil))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey(a, 30), mvcc.EncodeWriteCFValue(mvcc.WriteTypeRollback, 30, nil))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey(b, 20), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, 10, []byte("v")))
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey(a), mvcc.EncodeLockCFValue(&mvcc.Lock{
+		Type: mvcc.LockTypeDelete, Primary: a, StartTS: 40, TTL: 100}))
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey(c), mvcc.EncodeLockCFValue(&mvcc.Lock{
+		Type: mvcc.LockTypePut, Primary: a, StartTS: 40, TTL: 100}))
+	wb.SetCF(engine_util.CF_DEFAULT, mvcc.EncodeKey(c, 40), []byte("long value 2"))
+	store.write(wb)
+
+	run := func(region metapb.Region, cmd testCommand) interface{} {
+		resp, _ := store.runInRegion(region, cmd)
+		return resp
+	}
+
+	// The lock, and the writes and the values from the newest.
+	byKey := NewMvccGetByKey(&kvrpcpb.MvccGetByKeyRequest{Key: a})
+	info := run(metapb.Region{}, &byKey).(*kvrpcpb.MvccGetByKeyResponse).Info
+	assert.Equal(t, &kvrpcpb.MvccInfo{
+		Lock: &kvrpcpb.MvccLock{Type: kvrpcpb.Op_Del, StartTs: 40, Primary: a},
+		Writes: []*kvrpcpb.MvccWrite{
+			{Type: kvrpcpb.Op_Rollback, StartTs: 30, CommitTs: 30},
+			{Type: kvrpcpb.Op_Put, StartTs: 10, CommitTs: 20},
+		},
+		Values: []*kvrpcpb.MvccValue{{StartTs: 10, Value: []byte("long value")}},
+	}, info)
+
+	// The keys of a transaction are found by its locks and its writes.
+	byStartTs := NewMvccGetByStartTs(&kvrpcpb.MvccGetByStartTsRequest{StartTs: 40})
+	resp := run(metapb.Region{}, &byStartTs).(*kvrpcpb.MvccGetByStartTsResponse)
+	require.Len(t, resp.Keys, 2)
+	assert.Equal(t, a, resp.Key)
+	assert.Equal(t, c, resp.Keys[1].Key)
+	assert.Equal(t, []*kvrpcpb.MvccValue{{StartTs: 40, Value: []byte("long value 2")}}, resp.Keys[1].Info.Values)
+
+	byStartTs = NewMvccGetByStartTs(&kvrpcpb.MvccGetByStartTsRequest{StartTs: 10})
+	resp = run(metapb.Region{}, &byStartTs).(*kvrpcpb.MvccGetByStartTsResponse)
+	require.Len(t, resp.Keys, 2)
+	assert.Equal(t, b, resp.Keys[1].Key)
+	assert.Equal(t, []byte("v"), resp.Keys[1].Info.Writes[0].ShortValue)
+
+	// Only the keys of the region are scanned.
+	byStartTs = NewMvccGetByStartTs(&kvrpcpb.MvccGetByStartTsRequest{StartTs: 10})
+	region := metapb.Region{StartKey: mvcc.EncodeLockKey(b)}
+	resp = run(region, &byStartTs).(*kvrpcpb.MvccGetByStartTsResponse)
+	require.Len(t, resp.Keys, 1)
+	assert.Equal(t, b, resp.Key)
+
+	byStartTs = NewMvccGetByStartTs(&kvrpcpb.MvccGetByStartTsRequest{StartTs: 50})
+	resp = run(metapb.Region{}, &byStartTs).(*kvrpcpb.MvccGetByStartTsResponse)
+	assert.Empty(t, resp.Keys)
+	assert.Nil(t, resp.Info)
+}
@@ -1,8 +1,14 @@
 package commands
 
 import (
+	"bytes"
+	"fmt"
+	"time"
+
 	"github.com/coocood/badger"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/kvstore"
+	"github.com/pingcap-incubator/tinykv/kv/util/memory"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
 )
@@ -47,3 +53,181 @@ func (rg *RawGet) RegionError(err *errorpb.Error) interface{} {
 	rg.response.RegionError = err
 	return &rg.response
 }
+
+// RawBatchGet implements the Command interface for raw batch get requests. The keys are read in one pass over the
+// engine, and the ones not found are left out of the response.
+type RawBatchGet struct {
+	request  *kvrpcpb.RawBatchGetRequest
+	response kvrpcpb.RawBatchGetResponse
+}
+
+func NewRawBatchGet(request *kvrpcpb.RawBatchGetRequest) RawBatchGet {
+	return RawBatchGet{request, kvrpcpb.RawBatchGetResponse{}}
+}
+
+func (rbg *RawBatchGet) BuildTxn(txn *kvstore.Txn) error {
+	vals, err := txn.Reader.MultiGetCF(rbg.request.Cf, rbg.request.Keys)
+	if err != nil {
+		return err
+	}
+	for i, key := range rbg.request.Keys {
+		if vals[i] != nil {
+			rbg.response.Pairs = append(rbg.response.Pairs, &kvrpcpb.KvPair{Key: key, Value: vals[i]})
+		}
+	}
+	return nil
+}
+
+func (rbg *RawBatchGet) Context() *kvrpcpb.Context {
+	return rbg.request.Context
+}
+
+func (rbg *RawBatchGet) Response() (interface{}, error) {
+	return &rbg.response, nil
+}
+
+func (rbg *RawBatchGet) RegionError(err *errorpb.Error) interface{} {
+	if err == nil {
+		return nil
+	}
+
+	rbg.response.RegionError = err
+	return &rbg.response
+}
+
+// ScanLimits caps the resources a single scan may use. A zero field means no cap.
+type ScanLimits struct {
+	MaxKeys     int
+	MaxBytes    int64
+	MaxDuration time.Duration
+}
+
+// RawScan implements the Command interface for raw scan requests, in ascending or in descending order. The scanned
+// pairs are charged to the store memory budget, the scan is rejected as server busy if they don't fit. A scan hitting
+// one of its limits returns the pairs read so far, with the reason and the key to continue from.
+type RawScan struct {
+	request  *kvrpcpb.RawScanRequest
+	response kvrpcpb.RawScanResponse
+	limits   ScanLimits
+	charged  int64
+}
+
+func NewRawScan(request *kvrpcpb.RawScanRequest, limits ScanLimits) RawScan {
+	return RawScan{request: request, limits: limits}
+}
+
+func (rs *RawScan) BuildTxn(txn *kvstore.Txn) error {
+	pairs := make([]*kvrpcpb.KvPair, 0)
+	var size int64
+	start := time.Now()
+
+	var it *engine_util.CFIterator
+	if rs.request.Reverse {
+		it = txn.Reader.ReverseIterCF(rs.request.Cf)
+	} else {
+		it = txn.Reader.IterCF(rs.request.Cf)
+	}
+	defer it.Close()
+	for rs.seek(it); it.Valid() && len(pairs) < int(rs.request.Limit); it.Next() {
+		key := it.Item().KeyCopy(nil)
+		if rs.beyondEnd(key) {
+			break
+		}
+		value, err := it.Item().ValueCopy(nil)
+		if err != nil {
+			rs.response.Error = err.Error()
+			return nil
+		}
+
+		pairSize := int64(len(key) + len(value))
+		// At least one pair is returned, so that a continued scan always makes progress.
+		if len(pairs) > 0 {
+			if reason := rs.limits.check(len(pairs), size+pairSize, start); reason != kvrpcpb.ScanStopReason_ScanComplete {
+				rs.response.StopReason = reason
+				rs.response.NextKey = key
+				if rs.request.Reverse {
+					// The start key of a reverse scan is exclusive, the smallest key after key continues from key.
+					rs.response.NextKey = append(key, 0)
+				}
+				break
+			}
+		}
+
+		if !memory.StoreBudget.TryConsume(memory.ScanBuffer, pairSize) {
+			rs.Release()
+			rs.response.RegionError = &errorpb.Error{
+				Message:      "memory budget exceeded",
+				ServerIsBusy: &errorpb.ServerIsBusy{Reason: fmt.Sprintf("scan rejected after %d pairs", len(pairs))},
+			}
+			return nil
+		}
+		rs.charged += pairSize
+		size += pairSize
+
+		pairs = append(pairs, &kvrpcpb.KvPair{
+			Key:   key,
+			Value: value,
+		})
+	}
+	rs.response.Kvs = pairs
+	return nil
+}
+
+// seek positions it at the first key of the scan, a reverse scan starts below StartKey, or at the last key if there
+// is no StartKey.
+func (rs *RawScan) seek(it *engine_util.CFIterator) {
+	it.Seek(rs.request.StartKey)
+	if rs.request.Reverse && len(rs.request.StartKey) > 0 && it.Valid() && bytes.Equal(it.Item().Key(), rs.request.StartKey) {
+		it.Next()
+	}
+}
+
+// beyondEnd returns whether key is past the end of the scan.
+func (rs *RawScan) beyondEnd(key []byte) bool {
+	if len(rs.request.EndKey) == 0 {
+		return false
+	}
+	if rs.request.Reverse {
+		return bytes.Compare(key, rs.request.EndKey) < 0
+	}
+	return bytes.Compare(key, rs.request.EndKey) >= 0
+}
+
+// check returns which limit a scan that has read keys pairs would exceed by reading a pair which takes it to size
+// bytes.
+func (l ScanLimits) check(keys int, size int64, start time.Time) kvrpcpb.ScanStopReason {
+	if l.MaxKeys > 0 && keys >= l.MaxKeys {
+		return kvrpcpb.ScanStopReason_ScanMaxKeys
+	}
+	if l.MaxBytes > 0 && size > l.MaxBytes {
+		return kvrpcpb.ScanStopReason_ScanMaxBytes
+	}
+	if l.MaxDuration > 0 && time.Since(start) >= l.MaxDuration {
+		return kvrpcpb.ScanStopReason_ScanMaxDuration
+	}
+	return kvrpcpb.ScanStopReason_ScanComplete
+}
+
+// Release returns the memory charged for the scanned pairs to the store memory budget, it should be called once the
+// response is no longer needed.
+func (rs *RawScan) Release() {
+	memory.StoreBudget.Release(memory.ScanBuffer, rs.charged)
+	rs.charged = 0
+}
+
+func (rs *RawScan) Context() *kvrpcpb.Context {
+	return rs.request.Context
+}
+
+func (rs *RawScan) Response() (interface{}, error) {
+	return &rs.response, nil
+}
+
+func (rs *RawScan) RegionError(err *errorpb.Error) interface{} {
+	if err == nil {
+		return nil
+	}
+
+	rs.response.RegionError = err
+	return &rs.response
+}
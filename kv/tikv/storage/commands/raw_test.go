@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRawScanLimits(t *testing.T) {
+	store := newTestStore(t)
+	defer store.close()
+
+	wb := new(engine_util.WriteBatch)
+	for _, key := range []string{"a", "b", "c", "d", "e"} {
+		wb.SetCF(engine_util.CF_DEFAULT, []byte(key), []byte("value"))
+	}
+	store.write(wb)
+
+	run := func(req *kvrpcpb.RawScanRequest, limits ScanLimits) *kvrpcpb.RawScanResponse {
+		req.Limit, req.Cf = 10, engine_util.CF_DEFAULT
+		cmd := NewRawScan(req, limits)
+		defer cmd.Release()
+		resp, _ := store.run(&cmd)
+		return resp.(*kvrpcpb.RawScanResponse)
+	}
+	scan := func(startKey string, limits ScanLimits) *kvrpcpb.RawScanResponse {
+		return run(&kvrpcpb.RawScanRequest{StartKey: []byte(startKey)}, limits)
+	}
+
+	resp := scan("", ScanLimits{})
+	assert.Len(t, resp.Kvs, 5)
+	assert.Equal(t, kvrpcpb.ScanStopReason_ScanComplete, resp.StopReason)
+	assert.Nil(t, resp.NextKey)
+
+	// Continue the scan until it completes.
+	var keys []string
+	next := ""
+	for {
+		resp = scan(next, ScanLimits{MaxKeys: 2})
+		for _, pair := range resp.Kvs {
+			keys = append(keys, string(pair.Key))
+		}
+		if resp.StopReason == kvrpcpb.ScanStopReason_ScanComplete {
+			break
+		}
+		assert.Equal(t, kvrpcpb.ScanStopReason_ScanMaxKeys, resp.StopReason)
+		next = string(resp.NextKey)
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, keys)
+
+	// Each pair is 6 bytes.
+	resp = scan("", ScanLimits{MaxBytes: 15})
+	assert.Len(t, resp.Kvs, 2)
+	assert.Equal(t, kvrpcpb.ScanStopReason_ScanMaxBytes, resp.StopReason)
+	assert.Equal(t, []byte("c"), resp.NextKey)
+
+	// A scan always makes progress.
+	resp = scan("", ScanLimits{MaxBytes: 1, MaxDuration: time.Nanosecond})
+	assert.Len(t, resp.Kvs, 1)
+	assert.Equal(t, []byte("b"), resp.NextKey)
+
+	// A reverse scan reads [EndKey, StartKey) backwards, and it's continued from its next key too.
+	keys = nil
+	req := &kvrpcpb.RawScanRequest{StartKey: []byte("e"), EndKey: []byte("b"), Reverse: true}
+	for {
+		resp = run(req, ScanLimits{MaxKeys: 2})
+		for _, pair := range resp.Kvs {
+			keys = append(keys, string(pair.Key))
+		}
+		if resp.StopReason == kvrpcpb.ScanStopReason_ScanComplete {
+			break
+		}
+		req.StartKey = resp.NextKey
+	}
+	assert.Equal(t, []string{"d", "c", "b"}, keys)
+	resp = run(&kvrpcpb.RawScanRequest{Reverse: true}, ScanLimits{})
+	assert.Len(t, resp.Kvs, 5)
+	assert.Equal(t, []byte("e"), resp.Kvs[0].Key)
+}
@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"bytes"
+	"math"
+
+	"github.com/coocood/badger"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/kvstore"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// BatchRollback implements the Command interface for rolling back the given keys of a transaction. Only the listed
+// keys are rolled back, the rest of the transaction is untouched, so a client can roll back to a savepoint by
+// rolling back just the keys it has prewritten since.
+type BatchRollback struct {
+	request  *kvrpcpb.BatchRollbackRequest
+	response kvrpcpb.BatchRollbackResponse
+}
+
+func NewBatchRollback(request *kvrpcpb.BatchRollbackRequest) BatchRollback {
+	return BatchRollback{request, kvrpcpb.BatchRollbackResponse{}}
+}
+
+func (br *BatchRollback) BuildTxn(txn *kvstore.Txn) error {
+	startTS := br.request.StartVersion
+	for _, key := range br.request.Keys {
+		write, err := findWrite(txn, key, startTS)
+		if err != nil {
+			return err
+		}
+		if write != nil {
+			if write.Type == mvcc.WriteTypeRollback {
+				continue
+			}
+			br.response.Error = &kvrpcpb.KeyError{Abort: "already committed"}
+			txn.Writes = nil
+			return nil
+		}
+
+		val, err := txn.Reader.GetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey(key))
+		if err != nil && err != badger.ErrKeyNotFound {
+			return err
+		}
+		foreignLock := false
+		if err == nil {
+			lock, err := mvcc.DecodeLock(val)
+			if err != nil {
+				return err
+			}
+			if lock.StartTS == startTS {
+				txn.DeleteCF(engine_util.CF_LOCK, mvcc.EncodeLockKey(key))
+				if lock.Type == mvcc.LockTypePut && lock.ShortValue == nil {
+					txn.DeleteCF(engine_util.CF_DEFAULT, mvcc.EncodeKey(key, startTS))
+				}
+			} else {
+				foreignLock = true
+			}
+		}
+
+		// Leave a rollback record even if the key was never prewritten, so a delayed prewrite can't succeed.
+		rollback := &mvcc.Write{Type: mvcc.WriteTypeRollback, StartTS: startTS}
+		// The holder of another lock may still commit below the rollback record, after the newest change the hint
+		// would point to, so the record gets no hint then.
+		if !foreignLock {
+			if rollback.LastChangeTS, rollback.VersionsToLastChange, err = lastChange(txn, key, startTS); err != nil {
+				return err
+			}
+		}
+		txn.PutCF(engine_util.CF_WRITE, mvcc.EncodeKey(key, startTS), mvcc.EncodeWrite(rollback))
+	}
+	return nil
+}
+
+// findWrite returns the commit or rollback record the transaction started at startTS left on key, if any.
+func findWrite(txn *kvstore.Txn, key []byte, startTS uint64) (*mvcc.Write, error) {
+	iter := txn.Reader.IterCF(engine_util.CF_WRITE)
+	defer iter.Close()
+	for iter.Seek(mvcc.EncodeKey(key, math.MaxUint64)); iter.Valid(); iter.Next() {
+		item := iter.Item()
+		userKey, commitTS, err := mvcc.DecodeKey(item.Key())
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(userKey, key) || commitTS < startTS {
+			return nil, nil
+		}
+		val, err := item.Value()
+		if err != nil {
+			return nil, err
+		}
+		write, err := mvcc.DecodeWriteCFValue(val)
+		if err != nil {
+			return nil, err
+		}
+		if write.StartTS == startTS {
+			return write, nil
+		}
+	}
+	return nil, nil
+}
+
+func (br *BatchRollback) WillWrite() [][]byte {
+	return br.request.Keys
+}
+
+func (br *BatchRollback) Context() *kvrpcpb.Context {
+	return br.request.Context
+}
+
+func (br *BatchRollback) Response() (interface{}, error) {
+	return &br.response, nil
+}
+
+func (br *BatchRollback) RegionError(err *errorpb.Error) interface{} {
+	if err == nil {
+		return nil
+	}
+
+	br.response.RegionError = err
+	return &br.response
+}
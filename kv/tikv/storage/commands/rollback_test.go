@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchRollback(t *testing.T) {
+	store := newTestStore(t)
+	defer store.close()
+
+	wb := new(engine_util.WriteBatch)
+	// a and b: prewritten by the transaction, only b is rolled back to the savepoint.
+	for _, key := range []string{"a", "b"} {
+		wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey([]byte(key)), mvcc.EncodeLockCFValue(&mvcc.Lock{
+			Type: mvcc.LockTypePut, Primary: []byte("a"), StartTS: ts(10), TTL: 100}))
+		wb.SetCF(engine_util.CF_DEFAULT, mvcc.EncodeKey([]byte(key), ts(10)), []byte("v"))
+	}
+	// c: locked by another transaction.
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey([]byte("c")), mvcc.EncodeLockCFValue(&mvcc.Lock{
+		Type: mvcc.LockTypePut, Primary: []byte("c"), StartTS: ts(8), TTL: 100, ShortValue: []byte("v")}))
+	// d: already committed by the transaction.
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("d"), ts(20)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(10), nil))
+	store.write(wb)
+
+	rollback := func(keys ...string) (*kvrpcpb.BatchRollbackResponse, []inner_server.Modify) {
+		req := &kvrpcpb.BatchRollbackRequest{StartVersion: ts(10)}
+		for _, key := range keys {
+			req.Keys = append(req.Keys, []byte(key))
+		}
+		cmd := NewBatchRollback(req)
+		resp, writes := store.run(&cmd)
+		return resp.(*kvrpcpb.BatchRollbackResponse), writes
+	}
+
+	rollbackRecord := func(key string) inner_server.Modify {
+		return inner_server.Modify{Type: inner_server.ModifyTypePut, Data: inner_server.Put{
+			Key: mvcc.EncodeKey([]byte(key), ts(10)), Value: mvcc.EncodeWriteCFValue(mvcc.WriteTypeRollback, ts(10), nil), Cf: engine_util.CF_WRITE}}
+	}
+
+	// Only b is rolled back, a keeps its lock.
+	resp, writes := rollback("b")
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, []inner_server.Modify{
+		{Type: inner_server.ModifyTypeDelete, Data: inner_server.Delete{Key: mvcc.EncodeLockKey([]byte("b")), Cf: engine_util.CF_LOCK}},
+		{Type: inner_server.ModifyTypeDelete, Data: inner_server.Delete{Key: mvcc.EncodeKey([]byte("b"), ts(10)), Cf: engine_util.CF_DEFAULT}},
+		rollbackRecord("b"),
+	}, writes)
+
+	// The lock of another transaction is kept, an unlocked key still gets a rollback record.
+	resp, writes = rollback("c", "e")
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, []inner_server.Modify{rollbackRecord("c"), rollbackRecord("e")}, writes)
+
+	// A committed key can't be rolled back.
+	resp, writes = rollback("b", "d")
+	require.NotNil(t, resp.Error)
+	assert.NotEmpty(t, resp.Error.Abort)
+	assert.Empty(t, writes)
+
+	// Rolling back twice is a no-op.
+	wb = new(engine_util.WriteBatch)
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("e"), ts(10)), mvcc.EncodeWriteCFValue(mvcc.WriteTypeRollback, ts(10), nil))
+	store.write(wb)
+	resp, writes = rollback("e")
+	assert.Nil(t, resp.Error)
+	assert.Empty(t, writes)
+
+	// The rollback record points to the last change of the key, unless another transaction holds the lock, which may
+	// still commit below the record.
+	wb = new(engine_util.WriteBatch)
+	for _, key := range []string{"f", "g"} {
+		wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte(key), ts(5)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(4), []byte("v")))
+	}
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey([]byte("f")), mvcc.EncodeLockCFValue(&mvcc.Lock{
+		Type: mvcc.LockTypePut, Primary: []byte("f"), StartTS: ts(8), TTL: 100, ShortValue: []byte("v")}))
+	store.write(wb)
+	resp, writes = rollback("f", "g")
+	assert.Nil(t, resp.Error)
+	hinted := rollbackRecord("g")
+	hinted.Data = inner_server.Put{Key: mvcc.EncodeKey([]byte("g"), ts(10)), Cf: engine_util.CF_WRITE, Value: mvcc.EncodeWrite(&mvcc.Write{
+		Type: mvcc.WriteTypeRollback, StartTS: ts(10), LastChangeTS: ts(5), VersionsToLastChange: 1})}
+	assert.Equal(t, []inner_server.Modify{rollbackRecord("f"), hinted}, writes)
+}
@@ -0,0 +1,310 @@
+package commands
+
+import (
+	"bytes"
+	"math"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/kvstore"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// Scan implements the Command interface for reading the keys of [StartKey, EndKey) at a version, in ascending order,
+// or of [EndKey, StartKey) in descending order if the request is reverse. A key locked by a transaction which may
+// commit before the version is reported with the lock in its pair, like in BatchGet, or skipped if the request skips
+// the locked keys. Every version of a scanned key is stepped over rather than sought past, so the stats returned when
+// the request asks for them show how much MVCC garbage the scan went through.
+type Scan struct {
+	request  *kvrpcpb.ScanRequest
+	response kvrpcpb.ScanResponse
+}
+
+func NewScan(request *kvrpcpb.ScanRequest) Scan {
+	return Scan{request: request}
+}
+
+func (s *Scan) BuildTxn(txn *kvstore.Txn) error {
+	start := time.Now()
+	var stats kvrpcpb.ScanStats
+
+	pairs := make([]*kvrpcpb.KvPair, 0)
+	err := s.each(txn, &stats, func(pair *kvrpcpb.KvPair) bool {
+		pairs = append(pairs, pair)
+		return s.request.Limit == 0 || len(pairs) < int(s.request.Limit)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.response.Pairs = pairs
+	if s.request.CollectStats {
+		stats.DurationUs = uint64(time.Since(start) / time.Microsecond)
+		s.response.Stats = &stats
+	}
+	return nil
+}
+
+// Each calls f with the pairs of the scan in the order of the request until f returns false, ignoring the limit of
+// the request, so a caller can stream a range of any size from one snapshot.
+func (s *Scan) Each(txn *kvstore.Txn, f func(pair *kvrpcpb.KvPair) bool) error {
+	var stats kvrpcpb.ScanStats
+	return s.each(txn, &stats, f)
+}
+
+func (s *Scan) each(txn *kvstore.Txn, stats *kvrpcpb.ScanStats, f func(pair *kvrpcpb.KvPair) bool) error {
+	reverse := s.request.Reverse
+	var writeIter, lockIter *engine_util.CFIterator
+	if reverse {
+		writeIter = txn.Reader.ReverseIterCF(engine_util.CF_WRITE)
+		lockIter = txn.Reader.ReverseIterCF(engine_util.CF_LOCK)
+	} else {
+		writeIter = txn.Reader.IterCF(engine_util.CF_WRITE)
+		lockIter = txn.Reader.IterCF(engine_util.CF_LOCK)
+	}
+	defer writeIter.Close()
+	defer lockIter.Close()
+	s.seek(writeIter, lockIter)
+
+	for {
+		key, locked, err := nextScanKey(writeIter, lockIter, reverse)
+		if err != nil {
+			return err
+		}
+		if key == nil || s.beyondEnd(key) {
+			return nil
+		}
+		stats.KeysExamined++
+
+		if locked {
+			lockIter.Next()
+			lock, err := checkReadLock(txn, key, s.request.Version)
+			if err != nil {
+				return err
+			}
+			if lock != nil {
+				if err := skipVersions(writeIter, key, stats); err != nil {
+					return err
+				}
+				if s.request.SkipLocked {
+					// The skipped keys don't count against the limit.
+					s.response.LockedKeysSkipped++
+					continue
+				}
+				if !f(&kvrpcpb.KvPair{Key: key, Error: &kvrpcpb.KeyError{Locked: lock}}) {
+					return nil
+				}
+				continue
+			}
+		}
+
+		var value []byte
+		var exists bool
+		if reverse {
+			value, exists, err = s.readKeyReverse(txn, writeIter, key, stats)
+		} else {
+			value, exists, err = s.readKey(txn, writeIter, key, stats)
+		}
+		if err != nil {
+			return err
+		}
+		if exists && !f(&kvrpcpb.KvPair{Key: key, Value: value}) {
+			return nil
+		}
+	}
+}
+
+// seek positions the iterators at the first key of the scan. A reverse scan starts below StartKey, or at the last key
+// if there is no StartKey.
+func (s *Scan) seek(writeIter, lockIter *engine_util.CFIterator) {
+	if !s.request.Reverse {
+		writeIter.Seek(mvcc.EncodeKey(s.request.StartKey, math.MaxUint64))
+		lockIter.Seek(mvcc.EncodeLockKey(s.request.StartKey))
+		return
+	}
+	if len(s.request.StartKey) == 0 {
+		writeIter.Seek(nil)
+		lockIter.Seek(nil)
+		return
+	}
+	// The versions of a key are ordered by descending commit ts, so the version at the max ts is before all of them.
+	writeIter.Seek(mvcc.EncodeKey(s.request.StartKey, math.MaxUint64))
+	lockKey := mvcc.EncodeLockKey(s.request.StartKey)
+	lockIter.Seek(lockKey)
+	if lockIter.Valid() && bytes.Equal(lockIter.Item().Key(), lockKey) {
+		lockIter.Next()
+	}
+}
+
+// beyondEnd returns whether key is past the end of the scan.
+func (s *Scan) beyondEnd(key []byte) bool {
+	if len(s.request.EndKey) == 0 {
+		return false
+	}
+	if s.request.Reverse {
+		return bytes.Compare(key, s.request.EndKey) < 0
+	}
+	return bytes.Compare(key, s.request.EndKey) >= 0
+}
+
+// nextScanKey returns the next user key in the order of the scan either iterator is positioned at, and whether
+// lockIter is positioned at it. It returns a nil key once both iterators are exhausted.
+func nextScanKey(writeIter, lockIter *engine_util.CFIterator, reverse bool) ([]byte, bool, error) {
+	var writeKey, lockKey []byte
+	var err error
+	if writeIter.Valid() {
+		if writeKey, _, err = mvcc.DecodeKey(writeIter.Item().Key()); err != nil {
+			return nil, false, err
+		}
+	}
+	if lockIter.Valid() {
+		if lockKey, err = mvcc.DecodeLockKey(lockIter.Item().Key()); err != nil {
+			return nil, false, err
+		}
+	}
+	switch {
+	case lockKey == nil:
+		return writeKey, false, nil
+	case writeKey == nil:
+		return lockKey, true, nil
+	}
+	cmp := bytes.Compare(lockKey, writeKey)
+	if reverse {
+		cmp = -cmp
+	}
+	if cmp <= 0 {
+		return lockKey, true, nil
+	}
+	return writeKey, false, nil
+}
+
+// readKey steps iter over all the versions of key, and returns the value committed most recently at or before the
+// version of the request. exists is false if there is none or it was deleted.
+func (s *Scan) readKey(txn *kvstore.Txn, iter *engine_util.CFIterator, key []byte, stats *kvrpcpb.ScanStats) (value []byte, exists bool, err error) {
+	found := false
+	for ; iter.Valid(); iter.Next() {
+		item := iter.Item()
+		userKey, commitTS, err := mvcc.DecodeKey(item.Key())
+		if err != nil {
+			return nil, false, err
+		}
+		if !bytes.Equal(userKey, key) {
+			break
+		}
+		if found || commitTS > s.request.Version {
+			stats.VersionsSkipped++
+			continue
+		}
+		val, err := item.Value()
+		if err != nil {
+			return nil, false, err
+		}
+		write, err := mvcc.DecodeWriteCFValue(val)
+		if err != nil {
+			return nil, false, err
+		}
+		switch write.Type {
+		case mvcc.WriteTypePut:
+			found, exists = true, true
+			if s.request.KeyOnly {
+				break
+			}
+			if write.ShortValue != nil {
+				value = write.ShortValue
+			} else if value, err = txn.Reader.GetCF(engine_util.CF_DEFAULT, mvcc.EncodeKey(key, write.StartTS)); err != nil {
+				return nil, false, err
+			}
+		case mvcc.WriteTypeDelete:
+			found = true
+			stats.TombstonesSeen++
+		default:
+			stats.VersionsSkipped++
+		}
+	}
+	return value, exists, nil
+}
+
+// readKeyReverse is readKey for a reverse iter, which meets the versions of key from the oldest one. Every version
+// committed at or before the version of the request replaces the one read before, so the newest of them is returned.
+func (s *Scan) readKeyReverse(txn *kvstore.Txn, iter *engine_util.CFIterator, key []byte, stats *kvrpcpb.ScanStats) (value []byte, exists bool, err error) {
+	var found *mvcc.Write
+	for ; iter.Valid(); iter.Next() {
+		item := iter.Item()
+		userKey, commitTS, err := mvcc.DecodeKey(item.Key())
+		if err != nil {
+			return nil, false, err
+		}
+		if !bytes.Equal(userKey, key) {
+			break
+		}
+		if commitTS > s.request.Version {
+			stats.VersionsSkipped++
+			continue
+		}
+		val, err := item.ValueCopy(nil)
+		if err != nil {
+			return nil, false, err
+		}
+		write, err := mvcc.DecodeWriteCFValue(val)
+		if err != nil {
+			return nil, false, err
+		}
+		if write.Type != mvcc.WriteTypePut && write.Type != mvcc.WriteTypeDelete {
+			stats.VersionsSkipped++
+			continue
+		}
+		if found != nil {
+			stats.VersionsSkipped++
+		}
+		found = write
+	}
+	if found == nil {
+		return nil, false, nil
+	}
+	if found.Type == mvcc.WriteTypeDelete {
+		stats.TombstonesSeen++
+		return nil, false, nil
+	}
+	if s.request.KeyOnly {
+		return nil, true, nil
+	}
+	if found.ShortValue != nil {
+		return found.ShortValue, true, nil
+	}
+	value, err = txn.Reader.GetCF(engine_util.CF_DEFAULT, mvcc.EncodeKey(key, found.StartTS))
+	return value, err == nil, err
+}
+
+// skipVersions steps iter over all the versions of key.
+func skipVersions(iter *engine_util.CFIterator, key []byte, stats *kvrpcpb.ScanStats) error {
+	for ; iter.Valid(); iter.Next() {
+		userKey, _, err := mvcc.DecodeKey(iter.Item().Key())
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(userKey, key) {
+			return nil
+		}
+		stats.VersionsSkipped++
+	}
+	return nil
+}
+
+func (s *Scan) Context() *kvrpcpb.Context {
+	return s.request.Context
+}
+
+func (s *Scan) Response() (interface{}, error) {
+	return &s.response, nil
+}
+
+func (s *Scan) RegionError(err *errorpb.Error) interface{} {
+	if err == nil {
+		return nil
+	}
+
+	s.response.RegionError = err
+	return &s.response
+}
@@ -0,0 +1,126 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScan(t *testing.T) {
+	store := newTestStore(t)
+	defer store.close()
+
+	wb := new(engine_util.WriteBatch)
+	// a: a short value, overwritten after the read version.
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("a"), ts(20)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(15), []byte("a2")))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("a"), ts(5)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(4), []byte("a1")))
+	// b: a value in the default column family, below a rollback.
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("b"), ts(8)), mvcc.EncodeWriteCFValue(mvcc.WriteTypeRollback, ts(8), nil))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("b"), ts(5)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(4), nil))
+	wb.SetCF(engine_util.CF_DEFAULT, mvcc.EncodeKey([]byte("b"), ts(4)), []byte("b1"))
+	// c: deleted.
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("c"), ts(6)), mvcc.EncodeWriteCFValue(mvcc.WriteTypeDelete, ts(6), nil))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("c"), ts(5)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(4), []byte("c1")))
+	// d: locked before the read version.
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey([]byte("d")), mvcc.EncodeLockCFValue(&mvcc.Lock{
+		Type: mvcc.LockTypePut, Primary: []byte("d"), StartTS: ts(8), TTL: 100}))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("d"), ts(5)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(4), []byte("d1")))
+	// e: locked after the read version.
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey([]byte("e")), mvcc.EncodeLockCFValue(&mvcc.Lock{
+		Type: mvcc.LockTypePut, Primary: []byte("d"), StartTS: ts(12), TTL: 100}))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("e"), ts(5)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(4), []byte("e1")))
+	// f: locked before the read version, without any committed version.
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey([]byte("f")), mvcc.EncodeLockCFValue(&mvcc.Lock{
+		Type: mvcc.LockTypePut, Primary: []byte("d"), StartTS: ts(8), TTL: 100}))
+	// g: after the end key.
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("g"), ts(5)), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, ts(4), []byte("g1")))
+	store.write(wb)
+
+	cmd := NewScan(&kvrpcpb.ScanRequest{
+		StartKey:     []byte("a"),
+		EndKey:       []byte("g"),
+		Version:      ts(10),
+		CollectStats: true,
+	})
+	resp, _ := store.run(&cmd)
+	scanResp := resp.(*kvrpcpb.ScanResponse)
+	pairs := scanResp.Pairs
+	require.Len(t, pairs, 5)
+	assert.Equal(t, &kvrpcpb.KvPair{Key: []byte("a"), Value: []byte("a1")}, pairs[0])
+	assert.Equal(t, &kvrpcpb.KvPair{Key: []byte("b"), Value: []byte("b1")}, pairs[1])
+	assert.Equal(t, []byte("d"), pairs[2].Key)
+	assert.Equal(t, ts(8), pairs[2].Error.GetLocked().GetLockVersion())
+	assert.Equal(t, &kvrpcpb.KvPair{Key: []byte("e"), Value: []byte("e1")}, pairs[3])
+	assert.Equal(t, []byte("f"), pairs[4].Key)
+	assert.NotNil(t, pairs[4].Error.GetLocked())
+
+	stats := scanResp.Stats
+	require.NotNil(t, stats)
+	assert.Equal(t, uint64(6), stats.KeysExamined)
+	// The newer version of a, the rollback of b, the older version of c and the version of d under its lock.
+	assert.Equal(t, uint64(4), stats.VersionsSkipped)
+	assert.Equal(t, uint64(1), stats.TombstonesSeen)
+
+	// The locked keys are skipped, and don't count against the limit.
+	cmd = NewScan(&kvrpcpb.ScanRequest{StartKey: []byte("c"), EndKey: []byte("g"), Limit: 1, Version: ts(10), SkipLocked: true})
+	resp, _ = store.run(&cmd)
+	scanResp = resp.(*kvrpcpb.ScanResponse)
+	assert.Equal(t, []*kvrpcpb.KvPair{{Key: []byte("e"), Value: []byte("e1")}}, scanResp.Pairs)
+	assert.Equal(t, uint32(1), scanResp.LockedKeysSkipped)
+	cmd = NewScan(&kvrpcpb.ScanRequest{StartKey: []byte("f"), EndKey: []byte("g"), Version: ts(10), SkipLocked: true})
+	resp, _ = store.run(&cmd)
+	scanResp = resp.(*kvrpcpb.ScanResponse)
+	assert.Empty(t, scanResp.Pairs)
+	assert.Equal(t, uint32(1), scanResp.LockedKeysSkipped)
+
+	// A limited key only scan, without stats.
+	cmd = NewScan(&kvrpcpb.ScanRequest{StartKey: []byte("a"), Limit: 2, Version: ts(10), KeyOnly: true})
+	resp, _ = store.run(&cmd)
+	scanResp = resp.(*kvrpcpb.ScanResponse)
+	assert.Nil(t, scanResp.Stats)
+	assert.Equal(t, []*kvrpcpb.KvPair{{Key: []byte("a")}, {Key: []byte("b")}}, scanResp.Pairs)
+
+	// A reverse scan of [a, g) finds the same versions in descending order of the keys.
+	cmd = NewScan(&kvrpcpb.ScanRequest{
+		StartKey:     []byte("g"),
+		EndKey:       []byte("a"),
+		Version:      ts(10),
+		Reverse:      true,
+		CollectStats: true,
+	})
+	resp, _ = store.run(&cmd)
+	scanResp = resp.(*kvrpcpb.ScanResponse)
+	pairs = scanResp.Pairs
+	require.Len(t, pairs, 5)
+	assert.Equal(t, []byte("f"), pairs[0].Key)
+	assert.NotNil(t, pairs[0].Error.GetLocked())
+	assert.Equal(t, &kvrpcpb.KvPair{Key: []byte("e"), Value: []byte("e1")}, pairs[1])
+	assert.Equal(t, []byte("d"), pairs[2].Key)
+	assert.Equal(t, ts(8), pairs[2].Error.GetLocked().GetLockVersion())
+	assert.Equal(t, &kvrpcpb.KvPair{Key: []byte("b"), Value: []byte("b1")}, pairs[3])
+	assert.Equal(t, &kvrpcpb.KvPair{Key: []byte("a"), Value: []byte("a1")}, pairs[4])
+	stats = scanResp.Stats
+	assert.Equal(t, uint64(6), stats.KeysExamined)
+	assert.Equal(t, uint64(4), stats.VersionsSkipped)
+	assert.Equal(t, uint64(1), stats.TombstonesSeen)
+
+	// The start key of a reverse scan is exclusive, and its end key inclusive.
+	cmd = NewScan(&kvrpcpb.ScanRequest{StartKey: []byte("e"), EndKey: []byte("b"), Version: ts(30), Reverse: true})
+	resp, _ = store.run(&cmd)
+	scanResp = resp.(*kvrpcpb.ScanResponse)
+	require.Len(t, scanResp.Pairs, 2)
+	assert.Equal(t, []byte("d"), scanResp.Pairs[0].Key)
+	assert.Equal(t, &kvrpcpb.KvPair{Key: []byte("b"), Value: []byte("b1")}, scanResp.Pairs[1])
+
+	// Without a start key, a reverse scan starts from the last key, and the newest version is read.
+	cmd = NewScan(&kvrpcpb.ScanRequest{Limit: 1, Version: ts(30), Reverse: true})
+	resp, _ = store.run(&cmd)
+	assert.Equal(t, []*kvrpcpb.KvPair{{Key: []byte("g"), Value: []byte("g1")}}, resp.(*kvrpcpb.ScanResponse).Pairs)
+	cmd = NewScan(&kvrpcpb.ScanRequest{StartKey: []byte("b"), Version: ts(30), Reverse: true})
+	resp, _ = store.run(&cmd)
+	assert.Equal(t, []*kvrpcpb.KvPair{{Key: []byte("a"), Value: []byte("a2")}}, resp.(*kvrpcpb.ScanResponse).Pairs)
+}
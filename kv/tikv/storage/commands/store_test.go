@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/coocood/badger"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/dbreader"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/kvstore"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/stretchr/testify/require"
+)
+
+// ts returns a timestamp with the physical part, and the logical part 0.
+func ts(physical uint64) uint64 { return physical << 18 }
+
+// testCommand is a command run by a testStore.
+type testCommand interface {
+	BuildTxn(txn *kvstore.Txn) error
+	Response() (interface{}, error)
+}
+
+// testStore is a badger engine in a temporary directory which commands are run against.
+type testStore struct {
+	t   *testing.T
+	dir string
+	db  *badger.DB
+}
+
+func newTestStore(t *testing.T) *testStore {
+	dir, err := ioutil.TempDir("", "tinykv_commands")
+	require.Nil(t, err)
+	opts := badger.DefaultOptions
+	opts.Dir = dir
+	opts.ValueDir = dir
+	db, err := badger.Open(opts)
+	if err != nil {
+		os.RemoveAll(dir)
+	}
+	require.Nil(t, err)
+	return &testStore{t: t, dir: dir, db: db}
+}
+
+func (s *testStore) close() {
+	s.db.Close()
+	os.RemoveAll(s.dir)
+}
+
+func (s *testStore) write(wb *engine_util.WriteBatch) {
+	require.Nil(s.t, wb.WriteToDB(s.db))
+}
+
+// run builds the command on a snapshot of the whole engine, and returns its response and the writes it builds.
+func (s *testStore) run(cmd testCommand) (interface{}, []inner_server.Modify) {
+	return s.runInRegion(metapb.Region{}, cmd)
+}
+
+// runInRegion builds the command on a snapshot of the region.
+func (s *testStore) runInRegion(region metapb.Region, cmd testCommand) (interface{}, []inner_server.Modify) {
+	badgerTxn := s.db.NewTransaction(false)
+	defer badgerTxn.Discard()
+	txn := kvstore.NewTxn(dbreader.NewRegionReader(badgerTxn, region))
+	require.Nil(s.t, cmd.BuildTxn(&txn))
+	resp, err := cmd.Response()
+	require.Nil(s.t, err)
+	return resp, txn.Writes
+}
+
+// apply writes the writes built by a command to the engine.
+func (s *testStore) apply(writes []inner_server.Modify) {
+	wb := new(engine_util.WriteBatch)
+	for _, m := range writes {
+		switch data := m.Data.(type) {
+		case inner_server.Put:
+			wb.SetCF(data.Cf, data.Key, data.Value)
+		case inner_server.Delete:
+			wb.DeleteCF(data.Cf, data.Key)
+		}
+	}
+	s.write(wb)
+}
@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"github.com/coocood/badger"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/kvstore"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+)
+
+// TxnHeartBeat implements the Command interface for keeping a long-running transaction alive. The TTL of the
+// primary lock is raised to the advised TTL, so CheckTxnStatus doesn't roll the transaction back as expired while
+// it's still being prewritten. The TTL is never lowered.
+type TxnHeartBeat struct {
+	request  *kvrpcpb.TxnHeartBeatRequest
+	response kvrpcpb.TxnHeartBeatResponse
+}
+
+func NewTxnHeartBeat(request *kvrpcpb.TxnHeartBeatRequest) TxnHeartBeat {
+	return TxnHeartBeat{request, kvrpcpb.TxnHeartBeatResponse{}}
+}
+
+func (hb *TxnHeartBeat) BuildTxn(txn *kvstore.Txn) error {
+	key := mvcc.EncodeLockKey(hb.request.PrimaryLock)
+	val, err := txn.Reader.GetCF(engine_util.CF_LOCK, key)
+	if err != nil && err != badger.ErrKeyNotFound {
+		return err
+	}
+	var lock *mvcc.Lock
+	if err == nil {
+		if lock, err = mvcc.DecodeLock(val); err != nil {
+			return err
+		}
+	}
+	// The transaction has been committed or rolled back if it no longer holds its primary lock.
+	if lock == nil || lock.StartTS != hb.request.StartVersion {
+		hb.response.Error = &kvrpcpb.KeyError{TxnNotFound: &kvrpcpb.TxnNotFound{
+			StartTs:    hb.request.StartVersion,
+			PrimaryKey: hb.request.PrimaryLock,
+		}}
+		return nil
+	}
+
+	if hb.request.AdviseLockTtl > lock.TTL {
+		lock.TTL = hb.request.AdviseLockTtl
+		txn.PutCF(engine_util.CF_LOCK, key, mvcc.EncodeLockCFValue(lock))
+	}
+	hb.response.LockTtl = lock.TTL
+	return nil
+}
+
+func (hb *TxnHeartBeat) WillWrite() [][]byte {
+	return [][]byte{hb.request.PrimaryLock}
+}
+
+func (hb *TxnHeartBeat) Context() *kvrpcpb.Context {
+	return hb.request.Context
+}
+
+func (hb *TxnHeartBeat) Response() (interface{}, error) {
+	return &hb.response, nil
+}
+
+func (hb *TxnHeartBeat) RegionError(err *errorpb.Error) interface{} {
+	if err == nil {
+		return nil
+	}
+
+	hb.response.RegionError = err
+	return &hb.response
+}
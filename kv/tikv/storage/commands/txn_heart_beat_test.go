@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxnHeartBeat(t *testing.T) {
+	store := newTestStore(t)
+	defer store.close()
+
+	lock := &mvcc.Lock{Type: mvcc.LockTypePut, Primary: []byte("a"), StartTS: 10, TTL: 100, ShortValue: []byte("v")}
+	wb := new(engine_util.WriteBatch)
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey([]byte("a")), mvcc.EncodeLockCFValue(lock))
+	store.write(wb)
+
+	heartBeat := func(key string, startTS, ttl uint64) (*kvrpcpb.TxnHeartBeatResponse, []inner_server.Modify) {
+		cmd := NewTxnHeartBeat(&kvrpcpb.TxnHeartBeatRequest{PrimaryLock: []byte(key), StartVersion: startTS, AdviseLockTtl: ttl})
+		resp, writes := store.run(&cmd)
+		return resp.(*kvrpcpb.TxnHeartBeatResponse), writes
+	}
+
+	// The TTL is raised, the rest of the lock is kept.
+	resp, writes := heartBeat("a", 10, 300)
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, uint64(300), resp.LockTtl)
+	raised := *lock
+	raised.TTL = 300
+	assert.Equal(t, []inner_server.Modify{{Type: inner_server.ModifyTypePut, Data: inner_server.Put{
+		Key: mvcc.EncodeLockKey([]byte("a")), Value: mvcc.EncodeLockCFValue(&raised), Cf: engine_util.CF_LOCK}}}, writes)
+
+	// The TTL is never lowered.
+	resp, writes = heartBeat("a", 10, 50)
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, uint64(100), resp.LockTtl)
+	assert.Empty(t, writes)
+
+	// A transaction which doesn't hold the primary lock is not found.
+	for _, key := range []string{"a", "b"} {
+		resp, writes = heartBeat(key, 20, 300)
+		require.NotNil(t, resp.Error)
+		require.NotNil(t, resp.Error.TxnNotFound)
+		assert.Equal(t, uint64(20), resp.Error.TxnNotFound.StartTs)
+		assert.Empty(t, writes)
+	}
+}
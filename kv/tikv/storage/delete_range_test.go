@@ -0,0 +1,51 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/exec"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rangeDeleterInnerServer is a MemInnerServer which records the deleted ranges.
+type rangeDeleterInnerServer struct {
+	*inner_server.MemInnerServer
+	deleted []engine_util.KeyRange
+}
+
+func (s *rangeDeleterInnerServer) DeleteRange(ctx *kvrpcpb.Context, startKey, endKey []byte) error {
+	s.deleted = append(s.deleted, engine_util.KeyRange{StartKey: startKey, EndKey: endKey})
+	return nil
+}
+
+func TestDeleteRange(t *testing.T) {
+	inner := &rangeDeleterInnerServer{MemInnerServer: inner_server.NewMemInnerServer()}
+	svr := tikv.NewServer(inner, exec.NewSeqScheduler(inner), exec.NewReadPool(inner, &config.DefaultConf.ReadPool))
+	defer svr.Stop()
+	ctx := context.Background()
+
+	// The user keys are encoded like the keys of the CFs, an empty key leaves the range open.
+	resp, err := svr.KvDeleteRange(ctx, &kvrpcpb.DeleteRangeRequest{StartKey: []byte("t1"), EndKey: []byte("t2")})
+	require.Nil(t, err)
+	assert.Empty(t, resp.Error)
+	resp, err = svr.KvDeleteRange(ctx, &kvrpcpb.DeleteRangeRequest{StartKey: []byte("t3")})
+	require.Nil(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, []engine_util.KeyRange{
+		{StartKey: mvcc.EncodeLockKey([]byte("t1")), EndKey: mvcc.EncodeLockKey([]byte("t2"))},
+		{StartKey: mvcc.EncodeLockKey([]byte("t3"))},
+	}, inner.deleted)
+
+	resp, err = svr.KvDeleteRange(ctx, &kvrpcpb.DeleteRangeRequest{StartKey: []byte("t2"), EndKey: []byte("t1")})
+	require.Nil(t, err)
+	assert.NotEmpty(t, resp.Error)
+	assert.Len(t, inner.deleted, 2)
+}
@@ -16,8 +16,10 @@ package storage
 // writing or reading a key and value in the InnerServer store. TODO explain this encoding in detail.
 //
 // *Latches* are used to implement internal transactions and are not visible to the client. They are stored outside the
-// underlying storage (or equivalently, you can think of every key having its own latch). TODO explain latching in more detail.
+// underlying storage (or equivalently, you can think of every key having its own latch). A command declaring the keys it
+// writes holds their latches while it runs, so the commands writing the same keys run one after another, and the
+// commands writing disjoint keys run concurrently.
 //
 // Within this package, `commands` contains code to lower TinySQL requests to internal transactions. `exec` contains
 // code to handle scheduling and running commands. `kvstore` contains code for interacting with the underlying storage
-// (InnerServer).
+// (InnerServer). `latches` contains the latches of the keys. `lockwait` queues the commands waiting for the locks of other transactions and detects deadlocks.
@@ -0,0 +1,28 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/coocood/badger"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestDB opens a badger engine in a temporary directory, the returned function closes and removes it.
+func newTestDB(t *testing.T) (*badger.DB, func()) {
+	dir, err := ioutil.TempDir("", "tinykv_storage")
+	require.Nil(t, err)
+	opts := badger.DefaultOptions
+	opts.Dir = dir
+	opts.ValueDir = dir
+	db, err := badger.Open(opts)
+	if err != nil {
+		os.RemoveAll(dir)
+	}
+	require.Nil(t, err)
+	return db, func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}
+}
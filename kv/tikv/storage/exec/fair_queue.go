@@ -0,0 +1,121 @@
+package exec
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/kv/util/resource"
+)
+
+// fairQueue queues tasks by resource group and pops them in weighted fair order. Every group has a virtual time which
+// advances by the request units of its executed tasks divided by its weight, and the queued group with the earliest
+// virtual time goes first, so under contention the groups are served in proportion to their weights. A group joins
+// at no earlier than the virtual time of the last popped task, so it can't save up credit while it's idle.
+type fairQueue struct {
+	groups *resource.Groups
+	// Max number of queued tasks, 0 for no limit.
+	capacity int
+
+	mu     sync.Mutex
+	cond   *sync.Cond
+	queues map[string]*groupQueue
+	length int
+	now    float64
+	closed bool
+}
+
+var (
+	errQueueFull   = errors.New("queue is full")
+	errQueueClosed = errors.New("queue is closed")
+)
+
+type groupQueue struct {
+	tasks  []task
+	vtime  float64
+	weight uint64
+}
+
+func newFairQueue(groups *resource.Groups, capacity int) *fairQueue {
+	q := &fairQueue{groups: groups, capacity: capacity, queues: make(map[string]*groupQueue)}
+	q.cond = sync.NewCond(&q.mu)
+	return q
+}
+
+// push queues the task to the group named by its context, it returns errQueueFull or errQueueClosed if the task is
+// rejected.
+func (q *fairQueue) push(t task) error {
+	name, weight := q.groups.Resolve(t.cmd.Context().GetResourceGroup())
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return errQueueClosed
+	}
+	if q.capacity > 0 && q.length >= q.capacity {
+		return errQueueFull
+	}
+	gq, ok := q.queues[name]
+	if !ok {
+		gq = new(groupQueue)
+		q.queues[name] = gq
+	}
+	if len(gq.tasks) == 0 && gq.vtime < q.now {
+		gq.vtime = q.now
+	}
+	gq.weight = weight
+	t.group = name
+	gq.tasks = append(gq.tasks, t)
+	q.length++
+	q.cond.Signal()
+	return nil
+}
+
+// pop blocks until a task is queued, it returns false once the queue is closed and drained.
+func (q *fairQueue) pop() (task, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for q.length == 0 {
+		if q.closed {
+			return task{}, false
+		}
+		q.cond.Wait()
+	}
+	var next *groupQueue
+	var nextName string
+	for name, gq := range q.queues {
+		if len(gq.tasks) == 0 {
+			continue
+		}
+		if next == nil || gq.vtime < next.vtime || (gq.vtime == next.vtime && name < nextName) {
+			next, nextName = gq, name
+		}
+	}
+	t := next.tasks[0]
+	next.tasks[0] = task{}
+	next.tasks = next.tasks[1:]
+	q.length--
+	q.now = next.vtime
+	return t, true
+}
+
+// charge advances the virtual time of the group by the request units used by one of its tasks.
+func (q *fairQueue) charge(group string, ru float64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if gq, ok := q.queues[group]; ok {
+		gq.vtime += ru / float64(gq.weight)
+	}
+}
+
+func (q *fairQueue) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.length
+}
+
+// close makes pop return false once the queued tasks are popped, and push reject new tasks.
+func (q *fairQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.closed = true
+	q.cond.Broadcast()
+}
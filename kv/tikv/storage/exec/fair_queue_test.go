@@ -0,0 +1,77 @@
+package exec
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/util/resource"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type groupCmd struct {
+	dummyCmd
+	group string
+}
+
+func (gc *groupCmd) Context() *kvrpcpb.Context {
+	return &kvrpcpb.Context{ResourceGroup: gc.group}
+}
+
+// TestFairQueueWeights tests that the groups are served in proportion to their weights when every task costs the
+// same.
+func TestFairQueueWeights(t *testing.T) {
+	groups := resource.NewGroups()
+	groups.Update([]*pdpb.ResourceGroup{{Name: "a", Weight: 1}, {Name: "b", Weight: 3}})
+	q := newFairQueue(groups, 0)
+	for i := 0; i < 8; i++ {
+		require.Nil(t, q.push(task{cmd: &groupCmd{dummyCmd{i}, "a"}}))
+		require.Nil(t, q.push(task{cmd: &groupCmd{dummyCmd{i}, "b"}}))
+	}
+
+	served := make(map[string]int)
+	for i := 0; i < 8; i++ {
+		task, ok := q.pop()
+		require.True(t, ok)
+		served[task.group]++
+		q.charge(task.group, 1)
+	}
+	assert.Equal(t, 2, served["a"])
+	assert.Equal(t, 6, served["b"])
+}
+
+func TestFairQueueIdleGroup(t *testing.T) {
+	groups := resource.NewGroups()
+	groups.Update([]*pdpb.ResourceGroup{{Name: "a", Weight: 1}, {Name: "b", Weight: 1}})
+	q := newFairQueue(groups, 2)
+
+	// a runs alone for a while.
+	for i := 0; i < 10; i++ {
+		require.Nil(t, q.push(task{cmd: &groupCmd{dummyCmd{i}, "a"}}))
+		task, _ := q.pop()
+		q.charge(task.group, 1)
+	}
+	// b doesn't get the credit of the time it was idle, so it alternates with a instead of running 10 tasks first.
+	require.Nil(t, q.push(task{cmd: &groupCmd{dummyCmd{0}, "b"}}))
+	require.Nil(t, q.push(task{cmd: &groupCmd{dummyCmd{0}, "a"}}))
+	assert.Equal(t, errQueueFull, q.push(task{cmd: &groupCmd{dummyCmd{1}, "b"}}))
+	first, _ := q.pop()
+	q.charge(first.group, 1)
+	second, _ := q.pop()
+	assert.NotEqual(t, first.group, second.group)
+
+	q.close()
+	assert.Equal(t, errQueueClosed, q.push(task{cmd: &groupCmd{dummyCmd{0}, "a"}}))
+	_, ok := q.pop()
+	assert.False(t, ok)
+}
+
+// TestFairQueueUnknownGroup tests that unknown groups fall back to the default group.
+func TestFairQueueUnknownGroup(t *testing.T) {
+	q := newFairQueue(resource.NewGroups(), 0)
+	require.Nil(t, q.push(task{cmd: &groupCmd{dummyCmd{0}, "unknown"}}))
+	task, ok := q.pop()
+	require.True(t, ok)
+	assert.Equal(t, resource.DefaultGroup, task.group)
+}
@@ -0,0 +1,70 @@
+package exec
+
+import (
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/kv/tikv"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/latches"
+	"github.com/pingcap-incubator/tinykv/kv/util/resource"
+)
+
+// Latched is a Scheduler which executes commands on a pool of workers. The commands writing the same keys are
+// serialized by latches while the commands writing disjoint keys run concurrently, and a command which doesn't declare
+// its keys runs alone. The queued commands are executed in weighted fair order of their resource groups.
+type Latched struct {
+	innerServer tikv.InnerServer
+	queue       *fairQueue
+	latches     *latches.Latches
+	wg          sync.WaitGroup
+}
+
+func NewLatchedScheduler(innerServer tikv.InnerServer, concurrency int) *Latched {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	sched := &Latched{
+		innerServer: innerServer,
+		queue:       newFairQueue(resource.StoreGroups, maxPendingCommands),
+		latches:     latches.NewLatches(),
+	}
+	for i := 0; i < concurrency; i++ {
+		sched.wg.Add(1)
+		go sched.handleTask()
+	}
+	return sched
+}
+
+func (sched *Latched) handleTask() {
+	defer sched.wg.Done()
+	for {
+		task, ok := sched.queue.pop()
+		if !ok {
+			return
+		}
+		if keyed, ok := task.cmd.(tikv.KeyedCommand); ok {
+			keys := keyed.WillWrite()
+			sched.latches.Acquire(keys)
+			runTask(sched.innerServer, sched.queue, task)
+			sched.latches.Release(keys)
+		} else {
+			sched.latches.AcquireAll()
+			runTask(sched.innerServer, sched.queue, task)
+			sched.latches.ReleaseAll()
+		}
+	}
+}
+
+// Stop stops the workers after the queued commands are executed.
+func (sched *Latched) Stop() {
+	sched.queue.close()
+	sched.wg.Wait()
+}
+
+func (sched *Latched) Run(cmd tikv.Command) <-chan tikv.RespResult {
+	channel := make(chan tikv.RespResult, 1)
+	if err := sched.queue.push(task{cmd: cmd, resultChannel: channel}); err != nil {
+		channel <- rejectResult(cmd, err)
+		close(channel)
+	}
+	return channel
+}
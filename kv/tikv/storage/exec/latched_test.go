@@ -0,0 +1,93 @@
+package exec
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/tikv"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/kvstore"
+	"github.com/stretchr/testify/assert"
+)
+
+// keyedCmd is a dummyCmd writing keys, which blocks until it's released.
+type keyedCmd struct {
+	dummyCmd
+	keys    [][]byte
+	started chan struct{}
+	release chan struct{}
+}
+
+func newKeyedCmd(id int, keys ...string) *keyedCmd {
+	cmd := &keyedCmd{dummyCmd: dummyCmd{id}, started: make(chan struct{}), release: make(chan struct{})}
+	for _, key := range keys {
+		cmd.keys = append(cmd.keys, []byte(key))
+	}
+	return cmd
+}
+
+func (kc *keyedCmd) BuildTxn(txn *kvstore.Txn) error {
+	close(kc.started)
+	<-kc.release
+	return nil
+}
+
+func (kc *keyedCmd) WillWrite() [][]byte {
+	return kc.keys
+}
+
+func started(cmd *keyedCmd) bool {
+	select {
+	case <-cmd.started:
+		return true
+	case <-time.After(50 * time.Millisecond):
+		return false
+	}
+}
+
+// TestLatchedScheduled tests that the latched scheduler serializes the commands writing the same keys, and runs the
+// ones writing disjoint keys concurrently.
+func TestLatchedScheduled(t *testing.T) {
+	sched := NewLatchedScheduler(inner_server.NewMemInnerServer(), 3)
+	ab, bc, d := newKeyedCmd(0, "a", "b"), newKeyedCmd(1, "b", "c"), newKeyedCmd(2, "d")
+	var chs []<-chan tikv.RespResult
+	for _, cmd := range []*keyedCmd{ab, bc, d} {
+		chs = append(chs, sched.Run(cmd))
+	}
+
+	assert.True(t, started(ab))
+	assert.True(t, started(d))
+	assert.False(t, started(bc))
+	close(ab.release)
+	assert.True(t, started(bc))
+	close(bc.release)
+	close(d.release)
+	for i, ch := range chs {
+		r := <-ch
+		assert.Equal(t, i, r.Response.(int))
+	}
+
+	// A command without keys runs alone.
+	ab = newKeyedCmd(0, "a", "b")
+	ch := sched.Run(ab)
+	assert.True(t, started(ab))
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		r := <-sched.Run(&dummyCmd{1})
+		assert.Equal(t, 1, r.Response.(int))
+	}()
+	d = newKeyedCmd(2, "d")
+	time.Sleep(50 * time.Millisecond)
+	dCh := sched.Run(d)
+	assert.False(t, started(d))
+	close(ab.release)
+	wg.Wait()
+	assert.True(t, started(d))
+	close(d.release)
+	<-ch
+	<-dCh
+	sched.Stop()
+}
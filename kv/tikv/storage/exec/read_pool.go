@@ -0,0 +1,74 @@
+package exec
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/tikv"
+	"github.com/pingcap-incubator/tinykv/kv/util/resource"
+)
+
+// ReadPool is a tikv.ReadPool with a fixed number of workers for each class of reads. Each class has its own bounded
+// queue, a read is rejected as server busy when the queue of its class is full. The queued reads of a class are
+// executed in weighted fair order of their resource groups.
+type ReadPool struct {
+	innerServer tikv.InnerServer
+	queues      map[tikv.ReadClass]*fairQueue
+	wg          sync.WaitGroup
+}
+
+func NewReadPool(innerServer tikv.InnerServer, conf *config.ReadPool) *ReadPool {
+	pool := &ReadPool{
+		innerServer: innerServer,
+		queues:      make(map[tikv.ReadClass]*fairQueue),
+	}
+	pool.start(tikv.ReadClassPointGet, conf.PointGetConcurrency, conf.MaxTasksPerWorker)
+	pool.start(tikv.ReadClassScan, conf.ScanConcurrency, conf.MaxTasksPerWorker)
+	pool.start(tikv.ReadClassCoprocessor, conf.CoprocessorConcurrency, conf.MaxTasksPerWorker)
+	return pool
+}
+
+func (pool *ReadPool) start(class tikv.ReadClass, concurrency, maxTasksPerWorker int) {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	queue := newFairQueue(resource.StoreGroups, concurrency*maxTasksPerWorker)
+	pool.queues[class] = queue
+	for i := 0; i < concurrency; i++ {
+		pool.wg.Add(1)
+		go func() {
+			defer pool.wg.Done()
+			for {
+				task, ok := queue.pop()
+				if !ok {
+					return
+				}
+				runTask(pool.innerServer, queue, task)
+			}
+		}()
+	}
+}
+
+func (pool *ReadPool) Run(class tikv.ReadClass, cmd tikv.Command) <-chan tikv.RespResult {
+	channel := make(chan tikv.RespResult, 1)
+	queue, ok := pool.queues[class]
+	if !ok {
+		channel <- tikv.RespErr(fmt.Errorf("unknown read class %d", class))
+		close(channel)
+		return channel
+	}
+	if err := queue.push(task{cmd: cmd, resultChannel: channel}); err != nil {
+		channel <- busyResult(cmd, "read pool is busy", fmt.Sprintf("too many pending reads of class %d", class))
+		close(channel)
+	}
+	return channel
+}
+
+// Stop stops the workers after the pending reads are done.
+func (pool *ReadPool) Stop() {
+	for _, queue := range pool.queues {
+		queue.close()
+	}
+	pool.wg.Wait()
+}
@@ -0,0 +1,62 @@
+package exec
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/tikv"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/kvstore"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReadPoolClasses tests that scans occupying all the scan workers don't block point gets, and that reads are
+// rejected as busy once the queue of their class is full.
+func TestReadPoolClasses(t *testing.T) {
+	pool := NewReadPool(inner_server.NewMemInnerServer(), &config.ReadPool{
+		PointGetConcurrency:    1,
+		ScanConcurrency:        1,
+		CoprocessorConcurrency: 1,
+		MaxTasksPerWorker:      1,
+	})
+
+	unblock := make(chan struct{})
+	scan := pool.Run(tikv.ReadClassScan, &blockingCmd{dummyCmd{0}, unblock})
+	// Wait for the worker to take the first scan, so the second one fills the queue.
+	for pool.queues[tikv.ReadClassScan].len() != 0 {
+		time.Sleep(time.Millisecond)
+	}
+	queued := pool.Run(tikv.ReadClassScan, &blockingCmd{dummyCmd{1}, unblock})
+	busy := <-pool.Run(tikv.ReadClassScan, &blockingCmd{dummyCmd{2}, unblock})
+	require.Nil(t, busy.Err)
+	assert.NotNil(t, busy.Response.(*errorpb.Error).ServerIsBusy)
+
+	select {
+	case r := <-pool.Run(tikv.ReadClassPointGet, &dummyCmd{3}):
+		assert.Equal(t, 3, r.Response)
+	case <-time.After(time.Second):
+		t.Fatal("point get is blocked by scans")
+	}
+
+	close(unblock)
+	assert.Equal(t, 0, (<-scan).Response)
+	assert.Equal(t, 1, (<-queued).Response)
+	pool.Stop()
+}
+
+type blockingCmd struct {
+	dummyCmd
+	unblock <-chan struct{}
+}
+
+func (bc *blockingCmd) BuildTxn(txn *kvstore.Txn) error {
+	<-bc.unblock
+	return nil
+}
+
+func (bc *blockingCmd) RegionError(err *errorpb.Error) interface{} {
+	return err
+}
@@ -1,72 +1,149 @@
 package exec
 
 import (
+	"errors"
+	"time"
+
 	"github.com/pingcap-incubator/tinykv/kv/tikv"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/kvstore"
+	"github.com/pingcap-incubator/tinykv/kv/util/resource"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
 )
 
+// maxPendingCommands caps the commands queued to a scheduler, the commands beyond it are rejected as server busy.
+const maxPendingCommands = 10000
+
 // Sequential is a Scheduler which executes all commands sequentially on a single thread. Since there is no concurrency,
-// no latching, etc. is required.
+// no latching, etc. is required. The queued commands are executed in weighted fair order of their resource groups.
 type Sequential struct {
 	innerServer tikv.InnerServer
-	queue       chan task
+	queue       *fairQueue
 }
 
 type task struct {
 	cmd           tikv.Command
 	resultChannel chan<- tikv.RespResult
+	// The resource group the task is accounted to, set when it's queued.
+	group string
 }
 
 func NewSeqScheduler(innerServer tikv.InnerServer) *Sequential {
-	sched := &Sequential{innerServer, make(chan task)}
+	sched := &Sequential{innerServer, newFairQueue(resource.StoreGroups, maxPendingCommands)}
 	go sched.handleTask()
 	return sched
 }
 
 func (seq *Sequential) handleTask() {
 	for {
-		task := <-seq.queue
-
-		if task.cmd == nil && task.resultChannel == nil {
-			close(seq.queue)
+		task, ok := seq.queue.pop()
+		if !ok {
 			return
 		}
+		runTask(seq.innerServer, seq.queue, task)
+	}
+}
 
-		reader, err := seq.innerServer.Reader(task.cmd.Context())
-		if err != nil {
-			if regResp := task.cmd.RegionError(tikv.ExtractRegionError(err)); regResp != nil {
-				task.resultChannel <- tikv.RespOk(regResp)
-			} else {
-				task.resultChannel <- tikv.RespErr(err)
-			}
-		}
+// runTask executes the task and accounts the resources it used to its resource group.
+func runTask(innerServer tikv.InnerServer, queue *fairQueue, task task) {
+	result, usage := execute(innerServer, task.cmd)
+	queue.charge(task.group, usage.RU())
+	resource.Charge(task.group, usage)
+	task.resultChannel <- result
+	close(task.resultChannel)
+}
 
-		txn := kvstore.NewTxn(reader)
-		err = task.cmd.BuildTxn(&txn)
-		if err != nil {
-			task.resultChannel <- tikv.RespErr(err)
+// execute runs cmd against a snapshot of innerServer. It returns the resources used by cmd: the read bytes are
+// estimated by the size of its response, and the CPU time by the time it took to build its writes, which leaves out
+// the wait for them to be replicated.
+func execute(innerServer tikv.InnerServer, cmd tikv.Command) (tikv.RespResult, resource.Usage) {
+	var usage resource.Usage
+	result := doExecute(innerServer, cmd, &usage)
+	if sizer, ok := result.Response.(interface{ Size() int }); ok {
+		usage.ReadBytes = int64(sizer.Size())
+	}
+	return result, usage
+}
+
+func doExecute(innerServer tikv.InnerServer, cmd tikv.Command, usage *resource.Usage) tikv.RespResult {
+	start := time.Now()
+	reader, err := innerServer.Reader(cmd.Context())
+	if err != nil {
+		if regResp := cmd.RegionError(tikv.ExtractRegionError(err)); regResp != nil {
+			return tikv.RespOk(regResp)
 		}
+		return tikv.RespErr(err)
+	}
 
-		// TODO exectute txn
+	txn := kvstore.NewTxn(reader)
+	err = cmd.BuildTxn(&txn)
+	usage.CPU = time.Since(start)
+	if err != nil {
+		return tikv.RespErr(err)
+	}
 
-		result, err := task.cmd.Response()
+	if len(txn.Writes) > 0 {
+		usage.WriteBytes = writeSize(txn.Writes)
+		err = innerServer.Write(cmd.Context(), txn.Writes)
 		if err != nil {
-			task.resultChannel <- tikv.RespErr(err)
+			if regResp := cmd.RegionError(tikv.ExtractRegionError(err)); regResp != nil {
+				return tikv.RespOk(regResp)
+			}
+			return tikv.RespErr(err)
 		}
+	}
 
-		task.resultChannel <- tikv.RespOk(result)
+	result, err := cmd.Response()
+	if err != nil {
+		return tikv.RespErr(err)
+	}
+	return tikv.RespOk(result)
+}
 
-		close(task.resultChannel)
+func writeSize(writes []inner_server.Modify) int64 {
+	var size int64
+	for _, m := range writes {
+		switch data := m.Data.(type) {
+		case inner_server.Put:
+			size += int64(len(data.Key) + len(data.Value))
+		case inner_server.Delete:
+			size += int64(len(data.Key))
+		}
 	}
+	return size
 }
 
+// Stop stops the scheduler after the queued commands are executed.
 func (seq *Sequential) Stop() {
-	seq.queue <- task{nil, nil}
+	seq.queue.close()
 }
 
 func (seq *Sequential) Run(cmd tikv.Command) <-chan tikv.RespResult {
 	channel := make(chan tikv.RespResult, 1)
-	tsk := task{cmd, channel}
-	seq.queue <- tsk
+	if err := seq.queue.push(task{cmd: cmd, resultChannel: channel}); err != nil {
+		channel <- rejectResult(cmd, err)
+		close(channel)
+	}
 	return channel
 }
+
+// rejectResult is the result of a command rejected by the queue of a scheduler.
+func rejectResult(cmd tikv.Command, err error) tikv.RespResult {
+	if err == errQueueFull {
+		return busyResult(cmd, "scheduler is busy", "too many pending commands")
+	}
+	return tikv.RespErr(errors.New("scheduler is stopped"))
+}
+
+// busyResult is the server busy error of a rejected command, it's returned in the response of the command if there's
+// one for region errors.
+func busyResult(cmd tikv.Command, message, reason string) tikv.RespResult {
+	busy := &errorpb.Error{
+		Message:      message,
+		ServerIsBusy: &errorpb.ServerIsBusy{Reason: reason},
+	}
+	if resp := cmd.RegionError(busy); resp != nil {
+		return tikv.RespOk(resp)
+	}
+	return tikv.RespErr(errors.New(message))
+}
@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/coocood/badger"
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/pd"
+	"github.com/pingcap-incubator/tinykv/kv/tikv"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/dbreader"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/exec"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// regionsPDClient locates keys in a fixed list of regions, the leader of each is the first peer.
+type regionsPDClient struct {
+	pd.Client
+	regions []*metapb.Region
+}
+
+func (c *regionsPDClient) GetRegion(ctx context.Context, key []byte) (*metapb.Region, *metapb.Peer, error) {
+	for _, r := range c.regions {
+		if bytes.Compare(key, r.StartKey) >= 0 && (len(r.EndKey) == 0 || bytes.Compare(key, r.EndKey) < 0) {
+			return r, r.Peers[0], nil
+		}
+	}
+	return nil, nil, nil
+}
+
+// gcInnerServer reads from db, and records the writes of each region.
+type gcInnerServer struct {
+	*inner_server.MemInnerServer
+	db     *badger.DB
+	writes map[uint64][]inner_server.Modify
+}
+
+func (s *gcInnerServer) Reader(ctx *kvrpcpb.Context) (dbreader.DBReader, error) {
+	return dbreader.NewRegionReader(s.db.NewTransaction(false), metapb.Region{}), nil
+}
+
+func (s *gcInnerServer) Write(ctx *kvrpcpb.Context, batch []inner_server.Modify) error {
+	s.writes[ctx.RegionId] = append(s.writes[ctx.RegionId], batch...)
+	return nil
+}
+
+func TestGCWorker(t *testing.T) {
+	db, cleanUp := newTestDB(t)
+	defer cleanUp()
+
+	// Both keys have a version hidden by the safe point.
+	wb := new(engine_util.WriteBatch)
+	for _, key := range []string{"a", "x"} {
+		wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte(key), 15), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, 14, []byte("v2")))
+		wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte(key), 10), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, 9, []byte("v1")))
+	}
+	require.Nil(t, wb.WriteToDB(db))
+
+	inner := &gcInnerServer{MemInnerServer: inner_server.NewMemInnerServer(), db: db, writes: make(map[uint64][]inner_server.Modify)}
+	svr := tikv.NewServer(inner, exec.NewSeqScheduler(inner), exec.NewReadPool(inner, &config.DefaultConf.ReadPool))
+	defer svr.Stop()
+	pdClient := &regionsPDClient{regions: []*metapb.Region{
+		{Id: 1, EndKey: mvcc.EncodeLockKey([]byte("m")), Peers: []*metapb.Peer{{Id: 11, StoreId: 1}}},
+		{Id: 2, StartKey: mvcc.EncodeLockKey([]byte("m")), Peers: []*metapb.Peer{{Id: 21, StoreId: 2}}},
+	}}
+	del := func(key string) inner_server.Modify {
+		return inner_server.Modify{Type: inner_server.ModifyTypeDelete, Data: inner_server.Delete{Key: mvcc.EncodeKey([]byte(key), 10), Cf: engine_util.CF_WRITE}}
+	}
+
+	// Only the region led by the store is collected.
+	w := tikv.NewGCWorker(svr, pdClient, 1, &config.GC{KeysPerSecond: 1000})
+	require.Nil(t, w.Collect(20))
+	w.Stop()
+	assert.Equal(t, map[uint64][]inner_server.Modify{1: {del("a")}}, inner.writes)
+
+	// A standalone store collects the whole key space.
+	inner.writes = make(map[uint64][]inner_server.Modify)
+	w = tikv.NewGCWorker(svr, pdClient, 0, &config.GC{})
+	require.Nil(t, w.Collect(20))
+	w.Stop()
+	assert.Equal(t, map[uint64][]inner_server.Modify{0: {del("a"), del("x")}}, inner.writes)
+}
@@ -18,3 +18,19 @@ func NewTxn(reader dbreader.DBReader) Txn {
 		nil,
 	}
 }
+
+// PutCF adds a put of key in cf to the write buffer.
+func (txn *Txn) PutCF(cf string, key []byte, value []byte) {
+	txn.Writes = append(txn.Writes, inner_server.Modify{
+		Type: inner_server.ModifyTypePut,
+		Data: inner_server.Put{Key: key, Value: value, Cf: cf},
+	})
+}
+
+// DeleteCF adds a delete of key in cf to the write buffer.
+func (txn *Txn) DeleteCF(cf string, key []byte) {
+	txn.Writes = append(txn.Writes, inner_server.Modify{
+		Type: inner_server.ModifyTypeDelete,
+		Data: inner_server.Delete{Key: key, Cf: cf},
+	})
+}
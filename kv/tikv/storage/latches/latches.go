@@ -0,0 +1,79 @@
+// Package latches serializes the commands writing the same keys. A command acquires the latches of the keys it may
+// write before it reads them, and releases them once its writes are applied, so the conflicting commands run one after
+// another while the commands writing disjoint keys run concurrently. Unlike the locks of the transactions, latches
+// only live in the memory of a store and aren't visible to the clients.
+package latches
+
+import "sync"
+
+// Latches are the latches of the keys of a store.
+type Latches struct {
+	mu sync.Mutex
+	// latched maps the latched keys to the wait group of the command holding them.
+	latched map[string]*sync.WaitGroup
+	// all is held shared by the commands latching keys, and exclusively by a command latching the whole store.
+	all sync.RWMutex
+}
+
+func NewLatches() *Latches {
+	return &Latches{latched: make(map[string]*sync.WaitGroup)}
+}
+
+// Acquire blocks until the latches of all the keys are held, the keys may repeat. The latches are acquired all at once,
+// so two commands can't each hold a latch the other one waits for.
+func (l *Latches) Acquire(keys [][]byte) {
+	l.all.RLock()
+	for {
+		held := l.tryAcquire(keys)
+		if held == nil {
+			return
+		}
+		held.Wait()
+	}
+}
+
+// tryAcquire acquires the latches of the keys if none of them is held, otherwise it returns the wait group of a
+// command holding one of them.
+func (l *Latches) tryAcquire(keys [][]byte) *sync.WaitGroup {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, key := range keys {
+		if held, ok := l.latched[string(key)]; ok {
+			return held
+		}
+	}
+	wg := new(sync.WaitGroup)
+	wg.Add(1)
+	for _, key := range keys {
+		l.latched[string(key)] = wg
+	}
+	return nil
+}
+
+// Release releases the latches of the keys acquired by Acquire.
+func (l *Latches) Release(keys [][]byte) {
+	var wg *sync.WaitGroup
+	l.mu.Lock()
+	for _, key := range keys {
+		if held, ok := l.latched[string(key)]; ok {
+			wg = held
+			delete(l.latched, string(key))
+		}
+	}
+	l.mu.Unlock()
+	if wg != nil {
+		wg.Done()
+	}
+	l.all.RUnlock()
+}
+
+// AcquireAll blocks until no latch is held, and keeps the other commands from acquiring any until ReleaseAll, for a
+// command which can't tell the keys it writes beforehand.
+func (l *Latches) AcquireAll() {
+	l.all.Lock()
+}
+
+// ReleaseAll releases the latches acquired by AcquireAll.
+func (l *Latches) ReleaseAll() {
+	l.all.Unlock()
+}
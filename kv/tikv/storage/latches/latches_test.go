@@ -0,0 +1,56 @@
+package latches
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// acquired acquires the latches of the keys in the background, the returned channel is closed once they're held.
+func acquired(l *Latches, keys ...[]byte) <-chan struct{} {
+	ch := make(chan struct{})
+	go func() {
+		l.Acquire(keys)
+		close(ch)
+	}()
+	return ch
+}
+
+func isClosed(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	case <-time.After(50 * time.Millisecond):
+		return false
+	}
+}
+
+func TestLatches(t *testing.T) {
+	l := NewLatches()
+	a, b, c := []byte("a"), []byte("b"), []byte("c")
+	assert.True(t, isClosed(acquired(l, a, b, a)))
+
+	// A command writing one of the keys waits, the one writing other keys doesn't.
+	waiting := acquired(l, b, c)
+	assert.False(t, isClosed(waiting))
+	assert.True(t, isClosed(acquired(l, []byte("d"))))
+	l.Release([][]byte{a, b, a})
+	assert.True(t, isClosed(waiting))
+
+	// A command latching the whole store waits for all the latches, and blocks the later commands.
+	all := make(chan struct{})
+	go func() {
+		l.AcquireAll()
+		close(all)
+	}()
+	assert.False(t, isClosed(all))
+	l.Release([][]byte{b, c})
+	assert.False(t, isClosed(all))
+	l.Release([][]byte{[]byte("d")})
+	assert.True(t, isClosed(all))
+	waiting = acquired(l, a)
+	assert.False(t, isClosed(waiting))
+	l.ReleaseAll()
+	assert.True(t, isClosed(waiting))
+}
@@ -0,0 +1,102 @@
+package lockwait
+
+import "sync"
+
+// Edge is a wait of the transaction started at Txn for the lock of the transaction started at WaitForTxn on the key
+// hashed to KeyHash.
+type Edge struct {
+	Txn        uint64
+	WaitForTxn uint64
+	KeyHash    uint64
+}
+
+// Detector is the wait-for graph of the transactions waiting for locks. A wait closing a cycle in the graph is a
+// deadlock.
+type Detector struct {
+	mu sync.Mutex
+	// waitFor maps a transaction to its waits.
+	waitFor map[uint64][]Edge
+	// refs counts the waiters sharing a wait, it's removed once the last of them cleans it up.
+	refs map[Edge]int
+}
+
+func NewDetector() *Detector {
+	return &Detector{waitFor: make(map[uint64][]Edge), refs: make(map[Edge]int)}
+}
+
+// Detect adds the wait of txn for waitForTxn on the key, unless the wait closes a cycle. Then the graph is left as is
+// and the cycle is returned, starting with the new wait and following the waits back to txn.
+func (d *Detector) Detect(txn, waitForTxn, keyHash uint64) []Edge {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	edge := Edge{Txn: txn, WaitForTxn: waitForTxn, KeyHash: keyHash}
+	if path := d.findPath(waitForTxn, txn); path != nil {
+		return append([]Edge{edge}, path...)
+	}
+	if d.refs[edge] == 0 {
+		d.waitFor[txn] = append(d.waitFor[txn], edge)
+	}
+	d.refs[edge]++
+	return nil
+}
+
+// findPath returns the waits leading from one transaction to another, or nil if there are none.
+func (d *Detector) findPath(from, to uint64) []Edge {
+	// reachedBy maps the visited transactions to the wait they're reached by.
+	reachedBy := map[uint64]Edge{from: {}}
+	stack := []uint64{from}
+	for len(stack) > 0 {
+		txn := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, e := range d.waitFor[txn] {
+			if _, ok := reachedBy[e.WaitForTxn]; ok {
+				continue
+			}
+			reachedBy[e.WaitForTxn] = e
+			if e.WaitForTxn != to {
+				stack = append(stack, e.WaitForTxn)
+				continue
+			}
+			var path []Edge
+			for t := to; t != from; t = reachedBy[t].Txn {
+				path = append([]Edge{reachedBy[t]}, path...)
+			}
+			return path
+		}
+	}
+	return nil
+}
+
+// CleanUpWaitFor removes the wait of txn for waitForTxn on the key, once every Detect adding it is cleaned up.
+func (d *Detector) CleanUpWaitFor(txn, waitForTxn, keyHash uint64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	edge := Edge{Txn: txn, WaitForTxn: waitForTxn, KeyHash: keyHash}
+	if d.refs[edge] > 1 {
+		d.refs[edge]--
+		return
+	}
+	delete(d.refs, edge)
+	edges := d.waitFor[txn]
+	for i, e := range edges {
+		if e == edge {
+			edges = append(edges[:i], edges[i+1:]...)
+			break
+		}
+	}
+	if len(edges) == 0 {
+		delete(d.waitFor, txn)
+	} else {
+		d.waitFor[txn] = edges
+	}
+}
+
+// CleanUp removes all the waits of txn.
+func (d *Detector) CleanUp(txn uint64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, e := range d.waitFor[txn] {
+		delete(d.refs, e)
+	}
+	delete(d.waitFor, txn)
+}
@@ -0,0 +1,35 @@
+package lockwait
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetector(t *testing.T) {
+	d := NewDetector()
+	assert.Nil(t, d.Detect(1, 2, 12))
+	assert.Nil(t, d.Detect(2, 3, 23))
+
+	// 3 waiting for 1 closes the cycle 3 -> 1 -> 2 -> 3.
+	cycle := d.Detect(3, 1, 31)
+	assert.Equal(t, []Edge{{3, 1, 31}, {1, 2, 12}, {2, 3, 23}}, cycle)
+	// The wait closing the cycle isn't added.
+	assert.Equal(t, cycle, d.Detect(3, 1, 31))
+
+	// Removing a wait of the cycle breaks it.
+	d.CleanUpWaitFor(1, 2, 12)
+	assert.Nil(t, d.Detect(3, 1, 31))
+	assert.Equal(t, []Edge{{1, 3, 13}, {3, 1, 31}}, d.Detect(1, 3, 13))
+	d.CleanUp(3)
+	assert.Nil(t, d.Detect(1, 3, 13))
+	assert.Empty(t, d.refs[Edge{3, 1, 31}])
+
+	// A wait shared by two waiters is kept until both clean it up.
+	assert.Nil(t, d.Detect(4, 5, 45))
+	assert.Nil(t, d.Detect(4, 5, 45))
+	d.CleanUpWaitFor(4, 5, 45)
+	assert.Equal(t, []Edge{{5, 4, 54}, {4, 5, 45}}, d.Detect(5, 4, 54))
+	d.CleanUpWaitFor(4, 5, 45)
+	assert.Nil(t, d.Detect(5, 4, 54))
+}
@@ -0,0 +1,207 @@
+// Package lockwait queues the commands of the transactions waiting for the locks of other transactions, and detects
+// the deadlocks among them. Rather than returning a lock to the client at once, a command may wait for the transaction
+// holding it, and be woken up once that transaction commits or rolls back the key. The waits form a wait-for graph
+// between the transactions, and a wait closing a cycle is a deadlock, broken by aborting the youngest transaction of
+// the cycle, the one with the largest start ts.
+package lockwait
+
+import (
+	"sync"
+	"time"
+)
+
+// ResultKind tells how a wait ended.
+type ResultKind int
+
+const (
+	// WokenUp means the transaction holding the lock committed or rolled back the key.
+	WokenUp ResultKind = iota
+	// TimedOut means the lock is still held after the timeout.
+	TimedOut
+	// Deadlocked means the waiting transaction is aborted to break a deadlock.
+	Deadlocked
+)
+
+// Result is the outcome of a wait.
+type Result struct {
+	Kind ResultKind
+	// For WokenUp, the commit ts of the transaction holding the lock, 0 if it rolled back.
+	CommitTS uint64
+	// For Deadlocked, the hash of the key another transaction of the cycle waits for the aborted one on.
+	DeadlockKeyHash uint64
+}
+
+// Waiter is a wait of the transaction started at StartTS for the lock of the transaction started at LockTS on the key
+// hashed to KeyHash.
+type Waiter struct {
+	StartTS uint64
+	LockTS  uint64
+	KeyHash uint64
+
+	manager *Manager
+	result  chan Result
+}
+
+// Wait blocks until the wait ends, for the timeout at most.
+func (w *Waiter) Wait(timeout time.Duration) Result {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case result := <-w.result:
+		return result
+	case <-timer.C:
+	}
+	if !w.manager.dequeue(w) {
+		// The wait ended meanwhile.
+		return <-w.result
+	}
+	return Result{Kind: TimedOut}
+}
+
+// Cancel drops the wait instead of waiting, for a caller which finds the lock released after queueing it.
+func (w *Waiter) Cancel() {
+	w.manager.dequeue(w)
+}
+
+// Manager queues the waiters of the locks of a store.
+type Manager struct {
+	mu sync.Mutex
+	// waiters maps the start ts of the transactions holding locks to the waiters of their locks.
+	waiters  map[uint64][]*Waiter
+	detector *Detector
+}
+
+func NewManager() *Manager {
+	return &Manager{
+		waiters:  make(map[uint64][]*Waiter),
+		detector: NewDetector(),
+	}
+}
+
+// NewWaiter queues a wait of the transaction started at startTS for the lock of the transaction started at lockTS on
+// the key. If the wait closes a cycle, the youngest transaction of the cycle is aborted: if it's the waiting one, the
+// returned waiter isn't queued and its Wait returns Deadlocked at once, otherwise the waits of the youngest one end
+// with Deadlocked and the new wait is queued. The caller should check the lock again after queueing the wait, since
+// the lock may have been released meanwhile.
+func (m *Manager) NewWaiter(startTS, lockTS, keyHash uint64) *Waiter {
+	w := &Waiter{
+		StartTS: startTS,
+		LockTS:  lockTS,
+		KeyHash: keyHash,
+		manager: m,
+		result:  make(chan Result, 1),
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for {
+		cycle := m.detector.Detect(startTS, lockTS, keyHash)
+		if cycle == nil {
+			break
+		}
+		youngest := cycle[0].Txn
+		for _, e := range cycle {
+			if e.Txn > youngest {
+				youngest = e.Txn
+			}
+		}
+		result := Result{Kind: Deadlocked, DeadlockKeyHash: waitedKeyHash(cycle, youngest)}
+		if youngest == startTS {
+			w.result <- result
+			return w
+		}
+		m.abortLocked(youngest, result)
+	}
+	m.waiters[lockTS] = append(m.waiters[lockTS], w)
+	return w
+}
+
+// waitedKeyHash returns the hash of the key a transaction of the cycle waits for txn on.
+func waitedKeyHash(cycle []Edge, txn uint64) uint64 {
+	for _, e := range cycle {
+		if e.WaitForTxn == txn {
+			return e.KeyHash
+		}
+	}
+	return 0
+}
+
+// abortLocked ends all the waits of the transaction started at txn with the result.
+func (m *Manager) abortLocked(txn uint64, result Result) {
+	for lockTS, waiters := range m.waiters {
+		m.waiters[lockTS] = m.filterLocked(waiters, func(w *Waiter) bool { return w.StartTS == txn }, result)
+		if len(m.waiters[lockTS]) == 0 {
+			delete(m.waiters, lockTS)
+		}
+	}
+	m.detector.CleanUp(txn)
+}
+
+// WakeUp ends the waits for the locks of the transaction started at lockTS on the keys, once it has committed them
+// at commitTS, or rolled them back if commitTS is 0.
+func (m *Manager) WakeUp(lockTS, commitTS uint64, keyHashes []uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	waiters, ok := m.waiters[lockTS]
+	if !ok {
+		return
+	}
+	released := make(map[uint64]struct{}, len(keyHashes))
+	for _, keyHash := range keyHashes {
+		released[keyHash] = struct{}{}
+	}
+	waiters = m.filterLocked(waiters, func(w *Waiter) bool {
+		_, ok := released[w.KeyHash]
+		return ok
+	}, Result{Kind: WokenUp, CommitTS: commitTS})
+	if len(waiters) == 0 {
+		delete(m.waiters, lockTS)
+	} else {
+		m.waiters[lockTS] = waiters
+	}
+}
+
+// WakeUpAll ends all the waits, for the locks removed without knowing the transactions holding them, like by deleting
+// a range of keys. The waits end as if the locks were rolled back, a waiter of a lock still held must check it again.
+func (m *Manager) WakeUpAll() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for lockTS, waiters := range m.waiters {
+		m.filterLocked(waiters, func(w *Waiter) bool { return true }, Result{Kind: WokenUp})
+		delete(m.waiters, lockTS)
+	}
+}
+
+// filterLocked ends the waits matching end with the result, and returns the rest.
+func (m *Manager) filterLocked(waiters []*Waiter, end func(w *Waiter) bool, result Result) []*Waiter {
+	rest := waiters[:0]
+	for _, w := range waiters {
+		if !end(w) {
+			rest = append(rest, w)
+			continue
+		}
+		m.detector.CleanUpWaitFor(w.StartTS, w.LockTS, w.KeyHash)
+		w.result <- result
+	}
+	return rest
+}
+
+// dequeue drops a waiter, it returns false if the waiter isn't queued, because its wait has ended already.
+func (m *Manager) dequeue(w *Waiter) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	waiters := m.waiters[w.LockTS]
+	for i, queued := range waiters {
+		if queued != w {
+			continue
+		}
+		waiters = append(waiters[:i], waiters[i+1:]...)
+		if len(waiters) == 0 {
+			delete(m.waiters, w.LockTS)
+		} else {
+			m.waiters[w.LockTS] = waiters
+		}
+		m.detector.CleanUpWaitFor(w.StartTS, w.LockTS, w.KeyHash)
+		return true
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package lockwait
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWakeUp(t *testing.T) {
+	m := NewManager()
+	w1 := m.NewWaiter(20, 10, 1)
+	w2 := m.NewWaiter(30, 10, 2)
+	results := make(chan Result)
+	go func() {
+		results <- w1.Wait(time.Minute)
+	}()
+
+	// Only the waiters of the released keys are woken up.
+	m.WakeUp(10, 15, []uint64{1, 3})
+	assert.Equal(t, Result{Kind: WokenUp, CommitTS: 15}, <-results)
+	assert.Equal(t, Result{Kind: TimedOut}, w2.Wait(10*time.Millisecond))
+	assert.Empty(t, m.waiters)
+	assert.Empty(t, m.detector.waitFor)
+
+	// A cancelled wait is dropped.
+	m.NewWaiter(20, 10, 1).Cancel()
+	assert.Empty(t, m.waiters)
+	assert.Empty(t, m.detector.waitFor)
+
+	// All the waits end whatever lock they wait for.
+	w1 = m.NewWaiter(20, 10, 1)
+	w2 = m.NewWaiter(30, 40, 2)
+	m.WakeUpAll()
+	assert.Equal(t, Result{Kind: WokenUp}, w1.Wait(time.Minute))
+	assert.Equal(t, Result{Kind: WokenUp}, w2.Wait(time.Minute))
+	assert.Empty(t, m.waiters)
+	assert.Empty(t, m.detector.waitFor)
+}
+
+func TestSharedWait(t *testing.T) {
+	m := NewManager()
+	// Two reads of the same transaction wait for the same lock.
+	w1 := m.NewWaiter(20, 10, 1)
+	w2 := m.NewWaiter(20, 10, 1)
+	w1.Cancel()
+
+	// The wait of the other read still closes the cycle 10 -> 20 -> 10, the youngest 20 is aborted.
+	m.NewWaiter(10, 20, 2)
+	assert.Equal(t, Result{Kind: Deadlocked, DeadlockKeyHash: 2}, w2.Wait(time.Minute))
+	assert.Equal(t, []Edge{{10, 20, 2}}, m.detector.waitFor[10])
+	assert.Empty(t, m.waiters[10])
+	assert.Empty(t, m.detector.waitFor[20])
+}
+
+func TestDeadlock(t *testing.T) {
+	m := NewManager()
+	w1 := m.NewWaiter(10, 20, 1)
+	w2 := m.NewWaiter(30, 10, 2)
+
+	// 20 waiting for 30 closes the cycle 20 -> 30 -> 10 -> 20, the youngest 30 is aborted and 20 waits.
+	w3 := m.NewWaiter(20, 30, 3)
+	assert.Equal(t, Result{Kind: Deadlocked, DeadlockKeyHash: 3}, w2.Wait(time.Minute))
+
+	// The youngest 30 closing the cycle again is aborted at once.
+	w4 := m.NewWaiter(30, 10, 4)
+	assert.Equal(t, Result{Kind: Deadlocked, DeadlockKeyHash: 3}, w4.Wait(time.Minute))
+
+	m.WakeUp(20, 25, []uint64{1})
+	assert.Equal(t, Result{Kind: WokenUp, CommitTS: 25}, w1.Wait(time.Minute))
+	m.WakeUp(30, 0, []uint64{3})
+	assert.Equal(t, Result{Kind: WokenUp}, w3.Wait(time.Minute))
+	assert.Empty(t, m.waiters)
+	assert.Empty(t, m.detector.waitFor)
+}
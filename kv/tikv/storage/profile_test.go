@@ -0,0 +1,54 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/tikv"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type profileStream struct {
+	grpc.ServerStream
+	resps []*kvrpcpb.ProfileResponse
+}
+
+func (s *profileStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *profileStream) Send(resp *kvrpcpb.ProfileResponse) error {
+	s.resps = append(s.resps, resp)
+	return nil
+}
+
+func TestProfile(t *testing.T) {
+	svr := tikv.NewServer(inner_server.NewMemInnerServer(), nil, nil)
+	for _, tp := range []kvrpcpb.ProfileType{
+		kvrpcpb.ProfileType_ProfileCPU,
+		kvrpcpb.ProfileType_ProfileHeap,
+		kvrpcpb.ProfileType_ProfileMutex,
+		kvrpcpb.ProfileType_ProfileBlock,
+		kvrpcpb.ProfileType_ProfileGoroutine,
+	} {
+		stream := new(profileStream)
+		require.Nil(t, svr.Profile(&kvrpcpb.ProfileRequest{Type: tp}, stream))
+		var data []byte
+		for _, resp := range stream.resps {
+			require.Empty(t, resp.Error, tp.String())
+			data = append(data, resp.Data...)
+		}
+		// The profiles are gzipped protobufs.
+		assert.True(t, bytes.HasPrefix(data, []byte{0x1f, 0x8b}), tp.String())
+	}
+
+	stream := new(profileStream)
+	require.Nil(t, svr.Profile(&kvrpcpb.ProfileRequest{Type: 10}, stream))
+	require.Len(t, stream.resps, 1)
+	assert.NotEmpty(t, stream.resps[0].Error)
+}
@@ -1,12 +1,22 @@
 package storage
 
 import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/coocood/badger"
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/tikv"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/dbreader"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/commands"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/exec"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/stretchr/testify/assert"
-	"testing"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGet(t *testing.T) {
@@ -27,3 +37,78 @@ func TestGet(t *testing.T) {
 	resp := result.Response.(*kvrpcpb.RawGetResponse)
 	assert.Equal(t, resp.Value, []byte{42})
 }
+
+// batchInnerServer is a MemInnerServer which reads from and writes to db, and counts the writes.
+type batchInnerServer struct {
+	*inner_server.MemInnerServer
+	db     *badger.DB
+	mu     sync.Mutex
+	writes int
+}
+
+func (s *batchInnerServer) Reader(ctx *kvrpcpb.Context) (dbreader.DBReader, error) {
+	return dbreader.NewRegionReader(s.db.NewTransaction(false), metapb.Region{}), nil
+}
+
+func (s *batchInnerServer) Write(ctx *kvrpcpb.Context, batch []inner_server.Modify) error {
+	s.mu.Lock()
+	s.writes++
+	s.mu.Unlock()
+	wb := new(engine_util.WriteBatch)
+	for _, m := range batch {
+		switch data := m.Data.(type) {
+		case inner_server.Put:
+			wb.SetCF(data.Cf, data.Key, data.Value)
+		case inner_server.Delete:
+			wb.DeleteCF(data.Cf, data.Key)
+		}
+	}
+	return wb.WriteToDB(s.db)
+}
+
+func (s *batchInnerServer) DeleteRange(ctx *kvrpcpb.Context, startKey, endKey []byte) error {
+	return engine_util.DeleteRange(s.db, startKey, endKey)
+}
+
+func TestRawBatch(t *testing.T) {
+	db, cleanUp := newTestDB(t)
+	defer cleanUp()
+
+	inner := &batchInnerServer{MemInnerServer: inner_server.NewMemInnerServer(), db: db}
+	svr := tikv.NewServer(inner, exec.NewSeqScheduler(inner), exec.NewReadPool(inner, &config.DefaultConf.ReadPool))
+	defer svr.Stop()
+	cf := engine_util.CF_DEFAULT
+	keys := func(keys ...string) [][]byte {
+		var res [][]byte
+		for _, key := range keys {
+			res = append(res, []byte(key))
+		}
+		return res
+	}
+
+	// All the pairs are put in a single write.
+	putResp, err := svr.RawBatchPut(context.Background(), &kvrpcpb.RawBatchPutRequest{Cf: cf, Pairs: []*kvrpcpb.KvPair{
+		{Key: []byte("c"), Value: []byte("3")},
+		{Key: []byte("a"), Value: []byte("1")},
+		{Key: []byte("b"), Value: []byte("2")},
+	}})
+	require.Nil(t, err)
+	assert.Empty(t, putResp.Error)
+	assert.Equal(t, 1, inner.writes)
+
+	// The pairs are returned in the order of the keys, missing keys are left out.
+	getResp, err := svr.RawBatchGet(context.Background(), &kvrpcpb.RawBatchGetRequest{Cf: cf, Keys: keys("c", "x", "a")})
+	require.Nil(t, err)
+	assert.Equal(t, []*kvrpcpb.KvPair{
+		{Key: []byte("c"), Value: []byte("3")},
+		{Key: []byte("a"), Value: []byte("1")},
+	}, getResp.Pairs)
+
+	deleteResp, err := svr.RawBatchDelete(context.Background(), &kvrpcpb.RawBatchDeleteRequest{Cf: cf, Keys: keys("a", "c")})
+	require.Nil(t, err)
+	assert.Empty(t, deleteResp.Error)
+	assert.Equal(t, 2, inner.writes)
+	getResp, err = svr.RawBatchGet(context.Background(), &kvrpcpb.RawBatchGetRequest{Cf: cf, Keys: keys("a", "b", "c")})
+	require.Nil(t, err)
+	assert.Equal(t, []*kvrpcpb.KvPair{{Key: []byte("b"), Value: []byte("2")}}, getResp.Pairs)
+}
@@ -0,0 +1,142 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/coocood/badger"
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/raftstore/message"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/exec"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// snapshotInnerServer is a MemInnerServer which takes region snapshots from db, and records the writes.
+type snapshotInnerServer struct {
+	*inner_server.MemInnerServer
+	db     *badger.DB
+	region metapb.Region
+	writes []inner_server.Modify
+}
+
+func (s *snapshotInnerServer) RegionSnapshot(ctx *kvrpcpb.Context) (*message.RegionSnapshot, error) {
+	return &message.RegionSnapshot{Region: s.region, Txn: s.db.NewTransaction(false), Index: 5}, nil
+}
+
+func (s *snapshotInnerServer) Write(ctx *kvrpcpb.Context, batch []inner_server.Modify) error {
+	s.writes = append(s.writes, batch...)
+	return nil
+}
+
+type exportStream struct {
+	grpc.ServerStream
+	resps []*kvrpcpb.ExportRegionResponse
+}
+
+func (s *exportStream) Send(resp *kvrpcpb.ExportRegionResponse) error {
+	s.resps = append(s.resps, resp)
+	return nil
+}
+
+func TestExportImportRegion(t *testing.T) {
+	db, cleanUp := newTestDB(t)
+	defer cleanUp()
+
+	wb := new(engine_util.WriteBatch)
+	for _, key := range []string{"a", "b", "c", "d"} {
+		wb.SetCF(engine_util.CF_DEFAULT, []byte(key), []byte("v"+key))
+	}
+	wb.SetCF(engine_util.CF_LOCK, []byte("c"), []byte("lock"))
+	require.Nil(t, wb.WriteToDB(db))
+
+	region := metapb.Region{Id: 1, StartKey: []byte("b"), EndKey: []byte("d")}
+	inner := &snapshotInnerServer{MemInnerServer: inner_server.NewMemInnerServer(), db: db, region: region}
+	svr := tikv.NewServer(inner, exec.NewSeqScheduler(inner), exec.NewReadPool(inner, &config.DefaultConf.ReadPool))
+	defer svr.Stop()
+
+	// Only the keys inside the region are exported, from all the column families.
+	stream := new(exportStream)
+	require.Nil(t, svr.ExportRegion(&kvrpcpb.ExportRegionRequest{Context: &kvrpcpb.Context{RegionId: 1}}, stream))
+	require.Len(t, stream.resps, 1)
+	resp := stream.resps[0]
+	assert.Equal(t, uint64(1), resp.Region.Id)
+	assert.Equal(t, uint64(5), resp.AppliedIndex)
+	assert.Equal(t, []*kvrpcpb.ExportedPair{
+		{Cf: engine_util.CF_DEFAULT, Key: []byte("b"), Value: []byte("vb")},
+		{Cf: engine_util.CF_DEFAULT, Key: []byte("c"), Value: []byte("vc")},
+		{Cf: engine_util.CF_LOCK, Key: []byte("c"), Value: []byte("lock")},
+	}, resp.Pairs)
+
+	// The imported pairs are written as puts to their column families.
+	importResp, err := svr.ImportRegion(context.Background(), &kvrpcpb.ImportRegionRequest{
+		Context: &kvrpcpb.Context{RegionId: 7},
+		Pairs:   resp.Pairs,
+	})
+	require.Nil(t, err)
+	assert.Nil(t, importResp.RegionError)
+	assert.Empty(t, importResp.Error)
+	require.Len(t, inner.writes, 3)
+	assert.Equal(t, inner_server.Put{Key: []byte("c"), Value: []byte("lock"), Cf: engine_util.CF_LOCK}, inner.writes[2].Data)
+}
+
+func TestImportRegionCommitted(t *testing.T) {
+	db, cleanUp := newTestDB(t)
+	defer cleanUp()
+
+	inner := &batchInnerServer{MemInnerServer: inner_server.NewMemInnerServer(), db: db}
+	svr := tikv.NewServer(inner, exec.NewSeqScheduler(inner), exec.NewReadPool(inner, &config.DefaultConf.ReadPool))
+	defer svr.Stop()
+
+	long := bytes.Repeat([]byte("v"), mvcc.MaxShortValueLen+1)
+	importResp, err := svr.ImportRegion(context.Background(), &kvrpcpb.ImportRegionRequest{
+		Context:  &kvrpcpb.Context{},
+		Pairs:    []*kvrpcpb.ExportedPair{{Key: []byte("a"), Value: []byte("va")}, {Key: []byte("b"), Value: long}},
+		CommitTs: 10,
+	})
+	require.Nil(t, err)
+	assert.Empty(t, importResp.Error)
+
+	// The pairs are visible to the reads at the commit ts or later.
+	getResp, err := svr.KvBatchGet(context.Background(), &kvrpcpb.BatchGetRequest{
+		Context: &kvrpcpb.Context{},
+		Keys:    [][]byte{[]byte("a"), []byte("b")},
+		Version: 10,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, []*kvrpcpb.KvPair{{Key: []byte("a"), Value: []byte("va")}, {Key: []byte("b"), Value: long}},
+		getResp.Pairs)
+	getResp, err = svr.KvBatchGet(context.Background(), &kvrpcpb.BatchGetRequest{
+		Context: &kvrpcpb.Context{},
+		Keys:    [][]byte{[]byte("a"), []byte("b")},
+		Version: 9,
+	})
+	require.Nil(t, err)
+	assert.Empty(t, getResp.Pairs)
+
+	// The pairs can't be imported below the versions the keys already have.
+	importResp, err = svr.ImportRegion(context.Background(), &kvrpcpb.ImportRegionRequest{
+		Context:  &kvrpcpb.Context{},
+		Pairs:    []*kvrpcpb.ExportedPair{{Key: []byte("a"), Value: []byte("old")}},
+		CommitTs: 10,
+	})
+	require.Nil(t, err)
+	assert.NotEmpty(t, importResp.Error)
+
+	// Only the default column family can be imported as committed.
+	importResp, err = svr.ImportRegion(context.Background(), &kvrpcpb.ImportRegionRequest{
+		Context:  &kvrpcpb.Context{},
+		Pairs:    []*kvrpcpb.ExportedPair{{Cf: engine_util.CF_LOCK, Key: []byte("a"), Value: []byte("va")}},
+		CommitTs: 10,
+	})
+	require.Nil(t, err)
+	assert.NotEmpty(t, importResp.Error)
+}
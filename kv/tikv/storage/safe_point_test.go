@@ -0,0 +1,58 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/tikv"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/commands"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/exec"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/coprocessor"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSafePointCheck(t *testing.T) {
+	db, cleanUp := newTestDB(t)
+	defer cleanUp()
+
+	inner := &batchInnerServer{MemInnerServer: inner_server.NewMemInnerServer(), db: db}
+	svr := tikv.NewServer(inner, exec.NewSeqScheduler(inner), exec.NewReadPool(inner, &config.DefaultConf.ReadPool))
+	defer svr.Stop()
+	ctx := context.Background()
+
+	gcResp, err := svr.KvGC(ctx, &kvrpcpb.GCRequest{Context: &kvrpcpb.Context{}, SafePoint: 20})
+	require.Nil(t, err)
+	require.Empty(t, gcResp.Error)
+	// An older safe point doesn't move it back.
+	_, err = svr.KvGC(ctx, &kvrpcpb.GCRequest{Context: &kvrpcpb.Context{}, SafePoint: 10})
+	require.Nil(t, err)
+
+	expired := &kvrpcpb.KeyError{SafePointExpired: &kvrpcpb.SafePointExpired{StartTs: 15, SafePoint: 20}}
+	scanResp, err := svr.KvScan(ctx, &kvrpcpb.ScanRequest{Context: &kvrpcpb.Context{}, Version: 15})
+	require.Nil(t, err)
+	assert.Equal(t, expired, scanResp.Error)
+	getResp, err := svr.KvBatchGet(ctx, &kvrpcpb.BatchGetRequest{Context: &kvrpcpb.Context{}, Keys: [][]byte{{1}}, Version: 15})
+	require.Nil(t, err)
+	assert.Equal(t, expired, getResp.Error)
+	conflictsResp, err := svr.KvCheckConflicts(ctx, &kvrpcpb.CheckConflictsRequest{Context: &kvrpcpb.Context{}, StartVersion: 15})
+	require.Nil(t, err)
+	assert.Equal(t, []*kvrpcpb.KeyError{expired}, conflictsResp.Errors)
+	commitResp, err := svr.KvCommit(ctx, &kvrpcpb.CommitRequest{Context: &kvrpcpb.Context{}, StartVersion: 15, CommitVersion: 25})
+	require.Nil(t, err)
+	assert.Equal(t, expired, commitResp.Error)
+	heartBeatResp, err := svr.KvTxnHeartBeat(ctx, &kvrpcpb.TxnHeartBeatRequest{Context: &kvrpcpb.Context{}, StartVersion: 15})
+	require.Nil(t, err)
+	assert.Equal(t, expired, heartBeatResp.Error)
+	coprResp, err := svr.Coprocessor(ctx, &coprocessor.Request{Context: &kvrpcpb.Context{}, Tp: commands.ReqTypeChecksum, StartTs: 15})
+	require.Nil(t, err)
+	assert.Equal(t, expired.String(), coprResp.OtherError)
+
+	// A transaction started at the safe point can still read.
+	scanResp, err = svr.KvScan(ctx, &kvrpcpb.ScanRequest{Context: &kvrpcpb.Context{}, Version: 20})
+	require.Nil(t, err)
+	assert.Nil(t, scanResp.Error)
+}
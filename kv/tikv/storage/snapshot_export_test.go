@@ -0,0 +1,128 @@
+package storage
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/exec"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type exportSnapshotStream struct {
+	grpc.ServerStream
+	resps []*kvrpcpb.ExportSnapshotResponse
+}
+
+func (s *exportSnapshotStream) Send(resp *kvrpcpb.ExportSnapshotResponse) error {
+	s.resps = append(s.resps, resp)
+	return nil
+}
+
+func TestExportSnapshot(t *testing.T) {
+	db, cleanUp := newTestDB(t)
+	defer cleanUp()
+
+	wb := new(engine_util.WriteBatch)
+	for i := 0; i < 10; i++ {
+		key := []byte(fmt.Sprintf("k%d", i))
+		wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey(key, 10), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, 9, []byte("v1")))
+	}
+	// k3 is deleted before the version, k4 is overwritten after it.
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("k3"), 20), mvcc.EncodeWriteCFValue(mvcc.WriteTypeDelete, 19, nil))
+	wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte("k4"), 40), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, 39, []byte("v2")))
+	// k6 is locked after the version.
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey([]byte("k6")), mvcc.EncodeLockCFValue(&mvcc.Lock{
+		Type: mvcc.LockTypePut, Primary: []byte("k6"), StartTS: 50, TTL: 100}))
+	// k8 is locked before the version.
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey([]byte("k8")), mvcc.EncodeLockCFValue(&mvcc.Lock{
+		Type: mvcc.LockTypePut, Primary: []byte("k8"), StartTS: 25, TTL: 100}))
+	require.Nil(t, wb.WriteToDB(db))
+
+	region := metapb.Region{Id: 1, StartKey: mvcc.EncodeLockKey([]byte("k1")), EndKey: mvcc.EncodeLockKey([]byte("k9"))}
+	inner := &snapshotInnerServer{MemInnerServer: inner_server.NewMemInnerServer(), db: db, region: region}
+	svr := tikv.NewServer(inner, exec.NewSeqScheduler(inner), exec.NewReadPool(inner, &config.DefaultConf.ReadPool))
+	defer svr.Stop()
+
+	// The range is clipped to the region, and the pairs visible at the version are cut into files of 2 pairs.
+	stream := new(exportSnapshotStream)
+	require.Nil(t, svr.ExportSnapshot(&kvrpcpb.ExportSnapshotRequest{
+		Context:  &kvrpcpb.Context{RegionId: 1},
+		EndKey:   []byte("k8"),
+		Version:  30,
+		FileSize: 8,
+	}, stream))
+	require.Len(t, stream.resps, 3)
+	var keys []string
+	for i, resp := range stream.resps {
+		assert.Empty(t, resp.Error)
+		assert.Nil(t, resp.Locked)
+		assert.Equal(t, uint32(i), resp.File)
+		assert.True(t, resp.FileEnd)
+		for _, pair := range resp.Pairs {
+			assert.Equal(t, []byte("v1"), pair.Value)
+			keys = append(keys, string(pair.Key))
+		}
+	}
+	assert.Equal(t, []string{"k1", "k2", "k4", "k5", "k6", "k7"}, keys)
+
+	// The export ends at a key which may be committed before the version.
+	stream = new(exportSnapshotStream)
+	require.Nil(t, svr.ExportSnapshot(&kvrpcpb.ExportSnapshotRequest{
+		Context:  &kvrpcpb.Context{RegionId: 1},
+		StartKey: []byte("k7"),
+		Version:  30,
+	}, stream))
+	require.Len(t, stream.resps, 1)
+	resp := stream.resps[0]
+	require.NotNil(t, resp.Locked)
+	assert.Equal(t, []byte("k8"), resp.Locked.Locked.Key)
+	require.Len(t, resp.Pairs, 1)
+	assert.Equal(t, []byte("k7"), resp.Pairs[0].Key)
+	assert.False(t, resp.FileEnd)
+}
+
+func TestExportSnapshotSkipLocked(t *testing.T) {
+	db, cleanUp := newTestDB(t)
+	defer cleanUp()
+
+	wb := new(engine_util.WriteBatch)
+	for _, key := range []string{"k1", "k2", "k3"} {
+		wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte(key), 10), mvcc.EncodeWriteCFValue(mvcc.WriteTypePut, 9, []byte("v1")))
+	}
+	// k2 is locked before the version.
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey([]byte("k2")), mvcc.EncodeLockCFValue(&mvcc.Lock{
+		Type: mvcc.LockTypePut, Primary: []byte("k2"), StartTS: 25, TTL: 100}))
+	require.Nil(t, wb.WriteToDB(db))
+
+	inner := &snapshotInnerServer{MemInnerServer: inner_server.NewMemInnerServer(), db: db, region: metapb.Region{Id: 1}}
+	svr := tikv.NewServer(inner, exec.NewSeqScheduler(inner), exec.NewReadPool(inner, &config.DefaultConf.ReadPool))
+	defer svr.Stop()
+
+	// The locked key is left out and reported instead of ending the export.
+	stream := new(exportSnapshotStream)
+	require.Nil(t, svr.ExportSnapshot(&kvrpcpb.ExportSnapshotRequest{
+		Context:    &kvrpcpb.Context{RegionId: 1},
+		Version:    30,
+		SkipLocked: true,
+	}, stream))
+	require.Len(t, stream.resps, 1)
+	resp := stream.resps[0]
+	assert.Nil(t, resp.Locked)
+	assert.True(t, resp.FileEnd)
+	require.Len(t, resp.SkippedLocks, 1)
+	assert.Equal(t, []byte("k2"), resp.SkippedLocks[0].Locked.Key)
+	var keys []string
+	for _, pair := range resp.Pairs {
+		keys = append(keys, string(pair.Key))
+	}
+	assert.Equal(t, []string{"k1", "k3"}, keys)
+}
@@ -0,0 +1,137 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/tikv"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/exec"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRegionApproximateSplitKeys(t *testing.T) {
+	db, cleanUp := newTestDB(t)
+	defer cleanUp()
+
+	wb := new(engine_util.WriteBatch)
+	value := make([]byte, 100)
+	for i := 0; i < 10; i++ {
+		key := []byte(fmt.Sprintf("k%d", i))
+		wb.SetCF(engine_util.CF_DEFAULT, mvcc.EncodeKey(key, 10), value)
+		wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey(key, 11), []byte("w"))
+	}
+	wb.SetCF(engine_util.CF_LOCK, mvcc.EncodeLockKey([]byte("k2")), []byte("lock"))
+	require.Nil(t, wb.WriteToDB(db))
+
+	region := metapb.Region{Id: 1, StartKey: mvcc.EncodeLockKey([]byte("k1")), EndKey: mvcc.EncodeLockKey([]byte("k9"))}
+	inner := &snapshotInnerServer{MemInnerServer: inner_server.NewMemInnerServer(), db: db, region: region}
+	svr := tikv.NewServer(inner, exec.NewSeqScheduler(inner), exec.NewReadPool(inner, &config.DefaultConf.ReadPool))
+	defer svr.Stop()
+	ctx := &kvrpcpb.Context{RegionId: 1}
+
+	// The 8 keys of the region are split into 4 parts of 2 keys, all the column families of a key count.
+	resp, err := svr.GetRegionApproximateSplitKeys(context.Background(),
+		&kvrpcpb.GetRegionApproximateSplitKeysRequest{Context: ctx, Count: 3})
+	require.Nil(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, [][]byte{
+		mvcc.EncodeLockKey([]byte("k3")),
+		mvcc.EncodeLockKey([]byte("k5")),
+		mvcc.EncodeLockKey([]byte("k7")),
+	}, resp.SplitKeys)
+	assert.True(t, resp.ApproximateSize > 800)
+
+	// A key is never split, nor is the start key of the region taken.
+	resp, err = svr.GetRegionApproximateSplitKeys(context.Background(),
+		&kvrpcpb.GetRegionApproximateSplitKeysRequest{Context: ctx, Count: 20})
+	require.Nil(t, err)
+	require.Len(t, resp.SplitKeys, 7)
+	assert.Equal(t, mvcc.EncodeLockKey([]byte("k2")), resp.SplitKeys[0])
+	assert.Equal(t, mvcc.EncodeLockKey([]byte("k8")), resp.SplitKeys[6])
+
+	resp, err = svr.GetRegionApproximateSplitKeys(context.Background(),
+		&kvrpcpb.GetRegionApproximateSplitKeysRequest{Context: ctx})
+	require.Nil(t, err)
+	assert.NotEmpty(t, resp.Error)
+}
+
+// TestGetRegionApproximateSplitKeysSampled tests that the split keys of a region with more keys than are sampled are
+// still about even.
+func TestGetRegionApproximateSplitKeysSampled(t *testing.T) {
+	db, cleanUp := newTestDB(t)
+	defer cleanUp()
+
+	wb := new(engine_util.WriteBatch)
+	value := make([]byte, 100)
+	for i := 0; i < 1000; i++ {
+		wb.SetCF(engine_util.CF_DEFAULT, mvcc.EncodeKey([]byte(fmt.Sprintf("k%04d", i)), 10), value)
+	}
+	require.Nil(t, wb.WriteToDB(db))
+
+	inner := &snapshotInnerServer{MemInnerServer: inner_server.NewMemInnerServer(), db: db, region: metapb.Region{Id: 1}}
+	svr := tikv.NewServer(inner, exec.NewSeqScheduler(inner), exec.NewReadPool(inner, &config.DefaultConf.ReadPool))
+	defer svr.Stop()
+
+	resp, err := svr.GetRegionApproximateSplitKeys(context.Background(),
+		&kvrpcpb.GetRegionApproximateSplitKeysRequest{Context: &kvrpcpb.Context{RegionId: 1}, Count: 1})
+	require.Nil(t, err)
+	assert.Empty(t, resp.Error)
+	require.Len(t, resp.SplitKeys, 1)
+	key, err := mvcc.DecodeLockKey(resp.SplitKeys[0])
+	require.Nil(t, err)
+	assert.True(t, string(key) >= "k0500" && string(key) < "k0563", string(key))
+}
+
+// splitInnerServer is a snapshotInnerServer which records the split keys.
+type splitInnerServer struct {
+	*snapshotInnerServer
+	splitKeys [][]byte
+}
+
+func (s *splitInnerServer) SplitRegion(ctx *kvrpcpb.Context, splitKeys [][]byte) ([]*metapb.Region, error) {
+	s.splitKeys = splitKeys
+	return []*metapb.Region{{Id: 1}, {Id: 2}}, nil
+}
+
+func TestSplitRegion(t *testing.T) {
+	db, cleanUp := newTestDB(t)
+	defer cleanUp()
+
+	wb := new(engine_util.WriteBatch)
+	for i := 0; i < 4; i++ {
+		wb.SetCF(engine_util.CF_WRITE, mvcc.EncodeKey([]byte(fmt.Sprintf("k%d", i)), 11), []byte("w"))
+	}
+	require.Nil(t, wb.WriteToDB(db))
+
+	inner := &splitInnerServer{snapshotInnerServer: &snapshotInnerServer{
+		MemInnerServer: inner_server.NewMemInnerServer(), db: db, region: metapb.Region{Id: 1}}}
+	svr := tikv.NewServer(inner, exec.NewSeqScheduler(inner), exec.NewReadPool(inner, &config.DefaultConf.ReadPool))
+	defer svr.Stop()
+	ctx := &kvrpcpb.Context{RegionId: 1}
+
+	// The user keys are encoded like the region boundaries.
+	resp, err := svr.SplitRegion(context.Background(), &kvrpcpb.SplitRegionRequest{
+		Context: ctx, SplitKeys: [][]byte{[]byte("a"), []byte("b")}})
+	require.Nil(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Len(t, resp.Regions, 2)
+	assert.Equal(t, [][]byte{mvcc.EncodeLockKey([]byte("a")), mvcc.EncodeLockKey([]byte("b"))}, inner.splitKeys)
+
+	// Without split keys, the region is split evenly.
+	resp, err = svr.SplitRegion(context.Background(), &kvrpcpb.SplitRegionRequest{Context: ctx, SplitCount: 1})
+	require.Nil(t, err)
+	assert.Empty(t, resp.Error)
+	assert.Equal(t, [][]byte{mvcc.EncodeLockKey([]byte("k2"))}, inner.splitKeys)
+
+	resp, err = svr.SplitRegion(context.Background(), &kvrpcpb.SplitRegionRequest{Context: ctx})
+	require.Nil(t, err)
+	assert.NotEmpty(t, resp.Error)
+}
@@ -19,16 +19,32 @@ const (
 	///
 	/// The deletion may and may not succeed.
 	TaskTypeRegionDestroy TaskType = 403
+	/// Destroy the data hidden by the range tombstones, then the tombstones.
+	TaskTypeRegionCleanUpTombstone TaskType = 404
 
 	TaskTypeResolveAddr TaskType = 501
 
 	TaskTypeSnapSend TaskType = 601
 	TaskTypeSnapRecv TaskType = 602
+	/// Save a snapshot received inline with its raft message.
+	TaskTypeSnapRecvInline TaskType = 603
+)
+
+// TaskPriority decides the order of the queued tasks of a worker, the tasks of higher priority are handled first
+// and the tasks of the same priority are handled in the order they are sent.
+type TaskPriority int
+
+const (
+	TaskPriorityNormal TaskPriority = 0
+	TaskPriorityHigh   TaskPriority = 1
+
+	numTaskPriorities = 2
 )
 
 type Task struct {
-	Tp   TaskType
-	Data interface{}
+	Tp       TaskType
+	Data     interface{}
+	Priority TaskPriority
 }
 
 type Worker struct {
@@ -54,16 +70,61 @@ func (w *Worker) Start(handler TaskHandler) {
 		if s, ok := handler.(Starter); ok {
 			s.start()
 		}
+		var queues taskQueues
 		for {
-			Task := <-w.receiver
-			if Task.Tp == TaskTypeStop {
+			if queues.empty() {
+				queues.push(<-w.receiver)
+			}
+			// Queue the tasks already sent, so a high priority task goes before the normal ones sent earlier. The
+			// queues are bounded like the channel, so the senders are still blocked by a busy worker.
+			for drained := false; !drained && queues.len() < defaultWorkerCapacity; {
+				select {
+				case t := <-w.receiver:
+					queues.push(t)
+				default:
+					drained = true
+				}
+			}
+			task := queues.pop()
+			if task.Tp == TaskTypeStop {
 				return
 			}
-			handler.Handle(Task)
+			handler.Handle(task)
 		}
 	}()
 }
 
+// taskQueues holds a FIFO queue of tasks for each priority.
+type taskQueues [numTaskPriorities][]Task
+
+func (q *taskQueues) push(t Task) {
+	q[t.Priority] = append(q[t.Priority], t)
+}
+
+// pop removes and returns the first task of the highest priority.
+func (q *taskQueues) pop() Task {
+	for p := numTaskPriorities - 1; p >= 0; p-- {
+		if len(q[p]) > 0 {
+			t := q[p][0]
+			q[p] = q[p][1:]
+			return t
+		}
+	}
+	panic("pop from empty task queues")
+}
+
+func (q *taskQueues) len() int {
+	n := 0
+	for p := range q {
+		n += len(q[p])
+	}
+	return n
+}
+
+func (q *taskQueues) empty() bool {
+	return q.len() == 0
+}
+
 func (w *Worker) Sender() chan<- Task {
 	return w.sender
 }
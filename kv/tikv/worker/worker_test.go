@@ -0,0 +1,44 @@
+package worker
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordHandler struct {
+	started chan struct{}
+	block   chan struct{}
+	handled chan TaskType
+}
+
+func (h *recordHandler) Handle(t Task) {
+	h.started <- struct{}{}
+	<-h.block
+	h.handled <- t.Tp
+}
+
+func TestWorkerPriority(t *testing.T) {
+	wg := new(sync.WaitGroup)
+	w := NewWorker("test", wg)
+	h := &recordHandler{started: make(chan struct{}, 8), block: make(chan struct{}), handled: make(chan TaskType, 8)}
+	w.Start(h)
+
+	// The first task blocks the worker until the others are sent.
+	w.Sender() <- Task{Tp: 1}
+	<-h.started
+	w.Sender() <- Task{Tp: 2}
+	w.Sender() <- Task{Tp: 3}
+	w.Sender() <- Task{Tp: 4, Priority: TaskPriorityHigh}
+	w.Sender() <- Task{Tp: 5}
+	close(h.block)
+
+	var handled []TaskType
+	for i := 0; i < 5; i++ {
+		handled = append(handled, <-h.handled)
+	}
+	w.Stop()
+	wg.Wait()
+	assert.Equal(t, []TaskType{1, 4, 2, 3, 5}, handled)
+}
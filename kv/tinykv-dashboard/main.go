@@ -0,0 +1,39 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+
+	"github.com/ngaut/log"
+	"github.com/pingcap-incubator/tinykv/kv/util/metrics"
+
+	// Create the metrics of the store.
+	_ "github.com/pingcap-incubator/tinykv/kv/tikv"
+	_ "github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
+	_ "github.com/pingcap-incubator/tinykv/kv/tikv/raftstore"
+)
+
+// tinykv-dashboard writes the Grafana dashboard of the metrics of a store, to be imported into Grafana with a
+// prometheus datasource scraping the /metrics of the stores.
+
+var (
+	outputPath = flag.String("output", "", "path to write the dashboard to, stdout if empty")
+	title      = flag.String("title", "TinyKV", "title of the dashboard")
+	uid        = flag.String("uid", "tinykv", "uid of the dashboard")
+)
+
+func main() {
+	flag.Parse()
+	data, err := metrics.GenerateDashboard(*title, *uid)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *outputPath == "" {
+		os.Stdout.Write(data)
+		return
+	}
+	if err := ioutil.WriteFile(*outputPath, data, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,268 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ngaut/log"
+	"github.com/pingcap-incubator/tinykv/kv/mvcc"
+	"github.com/pingcap-incubator/tinykv/kv/pd"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tikvpb"
+	"github.com/pingcap/errors"
+	"google.golang.org/grpc"
+)
+
+// tinykv-export writes the pairs of a key range visible at a timestamp into sorted CSV files, for offline analytics
+// to read directly or convert to other formats. The regions of the range are exported one by one, every file holds
+// the pairs of one region in ascending order, and the files are numbered in the order of their keys.
+//
+// Every row of a file is "hex(key),hex(value)". The keys locked by a transaction which may commit at or before the
+// timestamp have no known value, they're left out and logged, so the export doesn't stall on a lock nobody resolves.
+
+var (
+	pdAddr   = flag.String("pd", "", "pd address of the cluster")
+	startKey = flag.String("start", "", "hex of the first key to export")
+	endKey   = flag.String("end", "", "hex of the key the export ends before, empty for no upper bound")
+	version  = flag.Uint64("version", 0, "timestamp to export at, 0 gets one from pd")
+	outDir   = flag.String("dir", "", "directory to write the files to")
+	fileSize = flag.Uint64("file-size", 64*1024*1024, "size of the keys and values in a file")
+)
+
+const exportMaxRetry = 10
+
+func main() {
+	flag.Parse()
+	if *pdAddr == "" || *outDir == "" {
+		flag.Usage()
+		return
+	}
+	start, err := hex.DecodeString(*startKey)
+	if err != nil {
+		log.Fatal(err)
+	}
+	end, err := hex.DecodeString(*endKey)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.MkdirAll(*outDir, 0755); err != nil {
+		log.Fatal(err)
+	}
+	pdClient, err := pd.NewClient(strings.Split(*pdAddr, ","), "")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer pdClient.Close()
+
+	ctx := context.Background()
+	ts := *version
+	if ts == 0 {
+		if ts, err = pdClient.GetTS(ctx, 1); err != nil {
+			log.Fatal(err)
+		}
+	}
+	e := &exporter{pd: pdClient, version: ts, conns: make(map[string]*grpc.ClientConn)}
+	defer e.close()
+	if err := e.export(ctx, start, end); err != nil {
+		log.Fatal(err)
+	}
+	log.Infof("exported %d pairs into %d files at version %d, skipped %d locked keys", e.pairs, e.files, ts, e.skipped)
+}
+
+type exporter struct {
+	pd      pd.Client
+	version uint64
+	// store address -> connection.
+	conns map[string]*grpc.ClientConn
+	// The number of the files and pairs written, and of the locked keys left out.
+	files   int
+	pairs   int
+	skipped int
+}
+
+func (e *exporter) close() {
+	for _, conn := range e.conns {
+		conn.Close()
+	}
+}
+
+func (e *exporter) client(ctx context.Context, storeID uint64) (tikvpb.TikvClient, error) {
+	store, err := e.pd.GetStore(ctx, storeID)
+	if err != nil {
+		return nil, err
+	}
+	if conn, ok := e.conns[store.Address]; ok {
+		return tikvpb.NewTikvClient(conn), nil
+	}
+	conn, err := grpc.Dial(store.Address, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	e.conns[store.Address] = conn
+	return tikvpb.NewTikvClient(conn), nil
+}
+
+// export exports [start, end) region by region. The pairs visible at the version never change, so when the export
+// of a region fails, it's retried from the key after the last complete file.
+func (e *exporter) export(ctx context.Context, start, end []byte) error {
+	next := start
+	retries := 0
+	for len(end) == 0 || bytes.Compare(next, end) < 0 {
+		regionEnd, resumeKey, err := e.exportRegion(ctx, next, end)
+		if resumeKey != nil {
+			next = resumeKey
+		}
+		if err != nil {
+			if retries++; retries > exportMaxRetry {
+				return err
+			}
+			log.Warnf("export from key %q failed: %s, retrying", next, err)
+			time.Sleep(time.Second)
+			continue
+		}
+		retries = 0
+		if len(regionEnd) == 0 {
+			return nil
+		}
+		next = regionEnd
+	}
+	return nil
+}
+
+// exportRegion exports the part of [start, end) in the region containing start. It returns the user key the region
+// ends at, and the key to resume from if some files are complete before an error.
+func (e *exporter) exportRegion(ctx context.Context, start, end []byte) (regionEnd, resumeKey []byte, err error) {
+	region, leader, err := e.pd.GetRegion(ctx, mvcc.EncodeLockKey(start))
+	if err != nil {
+		return nil, nil, err
+	}
+	if region == nil || leader == nil {
+		return nil, nil, errors.Errorf("region or leader of key %q is not found", start)
+	}
+	if len(region.EndKey) > 0 {
+		if regionEnd, err = mvcc.DecodeLockKey(region.EndKey); err != nil {
+			return nil, nil, err
+		}
+	}
+	client, err := e.client(ctx, leader.StoreId)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := client.ExportSnapshot(ctx, &kvrpcpb.ExportSnapshotRequest{
+		Context: &kvrpcpb.Context{
+			RegionId:    region.Id,
+			RegionEpoch: region.RegionEpoch,
+			Peer:        leader,
+		},
+		StartKey:   start,
+		EndKey:     end,
+		Version:    e.version,
+		FileSize:   *fileSize,
+		SkipLocked: true,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var w *fileWriter
+	// The locked keys skipped since the last complete file, they're skipped again when the export is retried.
+	var skipped int
+	defer func() {
+		// An incomplete file is written again when the export is retried.
+		if w != nil {
+			w.abort()
+		}
+	}()
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			e.skipped += skipped
+			return regionEnd, nil, nil
+		}
+		if err != nil {
+			return nil, resumeKey, err
+		}
+		if resp.RegionError != nil {
+			return nil, resumeKey, errors.Errorf("export region %d failed: %s", region.Id, resp.RegionError)
+		}
+		if resp.Error != "" {
+			return nil, resumeKey, errors.Errorf("export region %d failed: %s", region.Id, resp.Error)
+		}
+		if len(resp.Pairs) > 0 && w == nil {
+			if w, err = e.newFileWriter(); err != nil {
+				return nil, resumeKey, err
+			}
+		}
+		for _, pair := range resp.Pairs {
+			if err := w.write(pair); err != nil {
+				return nil, resumeKey, err
+			}
+		}
+		for _, lock := range resp.SkippedLocks {
+			log.Warnf("skipped key %q locked by the transaction started at %d", lock.Locked.Key, lock.Locked.LockVersion)
+			skipped++
+		}
+		if resp.FileEnd && w != nil {
+			if err := w.finish(); err != nil {
+				return nil, resumeKey, err
+			}
+			e.files++
+			e.pairs += w.pairs
+			e.skipped += skipped
+			skipped = 0
+			resumeKey = append(append([]byte{}, w.lastKey...), 0)
+			w = nil
+		}
+	}
+}
+
+// fileWriter writes a file under a temporary name, it's renamed once the file is complete.
+type fileWriter struct {
+	path    string
+	f       *os.File
+	w       *csv.Writer
+	pairs   int
+	lastKey []byte
+}
+
+func (e *exporter) newFileWriter() (*fileWriter, error) {
+	path := filepath.Join(*outDir, fmt.Sprintf("part-%06d.csv", e.files))
+	f, err := os.Create(path + ".tmp")
+	if err != nil {
+		return nil, err
+	}
+	return &fileWriter{path: path, f: f, w: csv.NewWriter(f)}, nil
+}
+
+func (w *fileWriter) write(pair *kvrpcpb.KvPair) error {
+	w.pairs++
+	w.lastKey = pair.Key
+	return w.w.Write([]string{hex.EncodeToString(pair.Key), hex.EncodeToString(pair.Value)})
+}
+
+func (w *fileWriter) finish() error {
+	w.w.Flush()
+	if err := w.w.Error(); err != nil {
+		return err
+	}
+	if err := w.f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(w.path+".tmp", w.path)
+}
+
+func (w *fileWriter) abort() {
+	w.f.Close()
+	os.Remove(w.path + ".tmp")
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ngaut/log"
+	"github.com/pingcap-incubator/tinykv/kv/pd"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/errorpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tikvpb"
+	"github.com/pingcap/errors"
+	"google.golang.org/grpc"
+)
+
+// tinykv-migrate copies a region of one cluster into another cluster. The data is exported from the leader of the
+// region at a consistent applied index, and imported into whatever regions of the target cluster cover its keys.
+
+var (
+	srcPDAddr = flag.String("src-pd", "", "pd address of the source cluster")
+	dstPDAddr = flag.String("dst-pd", "", "pd address of the target cluster")
+	regionID  = flag.Uint64("region", 0, "id of the region to migrate in the source cluster")
+)
+
+const importMaxRetry = 10
+
+func main() {
+	flag.Parse()
+	if *srcPDAddr == "" || *dstPDAddr == "" || *regionID == 0 {
+		flag.Usage()
+		return
+	}
+	srcPD, err := pd.NewClient(strings.Split(*srcPDAddr, ","), "")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer srcPD.Close()
+	dstPD, err := pd.NewClient(strings.Split(*dstPDAddr, ","), "")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer dstPD.Close()
+
+	m := &migrator{srcPD: srcPD, dstPD: dstPD, conns: make(map[string]*grpc.ClientConn)}
+	defer m.close()
+	if err := m.migrate(context.Background(), *regionID); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type migrator struct {
+	srcPD pd.Client
+	dstPD pd.Client
+	// store address -> connection.
+	conns map[string]*grpc.ClientConn
+	// The region of the target cluster the last batch was imported into.
+	dstRegion *metapb.Region
+	dstLeader *metapb.Peer
+}
+
+func (m *migrator) close() {
+	for _, conn := range m.conns {
+		conn.Close()
+	}
+}
+
+func (m *migrator) client(ctx context.Context, pdClient pd.Client, storeID uint64) (tikvpb.TikvClient, error) {
+	store, err := pdClient.GetStore(ctx, storeID)
+	if err != nil {
+		return nil, err
+	}
+	if conn, ok := m.conns[store.Address]; ok {
+		return tikvpb.NewTikvClient(conn), nil
+	}
+	conn, err := grpc.Dial(store.Address, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	m.conns[store.Address] = conn
+	return tikvpb.NewTikvClient(conn), nil
+}
+
+func (m *migrator) migrate(ctx context.Context, regionID uint64) error {
+	region, leader, err := m.srcPD.GetRegionByID(ctx, regionID)
+	if err != nil {
+		return err
+	}
+	if region == nil || leader == nil {
+		return errors.Errorf("region %d or its leader is not found", regionID)
+	}
+	client, err := m.client(ctx, m.srcPD, leader.StoreId)
+	if err != nil {
+		return err
+	}
+	stream, err := client.ExportRegion(ctx, &kvrpcpb.ExportRegionRequest{Context: &kvrpcpb.Context{
+		RegionId:    region.Id,
+		RegionEpoch: region.RegionEpoch,
+		Peer:        leader,
+	}})
+	if err != nil {
+		return err
+	}
+	var count int
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if resp.RegionError != nil {
+			return errors.Errorf("export region %d failed: %s", regionID, resp.RegionError)
+		}
+		if resp.Error != "" {
+			return errors.Errorf("export region %d failed: %s", regionID, resp.Error)
+		}
+		if resp.Region != nil {
+			log.Infof("exporting region %d at applied index %d", regionID, resp.AppliedIndex)
+		}
+		if err := m.importPairs(ctx, resp.Pairs); err != nil {
+			return err
+		}
+		count += len(resp.Pairs)
+	}
+	log.Infof("migrated region %d, %d pairs", regionID, count)
+	return nil
+}
+
+// importPairs imports the pairs into the target cluster, the pairs of a batch are sent to the same region as long as
+// their keys are inside it.
+func (m *migrator) importPairs(ctx context.Context, pairs []*kvrpcpb.ExportedPair) error {
+	retries := 0
+	for len(pairs) > 0 {
+		if err := m.locate(ctx, pairs[0].Key); err != nil {
+			return err
+		}
+		n := 1
+		for n < len(pairs) && regionContains(m.dstRegion, pairs[n].Key) {
+			n++
+		}
+		regionErr, err := m.importBatch(ctx, pairs[:n])
+		if err != nil {
+			return err
+		}
+		if regionErr != nil {
+			// The region of the target cluster has changed, locate the keys again.
+			if retries++; retries > importMaxRetry {
+				return errors.Errorf("import region %d failed: %s", m.dstRegion.Id, regionErr)
+			}
+			log.Warnf("import region %d failed: %s, retrying", m.dstRegion.Id, regionErr)
+			m.dstRegion = nil
+			time.Sleep(time.Second)
+			continue
+		}
+		retries = 0
+		pairs = pairs[n:]
+	}
+	return nil
+}
+
+func (m *migrator) locate(ctx context.Context, key []byte) error {
+	if m.dstRegion != nil && regionContains(m.dstRegion, key) {
+		return nil
+	}
+	region, leader, err := m.dstPD.GetRegion(ctx, key)
+	if err != nil {
+		return err
+	}
+	if region == nil || leader == nil {
+		return errors.Errorf("region or leader of key %q is not found in the target cluster", key)
+	}
+	m.dstRegion, m.dstLeader = region, leader
+	return nil
+}
+
+func (m *migrator) importBatch(ctx context.Context, pairs []*kvrpcpb.ExportedPair) (*errorpb.Error, error) {
+	client, err := m.client(ctx, m.dstPD, m.dstLeader.StoreId)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.ImportRegion(ctx, &kvrpcpb.ImportRegionRequest{
+		Context: &kvrpcpb.Context{
+			RegionId:    m.dstRegion.Id,
+			RegionEpoch: m.dstRegion.RegionEpoch,
+			Peer:        m.dstLeader,
+		},
+		Pairs: pairs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != "" {
+		return nil, errors.Errorf("import region %d failed: %s", m.dstRegion.Id, resp.Error)
+	}
+	return resp.RegionError, nil
+}
+
+func regionContains(region *metapb.Region, key []byte) bool {
+	return bytes.Compare(key, region.StartKey) >= 0 && (len(region.EndKey) == 0 || bytes.Compare(key, region.EndKey) < 0)
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"flag"
+	"os"
+	"strings"
+
+	"github.com/ngaut/log"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tikvpb"
+	"github.com/pingcap/errors"
+	"google.golang.org/grpc"
+)
+
+// tinykv-seed loads the initial data of an empty cluster directly into the kv engines of all its stores, which must
+// be started in the seed mode and not opened for traffic yet. Once loaded, the checksums of the stores are compared
+// to verify they hold the same data.
+//
+// Every line of the input is a pair in the form of "cf\thex(key)\thex(value)".
+
+var (
+	storeAddrs = flag.String("stores", "", "addresses of all the stores of the cluster")
+	inputPath  = flag.String("input", "", "path of the pairs to load")
+	batchSize  = flag.Int("batch-size", 4096, "number of pairs written in one request")
+)
+
+func main() {
+	flag.Parse()
+	if *storeAddrs == "" || *inputPath == "" {
+		flag.Usage()
+		return
+	}
+	var clients []tikvpb.TikvClient
+	for _, addr := range strings.Split(*storeAddrs, ",") {
+		conn, err := grpc.Dial(addr, grpc.WithInsecure())
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer conn.Close()
+		clients = append(clients, tikvpb.NewTikvClient(conn))
+	}
+	ctx := context.Background()
+	if err := load(ctx, clients, *inputPath); err != nil {
+		log.Fatal(err)
+	}
+	if err := verify(ctx, clients); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func load(ctx context.Context, clients []tikvpb.TikvClient, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 64*1024*1024)
+	var pairs []*kvrpcpb.ExportedPair
+	var count int
+	for line := 1; scanner.Scan(); line++ {
+		pair, err := parsePair(scanner.Text())
+		if err != nil {
+			return errors.Annotatef(err, "line %d", line)
+		}
+		pairs = append(pairs, pair)
+		if len(pairs) >= *batchSize {
+			if err := write(ctx, clients, pairs); err != nil {
+				return err
+			}
+			count += len(pairs)
+			pairs = pairs[:0]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if err := write(ctx, clients, pairs); err != nil {
+		return err
+	}
+	log.Infof("loaded %d pairs into %d stores", count+len(pairs), len(clients))
+	return nil
+}
+
+func parsePair(line string) (*kvrpcpb.ExportedPair, error) {
+	fields := strings.Split(line, "\t")
+	if len(fields) != 3 {
+		return nil, errors.Errorf("expect 3 fields, got %d", len(fields))
+	}
+	key, err := hex.DecodeString(fields[1])
+	if err != nil {
+		return nil, err
+	}
+	value, err := hex.DecodeString(fields[2])
+	if err != nil {
+		return nil, err
+	}
+	return &kvrpcpb.ExportedPair{Cf: fields[0], Key: key, Value: value}, nil
+}
+
+func write(ctx context.Context, clients []tikvpb.TikvClient, pairs []*kvrpcpb.ExportedPair) error {
+	if len(pairs) == 0 {
+		return nil
+	}
+	for _, client := range clients {
+		resp, err := client.SeedWrite(ctx, &kvrpcpb.SeedWriteRequest{Pairs: pairs})
+		if err != nil {
+			return err
+		}
+		if resp.Error != "" {
+			return errors.New(resp.Error)
+		}
+	}
+	return nil
+}
+
+// verify checks all the stores hold the same data.
+func verify(ctx context.Context, clients []tikvpb.TikvClient) error {
+	var first *kvrpcpb.SeedChecksumResponse
+	for i, client := range clients {
+		resp, err := client.SeedChecksum(ctx, &kvrpcpb.SeedChecksumRequest{})
+		if err != nil {
+			return err
+		}
+		if resp.Error != "" {
+			return errors.New(resp.Error)
+		}
+		if first == nil {
+			first = resp
+			continue
+		}
+		if resp.Checksum != first.Checksum || resp.TotalKvs != first.TotalKvs || resp.TotalBytes != first.TotalBytes {
+			return errors.Errorf("store %d has checksum %x of %d pairs, store 0 has %x of %d pairs",
+				i, resp.Checksum, resp.TotalKvs, first.Checksum, first.TotalKvs)
+		}
+	}
+	log.Infof("verified %d stores, checksum %x, %d pairs, %d bytes", len(clients), first.Checksum, first.TotalKvs, first.TotalBytes)
+	return nil
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/storage/exec"
 	"net"
@@ -14,14 +15,19 @@ import (
 	"time"
 
 	"github.com/BurntSushi/toml"
-	"github.com/coocood/badger"
-	"github.com/coocood/badger/y"
 	"github.com/ngaut/log"
 	"github.com/pingcap-incubator/tinykv/kv/config"
 	"github.com/pingcap-incubator/tinykv/kv/pd"
 	"github.com/pingcap-incubator/tinykv/kv/tikv"
 	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
+	"github.com/pingcap-incubator/tinykv/kv/util/clusterid"
+	_ "github.com/pingcap-incubator/tinykv/kv/util/compress"
+	"github.com/pingcap-incubator/tinykv/kv/util/memory"
+	"github.com/pingcap-incubator/tinykv/kv/util/metrics"
+	"github.com/pingcap-incubator/tinykv/kv/util/status"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/tikvpb"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
 )
@@ -50,12 +56,19 @@ func main() {
 	if *storeAddr != "" {
 		conf.Server.StoreAddr = *storeAddr
 	}
+	if flag.Arg(0) == "self-check" {
+		os.Exit(selfCheck(conf))
+	}
+	if err := conf.Validate(); err != nil {
+		log.Fatal(err)
+	}
 	runtime.GOMAXPROCS(conf.Server.MaxProcs)
 	log.Info("gitHash:", gitHash)
 	log.SetLevelByString(conf.Server.LogLevel)
 	log.SetFlags(log.Ldate | log.Ltime | log.Lmicroseconds | log.Lshortfile)
 	log.Infof("conf %v", conf)
 	config.SetGlobalConf(conf)
+	memory.StoreBudget.SetLimit(conf.Server.MemoryBudget)
 
 	pdClient, err := pd.NewClient(strings.Split(conf.Server.PDAddr, ","), "")
 	if err != nil {
@@ -68,19 +81,46 @@ func main() {
 	} else {
 		innerServer = setupStandAloneInnerServer(pdClient, conf)
 	}
-	scheduler := exec.NewSeqScheduler(innerServer)
-	tikvServer := tikv.NewServer(innerServer, scheduler)
+	scheduler := exec.NewLatchedScheduler(innerServer, conf.Scheduler.Concurrency)
+	readPool := exec.NewReadPool(innerServer, &conf.ReadPool)
+	tikvServer := tikv.NewServer(innerServer, scheduler, readPool)
+
+	clusterChecker := &clusterid.Checker{
+		ClusterID: pdClient.GetClusterID(context.TODO()),
+		Strict:    conf.Server.StrictClusterCheck,
+	}
+	if s, ok := innerServer.(interface{ GetStoreMeta() *metapb.Store }); ok {
+		clusterChecker.StoreID = s.GetStoreMeta().Id
+	}
+	gcWorker := tikv.NewGCWorker(tikvServer, pdClient, clusterChecker.StoreID, &conf.GC)
+	gcWorker.Start()
 
 	var alivePolicy = keepalive.EnforcementPolicy{
 		MinTime:             2 * time.Second, // If a client pings more than once every 2 seconds, terminate the connection
 		PermitWithoutStream: true,            // Allow pings even when there are no active streams
 	}
 
+	interceptors := []grpc.UnaryServerInterceptor{
+		clusterChecker.UnaryServerInterceptor(),
+		tikv.RequestMetricsInterceptor(conf.Server.ExemplarSampleRate),
+	}
+	if conf.Server.ShadowAddr != "" {
+		conn, err := grpc.Dial(conf.Server.ShadowAddr, grpc.WithInsecure())
+		if err != nil {
+			log.Fatal(err)
+		}
+		target := tikv.NewStoreShadowTarget(tikvpb.NewTikvClient(conn))
+		interceptors = append(interceptors, tikv.ShadowInterceptor(target, conf.Server.ShadowSampleRate))
+		log.Infof("shadowing %v of the reads to %s", conf.Server.ShadowSampleRate, conf.Server.ShadowAddr)
+	}
+
 	grpcServer := grpc.NewServer(
 		grpc.KeepaliveEnforcementPolicy(alivePolicy),
 		grpc.InitialWindowSize(grpcInitialWindowSize),
 		grpc.InitialConnWindowSize(grpcInitialConnWindowSize),
 		grpc.MaxRecvMsgSize(10*1024*1024),
+		grpc.UnaryInterceptor(chainUnaryInterceptors(interceptors...)),
+		grpc.StreamInterceptor(clusterChecker.StreamServerInterceptor()),
 	)
 	tikvpb.RegisterTikvServer(grpcServer, tikvServer)
 	listenAddr := conf.Server.StoreAddr[strings.IndexByte(conf.Server.StoreAddr, ':'):]
@@ -91,8 +131,18 @@ func main() {
 	handleSignal(grpcServer)
 	go func() {
 		log.Infof("listening on %v", conf.Server.StatusAddr)
-		http.HandleFunc("/status", func(writer http.ResponseWriter, request *http.Request) {
-			writer.WriteHeader(http.StatusOK)
+		http.Handle("/metrics", promhttp.Handler())
+		http.Handle("/exemplars", metrics.ExemplarsHandler())
+		status.RegisterHandlers(http.DefaultServeMux, func() *status.StoreStatus {
+			return &status.StoreStatus{
+				StoreAddr: conf.Server.StoreAddr,
+				GitHash:   gitHash,
+				Raft:      conf.Server.Raft,
+				Memory: status.MemoryStatus{
+					Limit: memory.StoreBudget.Limit(),
+					Used:  memory.StoreBudget.Total(),
+				},
+			}
 		})
 		err := http.ListenAndServe(conf.Server.StatusAddr, nil)
 		if err != nil {
@@ -103,6 +153,7 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	gcWorker.Stop()
 	err = tikvServer.Stop()
 	if err != nil {
 		log.Fatal(err)
@@ -118,7 +169,6 @@ func loadConfig() *config.Config {
 			panic(err)
 		}
 	}
-	y.Assert(len(conf.Engine.Compression) >= badger.DefaultOptions.TableBuilderOptions.MaxLevels)
 	return &conf
 }
 
@@ -140,6 +190,19 @@ func setupStandAloneInnerServer(pdClient pd.Client, conf *config.Config) tikv.In
 	return innerServer
 }
 
+// chainUnaryInterceptors runs the interceptors in order, each calls the next one as its handler.
+func chainUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		for i := len(interceptors) - 1; i > 0; i-- {
+			interceptor, next := interceptors[i], handler
+			handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return interceptors[0](ctx, req, info, handler)
+	}
+}
+
 func handleSignal(grpcServer *grpc.Server) {
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh,
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/coocood/badger"
+	"github.com/pingcap-incubator/tinykv/kv/config"
+	"github.com/pingcap-incubator/tinykv/kv/engine_util"
+	"github.com/pingcap-incubator/tinykv/kv/pd"
+	"github.com/pingcap-incubator/tinykv/kv/tikv/inner_server"
+	"github.com/pingcap/errors"
+	"github.com/shirou/gopsutil/disk"
+)
+
+// selfCheckKeyPrefix is the keyspace of the kv engine the self check writes to. The keys of the column families all
+// start with the name of their column family and an underscore, so no reader of the store ever sees these keys.
+const selfCheckKeyPrefix = "self-check_"
+
+const selfCheckPDTimeout = 10 * time.Second
+
+// selfCheck checks the store could start with the configuration, without starting it: the configuration is valid,
+// the data directory is writable with enough free space, the engines open and serve a write, a read and a delete,
+// and the scheduler is reachable. The engines are locked while the store runs, so it's run before starting the store.
+// It prints the result of every check, and returns the exit code, 1 if any check fails.
+func selfCheck(conf *config.Config) int {
+	checks := []struct {
+		name  string
+		check func(*config.Config) error
+	}{
+		{"config", checkConfig},
+		{"disk", checkDisk},
+		{"engines", checkEngines},
+		{"scheduler", checkScheduler},
+	}
+	code := 0
+	for _, c := range checks {
+		start := time.Now()
+		if err := c.check(conf); err != nil {
+			fmt.Printf("%-10s FAIL %v\n", c.name, err)
+			code = 1
+			if c.name == "config" {
+				// The other checks rely on the configuration.
+				break
+			}
+			continue
+		}
+		fmt.Printf("%-10s ok   %v\n", c.name, time.Since(start))
+	}
+	return code
+}
+
+func checkConfig(conf *config.Config) error {
+	if err := conf.Validate(); err != nil {
+		return err
+	}
+	if conf.Server.Raft {
+		return inner_server.NewRaftStoreConfig(conf).Validate()
+	}
+	return nil
+}
+
+// checkDisk creates the data directory, writes a file into it, and compares the free space with the reserve.
+func checkDisk(conf *config.Config) error {
+	dir := conf.Engine.DBPath
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(dir, "self-check")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err = f.Write([]byte("self-check")); err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	stat, err := disk.Usage(dir)
+	if err != nil {
+		return err
+	}
+	if reserve := uint64(conf.RaftStore.ReserveSpace); conf.Server.Raft && reserve > 0 && stat.Free < reserve {
+		return errors.Errorf("%d bytes free in %s, less than the reserve of %d bytes", stat.Free, dir, reserve)
+	}
+	return nil
+}
+
+// checkEngines opens the engines of the store, and writes, reads and deletes a key of the self check keyspace in the
+// kv engine.
+func checkEngines(conf *config.Config) error {
+	subPaths := []string{"kv"}
+	if conf.Server.Raft {
+		subPaths = append(subPaths, "raft")
+	}
+	for _, subPath := range subPaths {
+		if err := os.MkdirAll(filepath.Join(conf.Engine.DBPath, subPath), os.ModePerm); err != nil {
+			return err
+		}
+		db, err := engine_util.OpenDB(subPath, &conf.Engine)
+		if err != nil {
+			return errors.Annotatef(err, "open %s engine", subPath)
+		}
+		if subPath == "kv" {
+			err = writeReadDelete(db)
+		}
+		if closeErr := db.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return errors.Annotatef(err, "%s engine", subPath)
+		}
+	}
+	return nil
+}
+
+func writeReadDelete(db *badger.DB) error {
+	key := []byte(fmt.Sprintf("%s%d", selfCheckKeyPrefix, time.Now().UnixNano()))
+	val := []byte("self-check")
+	if err := db.Update(func(txn *badger.Txn) error { return txn.Set(key, val) }); err != nil {
+		return errors.Annotate(err, "write")
+	}
+	err := db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(key)
+		if err != nil {
+			return err
+		}
+		got, err := item.Value()
+		if err == nil && !bytes.Equal(got, val) {
+			err = errors.Errorf("read %q, wrote %q", got, val)
+		}
+		return err
+	})
+	if err != nil {
+		return errors.Annotate(err, "read")
+	}
+	if err = db.Update(func(txn *badger.Txn) error { return txn.Delete(key) }); err != nil {
+		return errors.Annotate(err, "delete")
+	}
+	err = db.View(func(txn *badger.Txn) error {
+		_, err := txn.Get(key)
+		return err
+	})
+	if err != badger.ErrKeyNotFound {
+		return errors.Errorf("read after delete: %v", err)
+	}
+	return nil
+}
+
+// checkScheduler connects to the scheduler and asks whether the cluster is bootstrapped.
+func checkScheduler(conf *config.Config) error {
+	pdClient, err := pd.NewClient(strings.Split(conf.Server.PDAddr, ","), "self-check")
+	if err != nil {
+		return err
+	}
+	defer pdClient.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), selfCheckPDTimeout)
+	defer cancel()
+	if _, err = pdClient.IsBootstrapped(ctx); err != nil {
+		return errors.Annotatef(err, "cluster %d", pdClient.GetClusterID(ctx))
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+// Package clusterid checks that the requests to a store come from the clients and stores of its own cluster, and are
+// meant for this store. The IDs are carried in the gRPC metadata, so every RPC is checked the same way, including the
+// raft and snapshot streams between the stores.
+package clusterid
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// ClusterIDKey is the metadata key of the ID of the cluster the sender belongs to.
+	ClusterIDKey = "tinykv-cluster-id"
+	// StoreIDKey is the metadata key of the ID of the store the request is meant for, it's optional.
+	StoreIDKey = "tinykv-store-id"
+)
+
+// NewOutgoingContext returns a context which attaches the cluster ID, and the target store ID if it's not 0, to the
+// requests sent with it.
+func NewOutgoingContext(ctx context.Context, clusterID, storeID uint64) context.Context {
+	kv := []string{ClusterIDKey, strconv.FormatUint(clusterID, 10)}
+	if storeID != 0 {
+		kv = append(kv, StoreIDKey, strconv.FormatUint(storeID, 10))
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
+
+// DialOptions attach the cluster ID to all the requests sent over the connection, unless their contexts are made by
+// NewOutgoingContext already.
+func DialOptions(clusterID uint64) []grpc.DialOption {
+	withClusterID := func(ctx context.Context) context.Context {
+		if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get(ClusterIDKey)) > 0 {
+			return ctx
+		}
+		return NewOutgoingContext(ctx, clusterID, 0)
+	}
+	return []grpc.DialOption{
+		grpc.WithUnaryInterceptor(func(ctx context.Context, method string, req, reply interface{},
+			cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			return invoker(withClusterID(ctx), method, req, reply, cc, opts...)
+		}),
+		grpc.WithStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn,
+			method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return streamer(withClusterID(ctx), desc, cc, method, opts...)
+		}),
+	}
+}
+
+// Checker rejects the requests whose metadata names another cluster or another store.
+type Checker struct {
+	// The ID of the cluster of this store, 0 skips the check.
+	ClusterID uint64
+	// The ID of this store, 0 skips the check.
+	StoreID uint64
+	// Reject the requests which don't carry the cluster ID, otherwise only a mismatched ID is rejected.
+	Strict bool
+}
+
+// Check checks the metadata of an incoming request.
+func (c *Checker) Check(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	clusterID, ok, err := getID(md, ClusterIDKey)
+	if err != nil {
+		return err
+	}
+	if !ok && c.Strict {
+		return status.Errorf(codes.FailedPrecondition, "missing cluster ID, expect cluster %d", c.ClusterID)
+	}
+	if ok && c.ClusterID != 0 && clusterID != c.ClusterID {
+		return status.Errorf(codes.FailedPrecondition, "cluster ID mismatch, request for cluster %d, store is in cluster %d",
+			clusterID, c.ClusterID)
+	}
+	storeID, ok, err := getID(md, StoreIDKey)
+	if err != nil {
+		return err
+	}
+	if ok && c.StoreID != 0 && storeID != c.StoreID {
+		return status.Errorf(codes.FailedPrecondition, "store ID mismatch, request for store %d, this is store %d",
+			storeID, c.StoreID)
+	}
+	return nil
+}
+
+func getID(md metadata.MD, key string) (uint64, bool, error) {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return 0, false, nil
+	}
+	id, err := strconv.ParseUint(values[0], 10, 64)
+	if err != nil {
+		return 0, false, status.Errorf(codes.InvalidArgument, "invalid %s %q", key, values[0])
+	}
+	return id, true, nil
+}
+
+// UnaryServerInterceptor checks the unary requests before they are handled.
+func (c *Checker) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := c.Check(ctx); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor checks the streams when they are opened.
+func (c *Checker) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := c.Check(ss.Context()); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,41 @@
+package clusterid
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// incoming returns the context the server sees for a request sent with NewOutgoingContext.
+func incoming(clusterID, storeID uint64) context.Context {
+	md, _ := metadata.FromOutgoingContext(NewOutgoingContext(context.Background(), clusterID, storeID))
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestCheck(t *testing.T) {
+	c := &Checker{ClusterID: 1, StoreID: 2}
+	assert.Nil(t, c.Check(incoming(1, 0)))
+	assert.Nil(t, c.Check(incoming(1, 2)))
+	assert.Nil(t, c.Check(context.Background()))
+
+	err := c.Check(incoming(3, 2))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	err = c.Check(incoming(1, 4))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	bad := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ClusterIDKey, "x"))
+	assert.Equal(t, codes.InvalidArgument, status.Code(c.Check(bad)))
+
+	// A strict checker rejects the requests without a cluster ID.
+	c.Strict = true
+	assert.Equal(t, codes.FailedPrecondition, status.Code(c.Check(context.Background())))
+	assert.Nil(t, c.Check(incoming(1, 0)))
+
+	// The store ID is unknown to a standalone store.
+	c = &Checker{ClusterID: 1}
+	assert.Nil(t, c.Check(incoming(1, 9)))
+}
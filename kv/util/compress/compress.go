@@ -0,0 +1,81 @@
+// Package compress registers the compressors of the gRPC server, importing it registers gzip and snappy. gRPC
+// compresses a response with the compressor the client compressed the request with, so a client which expects a large
+// response of compressible data, like a wide scan, a large batch get or a coprocessor request, asks for it compressed
+// by calling with grpc.UseCompressor. The compressor of the response is chosen before the request is handled, so the
+// client, which knows the size of the request, decides which requests are worth the CPU.
+package compress
+
+import (
+	"io"
+	"io/ioutil"
+	"sync"
+
+	"github.com/golang/snappy"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+const (
+	// Gzip is the name of the gzip compressor, it compresses better than snappy.
+	Gzip = gzip.Name
+	// Snappy is the name of the snappy compressor, it's faster than gzip.
+	Snappy = "snappy"
+)
+
+func init() {
+	c := new(snappyCompressor)
+	c.writers.New = func() interface{} {
+		return &snappyWriter{Writer: snappy.NewBufferedWriter(ioutil.Discard), pool: &c.writers}
+	}
+	c.readers.New = func() interface{} {
+		return &snappyReader{Reader: snappy.NewReader(nil), pool: &c.readers}
+	}
+	encoding.RegisterCompressor(c)
+}
+
+// snappyCompressor compresses in the snappy framing format, the writers and readers are pooled like the ones of
+// gzip, they are reused across the messages.
+type snappyCompressor struct {
+	writers sync.Pool
+	readers sync.Pool
+}
+
+func (c *snappyCompressor) Name() string {
+	return Snappy
+}
+
+func (c *snappyCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	sw := c.writers.Get().(*snappyWriter)
+	sw.Reset(w)
+	return sw, nil
+}
+
+func (c *snappyCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	sr := c.readers.Get().(*snappyReader)
+	sr.Reset(r)
+	return sr, nil
+}
+
+type snappyWriter struct {
+	*snappy.Writer
+	pool *sync.Pool
+}
+
+func (w *snappyWriter) Close() error {
+	defer w.pool.Put(w)
+	return w.Writer.Close()
+}
+
+type snappyReader struct {
+	*snappy.Reader
+	pool *sync.Pool
+}
+
+// Read returns the reader to the pool once the message is read to the end.
+func (r *snappyReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if err == io.EOF {
+		r.pool.Put(r)
+	}
+	return n, err
+}
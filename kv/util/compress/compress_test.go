@@ -0,0 +1,73 @@
+package compress
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net"
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/tikvpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+)
+
+func TestCompressor(t *testing.T) {
+	data := bytes.Repeat([]byte("compressible "), 1000)
+	for _, name := range []string{Gzip, Snappy} {
+		c := encoding.GetCompressor(name)
+		require.NotNil(t, c, name)
+		// The pooled writers and readers are reused by the second round.
+		for i := 0; i < 2; i++ {
+			var buf bytes.Buffer
+			w, err := c.Compress(&buf)
+			require.Nil(t, err)
+			_, err = w.Write(data)
+			require.Nil(t, err)
+			require.Nil(t, w.Close())
+			assert.True(t, buf.Len() < len(data)/10, name)
+
+			r, err := c.Decompress(&buf)
+			require.Nil(t, err)
+			decompressed, err := ioutil.ReadAll(r)
+			require.Nil(t, err)
+			assert.Equal(t, data, decompressed, name)
+		}
+	}
+}
+
+type scanServer struct {
+	tikvpb.TikvServer
+	pairs []*kvrpcpb.KvPair
+}
+
+func (s *scanServer) RawScan(ctx context.Context, req *kvrpcpb.RawScanRequest) (*kvrpcpb.RawScanResponse, error) {
+	return &kvrpcpb.RawScanResponse{Kvs: s.pairs}, nil
+}
+
+func TestCompressedResponse(t *testing.T) {
+	var pairs []*kvrpcpb.KvPair
+	for i := 0; i < 1000; i++ {
+		pairs = append(pairs, &kvrpcpb.KvPair{Key: []byte{byte(i >> 8), byte(i)}, Value: bytes.Repeat([]byte("v"), 100)})
+	}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	grpcServer := grpc.NewServer()
+	tikvpb.RegisterTikvServer(grpcServer, &scanServer{pairs: pairs})
+	go grpcServer.Serve(l)
+	defer grpcServer.Stop()
+	cc, err := grpc.Dial(l.Addr().String(), grpc.WithInsecure())
+	require.Nil(t, err)
+	defer cc.Close()
+
+	// The responses of the requests compressed by either compressor, or not compressed, are the same.
+	client := tikvpb.NewTikvClient(cc)
+	for _, opts := range [][]grpc.CallOption{nil, {grpc.UseCompressor(Gzip)}, {grpc.UseCompressor(Snappy)}} {
+		resp, err := client.RawScan(context.Background(), &kvrpcpb.RawScanRequest{}, opts...)
+		require.Nil(t, err)
+		assert.Equal(t, pairs, resp.Kvs)
+	}
+}
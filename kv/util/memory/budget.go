@@ -0,0 +1,100 @@
+// Package memory accounts the memory held by the major consumers of a store against a configurable budget, so that
+// the store can shed load before the process is killed for running out of memory.
+package memory
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// Consumer identifies a part of the store that holds a significant amount of memory.
+type Consumer int
+
+const (
+	RaftEntryCache Consumer = iota
+	PendingProposals
+	ScanBuffer
+	SnapshotBuffer
+	numConsumers
+)
+
+func (c Consumer) String() string {
+	switch c {
+	case RaftEntryCache:
+		return "raft-entry-cache"
+	case PendingProposals:
+		return "pending-proposals"
+	case ScanBuffer:
+		return "scan-buffer"
+	case SnapshotBuffer:
+		return "snapshot-buffer"
+	}
+	return fmt.Sprintf("consumer-%d", int(c))
+}
+
+// Budget tracks the bytes held by each consumer against a limit. Memory which has already been accepted, like raft
+// entries, is charged with Consume and may push the usage over the limit; memory that can be refused, like the
+// buffer of a scan, is charged with TryConsume so the caller can reject the work instead.
+//
+// A Budget is safe for concurrent use. A limit of 0 means unlimited.
+type Budget struct {
+	limit int64
+	used  [numConsumers]int64
+}
+
+// StoreBudget is the budget shared by all the consumers of the store running in this process.
+var StoreBudget = NewBudget(0)
+
+func NewBudget(limit int64) *Budget {
+	return &Budget{limit: limit}
+}
+
+func (b *Budget) SetLimit(limit int64) {
+	atomic.StoreInt64(&b.limit, limit)
+}
+
+func (b *Budget) Limit() int64 {
+	return atomic.LoadInt64(&b.limit)
+}
+
+// Consume charges n bytes to the consumer regardless of the limit.
+func (b *Budget) Consume(c Consumer, n int64) {
+	atomic.AddInt64(&b.used[c], n)
+}
+
+// TryConsume charges n bytes to the consumer if it doesn't take the total usage over the limit. It returns false
+// and charges nothing otherwise.
+func (b *Budget) TryConsume(c Consumer, n int64) bool {
+	limit := b.Limit()
+	if limit > 0 && b.Total()+n > limit {
+		return false
+	}
+	b.Consume(c, n)
+	return true
+}
+
+// Release returns n bytes previously charged to the consumer.
+func (b *Budget) Release(c Consumer, n int64) {
+	atomic.AddInt64(&b.used[c], -n)
+}
+
+// Used returns the bytes currently charged to the consumer.
+func (b *Budget) Used(c Consumer) int64 {
+	return atomic.LoadInt64(&b.used[c])
+}
+
+// Total returns the bytes currently charged to all consumers.
+func (b *Budget) Total() int64 {
+	var total int64
+	for c := Consumer(0); c < numConsumers; c++ {
+		total += b.Used(c)
+	}
+	return total
+}
+
+// Exceeded reports whether the total usage has reached the limit. Work that can be delayed or refused, like
+// generating snapshots or large scans, should be shed while it holds.
+func (b *Budget) Exceeded() bool {
+	limit := b.Limit()
+	return limit > 0 && b.Total() >= limit
+}
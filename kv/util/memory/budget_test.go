@@ -0,0 +1,31 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBudget(t *testing.T) {
+	b := NewBudget(100)
+	assert.True(t, b.TryConsume(ScanBuffer, 60))
+	assert.False(t, b.TryConsume(ScanBuffer, 50))
+	assert.Equal(t, int64(60), b.Used(ScanBuffer))
+	assert.False(t, b.Exceeded())
+
+	// Consume charges over the limit.
+	b.Consume(RaftEntryCache, 50)
+	assert.Equal(t, int64(110), b.Total())
+	assert.True(t, b.Exceeded())
+	assert.False(t, b.TryConsume(SnapshotBuffer, 1))
+
+	b.Release(ScanBuffer, 60)
+	assert.False(t, b.Exceeded())
+	assert.True(t, b.TryConsume(SnapshotBuffer, 50))
+	assert.Equal(t, int64(100), b.Total())
+
+	// A limit of 0 means unlimited.
+	b.SetLimit(0)
+	assert.False(t, b.Exceeded())
+	assert.True(t, b.TryConsume(ScanBuffer, 1<<40))
+}
@@ -0,0 +1,157 @@
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// The rate window of the counters and histograms charted by the dashboard.
+const rateInterval = "1m"
+
+// The datasource input of the dashboard, Grafana asks for the prometheus datasource to use when it's imported.
+const datasource = "${DS_PROMETHEUS}"
+
+type dashboard struct {
+	Inputs        []dashboardInput `json:"__inputs"`
+	Title         string           `json:"title"`
+	UID           string           `json:"uid"`
+	SchemaVersion int              `json:"schemaVersion"`
+	Editable      bool             `json:"editable"`
+	Refresh       string           `json:"refresh"`
+	Time          timeRange        `json:"time"`
+	Panels        []panel          `json:"panels"`
+}
+
+type dashboardInput struct {
+	Name     string `json:"name"`
+	Label    string `json:"label"`
+	Type     string `json:"type"`
+	PluginID string `json:"pluginId"`
+}
+
+type timeRange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type gridPos struct {
+	H int `json:"h"`
+	W int `json:"w"`
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+type panel struct {
+	ID          int      `json:"id"`
+	Type        string   `json:"type"`
+	Title       string   `json:"title"`
+	Description string   `json:"description,omitempty"`
+	GridPos     gridPos  `json:"gridPos"`
+	Datasource  string   `json:"datasource,omitempty"`
+	Targets     []target `json:"targets,omitempty"`
+	Yaxes       []yaxis  `json:"yaxes,omitempty"`
+	DataFormat  string   `json:"dataFormat,omitempty"`
+}
+
+type target struct {
+	Expr         string `json:"expr"`
+	LegendFormat string `json:"legendFormat"`
+	Format       string `json:"format"`
+	RefID        string `json:"refId"`
+}
+
+type yaxis struct {
+	Format string `json:"format"`
+	Show   bool   `json:"show"`
+}
+
+// GenerateDashboard returns the JSON of a Grafana dashboard charting all the metrics created so far, a row per
+// subsystem and a panel per metric, charted as the Panel of its Desc hints.
+func GenerateDashboard(title, uid string) ([]byte, error) {
+	d := &dashboard{
+		Inputs: []dashboardInput{{
+			Name:     "DS_PROMETHEUS",
+			Label:    "Prometheus",
+			Type:     "datasource",
+			PluginID: "prometheus",
+		}},
+		Title:         title,
+		UID:           uid,
+		SchemaVersion: 16,
+		Editable:      true,
+		Refresh:       "30s",
+		Time:          timeRange{From: "now-1h", To: "now"},
+	}
+	const panelWidth, panelHeight = 12, 8
+	var y, x int
+	subsystem := ""
+	for i, desc := range Descs() {
+		if i == 0 || desc.Subsystem != subsystem {
+			subsystem = desc.Subsystem
+			if x > 0 {
+				y += panelHeight
+				x = 0
+			}
+			d.Panels = append(d.Panels, panel{
+				ID:      len(d.Panels) + 1,
+				Type:    "row",
+				Title:   subsystem,
+				GridPos: gridPos{H: 1, W: 2 * panelWidth, X: 0, Y: y},
+			})
+			y++
+		}
+		p := newPanel(&desc)
+		p.ID = len(d.Panels) + 1
+		p.GridPos = gridPos{H: panelHeight, W: panelWidth, X: x, Y: y}
+		d.Panels = append(d.Panels, p)
+		if x += panelWidth; x >= 2*panelWidth {
+			x = 0
+			y += panelHeight
+		}
+	}
+	return json.MarshalIndent(d, "", "  ")
+}
+
+func newPanel(desc *Desc) panel {
+	p := panel{
+		Type:        "graph",
+		Title:       strings.Replace(desc.Name, "_", " ", -1),
+		Description: desc.Help,
+		Datasource:  datasource,
+		Yaxes:       []yaxis{{Format: string(desc.Unit), Show: true}, {Format: string(UnitNone), Show: false}},
+	}
+	if p.Yaxes[0].Format == "" {
+		p.Yaxes[0].Format = string(UnitNone)
+	}
+	name := desc.FullName()
+	by := strings.Join(append([]string{"instance"}, desc.Labels...), ", ")
+	legend := "{{instance}}"
+	for _, label := range desc.Labels {
+		legend += fmt.Sprintf(" {{%s}}", label)
+	}
+	switch {
+	case desc.Type == TypeCounter:
+		p.Targets = []target{newTarget(fmt.Sprintf("sum(rate(%s[%s])) by (%s)", name, rateInterval, by), legend, "A")}
+	case desc.Type == TypeGauge:
+		p.Targets = []target{newTarget(fmt.Sprintf("sum(%s) by (%s)", name, by), legend, "A")}
+	case desc.Panel == PanelHeatmap:
+		p.Type = "heatmap"
+		p.Yaxes = nil
+		p.DataFormat = "tsbuckets"
+		t := newTarget(fmt.Sprintf("sum(rate(%s_bucket[%s])) by (le)", name, rateInterval), "{{le}}", "A")
+		t.Format = "heatmap"
+		p.Targets = []target{t}
+	default:
+		for i, percentile := range []int{99, 95, 50} {
+			expr := fmt.Sprintf("histogram_quantile(%g, sum(rate(%s_bucket[%s])) by (le, %s))",
+				float64(percentile)/100, name, rateInterval, by)
+			p.Targets = append(p.Targets, newTarget(expr, fmt.Sprintf("%s p%d", legend, percentile), string(rune('A'+i))))
+		}
+	}
+	return p
+}
+
+func newTarget(expr, legend, refID string) target {
+	return target{Expr: expr, LegendFormat: legend, Format: "time_series", RefID: refID}
+}
@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateDashboard(t *testing.T) {
+	NewCounterVec(Desc{Subsystem: "test_a", Name: "requests_total", Help: "Number of requests.", Labels: []string{"type"}}, "type")
+	NewGaugeVec(Desc{Subsystem: "test_a", Name: "memory_used", Help: "Bytes of memory used.", Unit: UnitBytes})
+	NewHistogramVec(Desc{Subsystem: "test_b", Name: "request_duration_seconds", Help: "Duration of requests.",
+		Unit: UnitSeconds, Panel: PanelHeatmap}, []float64{0.1, 1})
+
+	data, err := GenerateDashboard("TinyKV", "tinykv")
+	require.Nil(t, err)
+	var d dashboard
+	require.Nil(t, json.Unmarshal(data, &d))
+	assert.Equal(t, "TinyKV", d.Title)
+
+	// A row per subsystem, the panels of a subsystem are laid out two per line below its row.
+	require.Len(t, d.Panels, 5)
+	assert.Equal(t, "row", d.Panels[0].Type)
+	assert.Equal(t, "test_a", d.Panels[0].Title)
+	assert.Equal(t, gridPos{H: 8, W: 12, X: 0, Y: 1}, d.Panels[1].GridPos)
+	assert.Equal(t, gridPos{H: 8, W: 12, X: 12, Y: 1}, d.Panels[2].GridPos)
+	assert.Equal(t, "row", d.Panels[3].Type)
+	assert.Equal(t, 9, d.Panels[3].GridPos.Y)
+	for i, p := range d.Panels {
+		assert.Equal(t, i+1, p.ID)
+	}
+
+	gauge := d.Panels[1]
+	assert.Equal(t, "graph", gauge.Type)
+	assert.Equal(t, "Bytes of memory used.", gauge.Description)
+	assert.Equal(t, "bytes", gauge.Yaxes[0].Format)
+	assert.Equal(t, "sum(tikv_test_a_memory_used) by (instance)", gauge.Targets[0].Expr)
+
+	counter := d.Panels[2]
+	assert.Equal(t, "short", counter.Yaxes[0].Format)
+	assert.Equal(t, "sum(rate(tikv_test_a_requests_total[1m])) by (instance, type)", counter.Targets[0].Expr)
+	assert.Equal(t, "{{instance}} {{type}}", counter.Targets[0].LegendFormat)
+
+	histogram := d.Panels[4]
+	assert.Equal(t, "heatmap", histogram.Type)
+	assert.Equal(t, "sum(rate(tikv_test_b_request_duration_seconds_bucket[1m])) by (le)", histogram.Targets[0].Expr)
+	assert.Equal(t, "heatmap", histogram.Targets[0].Format)
+}
@@ -0,0 +1,149 @@
+package metrics
+
+import (
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Exemplar links an observation of a histogram to the trace of the request it came from.
+type Exemplar struct {
+	TraceID string    `json:"trace_id"`
+	Value   float64   `json:"value"`
+	Time    time.Time `json:"time"`
+}
+
+// BucketExemplar is the latest exemplar of a bucket, the bucket holds the observations up to UpperBound, formatted
+// like the le label of the bucket.
+type BucketExemplar struct {
+	UpperBound string `json:"le"`
+	Exemplar
+}
+
+// SeriesExemplars are the exemplars of the buckets of a series of a histogram.
+type SeriesExemplars struct {
+	Labels  map[string]string `json:"labels"`
+	Buckets []BucketExemplar  `json:"buckets"`
+}
+
+// ExemplarHistogramVec is a histogram which keeps the latest exemplar of every bucket of every series, taken from a
+// sampled fraction of the observations with a trace ID. The exemplars are served by ExemplarsHandler, so that a
+// slow bucket of the heatmap leads to the trace of a request which fell into it.
+type ExemplarHistogramVec struct {
+	*prometheus.HistogramVec
+	name       string
+	labelNames []string
+	buckets    []float64
+
+	mu         sync.Mutex
+	sampleRate float64
+	// label values joined by "\x00" -> exemplar of each bucket, the last one is the +Inf bucket.
+	exemplars map[string][]*Exemplar
+}
+
+var exemplarHistograms struct {
+	sync.Mutex
+	vecs []*ExemplarHistogramVec
+}
+
+// NewExemplarHistogramVec creates and registers a histogram described by desc with the buckets, partitioned by
+// labelNames. It keeps no exemplars until SetSampleRate.
+func NewExemplarHistogramVec(desc Desc, buckets []float64, labelNames ...string) *ExemplarHistogramVec {
+	h := &ExemplarHistogramVec{
+		HistogramVec: NewHistogramVec(desc, buckets, labelNames...),
+		name:         desc.FullName(),
+		labelNames:   labelNames,
+		buckets:      buckets,
+		exemplars:    make(map[string][]*Exemplar),
+	}
+	exemplarHistograms.Lock()
+	defer exemplarHistograms.Unlock()
+	exemplarHistograms.vecs = append(exemplarHistograms.vecs, h)
+	return h
+}
+
+// SetSampleRate sets the fraction of the observations with a trace ID which are kept as exemplars.
+func (h *ExemplarHistogramVec) SetSampleRate(rate float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.sampleRate = rate
+}
+
+// ObserveWithTrace observes value in the series of labelValues, and keeps it as the exemplar of its bucket if it's
+// sampled.
+func (h *ExemplarHistogramVec) ObserveWithTrace(value float64, traceID string, labelValues ...string) {
+	h.WithLabelValues(labelValues...).Observe(value)
+	if traceID == "" {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.sampleRate <= 0 || rand.Float64() >= h.sampleRate {
+		return
+	}
+	key := strings.Join(labelValues, "\x00")
+	exemplars, ok := h.exemplars[key]
+	if !ok {
+		exemplars = make([]*Exemplar, len(h.buckets)+1)
+		h.exemplars[key] = exemplars
+	}
+	exemplars[sort.SearchFloat64s(h.buckets, value)] = &Exemplar{TraceID: traceID, Value: value, Time: time.Now()}
+}
+
+// Exemplars returns the exemplars of all the series, ordered by labels.
+func (h *ExemplarHistogramVec) Exemplars() []SeriesExemplars {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	keys := make([]string, 0, len(h.exemplars))
+	for key := range h.exemplars {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	series := make([]SeriesExemplars, 0, len(keys))
+	for _, key := range keys {
+		s := SeriesExemplars{Labels: make(map[string]string)}
+		if len(h.labelNames) > 0 {
+			for i, value := range strings.Split(key, "\x00") {
+				s.Labels[h.labelNames[i]] = value
+			}
+		}
+		for i, exemplar := range h.exemplars[key] {
+			if exemplar == nil {
+				continue
+			}
+			upperBound := "+Inf"
+			if i < len(h.buckets) {
+				upperBound = strconv.FormatFloat(h.buckets[i], 'g', -1, 64)
+			}
+			s.Buckets = append(s.Buckets, BucketExemplar{UpperBound: upperBound, Exemplar: *exemplar})
+		}
+		series = append(series, s)
+	}
+	return series
+}
+
+// ExemplarsHandler serves the exemplars of all the exemplar histograms as JSON, keyed by the metric names.
+func ExemplarsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		exemplarHistograms.Lock()
+		all := make(map[string][]SeriesExemplars, len(exemplarHistograms.vecs))
+		for _, h := range exemplarHistograms.vecs {
+			all[h.name] = h.Exemplars()
+		}
+		exemplarHistograms.Unlock()
+		data, err := json.Marshal(all)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	})
+}
@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExemplarHistogram(t *testing.T) {
+	h := NewExemplarHistogramVec(Desc{Subsystem: "test_exemplar", Name: "duration_seconds", Help: "Duration."},
+		[]float64{0.1, 1}, "type")
+
+	// Nothing is kept before the sample rate is set, or without a trace ID.
+	h.ObserveWithTrace(0.05, "t0", "get")
+	h.SetSampleRate(1)
+	h.ObserveWithTrace(0.05, "", "get")
+	assert.Empty(t, h.Exemplars())
+
+	h.ObserveWithTrace(0.05, "t1", "get")
+	h.ObserveWithTrace(0.5, "t2", "get")
+	h.ObserveWithTrace(0.6, "t3", "get")
+	h.ObserveWithTrace(5, "t4", "scan")
+	series := h.Exemplars()
+	require.Len(t, series, 2)
+	assert.Equal(t, map[string]string{"type": "get"}, series[0].Labels)
+	// The latest exemplar of a bucket wins.
+	require.Len(t, series[0].Buckets, 2)
+	assert.Equal(t, "0.1", series[0].Buckets[0].UpperBound)
+	assert.Equal(t, "t1", series[0].Buckets[0].TraceID)
+	assert.Equal(t, "1", series[0].Buckets[1].UpperBound)
+	assert.Equal(t, "t3", series[0].Buckets[1].TraceID)
+	require.Len(t, series[1].Buckets, 1)
+	assert.Equal(t, "+Inf", series[1].Buckets[0].UpperBound)
+	assert.Equal(t, 5.0, series[1].Buckets[0].Value)
+
+	w := httptest.NewRecorder()
+	ExemplarsHandler().ServeHTTP(w, httptest.NewRequest("GET", "/exemplars", nil))
+	var all map[string][]SeriesExemplars
+	require.Nil(t, json.Unmarshal(w.Body.Bytes(), &all))
+	assert.Len(t, all["tikv_test_exemplar_duration_seconds"], 2)
+}
@@ -0,0 +1,130 @@
+// Package metrics creates the prometheus metrics of a store under a single namespace, and records what a dashboard
+// needs to know to chart them, so that the Grafana dashboard of the store is generated from the metrics themselves
+// instead of being maintained by hand.
+package metrics
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Namespace is the namespace of all the metrics of a store.
+const Namespace = "tikv"
+
+// Type is the prometheus type of a metric.
+type Type int
+
+const (
+	TypeCounter Type = iota
+	TypeGauge
+	TypeHistogram
+)
+
+// Unit is the unit of the values of a metric, it's the unit format of the Grafana panel.
+type Unit string
+
+const (
+	UnitNone    Unit = "short"
+	UnitBytes   Unit = "bytes"
+	UnitSeconds Unit = "s"
+)
+
+// Panel hints how a metric is best charted.
+type Panel int
+
+const (
+	// PanelGraph charts the values of a gauge, or the rate of a counter, over time.
+	PanelGraph Panel = iota
+	// PanelQuantiles charts the 99th, 95th and 50th percentiles of a histogram.
+	PanelQuantiles
+	// PanelHeatmap charts the distribution of a histogram over time.
+	PanelHeatmap
+)
+
+// Desc describes a metric for the dashboard.
+type Desc struct {
+	Type      Type
+	Subsystem string
+	Name      string
+	Help      string
+	Unit      Unit
+	Panel     Panel
+	// The labels the panel breaks the series down by, the other labels are summed up.
+	Labels []string
+}
+
+// FullName returns the name of the metric exposed to prometheus.
+func (d *Desc) FullName() string {
+	return prometheus.BuildFQName(Namespace, d.Subsystem, d.Name)
+}
+
+var registry struct {
+	sync.Mutex
+	descs []*Desc
+}
+
+func register(desc *Desc, collector prometheus.Collector) {
+	prometheus.MustRegister(collector)
+	registry.Lock()
+	defer registry.Unlock()
+	registry.descs = append(registry.descs, desc)
+}
+
+// Descs returns the descriptions of all the metrics created so far, ordered by subsystem and name.
+func Descs() []Desc {
+	registry.Lock()
+	defer registry.Unlock()
+	descs := make([]Desc, 0, len(registry.descs))
+	for _, desc := range registry.descs {
+		descs = append(descs, *desc)
+	}
+	sort.Slice(descs, func(i, j int) bool {
+		if descs[i].Subsystem != descs[j].Subsystem {
+			return descs[i].Subsystem < descs[j].Subsystem
+		}
+		return descs[i].Name < descs[j].Name
+	})
+	return descs
+}
+
+// NewCounterVec creates and registers a counter described by desc, partitioned by labelNames.
+func NewCounterVec(desc Desc, labelNames ...string) *prometheus.CounterVec {
+	desc.Type = TypeCounter
+	vec := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: desc.Subsystem,
+		Name:      desc.Name,
+		Help:      desc.Help,
+	}, labelNames)
+	register(&desc, vec)
+	return vec
+}
+
+// NewGaugeVec creates and registers a gauge described by desc, partitioned by labelNames.
+func NewGaugeVec(desc Desc, labelNames ...string) *prometheus.GaugeVec {
+	desc.Type = TypeGauge
+	vec := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: desc.Subsystem,
+		Name:      desc.Name,
+		Help:      desc.Help,
+	}, labelNames)
+	register(&desc, vec)
+	return vec
+}
+
+// NewHistogramVec creates and registers a histogram described by desc with the buckets, partitioned by labelNames.
+func NewHistogramVec(desc Desc, buckets []float64, labelNames ...string) *prometheus.HistogramVec {
+	desc.Type = TypeHistogram
+	vec := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: desc.Subsystem,
+		Name:      desc.Name,
+		Help:      desc.Help,
+		Buckets:   buckets,
+	}, labelNames)
+	register(&desc, vec)
+	return vec
+}
@@ -0,0 +1,116 @@
+// Package resource accounts the resources used by the requests of a store to the resource groups named in their
+// contexts, and holds the weights the groups are scheduled by. The groups are defined in the scheduler and sent to
+// the stores in the store heartbeat responses.
+package resource
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/kv/util/metrics"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+)
+
+// DefaultGroup is the group of the requests which name no group, or a group the store doesn't know.
+const DefaultGroup = "default"
+
+// defaultWeight is the weight of DefaultGroup unless the scheduler defines it.
+const defaultWeight = 1
+
+// The resources worth a request unit.
+const (
+	readBytesPerRU  = 64 * 1024
+	writeBytesPerRU = 1024
+	cpuPerRU        = 3 * time.Millisecond
+)
+
+var (
+	groupRUCounter = metrics.NewCounterVec(metrics.Desc{
+		Subsystem: "resource_group",
+		Name:      "ru_total",
+		Help:      "Request units used by the requests of the resource group.",
+		Labels:    []string{"group"},
+	}, "group")
+
+	groupReadBytesCounter = metrics.NewCounterVec(metrics.Desc{
+		Subsystem: "resource_group",
+		Name:      "read_bytes_total",
+		Help:      "Bytes read by the requests of the resource group.",
+		Unit:      metrics.UnitBytes,
+		Labels:    []string{"group"},
+	}, "group")
+
+	groupWriteBytesCounter = metrics.NewCounterVec(metrics.Desc{
+		Subsystem: "resource_group",
+		Name:      "write_bytes_total",
+		Help:      "Bytes written by the requests of the resource group.",
+		Unit:      metrics.UnitBytes,
+		Labels:    []string{"group"},
+	}, "group")
+
+	groupCPUCounter = metrics.NewCounterVec(metrics.Desc{
+		Subsystem: "resource_group",
+		Name:      "cpu_seconds_total",
+		Help:      "Estimated CPU time used by the requests of the resource group.",
+		Unit:      metrics.UnitSeconds,
+		Labels:    []string{"group"},
+	}, "group")
+)
+
+// Usage is the resources used by a request.
+type Usage struct {
+	ReadBytes  int64
+	WriteBytes int64
+	// The time the request took to execute, which estimates its CPU time.
+	CPU time.Duration
+}
+
+// RU returns the request units of the usage.
+func (u Usage) RU() float64 {
+	return float64(u.ReadBytes)/readBytesPerRU + float64(u.WriteBytes)/writeBytesPerRU + float64(u.CPU)/float64(cpuPerRU)
+}
+
+// Groups holds the weights of the resource groups. It's safe for concurrent use.
+type Groups struct {
+	mu      sync.RWMutex
+	weights map[string]uint64
+}
+
+// StoreGroups are the groups of the store running in this process.
+var StoreGroups = NewGroups()
+
+func NewGroups() *Groups {
+	return &Groups{weights: make(map[string]uint64)}
+}
+
+// Update replaces the groups.
+func (g *Groups) Update(groups []*pdpb.ResourceGroup) {
+	weights := make(map[string]uint64, len(groups))
+	for _, group := range groups {
+		weights[group.Name] = group.Weight
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.weights = weights
+}
+
+// Resolve returns the group a request naming name is accounted to, and the weight of the group.
+func (g *Groups) Resolve(name string) (string, uint64) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if weight, ok := g.weights[name]; ok && weight > 0 {
+		return name, weight
+	}
+	if weight, ok := g.weights[DefaultGroup]; ok && weight > 0 {
+		return DefaultGroup, weight
+	}
+	return DefaultGroup, defaultWeight
+}
+
+// Charge accounts the usage to the resolved group.
+func Charge(group string, usage Usage) {
+	groupRUCounter.WithLabelValues(group).Add(usage.RU())
+	groupReadBytesCounter.WithLabelValues(group).Add(float64(usage.ReadBytes))
+	groupWriteBytesCounter.WithLabelValues(group).Add(float64(usage.WriteBytes))
+	groupCPUCounter.WithLabelValues(group).Add(usage.CPU.Seconds())
+}
@@ -0,0 +1,38 @@
+package resource
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroups(t *testing.T) {
+	groups := NewGroups()
+	name, weight := groups.Resolve("a")
+	assert.Equal(t, DefaultGroup, name)
+	assert.Equal(t, uint64(1), weight)
+
+	groups.Update([]*pdpb.ResourceGroup{{Name: "a", Weight: 4}, {Name: DefaultGroup, Weight: 2}})
+	name, weight = groups.Resolve("a")
+	assert.Equal(t, "a", name)
+	assert.Equal(t, uint64(4), weight)
+	// Unknown groups fall back to the default group.
+	name, weight = groups.Resolve("b")
+	assert.Equal(t, DefaultGroup, name)
+	assert.Equal(t, uint64(2), weight)
+	name, _ = groups.Resolve("")
+	assert.Equal(t, DefaultGroup, name)
+
+	// The groups are replaced.
+	groups.Update(nil)
+	name, _ = groups.Resolve("a")
+	assert.Equal(t, DefaultGroup, name)
+}
+
+func TestUsageRU(t *testing.T) {
+	assert.Equal(t, 1.0, Usage{ReadBytes: 64 * 1024}.RU())
+	assert.Equal(t, 2.0, Usage{WriteBytes: 2048}.RU())
+	assert.Equal(t, 3.0, Usage{ReadBytes: 64 * 1024, CPU: 6 * time.Millisecond}.RU())
+}
@@ -0,0 +1,82 @@
+package status
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/ngaut/log"
+)
+
+const (
+	StatusURL = "/status"
+	SchemaURL = "/schema"
+)
+
+// SchemaVersion is the version of the JSON served at StatusURL. Within a version, fields are only ever added, renaming
+// or removing a field, or changing its type, bumps the version, so dashboards and scripts can rely on it.
+const SchemaVersion = 1
+
+// StoreStatus is the JSON served at StatusURL, described by Schema.
+type StoreStatus struct {
+	SchemaVersion int    `json:"schema_version"`
+	StoreAddr     string `json:"store_addr"`
+	GitHash       string `json:"git_hash"`
+	Raft          bool   `json:"raft"`
+	// Seconds since the store started.
+	UptimeSeconds int64        `json:"uptime_seconds"`
+	Memory        MemoryStatus `json:"memory"`
+}
+
+// MemoryStatus is the usage of the store memory budget, in bytes.
+type MemoryStatus struct {
+	// 0 if there is no limit.
+	Limit int64 `json:"limit"`
+	Used  int64 `json:"used"`
+}
+
+// Schema is the JSON schema of StoreStatus, served at SchemaURL.
+const Schema = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "$id": "tinykv/store-status/v1",
+  "type": "object",
+  "properties": {
+    "schema_version": {"type": "integer", "const": 1},
+    "store_addr": {"type": "string"},
+    "git_hash": {"type": "string"},
+    "raft": {"type": "boolean"},
+    "uptime_seconds": {"type": "integer"},
+    "memory": {
+      "type": "object",
+      "properties": {
+        "limit": {"type": "integer"},
+        "used": {"type": "integer"}
+      },
+      "required": ["limit", "used"]
+    }
+  },
+  "required": ["schema_version", "store_addr", "git_hash", "raft", "uptime_seconds", "memory"]
+}`
+
+// RegisterHandlers registers the handlers of StatusURL and SchemaURL to mux, getStatus is called for every request
+// of the status. The SchemaVersion of the status is filled in by the handler.
+func RegisterHandlers(mux *http.ServeMux, getStatus func() *StoreStatus) {
+	start := time.Now()
+	mux.HandleFunc(StatusURL, func(w http.ResponseWriter, r *http.Request) {
+		status := getStatus()
+		status.SchemaVersion = SchemaVersion
+		status.UptimeSeconds = int64(time.Since(start) / time.Second)
+		data, err := json.Marshal(status)
+		if err != nil {
+			log.Errorf("marshal status failed: %v", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	})
+	mux.HandleFunc(SchemaURL, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/schema+json")
+		w.Write([]byte(Schema))
+	})
+}
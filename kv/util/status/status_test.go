@@ -0,0 +1,78 @@
+package status
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type schemaObject struct {
+	ID         string                  `json:"$id"`
+	Properties map[string]schemaObject `json:"properties"`
+	Required   []string                `json:"required"`
+}
+
+// checkFields checks the fields of the JSON object are exactly the required properties of schema, recursively.
+func checkFields(t *testing.T, schema schemaObject, fields map[string]interface{}) {
+	var keys []string
+	for key, value := range fields {
+		keys = append(keys, key)
+		property, ok := schema.Properties[key]
+		require.True(t, ok, "field %s is not in the schema", key)
+		if object, ok := value.(map[string]interface{}); ok {
+			checkFields(t, property, object)
+		}
+	}
+	sort.Strings(keys)
+	required := append([]string(nil), schema.Required...)
+	sort.Strings(required)
+	assert.Equal(t, required, keys)
+}
+
+// TestSchemaCompatibility guards the fields of the current schema version, a change failing it needs a new version.
+func TestSchemaCompatibility(t *testing.T) {
+	var schema schemaObject
+	require.Nil(t, json.Unmarshal([]byte(Schema), &schema))
+	assert.Equal(t, "tinykv/store-status/v1", schema.ID)
+	assert.Equal(t, 1, SchemaVersion)
+
+	data, err := json.Marshal(&StoreStatus{})
+	require.Nil(t, err)
+	assert.Equal(t, `{"schema_version":0,"store_addr":"","git_hash":"","raft":false,"uptime_seconds":0,`+
+		`"memory":{"limit":0,"used":0}}`, string(data))
+	var fields map[string]interface{}
+	require.Nil(t, json.Unmarshal(data, &fields))
+	checkFields(t, schema, fields)
+}
+
+func TestHandlers(t *testing.T) {
+	mux := http.NewServeMux()
+	RegisterHandlers(mux, func() *StoreStatus {
+		return &StoreStatus{StoreAddr: "127.0.0.1:9191", Raft: true, Memory: MemoryStatus{Limit: 100, Used: 10}}
+	})
+	svr := httptest.NewServer(mux)
+	defer svr.Close()
+
+	resp, err := http.Get(svr.URL + StatusURL)
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	var status StoreStatus
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&status))
+	assert.Equal(t, SchemaVersion, status.SchemaVersion)
+	assert.Equal(t, "127.0.0.1:9191", status.StoreAddr)
+	assert.Equal(t, MemoryStatus{Limit: 100, Used: 10}, status.Memory)
+
+	resp, err = http.Get(svr.URL + SchemaURL)
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	require.Nil(t, err)
+	assert.Equal(t, Schema, string(data))
+}
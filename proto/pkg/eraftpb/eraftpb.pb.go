@@ -42,7 +42,7 @@ func (x EntryType) String() string {
 	return proto.EnumName(EntryType_name, int32(x))
 }
 func (EntryType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_eraftpb_4260401482694966, []int{0}
+	return fileDescriptor_eraftpb_a8f84c9f2455793b, []int{0}
 }
 
 type MessageType int32
@@ -62,6 +62,8 @@ const (
 	MessageType_MsgSnapStatus          MessageType = 11
 	MessageType_MsgTransferLeader      MessageType = 12
 	MessageType_MsgTimeoutNow          MessageType = 13
+	MessageType_MsgReadIndex           MessageType = 14
+	MessageType_MsgReadIndexResponse   MessageType = 15
 )
 
 var MessageType_name = map[int32]string{
@@ -79,6 +81,8 @@ var MessageType_name = map[int32]string{
 	11: "MsgSnapStatus",
 	12: "MsgTransferLeader",
 	13: "MsgTimeoutNow",
+	14: "MsgReadIndex",
+	15: "MsgReadIndexResponse",
 }
 var MessageType_value = map[string]int32{
 	"MsgHup":                 0,
@@ -95,13 +99,15 @@ var MessageType_value = map[string]int32{
 	"MsgSnapStatus":          11,
 	"MsgTransferLeader":      12,
 	"MsgTimeoutNow":          13,
+	"MsgReadIndex":           14,
+	"MsgReadIndexResponse":   15,
 }
 
 func (x MessageType) String() string {
 	return proto.EnumName(MessageType_name, int32(x))
 }
 func (MessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_eraftpb_4260401482694966, []int{1}
+	return fileDescriptor_eraftpb_a8f84c9f2455793b, []int{1}
 }
 
 type ConfChangeType int32
@@ -124,7 +130,7 @@ func (x ConfChangeType) String() string {
 	return proto.EnumName(ConfChangeType_name, int32(x))
 }
 func (ConfChangeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_eraftpb_4260401482694966, []int{2}
+	return fileDescriptor_eraftpb_a8f84c9f2455793b, []int{2}
 }
 
 // The entry is a type of change that needs to be applied. It contains two data fields.
@@ -152,7 +158,7 @@ func (m *Entry) Reset()         { *m = Entry{} }
 func (m *Entry) String() string { return proto.CompactTextString(m) }
 func (*Entry) ProtoMessage()    {}
 func (*Entry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eraftpb_4260401482694966, []int{0}
+	return fileDescriptor_eraftpb_a8f84c9f2455793b, []int{0}
 }
 func (m *Entry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,7 +235,7 @@ func (m *SnapshotMetadata) Reset()         { *m = SnapshotMetadata{} }
 func (m *SnapshotMetadata) String() string { return proto.CompactTextString(m) }
 func (*SnapshotMetadata) ProtoMessage()    {}
 func (*SnapshotMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eraftpb_4260401482694966, []int{1}
+	return fileDescriptor_eraftpb_a8f84c9f2455793b, []int{1}
 }
 func (m *SnapshotMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,7 +297,7 @@ func (m *Snapshot) Reset()         { *m = Snapshot{} }
 func (m *Snapshot) String() string { return proto.CompactTextString(m) }
 func (*Snapshot) ProtoMessage()    {}
 func (*Snapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eraftpb_4260401482694966, []int{2}
+	return fileDescriptor_eraftpb_a8f84c9f2455793b, []int{2}
 }
 func (m *Snapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -356,7 +362,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eraftpb_4260401482694966, []int{3}
+	return fileDescriptor_eraftpb_a8f84c9f2455793b, []int{3}
 }
 func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -482,7 +488,7 @@ func (m *HardState) Reset()         { *m = HardState{} }
 func (m *HardState) String() string { return proto.CompactTextString(m) }
 func (*HardState) ProtoMessage()    {}
 func (*HardState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eraftpb_4260401482694966, []int{4}
+	return fileDescriptor_eraftpb_a8f84c9f2455793b, []int{4}
 }
 func (m *HardState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +549,7 @@ func (m *ConfState) Reset()         { *m = ConfState{} }
 func (m *ConfState) String() string { return proto.CompactTextString(m) }
 func (*ConfState) ProtoMessage()    {}
 func (*ConfState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eraftpb_4260401482694966, []int{5}
+	return fileDescriptor_eraftpb_a8f84c9f2455793b, []int{5}
 }
 func (m *ConfState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -593,7 +599,7 @@ func (m *ConfChange) Reset()         { *m = ConfChange{} }
 func (m *ConfChange) String() string { return proto.CompactTextString(m) }
 func (*ConfChange) ProtoMessage()    {}
 func (*ConfChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eraftpb_4260401482694966, []int{6}
+	return fileDescriptor_eraftpb_a8f84c9f2455793b, []int{6}
 }
 func (m *ConfChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2383,54 +2389,55 @@ var (
 	ErrIntOverflowEraftpb   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("eraftpb.proto", fileDescriptor_eraftpb_4260401482694966) }
+func init() { proto.RegisterFile("eraftpb.proto", fileDescriptor_eraftpb_a8f84c9f2455793b) }
 
-var fileDescriptor_eraftpb_4260401482694966 = []byte{
-	// 727 bytes of a gzipped FileDescriptorProto
+var fileDescriptor_eraftpb_a8f84c9f2455793b = []byte{
+	// 740 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x54, 0x4d, 0x6f, 0xf3, 0x44,
-	0x10, 0xce, 0x3a, 0x1f, 0xb6, 0xc7, 0x49, 0xde, 0xed, 0x52, 0xde, 0xd7, 0xe5, 0x10, 0x42, 0x4e,
-	0x51, 0xa5, 0x16, 0xb5, 0x08, 0x89, 0x6b, 0x5b, 0x21, 0x15, 0x81, 0x2b, 0xe4, 0xa6, 0x5c, 0xa3,
-	0x8d, 0x3d, 0x71, 0x8c, 0x62, 0xaf, 0xf1, 0x6e, 0x4a, 0xfb, 0x13, 0xb8, 0x72, 0x42, 0xfc, 0x22,
-	0x8e, 0xfc, 0x04, 0x54, 0x0e, 0xfc, 0x0d, 0xb4, 0x1b, 0xdb, 0x75, 0xfa, 0xde, 0xe6, 0x19, 0xcf,
-	0xce, 0x3c, 0xf3, 0x3c, 0x93, 0xc0, 0x08, 0x4b, 0xbe, 0x56, 0xc5, 0xea, 0xbc, 0x28, 0x85, 0x12,
-	0xcc, 0xae, 0xe0, 0xec, 0x77, 0x02, 0xfd, 0x6f, 0x73, 0x55, 0x3e, 0xb3, 0x0b, 0x00, 0xd4, 0xc1,
-	0x52, 0x3d, 0x17, 0xe8, 0x93, 0x29, 0x99, 0x8f, 0x2f, 0xd9, 0x79, 0xfd, 0xcc, 0xd4, 0x2c, 0x9e,
-	0x0b, 0x0c, 0x5d, 0xac, 0x43, 0xc6, 0xa0, 0xa7, 0xb0, 0xcc, 0x7c, 0x6b, 0x4a, 0xe6, 0xbd, 0xd0,
-	0xc4, 0xec, 0x18, 0xfa, 0x69, 0x1e, 0xe3, 0x93, 0xdf, 0x35, 0xc9, 0x3d, 0xd0, 0x95, 0x31, 0x57,
-	0xdc, 0xef, 0x4d, 0xc9, 0x7c, 0x18, 0x9a, 0x98, 0xf9, 0x60, 0x47, 0x22, 0x57, 0xf8, 0xa4, 0xfc,
-	0x81, 0x49, 0xd7, 0x70, 0x26, 0x80, 0xde, 0xe7, 0xbc, 0x90, 0x1b, 0xa1, 0x02, 0x54, 0xdc, 0x54,
-	0x5f, 0x00, 0x44, 0x22, 0x5f, 0x2f, 0xa5, 0xe2, 0x6a, 0x4f, 0xcf, 0x6b, 0xd1, 0xbb, 0x11, 0xf9,
-	0xfa, 0x5e, 0x7f, 0x09, 0xdd, 0xa8, 0x0e, 0x5f, 0xa9, 0x58, 0x6f, 0xa8, 0x18, 0xd2, 0xdd, 0x57,
-	0xd2, 0xb3, 0x07, 0x70, 0xea, 0x81, 0x0d, 0x55, 0xd2, 0xa2, 0xfa, 0x35, 0x38, 0x59, 0x45, 0xc4,
-	0x34, 0xf3, 0x2e, 0x4f, 0x9a, 0xd1, 0x6f, 0x99, 0x86, 0x4d, 0xe9, 0xec, 0x3f, 0x0b, 0xec, 0x00,
-	0xa5, 0xe4, 0x09, 0xb2, 0x2f, 0xc1, 0xc9, 0x64, 0xd2, 0x16, 0xf7, 0xb8, 0x69, 0x51, 0xd5, 0x18,
-	0x79, 0xed, 0x4c, 0x26, 0x46, 0xdc, 0x31, 0x58, 0x4a, 0x54, 0xd4, 0x2d, 0x25, 0x34, 0xaf, 0x75,
-	0x29, 0x1a, 0xde, 0x3a, 0x6e, 0x76, 0xe9, 0xb5, 0x0c, 0x38, 0x01, 0x67, 0x2b, 0x92, 0xa5, 0xc9,
-	0xf7, 0x4d, 0xde, 0xde, 0x8a, 0x64, 0x71, 0xe0, 0xcd, 0xa0, 0x2d, 0xc8, 0x1c, 0x6c, 0x6d, 0x69,
-	0x8a, 0xd2, 0xb7, 0xa7, 0xdd, 0xb9, 0x77, 0x39, 0x3e, 0x74, 0x3d, 0xac, 0x3f, 0xb3, 0xf7, 0x30,
-	0x88, 0x44, 0x96, 0xa5, 0xca, 0x77, 0x4c, 0x83, 0x0a, 0xb1, 0x33, 0x70, 0x64, 0xa5, 0x82, 0xef,
-	0x1a, 0x79, 0x8e, 0x3e, 0x92, 0x27, 0x6c, 0x4a, 0x74, 0x9b, 0x12, 0x7f, 0xc6, 0x48, 0xf9, 0x30,
-	0x25, 0x73, 0x27, 0xac, 0x10, 0xfb, 0x1c, 0xbc, 0x7d, 0xb4, 0xdc, 0xa4, 0xb9, 0xf2, 0x3d, 0x33,
-	0x03, 0xf6, 0xa9, 0xdb, 0x34, 0x57, 0xed, 0x8b, 0x19, 0x1e, 0x5e, 0xcc, 0xf7, 0xe0, 0xde, 0xf2,
-	0x32, 0xde, 0xfb, 0x5e, 0xab, 0x42, 0x5a, 0xaa, 0x30, 0xe8, 0x3d, 0x0a, 0x85, 0xf5, 0xa9, 0xea,
-	0xb8, 0xb5, 0x4e, 0xb7, 0xbd, 0xce, 0xec, 0x0b, 0x70, 0x6f, 0xda, 0x47, 0x94, 0x8b, 0x18, 0xa5,
-	0x4f, 0xa6, 0x5d, 0xad, 0x99, 0x01, 0xb3, 0xdf, 0x08, 0x80, 0xae, 0xb9, 0xd9, 0xf0, 0x3c, 0x31,
-	0x5e, 0xa5, 0x71, 0x35, 0xcf, 0x4a, 0x63, 0xf6, 0x0d, 0x78, 0x91, 0xf9, 0xb2, 0xf7, 0xdb, 0x32,
-	0x7e, 0x7f, 0x38, 0xb8, 0xd6, 0xfd, 0x4b, 0x63, 0x39, 0x44, 0x4d, 0xcc, 0x3e, 0x80, 0xad, 0x27,
-	0x2c, 0xd3, 0xb8, 0x26, 0xa5, 0xe1, 0x77, 0x71, 0x7b, 0xf7, 0xde, 0xc1, 0xee, 0xa7, 0x17, 0xe0,
-	0x36, 0xbf, 0x4e, 0xf6, 0x0e, 0x3c, 0x03, 0xee, 0x44, 0x99, 0xf1, 0x2d, 0xed, 0xb0, 0x4f, 0xe0,
-	0x9d, 0x49, 0xbc, 0xce, 0xa4, 0xe4, 0xf4, 0x4f, 0x0b, 0xbc, 0xd6, 0xd1, 0x31, 0x80, 0x41, 0x20,
-	0x93, 0xdb, 0x5d, 0x41, 0x3b, 0xcc, 0x03, 0x3b, 0x90, 0xc9, 0x35, 0x72, 0x45, 0x09, 0x1b, 0x03,
-	0x04, 0x32, 0xf9, 0xb1, 0x14, 0x85, 0x90, 0x48, 0x2d, 0x36, 0x02, 0x37, 0x90, 0xc9, 0x55, 0x51,
-	0x60, 0x1e, 0xd3, 0x2e, 0xfb, 0x14, 0x8e, 0x1a, 0x18, 0xa2, 0x2c, 0x44, 0x2e, 0x91, 0xf6, 0x18,
-	0x83, 0x71, 0x20, 0x93, 0x10, 0x7f, 0xd9, 0xa1, 0x54, 0x3f, 0x09, 0x85, 0xb4, 0xcf, 0x3e, 0x83,
-	0xf7, 0x87, 0xb9, 0xa6, 0x7e, 0xa0, 0x49, 0x07, 0x32, 0xa9, 0x2f, 0x85, 0xda, 0x8c, 0xc2, 0x50,
-	0xf3, 0x41, 0x5e, 0xaa, 0x95, 0x26, 0xe2, 0x30, 0x1f, 0x8e, 0xdb, 0x99, 0xe6, 0xb1, 0x5b, 0x0d,
-	0x7b, 0xc8, 0x4b, 0xe4, 0xd1, 0x86, 0xaf, 0xb6, 0x48, 0x81, 0x1d, 0xc1, 0xa8, 0x6a, 0xa8, 0x4d,
-	0xdc, 0x49, 0xea, 0x55, 0x54, 0x17, 0x25, 0xcf, 0xe5, 0x1a, 0xcb, 0x1f, 0x90, 0xc7, 0x58, 0xd2,
-	0x61, 0x55, 0xb9, 0x48, 0x33, 0x14, 0x3b, 0x75, 0x27, 0x7e, 0xa5, 0xa3, 0xd3, 0x33, 0x18, 0x1f,
-	0x1a, 0xa4, 0x25, 0xb9, 0x8a, 0xe3, 0x3b, 0x11, 0x23, 0xed, 0x68, 0x49, 0x42, 0xcc, 0xc4, 0x23,
-	0x1a, 0x4c, 0xae, 0xe9, 0x5f, 0x2f, 0x13, 0xf2, 0xf7, 0xcb, 0x84, 0xfc, 0xf3, 0x32, 0x21, 0x7f,
-	0xfc, 0x3b, 0xe9, 0xac, 0x06, 0xe6, 0x3f, 0xf6, 0xab, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x73,
-	0xfb, 0x26, 0x9a, 0x74, 0x05, 0x00, 0x00,
+	0x10, 0xce, 0x3a, 0x1f, 0xb6, 0xc7, 0x49, 0xba, 0x5d, 0xca, 0xfb, 0xfa, 0xe5, 0x10, 0x42, 0x4e,
+	0x51, 0xa5, 0xb7, 0xa8, 0x45, 0x48, 0x5c, 0xdb, 0x0a, 0xa9, 0x15, 0xb8, 0x42, 0x6e, 0xca, 0x35,
+	0xda, 0xd8, 0x13, 0xc7, 0x28, 0xf6, 0x1a, 0xef, 0xa6, 0xb4, 0x3f, 0x81, 0x2b, 0x27, 0x7e, 0x11,
+	0xe2, 0xc8, 0x4f, 0x40, 0xe5, 0xc0, 0xdf, 0x40, 0xbb, 0xb1, 0x5d, 0xa7, 0xdc, 0x66, 0x66, 0x67,
+	0xf7, 0x79, 0xe6, 0x79, 0xc6, 0x86, 0x11, 0x96, 0x7c, 0xad, 0x8a, 0xd5, 0x59, 0x51, 0x0a, 0x25,
+	0x98, 0x5d, 0xa5, 0xb3, 0xdf, 0x08, 0xf4, 0xbf, 0xcd, 0x55, 0xf9, 0xcc, 0xce, 0x01, 0x50, 0x07,
+	0x4b, 0xf5, 0x5c, 0xa0, 0x4f, 0xa6, 0x64, 0x3e, 0xbe, 0x60, 0x67, 0xf5, 0x35, 0xd3, 0xb3, 0x78,
+	0x2e, 0x30, 0x74, 0xb1, 0x0e, 0x19, 0x83, 0x9e, 0xc2, 0x32, 0xf3, 0xad, 0x29, 0x99, 0xf7, 0x42,
+	0x13, 0xb3, 0x13, 0xe8, 0xa7, 0x79, 0x8c, 0x4f, 0x7e, 0xd7, 0x14, 0xf7, 0x89, 0xee, 0x8c, 0xb9,
+	0xe2, 0x7e, 0x6f, 0x4a, 0xe6, 0xc3, 0xd0, 0xc4, 0xcc, 0x07, 0x3b, 0x12, 0xb9, 0xc2, 0x27, 0xe5,
+	0x0f, 0x4c, 0xb9, 0x4e, 0x67, 0x02, 0xe8, 0x7d, 0xce, 0x0b, 0xb9, 0x11, 0x2a, 0x40, 0xc5, 0x4d,
+	0xf7, 0x39, 0x40, 0x24, 0xf2, 0xf5, 0x52, 0x2a, 0xae, 0xf6, 0xf4, 0xbc, 0x16, 0xbd, 0x6b, 0x91,
+	0xaf, 0xef, 0xf5, 0x49, 0xe8, 0x46, 0x75, 0xf8, 0x4a, 0xc5, 0x7a, 0x43, 0xc5, 0x90, 0xee, 0xbe,
+	0x92, 0x9e, 0x3d, 0x80, 0x53, 0x03, 0x36, 0x54, 0x49, 0x8b, 0xea, 0xd7, 0xe0, 0x64, 0x15, 0x11,
+	0xf3, 0x98, 0x77, 0xf1, 0xa1, 0x81, 0x7e, 0xcb, 0x34, 0x6c, 0x5a, 0x67, 0xff, 0x5a, 0x60, 0x07,
+	0x28, 0x25, 0x4f, 0x90, 0x7d, 0x09, 0x4e, 0x26, 0x93, 0xb6, 0xb8, 0x27, 0xcd, 0x13, 0x55, 0x8f,
+	0x91, 0xd7, 0xce, 0x64, 0xa2, 0x03, 0x36, 0x06, 0x4b, 0x89, 0x8a, 0xba, 0xa5, 0x84, 0xe6, 0xb5,
+	0x2e, 0x45, 0xc3, 0x5b, 0xc7, 0xcd, 0x2c, 0xbd, 0x96, 0x01, 0x1f, 0xc0, 0xd9, 0x8a, 0x64, 0x69,
+	0xea, 0x7d, 0x53, 0xb7, 0xb7, 0x22, 0x59, 0x1c, 0x78, 0x33, 0x68, 0x0b, 0x32, 0x07, 0x5b, 0x5b,
+	0x9a, 0xa2, 0xf4, 0xed, 0x69, 0x77, 0xee, 0x5d, 0x8c, 0x0f, 0x5d, 0x0f, 0xeb, 0x63, 0xf6, 0x0e,
+	0x06, 0x91, 0xc8, 0xb2, 0x54, 0xf9, 0x8e, 0x79, 0xa0, 0xca, 0xd8, 0x47, 0x70, 0x64, 0xa5, 0x82,
+	0xef, 0x1a, 0x79, 0x8e, 0xff, 0x27, 0x4f, 0xd8, 0xb4, 0xe8, 0x67, 0x4a, 0xfc, 0x09, 0x23, 0xe5,
+	0xc3, 0x94, 0xcc, 0x9d, 0xb0, 0xca, 0xd8, 0xe7, 0xe0, 0xed, 0xa3, 0xe5, 0x26, 0xcd, 0x95, 0xef,
+	0x19, 0x0c, 0xd8, 0x97, 0x6e, 0xd2, 0x5c, 0xb5, 0x37, 0x66, 0x78, 0xb8, 0x31, 0xdf, 0x81, 0x7b,
+	0xc3, 0xcb, 0x78, 0xef, 0x7b, 0xad, 0x0a, 0x69, 0xa9, 0xc2, 0xa0, 0xf7, 0x28, 0x14, 0xd6, 0xab,
+	0xaa, 0xe3, 0xd6, 0x38, 0xdd, 0xf6, 0x38, 0xb3, 0x2f, 0xc0, 0xbd, 0x6e, 0x2f, 0x51, 0x2e, 0x62,
+	0x94, 0x3e, 0x99, 0x76, 0xb5, 0x66, 0x26, 0x99, 0xfd, 0x4a, 0x00, 0x74, 0xcf, 0xf5, 0x86, 0xe7,
+	0x89, 0xf1, 0x2a, 0x8d, 0x2b, 0x3c, 0x2b, 0x8d, 0xd9, 0x37, 0xe0, 0x45, 0xe6, 0x64, 0xef, 0xb7,
+	0x65, 0xfc, 0x7e, 0x7f, 0xb0, 0xad, 0xfb, 0x9b, 0xc6, 0x72, 0x88, 0x9a, 0x98, 0xbd, 0x07, 0x5b,
+	0x23, 0x2c, 0xd3, 0xb8, 0x26, 0xa5, 0xd3, 0xdb, 0xb8, 0x3d, 0x7b, 0xef, 0x60, 0xf6, 0xd3, 0x73,
+	0x70, 0x9b, 0xaf, 0x93, 0x1d, 0x81, 0x67, 0x92, 0x3b, 0x51, 0x66, 0x7c, 0x4b, 0x3b, 0xec, 0x13,
+	0x38, 0x32, 0x85, 0x57, 0x4c, 0x4a, 0x4e, 0xff, 0xb0, 0xc0, 0x6b, 0x2d, 0x1d, 0x03, 0x18, 0x04,
+	0x32, 0xb9, 0xd9, 0x15, 0xb4, 0xc3, 0x3c, 0xb0, 0x03, 0x99, 0x5c, 0x21, 0x57, 0x94, 0xb0, 0x31,
+	0x40, 0x20, 0x93, 0x1f, 0x4a, 0x51, 0x08, 0x89, 0xd4, 0x62, 0x23, 0x70, 0x03, 0x99, 0x5c, 0x16,
+	0x05, 0xe6, 0x31, 0xed, 0xb2, 0x4f, 0xe1, 0xb8, 0x49, 0x43, 0x94, 0x85, 0xc8, 0x25, 0xd2, 0x1e,
+	0x63, 0x30, 0x0e, 0x64, 0x12, 0xe2, 0xcf, 0x3b, 0x94, 0xea, 0x47, 0xa1, 0x90, 0xf6, 0xd9, 0x67,
+	0xf0, 0xee, 0xb0, 0xd6, 0xf4, 0x0f, 0x34, 0xe9, 0x40, 0x26, 0xf5, 0xa6, 0x50, 0x9b, 0x51, 0x18,
+	0x6a, 0x3e, 0xc8, 0x4b, 0xb5, 0xd2, 0x44, 0x1c, 0xe6, 0xc3, 0x49, 0xbb, 0xd2, 0x5c, 0x76, 0x2b,
+	0xb0, 0x87, 0xbc, 0x44, 0x1e, 0x6d, 0xf8, 0x6a, 0x8b, 0x14, 0xd8, 0x31, 0x8c, 0xaa, 0x07, 0xb5,
+	0x89, 0x3b, 0x49, 0xbd, 0x8a, 0xea, 0xa2, 0xe4, 0xb9, 0x5c, 0x63, 0xf9, 0x3d, 0xf2, 0x18, 0x4b,
+	0x3a, 0xac, 0x3a, 0x17, 0x69, 0x86, 0x62, 0xa7, 0xee, 0xc4, 0x2f, 0x74, 0x54, 0x81, 0x87, 0xc8,
+	0xe3, 0x5b, 0xfd, 0x7d, 0xd0, 0x71, 0x05, 0xde, 0x54, 0x1a, 0xf0, 0xa3, 0xd3, 0x8f, 0x30, 0x3e,
+	0x34, 0x53, 0xcb, 0x77, 0x19, 0xc7, 0x77, 0x22, 0x46, 0xda, 0xd1, 0xf2, 0x85, 0x98, 0x89, 0x47,
+	0x34, 0x39, 0xb9, 0xa2, 0x7f, 0xbe, 0x4c, 0xc8, 0x5f, 0x2f, 0x13, 0xf2, 0xf7, 0xcb, 0x84, 0xfc,
+	0xfe, 0xcf, 0xa4, 0xb3, 0x1a, 0x98, 0xff, 0xf1, 0x57, 0xff, 0x0d, 0x00, 0xc0, 0xfc, 0xe9, 0xae,
+	0xa0, 0x05, 0x00, 0x00,
 }
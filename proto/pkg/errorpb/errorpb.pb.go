@@ -27,18 +27,19 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type NotLeader struct {
-	RegionId             uint64       `protobuf:"varint,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
-	Leader               *metapb.Peer `protobuf:"bytes,2,opt,name=leader" json:"leader,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	RegionId             uint64              `protobuf:"varint,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	Leader               *metapb.Peer        `protobuf:"bytes,2,opt,name=leader" json:"leader,omitempty"`
+	RegionEpoch          *metapb.RegionEpoch `protobuf:"bytes,3,opt,name=region_epoch,json=regionEpoch" json:"region_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *NotLeader) Reset()         { *m = NotLeader{} }
 func (m *NotLeader) String() string { return proto.CompactTextString(m) }
 func (*NotLeader) ProtoMessage()    {}
 func (*NotLeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_errorpb_1b641c8200630b0e, []int{0}
+	return fileDescriptor_errorpb_9ffa71b5adaa95ec, []int{0}
 }
 func (m *NotLeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -81,6 +82,13 @@ func (m *NotLeader) GetLeader() *metapb.Peer {
 	return nil
 }
 
+func (m *NotLeader) GetRegionEpoch() *metapb.RegionEpoch {
+	if m != nil {
+		return m.RegionEpoch
+	}
+	return nil
+}
+
 type StoreNotMatch struct {
 	RequestStoreId       uint64   `protobuf:"varint,1,opt,name=request_store_id,json=requestStoreId,proto3" json:"request_store_id,omitempty"`
 	ActualStoreId        uint64   `protobuf:"varint,2,opt,name=actual_store_id,json=actualStoreId,proto3" json:"actual_store_id,omitempty"`
@@ -93,7 +101,7 @@ func (m *StoreNotMatch) Reset()         { *m = StoreNotMatch{} }
 func (m *StoreNotMatch) String() string { return proto.CompactTextString(m) }
 func (*StoreNotMatch) ProtoMessage()    {}
 func (*StoreNotMatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_errorpb_1b641c8200630b0e, []int{1}
+	return fileDescriptor_errorpb_9ffa71b5adaa95ec, []int{1}
 }
 func (m *StoreNotMatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -147,7 +155,7 @@ func (m *RegionNotFound) Reset()         { *m = RegionNotFound{} }
 func (m *RegionNotFound) String() string { return proto.CompactTextString(m) }
 func (*RegionNotFound) ProtoMessage()    {}
 func (*RegionNotFound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_errorpb_1b641c8200630b0e, []int{2}
+	return fileDescriptor_errorpb_9ffa71b5adaa95ec, []int{2}
 }
 func (m *RegionNotFound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -197,7 +205,7 @@ func (m *KeyNotInRegion) Reset()         { *m = KeyNotInRegion{} }
 func (m *KeyNotInRegion) String() string { return proto.CompactTextString(m) }
 func (*KeyNotInRegion) ProtoMessage()    {}
 func (*KeyNotInRegion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_errorpb_1b641c8200630b0e, []int{3}
+	return fileDescriptor_errorpb_9ffa71b5adaa95ec, []int{3}
 }
 func (m *KeyNotInRegion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -265,7 +273,7 @@ func (m *EpochNotMatch) Reset()         { *m = EpochNotMatch{} }
 func (m *EpochNotMatch) String() string { return proto.CompactTextString(m) }
 func (*EpochNotMatch) ProtoMessage()    {}
 func (*EpochNotMatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_errorpb_1b641c8200630b0e, []int{4}
+	return fileDescriptor_errorpb_9ffa71b5adaa95ec, []int{4}
 }
 func (m *EpochNotMatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -313,7 +321,7 @@ func (m *ServerIsBusy) Reset()         { *m = ServerIsBusy{} }
 func (m *ServerIsBusy) String() string { return proto.CompactTextString(m) }
 func (*ServerIsBusy) ProtoMessage()    {}
 func (*ServerIsBusy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_errorpb_1b641c8200630b0e, []int{5}
+	return fileDescriptor_errorpb_9ffa71b5adaa95ec, []int{5}
 }
 func (m *ServerIsBusy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,7 +374,7 @@ func (m *StaleCommand) Reset()         { *m = StaleCommand{} }
 func (m *StaleCommand) String() string { return proto.CompactTextString(m) }
 func (*StaleCommand) ProtoMessage()    {}
 func (*StaleCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_errorpb_1b641c8200630b0e, []int{6}
+	return fileDescriptor_errorpb_9ffa71b5adaa95ec, []int{6}
 }
 func (m *StaleCommand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,7 +415,7 @@ func (m *RaftEntryTooLarge) Reset()         { *m = RaftEntryTooLarge{} }
 func (m *RaftEntryTooLarge) String() string { return proto.CompactTextString(m) }
 func (*RaftEntryTooLarge) ProtoMessage()    {}
 func (*RaftEntryTooLarge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_errorpb_1b641c8200630b0e, []int{7}
+	return fileDescriptor_errorpb_9ffa71b5adaa95ec, []int{7}
 }
 func (m *RaftEntryTooLarge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -450,6 +458,102 @@ func (m *RaftEntryTooLarge) GetEntrySize() uint64 {
 	return 0
 }
 
+// The deadline of a read request passed before it was executed.
+type DeadlineExceeded struct {
+	RegionId             uint64   `protobuf:"varint,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeadlineExceeded) Reset()         { *m = DeadlineExceeded{} }
+func (m *DeadlineExceeded) String() string { return proto.CompactTextString(m) }
+func (*DeadlineExceeded) ProtoMessage()    {}
+func (*DeadlineExceeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_errorpb_9ffa71b5adaa95ec, []int{8}
+}
+func (m *DeadlineExceeded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeadlineExceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeadlineExceeded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *DeadlineExceeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadlineExceeded.Merge(dst, src)
+}
+func (m *DeadlineExceeded) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeadlineExceeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadlineExceeded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadlineExceeded proto.InternalMessageInfo
+
+func (m *DeadlineExceeded) GetRegionId() uint64 {
+	if m != nil {
+		return m.RegionId
+	}
+	return 0
+}
+
+// The region is in the read-only mode set through the scheduler, it rejects the writes.
+type ReadOnly struct {
+	RegionId             uint64   `protobuf:"varint,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadOnly) Reset()         { *m = ReadOnly{} }
+func (m *ReadOnly) String() string { return proto.CompactTextString(m) }
+func (*ReadOnly) ProtoMessage()    {}
+func (*ReadOnly) Descriptor() ([]byte, []int) {
+	return fileDescriptor_errorpb_9ffa71b5adaa95ec, []int{9}
+}
+func (m *ReadOnly) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReadOnly) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReadOnly.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ReadOnly) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadOnly.Merge(dst, src)
+}
+func (m *ReadOnly) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReadOnly) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadOnly.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadOnly proto.InternalMessageInfo
+
+func (m *ReadOnly) GetRegionId() uint64 {
+	if m != nil {
+		return m.RegionId
+	}
+	return 0
+}
+
 type Error struct {
 	Message              string             `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	NotLeader            *NotLeader         `protobuf:"bytes,2,opt,name=not_leader,json=notLeader" json:"not_leader,omitempty"`
@@ -460,6 +564,8 @@ type Error struct {
 	StaleCommand         *StaleCommand      `protobuf:"bytes,7,opt,name=stale_command,json=staleCommand" json:"stale_command,omitempty"`
 	StoreNotMatch        *StoreNotMatch     `protobuf:"bytes,8,opt,name=store_not_match,json=storeNotMatch" json:"store_not_match,omitempty"`
 	RaftEntryTooLarge    *RaftEntryTooLarge `protobuf:"bytes,9,opt,name=raft_entry_too_large,json=raftEntryTooLarge" json:"raft_entry_too_large,omitempty"`
+	DeadlineExceeded     *DeadlineExceeded  `protobuf:"bytes,10,opt,name=deadline_exceeded,json=deadlineExceeded" json:"deadline_exceeded,omitempty"`
+	ReadOnly             *ReadOnly          `protobuf:"bytes,11,opt,name=read_only,json=readOnly" json:"read_only,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -469,7 +575,7 @@ func (m *Error) Reset()         { *m = Error{} }
 func (m *Error) String() string { return proto.CompactTextString(m) }
 func (*Error) ProtoMessage()    {}
 func (*Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_errorpb_1b641c8200630b0e, []int{8}
+	return fileDescriptor_errorpb_9ffa71b5adaa95ec, []int{10}
 }
 func (m *Error) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,6 +667,20 @@ func (m *Error) GetRaftEntryTooLarge() *RaftEntryTooLarge {
 	return nil
 }
 
+func (m *Error) GetDeadlineExceeded() *DeadlineExceeded {
+	if m != nil {
+		return m.DeadlineExceeded
+	}
+	return nil
+}
+
+func (m *Error) GetReadOnly() *ReadOnly {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*NotLeader)(nil), "errorpb.NotLeader")
 	proto.RegisterType((*StoreNotMatch)(nil), "errorpb.StoreNotMatch")
@@ -570,6 +690,8 @@ func init() {
 	proto.RegisterType((*ServerIsBusy)(nil), "errorpb.ServerIsBusy")
 	proto.RegisterType((*StaleCommand)(nil), "errorpb.StaleCommand")
 	proto.RegisterType((*RaftEntryTooLarge)(nil), "errorpb.RaftEntryTooLarge")
+	proto.RegisterType((*DeadlineExceeded)(nil), "errorpb.DeadlineExceeded")
+	proto.RegisterType((*ReadOnly)(nil), "errorpb.ReadOnly")
 	proto.RegisterType((*Error)(nil), "errorpb.Error")
 }
 func (m *NotLeader) Marshal() (dAtA []byte, err error) {
@@ -602,6 +724,16 @@ func (m *NotLeader) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n1
 	}
+	if m.RegionEpoch != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintErrorpb(dAtA, i, uint64(m.RegionEpoch.Size()))
+		n2, err := m.RegionEpoch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -826,6 +958,58 @@ func (m *RaftEntryTooLarge) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *DeadlineExceeded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeadlineExceeded) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.RegionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintErrorpb(dAtA, i, uint64(m.RegionId))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ReadOnly) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReadOnly) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.RegionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintErrorpb(dAtA, i, uint64(m.RegionId))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *Error) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -851,81 +1035,101 @@ func (m *Error) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintErrorpb(dAtA, i, uint64(m.NotLeader.Size()))
-		n2, err := m.NotLeader.MarshalTo(dAtA[i:])
+		n3, err := m.NotLeader.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n3
 	}
 	if m.RegionNotFound != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintErrorpb(dAtA, i, uint64(m.RegionNotFound.Size()))
-		n3, err := m.RegionNotFound.MarshalTo(dAtA[i:])
+		n4, err := m.RegionNotFound.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n4
 	}
 	if m.KeyNotInRegion != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintErrorpb(dAtA, i, uint64(m.KeyNotInRegion.Size()))
-		n4, err := m.KeyNotInRegion.MarshalTo(dAtA[i:])
+		n5, err := m.KeyNotInRegion.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n5
 	}
 	if m.EpochNotMatch != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintErrorpb(dAtA, i, uint64(m.EpochNotMatch.Size()))
-		n5, err := m.EpochNotMatch.MarshalTo(dAtA[i:])
+		n6, err := m.EpochNotMatch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n6
 	}
 	if m.ServerIsBusy != nil {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintErrorpb(dAtA, i, uint64(m.ServerIsBusy.Size()))
-		n6, err := m.ServerIsBusy.MarshalTo(dAtA[i:])
+		n7, err := m.ServerIsBusy.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n7
 	}
 	if m.StaleCommand != nil {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintErrorpb(dAtA, i, uint64(m.StaleCommand.Size()))
-		n7, err := m.StaleCommand.MarshalTo(dAtA[i:])
+		n8, err := m.StaleCommand.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n8
 	}
 	if m.StoreNotMatch != nil {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintErrorpb(dAtA, i, uint64(m.StoreNotMatch.Size()))
-		n8, err := m.StoreNotMatch.MarshalTo(dAtA[i:])
+		n9, err := m.StoreNotMatch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n9
 	}
 	if m.RaftEntryTooLarge != nil {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintErrorpb(dAtA, i, uint64(m.RaftEntryTooLarge.Size()))
-		n9, err := m.RaftEntryTooLarge.MarshalTo(dAtA[i:])
+		n10, err := m.RaftEntryTooLarge.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n10
+	}
+	if m.DeadlineExceeded != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintErrorpb(dAtA, i, uint64(m.DeadlineExceeded.Size()))
+		n11, err := m.DeadlineExceeded.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.ReadOnly != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintErrorpb(dAtA, i, uint64(m.ReadOnly.Size()))
+		n12, err := m.ReadOnly.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -952,6 +1156,10 @@ func (m *NotLeader) Size() (n int) {
 		l = m.Leader.Size()
 		n += 1 + l + sovErrorpb(uint64(l))
 	}
+	if m.RegionEpoch != nil {
+		l = m.RegionEpoch.Size()
+		n += 1 + l + sovErrorpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1064,6 +1272,30 @@ func (m *RaftEntryTooLarge) Size() (n int) {
 	return n
 }
 
+func (m *DeadlineExceeded) Size() (n int) {
+	var l int
+	_ = l
+	if m.RegionId != 0 {
+		n += 1 + sovErrorpb(uint64(m.RegionId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadOnly) Size() (n int) {
+	var l int
+	_ = l
+	if m.RegionId != 0 {
+		n += 1 + sovErrorpb(uint64(m.RegionId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *Error) Size() (n int) {
 	var l int
 	_ = l
@@ -1103,6 +1335,14 @@ func (m *Error) Size() (n int) {
 		l = m.RaftEntryTooLarge.Size()
 		n += 1 + l + sovErrorpb(uint64(l))
 	}
+	if m.DeadlineExceeded != nil {
+		l = m.DeadlineExceeded.Size()
+		n += 1 + l + sovErrorpb(uint64(l))
+	}
+	if m.ReadOnly != nil {
+		l = m.ReadOnly.Size()
+		n += 1 + l + sovErrorpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1203,6 +1443,39 @@ func (m *NotLeader) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErrorpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErrorpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RegionEpoch == nil {
+				m.RegionEpoch = &metapb.RegionEpoch{}
+			}
+			if err := m.RegionEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErrorpb(dAtA[iNdEx:])
@@ -1868,6 +2141,146 @@ func (m *RaftEntryTooLarge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DeadlineExceeded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErrorpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeadlineExceeded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeadlineExceeded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
+			}
+			m.RegionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErrorpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErrorpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthErrorpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadOnly) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErrorpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadOnly: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadOnly: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
+			}
+			m.RegionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErrorpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErrorpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthErrorpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Error) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2190,6 +2603,72 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineExceeded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErrorpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErrorpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeadlineExceeded == nil {
+				m.DeadlineExceeded = &DeadlineExceeded{}
+			}
+			if err := m.DeadlineExceeded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErrorpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErrorpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReadOnly == nil {
+				m.ReadOnly = &ReadOnly{}
+			}
+			if err := m.ReadOnly.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErrorpb(dAtA[iNdEx:])
@@ -2317,48 +2796,54 @@ var (
 	ErrIntOverflowErrorpb   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("errorpb.proto", fileDescriptor_errorpb_1b641c8200630b0e) }
-
-var fileDescriptor_errorpb_1b641c8200630b0e = []byte{
-	// 637 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0xd3, 0x30,
-	0x14, 0x26, 0xfb, 0x69, 0x97, 0xd3, 0x24, 0xdb, 0xa2, 0xb1, 0x45, 0x9b, 0x56, 0x4d, 0x11, 0x42,
-	0xbd, 0xa1, 0x88, 0x71, 0x81, 0x04, 0x12, 0x12, 0x43, 0x45, 0x54, 0xdd, 0x0a, 0x72, 0xb9, 0x8f,
-	0xdc, 0xe6, 0xb4, 0x8b, 0xda, 0xda, 0xc3, 0x76, 0x26, 0x65, 0x4f, 0xc2, 0x53, 0xf0, 0x1c, 0x5c,
-	0xf2, 0x08, 0xa8, 0xbc, 0x08, 0xb2, 0x93, 0xa6, 0x4d, 0x91, 0x76, 0x55, 0x9f, 0xcf, 0xe7, 0xfb,
-	0x7a, 0xec, 0xef, 0x73, 0xc0, 0x45, 0x21, 0xb8, 0xb8, 0x1b, 0xb6, 0xef, 0x04, 0x57, 0xdc, 0xaf,
-	0x17, 0xe5, 0xa9, 0x33, 0x47, 0x45, 0x97, 0xf0, 0xe9, 0xd1, 0x84, 0x4f, 0xb8, 0x59, 0xbe, 0xd4,
-	0xab, 0x1c, 0x0d, 0xfb, 0x60, 0xf7, 0xb9, 0xba, 0x46, 0x1a, 0xa3, 0xf0, 0xcf, 0xc0, 0x16, 0x38,
-	0x49, 0x38, 0x8b, 0x92, 0x38, 0xb0, 0x2e, 0xac, 0xd6, 0x0e, 0xd9, 0xcb, 0x81, 0x6e, 0xec, 0x3f,
-	0x83, 0xda, 0xcc, 0xb4, 0x05, 0x5b, 0x17, 0x56, 0xab, 0x71, 0xe9, 0xb4, 0x0b, 0xf9, 0xaf, 0x88,
-	0x82, 0x14, 0x7b, 0x21, 0x05, 0x77, 0xa0, 0xb8, 0xc0, 0x3e, 0x57, 0x37, 0x54, 0x8d, 0x6e, 0xfd,
-	0x16, 0x1c, 0x08, 0xfc, 0x9e, 0xa2, 0x54, 0x91, 0xd4, 0x1b, 0x2b, 0x69, 0xaf, 0xc0, 0x4d, 0x7f,
-	0x37, 0xf6, 0x9f, 0xc3, 0x3e, 0x1d, 0xa9, 0x94, 0xce, 0x56, 0x8d, 0x5b, 0xa6, 0xd1, 0xcd, 0xe1,
-	0xa2, 0x2f, 0x7c, 0x01, 0x1e, 0x31, 0x43, 0xf5, 0xb9, 0xfa, 0xc4, 0x53, 0x16, 0x3f, 0x3a, 0x77,
-	0x98, 0x82, 0xd7, 0xc3, 0xac, 0xcf, 0x55, 0x97, 0xe5, 0x34, 0xff, 0x00, 0xb6, 0xa7, 0x98, 0x99,
-	0x46, 0x87, 0xe8, 0x65, 0x55, 0x60, 0x6b, 0xe3, 0xe0, 0x67, 0x60, 0x4b, 0x45, 0x85, 0x8a, 0x34,
-	0x69, 0xdb, 0x90, 0xf6, 0x0c, 0xd0, 0xc3, 0xcc, 0x3f, 0x81, 0x3a, 0xb2, 0xd8, 0x6c, 0xed, 0x98,
-	0xad, 0x1a, 0xb2, 0xb8, 0x87, 0x59, 0xf8, 0x19, 0xdc, 0xce, 0x1d, 0x1f, 0xdd, 0x96, 0x17, 0xf1,
-	0x06, 0xf6, 0x47, 0xa9, 0x10, 0xc8, 0x54, 0x94, 0x4b, 0xcb, 0xc0, 0xba, 0xd8, 0x6e, 0x35, 0x2e,
-	0xbd, 0xe5, 0x45, 0xe6, 0xe3, 0x11, 0xaf, 0x68, 0xcb, 0x4b, 0x19, 0x76, 0xc0, 0x19, 0xa0, 0xb8,
-	0x47, 0xd1, 0x95, 0x57, 0xa9, 0xcc, 0xfc, 0x63, 0xa8, 0x09, 0xa4, 0x92, 0x33, 0x73, 0x02, 0x9b,
-	0x14, 0x95, 0x7f, 0x0e, 0x30, 0xa4, 0xa3, 0x29, 0x1f, 0x8f, 0xa3, 0xb9, 0x2c, 0x4e, 0x61, 0x17,
-	0xc8, 0x8d, 0x0c, 0x3d, 0x70, 0x06, 0x8a, 0xce, 0xf0, 0x23, 0x9f, 0xcf, 0x29, 0x8b, 0xc3, 0x2f,
-	0x70, 0x48, 0xe8, 0x58, 0x75, 0x98, 0x12, 0xd9, 0x37, 0xce, 0xaf, 0xa9, 0x98, 0xe0, 0xe3, 0x09,
-	0x38, 0x07, 0x40, 0xdd, 0x1d, 0xc9, 0xe4, 0x01, 0x97, 0x7f, 0x60, 0x90, 0x41, 0xf2, 0x80, 0xe1,
-	0xcf, 0x1d, 0xd8, 0xed, 0xe8, 0xe8, 0xf9, 0x01, 0xd4, 0xe7, 0x28, 0x25, 0x9d, 0x60, 0x31, 0xe2,
-	0xb2, 0xf4, 0x5f, 0x01, 0x30, 0xae, 0xa2, 0x4a, 0x90, 0xfc, 0xf6, 0x32, 0xbf, 0x65, 0x12, 0x89,
-	0xcd, 0xca, 0x50, 0x7e, 0xd0, 0x01, 0x32, 0x23, 0x69, 0xe6, 0x58, 0x1b, 0x6e, 0x5c, 0x68, 0x5c,
-	0x9e, 0x94, 0xc4, 0x6a, 0x1e, 0x74, 0xb2, 0x2a, 0xf9, 0xb8, 0x82, 0xc3, 0x29, 0x66, 0x86, 0x9f,
-	0xb0, 0xe2, 0xf6, 0x8d, 0x5d, 0xeb, 0x1a, 0xd5, 0x90, 0x10, 0x6f, 0x5a, 0x0d, 0xcd, 0x7b, 0xd8,
-	0x47, 0xed, 0xa7, 0x51, 0x99, 0x6b, 0x47, 0x83, 0x5d, 0xa3, 0x70, 0x5c, 0x2a, 0x54, 0xfc, 0x26,
-	0x2e, 0x56, 0xec, 0x7f, 0x07, 0x9e, 0x34, 0x2e, 0x46, 0x89, 0x8c, 0x86, 0xa9, 0xcc, 0x82, 0x9a,
-	0xa1, 0x3f, 0x2d, 0xe9, 0xeb, 0x26, 0x13, 0x47, 0xae, 0x5b, 0xfe, 0x16, 0x5c, 0xa9, 0xbd, 0x8b,
-	0x46, 0xb9, 0x79, 0x41, 0x7d, 0x93, 0xbb, 0xe6, 0x2c, 0x71, 0xe4, 0x5a, 0xa5, 0x07, 0xcf, 0xdf,
-	0xd3, 0x6a, 0xf0, 0xbd, 0x8d, 0xc1, 0x2b, 0x2f, 0x96, 0xb8, 0xb2, 0xf2, 0x80, 0x7b, 0x70, 0x24,
-	0xe8, 0x58, 0x45, 0xb9, 0xf5, 0x8a, 0xf3, 0x68, 0xa6, 0xa3, 0x12, 0xd8, 0x46, 0xe4, 0x74, 0xe5,
-	0xc1, 0x66, 0x98, 0xc8, 0xa1, 0xf8, 0x0f, 0x6a, 0xe4, 0xc7, 0x30, 0x57, 0x73, 0x15, 0xfe, 0x5a,
-	0x34, 0xad, 0xdf, 0x8b, 0xa6, 0xf5, 0x67, 0xd1, 0xb4, 0x7e, 0xfc, 0x6d, 0x3e, 0x81, 0x03, 0x2e,
-	0x26, 0x6d, 0x95, 0x4c, 0xef, 0xdb, 0xd3, 0x7b, 0xf3, 0x7d, 0x1a, 0xd6, 0xcc, 0xcf, 0xeb, 0x7f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x51, 0x77, 0xa0, 0xcc, 0xe4, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("errorpb.proto", fileDescriptor_errorpb_9ffa71b5adaa95ec) }
+
+var fileDescriptor_errorpb_9ffa71b5adaa95ec = []byte{
+	// 729 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xdd, 0x6e, 0xdb, 0x36,
+	0x14, 0xc7, 0xa7, 0x38, 0xf1, 0xc7, 0xb1, 0xac, 0xd8, 0x5c, 0x96, 0x68, 0x09, 0x62, 0x04, 0xc2,
+	0xb0, 0xf9, 0x66, 0x0e, 0x96, 0x01, 0x1b, 0xb0, 0x01, 0x03, 0x96, 0xcd, 0x41, 0x0d, 0x27, 0x4e,
+	0x41, 0xf7, 0x5e, 0xa0, 0xad, 0x63, 0x47, 0xb0, 0x2d, 0xa6, 0x24, 0x1d, 0x54, 0x79, 0x80, 0x3e,
+	0x43, 0x9f, 0xa0, 0xcf, 0xd2, 0xcb, 0x3e, 0x42, 0x91, 0xbe, 0x48, 0x41, 0x8a, 0xfe, 0x90, 0x0b,
+	0xa4, 0x57, 0xe2, 0x39, 0xe7, 0x7f, 0xfe, 0xfc, 0xfa, 0x51, 0x50, 0x43, 0x21, 0xb8, 0xb8, 0x1f,
+	0xb6, 0xef, 0x05, 0x57, 0x9c, 0x94, 0x6c, 0x78, 0xec, 0xce, 0x51, 0xb1, 0x65, 0xfa, 0xf8, 0x60,
+	0xc2, 0x27, 0xdc, 0x0c, 0xcf, 0xf5, 0x28, 0xcb, 0x06, 0x6f, 0x1d, 0xa8, 0xf4, 0xb9, 0xba, 0x46,
+	0x16, 0xa1, 0x20, 0x27, 0x50, 0x11, 0x38, 0x89, 0x79, 0x12, 0xc6, 0x91, 0xef, 0x9c, 0x39, 0xad,
+	0x5d, 0x5a, 0xce, 0x12, 0xdd, 0x88, 0xfc, 0x04, 0xc5, 0x99, 0x91, 0xf9, 0x3b, 0x67, 0x4e, 0xab,
+	0x7a, 0xe1, 0xb6, 0xad, 0xff, 0x4b, 0x44, 0x41, 0x6d, 0x8d, 0xfc, 0x01, 0xae, 0xb5, 0xc0, 0x7b,
+	0x3e, 0xba, 0xf3, 0x0b, 0x46, 0xfb, 0xfd, 0x52, 0x4b, 0x4d, 0xad, 0xa3, 0x4b, 0xb4, 0x2a, 0xd6,
+	0x41, 0xc0, 0xa0, 0x36, 0x50, 0x5c, 0x60, 0x9f, 0xab, 0x1b, 0xa6, 0x46, 0x77, 0xa4, 0x05, 0x75,
+	0x81, 0xaf, 0x17, 0x28, 0x55, 0x28, 0x75, 0x61, 0xbd, 0x24, 0xcf, 0xe6, 0x8d, 0xbe, 0x1b, 0x91,
+	0x9f, 0x61, 0x9f, 0x8d, 0xd4, 0x82, 0xcd, 0xd6, 0xc2, 0x1d, 0x23, 0xac, 0x65, 0x69, 0xab, 0x0b,
+	0x7e, 0x05, 0x2f, 0x9b, 0xbe, 0xcf, 0xd5, 0x15, 0x5f, 0x24, 0xd1, 0xb3, 0xfb, 0x0d, 0x16, 0xe0,
+	0xf5, 0x30, 0xed, 0x73, 0xd5, 0x4d, 0xb2, 0x36, 0x52, 0x87, 0xc2, 0x14, 0x53, 0x23, 0x74, 0xa9,
+	0x1e, 0xe6, 0x0d, 0x76, 0xb6, 0x0e, 0xec, 0x04, 0x2a, 0x52, 0x31, 0xa1, 0x42, 0xdd, 0x54, 0x30,
+	0x4d, 0x65, 0x93, 0xe8, 0x61, 0x4a, 0x8e, 0xa0, 0x84, 0x49, 0x64, 0x4a, 0xbb, 0xa6, 0x54, 0xc4,
+	0x24, 0xea, 0x61, 0x1a, 0xbc, 0x80, 0x9a, 0x39, 0x91, 0xd5, 0x41, 0xfc, 0x09, 0xfb, 0xa3, 0x85,
+	0x10, 0x98, 0xa8, 0x30, 0xb3, 0x96, 0xbe, 0x73, 0x56, 0x68, 0x55, 0x2f, 0xbc, 0xfc, 0xa1, 0x52,
+	0xcf, 0xca, 0xb2, 0x50, 0x06, 0x1d, 0x70, 0x07, 0x28, 0x1e, 0x50, 0x74, 0xe5, 0xe5, 0x42, 0xa6,
+	0xe4, 0x10, 0x8a, 0x02, 0x99, 0xe4, 0x89, 0xd9, 0x41, 0x85, 0xda, 0x88, 0x9c, 0x02, 0x0c, 0xd9,
+	0x68, 0xca, 0xc7, 0xe3, 0x70, 0x2e, 0xed, 0x2e, 0x2a, 0x36, 0x73, 0x23, 0x03, 0x0f, 0xdc, 0x81,
+	0x62, 0x33, 0xfc, 0x8f, 0xcf, 0xe7, 0x2c, 0x89, 0x82, 0x5b, 0x68, 0x50, 0x36, 0x56, 0x9d, 0x44,
+	0x89, 0xf4, 0x15, 0xe7, 0xd7, 0x4c, 0x4c, 0xf0, 0x79, 0x72, 0x4e, 0x01, 0x50, 0xab, 0x43, 0x19,
+	0x3f, 0xe2, 0x72, 0x02, 0x93, 0x19, 0xc4, 0x8f, 0x18, 0x9c, 0x43, 0xfd, 0x7f, 0x64, 0xd1, 0x2c,
+	0x4e, 0xb0, 0xf3, 0x66, 0x84, 0x18, 0xe1, 0x37, 0x6e, 0xe6, 0x17, 0x28, 0x53, 0x64, 0xd1, 0x6d,
+	0x32, 0x4b, 0x9f, 0x17, 0xbe, 0xdf, 0x83, 0xbd, 0x8e, 0x7e, 0x0d, 0xc4, 0x87, 0xd2, 0x1c, 0xa5,
+	0x64, 0x13, 0xb4, 0x9b, 0x5f, 0x86, 0xe4, 0x37, 0x80, 0x84, 0xab, 0x30, 0x87, 0x36, 0x69, 0x2f,
+	0x9f, 0xd4, 0xea, 0x6d, 0xd0, 0x4a, 0xb2, 0x1c, 0x92, 0x7f, 0xa1, 0x9e, 0x4d, 0x11, 0xea, 0xce,
+	0xb1, 0x46, 0xc9, 0x72, 0x7e, 0xb4, 0x6a, 0xcc, 0x93, 0xa6, 0x99, 0xcd, 0x91, 0x77, 0x09, 0x8d,
+	0x29, 0xa6, 0xa6, 0x3f, 0x4e, 0xec, 0xbd, 0xfa, 0xbb, 0x5b, 0x1e, 0x79, 0xfc, 0xa8, 0x37, 0xcd,
+	0xe3, 0xf8, 0x0f, 0xec, 0x9b, 0x37, 0x66, 0x5c, 0xe6, 0x9a, 0x15, 0x7f, 0xcf, 0x38, 0x1c, 0xae,
+	0x1c, 0x72, 0x24, 0xd1, 0x1a, 0x6e, 0x86, 0xe4, 0x6f, 0xf0, 0xa4, 0xe1, 0x23, 0x8c, 0x65, 0x38,
+	0x5c, 0xc8, 0xd4, 0x2f, 0x9a, 0xf6, 0x1f, 0x56, 0xed, 0x9b, 0xf8, 0x50, 0x57, 0x6e, 0x44, 0xe4,
+	0x2f, 0xa8, 0x49, 0x4d, 0x45, 0x38, 0xca, 0xb0, 0xf0, 0x4b, 0xdb, 0xbd, 0x1b, 0xcc, 0x50, 0x57,
+	0x6e, 0x44, 0x7a, 0xe1, 0xd9, 0x4b, 0x5d, 0x2f, 0xbc, 0xbc, 0xb5, 0xf0, 0xdc, 0xbf, 0x80, 0xd6,
+	0xe4, 0x66, 0x48, 0x7a, 0x70, 0x20, 0xd8, 0x58, 0x85, 0x19, 0x54, 0x8a, 0xf3, 0x70, 0xa6, 0x21,
+	0xf4, 0x2b, 0xc6, 0xe4, 0x78, 0x7d, 0x07, 0xdb, 0x98, 0xd2, 0x86, 0xf8, 0x8a, 0xdc, 0x2b, 0x68,
+	0x44, 0x96, 0xbe, 0x10, 0x2d, 0x7e, 0x3e, 0x18, 0xa7, 0x1f, 0x57, 0x4e, 0xdb, 0x7c, 0xd2, 0x7a,
+	0xb4, 0x4d, 0x6c, 0x5b, 0x83, 0xc8, 0xa2, 0x90, 0x27, 0xb3, 0xd4, 0xaf, 0x9a, 0xfe, 0xc6, 0x7a,
+	0x25, 0x16, 0x57, 0xcd, 0xa6, 0x1d, 0x55, 0xb3, 0xe3, 0x33, 0x57, 0x72, 0x19, 0x7c, 0x78, 0x6a,
+	0x3a, 0x1f, 0x9f, 0x9a, 0xce, 0xa7, 0xa7, 0xa6, 0xf3, 0xee, 0x73, 0xf3, 0x3b, 0xa8, 0x73, 0x31,
+	0x69, 0xab, 0x78, 0xfa, 0xd0, 0x9e, 0x3e, 0x98, 0x5f, 0xf5, 0xb0, 0x68, 0x3e, 0xbf, 0x7f, 0x19,
+	0x00, 0xf2, 0xd5, 0x7d, 0x6a, 0xef, 0x05, 0x00, 0x00,
 }
@@ -67,7 +67,7 @@ func (x ErrorType) String() string {
 	return proto.EnumName(ErrorType_name, int32(x))
 }
 func (ErrorType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{0}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{0}
 }
 
 type CheckPolicy int32
@@ -93,7 +93,7 @@ func (x CheckPolicy) String() string {
 	return proto.EnumName(CheckPolicy_name, int32(x))
 }
 func (CheckPolicy) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{1}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{1}
 }
 
 type OperatorStatus int32
@@ -104,6 +104,7 @@ const (
 	OperatorStatus_CANCEL  OperatorStatus = 2
 	OperatorStatus_REPLACE OperatorStatus = 3
 	OperatorStatus_RUNNING OperatorStatus = 4
+	OperatorStatus_FAILED  OperatorStatus = 5
 )
 
 var OperatorStatus_name = map[int32]string{
@@ -112,6 +113,7 @@ var OperatorStatus_name = map[int32]string{
 	2: "CANCEL",
 	3: "REPLACE",
 	4: "RUNNING",
+	5: "FAILED",
 }
 var OperatorStatus_value = map[string]int32{
 	"SUCCESS": 0,
@@ -119,13 +121,14 @@ var OperatorStatus_value = map[string]int32{
 	"CANCEL":  2,
 	"REPLACE": 3,
 	"RUNNING": 4,
+	"FAILED":  5,
 }
 
 func (x OperatorStatus) String() string {
 	return proto.EnumName(OperatorStatus_name, int32(x))
 }
 func (OperatorStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{2}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{2}
 }
 
 type RequestHeader struct {
@@ -140,7 +143,7 @@ func (m *RequestHeader) Reset()         { *m = RequestHeader{} }
 func (m *RequestHeader) String() string { return proto.CompactTextString(m) }
 func (*RequestHeader) ProtoMessage()    {}
 func (*RequestHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{0}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{0}
 }
 func (m *RequestHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -189,7 +192,7 @@ func (m *ResponseHeader) Reset()         { *m = ResponseHeader{} }
 func (m *ResponseHeader) String() string { return proto.CompactTextString(m) }
 func (*ResponseHeader) ProtoMessage()    {}
 func (*ResponseHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{1}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{1}
 }
 func (m *ResponseHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -244,7 +247,7 @@ func (m *Error) Reset()         { *m = Error{} }
 func (m *Error) String() string { return proto.CompactTextString(m) }
 func (*Error) ProtoMessage()    {}
 func (*Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{2}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{2}
 }
 func (m *Error) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,7 +302,7 @@ func (m *TsoRequest) Reset()         { *m = TsoRequest{} }
 func (m *TsoRequest) String() string { return proto.CompactTextString(m) }
 func (*TsoRequest) ProtoMessage()    {}
 func (*TsoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{3}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{3}
 }
 func (m *TsoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,7 +357,7 @@ func (m *Timestamp) Reset()         { *m = Timestamp{} }
 func (m *Timestamp) String() string { return proto.CompactTextString(m) }
 func (*Timestamp) ProtoMessage()    {}
 func (*Timestamp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{4}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{4}
 }
 func (m *Timestamp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -410,7 +413,7 @@ func (m *TsoResponse) Reset()         { *m = TsoResponse{} }
 func (m *TsoResponse) String() string { return proto.CompactTextString(m) }
 func (*TsoResponse) ProtoMessage()    {}
 func (*TsoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{5}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{5}
 }
 func (m *TsoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,7 +476,7 @@ func (m *BootstrapRequest) Reset()         { *m = BootstrapRequest{} }
 func (m *BootstrapRequest) String() string { return proto.CompactTextString(m) }
 func (*BootstrapRequest) ProtoMessage()    {}
 func (*BootstrapRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{6}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{6}
 }
 func (m *BootstrapRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -534,7 +537,7 @@ func (m *BootstrapResponse) Reset()         { *m = BootstrapResponse{} }
 func (m *BootstrapResponse) String() string { return proto.CompactTextString(m) }
 func (*BootstrapResponse) ProtoMessage()    {}
 func (*BootstrapResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{7}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{7}
 }
 func (m *BootstrapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -581,7 +584,7 @@ func (m *IsBootstrappedRequest) Reset()         { *m = IsBootstrappedRequest{} }
 func (m *IsBootstrappedRequest) String() string { return proto.CompactTextString(m) }
 func (*IsBootstrappedRequest) ProtoMessage()    {}
 func (*IsBootstrappedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{8}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{8}
 }
 func (m *IsBootstrappedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -629,7 +632,7 @@ func (m *IsBootstrappedResponse) Reset()         { *m = IsBootstrappedResponse{}
 func (m *IsBootstrappedResponse) String() string { return proto.CompactTextString(m) }
 func (*IsBootstrappedResponse) ProtoMessage()    {}
 func (*IsBootstrappedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{9}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{9}
 }
 func (m *IsBootstrappedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +686,7 @@ func (m *AllocIDRequest) Reset()         { *m = AllocIDRequest{} }
 func (m *AllocIDRequest) String() string { return proto.CompactTextString(m) }
 func (*AllocIDRequest) ProtoMessage()    {}
 func (*AllocIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{10}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{10}
 }
 func (m *AllocIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -731,7 +734,7 @@ func (m *AllocIDResponse) Reset()         { *m = AllocIDResponse{} }
 func (m *AllocIDResponse) String() string { return proto.CompactTextString(m) }
 func (*AllocIDResponse) ProtoMessage()    {}
 func (*AllocIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{11}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{11}
 }
 func (m *AllocIDResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -786,7 +789,7 @@ func (m *GetStoreRequest) Reset()         { *m = GetStoreRequest{} }
 func (m *GetStoreRequest) String() string { return proto.CompactTextString(m) }
 func (*GetStoreRequest) ProtoMessage()    {}
 func (*GetStoreRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{12}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{12}
 }
 func (m *GetStoreRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -842,7 +845,7 @@ func (m *GetStoreResponse) Reset()         { *m = GetStoreResponse{} }
 func (m *GetStoreResponse) String() string { return proto.CompactTextString(m) }
 func (*GetStoreResponse) ProtoMessage()    {}
 func (*GetStoreResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{13}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{13}
 }
 func (m *GetStoreResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -904,7 +907,7 @@ func (m *PutStoreRequest) Reset()         { *m = PutStoreRequest{} }
 func (m *PutStoreRequest) String() string { return proto.CompactTextString(m) }
 func (*PutStoreRequest) ProtoMessage()    {}
 func (*PutStoreRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{14}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{14}
 }
 func (m *PutStoreRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -958,7 +961,7 @@ func (m *PutStoreResponse) Reset()         { *m = PutStoreResponse{} }
 func (m *PutStoreResponse) String() string { return proto.CompactTextString(m) }
 func (*PutStoreResponse) ProtoMessage()    {}
 func (*PutStoreResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{15}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{15}
 }
 func (m *PutStoreResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1007,7 +1010,7 @@ func (m *GetAllStoresRequest) Reset()         { *m = GetAllStoresRequest{} }
 func (m *GetAllStoresRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAllStoresRequest) ProtoMessage()    {}
 func (*GetAllStoresRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{16}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{16}
 }
 func (m *GetAllStoresRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1062,7 +1065,7 @@ func (m *GetAllStoresResponse) Reset()         { *m = GetAllStoresResponse{} }
 func (m *GetAllStoresResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAllStoresResponse) ProtoMessage()    {}
 func (*GetAllStoresResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{17}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{17}
 }
 func (m *GetAllStoresResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1117,7 +1120,7 @@ func (m *GetRegionRequest) Reset()         { *m = GetRegionRequest{} }
 func (m *GetRegionRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRegionRequest) ProtoMessage()    {}
 func (*GetRegionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{18}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{18}
 }
 func (m *GetRegionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1174,7 +1177,7 @@ func (m *GetRegionResponse) Reset()         { *m = GetRegionResponse{} }
 func (m *GetRegionResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRegionResponse) ProtoMessage()    {}
 func (*GetRegionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{19}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{19}
 }
 func (m *GetRegionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1246,7 @@ func (m *GetRegionByIDRequest) Reset()         { *m = GetRegionByIDRequest{} }
 func (m *GetRegionByIDRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRegionByIDRequest) ProtoMessage()    {}
 func (*GetRegionByIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{20}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{20}
 }
 func (m *GetRegionByIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1300,7 +1303,7 @@ func (m *ScanRegionsRequest) Reset()         { *m = ScanRegionsRequest{} }
 func (m *ScanRegionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ScanRegionsRequest) ProtoMessage()    {}
 func (*ScanRegionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{21}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{21}
 }
 func (m *ScanRegionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1370,7 +1373,7 @@ func (m *ScanRegionsResponse) Reset()         { *m = ScanRegionsResponse{} }
 func (m *ScanRegionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ScanRegionsResponse) ProtoMessage()    {}
 func (*ScanRegionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{22}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{22}
 }
 func (m *ScanRegionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1420,6 +1423,126 @@ func (m *ScanRegionsResponse) GetLeaders() []*metapb.Peer {
 	return nil
 }
 
+type BatchGetRegionsRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	RegionKeys           [][]byte       `protobuf:"bytes,2,rep,name=region_keys,json=regionKeys" json:"region_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BatchGetRegionsRequest) Reset()         { *m = BatchGetRegionsRequest{} }
+func (m *BatchGetRegionsRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchGetRegionsRequest) ProtoMessage()    {}
+func (*BatchGetRegionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{23}
+}
+func (m *BatchGetRegionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchGetRegionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchGetRegionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *BatchGetRegionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchGetRegionsRequest.Merge(dst, src)
+}
+func (m *BatchGetRegionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchGetRegionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchGetRegionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchGetRegionsRequest proto.InternalMessageInfo
+
+func (m *BatchGetRegionsRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BatchGetRegionsRequest) GetRegionKeys() [][]byte {
+	if m != nil {
+		return m.RegionKeys
+	}
+	return nil
+}
+
+type BatchGetRegionsResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	// Regions containing the requested keys, deduplicated and in the order
+	// they are first hit. Keys not covered by any region are skipped.
+	Regions              []*metapb.Region `protobuf:"bytes,2,rep,name=regions" json:"regions,omitempty"`
+	Leaders              []*metapb.Peer   `protobuf:"bytes,3,rep,name=leaders" json:"leaders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *BatchGetRegionsResponse) Reset()         { *m = BatchGetRegionsResponse{} }
+func (m *BatchGetRegionsResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchGetRegionsResponse) ProtoMessage()    {}
+func (*BatchGetRegionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{24}
+}
+func (m *BatchGetRegionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchGetRegionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchGetRegionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *BatchGetRegionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchGetRegionsResponse.Merge(dst, src)
+}
+func (m *BatchGetRegionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchGetRegionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchGetRegionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchGetRegionsResponse proto.InternalMessageInfo
+
+func (m *BatchGetRegionsResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BatchGetRegionsResponse) GetRegions() []*metapb.Region {
+	if m != nil {
+		return m.Regions
+	}
+	return nil
+}
+
+func (m *BatchGetRegionsResponse) GetLeaders() []*metapb.Peer {
+	if m != nil {
+		return m.Leaders
+	}
+	return nil
+}
+
 type GetClusterConfigRequest struct {
 	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -1431,7 +1554,7 @@ func (m *GetClusterConfigRequest) Reset()         { *m = GetClusterConfigRequest
 func (m *GetClusterConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*GetClusterConfigRequest) ProtoMessage()    {}
 func (*GetClusterConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{23}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{25}
 }
 func (m *GetClusterConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1479,7 +1602,7 @@ func (m *GetClusterConfigResponse) Reset()         { *m = GetClusterConfigRespon
 func (m *GetClusterConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*GetClusterConfigResponse) ProtoMessage()    {}
 func (*GetClusterConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{24}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{26}
 }
 func (m *GetClusterConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1534,7 +1657,7 @@ func (m *PutClusterConfigRequest) Reset()         { *m = PutClusterConfigRequest
 func (m *PutClusterConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*PutClusterConfigRequest) ProtoMessage()    {}
 func (*PutClusterConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{25}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{27}
 }
 func (m *PutClusterConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1588,7 +1711,7 @@ func (m *PutClusterConfigResponse) Reset()         { *m = PutClusterConfigRespon
 func (m *PutClusterConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*PutClusterConfigResponse) ProtoMessage()    {}
 func (*PutClusterConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{26}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{28}
 }
 func (m *PutClusterConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1641,7 +1764,7 @@ func (m *Member) Reset()         { *m = Member{} }
 func (m *Member) String() string { return proto.CompactTextString(m) }
 func (*Member) ProtoMessage()    {}
 func (*Member) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{27}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{29}
 }
 func (m *Member) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1716,7 +1839,7 @@ func (m *GetMembersRequest) Reset()         { *m = GetMembersRequest{} }
 func (m *GetMembersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMembersRequest) ProtoMessage()    {}
 func (*GetMembersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{28}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{30}
 }
 func (m *GetMembersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1766,7 +1889,7 @@ func (m *GetMembersResponse) Reset()         { *m = GetMembersResponse{} }
 func (m *GetMembersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMembersResponse) ProtoMessage()    {}
 func (*GetMembersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{29}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{31}
 }
 func (m *GetMembersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1835,7 +1958,7 @@ func (m *PeerStats) Reset()         { *m = PeerStats{} }
 func (m *PeerStats) String() string { return proto.CompactTextString(m) }
 func (*PeerStats) ProtoMessage()    {}
 func (*PeerStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{30}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{32}
 }
 func (m *PeerStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1911,7 +2034,7 @@ func (m *RegionHeartbeatRequest) Reset()         { *m = RegionHeartbeatRequest{}
 func (m *RegionHeartbeatRequest) String() string { return proto.CompactTextString(m) }
 func (*RegionHeartbeatRequest) ProtoMessage()    {}
 func (*RegionHeartbeatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{31}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{33}
 }
 func (m *RegionHeartbeatRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2032,18 +2155,21 @@ func (m *RegionHeartbeatRequest) GetTerm() uint64 {
 }
 
 type ChangePeer struct {
-	Peer                 *metapb.Peer           `protobuf:"bytes,1,opt,name=peer" json:"peer,omitempty"`
-	ChangeType           eraftpb.ConfChangeType `protobuf:"varint,2,opt,name=change_type,json=changeType,proto3,enum=eraftpb.ConfChangeType" json:"change_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	Peer       *metapb.Peer           `protobuf:"bytes,1,opt,name=peer" json:"peer,omitempty"`
+	ChangeType eraftpb.ConfChangeType `protobuf:"varint,2,opt,name=change_type,json=changeType,proto3,enum=eraftpb.ConfChangeType" json:"change_type,omitempty"`
+	// true means the peer is added to repair an under-replicated region, its snapshot
+	// is generated and sent before the ones for rebalancing.
+	Repair               bool     `protobuf:"varint,3,opt,name=repair,proto3" json:"repair,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ChangePeer) Reset()         { *m = ChangePeer{} }
 func (m *ChangePeer) String() string { return proto.CompactTextString(m) }
 func (*ChangePeer) ProtoMessage()    {}
 func (*ChangePeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{32}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{34}
 }
 func (m *ChangePeer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2086,6 +2212,13 @@ func (m *ChangePeer) GetChangeType() eraftpb.ConfChangeType {
 	return eraftpb.ConfChangeType_AddNode
 }
 
+func (m *ChangePeer) GetRepair() bool {
+	if m != nil {
+		return m.Repair
+	}
+	return false
+}
+
 type TransferLeader struct {
 	Peer                 *metapb.Peer `protobuf:"bytes,1,opt,name=peer" json:"peer,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -2097,7 +2230,7 @@ func (m *TransferLeader) Reset()         { *m = TransferLeader{} }
 func (m *TransferLeader) String() string { return proto.CompactTextString(m) }
 func (*TransferLeader) ProtoMessage()    {}
 func (*TransferLeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{33}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{35}
 }
 func (m *TransferLeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2144,7 +2277,7 @@ func (m *Merge) Reset()         { *m = Merge{} }
 func (m *Merge) String() string { return proto.CompactTextString(m) }
 func (*Merge) ProtoMessage()    {}
 func (*Merge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{34}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{36}
 }
 func (m *Merge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2192,7 +2325,7 @@ func (m *SplitRegion) Reset()         { *m = SplitRegion{} }
 func (m *SplitRegion) String() string { return proto.CompactTextString(m) }
 func (*SplitRegion) ProtoMessage()    {}
 func (*SplitRegion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{35}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{37}
 }
 func (m *SplitRegion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2261,17 +2394,21 @@ type RegionHeartbeatResponse struct {
 	TargetPeer *metapb.Peer `protobuf:"bytes,6,opt,name=target_peer,json=targetPeer" json:"target_peer,omitempty"`
 	Merge      *Merge       `protobuf:"bytes,7,opt,name=merge" json:"merge,omitempty"`
 	// PD sends split_region to let TiKV split a region into two regions.
-	SplitRegion          *SplitRegion `protobuf:"bytes,8,opt,name=split_region,json=splitRegion" json:"split_region,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	SplitRegion *SplitRegion `protobuf:"bytes,8,opt,name=split_region,json=splitRegion" json:"split_region,omitempty"`
+	// The operator and the index of its step the command is sent for. TiKV reports
+	// the result of the command with them in the store heartbeat.
+	OperatorId           uint64   `protobuf:"varint,9,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	OperatorStep         uint32   `protobuf:"varint,10,opt,name=operator_step,json=operatorStep,proto3" json:"operator_step,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RegionHeartbeatResponse) Reset()         { *m = RegionHeartbeatResponse{} }
 func (m *RegionHeartbeatResponse) String() string { return proto.CompactTextString(m) }
 func (*RegionHeartbeatResponse) ProtoMessage()    {}
 func (*RegionHeartbeatResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{36}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{38}
 }
 func (m *RegionHeartbeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2356,6 +2493,20 @@ func (m *RegionHeartbeatResponse) GetSplitRegion() *SplitRegion {
 	return nil
 }
 
+func (m *RegionHeartbeatResponse) GetOperatorId() uint64 {
+	if m != nil {
+		return m.OperatorId
+	}
+	return 0
+}
+
+func (m *RegionHeartbeatResponse) GetOperatorStep() uint32 {
+	if m != nil {
+		return m.OperatorStep
+	}
+	return 0
+}
+
 type AskSplitRequest struct {
 	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
 	Region               *metapb.Region `protobuf:"bytes,2,opt,name=region" json:"region,omitempty"`
@@ -2368,7 +2519,7 @@ func (m *AskSplitRequest) Reset()         { *m = AskSplitRequest{} }
 func (m *AskSplitRequest) String() string { return proto.CompactTextString(m) }
 func (*AskSplitRequest) ProtoMessage()    {}
 func (*AskSplitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{37}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{39}
 }
 func (m *AskSplitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2428,7 +2579,7 @@ func (m *AskSplitResponse) Reset()         { *m = AskSplitResponse{} }
 func (m *AskSplitResponse) String() string { return proto.CompactTextString(m) }
 func (*AskSplitResponse) ProtoMessage()    {}
 func (*AskSplitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{38}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{40}
 }
 func (m *AskSplitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2491,7 +2642,7 @@ func (m *ReportSplitRequest) Reset()         { *m = ReportSplitRequest{} }
 func (m *ReportSplitRequest) String() string { return proto.CompactTextString(m) }
 func (*ReportSplitRequest) ProtoMessage()    {}
 func (*ReportSplitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{39}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{41}
 }
 func (m *ReportSplitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2552,7 +2703,7 @@ func (m *ReportSplitResponse) Reset()         { *m = ReportSplitResponse{} }
 func (m *ReportSplitResponse) String() string { return proto.CompactTextString(m) }
 func (*ReportSplitResponse) ProtoMessage()    {}
 func (*ReportSplitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{40}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{42}
 }
 func (m *ReportSplitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2601,7 +2752,7 @@ func (m *AskBatchSplitRequest) Reset()         { *m = AskBatchSplitRequest{} }
 func (m *AskBatchSplitRequest) String() string { return proto.CompactTextString(m) }
 func (*AskBatchSplitRequest) ProtoMessage()    {}
 func (*AskBatchSplitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{41}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{43}
 }
 func (m *AskBatchSplitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2663,7 +2814,7 @@ func (m *SplitID) Reset()         { *m = SplitID{} }
 func (m *SplitID) String() string { return proto.CompactTextString(m) }
 func (*SplitID) ProtoMessage()    {}
 func (*SplitID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{42}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{44}
 }
 func (m *SplitID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2718,7 +2869,7 @@ func (m *AskBatchSplitResponse) Reset()         { *m = AskBatchSplitResponse{} }
 func (m *AskBatchSplitResponse) String() string { return proto.CompactTextString(m) }
 func (*AskBatchSplitResponse) ProtoMessage()    {}
 func (*AskBatchSplitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{43}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{45}
 }
 func (m *AskBatchSplitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2773,7 +2924,7 @@ func (m *ReportBatchSplitRequest) Reset()         { *m = ReportBatchSplitRequest
 func (m *ReportBatchSplitRequest) String() string { return proto.CompactTextString(m) }
 func (*ReportBatchSplitRequest) ProtoMessage()    {}
 func (*ReportBatchSplitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{44}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{46}
 }
 func (m *ReportBatchSplitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2827,7 +2978,7 @@ func (m *ReportBatchSplitResponse) Reset()         { *m = ReportBatchSplitRespon
 func (m *ReportBatchSplitResponse) String() string { return proto.CompactTextString(m) }
 func (*ReportBatchSplitResponse) ProtoMessage()    {}
 func (*ReportBatchSplitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{45}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{47}
 }
 func (m *ReportBatchSplitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2877,7 +3028,7 @@ func (m *TimeInterval) Reset()         { *m = TimeInterval{} }
 func (m *TimeInterval) String() string { return proto.CompactTextString(m) }
 func (*TimeInterval) ProtoMessage()    {}
 func (*TimeInterval) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{46}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{48}
 }
 func (m *TimeInterval) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2932,7 +3083,7 @@ func (m *RecordPair) Reset()         { *m = RecordPair{} }
 func (m *RecordPair) String() string { return proto.CompactTextString(m) }
 func (*RecordPair) ProtoMessage()    {}
 func (*RecordPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{47}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{49}
 }
 func (m *RecordPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3012,17 +3163,20 @@ type StoreStats struct {
 	// Threads' write disk I/O rates in the store
 	WriteIoRates []*RecordPair `protobuf:"bytes,18,rep,name=write_io_rates,json=writeIoRates" json:"write_io_rates,omitempty"`
 	// Operations' latencies in the store
-	OpLatencies          []*RecordPair `protobuf:"bytes,19,rep,name=op_latencies,json=opLatencies" json:"op_latencies,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	OpLatencies []*RecordPair `protobuf:"bytes,19,rep,name=op_latencies,json=opLatencies" json:"op_latencies,omitempty"`
+	// How long the store should be regarded as busy (in milliseconds), no new
+	// replicas should be added to it meanwhile.
+	BusyBackoffMs        uint64   `protobuf:"varint,20,opt,name=busy_backoff_ms,json=busyBackoffMs,proto3" json:"busy_backoff_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *StoreStats) Reset()         { *m = StoreStats{} }
 func (m *StoreStats) String() string { return proto.CompactTextString(m) }
 func (*StoreStats) ProtoMessage()    {}
 func (*StoreStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{48}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{50}
 }
 func (m *StoreStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3184,19 +3338,109 @@ func (m *StoreStats) GetOpLatencies() []*RecordPair {
 	return nil
 }
 
+func (m *StoreStats) GetBusyBackoffMs() uint64 {
+	if m != nil {
+		return m.BusyBackoffMs
+	}
+	return 0
+}
+
+// OperatorResult is the result of a command sent by PD for a step of an operator.
+type OperatorResult struct {
+	RegionId     uint64 `protobuf:"varint,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	OperatorId   uint64 `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	OperatorStep uint32 `protobuf:"varint,3,opt,name=operator_step,json=operatorStep,proto3" json:"operator_step,omitempty"`
+	Success      bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// The reason why the command failed.
+	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OperatorResult) Reset()         { *m = OperatorResult{} }
+func (m *OperatorResult) String() string { return proto.CompactTextString(m) }
+func (*OperatorResult) ProtoMessage()    {}
+func (*OperatorResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{51}
+}
+func (m *OperatorResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *OperatorResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorResult.Merge(dst, src)
+}
+func (m *OperatorResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorResult proto.InternalMessageInfo
+
+func (m *OperatorResult) GetRegionId() uint64 {
+	if m != nil {
+		return m.RegionId
+	}
+	return 0
+}
+
+func (m *OperatorResult) GetOperatorId() uint64 {
+	if m != nil {
+		return m.OperatorId
+	}
+	return 0
+}
+
+func (m *OperatorResult) GetOperatorStep() uint32 {
+	if m != nil {
+		return m.OperatorStep
+	}
+	return 0
+}
+
+func (m *OperatorResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *OperatorResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 type StoreHeartbeatRequest struct {
-	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
-	Stats                *StoreStats    `protobuf:"bytes,2,opt,name=stats" json:"stats,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Header *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Stats  *StoreStats    `protobuf:"bytes,2,opt,name=stats" json:"stats,omitempty"`
+	// The results of the operator commands finished since the last heartbeat.
+	OperatorResults      []*OperatorResult `protobuf:"bytes,3,rep,name=operator_results,json=operatorResults" json:"operator_results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *StoreHeartbeatRequest) Reset()         { *m = StoreHeartbeatRequest{} }
 func (m *StoreHeartbeatRequest) String() string { return proto.CompactTextString(m) }
 func (*StoreHeartbeatRequest) ProtoMessage()    {}
 func (*StoreHeartbeatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{49}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{52}
 }
 func (m *StoreHeartbeatRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3239,18 +3483,30 @@ func (m *StoreHeartbeatRequest) GetStats() *StoreStats {
 	return nil
 }
 
+func (m *StoreHeartbeatRequest) GetOperatorResults() []*OperatorResult {
+	if m != nil {
+		return m.OperatorResults
+	}
+	return nil
+}
+
 type StoreHeartbeatResponse struct {
-	Header               *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	// The stores reject the writes to the whole cluster, or to the read_only_ranges.
+	ClusterReadOnly bool        `protobuf:"varint,2,opt,name=cluster_read_only,json=clusterReadOnly,proto3" json:"cluster_read_only,omitempty"`
+	ReadOnlyRanges  []*KeyRange `protobuf:"bytes,3,rep,name=read_only_ranges,json=readOnlyRanges" json:"read_only_ranges,omitempty"`
+	// The resource groups the requests to the stores are scheduled by.
+	ResourceGroups       []*ResourceGroup `protobuf:"bytes,4,rep,name=resource_groups,json=resourceGroups" json:"resource_groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *StoreHeartbeatResponse) Reset()         { *m = StoreHeartbeatResponse{} }
 func (m *StoreHeartbeatResponse) String() string { return proto.CompactTextString(m) }
 func (*StoreHeartbeatResponse) ProtoMessage()    {}
 func (*StoreHeartbeatResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{50}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{53}
 }
 func (m *StoreHeartbeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3286,6 +3542,27 @@ func (m *StoreHeartbeatResponse) GetHeader() *ResponseHeader {
 	return nil
 }
 
+func (m *StoreHeartbeatResponse) GetClusterReadOnly() bool {
+	if m != nil {
+		return m.ClusterReadOnly
+	}
+	return false
+}
+
+func (m *StoreHeartbeatResponse) GetReadOnlyRanges() []*KeyRange {
+	if m != nil {
+		return m.ReadOnlyRanges
+	}
+	return nil
+}
+
+func (m *StoreHeartbeatResponse) GetResourceGroups() []*ResourceGroup {
+	if m != nil {
+		return m.ResourceGroups
+	}
+	return nil
+}
+
 type ScatterRegionRequest struct {
 	Header   *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
 	RegionId uint64         `protobuf:"varint,2,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
@@ -3302,7 +3579,7 @@ func (m *ScatterRegionRequest) Reset()         { *m = ScatterRegionRequest{} }
 func (m *ScatterRegionRequest) String() string { return proto.CompactTextString(m) }
 func (*ScatterRegionRequest) ProtoMessage()    {}
 func (*ScatterRegionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{51}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{54}
 }
 func (m *ScatterRegionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3370,7 +3647,7 @@ func (m *ScatterRegionResponse) Reset()         { *m = ScatterRegionResponse{} }
 func (m *ScatterRegionResponse) String() string { return proto.CompactTextString(m) }
 func (*ScatterRegionResponse) ProtoMessage()    {}
 func (*ScatterRegionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{52}
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{55}
 }
 func (m *ScatterRegionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3406,25 +3683,28 @@ func (m *ScatterRegionResponse) GetHeader() *ResponseHeader {
 	return nil
 }
 
-type GetGCSafePointRequest struct {
-	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type SetSplitKeysRequest struct {
+	Header *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	// Keys no region should span, e.g. the prefix of every table. They are in the
+	// same encoding as region keys. The keys replace the ones set before.
+	Keys                 [][]byte `protobuf:"bytes,2,rep,name=keys" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetGCSafePointRequest) Reset()         { *m = GetGCSafePointRequest{} }
-func (m *GetGCSafePointRequest) String() string { return proto.CompactTextString(m) }
-func (*GetGCSafePointRequest) ProtoMessage()    {}
-func (*GetGCSafePointRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{53}
+func (m *SetSplitKeysRequest) Reset()         { *m = SetSplitKeysRequest{} }
+func (m *SetSplitKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*SetSplitKeysRequest) ProtoMessage()    {}
+func (*SetSplitKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{56}
 }
-func (m *GetGCSafePointRequest) XXX_Unmarshal(b []byte) error {
+func (m *SetSplitKeysRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetGCSafePointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetSplitKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetGCSafePointRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetSplitKeysRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -3434,45 +3714,51 @@ func (m *GetGCSafePointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (dst *GetGCSafePointRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetGCSafePointRequest.Merge(dst, src)
+func (dst *SetSplitKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetSplitKeysRequest.Merge(dst, src)
 }
-func (m *GetGCSafePointRequest) XXX_Size() int {
+func (m *SetSplitKeysRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetGCSafePointRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetGCSafePointRequest.DiscardUnknown(m)
+func (m *SetSplitKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetSplitKeysRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetGCSafePointRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetSplitKeysRequest proto.InternalMessageInfo
 
-func (m *GetGCSafePointRequest) GetHeader() *RequestHeader {
+func (m *SetSplitKeysRequest) GetHeader() *RequestHeader {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-type GetGCSafePointResponse struct {
+func (m *SetSplitKeysRequest) GetKeys() [][]byte {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type SetSplitKeysResponse struct {
 	Header               *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
-	SafePoint            uint64          `protobuf:"varint,2,opt,name=safe_point,json=safePoint,proto3" json:"safe_point,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *GetGCSafePointResponse) Reset()         { *m = GetGCSafePointResponse{} }
-func (m *GetGCSafePointResponse) String() string { return proto.CompactTextString(m) }
-func (*GetGCSafePointResponse) ProtoMessage()    {}
-func (*GetGCSafePointResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{54}
+func (m *SetSplitKeysResponse) Reset()         { *m = SetSplitKeysResponse{} }
+func (m *SetSplitKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*SetSplitKeysResponse) ProtoMessage()    {}
+func (*SetSplitKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{57}
 }
-func (m *GetGCSafePointResponse) XXX_Unmarshal(b []byte) error {
+func (m *SetSplitKeysResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetGCSafePointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetSplitKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetGCSafePointResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetSplitKeysResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -3482,52 +3768,46 @@ func (m *GetGCSafePointResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (dst *GetGCSafePointResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetGCSafePointResponse.Merge(dst, src)
+func (dst *SetSplitKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetSplitKeysResponse.Merge(dst, src)
 }
-func (m *GetGCSafePointResponse) XXX_Size() int {
+func (m *SetSplitKeysResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetGCSafePointResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetGCSafePointResponse.DiscardUnknown(m)
+func (m *SetSplitKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetSplitKeysResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetGCSafePointResponse proto.InternalMessageInfo
+var xxx_messageInfo_SetSplitKeysResponse proto.InternalMessageInfo
 
-func (m *GetGCSafePointResponse) GetHeader() *ResponseHeader {
+func (m *SetSplitKeysResponse) GetHeader() *ResponseHeader {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *GetGCSafePointResponse) GetSafePoint() uint64 {
-	if m != nil {
-		return m.SafePoint
-	}
-	return 0
+type KeyRange struct {
+	StartKey []byte `protobuf:"bytes,1,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	// An empty end_key means the range is unbounded.
+	EndKey               []byte   `protobuf:"bytes,2,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type UpdateGCSafePointRequest struct {
-	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
-	SafePoint            uint64         `protobuf:"varint,2,opt,name=safe_point,json=safePoint,proto3" json:"safe_point,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *KeyRange) Reset()         { *m = KeyRange{} }
+func (m *KeyRange) String() string { return proto.CompactTextString(m) }
+func (*KeyRange) ProtoMessage()    {}
+func (*KeyRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{58}
 }
-
-func (m *UpdateGCSafePointRequest) Reset()         { *m = UpdateGCSafePointRequest{} }
-func (m *UpdateGCSafePointRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateGCSafePointRequest) ProtoMessage()    {}
-func (*UpdateGCSafePointRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{55}
-}
-func (m *UpdateGCSafePointRequest) XXX_Unmarshal(b []byte) error {
+func (m *KeyRange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdateGCSafePointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *KeyRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdateGCSafePointRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_KeyRange.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -3537,52 +3817,56 @@ func (m *UpdateGCSafePointRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (dst *UpdateGCSafePointRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateGCSafePointRequest.Merge(dst, src)
+func (dst *KeyRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyRange.Merge(dst, src)
 }
-func (m *UpdateGCSafePointRequest) XXX_Size() int {
+func (m *KeyRange) XXX_Size() int {
 	return m.Size()
 }
-func (m *UpdateGCSafePointRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateGCSafePointRequest.DiscardUnknown(m)
+func (m *KeyRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyRange.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateGCSafePointRequest proto.InternalMessageInfo
+var xxx_messageInfo_KeyRange proto.InternalMessageInfo
 
-func (m *UpdateGCSafePointRequest) GetHeader() *RequestHeader {
+func (m *KeyRange) GetStartKey() []byte {
 	if m != nil {
-		return m.Header
+		return m.StartKey
 	}
 	return nil
 }
 
-func (m *UpdateGCSafePointRequest) GetSafePoint() uint64 {
+func (m *KeyRange) GetEndKey() []byte {
 	if m != nil {
-		return m.SafePoint
+		return m.EndKey
 	}
-	return 0
+	return nil
 }
 
-type UpdateGCSafePointResponse struct {
-	Header               *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
-	NewSafePoint         uint64          `protobuf:"varint,2,opt,name=new_safe_point,json=newSafePoint,proto3" json:"new_safe_point,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type SetReadOnlyRequest struct {
+	Header *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	// Rejects the writes to the whole cluster.
+	Cluster bool `protobuf:"varint,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Rejects the writes to the regions overlapping the ranges. They are in the same
+	// encoding as region keys. The ranges replace the ones set before.
+	Ranges               []*KeyRange `protobuf:"bytes,3,rep,name=ranges" json:"ranges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *UpdateGCSafePointResponse) Reset()         { *m = UpdateGCSafePointResponse{} }
-func (m *UpdateGCSafePointResponse) String() string { return proto.CompactTextString(m) }
-func (*UpdateGCSafePointResponse) ProtoMessage()    {}
-func (*UpdateGCSafePointResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{56}
+func (m *SetReadOnlyRequest) Reset()         { *m = SetReadOnlyRequest{} }
+func (m *SetReadOnlyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetReadOnlyRequest) ProtoMessage()    {}
+func (*SetReadOnlyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{59}
 }
-func (m *UpdateGCSafePointResponse) XXX_Unmarshal(b []byte) error {
+func (m *SetReadOnlyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdateGCSafePointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetReadOnlyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdateGCSafePointResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetReadOnlyRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -3592,55 +3876,58 @@ func (m *UpdateGCSafePointResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (dst *UpdateGCSafePointResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateGCSafePointResponse.Merge(dst, src)
+func (dst *SetReadOnlyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetReadOnlyRequest.Merge(dst, src)
 }
-func (m *UpdateGCSafePointResponse) XXX_Size() int {
+func (m *SetReadOnlyRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *UpdateGCSafePointResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateGCSafePointResponse.DiscardUnknown(m)
+func (m *SetReadOnlyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetReadOnlyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateGCSafePointResponse proto.InternalMessageInfo
+var xxx_messageInfo_SetReadOnlyRequest proto.InternalMessageInfo
 
-func (m *UpdateGCSafePointResponse) GetHeader() *ResponseHeader {
+func (m *SetReadOnlyRequest) GetHeader() *RequestHeader {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *UpdateGCSafePointResponse) GetNewSafePoint() uint64 {
+func (m *SetReadOnlyRequest) GetCluster() bool {
 	if m != nil {
-		return m.NewSafePoint
+		return m.Cluster
 	}
-	return 0
+	return false
 }
 
-type SyncRegionRequest struct {
-	Header *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
-	Member *Member        `protobuf:"bytes,2,opt,name=member" json:"member,omitempty"`
-	// the follower PD will use the start index to locate historical changes
-	// that require synchronization.
-	StartIndex           uint64   `protobuf:"varint,3,opt,name=start_index,json=startIndex,proto3" json:"start_index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *SetReadOnlyRequest) GetRanges() []*KeyRange {
+	if m != nil {
+		return m.Ranges
+	}
+	return nil
 }
 
-func (m *SyncRegionRequest) Reset()         { *m = SyncRegionRequest{} }
-func (m *SyncRegionRequest) String() string { return proto.CompactTextString(m) }
-func (*SyncRegionRequest) ProtoMessage()    {}
-func (*SyncRegionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{57}
+type SetReadOnlyResponse struct {
+	Header               *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
-func (m *SyncRegionRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *SetReadOnlyResponse) Reset()         { *m = SetReadOnlyResponse{} }
+func (m *SetReadOnlyResponse) String() string { return proto.CompactTextString(m) }
+func (*SetReadOnlyResponse) ProtoMessage()    {}
+func (*SetReadOnlyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{60}
+}
+func (m *SetReadOnlyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SyncRegionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetReadOnlyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SyncRegionRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetReadOnlyResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -3650,62 +3937,103 @@ func (m *SyncRegionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (dst *SyncRegionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SyncRegionRequest.Merge(dst, src)
+func (dst *SetReadOnlyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetReadOnlyResponse.Merge(dst, src)
 }
-func (m *SyncRegionRequest) XXX_Size() int {
+func (m *SetReadOnlyResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *SyncRegionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SyncRegionRequest.DiscardUnknown(m)
+func (m *SetReadOnlyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetReadOnlyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SyncRegionRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetReadOnlyResponse proto.InternalMessageInfo
 
-func (m *SyncRegionRequest) GetHeader() *RequestHeader {
+func (m *SetReadOnlyResponse) GetHeader() *ResponseHeader {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *SyncRegionRequest) GetMember() *Member {
+// A resource group of the requests which name it in their context. Under contention, the stores serve the groups in
+// proportion to their weights.
+type ResourceGroup struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Weight               uint64   `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceGroup) Reset()         { *m = ResourceGroup{} }
+func (m *ResourceGroup) String() string { return proto.CompactTextString(m) }
+func (*ResourceGroup) ProtoMessage()    {}
+func (*ResourceGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{61}
+}
+func (m *ResourceGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *ResourceGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceGroup.Merge(dst, src)
+}
+func (m *ResourceGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceGroup proto.InternalMessageInfo
+
+func (m *ResourceGroup) GetName() string {
 	if m != nil {
-		return m.Member
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *SyncRegionRequest) GetStartIndex() uint64 {
+func (m *ResourceGroup) GetWeight() uint64 {
 	if m != nil {
-		return m.StartIndex
+		return m.Weight
 	}
 	return 0
 }
 
-type SyncRegionResponse struct {
-	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
-	// the leader PD will send the repsonds include
-	// changed regions records and the index of the first record.
-	Regions              []*metapb.Region `protobuf:"bytes,2,rep,name=regions" json:"regions,omitempty"`
-	StartIndex           uint64           `protobuf:"varint,3,opt,name=start_index,json=startIndex,proto3" json:"start_index,omitempty"`
+type SetResourceGroupsRequest struct {
+	Header *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	// The groups replace the ones set before.
+	Groups               []*ResourceGroup `protobuf:"bytes,2,rep,name=groups" json:"groups,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *SyncRegionResponse) Reset()         { *m = SyncRegionResponse{} }
-func (m *SyncRegionResponse) String() string { return proto.CompactTextString(m) }
-func (*SyncRegionResponse) ProtoMessage()    {}
-func (*SyncRegionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{58}
+func (m *SetResourceGroupsRequest) Reset()         { *m = SetResourceGroupsRequest{} }
+func (m *SetResourceGroupsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetResourceGroupsRequest) ProtoMessage()    {}
+func (*SetResourceGroupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{62}
 }
-func (m *SyncRegionResponse) XXX_Unmarshal(b []byte) error {
+func (m *SetResourceGroupsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SyncRegionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetResourceGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SyncRegionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetResourceGroupsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -3715,59 +4043,98 @@ func (m *SyncRegionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (dst *SyncRegionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SyncRegionResponse.Merge(dst, src)
+func (dst *SetResourceGroupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetResourceGroupsRequest.Merge(dst, src)
 }
-func (m *SyncRegionResponse) XXX_Size() int {
+func (m *SetResourceGroupsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *SyncRegionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SyncRegionResponse.DiscardUnknown(m)
+func (m *SetResourceGroupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetResourceGroupsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SyncRegionResponse proto.InternalMessageInfo
+var xxx_messageInfo_SetResourceGroupsRequest proto.InternalMessageInfo
 
-func (m *SyncRegionResponse) GetHeader() *ResponseHeader {
+func (m *SetResourceGroupsRequest) GetHeader() *RequestHeader {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *SyncRegionResponse) GetRegions() []*metapb.Region {
+func (m *SetResourceGroupsRequest) GetGroups() []*ResourceGroup {
 	if m != nil {
-		return m.Regions
+		return m.Groups
 	}
 	return nil
 }
 
-func (m *SyncRegionResponse) GetStartIndex() uint64 {
+type SetResourceGroupsResponse struct {
+	Header               *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SetResourceGroupsResponse) Reset()         { *m = SetResourceGroupsResponse{} }
+func (m *SetResourceGroupsResponse) String() string { return proto.CompactTextString(m) }
+func (*SetResourceGroupsResponse) ProtoMessage()    {}
+func (*SetResourceGroupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{63}
+}
+func (m *SetResourceGroupsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetResourceGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetResourceGroupsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *SetResourceGroupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetResourceGroupsResponse.Merge(dst, src)
+}
+func (m *SetResourceGroupsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetResourceGroupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetResourceGroupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetResourceGroupsResponse proto.InternalMessageInfo
+
+func (m *SetResourceGroupsResponse) GetHeader() *ResponseHeader {
 	if m != nil {
-		return m.StartIndex
+		return m.Header
 	}
-	return 0
+	return nil
 }
 
-type GetOperatorRequest struct {
+type GetGCSafePointRequest struct {
 	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
-	RegionId             uint64         `protobuf:"varint,2,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *GetOperatorRequest) Reset()         { *m = GetOperatorRequest{} }
-func (m *GetOperatorRequest) String() string { return proto.CompactTextString(m) }
-func (*GetOperatorRequest) ProtoMessage()    {}
-func (*GetOperatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{59}
+func (m *GetGCSafePointRequest) Reset()         { *m = GetGCSafePointRequest{} }
+func (m *GetGCSafePointRequest) String() string { return proto.CompactTextString(m) }
+func (*GetGCSafePointRequest) ProtoMessage()    {}
+func (*GetGCSafePointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{64}
 }
-func (m *GetOperatorRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetGCSafePointRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetOperatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetGCSafePointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetOperatorRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetGCSafePointRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -3777,55 +4144,45 @@ func (m *GetOperatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (dst *GetOperatorRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetOperatorRequest.Merge(dst, src)
+func (dst *GetGCSafePointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGCSafePointRequest.Merge(dst, src)
 }
-func (m *GetOperatorRequest) XXX_Size() int {
+func (m *GetGCSafePointRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetOperatorRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetOperatorRequest.DiscardUnknown(m)
+func (m *GetGCSafePointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGCSafePointRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetOperatorRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetGCSafePointRequest proto.InternalMessageInfo
 
-func (m *GetOperatorRequest) GetHeader() *RequestHeader {
+func (m *GetGCSafePointRequest) GetHeader() *RequestHeader {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *GetOperatorRequest) GetRegionId() uint64 {
-	if m != nil {
-		return m.RegionId
-	}
-	return 0
-}
-
-type GetOperatorResponse struct {
+type GetGCSafePointResponse struct {
 	Header               *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
-	RegionId             uint64          `protobuf:"varint,2,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
-	Desc                 []byte          `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
-	Status               OperatorStatus  `protobuf:"varint,4,opt,name=status,proto3,enum=pdpb.OperatorStatus" json:"status,omitempty"`
-	Kind                 []byte          `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
+	SafePoint            uint64          `protobuf:"varint,2,opt,name=safe_point,json=safePoint,proto3" json:"safe_point,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *GetOperatorResponse) Reset()         { *m = GetOperatorResponse{} }
-func (m *GetOperatorResponse) String() string { return proto.CompactTextString(m) }
-func (*GetOperatorResponse) ProtoMessage()    {}
-func (*GetOperatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pdpb_4ddb592a4d17b00d, []int{60}
+func (m *GetGCSafePointResponse) Reset()         { *m = GetGCSafePointResponse{} }
+func (m *GetGCSafePointResponse) String() string { return proto.CompactTextString(m) }
+func (*GetGCSafePointResponse) ProtoMessage()    {}
+func (*GetGCSafePointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{65}
 }
-func (m *GetOperatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetGCSafePointResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetGCSafePointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetOperatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetGCSafePointResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -3835,1348 +4192,1483 @@ func (m *GetOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (dst *GetOperatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetOperatorResponse.Merge(dst, src)
+func (dst *GetGCSafePointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGCSafePointResponse.Merge(dst, src)
 }
-func (m *GetOperatorResponse) XXX_Size() int {
+func (m *GetGCSafePointResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetOperatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetOperatorResponse.DiscardUnknown(m)
+func (m *GetGCSafePointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGCSafePointResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetOperatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetGCSafePointResponse proto.InternalMessageInfo
 
-func (m *GetOperatorResponse) GetHeader() *ResponseHeader {
+func (m *GetGCSafePointResponse) GetHeader() *ResponseHeader {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *GetOperatorResponse) GetRegionId() uint64 {
+func (m *GetGCSafePointResponse) GetSafePoint() uint64 {
 	if m != nil {
-		return m.RegionId
+		return m.SafePoint
 	}
 	return 0
 }
 
-func (m *GetOperatorResponse) GetDesc() []byte {
-	if m != nil {
-		return m.Desc
-	}
-	return nil
+type UpdateGCSafePointRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	SafePoint            uint64         `protobuf:"varint,2,opt,name=safe_point,json=safePoint,proto3" json:"safe_point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *GetOperatorResponse) GetStatus() OperatorStatus {
-	if m != nil {
-		return m.Status
-	}
-	return OperatorStatus_SUCCESS
+func (m *UpdateGCSafePointRequest) Reset()         { *m = UpdateGCSafePointRequest{} }
+func (m *UpdateGCSafePointRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateGCSafePointRequest) ProtoMessage()    {}
+func (*UpdateGCSafePointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{66}
 }
-
-func (m *GetOperatorResponse) GetKind() []byte {
-	if m != nil {
-		return m.Kind
+func (m *UpdateGCSafePointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateGCSafePointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateGCSafePointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func init() {
-	proto.RegisterType((*RequestHeader)(nil), "pdpb.RequestHeader")
-	proto.RegisterType((*ResponseHeader)(nil), "pdpb.ResponseHeader")
-	proto.RegisterType((*Error)(nil), "pdpb.Error")
-	proto.RegisterType((*TsoRequest)(nil), "pdpb.TsoRequest")
-	proto.RegisterType((*Timestamp)(nil), "pdpb.Timestamp")
-	proto.RegisterType((*TsoResponse)(nil), "pdpb.TsoResponse")
-	proto.RegisterType((*BootstrapRequest)(nil), "pdpb.BootstrapRequest")
-	proto.RegisterType((*BootstrapResponse)(nil), "pdpb.BootstrapResponse")
-	proto.RegisterType((*IsBootstrappedRequest)(nil), "pdpb.IsBootstrappedRequest")
-	proto.RegisterType((*IsBootstrappedResponse)(nil), "pdpb.IsBootstrappedResponse")
-	proto.RegisterType((*AllocIDRequest)(nil), "pdpb.AllocIDRequest")
-	proto.RegisterType((*AllocIDResponse)(nil), "pdpb.AllocIDResponse")
-	proto.RegisterType((*GetStoreRequest)(nil), "pdpb.GetStoreRequest")
-	proto.RegisterType((*GetStoreResponse)(nil), "pdpb.GetStoreResponse")
-	proto.RegisterType((*PutStoreRequest)(nil), "pdpb.PutStoreRequest")
-	proto.RegisterType((*PutStoreResponse)(nil), "pdpb.PutStoreResponse")
-	proto.RegisterType((*GetAllStoresRequest)(nil), "pdpb.GetAllStoresRequest")
-	proto.RegisterType((*GetAllStoresResponse)(nil), "pdpb.GetAllStoresResponse")
-	proto.RegisterType((*GetRegionRequest)(nil), "pdpb.GetRegionRequest")
-	proto.RegisterType((*GetRegionResponse)(nil), "pdpb.GetRegionResponse")
-	proto.RegisterType((*GetRegionByIDRequest)(nil), "pdpb.GetRegionByIDRequest")
-	proto.RegisterType((*ScanRegionsRequest)(nil), "pdpb.ScanRegionsRequest")
-	proto.RegisterType((*ScanRegionsResponse)(nil), "pdpb.ScanRegionsResponse")
-	proto.RegisterType((*GetClusterConfigRequest)(nil), "pdpb.GetClusterConfigRequest")
-	proto.RegisterType((*GetClusterConfigResponse)(nil), "pdpb.GetClusterConfigResponse")
-	proto.RegisterType((*PutClusterConfigRequest)(nil), "pdpb.PutClusterConfigRequest")
-	proto.RegisterType((*PutClusterConfigResponse)(nil), "pdpb.PutClusterConfigResponse")
-	proto.RegisterType((*Member)(nil), "pdpb.Member")
-	proto.RegisterType((*GetMembersRequest)(nil), "pdpb.GetMembersRequest")
-	proto.RegisterType((*GetMembersResponse)(nil), "pdpb.GetMembersResponse")
-	proto.RegisterType((*PeerStats)(nil), "pdpb.PeerStats")
-	proto.RegisterType((*RegionHeartbeatRequest)(nil), "pdpb.RegionHeartbeatRequest")
-	proto.RegisterType((*ChangePeer)(nil), "pdpb.ChangePeer")
-	proto.RegisterType((*TransferLeader)(nil), "pdpb.TransferLeader")
-	proto.RegisterType((*Merge)(nil), "pdpb.Merge")
-	proto.RegisterType((*SplitRegion)(nil), "pdpb.SplitRegion")
-	proto.RegisterType((*RegionHeartbeatResponse)(nil), "pdpb.RegionHeartbeatResponse")
-	proto.RegisterType((*AskSplitRequest)(nil), "pdpb.AskSplitRequest")
-	proto.RegisterType((*AskSplitResponse)(nil), "pdpb.AskSplitResponse")
-	proto.RegisterType((*ReportSplitRequest)(nil), "pdpb.ReportSplitRequest")
-	proto.RegisterType((*ReportSplitResponse)(nil), "pdpb.ReportSplitResponse")
-	proto.RegisterType((*AskBatchSplitRequest)(nil), "pdpb.AskBatchSplitRequest")
-	proto.RegisterType((*SplitID)(nil), "pdpb.SplitID")
-	proto.RegisterType((*AskBatchSplitResponse)(nil), "pdpb.AskBatchSplitResponse")
-	proto.RegisterType((*ReportBatchSplitRequest)(nil), "pdpb.ReportBatchSplitRequest")
-	proto.RegisterType((*ReportBatchSplitResponse)(nil), "pdpb.ReportBatchSplitResponse")
-	proto.RegisterType((*TimeInterval)(nil), "pdpb.TimeInterval")
-	proto.RegisterType((*RecordPair)(nil), "pdpb.RecordPair")
-	proto.RegisterType((*StoreStats)(nil), "pdpb.StoreStats")
-	proto.RegisterType((*StoreHeartbeatRequest)(nil), "pdpb.StoreHeartbeatRequest")
-	proto.RegisterType((*StoreHeartbeatResponse)(nil), "pdpb.StoreHeartbeatResponse")
-	proto.RegisterType((*ScatterRegionRequest)(nil), "pdpb.ScatterRegionRequest")
-	proto.RegisterType((*ScatterRegionResponse)(nil), "pdpb.ScatterRegionResponse")
-	proto.RegisterType((*GetGCSafePointRequest)(nil), "pdpb.GetGCSafePointRequest")
-	proto.RegisterType((*GetGCSafePointResponse)(nil), "pdpb.GetGCSafePointResponse")
-	proto.RegisterType((*UpdateGCSafePointRequest)(nil), "pdpb.UpdateGCSafePointRequest")
-	proto.RegisterType((*UpdateGCSafePointResponse)(nil), "pdpb.UpdateGCSafePointResponse")
-	proto.RegisterType((*SyncRegionRequest)(nil), "pdpb.SyncRegionRequest")
-	proto.RegisterType((*SyncRegionResponse)(nil), "pdpb.SyncRegionResponse")
-	proto.RegisterType((*GetOperatorRequest)(nil), "pdpb.GetOperatorRequest")
-	proto.RegisterType((*GetOperatorResponse)(nil), "pdpb.GetOperatorResponse")
-	proto.RegisterEnum("pdpb.ErrorType", ErrorType_name, ErrorType_value)
-	proto.RegisterEnum("pdpb.CheckPolicy", CheckPolicy_name, CheckPolicy_value)
-	proto.RegisterEnum("pdpb.OperatorStatus", OperatorStatus_name, OperatorStatus_value)
+func (dst *UpdateGCSafePointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateGCSafePointRequest.Merge(dst, src)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// Client API for PD service
-
-type PDClient interface {
-	// GetMembers get the member list of this cluster. It does not require
-	// the cluster_id in request matchs the id of this cluster.
-	GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error)
-	Tso(ctx context.Context, opts ...grpc.CallOption) (PD_TsoClient, error)
-	Bootstrap(ctx context.Context, in *BootstrapRequest, opts ...grpc.CallOption) (*BootstrapResponse, error)
-	IsBootstrapped(ctx context.Context, in *IsBootstrappedRequest, opts ...grpc.CallOption) (*IsBootstrappedResponse, error)
-	AllocID(ctx context.Context, in *AllocIDRequest, opts ...grpc.CallOption) (*AllocIDResponse, error)
-	GetStore(ctx context.Context, in *GetStoreRequest, opts ...grpc.CallOption) (*GetStoreResponse, error)
-	PutStore(ctx context.Context, in *PutStoreRequest, opts ...grpc.CallOption) (*PutStoreResponse, error)
-	GetAllStores(ctx context.Context, in *GetAllStoresRequest, opts ...grpc.CallOption) (*GetAllStoresResponse, error)
-	StoreHeartbeat(ctx context.Context, in *StoreHeartbeatRequest, opts ...grpc.CallOption) (*StoreHeartbeatResponse, error)
-	RegionHeartbeat(ctx context.Context, opts ...grpc.CallOption) (PD_RegionHeartbeatClient, error)
-	GetRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error)
-	GetPrevRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error)
-	GetRegionByID(ctx context.Context, in *GetRegionByIDRequest, opts ...grpc.CallOption) (*GetRegionResponse, error)
-	ScanRegions(ctx context.Context, in *ScanRegionsRequest, opts ...grpc.CallOption) (*ScanRegionsResponse, error)
-	AskBatchSplit(ctx context.Context, in *AskBatchSplitRequest, opts ...grpc.CallOption) (*AskBatchSplitResponse, error)
-	GetClusterConfig(ctx context.Context, in *GetClusterConfigRequest, opts ...grpc.CallOption) (*GetClusterConfigResponse, error)
-	PutClusterConfig(ctx context.Context, in *PutClusterConfigRequest, opts ...grpc.CallOption) (*PutClusterConfigResponse, error)
-	ScatterRegion(ctx context.Context, in *ScatterRegionRequest, opts ...grpc.CallOption) (*ScatterRegionResponse, error)
-	GetGCSafePoint(ctx context.Context, in *GetGCSafePointRequest, opts ...grpc.CallOption) (*GetGCSafePointResponse, error)
-	UpdateGCSafePoint(ctx context.Context, in *UpdateGCSafePointRequest, opts ...grpc.CallOption) (*UpdateGCSafePointResponse, error)
-	SyncRegions(ctx context.Context, opts ...grpc.CallOption) (PD_SyncRegionsClient, error)
-	GetOperator(ctx context.Context, in *GetOperatorRequest, opts ...grpc.CallOption) (*GetOperatorResponse, error)
+func (m *UpdateGCSafePointRequest) XXX_Size() int {
+	return m.Size()
 }
-
-type pDClient struct {
-	cc *grpc.ClientConn
+func (m *UpdateGCSafePointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateGCSafePointRequest.DiscardUnknown(m)
 }
 
-func NewPDClient(cc *grpc.ClientConn) PDClient {
-	return &pDClient{cc}
-}
+var xxx_messageInfo_UpdateGCSafePointRequest proto.InternalMessageInfo
 
-func (c *pDClient) GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error) {
-	out := new(GetMembersResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/GetMembers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateGCSafePointRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
 	}
-	return out, nil
+	return nil
 }
 
-func (c *pDClient) Tso(ctx context.Context, opts ...grpc.CallOption) (PD_TsoClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_PD_serviceDesc.Streams[0], "/pdpb.PD/Tso", opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateGCSafePointRequest) GetSafePoint() uint64 {
+	if m != nil {
+		return m.SafePoint
 	}
-	x := &pDTsoClient{stream}
-	return x, nil
+	return 0
 }
 
-type PD_TsoClient interface {
-	Send(*TsoRequest) error
-	Recv() (*TsoResponse, error)
-	grpc.ClientStream
+type UpdateGCSafePointResponse struct {
+	Header               *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	NewSafePoint         uint64          `protobuf:"varint,2,opt,name=new_safe_point,json=newSafePoint,proto3" json:"new_safe_point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-type pDTsoClient struct {
-	grpc.ClientStream
+func (m *UpdateGCSafePointResponse) Reset()         { *m = UpdateGCSafePointResponse{} }
+func (m *UpdateGCSafePointResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateGCSafePointResponse) ProtoMessage()    {}
+func (*UpdateGCSafePointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{67}
 }
-
-func (x *pDTsoClient) Send(m *TsoRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *UpdateGCSafePointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x *pDTsoClient) Recv() (*TsoResponse, error) {
-	m := new(TsoResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *UpdateGCSafePointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateGCSafePointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
 }
-
-func (c *pDClient) Bootstrap(ctx context.Context, in *BootstrapRequest, opts ...grpc.CallOption) (*BootstrapResponse, error) {
-	out := new(BootstrapResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/Bootstrap", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (dst *UpdateGCSafePointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateGCSafePointResponse.Merge(dst, src)
 }
-
-func (c *pDClient) IsBootstrapped(ctx context.Context, in *IsBootstrappedRequest, opts ...grpc.CallOption) (*IsBootstrappedResponse, error) {
-	out := new(IsBootstrappedResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/IsBootstrapped", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *UpdateGCSafePointResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *pDClient) AllocID(ctx context.Context, in *AllocIDRequest, opts ...grpc.CallOption) (*AllocIDResponse, error) {
-	out := new(AllocIDResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/AllocID", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *UpdateGCSafePointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateGCSafePointResponse.DiscardUnknown(m)
 }
 
-func (c *pDClient) GetStore(ctx context.Context, in *GetStoreRequest, opts ...grpc.CallOption) (*GetStoreResponse, error) {
-	out := new(GetStoreResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/GetStore", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateGCSafePointResponse proto.InternalMessageInfo
+
+func (m *UpdateGCSafePointResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
 	}
-	return out, nil
+	return nil
 }
 
-func (c *pDClient) PutStore(ctx context.Context, in *PutStoreRequest, opts ...grpc.CallOption) (*PutStoreResponse, error) {
-	out := new(PutStoreResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/PutStore", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *UpdateGCSafePointResponse) GetNewSafePoint() uint64 {
+	if m != nil {
+		return m.NewSafePoint
 	}
-	return out, nil
+	return 0
 }
 
-func (c *pDClient) GetAllStores(ctx context.Context, in *GetAllStoresRequest, opts ...grpc.CallOption) (*GetAllStoresResponse, error) {
-	out := new(GetAllStoresResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/GetAllStores", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type SyncRegionRequest struct {
+	Header *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Member *Member        `protobuf:"bytes,2,opt,name=member" json:"member,omitempty"`
+	// the follower PD will use the start index to locate historical changes
+	// that require synchronization.
+	StartIndex           uint64   `protobuf:"varint,3,opt,name=start_index,json=startIndex,proto3" json:"start_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *pDClient) StoreHeartbeat(ctx context.Context, in *StoreHeartbeatRequest, opts ...grpc.CallOption) (*StoreHeartbeatResponse, error) {
-	out := new(StoreHeartbeatResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/StoreHeartbeat", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *SyncRegionRequest) Reset()         { *m = SyncRegionRequest{} }
+func (m *SyncRegionRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncRegionRequest) ProtoMessage()    {}
+func (*SyncRegionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{68}
 }
-
-func (c *pDClient) RegionHeartbeat(ctx context.Context, opts ...grpc.CallOption) (PD_RegionHeartbeatClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_PD_serviceDesc.Streams[1], "/pdpb.PD/RegionHeartbeat", opts...)
-	if err != nil {
-		return nil, err
+func (m *SyncRegionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncRegionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncRegionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	x := &pDRegionHeartbeatClient{stream}
-	return x, nil
 }
-
-type PD_RegionHeartbeatClient interface {
-	Send(*RegionHeartbeatRequest) error
-	Recv() (*RegionHeartbeatResponse, error)
-	grpc.ClientStream
+func (dst *SyncRegionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncRegionRequest.Merge(dst, src)
 }
-
-type pDRegionHeartbeatClient struct {
-	grpc.ClientStream
+func (m *SyncRegionRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *pDRegionHeartbeatClient) Send(m *RegionHeartbeatRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *SyncRegionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncRegionRequest.DiscardUnknown(m)
 }
 
-func (x *pDRegionHeartbeatClient) Recv() (*RegionHeartbeatResponse, error) {
-	m := new(RegionHeartbeatResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
+var xxx_messageInfo_SyncRegionRequest proto.InternalMessageInfo
 
-func (c *pDClient) GetRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error) {
-	out := new(GetRegionResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/GetRegion", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SyncRegionRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
 	}
-	return out, nil
+	return nil
 }
 
-func (c *pDClient) GetPrevRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error) {
-	out := new(GetRegionResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/GetPrevRegion", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SyncRegionRequest) GetMember() *Member {
+	if m != nil {
+		return m.Member
 	}
-	return out, nil
+	return nil
 }
 
-func (c *pDClient) GetRegionByID(ctx context.Context, in *GetRegionByIDRequest, opts ...grpc.CallOption) (*GetRegionResponse, error) {
-	out := new(GetRegionResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/GetRegionByID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SyncRegionRequest) GetStartIndex() uint64 {
+	if m != nil {
+		return m.StartIndex
 	}
-	return out, nil
+	return 0
 }
 
-func (c *pDClient) ScanRegions(ctx context.Context, in *ScanRegionsRequest, opts ...grpc.CallOption) (*ScanRegionsResponse, error) {
-	out := new(ScanRegionsResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/ScanRegions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type SyncRegionResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	// the leader PD will send the repsonds include
+	// changed regions records and the index of the first record.
+	Regions              []*metapb.Region `protobuf:"bytes,2,rep,name=regions" json:"regions,omitempty"`
+	StartIndex           uint64           `protobuf:"varint,3,opt,name=start_index,json=startIndex,proto3" json:"start_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (c *pDClient) AskBatchSplit(ctx context.Context, in *AskBatchSplitRequest, opts ...grpc.CallOption) (*AskBatchSplitResponse, error) {
-	out := new(AskBatchSplitResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/AskBatchSplit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *SyncRegionResponse) Reset()         { *m = SyncRegionResponse{} }
+func (m *SyncRegionResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncRegionResponse) ProtoMessage()    {}
+func (*SyncRegionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{69}
 }
-
-func (c *pDClient) GetClusterConfig(ctx context.Context, in *GetClusterConfigRequest, opts ...grpc.CallOption) (*GetClusterConfigResponse, error) {
-	out := new(GetClusterConfigResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/GetClusterConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SyncRegionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncRegionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncRegionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *SyncRegionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncRegionResponse.Merge(dst, src)
+}
+func (m *SyncRegionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncRegionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncRegionResponse.DiscardUnknown(m)
 }
 
-func (c *pDClient) PutClusterConfig(ctx context.Context, in *PutClusterConfigRequest, opts ...grpc.CallOption) (*PutClusterConfigResponse, error) {
-	out := new(PutClusterConfigResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/PutClusterConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SyncRegionResponse proto.InternalMessageInfo
+
+func (m *SyncRegionResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
 	}
-	return out, nil
+	return nil
 }
 
-func (c *pDClient) ScatterRegion(ctx context.Context, in *ScatterRegionRequest, opts ...grpc.CallOption) (*ScatterRegionResponse, error) {
-	out := new(ScatterRegionResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/ScatterRegion", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SyncRegionResponse) GetRegions() []*metapb.Region {
+	if m != nil {
+		return m.Regions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *pDClient) GetGCSafePoint(ctx context.Context, in *GetGCSafePointRequest, opts ...grpc.CallOption) (*GetGCSafePointResponse, error) {
-	out := new(GetGCSafePointResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/GetGCSafePoint", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SyncRegionResponse) GetStartIndex() uint64 {
+	if m != nil {
+		return m.StartIndex
 	}
-	return out, nil
+	return 0
 }
 
-func (c *pDClient) UpdateGCSafePoint(ctx context.Context, in *UpdateGCSafePointRequest, opts ...grpc.CallOption) (*UpdateGCSafePointResponse, error) {
-	out := new(UpdateGCSafePointResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/UpdateGCSafePoint", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetOperatorRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	RegionId             uint64         `protobuf:"varint,2,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (c *pDClient) SyncRegions(ctx context.Context, opts ...grpc.CallOption) (PD_SyncRegionsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_PD_serviceDesc.Streams[2], "/pdpb.PD/SyncRegions", opts...)
-	if err != nil {
-		return nil, err
+func (m *GetOperatorRequest) Reset()         { *m = GetOperatorRequest{} }
+func (m *GetOperatorRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOperatorRequest) ProtoMessage()    {}
+func (*GetOperatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{70}
+}
+func (m *GetOperatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetOperatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetOperatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	x := &pDSyncRegionsClient{stream}
-	return x, nil
 }
-
-type PD_SyncRegionsClient interface {
-	Send(*SyncRegionRequest) error
-	Recv() (*SyncRegionResponse, error)
-	grpc.ClientStream
+func (dst *GetOperatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOperatorRequest.Merge(dst, src)
 }
-
-type pDSyncRegionsClient struct {
-	grpc.ClientStream
+func (m *GetOperatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetOperatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOperatorRequest.DiscardUnknown(m)
 }
 
-func (x *pDSyncRegionsClient) Send(m *SyncRegionRequest) error {
-	return x.ClientStream.SendMsg(m)
+var xxx_messageInfo_GetOperatorRequest proto.InternalMessageInfo
+
+func (m *GetOperatorRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
 }
 
-func (x *pDSyncRegionsClient) Recv() (*SyncRegionResponse, error) {
-	m := new(SyncRegionResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *GetOperatorRequest) GetRegionId() uint64 {
+	if m != nil {
+		return m.RegionId
 	}
-	return m, nil
+	return 0
 }
 
-func (c *pDClient) GetOperator(ctx context.Context, in *GetOperatorRequest, opts ...grpc.CallOption) (*GetOperatorResponse, error) {
-	out := new(GetOperatorResponse)
-	err := c.cc.Invoke(ctx, "/pdpb.PD/GetOperator", in, out, opts...)
-	if err != nil {
-		return nil, err
+type GetOperatorResponse struct {
+	Header               *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	RegionId             uint64          `protobuf:"varint,2,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	Desc                 []byte          `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
+	Status               OperatorStatus  `protobuf:"varint,4,opt,name=status,proto3,enum=pdpb.OperatorStatus" json:"status,omitempty"`
+	Kind                 []byte          `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetOperatorResponse) Reset()         { *m = GetOperatorResponse{} }
+func (m *GetOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOperatorResponse) ProtoMessage()    {}
+func (*GetOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pdpb_1c93cc7ef5a1ee29, []int{71}
+}
+func (m *GetOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *GetOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOperatorResponse.Merge(dst, src)
+}
+func (m *GetOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOperatorResponse.DiscardUnknown(m)
 }
 
-// Server API for PD service
+var xxx_messageInfo_GetOperatorResponse proto.InternalMessageInfo
 
-type PDServer interface {
-	// GetMembers get the member list of this cluster. It does not require
-	// the cluster_id in request matchs the id of this cluster.
-	GetMembers(context.Context, *GetMembersRequest) (*GetMembersResponse, error)
-	Tso(PD_TsoServer) error
-	Bootstrap(context.Context, *BootstrapRequest) (*BootstrapResponse, error)
-	IsBootstrapped(context.Context, *IsBootstrappedRequest) (*IsBootstrappedResponse, error)
-	AllocID(context.Context, *AllocIDRequest) (*AllocIDResponse, error)
-	GetStore(context.Context, *GetStoreRequest) (*GetStoreResponse, error)
-	PutStore(context.Context, *PutStoreRequest) (*PutStoreResponse, error)
-	GetAllStores(context.Context, *GetAllStoresRequest) (*GetAllStoresResponse, error)
-	StoreHeartbeat(context.Context, *StoreHeartbeatRequest) (*StoreHeartbeatResponse, error)
-	RegionHeartbeat(PD_RegionHeartbeatServer) error
-	GetRegion(context.Context, *GetRegionRequest) (*GetRegionResponse, error)
-	GetPrevRegion(context.Context, *GetRegionRequest) (*GetRegionResponse, error)
-	GetRegionByID(context.Context, *GetRegionByIDRequest) (*GetRegionResponse, error)
-	ScanRegions(context.Context, *ScanRegionsRequest) (*ScanRegionsResponse, error)
-	AskBatchSplit(context.Context, *AskBatchSplitRequest) (*AskBatchSplitResponse, error)
-	GetClusterConfig(context.Context, *GetClusterConfigRequest) (*GetClusterConfigResponse, error)
-	PutClusterConfig(context.Context, *PutClusterConfigRequest) (*PutClusterConfigResponse, error)
-	ScatterRegion(context.Context, *ScatterRegionRequest) (*ScatterRegionResponse, error)
-	GetGCSafePoint(context.Context, *GetGCSafePointRequest) (*GetGCSafePointResponse, error)
-	UpdateGCSafePoint(context.Context, *UpdateGCSafePointRequest) (*UpdateGCSafePointResponse, error)
-	SyncRegions(PD_SyncRegionsServer) error
-	GetOperator(context.Context, *GetOperatorRequest) (*GetOperatorResponse, error)
+func (m *GetOperatorResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
 }
 
-func RegisterPDServer(s *grpc.Server, srv PDServer) {
-	s.RegisterService(&_PD_serviceDesc, srv)
+func (m *GetOperatorResponse) GetRegionId() uint64 {
+	if m != nil {
+		return m.RegionId
+	}
+	return 0
 }
 
-func _PD_GetMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMembersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PDServer).GetMembers(ctx, in)
+func (m *GetOperatorResponse) GetDesc() []byte {
+	if m != nil {
+		return m.Desc
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/GetMembers",
+	return nil
+}
+
+func (m *GetOperatorResponse) GetStatus() OperatorStatus {
+	if m != nil {
+		return m.Status
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).GetMembers(ctx, req.(*GetMembersRequest))
+	return OperatorStatus_SUCCESS
+}
+
+func (m *GetOperatorResponse) GetKind() []byte {
+	if m != nil {
+		return m.Kind
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _PD_Tso_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(PDServer).Tso(&pDTsoServer{stream})
+func init() {
+	proto.RegisterType((*RequestHeader)(nil), "pdpb.RequestHeader")
+	proto.RegisterType((*ResponseHeader)(nil), "pdpb.ResponseHeader")
+	proto.RegisterType((*Error)(nil), "pdpb.Error")
+	proto.RegisterType((*TsoRequest)(nil), "pdpb.TsoRequest")
+	proto.RegisterType((*Timestamp)(nil), "pdpb.Timestamp")
+	proto.RegisterType((*TsoResponse)(nil), "pdpb.TsoResponse")
+	proto.RegisterType((*BootstrapRequest)(nil), "pdpb.BootstrapRequest")
+	proto.RegisterType((*BootstrapResponse)(nil), "pdpb.BootstrapResponse")
+	proto.RegisterType((*IsBootstrappedRequest)(nil), "pdpb.IsBootstrappedRequest")
+	proto.RegisterType((*IsBootstrappedResponse)(nil), "pdpb.IsBootstrappedResponse")
+	proto.RegisterType((*AllocIDRequest)(nil), "pdpb.AllocIDRequest")
+	proto.RegisterType((*AllocIDResponse)(nil), "pdpb.AllocIDResponse")
+	proto.RegisterType((*GetStoreRequest)(nil), "pdpb.GetStoreRequest")
+	proto.RegisterType((*GetStoreResponse)(nil), "pdpb.GetStoreResponse")
+	proto.RegisterType((*PutStoreRequest)(nil), "pdpb.PutStoreRequest")
+	proto.RegisterType((*PutStoreResponse)(nil), "pdpb.PutStoreResponse")
+	proto.RegisterType((*GetAllStoresRequest)(nil), "pdpb.GetAllStoresRequest")
+	proto.RegisterType((*GetAllStoresResponse)(nil), "pdpb.GetAllStoresResponse")
+	proto.RegisterType((*GetRegionRequest)(nil), "pdpb.GetRegionRequest")
+	proto.RegisterType((*GetRegionResponse)(nil), "pdpb.GetRegionResponse")
+	proto.RegisterType((*GetRegionByIDRequest)(nil), "pdpb.GetRegionByIDRequest")
+	proto.RegisterType((*ScanRegionsRequest)(nil), "pdpb.ScanRegionsRequest")
+	proto.RegisterType((*ScanRegionsResponse)(nil), "pdpb.ScanRegionsResponse")
+	proto.RegisterType((*BatchGetRegionsRequest)(nil), "pdpb.BatchGetRegionsRequest")
+	proto.RegisterType((*BatchGetRegionsResponse)(nil), "pdpb.BatchGetRegionsResponse")
+	proto.RegisterType((*GetClusterConfigRequest)(nil), "pdpb.GetClusterConfigRequest")
+	proto.RegisterType((*GetClusterConfigResponse)(nil), "pdpb.GetClusterConfigResponse")
+	proto.RegisterType((*PutClusterConfigRequest)(nil), "pdpb.PutClusterConfigRequest")
+	proto.RegisterType((*PutClusterConfigResponse)(nil), "pdpb.PutClusterConfigResponse")
+	proto.RegisterType((*Member)(nil), "pdpb.Member")
+	proto.RegisterType((*GetMembersRequest)(nil), "pdpb.GetMembersRequest")
+	proto.RegisterType((*GetMembersResponse)(nil), "pdpb.GetMembersResponse")
+	proto.RegisterType((*PeerStats)(nil), "pdpb.PeerStats")
+	proto.RegisterType((*RegionHeartbeatRequest)(nil), "pdpb.RegionHeartbeatRequest")
+	proto.RegisterType((*ChangePeer)(nil), "pdpb.ChangePeer")
+	proto.RegisterType((*TransferLeader)(nil), "pdpb.TransferLeader")
+	proto.RegisterType((*Merge)(nil), "pdpb.Merge")
+	proto.RegisterType((*SplitRegion)(nil), "pdpb.SplitRegion")
+	proto.RegisterType((*RegionHeartbeatResponse)(nil), "pdpb.RegionHeartbeatResponse")
+	proto.RegisterType((*AskSplitRequest)(nil), "pdpb.AskSplitRequest")
+	proto.RegisterType((*AskSplitResponse)(nil), "pdpb.AskSplitResponse")
+	proto.RegisterType((*ReportSplitRequest)(nil), "pdpb.ReportSplitRequest")
+	proto.RegisterType((*ReportSplitResponse)(nil), "pdpb.ReportSplitResponse")
+	proto.RegisterType((*AskBatchSplitRequest)(nil), "pdpb.AskBatchSplitRequest")
+	proto.RegisterType((*SplitID)(nil), "pdpb.SplitID")
+	proto.RegisterType((*AskBatchSplitResponse)(nil), "pdpb.AskBatchSplitResponse")
+	proto.RegisterType((*ReportBatchSplitRequest)(nil), "pdpb.ReportBatchSplitRequest")
+	proto.RegisterType((*ReportBatchSplitResponse)(nil), "pdpb.ReportBatchSplitResponse")
+	proto.RegisterType((*TimeInterval)(nil), "pdpb.TimeInterval")
+	proto.RegisterType((*RecordPair)(nil), "pdpb.RecordPair")
+	proto.RegisterType((*StoreStats)(nil), "pdpb.StoreStats")
+	proto.RegisterType((*OperatorResult)(nil), "pdpb.OperatorResult")
+	proto.RegisterType((*StoreHeartbeatRequest)(nil), "pdpb.StoreHeartbeatRequest")
+	proto.RegisterType((*StoreHeartbeatResponse)(nil), "pdpb.StoreHeartbeatResponse")
+	proto.RegisterType((*ScatterRegionRequest)(nil), "pdpb.ScatterRegionRequest")
+	proto.RegisterType((*ScatterRegionResponse)(nil), "pdpb.ScatterRegionResponse")
+	proto.RegisterType((*SetSplitKeysRequest)(nil), "pdpb.SetSplitKeysRequest")
+	proto.RegisterType((*SetSplitKeysResponse)(nil), "pdpb.SetSplitKeysResponse")
+	proto.RegisterType((*KeyRange)(nil), "pdpb.KeyRange")
+	proto.RegisterType((*SetReadOnlyRequest)(nil), "pdpb.SetReadOnlyRequest")
+	proto.RegisterType((*SetReadOnlyResponse)(nil), "pdpb.SetReadOnlyResponse")
+	proto.RegisterType((*ResourceGroup)(nil), "pdpb.ResourceGroup")
+	proto.RegisterType((*SetResourceGroupsRequest)(nil), "pdpb.SetResourceGroupsRequest")
+	proto.RegisterType((*SetResourceGroupsResponse)(nil), "pdpb.SetResourceGroupsResponse")
+	proto.RegisterType((*GetGCSafePointRequest)(nil), "pdpb.GetGCSafePointRequest")
+	proto.RegisterType((*GetGCSafePointResponse)(nil), "pdpb.GetGCSafePointResponse")
+	proto.RegisterType((*UpdateGCSafePointRequest)(nil), "pdpb.UpdateGCSafePointRequest")
+	proto.RegisterType((*UpdateGCSafePointResponse)(nil), "pdpb.UpdateGCSafePointResponse")
+	proto.RegisterType((*SyncRegionRequest)(nil), "pdpb.SyncRegionRequest")
+	proto.RegisterType((*SyncRegionResponse)(nil), "pdpb.SyncRegionResponse")
+	proto.RegisterType((*GetOperatorRequest)(nil), "pdpb.GetOperatorRequest")
+	proto.RegisterType((*GetOperatorResponse)(nil), "pdpb.GetOperatorResponse")
+	proto.RegisterEnum("pdpb.ErrorType", ErrorType_name, ErrorType_value)
+	proto.RegisterEnum("pdpb.CheckPolicy", CheckPolicy_name, CheckPolicy_value)
+	proto.RegisterEnum("pdpb.OperatorStatus", OperatorStatus_name, OperatorStatus_value)
 }
 
-type PD_TsoServer interface {
-	Send(*TsoResponse) error
-	Recv() (*TsoRequest, error)
-	grpc.ServerStream
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for PD service
+
+type PDClient interface {
+	// GetMembers get the member list of this cluster. It does not require
+	// the cluster_id in request matchs the id of this cluster.
+	GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error)
+	Tso(ctx context.Context, opts ...grpc.CallOption) (PD_TsoClient, error)
+	Bootstrap(ctx context.Context, in *BootstrapRequest, opts ...grpc.CallOption) (*BootstrapResponse, error)
+	IsBootstrapped(ctx context.Context, in *IsBootstrappedRequest, opts ...grpc.CallOption) (*IsBootstrappedResponse, error)
+	AllocID(ctx context.Context, in *AllocIDRequest, opts ...grpc.CallOption) (*AllocIDResponse, error)
+	GetStore(ctx context.Context, in *GetStoreRequest, opts ...grpc.CallOption) (*GetStoreResponse, error)
+	PutStore(ctx context.Context, in *PutStoreRequest, opts ...grpc.CallOption) (*PutStoreResponse, error)
+	GetAllStores(ctx context.Context, in *GetAllStoresRequest, opts ...grpc.CallOption) (*GetAllStoresResponse, error)
+	StoreHeartbeat(ctx context.Context, in *StoreHeartbeatRequest, opts ...grpc.CallOption) (*StoreHeartbeatResponse, error)
+	RegionHeartbeat(ctx context.Context, opts ...grpc.CallOption) (PD_RegionHeartbeatClient, error)
+	GetRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error)
+	GetPrevRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error)
+	GetRegionByID(ctx context.Context, in *GetRegionByIDRequest, opts ...grpc.CallOption) (*GetRegionResponse, error)
+	ScanRegions(ctx context.Context, in *ScanRegionsRequest, opts ...grpc.CallOption) (*ScanRegionsResponse, error)
+	BatchGetRegions(ctx context.Context, in *BatchGetRegionsRequest, opts ...grpc.CallOption) (*BatchGetRegionsResponse, error)
+	AskBatchSplit(ctx context.Context, in *AskBatchSplitRequest, opts ...grpc.CallOption) (*AskBatchSplitResponse, error)
+	GetClusterConfig(ctx context.Context, in *GetClusterConfigRequest, opts ...grpc.CallOption) (*GetClusterConfigResponse, error)
+	PutClusterConfig(ctx context.Context, in *PutClusterConfigRequest, opts ...grpc.CallOption) (*PutClusterConfigResponse, error)
+	ScatterRegion(ctx context.Context, in *ScatterRegionRequest, opts ...grpc.CallOption) (*ScatterRegionResponse, error)
+	GetGCSafePoint(ctx context.Context, in *GetGCSafePointRequest, opts ...grpc.CallOption) (*GetGCSafePointResponse, error)
+	UpdateGCSafePoint(ctx context.Context, in *UpdateGCSafePointRequest, opts ...grpc.CallOption) (*UpdateGCSafePointResponse, error)
+	SyncRegions(ctx context.Context, opts ...grpc.CallOption) (PD_SyncRegionsClient, error)
+	GetOperator(ctx context.Context, in *GetOperatorRequest, opts ...grpc.CallOption) (*GetOperatorResponse, error)
+	SetSplitKeys(ctx context.Context, in *SetSplitKeysRequest, opts ...grpc.CallOption) (*SetSplitKeysResponse, error)
+	SetReadOnly(ctx context.Context, in *SetReadOnlyRequest, opts ...grpc.CallOption) (*SetReadOnlyResponse, error)
+	SetResourceGroups(ctx context.Context, in *SetResourceGroupsRequest, opts ...grpc.CallOption) (*SetResourceGroupsResponse, error)
 }
 
-type pDTsoServer struct {
-	grpc.ServerStream
+type pDClient struct {
+	cc *grpc.ClientConn
 }
 
-func (x *pDTsoServer) Send(m *TsoResponse) error {
-	return x.ServerStream.SendMsg(m)
+func NewPDClient(cc *grpc.ClientConn) PDClient {
+	return &pDClient{cc}
 }
 
-func (x *pDTsoServer) Recv() (*TsoRequest, error) {
-	m := new(TsoRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (c *pDClient) GetMembers(ctx context.Context, in *GetMembersRequest, opts ...grpc.CallOption) (*GetMembersResponse, error) {
+	out := new(GetMembersResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/GetMembers", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func _PD_Bootstrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BootstrapRequest)
-	if err := dec(in); err != nil {
+func (c *pDClient) Tso(ctx context.Context, opts ...grpc.CallOption) (PD_TsoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PD_serviceDesc.Streams[0], "/pdpb.PD/Tso", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).Bootstrap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/Bootstrap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).Bootstrap(ctx, req.(*BootstrapRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	x := &pDTsoClient{stream}
+	return x, nil
 }
 
-func _PD_IsBootstrapped_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IsBootstrappedRequest)
-	if err := dec(in); err != nil {
+type PD_TsoClient interface {
+	Send(*TsoRequest) error
+	Recv() (*TsoResponse, error)
+	grpc.ClientStream
+}
+
+type pDTsoClient struct {
+	grpc.ClientStream
+}
+
+func (x *pDTsoClient) Send(m *TsoRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *pDTsoClient) Recv() (*TsoResponse, error) {
+	m := new(TsoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).IsBootstrapped(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/IsBootstrapped",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).IsBootstrapped(ctx, req.(*IsBootstrappedRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _PD_AllocID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllocIDRequest)
-	if err := dec(in); err != nil {
+func (c *pDClient) Bootstrap(ctx context.Context, in *BootstrapRequest, opts ...grpc.CallOption) (*BootstrapResponse, error) {
+	out := new(BootstrapResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/Bootstrap", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).AllocID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/AllocID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).AllocID(ctx, req.(*AllocIDRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _PD_GetStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStoreRequest)
-	if err := dec(in); err != nil {
+func (c *pDClient) IsBootstrapped(ctx context.Context, in *IsBootstrappedRequest, opts ...grpc.CallOption) (*IsBootstrappedResponse, error) {
+	out := new(IsBootstrappedResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/IsBootstrapped", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).GetStore(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/GetStore",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).GetStore(ctx, req.(*GetStoreRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _PD_PutStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PutStoreRequest)
-	if err := dec(in); err != nil {
+func (c *pDClient) AllocID(ctx context.Context, in *AllocIDRequest, opts ...grpc.CallOption) (*AllocIDResponse, error) {
+	out := new(AllocIDResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/AllocID", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).PutStore(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/PutStore",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).PutStore(ctx, req.(*PutStoreRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _PD_GetAllStores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllStoresRequest)
-	if err := dec(in); err != nil {
+func (c *pDClient) GetStore(ctx context.Context, in *GetStoreRequest, opts ...grpc.CallOption) (*GetStoreResponse, error) {
+	out := new(GetStoreResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/GetStore", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).GetAllStores(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/GetAllStores",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).GetAllStores(ctx, req.(*GetAllStoresRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _PD_StoreHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StoreHeartbeatRequest)
-	if err := dec(in); err != nil {
+func (c *pDClient) PutStore(ctx context.Context, in *PutStoreRequest, opts ...grpc.CallOption) (*PutStoreResponse, error) {
+	out := new(PutStoreResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/PutStore", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).StoreHeartbeat(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/StoreHeartbeat",
+	return out, nil
+}
+
+func (c *pDClient) GetAllStores(ctx context.Context, in *GetAllStoresRequest, opts ...grpc.CallOption) (*GetAllStoresResponse, error) {
+	out := new(GetAllStoresResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/GetAllStores", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).StoreHeartbeat(ctx, req.(*StoreHeartbeatRequest))
+	return out, nil
+}
+
+func (c *pDClient) StoreHeartbeat(ctx context.Context, in *StoreHeartbeatRequest, opts ...grpc.CallOption) (*StoreHeartbeatResponse, error) {
+	out := new(StoreHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/StoreHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _PD_RegionHeartbeat_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(PDServer).RegionHeartbeat(&pDRegionHeartbeatServer{stream})
+func (c *pDClient) RegionHeartbeat(ctx context.Context, opts ...grpc.CallOption) (PD_RegionHeartbeatClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PD_serviceDesc.Streams[1], "/pdpb.PD/RegionHeartbeat", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pDRegionHeartbeatClient{stream}
+	return x, nil
 }
 
-type PD_RegionHeartbeatServer interface {
-	Send(*RegionHeartbeatResponse) error
-	Recv() (*RegionHeartbeatRequest, error)
-	grpc.ServerStream
+type PD_RegionHeartbeatClient interface {
+	Send(*RegionHeartbeatRequest) error
+	Recv() (*RegionHeartbeatResponse, error)
+	grpc.ClientStream
 }
 
-type pDRegionHeartbeatServer struct {
-	grpc.ServerStream
+type pDRegionHeartbeatClient struct {
+	grpc.ClientStream
 }
 
-func (x *pDRegionHeartbeatServer) Send(m *RegionHeartbeatResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (x *pDRegionHeartbeatClient) Send(m *RegionHeartbeatRequest) error {
+	return x.ClientStream.SendMsg(m)
 }
 
-func (x *pDRegionHeartbeatServer) Recv() (*RegionHeartbeatRequest, error) {
-	m := new(RegionHeartbeatRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (x *pDRegionHeartbeatClient) Recv() (*RegionHeartbeatResponse, error) {
+	m := new(RegionHeartbeatResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func _PD_GetRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRegionRequest)
-	if err := dec(in); err != nil {
+func (c *pDClient) GetRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error) {
+	out := new(GetRegionResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/GetRegion", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).GetRegion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/GetRegion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).GetRegion(ctx, req.(*GetRegionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _PD_GetPrevRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRegionRequest)
-	if err := dec(in); err != nil {
+func (c *pDClient) GetPrevRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error) {
+	out := new(GetRegionResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/GetPrevRegion", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).GetPrevRegion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/GetPrevRegion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).GetPrevRegion(ctx, req.(*GetRegionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _PD_GetRegionByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRegionByIDRequest)
-	if err := dec(in); err != nil {
+func (c *pDClient) GetRegionByID(ctx context.Context, in *GetRegionByIDRequest, opts ...grpc.CallOption) (*GetRegionResponse, error) {
+	out := new(GetRegionResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/GetRegionByID", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).GetRegionByID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/GetRegionByID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).GetRegionByID(ctx, req.(*GetRegionByIDRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _PD_ScanRegions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScanRegionsRequest)
-	if err := dec(in); err != nil {
+func (c *pDClient) ScanRegions(ctx context.Context, in *ScanRegionsRequest, opts ...grpc.CallOption) (*ScanRegionsResponse, error) {
+	out := new(ScanRegionsResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/ScanRegions", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).ScanRegions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/ScanRegions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).ScanRegions(ctx, req.(*ScanRegionsRequest))
+	return out, nil
+}
+
+func (c *pDClient) BatchGetRegions(ctx context.Context, in *BatchGetRegionsRequest, opts ...grpc.CallOption) (*BatchGetRegionsResponse, error) {
+	out := new(BatchGetRegionsResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/BatchGetRegions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _PD_AskBatchSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AskBatchSplitRequest)
-	if err := dec(in); err != nil {
+func (c *pDClient) AskBatchSplit(ctx context.Context, in *AskBatchSplitRequest, opts ...grpc.CallOption) (*AskBatchSplitResponse, error) {
+	out := new(AskBatchSplitResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/AskBatchSplit", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PDServer).AskBatchSplit(ctx, in)
+	return out, nil
+}
+
+func (c *pDClient) GetClusterConfig(ctx context.Context, in *GetClusterConfigRequest, opts ...grpc.CallOption) (*GetClusterConfigResponse, error) {
+	out := new(GetClusterConfigResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/GetClusterConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pdpb.PD/AskBatchSplit",
+	return out, nil
+}
+
+func (c *pDClient) PutClusterConfig(ctx context.Context, in *PutClusterConfigRequest, opts ...grpc.CallOption) (*PutClusterConfigResponse, error) {
+	out := new(PutClusterConfigResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/PutClusterConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).AskBatchSplit(ctx, req.(*AskBatchSplitRequest))
+	return out, nil
+}
+
+func (c *pDClient) ScatterRegion(ctx context.Context, in *ScatterRegionRequest, opts ...grpc.CallOption) (*ScatterRegionResponse, error) {
+	out := new(ScatterRegionResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/ScatterRegion", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _PD_GetClusterConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetClusterConfigRequest)
+func (c *pDClient) GetGCSafePoint(ctx context.Context, in *GetGCSafePointRequest, opts ...grpc.CallOption) (*GetGCSafePointResponse, error) {
+	out := new(GetGCSafePointResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/GetGCSafePoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pDClient) UpdateGCSafePoint(ctx context.Context, in *UpdateGCSafePointRequest, opts ...grpc.CallOption) (*UpdateGCSafePointResponse, error) {
+	out := new(UpdateGCSafePointResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/UpdateGCSafePoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pDClient) SyncRegions(ctx context.Context, opts ...grpc.CallOption) (PD_SyncRegionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PD_serviceDesc.Streams[2], "/pdpb.PD/SyncRegions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pDSyncRegionsClient{stream}
+	return x, nil
+}
+
+type PD_SyncRegionsClient interface {
+	Send(*SyncRegionRequest) error
+	Recv() (*SyncRegionResponse, error)
+	grpc.ClientStream
+}
+
+type pDSyncRegionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *pDSyncRegionsClient) Send(m *SyncRegionRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *pDSyncRegionsClient) Recv() (*SyncRegionResponse, error) {
+	m := new(SyncRegionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pDClient) GetOperator(ctx context.Context, in *GetOperatorRequest, opts ...grpc.CallOption) (*GetOperatorResponse, error) {
+	out := new(GetOperatorResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/GetOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pDClient) SetSplitKeys(ctx context.Context, in *SetSplitKeysRequest, opts ...grpc.CallOption) (*SetSplitKeysResponse, error) {
+	out := new(SetSplitKeysResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/SetSplitKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pDClient) SetReadOnly(ctx context.Context, in *SetReadOnlyRequest, opts ...grpc.CallOption) (*SetReadOnlyResponse, error) {
+	out := new(SetReadOnlyResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/SetReadOnly", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pDClient) SetResourceGroups(ctx context.Context, in *SetResourceGroupsRequest, opts ...grpc.CallOption) (*SetResourceGroupsResponse, error) {
+	out := new(SetResourceGroupsResponse)
+	err := c.cc.Invoke(ctx, "/pdpb.PD/SetResourceGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for PD service
+
+type PDServer interface {
+	// GetMembers get the member list of this cluster. It does not require
+	// the cluster_id in request matchs the id of this cluster.
+	GetMembers(context.Context, *GetMembersRequest) (*GetMembersResponse, error)
+	Tso(PD_TsoServer) error
+	Bootstrap(context.Context, *BootstrapRequest) (*BootstrapResponse, error)
+	IsBootstrapped(context.Context, *IsBootstrappedRequest) (*IsBootstrappedResponse, error)
+	AllocID(context.Context, *AllocIDRequest) (*AllocIDResponse, error)
+	GetStore(context.Context, *GetStoreRequest) (*GetStoreResponse, error)
+	PutStore(context.Context, *PutStoreRequest) (*PutStoreResponse, error)
+	GetAllStores(context.Context, *GetAllStoresRequest) (*GetAllStoresResponse, error)
+	StoreHeartbeat(context.Context, *StoreHeartbeatRequest) (*StoreHeartbeatResponse, error)
+	RegionHeartbeat(PD_RegionHeartbeatServer) error
+	GetRegion(context.Context, *GetRegionRequest) (*GetRegionResponse, error)
+	GetPrevRegion(context.Context, *GetRegionRequest) (*GetRegionResponse, error)
+	GetRegionByID(context.Context, *GetRegionByIDRequest) (*GetRegionResponse, error)
+	ScanRegions(context.Context, *ScanRegionsRequest) (*ScanRegionsResponse, error)
+	BatchGetRegions(context.Context, *BatchGetRegionsRequest) (*BatchGetRegionsResponse, error)
+	AskBatchSplit(context.Context, *AskBatchSplitRequest) (*AskBatchSplitResponse, error)
+	GetClusterConfig(context.Context, *GetClusterConfigRequest) (*GetClusterConfigResponse, error)
+	PutClusterConfig(context.Context, *PutClusterConfigRequest) (*PutClusterConfigResponse, error)
+	ScatterRegion(context.Context, *ScatterRegionRequest) (*ScatterRegionResponse, error)
+	GetGCSafePoint(context.Context, *GetGCSafePointRequest) (*GetGCSafePointResponse, error)
+	UpdateGCSafePoint(context.Context, *UpdateGCSafePointRequest) (*UpdateGCSafePointResponse, error)
+	SyncRegions(PD_SyncRegionsServer) error
+	GetOperator(context.Context, *GetOperatorRequest) (*GetOperatorResponse, error)
+	SetSplitKeys(context.Context, *SetSplitKeysRequest) (*SetSplitKeysResponse, error)
+	SetReadOnly(context.Context, *SetReadOnlyRequest) (*SetReadOnlyResponse, error)
+	SetResourceGroups(context.Context, *SetResourceGroupsRequest) (*SetResourceGroupsResponse, error)
+}
+
+func RegisterPDServer(s *grpc.Server, srv PDServer) {
+	s.RegisterService(&_PD_serviceDesc, srv)
+}
+
+func _PD_GetMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMembersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PDServer).GetClusterConfig(ctx, in)
+		return srv.(PDServer).GetMembers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pdpb.PD/GetClusterConfig",
+		FullMethod: "/pdpb.PD/GetMembers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).GetClusterConfig(ctx, req.(*GetClusterConfigRequest))
+		return srv.(PDServer).GetMembers(ctx, req.(*GetMembersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PD_PutClusterConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PutClusterConfigRequest)
+func _PD_Tso_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PDServer).Tso(&pDTsoServer{stream})
+}
+
+type PD_TsoServer interface {
+	Send(*TsoResponse) error
+	Recv() (*TsoRequest, error)
+	grpc.ServerStream
+}
+
+type pDTsoServer struct {
+	grpc.ServerStream
+}
+
+func (x *pDTsoServer) Send(m *TsoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *pDTsoServer) Recv() (*TsoRequest, error) {
+	m := new(TsoRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _PD_Bootstrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BootstrapRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PDServer).PutClusterConfig(ctx, in)
+		return srv.(PDServer).Bootstrap(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pdpb.PD/PutClusterConfig",
+		FullMethod: "/pdpb.PD/Bootstrap",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).PutClusterConfig(ctx, req.(*PutClusterConfigRequest))
+		return srv.(PDServer).Bootstrap(ctx, req.(*BootstrapRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PD_ScatterRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScatterRegionRequest)
+func _PD_IsBootstrapped_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsBootstrappedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PDServer).ScatterRegion(ctx, in)
+		return srv.(PDServer).IsBootstrapped(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pdpb.PD/ScatterRegion",
+		FullMethod: "/pdpb.PD/IsBootstrapped",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).ScatterRegion(ctx, req.(*ScatterRegionRequest))
+		return srv.(PDServer).IsBootstrapped(ctx, req.(*IsBootstrappedRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PD_GetGCSafePoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGCSafePointRequest)
+func _PD_AllocID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllocIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PDServer).GetGCSafePoint(ctx, in)
+		return srv.(PDServer).AllocID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pdpb.PD/GetGCSafePoint",
+		FullMethod: "/pdpb.PD/AllocID",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).GetGCSafePoint(ctx, req.(*GetGCSafePointRequest))
+		return srv.(PDServer).AllocID(ctx, req.(*AllocIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PD_UpdateGCSafePoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateGCSafePointRequest)
+func _PD_GetStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoreRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PDServer).UpdateGCSafePoint(ctx, in)
+		return srv.(PDServer).GetStore(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pdpb.PD/UpdateGCSafePoint",
+		FullMethod: "/pdpb.PD/GetStore",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).UpdateGCSafePoint(ctx, req.(*UpdateGCSafePointRequest))
+		return srv.(PDServer).GetStore(ctx, req.(*GetStoreRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PD_SyncRegions_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(PDServer).SyncRegions(&pDSyncRegionsServer{stream})
-}
-
-type PD_SyncRegionsServer interface {
-	Send(*SyncRegionResponse) error
-	Recv() (*SyncRegionRequest, error)
-	grpc.ServerStream
-}
-
-type pDSyncRegionsServer struct {
-	grpc.ServerStream
-}
-
-func (x *pDSyncRegionsServer) Send(m *SyncRegionResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *pDSyncRegionsServer) Recv() (*SyncRegionRequest, error) {
-	m := new(SyncRegionRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func _PD_GetOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOperatorRequest)
+func _PD_PutStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutStoreRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PDServer).GetOperator(ctx, in)
+		return srv.(PDServer).PutStore(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pdpb.PD/GetOperator",
+		FullMethod: "/pdpb.PD/PutStore",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PDServer).GetOperator(ctx, req.(*GetOperatorRequest))
+		return srv.(PDServer).PutStore(ctx, req.(*PutStoreRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _PD_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pdpb.PD",
-	HandlerType: (*PDServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetMembers",
-			Handler:    _PD_GetMembers_Handler,
-		},
-		{
-			MethodName: "Bootstrap",
-			Handler:    _PD_Bootstrap_Handler,
-		},
-		{
-			MethodName: "IsBootstrapped",
-			Handler:    _PD_IsBootstrapped_Handler,
-		},
-		{
-			MethodName: "AllocID",
-			Handler:    _PD_AllocID_Handler,
-		},
-		{
-			MethodName: "GetStore",
-			Handler:    _PD_GetStore_Handler,
-		},
-		{
-			MethodName: "PutStore",
-			Handler:    _PD_PutStore_Handler,
-		},
-		{
-			MethodName: "GetAllStores",
-			Handler:    _PD_GetAllStores_Handler,
-		},
-		{
-			MethodName: "StoreHeartbeat",
-			Handler:    _PD_StoreHeartbeat_Handler,
-		},
-		{
-			MethodName: "GetRegion",
-			Handler:    _PD_GetRegion_Handler,
-		},
-		{
-			MethodName: "GetPrevRegion",
-			Handler:    _PD_GetPrevRegion_Handler,
-		},
-		{
-			MethodName: "GetRegionByID",
-			Handler:    _PD_GetRegionByID_Handler,
-		},
-		{
-			MethodName: "ScanRegions",
-			Handler:    _PD_ScanRegions_Handler,
-		},
-		{
-			MethodName: "AskBatchSplit",
-			Handler:    _PD_AskBatchSplit_Handler,
-		},
-		{
-			MethodName: "GetClusterConfig",
-			Handler:    _PD_GetClusterConfig_Handler,
-		},
-		{
-			MethodName: "PutClusterConfig",
-			Handler:    _PD_PutClusterConfig_Handler,
-		},
-		{
-			MethodName: "ScatterRegion",
-			Handler:    _PD_ScatterRegion_Handler,
-		},
-		{
-			MethodName: "GetGCSafePoint",
-			Handler:    _PD_GetGCSafePoint_Handler,
-		},
-		{
-			MethodName: "UpdateGCSafePoint",
-			Handler:    _PD_UpdateGCSafePoint_Handler,
-		},
-		{
-			MethodName: "GetOperator",
-			Handler:    _PD_GetOperator_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Tso",
-			Handler:       _PD_Tso_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "RegionHeartbeat",
-			Handler:       _PD_RegionHeartbeat_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "SyncRegions",
-			Handler:       _PD_SyncRegions_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "pdpb.proto",
-}
-
-func (m *RequestHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_GetAllStores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllStoresRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RequestHeader) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ClusterId != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.ClusterId))
+	if interceptor == nil {
+		return srv.(PDServer).GetAllStores(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/GetAllStores",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).GetAllStores(ctx, req.(*GetAllStoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ResponseHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_StoreHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreHeartbeatRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ResponseHeader) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ClusterId != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.ClusterId))
+	if interceptor == nil {
+		return srv.(PDServer).StoreHeartbeat(ctx, in)
 	}
-	if m.Error != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Error.Size()))
-		n1, err := m.Error.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/StoreHeartbeat",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).StoreHeartbeat(ctx, req.(*StoreHeartbeatRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Error) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_RegionHeartbeat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PDServer).RegionHeartbeat(&pDRegionHeartbeatServer{stream})
+}
+
+type PD_RegionHeartbeatServer interface {
+	Send(*RegionHeartbeatResponse) error
+	Recv() (*RegionHeartbeatRequest, error)
+	grpc.ServerStream
+}
+
+type pDRegionHeartbeatServer struct {
+	grpc.ServerStream
+}
+
+func (x *pDRegionHeartbeatServer) Send(m *RegionHeartbeatResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *pDRegionHeartbeatServer) Recv() (*RegionHeartbeatRequest, error) {
+	m := new(RegionHeartbeatRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *Error) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Type != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Type))
+func _PD_GetRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Message) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Message)))
-		i += copy(dAtA[i:], m.Message)
+	if interceptor == nil {
+		return srv.(PDServer).GetRegion(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/GetRegion",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).GetRegion(ctx, req.(*GetRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TsoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_GetPrevRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(PDServer).GetPrevRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/GetPrevRegion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).GetPrevRegion(ctx, req.(*GetRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TsoRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n2, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+func _PD_GetRegionByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegionByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Count != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Count))
+	if interceptor == nil {
+		return srv.(PDServer).GetRegionByID(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/GetRegionByID",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).GetRegionByID(ctx, req.(*GetRegionByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Timestamp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_ScanRegions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanRegionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Timestamp) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Physical != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Physical))
+	if interceptor == nil {
+		return srv.(PDServer).ScanRegions(ctx, in)
 	}
-	if m.Logical != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Logical))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/ScanRegions",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).ScanRegions(ctx, req.(*ScanRegionsRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TsoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_BatchGetRegions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetRegionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(PDServer).BatchGetRegions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/BatchGetRegions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).BatchGetRegions(ctx, req.(*BatchGetRegionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TsoResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n3, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+func _PD_AskBatchSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AskBatchSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Count != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Count))
+	if interceptor == nil {
+		return srv.(PDServer).AskBatchSplit(ctx, in)
 	}
-	if m.Timestamp != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Timestamp.Size()))
-		n4, err := m.Timestamp.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/AskBatchSplit",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).AskBatchSplit(ctx, req.(*AskBatchSplitRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BootstrapRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_GetClusterConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterConfigRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(PDServer).GetClusterConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/GetClusterConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).GetClusterConfig(ctx, req.(*GetClusterConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BootstrapRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n5, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
+func _PD_PutClusterConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutClusterConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Store != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Store.Size()))
-		n6, err := m.Store.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
+	if interceptor == nil {
+		return srv.(PDServer).PutClusterConfig(ctx, in)
 	}
-	if m.Region != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Region.Size()))
-		n7, err := m.Region.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/PutClusterConfig",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).PutClusterConfig(ctx, req.(*PutClusterConfigRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BootstrapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_ScatterRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScatterRegionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BootstrapResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n8, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n8
+	if interceptor == nil {
+		return srv.(PDServer).ScatterRegion(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/ScatterRegion",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).ScatterRegion(ctx, req.(*ScatterRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *IsBootstrappedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_GetGCSafePoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGCSafePointRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(PDServer).GetGCSafePoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/GetGCSafePoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).GetGCSafePoint(ctx, req.(*GetGCSafePointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *IsBootstrappedRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n9, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n9
+func _PD_UpdateGCSafePoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGCSafePointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(PDServer).UpdateGCSafePoint(ctx, in)
 	}
-	return i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/UpdateGCSafePoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).UpdateGCSafePoint(ctx, req.(*UpdateGCSafePointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *IsBootstrappedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _PD_SyncRegions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PDServer).SyncRegions(&pDSyncRegionsServer{stream})
+}
+
+type PD_SyncRegionsServer interface {
+	Send(*SyncRegionResponse) error
+	Recv() (*SyncRegionRequest, error)
+	grpc.ServerStream
+}
+
+type pDSyncRegionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *pDSyncRegionsServer) Send(m *SyncRegionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *pDSyncRegionsServer) Recv() (*SyncRegionRequest, error) {
+	m := new(SyncRegionRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *IsBootstrappedResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n10, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
+func _PD_GetOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Bootstrapped {
-		dAtA[i] = 0x10
-		i++
-		if m.Bootstrapped {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if interceptor == nil {
+		return srv.(PDServer).GetOperator(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/GetOperator",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).GetOperator(ctx, req.(*GetOperatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AllocIDRequest) Marshal() (dAtA []byte, err error) {
+func _PD_SetSplitKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSplitKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PDServer).SetSplitKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/SetSplitKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).SetSplitKeys(ctx, req.(*SetSplitKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PD_SetReadOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReadOnlyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PDServer).SetReadOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/SetReadOnly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).SetReadOnly(ctx, req.(*SetReadOnlyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PD_SetResourceGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetResourceGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PDServer).SetResourceGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pdpb.PD/SetResourceGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PDServer).SetResourceGroups(ctx, req.(*SetResourceGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PD_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pdpb.PD",
+	HandlerType: (*PDServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMembers",
+			Handler:    _PD_GetMembers_Handler,
+		},
+		{
+			MethodName: "Bootstrap",
+			Handler:    _PD_Bootstrap_Handler,
+		},
+		{
+			MethodName: "IsBootstrapped",
+			Handler:    _PD_IsBootstrapped_Handler,
+		},
+		{
+			MethodName: "AllocID",
+			Handler:    _PD_AllocID_Handler,
+		},
+		{
+			MethodName: "GetStore",
+			Handler:    _PD_GetStore_Handler,
+		},
+		{
+			MethodName: "PutStore",
+			Handler:    _PD_PutStore_Handler,
+		},
+		{
+			MethodName: "GetAllStores",
+			Handler:    _PD_GetAllStores_Handler,
+		},
+		{
+			MethodName: "StoreHeartbeat",
+			Handler:    _PD_StoreHeartbeat_Handler,
+		},
+		{
+			MethodName: "GetRegion",
+			Handler:    _PD_GetRegion_Handler,
+		},
+		{
+			MethodName: "GetPrevRegion",
+			Handler:    _PD_GetPrevRegion_Handler,
+		},
+		{
+			MethodName: "GetRegionByID",
+			Handler:    _PD_GetRegionByID_Handler,
+		},
+		{
+			MethodName: "ScanRegions",
+			Handler:    _PD_ScanRegions_Handler,
+		},
+		{
+			MethodName: "BatchGetRegions",
+			Handler:    _PD_BatchGetRegions_Handler,
+		},
+		{
+			MethodName: "AskBatchSplit",
+			Handler:    _PD_AskBatchSplit_Handler,
+		},
+		{
+			MethodName: "GetClusterConfig",
+			Handler:    _PD_GetClusterConfig_Handler,
+		},
+		{
+			MethodName: "PutClusterConfig",
+			Handler:    _PD_PutClusterConfig_Handler,
+		},
+		{
+			MethodName: "ScatterRegion",
+			Handler:    _PD_ScatterRegion_Handler,
+		},
+		{
+			MethodName: "GetGCSafePoint",
+			Handler:    _PD_GetGCSafePoint_Handler,
+		},
+		{
+			MethodName: "UpdateGCSafePoint",
+			Handler:    _PD_UpdateGCSafePoint_Handler,
+		},
+		{
+			MethodName: "GetOperator",
+			Handler:    _PD_GetOperator_Handler,
+		},
+		{
+			MethodName: "SetSplitKeys",
+			Handler:    _PD_SetSplitKeys_Handler,
+		},
+		{
+			MethodName: "SetReadOnly",
+			Handler:    _PD_SetReadOnly_Handler,
+		},
+		{
+			MethodName: "SetResourceGroups",
+			Handler:    _PD_SetResourceGroups_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Tso",
+			Handler:       _PD_Tso_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "RegionHeartbeat",
+			Handler:       _PD_RegionHeartbeat_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SyncRegions",
+			Handler:       _PD_SyncRegions_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "pdpb.proto",
+}
+
+func (m *RequestHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5186,20 +5678,15 @@ func (m *AllocIDRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AllocIDRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RequestHeader) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
+	if m.ClusterId != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n11, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
+		i = encodeVarintPdpb(dAtA, i, uint64(m.ClusterId))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5207,7 +5694,7 @@ func (m *AllocIDRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *AllocIDResponse) Marshal() (dAtA []byte, err error) {
+func (m *ResponseHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5217,25 +5704,25 @@ func (m *AllocIDResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AllocIDResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResponseHeader) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
+	if m.ClusterId != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n12, err := m.Header.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.ClusterId))
+	}
+	if m.Error != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Error.Size()))
+		n1, err := m.Error.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
-	}
-	if m.Id != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Id))
+		i += n1
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5243,7 +5730,7 @@ func (m *AllocIDResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetStoreRequest) Marshal() (dAtA []byte, err error) {
+func (m *Error) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5253,25 +5740,21 @@ func (m *GetStoreRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetStoreRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Error) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
+	if m.Type != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n13, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n13
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Type))
 	}
-	if m.StoreId != 0 {
-		dAtA[i] = 0x10
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.StoreId))
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5279,7 +5762,7 @@ func (m *GetStoreRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetStoreResponse) Marshal() (dAtA []byte, err error) {
+func (m *TsoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5289,7 +5772,7 @@ func (m *GetStoreResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetStoreResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TsoRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5298,31 +5781,16 @@ func (m *GetStoreResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n14, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n14
-	}
-	if m.Store != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Store.Size()))
-		n15, err := m.Store.MarshalTo(dAtA[i:])
+		n2, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n2
 	}
-	if m.Stats != nil {
-		dAtA[i] = 0x1a
+	if m.Count != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Stats.Size()))
-		n16, err := m.Stats.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n16
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Count))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5330,7 +5798,7 @@ func (m *GetStoreResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *PutStoreRequest) Marshal() (dAtA []byte, err error) {
+func (m *Timestamp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5340,30 +5808,20 @@ func (m *PutStoreRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PutStoreRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Timestamp) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
+	if m.Physical != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n17, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n17
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Physical))
 	}
-	if m.Store != nil {
-		dAtA[i] = 0x12
+	if m.Logical != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Store.Size()))
-		n18, err := m.Store.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n18
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Logical))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5371,7 +5829,7 @@ func (m *PutStoreRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *PutStoreResponse) Marshal() (dAtA []byte, err error) {
+func (m *TsoResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5381,7 +5839,7 @@ func (m *PutStoreResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PutStoreResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TsoResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5390,11 +5848,26 @@ func (m *PutStoreResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n19, err := m.Header.MarshalTo(dAtA[i:])
+		n3, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n3
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Count))
+	}
+	if m.Timestamp != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Timestamp.Size()))
+		n4, err := m.Timestamp.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5402,7 +5875,7 @@ func (m *PutStoreResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetAllStoresRequest) Marshal() (dAtA []byte, err error) {
+func (m *BootstrapRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5412,7 +5885,7 @@ func (m *GetAllStoresRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetAllStoresRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *BootstrapRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5421,21 +5894,31 @@ func (m *GetAllStoresRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n20, err := m.Header.MarshalTo(dAtA[i:])
+		n5, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n5
 	}
-	if m.ExcludeTombstoneStores {
-		dAtA[i] = 0x10
+	if m.Store != nil {
+		dAtA[i] = 0x12
 		i++
-		if m.ExcludeTombstoneStores {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Store.Size()))
+		n6, err := m.Store.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n6
+	}
+	if m.Region != nil {
+		dAtA[i] = 0x1a
 		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Region.Size()))
+		n7, err := m.Region.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5443,7 +5926,7 @@ func (m *GetAllStoresRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetAllStoresResponse) Marshal() (dAtA []byte, err error) {
+func (m *BootstrapResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5453,7 +5936,7 @@ func (m *GetAllStoresResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetAllStoresResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *BootstrapResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5462,23 +5945,11 @@ func (m *GetAllStoresResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n21, err := m.Header.MarshalTo(dAtA[i:])
+		n8, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
-	}
-	if len(m.Stores) > 0 {
-		for _, msg := range m.Stores {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+		i += n8
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5486,7 +5957,7 @@ func (m *GetAllStoresResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetRegionRequest) Marshal() (dAtA []byte, err error) {
+func (m *IsBootstrappedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5496,7 +5967,7 @@ func (m *GetRegionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetRegionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *IsBootstrappedRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5505,17 +5976,11 @@ func (m *GetRegionRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n22, err := m.Header.MarshalTo(dAtA[i:])
+		n9, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
-	}
-	if len(m.RegionKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(len(m.RegionKey)))
-		i += copy(dAtA[i:], m.RegionKey)
+		i += n9
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5523,7 +5988,7 @@ func (m *GetRegionRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetRegionResponse) Marshal() (dAtA []byte, err error) {
+func (m *IsBootstrappedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5533,7 +5998,7 @@ func (m *GetRegionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetRegionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *IsBootstrappedResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5542,43 +6007,21 @@ func (m *GetRegionResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n23, err := m.Header.MarshalTo(dAtA[i:])
+		n10, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n10
 	}
-	if m.Region != nil {
-		dAtA[i] = 0x12
+	if m.Bootstrapped {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Region.Size()))
-		n24, err := m.Region.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		if m.Bootstrapped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i += n24
-	}
-	if m.Leader != nil {
-		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Leader.Size()))
-		n25, err := m.Leader.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n25
-	}
-	if len(m.Slaves) > 0 {
-		for _, msg := range m.Slaves {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5586,7 +6029,7 @@ func (m *GetRegionResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetRegionByIDRequest) Marshal() (dAtA []byte, err error) {
+func (m *AllocIDRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5596,7 +6039,7 @@ func (m *GetRegionByIDRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetRegionByIDRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AllocIDRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5605,16 +6048,11 @@ func (m *GetRegionByIDRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n26, err := m.Header.MarshalTo(dAtA[i:])
+		n11, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
-	}
-	if m.RegionId != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionId))
+		i += n11
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5622,7 +6060,7 @@ func (m *GetRegionByIDRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ScanRegionsRequest) Marshal() (dAtA []byte, err error) {
+func (m *AllocIDResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5632,7 +6070,7 @@ func (m *ScanRegionsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScanRegionsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AllocIDResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5641,28 +6079,16 @@ func (m *ScanRegionsRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n27, err := m.Header.MarshalTo(dAtA[i:])
+		n12, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n12
 	}
-	if len(m.StartKey) > 0 {
-		dAtA[i] = 0x12
+	if m.Id != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(len(m.StartKey)))
-		i += copy(dAtA[i:], m.StartKey)
-	}
-	if m.Limit != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Limit))
-	}
-	if len(m.EndKey) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(len(m.EndKey)))
-		i += copy(dAtA[i:], m.EndKey)
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Id))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5670,7 +6096,7 @@ func (m *ScanRegionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ScanRegionsResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetStoreRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5680,7 +6106,7 @@ func (m *ScanRegionsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScanRegionsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetStoreRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5689,35 +6115,16 @@ func (m *ScanRegionsResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n28, err := m.Header.MarshalTo(dAtA[i:])
+		n13, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
-	}
-	if len(m.Regions) > 0 {
-		for _, msg := range m.Regions {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+		i += n13
 	}
-	if len(m.Leaders) > 0 {
-		for _, msg := range m.Leaders {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.StoreId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.StoreId))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5725,7 +6132,7 @@ func (m *ScanRegionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetClusterConfigRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetStoreResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5735,7 +6142,7 @@ func (m *GetClusterConfigRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetClusterConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetStoreResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5744,11 +6151,31 @@ func (m *GetClusterConfigRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n29, err := m.Header.MarshalTo(dAtA[i:])
+		n14, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n14
+	}
+	if m.Store != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Store.Size()))
+		n15, err := m.Store.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if m.Stats != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Stats.Size()))
+		n16, err := m.Stats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5756,7 +6183,7 @@ func (m *GetClusterConfigRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetClusterConfigResponse) Marshal() (dAtA []byte, err error) {
+func (m *PutStoreRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5766,7 +6193,7 @@ func (m *GetClusterConfigResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetClusterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutStoreRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5775,21 +6202,21 @@ func (m *GetClusterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n30, err := m.Header.MarshalTo(dAtA[i:])
+		n17, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n17
 	}
-	if m.Cluster != nil {
+	if m.Store != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Cluster.Size()))
-		n31, err := m.Cluster.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Store.Size()))
+		n18, err := m.Store.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n18
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5797,7 +6224,7 @@ func (m *GetClusterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *PutClusterConfigRequest) Marshal() (dAtA []byte, err error) {
+func (m *PutStoreResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5807,7 +6234,7 @@ func (m *PutClusterConfigRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PutClusterConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutStoreResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5816,21 +6243,11 @@ func (m *PutClusterConfigRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n32, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n32
-	}
-	if m.Cluster != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Cluster.Size()))
-		n33, err := m.Cluster.MarshalTo(dAtA[i:])
+		n19, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n19
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5838,7 +6255,7 @@ func (m *PutClusterConfigRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *PutClusterConfigResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetAllStoresRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5848,7 +6265,7 @@ func (m *PutClusterConfigResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PutClusterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetAllStoresRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5857,11 +6274,21 @@ func (m *PutClusterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n34, err := m.Header.MarshalTo(dAtA[i:])
+		n20, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n20
+	}
+	if m.ExcludeTombstoneStores {
+		dAtA[i] = 0x10
+		i++
+		if m.ExcludeTombstoneStores {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5869,7 +6296,7 @@ func (m *PutClusterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *Member) Marshal() (dAtA []byte, err error) {
+func (m *GetAllStoresResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5879,64 +6306,40 @@ func (m *Member) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Member) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetAllStoresResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
+	if m.Header != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if m.MemberId != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.MemberId))
-	}
-	if len(m.PeerUrls) > 0 {
-		for _, s := range m.PeerUrls {
-			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n21, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n21
 	}
-	if len(m.ClientUrls) > 0 {
-		for _, s := range m.ClientUrls {
-			dAtA[i] = 0x22
+	if len(m.Stores) > 0 {
+		for _, msg := range m.Stores {
+			dAtA[i] = 0x12
 			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
 			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+			i += n
 		}
 	}
-	if m.LeaderPriority != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.LeaderPriority))
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *GetMembersRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetRegionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5946,7 +6349,7 @@ func (m *GetMembersRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetRegionRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5955,11 +6358,17 @@ func (m *GetMembersRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n35, err := m.Header.MarshalTo(dAtA[i:])
+		n22, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n22
+	}
+	if len(m.RegionKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.RegionKey)))
+		i += copy(dAtA[i:], m.RegionKey)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -5967,7 +6376,7 @@ func (m *GetMembersRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetMembersResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetRegionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5977,7 +6386,7 @@ func (m *GetMembersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetRegionResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -5986,43 +6395,43 @@ func (m *GetMembersResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n36, err := m.Header.MarshalTo(dAtA[i:])
+		n23, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n23
 	}
-	if len(m.Members) > 0 {
-		for _, msg := range m.Members {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if m.Region != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Region.Size()))
+		n24, err := m.Region.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n24
 	}
 	if m.Leader != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Leader.Size()))
-		n37, err := m.Leader.MarshalTo(dAtA[i:])
+		n25, err := m.Leader.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n25
 	}
-	if m.EtcdLeader != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.EtcdLeader.Size()))
-		n38, err := m.EtcdLeader.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.Slaves) > 0 {
+		for _, msg := range m.Slaves {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i += n38
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6030,7 +6439,7 @@ func (m *GetMembersResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *PeerStats) Marshal() (dAtA []byte, err error) {
+func (m *GetRegionByIDRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6040,25 +6449,25 @@ func (m *PeerStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PeerStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetRegionByIDRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Peer != nil {
+	if m.Header != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Peer.Size()))
-		n39, err := m.Peer.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n26, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n26
 	}
-	if m.DownSeconds != 0 {
+	if m.RegionId != 0 {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.DownSeconds))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionId))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6066,7 +6475,7 @@ func (m *PeerStats) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RegionHeartbeatRequest) Marshal() (dAtA []byte, err error) {
+func (m *ScanRegionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6076,7 +6485,7 @@ func (m *RegionHeartbeatRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegionHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScanRegionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6085,35 +6494,63 @@ func (m *RegionHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n40, err := m.Header.MarshalTo(dAtA[i:])
+		n27, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n27
 	}
-	if m.Region != nil {
+	if len(m.StartKey) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Region.Size()))
-		n41, err := m.Region.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n41
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.StartKey)))
+		i += copy(dAtA[i:], m.StartKey)
 	}
-	if m.Leader != nil {
-		dAtA[i] = 0x1a
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Leader.Size()))
-		n42, err := m.Leader.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Limit))
+	}
+	if len(m.EndKey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.EndKey)))
+		i += copy(dAtA[i:], m.EndKey)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ScanRegionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScanRegionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n28, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n28
 	}
-	if len(m.DownPeers) > 0 {
-		for _, msg := range m.DownPeers {
-			dAtA[i] = 0x22
+	if len(m.Regions) > 0 {
+		for _, msg := range m.Regions {
+			dAtA[i] = 0x12
 			i++
 			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -6123,9 +6560,9 @@ func (m *RegionHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if len(m.PendingPeers) > 0 {
-		for _, msg := range m.PendingPeers {
-			dAtA[i] = 0x2a
+	if len(m.Leaders) > 0 {
+		for _, msg := range m.Leaders {
+			dAtA[i] = 0x1a
 			i++
 			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -6135,58 +6572,13 @@ func (m *RegionHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if m.BytesWritten != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.BytesWritten))
-	}
-	if m.BytesRead != 0 {
-		dAtA[i] = 0x38
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.BytesRead))
-	}
-	if m.KeysWritten != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.KeysWritten))
-	}
-	if m.KeysRead != 0 {
-		dAtA[i] = 0x48
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.KeysRead))
-	}
-	if m.ApproximateSize != 0 {
-		dAtA[i] = 0x50
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.ApproximateSize))
-	}
-	if m.Interval != nil {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Interval.Size()))
-		n43, err := m.Interval.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n43
-	}
-	if m.ApproximateKeys != 0 {
-		dAtA[i] = 0x68
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.ApproximateKeys))
-	}
-	if m.Term != 0 {
-		dAtA[i] = 0x70
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Term))
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *ChangePeer) Marshal() (dAtA []byte, err error) {
+func (m *BatchGetRegionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6196,25 +6588,28 @@ func (m *ChangePeer) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChangePeer) MarshalTo(dAtA []byte) (int, error) {
+func (m *BatchGetRegionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Peer != nil {
+	if m.Header != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Peer.Size()))
-		n44, err := m.Peer.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n29, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n29
 	}
-	if m.ChangeType != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.ChangeType))
+	if len(m.RegionKeys) > 0 {
+		for _, b := range m.RegionKeys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6222,7 +6617,7 @@ func (m *ChangePeer) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *TransferLeader) Marshal() (dAtA []byte, err error) {
+func (m *BatchGetRegionsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6232,20 +6627,44 @@ func (m *TransferLeader) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TransferLeader) MarshalTo(dAtA []byte) (int, error) {
+func (m *BatchGetRegionsResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Peer != nil {
+	if m.Header != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Peer.Size()))
-		n45, err := m.Peer.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n30, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n30
+	}
+	if len(m.Regions) > 0 {
+		for _, msg := range m.Regions {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Leaders) > 0 {
+		for _, msg := range m.Leaders {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6253,7 +6672,7 @@ func (m *TransferLeader) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *Merge) Marshal() (dAtA []byte, err error) {
+func (m *GetClusterConfigRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6263,20 +6682,20 @@ func (m *Merge) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Merge) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetClusterConfigRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Target != nil {
+	if m.Header != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Target.Size()))
-		n46, err := m.Target.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n31, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n31
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6284,7 +6703,7 @@ func (m *Merge) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *SplitRegion) Marshal() (dAtA []byte, err error) {
+func (m *GetClusterConfigResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6294,23 +6713,30 @@ func (m *SplitRegion) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SplitRegion) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetClusterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Policy != 0 {
-		dAtA[i] = 0x8
+	if m.Header != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Policy))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n32, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
 	}
-	if len(m.Keys) > 0 {
-		for _, b := range m.Keys {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
+	if m.Cluster != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Cluster.Size()))
+		n33, err := m.Cluster.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n33
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6318,7 +6744,7 @@ func (m *SplitRegion) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RegionHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+func (m *PutClusterConfigRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6328,7 +6754,7 @@ func (m *RegionHeartbeatResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegionHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutClusterConfigRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6337,76 +6763,52 @@ func (m *RegionHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n47, err := m.Header.MarshalTo(dAtA[i:])
+		n34, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n34
 	}
-	if m.ChangePeer != nil {
+	if m.Cluster != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.ChangePeer.Size()))
-		n48, err := m.ChangePeer.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Cluster.Size()))
+		n35, err := m.Cluster.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n35
 	}
-	if m.TransferLeader != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.TransferLeader.Size()))
-		n49, err := m.TransferLeader.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n49
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RegionId != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionId))
+	return i, nil
+}
+
+func (m *PutClusterConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.RegionEpoch != nil {
-		dAtA[i] = 0x2a
+	return dAtA[:n], nil
+}
+
+func (m *PutClusterConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionEpoch.Size()))
-		n50, err := m.RegionEpoch.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n36, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
-	}
-	if m.TargetPeer != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.TargetPeer.Size()))
-		n51, err := m.TargetPeer.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n51
-	}
-	if m.Merge != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Merge.Size()))
-		n52, err := m.Merge.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n52
-	}
-	if m.SplitRegion != nil {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.SplitRegion.Size()))
-		n53, err := m.SplitRegion.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n53
+		i += n36
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6414,7 +6816,7 @@ func (m *RegionHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *AskSplitRequest) Marshal() (dAtA []byte, err error) {
+func (m *Member) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6424,30 +6826,56 @@ func (m *AskSplitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AskSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Member) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
+	if len(m.Name) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n54, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n54
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.Region != nil {
-		dAtA[i] = 0x12
+	if m.MemberId != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Region.Size()))
-		n55, err := m.Region.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		i = encodeVarintPdpb(dAtA, i, uint64(m.MemberId))
+	}
+	if len(m.PeerUrls) > 0 {
+		for _, s := range m.PeerUrls {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
-		i += n55
+	}
+	if len(m.ClientUrls) > 0 {
+		for _, s := range m.ClientUrls {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.LeaderPriority != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.LeaderPriority))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6455,7 +6883,7 @@ func (m *AskSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *AskSplitResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetMembersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6465,7 +6893,7 @@ func (m *AskSplitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AskSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetMembersRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6474,33 +6902,11 @@ func (m *AskSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n56, err := m.Header.MarshalTo(dAtA[i:])
+		n37, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
-	}
-	if m.NewRegionId != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.NewRegionId))
-	}
-	if len(m.NewPeerIds) > 0 {
-		dAtA58 := make([]byte, len(m.NewPeerIds)*10)
-		var j57 int
-		for _, num := range m.NewPeerIds {
-			for num >= 1<<7 {
-				dAtA58[j57] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j57++
-			}
-			dAtA58[j57] = uint8(num)
-			j57++
-		}
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(j57))
-		i += copy(dAtA[i:], dAtA58[:j57])
+		i += n37
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6508,7 +6914,7 @@ func (m *AskSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ReportSplitRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetMembersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6518,7 +6924,7 @@ func (m *ReportSplitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReportSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetMembersResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6527,31 +6933,43 @@ func (m *ReportSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n59, err := m.Header.MarshalTo(dAtA[i:])
+		n38, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n38
 	}
-	if m.Left != nil {
-		dAtA[i] = 0x12
+	if len(m.Members) > 0 {
+		for _, msg := range m.Members {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Leader != nil {
+		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Left.Size()))
-		n60, err := m.Left.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Leader.Size()))
+		n39, err := m.Leader.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n60
+		i += n39
 	}
-	if m.Right != nil {
-		dAtA[i] = 0x1a
+	if m.EtcdLeader != nil {
+		dAtA[i] = 0x22
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Right.Size()))
-		n61, err := m.Right.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.EtcdLeader.Size()))
+		n40, err := m.EtcdLeader.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n61
+		i += n40
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6559,7 +6977,7 @@ func (m *ReportSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ReportSplitResponse) Marshal() (dAtA []byte, err error) {
+func (m *PeerStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6569,20 +6987,25 @@ func (m *ReportSplitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReportSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PeerStats) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
+	if m.Peer != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n62, err := m.Header.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Peer.Size()))
+		n41, err := m.Peer.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n62
+		i += n41
+	}
+	if m.DownSeconds != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.DownSeconds))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6590,7 +7013,7 @@ func (m *ReportSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *AskBatchSplitRequest) Marshal() (dAtA []byte, err error) {
+func (m *RegionHeartbeatRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6600,7 +7023,7 @@ func (m *AskBatchSplitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AskBatchSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RegionHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6609,26 +7032,100 @@ func (m *AskBatchSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n63, err := m.Header.MarshalTo(dAtA[i:])
+		n42, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n63
+		i += n42
 	}
 	if m.Region != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Region.Size()))
-		n64, err := m.Region.MarshalTo(dAtA[i:])
+		n43, err := m.Region.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n64
+		i += n43
 	}
-	if m.SplitCount != 0 {
-		dAtA[i] = 0x18
+	if m.Leader != nil {
+		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.SplitCount))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Leader.Size()))
+		n44, err := m.Leader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n44
+	}
+	if len(m.DownPeers) > 0 {
+		for _, msg := range m.DownPeers {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.PendingPeers) > 0 {
+		for _, msg := range m.PendingPeers {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.BytesWritten != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.BytesWritten))
+	}
+	if m.BytesRead != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.BytesRead))
+	}
+	if m.KeysWritten != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.KeysWritten))
+	}
+	if m.KeysRead != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.KeysRead))
+	}
+	if m.ApproximateSize != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.ApproximateSize))
+	}
+	if m.Interval != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Interval.Size()))
+		n45, err := m.Interval.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
+	}
+	if m.ApproximateKeys != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.ApproximateKeys))
+	}
+	if m.Term != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Term))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6636,7 +7133,7 @@ func (m *AskBatchSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *SplitID) Marshal() (dAtA []byte, err error) {
+func (m *ChangePeer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6646,32 +7143,35 @@ func (m *SplitID) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SplitID) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChangePeer) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.NewRegionId != 0 {
-		dAtA[i] = 0x8
+	if m.Peer != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.NewRegionId))
-	}
-	if len(m.NewPeerIds) > 0 {
-		dAtA66 := make([]byte, len(m.NewPeerIds)*10)
-		var j65 int
-		for _, num := range m.NewPeerIds {
-			for num >= 1<<7 {
-				dAtA66[j65] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j65++
-			}
-			dAtA66[j65] = uint8(num)
-			j65++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Peer.Size()))
+		n46, err := m.Peer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(j65))
-		i += copy(dAtA[i:], dAtA66[:j65])
+		i += n46
+	}
+	if m.ChangeType != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.ChangeType))
+	}
+	if m.Repair {
+		dAtA[i] = 0x18
+		i++
+		if m.Repair {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6679,7 +7179,7 @@ func (m *SplitID) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *AskBatchSplitResponse) Marshal() (dAtA []byte, err error) {
+func (m *TransferLeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6689,32 +7189,20 @@ func (m *AskBatchSplitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AskBatchSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TransferLeader) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
+	if m.Peer != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n67, err := m.Header.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Peer.Size()))
+		n47, err := m.Peer.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n67
-	}
-	if len(m.Ids) > 0 {
-		for _, msg := range m.Ids {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+		i += n47
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6722,7 +7210,7 @@ func (m *AskBatchSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ReportBatchSplitRequest) Marshal() (dAtA []byte, err error) {
+func (m *Merge) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6732,31 +7220,53 @@ func (m *ReportBatchSplitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReportBatchSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Merge) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
+	if m.Target != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n68, err := m.Header.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Target.Size()))
+		n48, err := m.Target.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n68
+		i += n48
 	}
-	if len(m.Regions) > 0 {
-		for _, msg := range m.Regions {
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SplitRegion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SplitRegion) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Policy))
+	}
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
 			dAtA[i] = 0x12
 			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+			i = encodeVarintPdpb(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
 		}
 	}
 	if m.XXX_unrecognized != nil {
@@ -6765,7 +7275,7 @@ func (m *ReportBatchSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ReportBatchSplitResponse) Marshal() (dAtA []byte, err error) {
+func (m *RegionHeartbeatResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6775,7 +7285,7 @@ func (m *ReportBatchSplitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReportBatchSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RegionHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6784,11 +7294,86 @@ func (m *ReportBatchSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n69, err := m.Header.MarshalTo(dAtA[i:])
+		n49, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n69
+		i += n49
+	}
+	if m.ChangePeer != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.ChangePeer.Size()))
+		n50, err := m.ChangePeer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n50
+	}
+	if m.TransferLeader != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.TransferLeader.Size()))
+		n51, err := m.TransferLeader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n51
+	}
+	if m.RegionId != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionId))
+	}
+	if m.RegionEpoch != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionEpoch.Size()))
+		n52, err := m.RegionEpoch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n52
+	}
+	if m.TargetPeer != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.TargetPeer.Size()))
+		n53, err := m.TargetPeer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n53
+	}
+	if m.Merge != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Merge.Size()))
+		n54, err := m.Merge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n54
+	}
+	if m.SplitRegion != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.SplitRegion.Size()))
+		n55, err := m.SplitRegion.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n55
+	}
+	if m.OperatorId != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.OperatorId))
+	}
+	if m.OperatorStep != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.OperatorStep))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6796,7 +7381,7 @@ func (m *ReportBatchSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *TimeInterval) Marshal() (dAtA []byte, err error) {
+func (m *AskSplitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6806,20 +7391,30 @@ func (m *TimeInterval) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TimeInterval) MarshalTo(dAtA []byte) (int, error) {
+func (m *AskSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.StartTimestamp != 0 {
-		dAtA[i] = 0x8
+	if m.Header != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.StartTimestamp))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n56, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n56
 	}
-	if m.EndTimestamp != 0 {
-		dAtA[i] = 0x10
+	if m.Region != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.EndTimestamp))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Region.Size()))
+		n57, err := m.Region.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n57
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6827,7 +7422,7 @@ func (m *TimeInterval) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RecordPair) Marshal() (dAtA []byte, err error) {
+func (m *AskSplitResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6837,21 +7432,42 @@ func (m *RecordPair) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RecordPair) MarshalTo(dAtA []byte) (int, error) {
+func (m *AskSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Key) > 0 {
+	if m.Header != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n58, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n58
 	}
-	if m.Value != 0 {
+	if m.NewRegionId != 0 {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Value))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.NewRegionId))
+	}
+	if len(m.NewPeerIds) > 0 {
+		dAtA60 := make([]byte, len(m.NewPeerIds)*10)
+		var j59 int
+		for _, num := range m.NewPeerIds {
+			for num >= 1<<7 {
+				dAtA60[j59] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j59++
+			}
+			dAtA60[j59] = uint8(num)
+			j59++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(j59))
+		i += copy(dAtA[i:], dAtA60[:j59])
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -6859,7 +7475,7 @@ func (m *RecordPair) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *StoreStats) Marshal() (dAtA []byte, err error) {
+func (m *ReportSplitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6869,151 +7485,71 @@ func (m *StoreStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StoreStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReportSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.StoreId != 0 {
-		dAtA[i] = 0x8
+	if m.Header != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.StoreId))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n61, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n61
 	}
-	if m.Capacity != 0 {
-		dAtA[i] = 0x10
+	if m.Left != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Capacity))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Left.Size()))
+		n62, err := m.Left.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n62
 	}
-	if m.Available != 0 {
-		dAtA[i] = 0x18
+	if m.Right != nil {
+		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Available))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Right.Size()))
+		n63, err := m.Right.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n63
 	}
-	if m.RegionCount != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionCount))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.SendingSnapCount != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.SendingSnapCount))
+	return i, nil
+}
+
+func (m *ReportSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.ReceivingSnapCount != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.ReceivingSnapCount))
-	}
-	if m.StartTime != 0 {
-		dAtA[i] = 0x38
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.StartTime))
-	}
-	if m.ApplyingSnapCount != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.ApplyingSnapCount))
-	}
-	if m.IsBusy {
-		dAtA[i] = 0x48
-		i++
-		if m.IsBusy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.UsedSize != 0 {
-		dAtA[i] = 0x50
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.UsedSize))
-	}
-	if m.BytesWritten != 0 {
-		dAtA[i] = 0x58
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.BytesWritten))
-	}
-	if m.KeysWritten != 0 {
-		dAtA[i] = 0x60
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.KeysWritten))
-	}
-	if m.BytesRead != 0 {
-		dAtA[i] = 0x68
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.BytesRead))
-	}
-	if m.KeysRead != 0 {
-		dAtA[i] = 0x70
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.KeysRead))
-	}
-	if m.Interval != nil {
-		dAtA[i] = 0x7a
+	return dAtA[:n], nil
+}
+
+func (m *ReportSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Interval.Size()))
-		n70, err := m.Interval.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n64, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n70
-	}
-	if len(m.CpuUsages) > 0 {
-		for _, msg := range m.CpuUsages {
-			dAtA[i] = 0x82
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.ReadIoRates) > 0 {
-		for _, msg := range m.ReadIoRates {
-			dAtA[i] = 0x8a
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.WriteIoRates) > 0 {
-		for _, msg := range m.WriteIoRates {
-			dAtA[i] = 0x92
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.OpLatencies) > 0 {
-		for _, msg := range m.OpLatencies {
-			dAtA[i] = 0x9a
-			i++
-			dAtA[i] = 0x1
-			i++
-			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+		i += n64
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7021,7 +7557,7 @@ func (m *StoreStats) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *StoreHeartbeatRequest) Marshal() (dAtA []byte, err error) {
+func (m *AskBatchSplitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7031,7 +7567,7 @@ func (m *StoreHeartbeatRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StoreHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AskBatchSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -7040,21 +7576,26 @@ func (m *StoreHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n71, err := m.Header.MarshalTo(dAtA[i:])
+		n65, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n71
+		i += n65
 	}
-	if m.Stats != nil {
+	if m.Region != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Stats.Size()))
-		n72, err := m.Stats.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Region.Size()))
+		n66, err := m.Region.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n72
+		i += n66
+	}
+	if m.SplitCount != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.SplitCount))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7062,7 +7603,7 @@ func (m *StoreHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *StoreHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+func (m *SplitID) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7072,20 +7613,32 @@ func (m *StoreHeartbeatResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StoreHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SplitID) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
+	if m.NewRegionId != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n73, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		i = encodeVarintPdpb(dAtA, i, uint64(m.NewRegionId))
+	}
+	if len(m.NewPeerIds) > 0 {
+		dAtA68 := make([]byte, len(m.NewPeerIds)*10)
+		var j67 int
+		for _, num := range m.NewPeerIds {
+			for num >= 1<<7 {
+				dAtA68[j67] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j67++
+			}
+			dAtA68[j67] = uint8(num)
+			j67++
 		}
-		i += n73
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(j67))
+		i += copy(dAtA[i:], dAtA68[:j67])
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7093,7 +7646,7 @@ func (m *StoreHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ScatterRegionRequest) Marshal() (dAtA []byte, err error) {
+func (m *AskBatchSplitResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7103,7 +7656,7 @@ func (m *ScatterRegionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScatterRegionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AskBatchSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -7112,36 +7665,23 @@ func (m *ScatterRegionRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n74, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n74
-	}
-	if m.RegionId != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionId))
-	}
-	if m.Region != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Region.Size()))
-		n75, err := m.Region.MarshalTo(dAtA[i:])
+		n69, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n75
+		i += n69
 	}
-	if m.Leader != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Leader.Size()))
-		n76, err := m.Leader.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.Ids) > 0 {
+		for _, msg := range m.Ids {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i += n76
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7149,7 +7689,7 @@ func (m *ScatterRegionRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ScatterRegionResponse) Marshal() (dAtA []byte, err error) {
+func (m *ReportBatchSplitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7159,7 +7699,7 @@ func (m *ScatterRegionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScatterRegionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReportBatchSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -7168,11 +7708,23 @@ func (m *ScatterRegionResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n77, err := m.Header.MarshalTo(dAtA[i:])
+		n70, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n77
+		i += n70
+	}
+	if len(m.Regions) > 0 {
+		for _, msg := range m.Regions {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7180,7 +7732,7 @@ func (m *ScatterRegionResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetGCSafePointRequest) Marshal() (dAtA []byte, err error) {
+func (m *ReportBatchSplitResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7190,7 +7742,7 @@ func (m *GetGCSafePointRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReportBatchSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -7199,11 +7751,11 @@ func (m *GetGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n78, err := m.Header.MarshalTo(dAtA[i:])
+		n71, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n78
+		i += n71
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7211,7 +7763,7 @@ func (m *GetGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetGCSafePointResponse) Marshal() (dAtA []byte, err error) {
+func (m *TimeInterval) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7221,25 +7773,20 @@ func (m *GetGCSafePointResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TimeInterval) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
+	if m.StartTimestamp != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n79, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n79
+		i = encodeVarintPdpb(dAtA, i, uint64(m.StartTimestamp))
 	}
-	if m.SafePoint != 0 {
+	if m.EndTimestamp != 0 {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.SafePoint))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.EndTimestamp))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7247,7 +7794,7 @@ func (m *GetGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *UpdateGCSafePointRequest) Marshal() (dAtA []byte, err error) {
+func (m *RecordPair) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7257,25 +7804,21 @@ func (m *UpdateGCSafePointRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RecordPair) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
+	if len(m.Key) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n80, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n80
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
 	}
-	if m.SafePoint != 0 {
+	if m.Value != 0 {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.SafePoint))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Value))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7283,7 +7826,7 @@ func (m *UpdateGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *UpdateGCSafePointResponse) Marshal() (dAtA []byte, err error) {
+func (m *StoreStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7293,25 +7836,158 @@ func (m *UpdateGCSafePointResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *StoreStats) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
+	if m.StoreId != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n81, err := m.Header.MarshalTo(dAtA[i:])
+		i = encodeVarintPdpb(dAtA, i, uint64(m.StoreId))
+	}
+	if m.Capacity != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Capacity))
+	}
+	if m.Available != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Available))
+	}
+	if m.RegionCount != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionCount))
+	}
+	if m.SendingSnapCount != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.SendingSnapCount))
+	}
+	if m.ReceivingSnapCount != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.ReceivingSnapCount))
+	}
+	if m.StartTime != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.StartTime))
+	}
+	if m.ApplyingSnapCount != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.ApplyingSnapCount))
+	}
+	if m.IsBusy {
+		dAtA[i] = 0x48
+		i++
+		if m.IsBusy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.UsedSize != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.UsedSize))
+	}
+	if m.BytesWritten != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.BytesWritten))
+	}
+	if m.KeysWritten != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.KeysWritten))
+	}
+	if m.BytesRead != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.BytesRead))
+	}
+	if m.KeysRead != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.KeysRead))
+	}
+	if m.Interval != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Interval.Size()))
+		n72, err := m.Interval.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n81
+		i += n72
 	}
-	if m.NewSafePoint != 0 {
-		dAtA[i] = 0x10
+	if len(m.CpuUsages) > 0 {
+		for _, msg := range m.CpuUsages {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ReadIoRates) > 0 {
+		for _, msg := range m.ReadIoRates {
+			dAtA[i] = 0x8a
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.WriteIoRates) > 0 {
+		for _, msg := range m.WriteIoRates {
+			dAtA[i] = 0x92
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.OpLatencies) > 0 {
+		for _, msg := range m.OpLatencies {
+			dAtA[i] = 0x9a
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.BusyBackoffMs != 0 {
+		dAtA[i] = 0xa0
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.NewSafePoint))
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.BusyBackoffMs))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7319,7 +7995,7 @@ func (m *UpdateGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *SyncRegionRequest) Marshal() (dAtA []byte, err error) {
+func (m *OperatorResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7329,35 +8005,41 @@ func (m *SyncRegionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncRegionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *OperatorResult) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
+	if m.RegionId != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n82, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n82
+		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionId))
 	}
-	if m.Member != nil {
-		dAtA[i] = 0x12
+	if m.OperatorId != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Member.Size()))
-		n83, err := m.Member.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n83
+		i = encodeVarintPdpb(dAtA, i, uint64(m.OperatorId))
 	}
-	if m.StartIndex != 0 {
+	if m.OperatorStep != 0 {
 		dAtA[i] = 0x18
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.StartIndex))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.OperatorStep))
+	}
+	if m.Success {
+		dAtA[i] = 0x20
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7365,7 +8047,7 @@ func (m *SyncRegionRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *SyncRegionResponse) Marshal() (dAtA []byte, err error) {
+func (m *StoreHeartbeatRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7375,7 +8057,7 @@ func (m *SyncRegionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SyncRegionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *StoreHeartbeatRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -7384,15 +8066,25 @@ func (m *SyncRegionResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n84, err := m.Header.MarshalTo(dAtA[i:])
+		n73, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n84
+		i += n73
 	}
-	if len(m.Regions) > 0 {
-		for _, msg := range m.Regions {
-			dAtA[i] = 0x12
+	if m.Stats != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Stats.Size()))
+		n74, err := m.Stats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n74
+	}
+	if len(m.OperatorResults) > 0 {
+		for _, msg := range m.OperatorResults {
+			dAtA[i] = 0x1a
 			i++
 			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -7402,18 +8094,13 @@ func (m *SyncRegionResponse) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if m.StartIndex != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.StartIndex))
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *GetOperatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *StoreHeartbeatResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7423,7 +8110,7 @@ func (m *GetOperatorRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *StoreHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -7432,16 +8119,45 @@ func (m *GetOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n85, err := m.Header.MarshalTo(dAtA[i:])
+		n75, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n85
+		i += n75
 	}
-	if m.RegionId != 0 {
+	if m.ClusterReadOnly {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionId))
+		if m.ClusterReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.ReadOnlyRanges) > 0 {
+		for _, msg := range m.ReadOnlyRanges {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ResourceGroups) > 0 {
+		for _, msg := range m.ResourceGroups {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7449,7 +8165,7 @@ func (m *GetOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetOperatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *ScatterRegionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -7459,7 +8175,7 @@ func (m *GetOperatorResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScatterRegionRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -7468,33 +8184,36 @@ func (m *GetOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
-		n86, err := m.Header.MarshalTo(dAtA[i:])
+		n76, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n86
+		i += n76
 	}
 	if m.RegionId != 0 {
 		dAtA[i] = 0x10
 		i++
 		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionId))
 	}
-	if len(m.Desc) > 0 {
+	if m.Region != nil {
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Desc)))
-		i += copy(dAtA[i:], m.Desc)
-	}
-	if m.Status != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(m.Status))
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Region.Size()))
+		n77, err := m.Region.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n77
 	}
-	if len(m.Kind) > 0 {
-		dAtA[i] = 0x2a
+	if m.Leader != nil {
+		dAtA[i] = 0x22
 		i++
-		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Kind)))
-		i += copy(dAtA[i:], m.Kind)
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Leader.Size()))
+		n78, err := m.Leader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n78
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -7502,381 +8221,666 @@ func (m *GetOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintPdpb(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ScatterRegionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *RequestHeader) Size() (n int) {
+
+func (m *ScatterRegionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ClusterId != 0 {
-		n += 1 + sovPdpb(uint64(m.ClusterId))
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n79, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n79
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ResponseHeader) Size() (n int) {
-	var l int
-	_ = l
-	if m.ClusterId != 0 {
-		n += 1 + sovPdpb(uint64(m.ClusterId))
-	}
-	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SetSplitKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Error) Size() (n int) {
+func (m *SetSplitKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovPdpb(uint64(m.Type))
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n80, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n80
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovPdpb(uint64(l))
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *TsoRequest) Size() (n int) {
+func (m *SetSplitKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetSplitKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.Count != 0 {
-		n += 1 + sovPdpb(uint64(m.Count))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n81, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n81
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Timestamp) Size() (n int) {
-	var l int
-	_ = l
-	if m.Physical != 0 {
-		n += 1 + sovPdpb(uint64(m.Physical))
-	}
-	if m.Logical != 0 {
-		n += 1 + sovPdpb(uint64(m.Logical))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *KeyRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TsoResponse) Size() (n int) {
+func (m *KeyRange) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.Count != 0 {
-		n += 1 + sovPdpb(uint64(m.Count))
+	if len(m.StartKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.StartKey)))
+		i += copy(dAtA[i:], m.StartKey)
 	}
-	if m.Timestamp != nil {
-		l = m.Timestamp.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if len(m.EndKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.EndKey)))
+		i += copy(dAtA[i:], m.EndKey)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *BootstrapRequest) Size() (n int) {
+func (m *SetReadOnlyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetReadOnlyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n82, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n82
 	}
-	if m.Store != nil {
-		l = m.Store.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.Cluster {
+		dAtA[i] = 0x10
+		i++
+		if m.Cluster {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.Region != nil {
-		l = m.Region.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if len(m.Ranges) > 0 {
+		for _, msg := range m.Ranges {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *BootstrapResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SetReadOnlyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *IsBootstrappedRequest) Size() (n int) {
+func (m *SetReadOnlyResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n83, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n83
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *IsBootstrappedResponse) Size() (n int) {
+func (m *ResourceGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceGroup) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if m.Bootstrapped {
-		n += 2
+	if m.Weight != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Weight))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AllocIDRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SetResourceGroupsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AllocIDResponse) Size() (n int) {
+func (m *SetResourceGroupsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n84, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n84
 	}
-	if m.Id != 0 {
-		n += 1 + sovPdpb(uint64(m.Id))
+	if len(m.Groups) > 0 {
+		for _, msg := range m.Groups {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetStoreRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.StoreId != 0 {
-		n += 1 + sovPdpb(uint64(m.StoreId))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SetResourceGroupsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetStoreResponse) Size() (n int) {
+func (m *SetResourceGroupsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.Store != nil {
-		l = m.Store.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n85, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n85
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *PutStoreRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.Store != nil {
-		l = m.Store.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetGCSafePointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PutStoreResponse) Size() (n int) {
+func (m *GetGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n86, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n86
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetAllStoresRequest) Size() (n int) {
+func (m *GetGCSafePointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n87, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n87
 	}
-	if m.ExcludeTombstoneStores {
-		n += 2
+	if m.SafePoint != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.SafePoint))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetAllStoresResponse) Size() (n int) {
+func (m *UpdateGCSafePointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateGCSafePointRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if len(m.Stores) > 0 {
-		for _, e := range m.Stores {
-			l = e.Size()
-			n += 1 + l + sovPdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n88, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n88
+	}
+	if m.SafePoint != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.SafePoint))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetRegionRequest) Size() (n int) {
+func (m *UpdateGCSafePointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateGCSafePointResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n89, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n89
 	}
-	l = len(m.RegionKey)
-	if l > 0 {
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.NewSafePoint != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.NewSafePoint))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetRegionResponse) Size() (n int) {
+func (m *SyncRegionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncRegionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n90, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n90
 	}
-	if m.Region != nil {
-		l = m.Region.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.Member != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Member.Size()))
+		n91, err := m.Member.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n91
 	}
-	if m.Leader != nil {
-		l = m.Leader.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.StartIndex != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.StartIndex))
 	}
-	if len(m.Slaves) > 0 {
-		for _, e := range m.Slaves {
-			l = e.Size()
-			n += 1 + l + sovPdpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SyncRegionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncRegionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n92, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n92
+	}
+	if len(m.Regions) > 0 {
+		for _, msg := range m.Regions {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
+	if m.StartIndex != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.StartIndex))
+	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetRegionByIDRequest) Size() (n int) {
+func (m *GetOperatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n93, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n93
 	}
 	if m.RegionId != 0 {
-		n += 1 + sovPdpb(uint64(m.RegionId))
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionId))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ScanRegionsRequest) Size() (n int) {
+func (m *GetOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Header.Size()))
+		n94, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n94
 	}
-	l = len(m.StartKey)
-	if l > 0 {
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.RegionId != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.RegionId))
 	}
-	if m.Limit != 0 {
-		n += 1 + sovPdpb(uint64(m.Limit))
+	if len(m.Desc) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Desc)))
+		i += copy(dAtA[i:], m.Desc)
 	}
-	l = len(m.EndKey)
-	if l > 0 {
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.Status != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(m.Status))
+	}
+	if len(m.Kind) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPdpb(dAtA, i, uint64(len(m.Kind)))
+		i += copy(dAtA[i:], m.Kind)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintPdpb(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *RequestHeader) Size() (n int) {
+	var l int
+	_ = l
+	if m.ClusterId != 0 {
+		n += 1 + sovPdpb(uint64(m.ClusterId))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -7884,24 +8888,15 @@ func (m *ScanRegionsRequest) Size() (n int) {
 	return n
 }
 
-func (m *ScanRegionsResponse) Size() (n int) {
+func (m *ResponseHeader) Size() (n int) {
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if len(m.Regions) > 0 {
-		for _, e := range m.Regions {
-			l = e.Size()
-			n += 1 + l + sovPdpb(uint64(l))
-		}
+	if m.ClusterId != 0 {
+		n += 1 + sovPdpb(uint64(m.ClusterId))
 	}
-	if len(m.Leaders) > 0 {
-		for _, e := range m.Leaders {
-			l = e.Size()
-			n += 1 + l + sovPdpb(uint64(l))
-		}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovPdpb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -7909,11 +8904,14 @@ func (m *ScanRegionsResponse) Size() (n int) {
 	return n
 }
 
-func (m *GetClusterConfigRequest) Size() (n int) {
+func (m *Error) Size() (n int) {
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
+	if m.Type != 0 {
+		n += 1 + sovPdpb(uint64(m.Type))
+	}
+	l = len(m.Message)
+	if l > 0 {
 		n += 1 + l + sovPdpb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -7922,16 +8920,15 @@ func (m *GetClusterConfigRequest) Size() (n int) {
 	return n
 }
 
-func (m *GetClusterConfigResponse) Size() (n int) {
+func (m *TsoRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.Cluster != nil {
-		l = m.Cluster.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovPdpb(uint64(m.Count))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -7939,16 +8936,14 @@ func (m *GetClusterConfigResponse) Size() (n int) {
 	return n
 }
 
-func (m *PutClusterConfigRequest) Size() (n int) {
+func (m *Timestamp) Size() (n int) {
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.Physical != 0 {
+		n += 1 + sovPdpb(uint64(m.Physical))
 	}
-	if m.Cluster != nil {
-		l = m.Cluster.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.Logical != 0 {
+		n += 1 + sovPdpb(uint64(m.Logical))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -7956,43 +8951,40 @@ func (m *PutClusterConfigRequest) Size() (n int) {
 	return n
 }
 
-func (m *PutClusterConfigResponse) Size() (n int) {
+func (m *TsoResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
+	if m.Count != 0 {
+		n += 1 + sovPdpb(uint64(m.Count))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *Member) Size() (n int) {
+func (m *BootstrapRequest) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
+	if m.Header != nil {
+		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.MemberId != 0 {
-		n += 1 + sovPdpb(uint64(m.MemberId))
-	}
-	if len(m.PeerUrls) > 0 {
-		for _, s := range m.PeerUrls {
-			l = len(s)
-			n += 1 + l + sovPdpb(uint64(l))
-		}
-	}
-	if len(m.ClientUrls) > 0 {
-		for _, s := range m.ClientUrls {
-			l = len(s)
-			n += 1 + l + sovPdpb(uint64(l))
-		}
+	if m.Store != nil {
+		l = m.Store.Size()
+		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.LeaderPriority != 0 {
-		n += 1 + sovPdpb(uint64(m.LeaderPriority))
+	if m.Region != nil {
+		l = m.Region.Size()
+		n += 1 + l + sovPdpb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -8000,7 +8992,7 @@ func (m *Member) Size() (n int) {
 	return n
 }
 
-func (m *GetMembersRequest) Size() (n int) {
+func (m *BootstrapResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
@@ -8013,42 +9005,28 @@ func (m *GetMembersRequest) Size() (n int) {
 	return n
 }
 
-func (m *GetMembersResponse) Size() (n int) {
+func (m *IsBootstrappedRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovPdpb(uint64(l))
-		}
-	}
-	if m.Leader != nil {
-		l = m.Leader.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.EtcdLeader != nil {
-		l = m.EtcdLeader.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *PeerStats) Size() (n int) {
+func (m *IsBootstrappedResponse) Size() (n int) {
 	var l int
 	_ = l
-	if m.Peer != nil {
-		l = m.Peer.Size()
+	if m.Header != nil {
+		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.DownSeconds != 0 {
-		n += 1 + sovPdpb(uint64(m.DownSeconds))
+	if m.Bootstrapped {
+		n += 2
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -8056,57 +9034,65 @@ func (m *PeerStats) Size() (n int) {
 	return n
 }
 
-func (m *RegionHeartbeatRequest) Size() (n int) {
+func (m *AllocIDRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.Region != nil {
-		l = m.Region.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	if m.Leader != nil {
-		l = m.Leader.Size()
+	return n
+}
+
+func (m *AllocIDResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if len(m.DownPeers) > 0 {
-		for _, e := range m.DownPeers {
-			l = e.Size()
-			n += 1 + l + sovPdpb(uint64(l))
-		}
-	}
-	if len(m.PendingPeers) > 0 {
-		for _, e := range m.PendingPeers {
-			l = e.Size()
-			n += 1 + l + sovPdpb(uint64(l))
-		}
-	}
-	if m.BytesWritten != 0 {
-		n += 1 + sovPdpb(uint64(m.BytesWritten))
+	if m.Id != 0 {
+		n += 1 + sovPdpb(uint64(m.Id))
 	}
-	if m.BytesRead != 0 {
-		n += 1 + sovPdpb(uint64(m.BytesRead))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	if m.KeysWritten != 0 {
-		n += 1 + sovPdpb(uint64(m.KeysWritten))
+	return n
+}
+
+func (m *GetStoreRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.KeysRead != 0 {
-		n += 1 + sovPdpb(uint64(m.KeysRead))
+	if m.StoreId != 0 {
+		n += 1 + sovPdpb(uint64(m.StoreId))
 	}
-	if m.ApproximateSize != 0 {
-		n += 1 + sovPdpb(uint64(m.ApproximateSize))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	if m.Interval != nil {
-		l = m.Interval.Size()
+	return n
+}
+
+func (m *GetStoreResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.ApproximateKeys != 0 {
-		n += 1 + sovPdpb(uint64(m.ApproximateKeys))
+	if m.Store != nil {
+		l = m.Store.Size()
+		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.Term != 0 {
-		n += 1 + sovPdpb(uint64(m.Term))
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovPdpb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -8114,15 +9100,16 @@ func (m *RegionHeartbeatRequest) Size() (n int) {
 	return n
 }
 
-func (m *ChangePeer) Size() (n int) {
+func (m *PutStoreRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.Peer != nil {
-		l = m.Peer.Size()
+	if m.Header != nil {
+		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.ChangeType != 0 {
-		n += 1 + sovPdpb(uint64(m.ChangeType))
+	if m.Store != nil {
+		l = m.Store.Size()
+		n += 1 + l + sovPdpb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -8130,11 +9117,11 @@ func (m *ChangePeer) Size() (n int) {
 	return n
 }
 
-func (m *TransferLeader) Size() (n int) {
+func (m *PutStoreResponse) Size() (n int) {
 	var l int
 	_ = l
-	if m.Peer != nil {
-		l = m.Peer.Size()
+	if m.Header != nil {
+		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -8143,28 +9130,32 @@ func (m *TransferLeader) Size() (n int) {
 	return n
 }
 
-func (m *Merge) Size() (n int) {
+func (m *GetAllStoresRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.Target != nil {
-		l = m.Target.Size()
+	if m.Header != nil {
+		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
+	if m.ExcludeTombstoneStores {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *SplitRegion) Size() (n int) {
+func (m *GetAllStoresResponse) Size() (n int) {
 	var l int
 	_ = l
-	if m.Policy != 0 {
-		n += 1 + sovPdpb(uint64(m.Policy))
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if len(m.Keys) > 0 {
-		for _, b := range m.Keys {
-			l = len(b)
+	if len(m.Stores) > 0 {
+		for _, e := range m.Stores {
+			l = e.Size()
 			n += 1 + l + sovPdpb(uint64(l))
 		}
 	}
@@ -8174,38 +9165,15 @@ func (m *SplitRegion) Size() (n int) {
 	return n
 }
 
-func (m *RegionHeartbeatResponse) Size() (n int) {
+func (m *GetRegionRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.ChangePeer != nil {
-		l = m.ChangePeer.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.TransferLeader != nil {
-		l = m.TransferLeader.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.RegionId != 0 {
-		n += 1 + sovPdpb(uint64(m.RegionId))
-	}
-	if m.RegionEpoch != nil {
-		l = m.RegionEpoch.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.TargetPeer != nil {
-		l = m.TargetPeer.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.Merge != nil {
-		l = m.Merge.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.SplitRegion != nil {
-		l = m.SplitRegion.Size()
+	l = len(m.RegionKey)
+	if l > 0 {
 		n += 1 + l + sovPdpb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -8214,7 +9182,7 @@ func (m *RegionHeartbeatResponse) Size() (n int) {
 	return n
 }
 
-func (m *AskSplitRequest) Size() (n int) {
+func (m *GetRegionResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
@@ -8225,28 +9193,31 @@ func (m *AskSplitRequest) Size() (n int) {
 		l = m.Region.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
+	if m.Leader != nil {
+		l = m.Leader.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if len(m.Slaves) > 0 {
+		for _, e := range m.Slaves {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *AskSplitResponse) Size() (n int) {
+func (m *GetRegionByIDRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.NewRegionId != 0 {
-		n += 1 + sovPdpb(uint64(m.NewRegionId))
-	}
-	if len(m.NewPeerIds) > 0 {
-		l = 0
-		for _, e := range m.NewPeerIds {
-			l += sovPdpb(uint64(e))
-		}
-		n += 1 + sovPdpb(uint64(l)) + l
+	if m.RegionId != 0 {
+		n += 1 + sovPdpb(uint64(m.RegionId))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -8254,19 +9225,22 @@ func (m *AskSplitResponse) Size() (n int) {
 	return n
 }
 
-func (m *ReportSplitRequest) Size() (n int) {
+func (m *ScanRegionsRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.Left != nil {
-		l = m.Left.Size()
+	l = len(m.StartKey)
+	if l > 0 {
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.Right != nil {
-		l = m.Right.Size()
+	if m.Limit != 0 {
+		n += 1 + sovPdpb(uint64(m.Limit))
+	}
+	l = len(m.EndKey)
+	if l > 0 {
 		n += 1 + l + sovPdpb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -8275,32 +9249,43 @@ func (m *ReportSplitRequest) Size() (n int) {
 	return n
 }
 
-func (m *ReportSplitResponse) Size() (n int) {
+func (m *ScanRegionsResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
+	if len(m.Regions) > 0 {
+		for _, e := range m.Regions {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if len(m.Leaders) > 0 {
+		for _, e := range m.Leaders {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *AskBatchSplitRequest) Size() (n int) {
+func (m *BatchGetRegionsRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.Region != nil {
-		l = m.Region.Size()
-		n += 1 + l + sovPdpb(uint64(l))
-	}
-	if m.SplitCount != 0 {
-		n += 1 + sovPdpb(uint64(m.SplitCount))
+	if len(m.RegionKeys) > 0 {
+		for _, b := range m.RegionKeys {
+			l = len(b)
+			n += 1 + l + sovPdpb(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -8308,18 +9293,24 @@ func (m *AskBatchSplitRequest) Size() (n int) {
 	return n
 }
 
-func (m *SplitID) Size() (n int) {
+func (m *BatchGetRegionsResponse) Size() (n int) {
 	var l int
 	_ = l
-	if m.NewRegionId != 0 {
-		n += 1 + sovPdpb(uint64(m.NewRegionId))
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if len(m.NewPeerIds) > 0 {
-		l = 0
-		for _, e := range m.NewPeerIds {
-			l += sovPdpb(uint64(e))
+	if len(m.Regions) > 0 {
+		for _, e := range m.Regions {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if len(m.Leaders) > 0 {
+		for _, e := range m.Leaders {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
 		}
-		n += 1 + sovPdpb(uint64(l)) + l
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -8327,37 +9318,29 @@ func (m *SplitID) Size() (n int) {
 	return n
 }
 
-func (m *AskBatchSplitResponse) Size() (n int) {
+func (m *GetClusterConfigRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if len(m.Ids) > 0 {
-		for _, e := range m.Ids {
-			l = e.Size()
-			n += 1 + l + sovPdpb(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ReportBatchSplitRequest) Size() (n int) {
+func (m *GetClusterConfigResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if len(m.Regions) > 0 {
-		for _, e := range m.Regions {
-			l = e.Size()
-			n += 1 + l + sovPdpb(uint64(l))
-		}
+	if m.Cluster != nil {
+		l = m.Cluster.Size()
+		n += 1 + l + sovPdpb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -8365,27 +9348,29 @@ func (m *ReportBatchSplitRequest) Size() (n int) {
 	return n
 }
 
-func (m *ReportBatchSplitResponse) Size() (n int) {
+func (m *PutClusterConfigRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
+	if m.Cluster != nil {
+		l = m.Cluster.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *TimeInterval) Size() (n int) {
+func (m *PutClusterConfigResponse) Size() (n int) {
 	var l int
 	_ = l
-	if m.StartTimestamp != 0 {
-		n += 1 + sovPdpb(uint64(m.StartTimestamp))
-	}
-	if m.EndTimestamp != 0 {
-		n += 1 + sovPdpb(uint64(m.EndTimestamp))
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -8393,15 +9378,30 @@ func (m *TimeInterval) Size() (n int) {
 	return n
 }
 
-func (m *RecordPair) Size() (n int) {
+func (m *Member) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Key)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.Value != 0 {
-		n += 1 + sovPdpb(uint64(m.Value))
+	if m.MemberId != 0 {
+		n += 1 + sovPdpb(uint64(m.MemberId))
+	}
+	if len(m.PeerUrls) > 0 {
+		for _, s := range m.PeerUrls {
+			l = len(s)
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if len(m.ClientUrls) > 0 {
+		for _, s := range m.ClientUrls {
+			l = len(s)
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if m.LeaderPriority != 0 {
+		n += 1 + sovPdpb(uint64(m.LeaderPriority))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -8409,94 +9409,38 @@ func (m *RecordPair) Size() (n int) {
 	return n
 }
 
-func (m *StoreStats) Size() (n int) {
+func (m *GetMembersRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.StoreId != 0 {
-		n += 1 + sovPdpb(uint64(m.StoreId))
-	}
-	if m.Capacity != 0 {
-		n += 1 + sovPdpb(uint64(m.Capacity))
-	}
-	if m.Available != 0 {
-		n += 1 + sovPdpb(uint64(m.Available))
-	}
-	if m.RegionCount != 0 {
-		n += 1 + sovPdpb(uint64(m.RegionCount))
-	}
-	if m.SendingSnapCount != 0 {
-		n += 1 + sovPdpb(uint64(m.SendingSnapCount))
-	}
-	if m.ReceivingSnapCount != 0 {
-		n += 1 + sovPdpb(uint64(m.ReceivingSnapCount))
-	}
-	if m.StartTime != 0 {
-		n += 1 + sovPdpb(uint64(m.StartTime))
-	}
-	if m.ApplyingSnapCount != 0 {
-		n += 1 + sovPdpb(uint64(m.ApplyingSnapCount))
-	}
-	if m.IsBusy {
-		n += 2
-	}
-	if m.UsedSize != 0 {
-		n += 1 + sovPdpb(uint64(m.UsedSize))
-	}
-	if m.BytesWritten != 0 {
-		n += 1 + sovPdpb(uint64(m.BytesWritten))
-	}
-	if m.KeysWritten != 0 {
-		n += 1 + sovPdpb(uint64(m.KeysWritten))
-	}
-	if m.BytesRead != 0 {
-		n += 1 + sovPdpb(uint64(m.BytesRead))
-	}
-	if m.KeysRead != 0 {
-		n += 1 + sovPdpb(uint64(m.KeysRead))
-	}
-	if m.Interval != nil {
-		l = m.Interval.Size()
+	if m.Header != nil {
+		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if len(m.CpuUsages) > 0 {
-		for _, e := range m.CpuUsages {
-			l = e.Size()
-			n += 2 + l + sovPdpb(uint64(l))
-		}
-	}
-	if len(m.ReadIoRates) > 0 {
-		for _, e := range m.ReadIoRates {
-			l = e.Size()
-			n += 2 + l + sovPdpb(uint64(l))
-		}
-	}
-	if len(m.WriteIoRates) > 0 {
-		for _, e := range m.WriteIoRates {
-			l = e.Size()
-			n += 2 + l + sovPdpb(uint64(l))
-		}
-	}
-	if len(m.OpLatencies) > 0 {
-		for _, e := range m.OpLatencies {
-			l = e.Size()
-			n += 2 + l + sovPdpb(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *StoreHeartbeatRequest) Size() (n int) {
+func (m *GetMembersResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.Stats != nil {
-		l = m.Stats.Size()
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if m.Leader != nil {
+		l = m.Leader.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.EtcdLeader != nil {
+		l = m.EtcdLeader.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -8505,29 +9449,29 @@ func (m *StoreHeartbeatRequest) Size() (n int) {
 	return n
 }
 
-func (m *StoreHeartbeatResponse) Size() (n int) {
+func (m *PeerStats) Size() (n int) {
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
+	if m.Peer != nil {
+		l = m.Peer.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
+	if m.DownSeconds != 0 {
+		n += 1 + sovPdpb(uint64(m.DownSeconds))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ScatterRegionRequest) Size() (n int) {
+func (m *RegionHeartbeatRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.RegionId != 0 {
-		n += 1 + sovPdpb(uint64(m.RegionId))
-	}
 	if m.Region != nil {
 		l = m.Region.Size()
 		n += 1 + l + sovPdpb(uint64(l))
@@ -8536,30 +9480,73 @@ func (m *ScatterRegionRequest) Size() (n int) {
 		l = m.Leader.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
+	if len(m.DownPeers) > 0 {
+		for _, e := range m.DownPeers {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if len(m.PendingPeers) > 0 {
+		for _, e := range m.PendingPeers {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if m.BytesWritten != 0 {
+		n += 1 + sovPdpb(uint64(m.BytesWritten))
+	}
+	if m.BytesRead != 0 {
+		n += 1 + sovPdpb(uint64(m.BytesRead))
+	}
+	if m.KeysWritten != 0 {
+		n += 1 + sovPdpb(uint64(m.KeysWritten))
+	}
+	if m.KeysRead != 0 {
+		n += 1 + sovPdpb(uint64(m.KeysRead))
+	}
+	if m.ApproximateSize != 0 {
+		n += 1 + sovPdpb(uint64(m.ApproximateSize))
+	}
+	if m.Interval != nil {
+		l = m.Interval.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.ApproximateKeys != 0 {
+		n += 1 + sovPdpb(uint64(m.ApproximateKeys))
+	}
+	if m.Term != 0 {
+		n += 1 + sovPdpb(uint64(m.Term))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ScatterRegionResponse) Size() (n int) {
+func (m *ChangePeer) Size() (n int) {
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
+	if m.Peer != nil {
+		l = m.Peer.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
+	if m.ChangeType != 0 {
+		n += 1 + sovPdpb(uint64(m.ChangeType))
+	}
+	if m.Repair {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GetGCSafePointRequest) Size() (n int) {
+func (m *TransferLeader) Size() (n int) {
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
+	if m.Peer != nil {
+		l = m.Peer.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -8568,31 +9555,30 @@ func (m *GetGCSafePointRequest) Size() (n int) {
 	return n
 }
 
-func (m *GetGCSafePointResponse) Size() (n int) {
+func (m *Merge) Size() (n int) {
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
+	if m.Target != nil {
+		l = m.Target.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.SafePoint != 0 {
-		n += 1 + sovPdpb(uint64(m.SafePoint))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *UpdateGCSafePointRequest) Size() (n int) {
+func (m *SplitRegion) Size() (n int) {
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovPdpb(uint64(l))
+	if m.Policy != 0 {
+		n += 1 + sovPdpb(uint64(m.Policy))
 	}
-	if m.SafePoint != 0 {
-		n += 1 + sovPdpb(uint64(m.SafePoint))
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			l = len(b)
+			n += 1 + l + sovPdpb(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -8600,15 +9586,45 @@ func (m *UpdateGCSafePointRequest) Size() (n int) {
 	return n
 }
 
-func (m *UpdateGCSafePointResponse) Size() (n int) {
+func (m *RegionHeartbeatResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.NewSafePoint != 0 {
-		n += 1 + sovPdpb(uint64(m.NewSafePoint))
+	if m.ChangePeer != nil {
+		l = m.ChangePeer.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.TransferLeader != nil {
+		l = m.TransferLeader.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.RegionId != 0 {
+		n += 1 + sovPdpb(uint64(m.RegionId))
+	}
+	if m.RegionEpoch != nil {
+		l = m.RegionEpoch.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.TargetPeer != nil {
+		l = m.TargetPeer.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Merge != nil {
+		l = m.Merge.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.SplitRegion != nil {
+		l = m.SplitRegion.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.OperatorId != 0 {
+		n += 1 + sovPdpb(uint64(m.OperatorId))
+	}
+	if m.OperatorStep != 0 {
+		n += 1 + sovPdpb(uint64(m.OperatorStep))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -8616,19 +9632,39 @@ func (m *UpdateGCSafePointResponse) Size() (n int) {
 	return n
 }
 
-func (m *SyncRegionRequest) Size() (n int) {
+func (m *AskSplitRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.Member != nil {
-		l = m.Member.Size()
+	if m.Region != nil {
+		l = m.Region.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.StartIndex != 0 {
-		n += 1 + sovPdpb(uint64(m.StartIndex))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AskSplitResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.NewRegionId != 0 {
+		n += 1 + sovPdpb(uint64(m.NewRegionId))
+	}
+	if len(m.NewPeerIds) > 0 {
+		l = 0
+		for _, e := range m.NewPeerIds {
+			l += sovPdpb(uint64(e))
+		}
+		n += 1 + sovPdpb(uint64(l)) + l
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -8636,37 +9672,110 @@ func (m *SyncRegionRequest) Size() (n int) {
 	return n
 }
 
-func (m *SyncRegionResponse) Size() (n int) {
+func (m *ReportSplitRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if len(m.Regions) > 0 {
-		for _, e := range m.Regions {
+	if m.Left != nil {
+		l = m.Left.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Right != nil {
+		l = m.Right.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReportSplitResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AskBatchSplitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Region != nil {
+		l = m.Region.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.SplitCount != 0 {
+		n += 1 + sovPdpb(uint64(m.SplitCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SplitID) Size() (n int) {
+	var l int
+	_ = l
+	if m.NewRegionId != 0 {
+		n += 1 + sovPdpb(uint64(m.NewRegionId))
+	}
+	if len(m.NewPeerIds) > 0 {
+		l = 0
+		for _, e := range m.NewPeerIds {
+			l += sovPdpb(uint64(e))
+		}
+		n += 1 + sovPdpb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AskBatchSplitResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		for _, e := range m.Ids {
 			l = e.Size()
 			n += 1 + l + sovPdpb(uint64(l))
 		}
 	}
-	if m.StartIndex != 0 {
-		n += 1 + sovPdpb(uint64(m.StartIndex))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GetOperatorRequest) Size() (n int) {
+func (m *ReportBatchSplitRequest) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.RegionId != 0 {
-		n += 1 + sovPdpb(uint64(m.RegionId))
+	if len(m.Regions) > 0 {
+		for _, e := range m.Regions {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -8674,47 +9783,1287 @@ func (m *GetOperatorRequest) Size() (n int) {
 	return n
 }
 
-func (m *GetOperatorResponse) Size() (n int) {
+func (m *ReportBatchSplitResponse) Size() (n int) {
 	var l int
 	_ = l
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 1 + l + sovPdpb(uint64(l))
 	}
-	if m.RegionId != 0 {
-		n += 1 + sovPdpb(uint64(m.RegionId))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	l = len(m.Desc)
-	if l > 0 {
-		n += 1 + l + sovPdpb(uint64(l))
+	return n
+}
+
+func (m *TimeInterval) Size() (n int) {
+	var l int
+	_ = l
+	if m.StartTimestamp != 0 {
+		n += 1 + sovPdpb(uint64(m.StartTimestamp))
 	}
-	if m.Status != 0 {
-		n += 1 + sovPdpb(uint64(m.Status))
+	if m.EndTimestamp != 0 {
+		n += 1 + sovPdpb(uint64(m.EndTimestamp))
 	}
-	l = len(m.Kind)
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RecordPair) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
 	if l > 0 {
 		n += 1 + l + sovPdpb(uint64(l))
 	}
+	if m.Value != 0 {
+		n += 1 + sovPdpb(uint64(m.Value))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func sovPdpb(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *StoreStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.StoreId != 0 {
+		n += 1 + sovPdpb(uint64(m.StoreId))
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovPdpb(uint64(m.Capacity))
+	}
+	if m.Available != 0 {
+		n += 1 + sovPdpb(uint64(m.Available))
+	}
+	if m.RegionCount != 0 {
+		n += 1 + sovPdpb(uint64(m.RegionCount))
+	}
+	if m.SendingSnapCount != 0 {
+		n += 1 + sovPdpb(uint64(m.SendingSnapCount))
+	}
+	if m.ReceivingSnapCount != 0 {
+		n += 1 + sovPdpb(uint64(m.ReceivingSnapCount))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovPdpb(uint64(m.StartTime))
+	}
+	if m.ApplyingSnapCount != 0 {
+		n += 1 + sovPdpb(uint64(m.ApplyingSnapCount))
+	}
+	if m.IsBusy {
+		n += 2
+	}
+	if m.UsedSize != 0 {
+		n += 1 + sovPdpb(uint64(m.UsedSize))
+	}
+	if m.BytesWritten != 0 {
+		n += 1 + sovPdpb(uint64(m.BytesWritten))
+	}
+	if m.KeysWritten != 0 {
+		n += 1 + sovPdpb(uint64(m.KeysWritten))
+	}
+	if m.BytesRead != 0 {
+		n += 1 + sovPdpb(uint64(m.BytesRead))
+	}
+	if m.KeysRead != 0 {
+		n += 1 + sovPdpb(uint64(m.KeysRead))
+	}
+	if m.Interval != nil {
+		l = m.Interval.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if len(m.CpuUsages) > 0 {
+		for _, e := range m.CpuUsages {
+			l = e.Size()
+			n += 2 + l + sovPdpb(uint64(l))
 		}
 	}
-	return n
-}
-func sozPdpb(x uint64) (n int) {
-	return sovPdpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if len(m.ReadIoRates) > 0 {
+		for _, e := range m.ReadIoRates {
+			l = e.Size()
+			n += 2 + l + sovPdpb(uint64(l))
+		}
+	}
+	if len(m.WriteIoRates) > 0 {
+		for _, e := range m.WriteIoRates {
+			l = e.Size()
+			n += 2 + l + sovPdpb(uint64(l))
+		}
+	}
+	if len(m.OpLatencies) > 0 {
+		for _, e := range m.OpLatencies {
+			l = e.Size()
+			n += 2 + l + sovPdpb(uint64(l))
+		}
+	}
+	if m.BusyBackoffMs != 0 {
+		n += 2 + sovPdpb(uint64(m.BusyBackoffMs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OperatorResult) Size() (n int) {
+	var l int
+	_ = l
+	if m.RegionId != 0 {
+		n += 1 + sovPdpb(uint64(m.RegionId))
+	}
+	if m.OperatorId != 0 {
+		n += 1 + sovPdpb(uint64(m.OperatorId))
+	}
+	if m.OperatorStep != 0 {
+		n += 1 + sovPdpb(uint64(m.OperatorStep))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StoreHeartbeatRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if len(m.OperatorResults) > 0 {
+		for _, e := range m.OperatorResults {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StoreHeartbeatResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.ClusterReadOnly {
+		n += 2
+	}
+	if len(m.ReadOnlyRanges) > 0 {
+		for _, e := range m.ReadOnlyRanges {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if len(m.ResourceGroups) > 0 {
+		for _, e := range m.ResourceGroups {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScatterRegionRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.RegionId != 0 {
+		n += 1 + sovPdpb(uint64(m.RegionId))
+	}
+	if m.Region != nil {
+		l = m.Region.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Leader != nil {
+		l = m.Leader.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScatterRegionResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetSplitKeysRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if len(m.Keys) > 0 {
+		for _, b := range m.Keys {
+			l = len(b)
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetSplitKeysResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KeyRange) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetReadOnlyRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Cluster {
+		n += 2
+	}
+	if len(m.Ranges) > 0 {
+		for _, e := range m.Ranges {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetReadOnlyResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceGroup) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovPdpb(uint64(m.Weight))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetResourceGroupsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetResourceGroupsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetGCSafePointRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetGCSafePointResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.SafePoint != 0 {
+		n += 1 + sovPdpb(uint64(m.SafePoint))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateGCSafePointRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.SafePoint != 0 {
+		n += 1 + sovPdpb(uint64(m.SafePoint))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateGCSafePointResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.NewSafePoint != 0 {
+		n += 1 + sovPdpb(uint64(m.NewSafePoint))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncRegionRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Member != nil {
+		l = m.Member.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.StartIndex != 0 {
+		n += 1 + sovPdpb(uint64(m.StartIndex))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncRegionResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if len(m.Regions) > 0 {
+		for _, e := range m.Regions {
+			l = e.Size()
+			n += 1 + l + sovPdpb(uint64(l))
+		}
+	}
+	if m.StartIndex != 0 {
+		n += 1 + sovPdpb(uint64(m.StartIndex))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetOperatorRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.RegionId != 0 {
+		n += 1 + sovPdpb(uint64(m.RegionId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetOperatorResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.RegionId != 0 {
+		n += 1 + sovPdpb(uint64(m.RegionId))
+	}
+	l = len(m.Desc)
+	if l > 0 {
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovPdpb(uint64(m.Status))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovPdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPdpb(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozPdpb(x uint64) (n int) {
+	return sovPdpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RequestHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			m.ClusterId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			m.ClusterId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClusterId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Error) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Error: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Error: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (ErrorType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TsoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TsoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TsoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Timestamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Timestamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Timestamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Physical", wireType)
+			}
+			m.Physical = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Physical |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logical", wireType)
+			}
+			m.Logical = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Logical |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TsoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TsoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TsoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BootstrapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BootstrapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Store == nil {
+				m.Store = &metapb.Store{}
+			}
+			if err := m.Store.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Region == nil {
+				m.Region = &metapb.Region{}
+			}
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *RequestHeader) Unmarshal(dAtA []byte) error {
+func (m *BootstrapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8737,17 +11086,17 @@ func (m *RequestHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: BootstrapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BootstrapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			m.ClusterId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -8757,11 +11106,25 @@ func (m *RequestHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClusterId |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -8784,7 +11147,7 @@ func (m *RequestHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
+func (m *IsBootstrappedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8807,34 +11170,15 @@ func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResponseHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: IsBootstrappedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResponseHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IsBootstrappedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
-			}
-			m.ClusterId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClusterId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8858,10 +11202,10 @@ func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Error == nil {
-				m.Error = &Error{}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
 			}
-			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8887,7 +11231,7 @@ func (m *ResponseHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Error) Unmarshal(dAtA []byte) error {
+func (m *IsBootstrappedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8910,17 +11254,17 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Error: wiretype end group for non-group")
+			return fmt.Errorf("proto: IsBootstrappedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Error: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IsBootstrappedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			m.Type = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -8930,16 +11274,101 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= (ErrorType(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bootstrapped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Bootstrapped = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllocIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -8949,20 +11378,24 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8986,7 +11419,7 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TsoRequest) Unmarshal(dAtA []byte) error {
+func (m *AllocIDResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9009,10 +11442,10 @@ func (m *TsoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TsoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllocIDResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TsoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllocIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9042,7 +11475,7 @@ func (m *TsoRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &RequestHeader{}
+				m.Header = &ResponseHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -9050,9 +11483,9 @@ func (m *TsoRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Count = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -9062,7 +11495,7 @@ func (m *TsoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= (uint32(b) & 0x7F) << shift
+				m.Id |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9089,7 +11522,7 @@ func (m *TsoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Timestamp) Unmarshal(dAtA []byte) error {
+func (m *GetStoreRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9112,17 +11545,17 @@ func (m *Timestamp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Timestamp: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetStoreRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Timestamp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetStoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Physical", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			m.Physical = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -9132,16 +11565,30 @@ func (m *Timestamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Physical |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logical", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreId", wireType)
 			}
-			m.Logical = 0
+			m.StoreId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -9151,7 +11598,7 @@ func (m *Timestamp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Logical |= (int64(b) & 0x7F) << shift
+				m.StoreId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9178,7 +11625,7 @@ func (m *Timestamp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TsoResponse) Unmarshal(dAtA []byte) error {
+func (m *GetStoreResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9201,10 +11648,10 @@ func (m *TsoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TsoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetStoreResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TsoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetStoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9241,10 +11688,10 @@ func (m *TsoResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
 			}
-			m.Count = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -9254,14 +11701,28 @@ func (m *TsoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Store == nil {
+				m.Store = &metapb.Store{}
+			}
+			if err := m.Store.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9285,10 +11746,10 @@ func (m *TsoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Timestamp == nil {
-				m.Timestamp = &Timestamp{}
+			if m.Stats == nil {
+				m.Stats = &StoreStats{}
 			}
-			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9314,7 +11775,7 @@ func (m *TsoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
+func (m *PutStoreRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9337,10 +11798,10 @@ func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BootstrapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutStoreRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BootstrapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutStoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9409,39 +11870,6 @@ func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Region == nil {
-				m.Region = &metapb.Region{}
-			}
-			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -9464,7 +11892,7 @@ func (m *BootstrapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BootstrapResponse) Unmarshal(dAtA []byte) error {
+func (m *PutStoreResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9487,10 +11915,10 @@ func (m *BootstrapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BootstrapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutStoreResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BootstrapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutStoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9548,7 +11976,7 @@ func (m *BootstrapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IsBootstrappedRequest) Unmarshal(dAtA []byte) error {
+func (m *GetAllStoresRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9571,10 +11999,10 @@ func (m *IsBootstrappedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IsBootstrappedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAllStoresRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IsBootstrappedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAllStoresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9610,6 +12038,26 @@ func (m *IsBootstrappedRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeTombstoneStores", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeTombstoneStores = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -9632,7 +12080,7 @@ func (m *IsBootstrappedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IsBootstrappedResponse) Unmarshal(dAtA []byte) error {
+func (m *GetAllStoresResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9655,10 +12103,10 @@ func (m *IsBootstrappedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IsBootstrappedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAllStoresResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IsBootstrappedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAllStoresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9695,10 +12143,10 @@ func (m *IsBootstrappedResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bootstrapped", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -9708,12 +12156,23 @@ func (m *IsBootstrappedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Bootstrapped = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stores = append(m.Stores, &metapb.Store{})
+			if err := m.Stores[len(m.Stores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -9736,7 +12195,7 @@ func (m *IsBootstrappedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllocIDRequest) Unmarshal(dAtA []byte) error {
+func (m *GetRegionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9759,10 +12218,10 @@ func (m *AllocIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllocIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRegionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllocIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRegionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9798,6 +12257,37 @@ func (m *AllocIDRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegionKey = append(m.RegionKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.RegionKey == nil {
+				m.RegionKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -9820,7 +12310,7 @@ func (m *AllocIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllocIDResponse) Unmarshal(dAtA []byte) error {
+func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9843,10 +12333,10 @@ func (m *AllocIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllocIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRegionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllocIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRegionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9883,10 +12373,10 @@ func (m *AllocIDResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -9896,11 +12386,89 @@ func (m *AllocIDResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Region == nil {
+				m.Region = &metapb.Region{}
+			}
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Leader == nil {
+				m.Leader = &metapb.Peer{}
+			}
+			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slaves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slaves = append(m.Slaves, &metapb.Peer{})
+			if err := m.Slaves[len(m.Slaves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -9923,7 +12491,7 @@ func (m *AllocIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetStoreRequest) Unmarshal(dAtA []byte) error {
+func (m *GetRegionByIDRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9946,10 +12514,10 @@ func (m *GetStoreRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetStoreRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetRegionByIDRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetStoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetRegionByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9987,9 +12555,9 @@ func (m *GetStoreRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoreId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
 			}
-			m.StoreId = 0
+			m.RegionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -9999,7 +12567,7 @@ func (m *GetStoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StoreId |= (uint64(b) & 0x7F) << shift
+				m.RegionId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10026,7 +12594,7 @@ func (m *GetStoreRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetStoreResponse) Unmarshal(dAtA []byte) error {
+func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10049,10 +12617,10 @@ func (m *GetStoreResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetStoreResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScanRegionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetStoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScanRegionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10082,7 +12650,7 @@ func (m *GetStoreResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+				m.Header = &RequestHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -10090,9 +12658,9 @@ func (m *GetStoreResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -10102,30 +12670,47 @@ func (m *GetStoreResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Store == nil {
-				m.Store = &metapb.Store{}
-			}
-			if err := m.Store.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartKey == nil {
+				m.StartKey = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -10135,23 +12720,21 @@ func (m *GetStoreResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &StoreStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EndKey == nil {
+				m.EndKey = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -10176,7 +12759,7 @@ func (m *GetStoreResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutStoreRequest) Unmarshal(dAtA []byte) error {
+func (m *ScanRegionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10199,10 +12782,10 @@ func (m *PutStoreRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutStoreRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScanRegionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutStoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScanRegionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10232,7 +12815,7 @@ func (m *PutStoreRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &RequestHeader{}
+				m.Header = &ResponseHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -10240,7 +12823,7 @@ func (m *PutStoreRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10264,67 +12847,14 @@ func (m *PutStoreRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Store == nil {
-				m.Store = &metapb.Store{}
-			}
-			if err := m.Store.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Regions = append(m.Regions, &metapb.Region{})
+			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PutStoreResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPdpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PutStoreResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutStoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10348,10 +12878,8 @@ func (m *PutStoreResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Leaders = append(m.Leaders, &metapb.Peer{})
+			if err := m.Leaders[len(m.Leaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10377,7 +12905,7 @@ func (m *PutStoreResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAllStoresRequest) Unmarshal(dAtA []byte) error {
+func (m *BatchGetRegionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10400,10 +12928,10 @@ func (m *GetAllStoresRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllStoresRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchGetRegionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllStoresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchGetRegionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10440,10 +12968,10 @@ func (m *GetAllStoresRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeTombstoneStores", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionKeys", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -10453,12 +12981,21 @@ func (m *GetAllStoresRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ExcludeTombstoneStores = bool(v != 0)
+			if byteLen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegionKeys = append(m.RegionKeys, make([]byte, postIndex-iNdEx))
+			copy(m.RegionKeys[len(m.RegionKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -10481,7 +13018,7 @@ func (m *GetAllStoresRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAllStoresResponse) Unmarshal(dAtA []byte) error {
+func (m *BatchGetRegionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10504,10 +13041,10 @@ func (m *GetAllStoresResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllStoresResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchGetRegionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllStoresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchGetRegionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10545,7 +13082,7 @@ func (m *GetAllStoresResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10569,8 +13106,39 @@ func (m *GetAllStoresResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stores = append(m.Stores, &metapb.Store{})
-			if err := m.Stores[len(m.Stores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Regions = append(m.Regions, &metapb.Region{})
+			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leaders = append(m.Leaders, &metapb.Peer{})
+			if err := m.Leaders[len(m.Leaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10596,7 +13164,7 @@ func (m *GetAllStoresResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRegionRequest) Unmarshal(dAtA []byte) error {
+func (m *GetClusterConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10619,10 +13187,10 @@ func (m *GetRegionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRegionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetClusterConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRegionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetClusterConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10658,37 +13226,6 @@ func (m *GetRegionRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RegionKey = append(m.RegionKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.RegionKey == nil {
-				m.RegionKey = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -10711,7 +13248,7 @@ func (m *GetRegionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
+func (m *GetClusterConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10734,10 +13271,10 @@ func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRegionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetClusterConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRegionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetClusterConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10775,7 +13312,7 @@ func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10792,23 +13329,74 @@ func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cluster == nil {
+				m.Cluster = &metapb.Cluster{}
+			}
+			if err := m.Cluster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Region == nil {
-				m.Region = &metapb.Region{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutClusterConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
 			}
-			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutClusterConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutClusterConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10832,16 +13420,16 @@ func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Leader == nil {
-				m.Leader = &metapb.Peer{}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
 			}
-			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slaves", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10865,8 +13453,10 @@ func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Slaves = append(m.Slaves, &metapb.Peer{})
-			if err := m.Slaves[len(m.Slaves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Cluster == nil {
+				m.Cluster = &metapb.Cluster{}
+			}
+			if err := m.Cluster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10892,7 +13482,7 @@ func (m *GetRegionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRegionByIDRequest) Unmarshal(dAtA []byte) error {
+func (m *PutClusterConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10915,10 +13505,10 @@ func (m *GetRegionByIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRegionByIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutClusterConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRegionByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutClusterConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10948,31 +13538,12 @@ func (m *GetRegionByIDRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &RequestHeader{}
+				m.Header = &ResponseHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
-			}
-			m.RegionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RegionId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -10995,7 +13566,7 @@ func (m *GetRegionByIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
+func (m *Member) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11018,17 +13589,17 @@ func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScanRegionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScanRegionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -11038,30 +13609,45 @@ func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberId", wireType)
+			}
+			m.MemberId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemberId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerUrls", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -11071,28 +13657,55 @@ func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.StartKey == nil {
-				m.StartKey = []byte{}
+			m.PeerUrls = append(m.PeerUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ClientUrls = append(m.ClientUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderPriority", wireType)
 			}
-			m.Limit = 0
+			m.LeaderPriority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -11102,16 +13715,67 @@ func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= (int32(b) & 0x7F) << shift
+				m.LeaderPriority |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMembersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMembersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -11121,21 +13785,23 @@ func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.EndKey == nil {
-				m.EndKey = []byte{}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -11160,7 +13826,7 @@ func (m *ScanRegionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScanRegionsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetMembersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11183,10 +13849,10 @@ func (m *ScanRegionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScanRegionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMembersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScanRegionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11224,7 +13890,7 @@ func (m *ScanRegionsResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11248,14 +13914,14 @@ func (m *ScanRegionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Regions = append(m.Regions, &metapb.Region{})
-			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Members = append(m.Members, &Member{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leaders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11279,8 +13945,43 @@ func (m *ScanRegionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Leaders = append(m.Leaders, &metapb.Peer{})
-			if err := m.Leaders[len(m.Leaders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Leader == nil {
+				m.Leader = &Member{}
+			}
+			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EtcdLeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EtcdLeader == nil {
+				m.EtcdLeader = &Member{}
+			}
+			if err := m.EtcdLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11306,7 +14007,7 @@ func (m *ScanRegionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetClusterConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *PeerStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11329,15 +14030,15 @@ func (m *GetClusterConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetClusterConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PeerStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetClusterConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PeerStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11361,13 +14062,32 @@ func (m *GetClusterConfigRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
+			if m.Peer == nil {
+				m.Peer = &metapb.Peer{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownSeconds", wireType)
+			}
+			m.DownSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownSeconds |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -11390,7 +14110,7 @@ func (m *GetClusterConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetClusterConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11413,10 +14133,10 @@ func (m *GetClusterConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetClusterConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegionHeartbeatRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetClusterConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegionHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11446,7 +14166,7 @@ func (m *GetClusterConfigResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+				m.Header = &RequestHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -11454,7 +14174,7 @@ func (m *GetClusterConfigResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11478,69 +14198,151 @@ func (m *GetClusterConfigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cluster == nil {
-				m.Cluster = &metapb.Cluster{}
+			if m.Region == nil {
+				m.Region = &metapb.Region{}
 			}
-			if err := m.Cluster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPdpb(dAtA[iNdEx:])
-			if err != nil {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Leader == nil {
+				m.Leader = &metapb.Peer{}
+			}
+			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownPeers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PutClusterConfigRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPdpb
+			m.DownPeers = append(m.DownPeers, &PeerStats{})
+			if err := m.DownPeers[len(m.DownPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPeers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PendingPeers = append(m.PendingPeers, &metapb.Peer{})
+			if err := m.PendingPeers[len(m.PendingPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesWritten", wireType)
+			}
+			m.BytesWritten = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesWritten |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesRead", wireType)
+			}
+			m.BytesRead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesRead |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PutClusterConfigRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutClusterConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysWritten", wireType)
 			}
-			var msglen int
+			m.KeysWritten = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -11550,28 +14352,52 @@ func (m *PutClusterConfigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.KeysWritten |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysRead", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.KeysRead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeysRead |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateSize", wireType)
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ApproximateSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApproximateSize |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11595,69 +14421,18 @@ func (m *PutClusterConfigRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cluster == nil {
-				m.Cluster = &metapb.Cluster{}
+			if m.Interval == nil {
+				m.Interval = &TimeInterval{}
 			}
-			if err := m.Cluster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PutClusterConfigResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPdpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PutClusterConfigResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutClusterConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateKeys", wireType)
 			}
-			var msglen int
+			m.ApproximateKeys = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -11667,25 +14442,30 @@ func (m *PutClusterConfigResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ApproximateKeys |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Term = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Term |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -11708,7 +14488,7 @@ func (m *PutClusterConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Member) Unmarshal(dAtA []byte) error {
+func (m *ChangePeer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11731,17 +14511,17 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangePeer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangePeer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -11751,26 +14531,30 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Peer == nil {
+				m.Peer = &metapb.Peer{}
+			}
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
 			}
-			m.MemberId = 0
+			m.ChangeType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -11780,74 +14564,16 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MemberId |= (uint64(b) & 0x7F) << shift
+				m.ChangeType |= (eraftpb.ConfChangeType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerUrls", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PeerUrls = append(m.PeerUrls, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientUrls", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientUrls = append(m.ClientUrls, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaderPriority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repair", wireType)
 			}
-			m.LeaderPriority = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -11857,11 +14583,12 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LeaderPriority |= (int32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Repair = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -11884,7 +14611,7 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMembersRequest) Unmarshal(dAtA []byte) error {
+func (m *TransferLeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11907,15 +14634,15 @@ func (m *GetMembersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMembersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferLeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferLeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11939,10 +14666,10 @@ func (m *GetMembersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
+			if m.Peer == nil {
+				m.Peer = &metapb.Peer{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11968,7 +14695,7 @@ func (m *GetMembersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMembersResponse) Unmarshal(dAtA []byte) error {
+func (m *Merge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11991,112 +14718,15 @@ func (m *GetMembersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMembersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Merge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Merge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Members = append(m.Members, &Member{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Leader == nil {
-				m.Leader = &Member{}
-			}
-			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EtcdLeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12120,10 +14750,10 @@ func (m *GetMembersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EtcdLeader == nil {
-				m.EtcdLeader = &Member{}
+			if m.Target == nil {
+				m.Target = &metapb.Region{}
 			}
-			if err := m.EtcdLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12149,7 +14779,7 @@ func (m *GetMembersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PeerStats) Unmarshal(dAtA []byte) error {
+func (m *SplitRegion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12172,17 +14802,17 @@ func (m *PeerStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PeerStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: SplitRegion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PeerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SplitRegion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var msglen int
+			m.Policy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -12192,30 +14822,16 @@ func (m *PeerStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Policy |= (CheckPolicy(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Peer == nil {
-				m.Peer = &metapb.Peer{}
-			}
-			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownSeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			m.DownSeconds = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -12225,11 +14841,21 @@ func (m *PeerStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DownSeconds |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
+			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -12252,7 +14878,7 @@ func (m *PeerStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
+func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12275,10 +14901,10 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegionHeartbeatRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegionHeartbeatResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegionHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegionHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12308,7 +14934,7 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &RequestHeader{}
+				m.Header = &ResponseHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -12316,7 +14942,7 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12340,16 +14966,16 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Region == nil {
-				m.Region = &metapb.Region{}
+			if m.ChangePeer == nil {
+				m.ChangePeer = &ChangePeer{}
 			}
-			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChangePeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLeader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12373,18 +14999,18 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Leader == nil {
-				m.Leader = &metapb.Peer{}
+			if m.TransferLeader == nil {
+				m.TransferLeader = &TransferLeader{}
 			}
-			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TransferLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownPeers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
 			}
-			var msglen int
+			m.RegionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -12394,26 +15020,14 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.RegionId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DownPeers = append(m.DownPeers, &PeerStats{})
-			if err := m.DownPeers[len(m.DownPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingPeers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionEpoch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12437,16 +15051,18 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingPeers = append(m.PendingPeers, &metapb.Peer{})
-			if err := m.PendingPeers[len(m.PendingPeers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RegionEpoch == nil {
+				m.RegionEpoch = &metapb.RegionEpoch{}
+			}
+			if err := m.RegionEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BytesWritten", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPeer", wireType)
 			}
-			m.BytesWritten = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -12456,16 +15072,30 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BytesWritten |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TargetPeer == nil {
+				m.TargetPeer = &metapb.Peer{}
+			}
+			if err := m.TargetPeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BytesRead", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
 			}
-			m.BytesRead = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -12475,16 +15105,30 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BytesRead |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Merge == nil {
+				m.Merge = &Merge{}
+			}
+			if err := m.Merge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeysWritten", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitRegion", wireType)
 			}
-			m.KeysWritten = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -12494,16 +15138,30 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeysWritten |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SplitRegion == nil {
+				m.SplitRegion = &SplitRegion{}
+			}
+			if err := m.SplitRegion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeysRead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
 			}
-			m.KeysRead = 0
+			m.OperatorId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -12513,16 +15171,16 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeysRead |= (uint64(b) & 0x7F) << shift
+				m.OperatorId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorStep", wireType)
 			}
-			m.ApproximateSize = 0
+			m.OperatorStep = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -12532,14 +15190,65 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApproximateSize |= (uint64(b) & 0x7F) << shift
+				m.OperatorStep |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AskSplitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AskSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12563,18 +15272,18 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Interval == nil {
-				m.Interval = &TimeInterval{}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
 			}
-			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateKeys", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			m.ApproximateKeys = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -12584,30 +15293,25 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ApproximateKeys |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
 			}
-			m.Term = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Term |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			if m.Region == nil {
+				m.Region = &metapb.Region{}
+			}
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -12630,7 +15334,7 @@ func (m *RegionHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangePeer) Unmarshal(dAtA []byte) error {
+func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12653,15 +15357,15 @@ func (m *ChangePeer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangePeer: wiretype end group for non-group")
+			return fmt.Errorf("proto: AskSplitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangePeer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AskSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12685,18 +15389,18 @@ func (m *ChangePeer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Peer == nil {
-				m.Peer = &metapb.Peer{}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
 			}
-			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRegionId", wireType)
 			}
-			m.ChangeType = 0
+			m.NewRegionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -12706,11 +15410,73 @@ func (m *ChangePeer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChangeType |= (eraftpb.ConfChangeType(b) & 0x7F) << shift
+				m.NewRegionId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPdpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NewPeerIds = append(m.NewPeerIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPdpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPdpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPdpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NewPeerIds = append(m.NewPeerIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -12733,7 +15499,7 @@ func (m *ChangePeer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferLeader) Unmarshal(dAtA []byte) error {
+func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12756,15 +15522,15 @@ func (m *TransferLeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransferLeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReportSplitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferLeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReportSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12788,67 +15554,49 @@ func (m *TransferLeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Peer == nil {
-				m.Peer = &metapb.Peer{}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
 			}
-			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Left", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Merge) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPdpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Left == nil {
+				m.Left = &metapb.Region{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Left.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Merge: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Merge: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Right", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12872,10 +15620,10 @@ func (m *Merge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Target == nil {
-				m.Target = &metapb.Region{}
+			if m.Right == nil {
+				m.Right = &metapb.Region{}
 			}
-			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Right.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12901,7 +15649,7 @@ func (m *Merge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SplitRegion) Unmarshal(dAtA []byte) error {
+func (m *ReportSplitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12924,36 +15672,17 @@ func (m *SplitRegion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SplitRegion: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReportSplitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SplitRegion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReportSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
-			}
-			m.Policy = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Policy |= (CheckPolicy(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -12963,20 +15692,24 @@ func (m *SplitRegion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
-			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13000,7 +15733,7 @@ func (m *SplitRegion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
+func (m *AskBatchSplitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13023,10 +15756,10 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegionHeartbeatResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AskBatchSplitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegionHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AskBatchSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13056,7 +15789,7 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+				m.Header = &RequestHeader{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -13064,7 +15797,7 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13088,18 +15821,18 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePeer == nil {
-				m.ChangePeer = &ChangePeer{}
+			if m.Region == nil {
+				m.Region = &metapb.Region{}
 			}
-			if err := m.ChangePeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferLeader", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitCount", wireType)
 			}
-			var msglen int
+			m.SplitCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13109,30 +15842,67 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.SplitCount |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TransferLeader == nil {
-				m.TransferLeader = &TransferLeader{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SplitID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
 			}
-			if err := m.TransferLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SplitID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SplitID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRegionId", wireType)
 			}
-			m.RegionId = 0
+			m.NewRegionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13142,80 +15912,127 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RegionId |= (uint64(b) & 0x7F) << shift
+				m.NewRegionId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionEpoch", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPdpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RegionEpoch == nil {
-				m.RegionEpoch = &metapb.RegionEpoch{}
-			}
-			if err := m.RegionEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetPeer", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
+				m.NewPeerIds = append(m.NewPeerIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPdpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				if packedLen < 0 {
+					return ErrInvalidLengthPdpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPdpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NewPeerIds = append(m.NewPeerIds, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIds", wireType)
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TargetPeer == nil {
-				m.TargetPeer = &metapb.Peer{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AskBatchSplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
 			}
-			if err := m.TargetPeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AskBatchSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AskBatchSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13239,16 +16056,16 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Merge == nil {
-				m.Merge = &Merge{}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
 			}
-			if err := m.Merge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SplitRegion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13272,10 +16089,8 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SplitRegion == nil {
-				m.SplitRegion = &SplitRegion{}
-			}
-			if err := m.SplitRegion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Ids = append(m.Ids, &SplitID{})
+			if err := m.Ids[len(m.Ids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13301,7 +16116,7 @@ func (m *RegionHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
+func (m *ReportBatchSplitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13324,10 +16139,10 @@ func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AskSplitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReportBatchSplitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AskSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReportBatchSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13365,7 +16180,7 @@ func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13389,10 +16204,8 @@ func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Region == nil {
-				m.Region = &metapb.Region{}
-			}
-			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Regions = append(m.Regions, &metapb.Region{})
+			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13418,7 +16231,7 @@ func (m *AskSplitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
+func (m *ReportBatchSplitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13441,10 +16254,10 @@ func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AskSplitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReportBatchSplitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AskSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReportBatchSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13480,87 +16293,6 @@ func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRegionId", wireType)
-			}
-			m.NewRegionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewRegionId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPdpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.NewPeerIds = append(m.NewPeerIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPdpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPdpb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPdpb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.NewPeerIds = append(m.NewPeerIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIds", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -13583,7 +16315,7 @@ func (m *AskSplitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
+func (m *TimeInterval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13606,50 +16338,17 @@ func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReportSplitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimeInterval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimeInterval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Left", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
 			}
-			var msglen int
+			m.StartTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13659,30 +16358,16 @@ func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.StartTimestamp |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Left == nil {
-				m.Left = &metapb.Region{}
-			}
-			if err := m.Left.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Right", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTimestamp", wireType)
 			}
-			var msglen int
+			m.EndTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13692,25 +16377,11 @@ func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.EndTimestamp |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Right == nil {
-				m.Right = &metapb.Region{}
-			}
-			if err := m.Right.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -13733,7 +16404,7 @@ func (m *ReportSplitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReportSplitResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13756,17 +16427,17 @@ func (m *ReportSplitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReportSplitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordPair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13776,25 +16447,40 @@ func (m *ReportSplitResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -13817,7 +16503,7 @@ func (m *ReportSplitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AskBatchSplitRequest) Unmarshal(dAtA []byte) error {
+func (m *StoreStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13840,17 +16526,189 @@ func (m *AskBatchSplitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AskBatchSplitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoreStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AskBatchSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoreStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreId", wireType)
+			}
+			m.StoreId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoreId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			m.Available = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Available |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionCount", wireType)
+			}
+			m.RegionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionCount |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendingSnapCount", wireType)
+			}
+			m.SendingSnapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendingSnapCount |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivingSnapCount", wireType)
+			}
+			m.ReceivingSnapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivingSnapCount |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyingSnapCount", wireType)
+			}
+			m.ApplyingSnapCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApplyingSnapCount |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBusy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+			m.IsBusy = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedSize", wireType)
+			}
+			m.UsedSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13860,30 +16718,16 @@ func (m *AskBatchSplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.UsedSize |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesWritten", wireType)
 			}
-			var msglen int
+			m.BytesWritten = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13893,30 +16737,16 @@ func (m *AskBatchSplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.BytesWritten |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Region == nil {
-				m.Region = &metapb.Region{}
-			}
-			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SplitCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysWritten", wireType)
 			}
-			m.SplitCount = 0
+			m.KeysWritten = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13926,67 +16756,16 @@ func (m *AskBatchSplitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SplitCount |= (uint32(b) & 0x7F) << shift
+				m.KeysWritten |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SplitID) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPdpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SplitID: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SplitID: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRegionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesRead", wireType)
 			}
-			m.NewRegionId = 0
+			m.BytesRead = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -13996,127 +16775,66 @@ func (m *SplitID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewRegionId |= (uint64(b) & 0x7F) << shift
+				m.BytesRead |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPdpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeysRead", wireType)
+			}
+			m.KeysRead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
 				}
-				m.NewPeerIds = append(m.NewPeerIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPdpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPdpb
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeysRead |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPdpb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.NewPeerIds = append(m.NewPeerIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIds", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
 			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AskBatchSplitResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPdpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Interval == nil {
+				m.Interval = &TimeInterval{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AskBatchSplitResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AskBatchSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuUsages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14140,16 +16858,14 @@ func (m *AskBatchSplitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CpuUsages = append(m.CpuUsages, &RecordPair{})
+			if err := m.CpuUsages[len(m.CpuUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadIoRates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14173,65 +16889,14 @@ func (m *AskBatchSplitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ids = append(m.Ids, &SplitID{})
-			if err := m.Ids[len(m.Ids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReadIoRates = append(m.ReadIoRates, &RecordPair{})
+			if err := m.ReadIoRates[len(m.ReadIoRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPdpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReportBatchSplitRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPdpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReportBatchSplitRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportBatchSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteIoRates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14255,16 +16920,14 @@ func (m *ReportBatchSplitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WriteIoRates = append(m.WriteIoRates, &RecordPair{})
+			if err := m.WriteIoRates[len(m.WriteIoRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpLatencies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14288,11 +16951,30 @@ func (m *ReportBatchSplitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Regions = append(m.Regions, &metapb.Region{})
-			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OpLatencies = append(m.OpLatencies, &RecordPair{})
+			if err := m.OpLatencies[len(m.OpLatencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BusyBackoffMs", wireType)
+			}
+			m.BusyBackoffMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BusyBackoffMs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -14315,7 +16997,7 @@ func (m *ReportBatchSplitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReportBatchSplitResponse) Unmarshal(dAtA []byte) error {
+func (m *OperatorResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14338,17 +17020,94 @@ func (m *ReportBatchSplitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReportBatchSplitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OperatorResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportBatchSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OperatorResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
+			}
+			m.RegionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorId", wireType)
+			}
+			m.OperatorId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorStep", wireType)
+			}
+			m.OperatorStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatorStep |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14358,24 +17117,20 @@ func (m *ReportBatchSplitResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResponseHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14399,7 +17154,7 @@ func (m *ReportBatchSplitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TimeInterval) Unmarshal(dAtA []byte) error {
+func (m *StoreHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14422,36 +17177,17 @@ func (m *TimeInterval) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TimeInterval: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoreHeartbeatRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TimeInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoreHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
-			}
-			m.StartTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTimestamp |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTimestamp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			m.EndTimestamp = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14461,67 +17197,30 @@ func (m *TimeInterval) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndTimestamp |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RecordPair) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPdpb
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RecordPair: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordPair: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14531,26 +17230,30 @@ func (m *RecordPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if m.Stats == nil {
+				m.Stats = &StoreStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorResults", wireType)
 			}
-			m.Value = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14560,11 +17263,23 @@ func (m *RecordPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorResults = append(m.OperatorResults, &OperatorResult{})
+			if err := m.OperatorResults[len(m.OperatorResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPdpb(dAtA[iNdEx:])
@@ -14587,7 +17302,7 @@ func (m *RecordPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StoreStats) Unmarshal(dAtA []byte) error {
+func (m *StoreHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14610,17 +17325,17 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StoreStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoreHeartbeatResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StoreStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoreHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoreId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			m.StoreId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14630,16 +17345,30 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StoreId |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterReadOnly", wireType)
 			}
-			m.Capacity = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14649,16 +17378,17 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Capacity |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.ClusterReadOnly = bool(v != 0)
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnlyRanges", wireType)
 			}
-			m.Available = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14668,16 +17398,28 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Available |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReadOnlyRanges = append(m.ReadOnlyRanges, &KeyRange{})
+			if err := m.ReadOnlyRanges[len(m.ReadOnlyRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceGroups", wireType)
 			}
-			m.RegionCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14687,92 +17429,79 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RegionCount |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendingSnapCount", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
 			}
-			m.SendingSnapCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SendingSnapCount |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceivingSnapCount", wireType)
+			m.ResourceGroups = append(m.ResourceGroups, &ResourceGroup{})
+			if err := m.ResourceGroups[len(m.ResourceGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ReceivingSnapCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReceivingSnapCount |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
 			}
-			m.StartTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTime |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplyingSnapCount", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
 			}
-			m.ApplyingSnapCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ApplyingSnapCount |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBusy", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var v int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScatterRegionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScatterRegionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14782,17 +17511,30 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsBusy = bool(v != 0)
-		case 10:
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsedSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
 			}
-			m.UsedSize = 0
+			m.RegionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14802,16 +17544,16 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UsedSize |= (uint64(b) & 0x7F) << shift
+				m.RegionId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BytesWritten", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			m.BytesWritten = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14821,16 +17563,30 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BytesWritten |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeysWritten", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
 			}
-			m.KeysWritten = 0
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Region == nil {
+				m.Region = &metapb.Region{}
+			}
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14840,16 +17596,81 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeysWritten |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BytesRead", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
 			}
-			m.BytesRead = 0
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Leader == nil {
+				m.Leader = &metapb.Peer{}
+			}
+			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScatterRegionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScatterRegionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScatterRegionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14859,16 +17680,81 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BytesRead |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeysRead", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
 			}
-			m.KeysRead = 0
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetSplitKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetSplitKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetSplitKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14878,16 +17764,30 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.KeysRead |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+			if msglen < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14897,28 +17797,75 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Interval == nil {
-				m.Interval = &TimeInterval{}
+			m.Keys = append(m.Keys, make([]byte, postIndex-iNdEx))
+			copy(m.Keys[len(m.Keys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetSplitKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 16:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetSplitKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetSplitKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuUsages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14942,47 +17889,69 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CpuUsages = append(m.CpuUsages, &RecordPair{})
-			if err := m.CpuUsages[len(m.CpuUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadIoRates", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReadIoRates = append(m.ReadIoRates, &RecordPair{})
-			if err := m.ReadIoRates[len(m.ReadIoRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
 			}
-			iNdEx = postIndex
-		case 18:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WriteIoRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -14992,28 +17961,28 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WriteIoRates = append(m.WriteIoRates, &RecordPair{})
-			if err := m.WriteIoRates[len(m.WriteIoRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartKey == nil {
+				m.StartKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 19:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpLatencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -15023,21 +17992,21 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OpLatencies = append(m.OpLatencies, &RecordPair{})
-			if err := m.OpLatencies[len(m.OpLatencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EndKey == nil {
+				m.EndKey = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -15062,7 +18031,7 @@ func (m *StoreStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StoreHeartbeatRequest) Unmarshal(dAtA []byte) error {
+func (m *SetReadOnlyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15085,10 +18054,10 @@ func (m *StoreHeartbeatRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StoreHeartbeatRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetReadOnlyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StoreHeartbeatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetReadOnlyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15125,8 +18094,28 @@ func (m *StoreHeartbeatRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cluster = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ranges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15150,10 +18139,8 @@ func (m *StoreHeartbeatRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &StoreStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Ranges = append(m.Ranges, &KeyRange{})
+			if err := m.Ranges[len(m.Ranges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15179,7 +18166,7 @@ func (m *StoreHeartbeatRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StoreHeartbeatResponse) Unmarshal(dAtA []byte) error {
+func (m *SetReadOnlyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15202,10 +18189,10 @@ func (m *StoreHeartbeatResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StoreHeartbeatResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetReadOnlyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StoreHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetReadOnlyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15263,7 +18250,7 @@ func (m *StoreHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
+func (m *ResourceGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15286,17 +18273,17 @@ func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScatterRegionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScatterRegionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -15306,30 +18293,26 @@ func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPdpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &RequestHeader{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			m.RegionId = 0
+			m.Weight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPdpb
@@ -15339,14 +18322,65 @@ func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RegionId |= (uint64(b) & 0x7F) << shift
+				m.Weight |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetResourceGroupsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetResourceGroupsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetResourceGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15370,16 +18404,16 @@ func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Region == nil {
-				m.Region = &metapb.Region{}
+			if m.Header == nil {
+				m.Header = &RequestHeader{}
 			}
-			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15403,10 +18437,8 @@ func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Leader == nil {
-				m.Leader = &metapb.Peer{}
-			}
-			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Groups = append(m.Groups, &ResourceGroup{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15432,7 +18464,7 @@ func (m *ScatterRegionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScatterRegionResponse) Unmarshal(dAtA []byte) error {
+func (m *SetResourceGroupsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15455,10 +18487,10 @@ func (m *ScatterRegionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScatterRegionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetResourceGroupsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScatterRegionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetResourceGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16571,181 +19603,207 @@ var (
 	ErrIntOverflowPdpb   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("pdpb.proto", fileDescriptor_pdpb_4ddb592a4d17b00d) }
-
-var fileDescriptor_pdpb_4ddb592a4d17b00d = []byte{
-	// 2763 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0xcd, 0x72, 0x1b, 0xc7,
-	0xd1, 0x5c, 0x10, 0x00, 0x81, 0xc6, 0x0f, 0xc1, 0x21, 0x45, 0x42, 0x90, 0x25, 0xcb, 0x2b, 0x7d,
-	0xfe, 0x64, 0xc7, 0xa6, 0x6d, 0x59, 0xe5, 0x72, 0x55, 0xca, 0x29, 0x83, 0x20, 0x44, 0xc3, 0x22,
-	0x01, 0xd4, 0x00, 0xb4, 0xe3, 0xaa, 0x94, 0x37, 0xcb, 0xdd, 0x21, 0xb8, 0x21, 0xb8, 0xbb, 0xde,
-	0x59, 0x50, 0x86, 0x2b, 0x87, 0x9c, 0x92, 0x43, 0x9c, 0xa3, 0x53, 0xa9, 0x9c, 0xf2, 0x04, 0xb9,
-	0xa4, 0x92, 0x6b, 0xae, 0x39, 0xe6, 0x11, 0x52, 0xce, 0x13, 0xe4, 0x0d, 0x52, 0xf3, 0xb3, 0xbf,
-	0x00, 0x25, 0x66, 0x65, 0x9d, 0x88, 0xed, 0xee, 0xe9, 0xe9, 0xbf, 0xe9, 0xe9, 0xe9, 0x26, 0x80,
-	0x6b, 0xba, 0x27, 0xbb, 0xae, 0xe7, 0xf8, 0x0e, 0xca, 0xb3, 0xdf, 0xad, 0xea, 0x05, 0xf1, 0xf5,
-	0x00, 0xd6, 0xaa, 0x11, 0x4f, 0x3f, 0xf5, 0xc3, 0xcf, 0xad, 0x89, 0x33, 0x71, 0xf8, 0xcf, 0x77,
-	0xd8, 0x2f, 0x01, 0x55, 0x77, 0xa1, 0x86, 0xc9, 0x57, 0x33, 0x42, 0xfd, 0x4f, 0x88, 0x6e, 0x12,
-	0x0f, 0xdd, 0x06, 0x30, 0xa6, 0x33, 0xea, 0x13, 0x4f, 0xb3, 0xcc, 0xa6, 0x72, 0x57, 0x79, 0x90,
-	0xc7, 0x65, 0x09, 0xe9, 0x99, 0x2a, 0x86, 0x3a, 0x26, 0xd4, 0x75, 0x6c, 0x4a, 0xae, 0xb5, 0x00,
-	0xbd, 0x06, 0x05, 0xe2, 0x79, 0x8e, 0xd7, 0xcc, 0xdd, 0x55, 0x1e, 0x54, 0x1e, 0x56, 0x76, 0xb9,
-	0xd4, 0x5d, 0x06, 0xc2, 0x02, 0xa3, 0x3e, 0x86, 0x02, 0xff, 0x46, 0xf7, 0x20, 0xef, 0xcf, 0x5d,
-	0xc2, 0x99, 0xd4, 0x1f, 0xae, 0xc7, 0x48, 0xc7, 0x73, 0x97, 0x60, 0x8e, 0x44, 0x4d, 0x58, 0xbb,
-	0x20, 0x94, 0xea, 0x13, 0xc2, 0x59, 0x96, 0x71, 0xf0, 0xa9, 0x0e, 0x00, 0xc6, 0xd4, 0x91, 0xea,
-	0xa0, 0x1f, 0x41, 0xf1, 0x8c, 0x4b, 0xc8, 0xd9, 0x55, 0x1e, 0x6e, 0x0a, 0x76, 0x09, 0x6d, 0xb1,
-	0x24, 0x41, 0x5b, 0x50, 0x30, 0x9c, 0x99, 0xed, 0x73, 0x96, 0x35, 0x2c, 0x3e, 0xd4, 0x36, 0x94,
-	0xc7, 0xd6, 0x05, 0xa1, 0xbe, 0x7e, 0xe1, 0xa2, 0x16, 0x94, 0xdc, 0xb3, 0x39, 0xb5, 0x0c, 0x7d,
-	0xca, 0x39, 0xae, 0xe2, 0xf0, 0x9b, 0xc9, 0x34, 0x75, 0x26, 0x1c, 0x95, 0xe3, 0xa8, 0xe0, 0x53,
-	0xfd, 0x95, 0x02, 0x15, 0x2e, 0x94, 0xb0, 0x19, 0x7a, 0x2b, 0x25, 0xd5, 0x56, 0x20, 0x55, 0xdc,
-	0xa6, 0xcf, 0x16, 0x0b, 0xbd, 0x0d, 0x65, 0x3f, 0x10, 0xab, 0xb9, 0xca, 0xd9, 0x48, 0x5b, 0x85,
-	0xd2, 0xe2, 0x88, 0x42, 0xfd, 0x56, 0x81, 0xc6, 0x9e, 0xe3, 0xf8, 0xd4, 0xf7, 0x74, 0x37, 0x93,
-	0x75, 0xee, 0x41, 0x81, 0xfa, 0x8e, 0x47, 0xa4, 0x0f, 0x6b, 0xbb, 0x32, 0xce, 0x46, 0x0c, 0x88,
-	0x05, 0x0e, 0xbd, 0x0e, 0x45, 0x8f, 0x4c, 0x2c, 0xc7, 0x96, 0x22, 0xd5, 0x03, 0x2a, 0xcc, 0xa1,
-	0x58, 0x62, 0xd5, 0x36, 0x6c, 0xc4, 0xa4, 0xc9, 0x62, 0x16, 0x75, 0x1f, 0x6e, 0xf4, 0x68, 0xc8,
-	0xc4, 0x25, 0x66, 0x16, 0xad, 0xd4, 0x5f, 0xc0, 0x76, 0x9a, 0x4b, 0x26, 0x27, 0xa9, 0x50, 0x3d,
-	0x89, 0x71, 0xe1, 0x46, 0x2a, 0xe1, 0x04, 0x4c, 0xfd, 0x08, 0xea, 0xed, 0xe9, 0xd4, 0x31, 0x7a,
-	0xfb, 0x99, 0x44, 0x1d, 0xc0, 0x7a, 0xb8, 0x3c, 0x93, 0x8c, 0x75, 0xc8, 0x59, 0x42, 0xb2, 0x3c,
-	0xce, 0x59, 0xa6, 0xfa, 0x05, 0xac, 0x1f, 0x10, 0x5f, 0xf8, 0x2f, 0x4b, 0x44, 0xdc, 0x84, 0x12,
-	0xf7, 0xba, 0x16, 0x72, 0x5d, 0xe3, 0xdf, 0x3d, 0x53, 0xfd, 0x9d, 0x02, 0x8d, 0x88, 0x77, 0x26,
-	0x69, 0xaf, 0x19, 0x6f, 0x05, 0xea, 0xeb, 0x3e, 0x95, 0xe1, 0xd6, 0x10, 0x1c, 0x39, 0xc9, 0x88,
-	0xc1, 0xb1, 0x40, 0xab, 0x06, 0xac, 0x0f, 0x67, 0x2f, 0xa0, 0xea, 0x75, 0x84, 0x51, 0x3f, 0x86,
-	0x46, 0xb4, 0x49, 0xa6, 0x98, 0xfe, 0x25, 0x6c, 0x1e, 0x10, 0xbf, 0x3d, 0x9d, 0x72, 0x26, 0x34,
-	0x93, 0xa8, 0x1f, 0x42, 0x93, 0x7c, 0x6d, 0x4c, 0x67, 0x26, 0xd1, 0x7c, 0xe7, 0xe2, 0x84, 0xfa,
-	0x8e, 0x4d, 0x34, 0x2e, 0x20, 0x95, 0x51, 0xb9, 0x2d, 0xf1, 0xe3, 0x00, 0x2d, 0x76, 0x53, 0xcf,
-	0x61, 0x2b, 0xb9, 0x7b, 0x26, 0xbf, 0xfd, 0x1f, 0x14, 0xc3, 0xdd, 0x56, 0x17, 0x6d, 0x25, 0x91,
-	0xea, 0x97, 0x3c, 0x40, 0x64, 0x5a, 0xc8, 0xa2, 0xe7, 0x6d, 0x00, 0x91, 0x4c, 0xb4, 0x73, 0x32,
-	0xe7, 0x9a, 0x55, 0x71, 0x59, 0x40, 0x9e, 0x90, 0xb9, 0xfa, 0x57, 0x05, 0x36, 0x62, 0x1b, 0x64,
-	0x52, 0x25, 0xca, 0x66, 0xb9, 0x67, 0x65, 0x33, 0x74, 0x1f, 0x8a, 0x53, 0xc1, 0x55, 0x84, 0x61,
-	0x35, 0xa0, 0x1b, 0x12, 0xc6, 0x4d, 0xe0, 0x18, 0x15, 0x9d, 0xea, 0x97, 0x84, 0x36, 0xf3, 0xdc,
-	0x30, 0x29, 0x2a, 0x81, 0x53, 0x7f, 0xce, 0x9d, 0x20, 0x36, 0xd8, 0x9b, 0x67, 0x4b, 0x15, 0xe8,
-	0x16, 0x48, 0x4b, 0x44, 0x47, 0xb3, 0x24, 0x00, 0xe2, 0x6c, 0xa2, 0x91, 0xa1, 0xdb, 0x62, 0x0f,
-	0x9a, 0x75, 0x03, 0xea, 0xeb, 0x9e, 0x1f, 0xb3, 0x7d, 0x89, 0x03, 0x9e, 0x90, 0x39, 0xbb, 0xb0,
-	0xa6, 0xd6, 0x85, 0xe5, 0x73, 0x6b, 0x14, 0xb0, 0xf8, 0x40, 0x3b, 0xb0, 0x46, 0x6c, 0x93, 0x2f,
-	0xc8, 0xf3, 0x05, 0x45, 0x62, 0x9b, 0xcc, 0x53, 0xdf, 0x29, 0xb0, 0x99, 0x90, 0x27, 0x93, 0xaf,
-	0x1e, 0xc0, 0x9a, 0xd0, 0x30, 0x88, 0xbb, 0xb4, 0xb3, 0x02, 0x34, 0x7a, 0x1d, 0xd6, 0x84, 0x47,
-	0x58, 0xd6, 0x58, 0x74, 0x44, 0x80, 0x54, 0x1f, 0xc3, 0xce, 0x01, 0xf1, 0x3b, 0xa2, 0x88, 0xe9,
-	0x38, 0xf6, 0xa9, 0x35, 0xc9, 0x94, 0xb7, 0x29, 0x34, 0x17, 0xf9, 0x64, 0xd2, 0xf1, 0x0d, 0x58,
-	0x93, 0x35, 0x95, 0x0c, 0xc8, 0xf5, 0x40, 0x72, 0xc9, 0x1d, 0x07, 0x78, 0xf5, 0x2b, 0xd8, 0x19,
-	0xce, 0x5e, 0x5c, 0xf8, 0xff, 0x65, 0xcb, 0x4f, 0xa0, 0xb9, 0xb8, 0x65, 0xa6, 0x34, 0xf8, 0x27,
-	0x05, 0x8a, 0x47, 0xe4, 0xe2, 0x84, 0x78, 0x08, 0x41, 0xde, 0xd6, 0x2f, 0x44, 0x35, 0x58, 0xc6,
-	0xfc, 0x37, 0x0b, 0xbe, 0x0b, 0x8e, 0x8d, 0x45, 0xb7, 0x00, 0xf4, 0x4c, 0x86, 0x74, 0x09, 0xf1,
-	0xb4, 0x99, 0x37, 0x15, 0xfe, 0x2d, 0xe3, 0x12, 0x03, 0x1c, 0x7b, 0x53, 0x8a, 0x5e, 0x85, 0x8a,
-	0x31, 0xb5, 0x88, 0xed, 0x0b, 0x74, 0x9e, 0xa3, 0x41, 0x80, 0x38, 0xc1, 0xff, 0xc3, 0xba, 0x70,
-	0xbf, 0xe6, 0x7a, 0x96, 0xe3, 0x59, 0xfe, 0xbc, 0x59, 0xe0, 0x41, 0x5c, 0x17, 0xe0, 0xa1, 0x84,
-	0xaa, 0x1f, 0xf3, 0xec, 0x22, 0x84, 0xcc, 0x74, 0x84, 0xd4, 0xbf, 0x2b, 0x80, 0xe2, 0x2c, 0x32,
-	0x66, 0xa8, 0x35, 0xa1, 0x79, 0x10, 0xf5, 0x55, 0x41, 0x2e, 0xb8, 0xe2, 0x00, 0xb9, 0x24, 0x43,
-	0xc5, 0xc9, 0x82, 0x0c, 0xf5, 0x36, 0x54, 0x88, 0x6f, 0x98, 0x9a, 0x24, 0xcd, 0x2f, 0x21, 0x05,
-	0x46, 0x70, 0x28, 0x34, 0x18, 0x42, 0x99, 0x9d, 0x18, 0x7e, 0xd1, 0xa2, 0xbb, 0x90, 0x67, 0x66,
-	0x96, 0x52, 0x27, 0x8f, 0x14, 0xc7, 0xa0, 0xd7, 0xa0, 0x6a, 0x3a, 0x4f, 0x6d, 0x8d, 0x12, 0xc3,
-	0xb1, 0x4d, 0x2a, 0x3d, 0x57, 0x61, 0xb0, 0x91, 0x00, 0xa9, 0x7f, 0xcc, 0xc3, 0xb6, 0x38, 0xae,
-	0x9f, 0x10, 0xdd, 0xf3, 0x4f, 0x88, 0xee, 0x67, 0x8a, 0xda, 0x1f, 0x36, 0x71, 0xef, 0x02, 0x70,
-	0xc1, 0x99, 0x16, 0x41, 0xf2, 0x96, 0xb5, 0x76, 0xa8, 0x3f, 0x2e, 0x33, 0x12, 0xf6, 0x49, 0xd1,
-	0x7b, 0x50, 0x73, 0x89, 0x6d, 0x5a, 0xf6, 0x44, 0x2e, 0x29, 0x2c, 0x49, 0x33, 0x55, 0x49, 0x22,
-	0x96, 0xdc, 0x83, 0xda, 0xc9, 0xdc, 0x27, 0x54, 0x7b, 0xea, 0x59, 0xbe, 0x4f, 0xec, 0x66, 0x91,
-	0x1b, 0xa7, 0xca, 0x81, 0x9f, 0x0b, 0x18, 0xbb, 0xf1, 0x04, 0x91, 0x47, 0x74, 0xb3, 0xb9, 0x26,
-	0x1e, 0x59, 0x1c, 0x82, 0x89, 0xce, 0x1e, 0x59, 0xd5, 0x73, 0x32, 0x8f, 0x58, 0x94, 0x84, 0x7d,
-	0x19, 0x2c, 0xe0, 0x70, 0x0b, 0xca, 0x9c, 0x84, 0x33, 0x28, 0x8b, 0x93, 0xc3, 0x00, 0x7c, 0xfd,
-	0x1b, 0xd0, 0xd0, 0x5d, 0xd7, 0x73, 0xbe, 0xb6, 0x2e, 0x74, 0x9f, 0x68, 0xd4, 0xfa, 0x86, 0x34,
-	0x81, 0xd3, 0xac, 0xc7, 0xe0, 0x23, 0xeb, 0x1b, 0x82, 0x76, 0xa1, 0x64, 0xd9, 0x3e, 0xf1, 0x2e,
-	0xf5, 0x69, 0xb3, 0xca, 0x2d, 0x87, 0xa2, 0xb7, 0x47, 0x4f, 0x62, 0x70, 0x48, 0x93, 0x66, 0xcd,
-	0xb6, 0x6c, 0xd6, 0x16, 0x58, 0x3f, 0x21, 0x73, 0xca, 0x0e, 0xbc, 0x4f, 0xbc, 0x8b, 0x66, 0x9d,
-	0xa3, 0xf9, 0xef, 0x4f, 0xf3, 0xa5, 0x4a, 0xa3, 0xaa, 0x9e, 0x01, 0x74, 0xce, 0x74, 0x7b, 0x42,
-	0x98, 0xc9, 0xae, 0x11, 0x6f, 0x1f, 0x42, 0xc5, 0xe0, 0xf4, 0x1a, 0x7f, 0x4f, 0xe6, 0xf8, 0x7b,
-	0x72, 0x67, 0x37, 0x78, 0x10, 0xb3, 0x0c, 0x25, 0xf8, 0xf1, 0x77, 0x25, 0x18, 0xe1, 0x6f, 0xf5,
-	0x21, 0xd4, 0xc7, 0x9e, 0x6e, 0xd3, 0x53, 0xe2, 0x89, 0x50, 0x7f, 0xfe, 0x6e, 0xea, 0x3b, 0x50,
-	0x38, 0x22, 0xde, 0x84, 0x3f, 0x81, 0x7c, 0xdd, 0x9b, 0x10, 0x5f, 0x12, 0x2f, 0xc4, 0x9e, 0xc0,
-	0xaa, 0x87, 0x50, 0x19, 0xb9, 0x53, 0x4b, 0x5e, 0xf5, 0xe8, 0x0d, 0x28, 0xba, 0xce, 0xd4, 0x32,
-	0xe6, 0xf2, 0xe1, 0xbb, 0x21, 0x0c, 0xda, 0x39, 0x23, 0xc6, 0xf9, 0x90, 0x23, 0xb0, 0x24, 0x60,
-	0x26, 0xe2, 0x16, 0x64, 0x27, 0xbe, 0x8a, 0xf9, 0x6f, 0xf5, 0xf7, 0xab, 0xb0, 0xb3, 0x70, 0x72,
-	0x32, 0xa5, 0x94, 0xf7, 0x42, 0xb3, 0x71, 0x8d, 0x73, 0xf1, 0xc2, 0x3a, 0xb2, 0x7f, 0x60, 0x2f,
-	0xee, 0x8b, 0x8f, 0x60, 0xdd, 0x97, 0xf6, 0xd2, 0x12, 0xe7, 0x49, 0xee, 0x94, 0x34, 0x26, 0xae,
-	0xfb, 0x49, 0xe3, 0x26, 0xaa, 0x95, 0x7c, 0xb2, 0x5a, 0x41, 0x1f, 0x40, 0x55, 0x22, 0x89, 0xeb,
-	0x18, 0x67, 0x3c, 0x1d, 0xb3, 0xd3, 0x9f, 0x30, 0x6a, 0x97, 0xa1, 0x70, 0xc5, 0x8b, 0x3e, 0x58,
-	0x2e, 0x13, 0x86, 0x16, 0x6a, 0x14, 0x97, 0x38, 0x0e, 0x04, 0xc1, 0x50, 0x24, 0xa7, 0xc2, 0x05,
-	0x73, 0x1f, 0x3f, 0x56, 0x61, 0x87, 0x82, 0x7b, 0x14, 0x0b, 0x0c, 0x7a, 0x04, 0x55, 0xca, 0x1c,
-	0xa6, 0xc9, 0xd4, 0x52, 0xe2, 0x94, 0xd2, 0x4f, 0x31, 0x57, 0xe2, 0x0a, 0x8d, 0x3e, 0xd4, 0x53,
-	0x58, 0x6f, 0xd3, 0x73, 0x89, 0x7e, 0x79, 0xa9, 0x4c, 0xfd, 0xb5, 0x02, 0x8d, 0x68, 0xa3, 0x8c,
-	0x6f, 0xd8, 0x9a, 0x4d, 0x9e, 0x6a, 0xe9, 0xca, 0xb1, 0x62, 0x93, 0xa7, 0x38, 0x70, 0xc7, 0x5d,
-	0xa8, 0x32, 0x1a, 0x7e, 0xc5, 0x5a, 0xa6, 0xb8, 0x61, 0xf3, 0x18, 0x6c, 0xf2, 0x94, 0x99, 0xb1,
-	0x67, 0x52, 0xf5, 0xb7, 0x0a, 0x20, 0x4c, 0x5c, 0xc7, 0xf3, 0xb3, 0x2b, 0xad, 0x42, 0x7e, 0x4a,
-	0x4e, 0xfd, 0x2b, 0x54, 0xe6, 0x38, 0x74, 0x1f, 0x0a, 0x9e, 0x35, 0x39, 0xf3, 0xaf, 0xe8, 0x34,
-	0x08, 0xa4, 0xda, 0x81, 0xcd, 0x84, 0x30, 0x99, 0xea, 0x91, 0x6f, 0x15, 0xd8, 0x6a, 0xd3, 0xf3,
-	0x3d, 0xdd, 0x37, 0xce, 0x5e, 0xba, 0x27, 0x59, 0x91, 0x22, 0xe2, 0x4c, 0x74, 0x7d, 0x56, 0x79,
-	0xd7, 0x07, 0x38, 0xa8, 0xc3, 0x3b, 0x52, 0x03, 0x58, 0xe3, 0x52, 0xf4, 0xf6, 0x17, 0x5d, 0xa6,
-	0x3c, 0xdf, 0x65, 0xb9, 0x05, 0x97, 0x9d, 0xc2, 0x8d, 0x94, 0x7a, 0x99, 0xe2, 0xe7, 0x55, 0x58,
-	0x0d, 0xf8, 0xb3, 0x67, 0x5f, 0x74, 0x2e, 0x7a, 0xfb, 0x98, 0x61, 0x54, 0x97, 0xe5, 0x28, 0xe6,
-	0x8c, 0x17, 0xb4, 0xe4, 0xb5, 0x6b, 0x7d, 0x56, 0x93, 0x2e, 0xee, 0x98, 0x29, 0x06, 0x7e, 0x06,
-	0xd5, 0xf8, 0xe5, 0xc6, 0x2a, 0x45, 0xf1, 0x02, 0x8a, 0xba, 0x70, 0xc2, 0xf6, 0x75, 0x0e, 0x8e,
-	0x5a, 0x86, 0xf7, 0xa0, 0xc6, 0xde, 0x3d, 0x11, 0x99, 0x38, 0x55, 0x55, 0x62, 0x9b, 0x21, 0x91,
-	0xfa, 0x08, 0x00, 0x13, 0xc3, 0xf1, 0xcc, 0xa1, 0x6e, 0x79, 0xa8, 0x01, 0xab, 0xec, 0x99, 0x24,
-	0x6a, 0x5e, 0xf6, 0x93, 0x3d, 0xa9, 0x2e, 0xf5, 0xe9, 0x8c, 0xc8, 0xc5, 0xe2, 0x43, 0xfd, 0x4f,
-	0x01, 0x20, 0xea, 0x75, 0x24, 0xfa, 0x31, 0x4a, 0xa2, 0x1f, 0x83, 0x5a, 0x50, 0x32, 0x74, 0x57,
-	0x37, 0x58, 0x41, 0x2b, 0x2b, 0xe6, 0xe0, 0x1b, 0xbd, 0x02, 0x65, 0xfd, 0x52, 0xb7, 0xa6, 0xfa,
-	0xc9, 0x94, 0xf0, 0x68, 0xcb, 0xe3, 0x08, 0xc0, 0xaa, 0x0a, 0x19, 0x5d, 0x22, 0x1c, 0xf3, 0x3c,
-	0x1c, 0x65, 0xaa, 0xe5, 0xf1, 0x88, 0xde, 0x02, 0x44, 0x65, 0xbd, 0x43, 0x6d, 0xdd, 0x95, 0x84,
-	0x05, 0x4e, 0xd8, 0x90, 0x98, 0x91, 0xad, 0xbb, 0x82, 0xfa, 0x5d, 0xd8, 0xf2, 0x88, 0x41, 0xac,
-	0xcb, 0x14, 0x7d, 0x91, 0xd3, 0xa3, 0x10, 0x17, 0xad, 0xb8, 0x0d, 0x10, 0x99, 0x9a, 0x27, 0xe8,
-	0x1a, 0x2e, 0x87, 0x56, 0x46, 0xbb, 0xb0, 0xa9, 0xbb, 0xee, 0x74, 0x9e, 0xe2, 0x57, 0xe2, 0x74,
-	0x1b, 0x01, 0x2a, 0x62, 0xb7, 0x03, 0x6b, 0x16, 0xd5, 0x4e, 0x66, 0x74, 0xce, 0x4b, 0xa0, 0x12,
-	0x2e, 0x5a, 0x74, 0x6f, 0x46, 0xe7, 0xec, 0x1e, 0x9a, 0x51, 0x62, 0xc6, 0x2b, 0x9f, 0x12, 0x03,
-	0xf0, 0x92, 0x67, 0xa1, 0x42, 0xab, 0x2c, 0xa9, 0xd0, 0xd2, 0x25, 0x58, 0x75, 0xb1, 0x04, 0x4b,
-	0x16, 0x71, 0xb5, 0x74, 0x11, 0x97, 0xa8, 0xd0, 0xea, 0xa9, 0x0a, 0x2d, 0x5e, 0x76, 0xad, 0x5f,
-	0xa3, 0xec, 0x7a, 0x07, 0xc0, 0x70, 0x67, 0xda, 0x8c, 0xea, 0x13, 0x42, 0x9b, 0x0d, 0x7e, 0x54,
-	0x1a, 0x41, 0x94, 0x07, 0xd1, 0x86, 0xcb, 0x86, 0x3b, 0x3b, 0xe6, 0x24, 0xe8, 0x11, 0xd4, 0xd8,
-	0xc6, 0x9a, 0xe5, 0x68, 0x9e, 0xee, 0x13, 0xda, 0xdc, 0xb8, 0x62, 0x4d, 0x85, 0x91, 0xf5, 0x1c,
-	0xcc, 0x88, 0xd0, 0x07, 0x50, 0x67, 0x0a, 0x93, 0x68, 0x19, 0xba, 0x62, 0x59, 0x95, 0xd3, 0x05,
-	0xeb, 0xde, 0x87, 0xaa, 0xe3, 0x6a, 0x53, 0xdd, 0x27, 0xb6, 0x61, 0x11, 0xda, 0xdc, 0xbc, 0x6a,
-	0x33, 0xc7, 0x3d, 0x0c, 0x88, 0xd4, 0x29, 0xdc, 0xe0, 0x21, 0xff, 0xa2, 0x0f, 0x04, 0xd9, 0x37,
-	0xcc, 0x3d, 0xbb, 0x6f, 0xf8, 0x18, 0xb6, 0xd3, 0xbb, 0x65, 0xca, 0x1e, 0x7f, 0x56, 0x60, 0x6b,
-	0x64, 0xe8, 0x3e, 0x7b, 0x30, 0x67, 0x6f, 0x79, 0x3d, 0xab, 0xad, 0x73, 0xdd, 0xd6, 0x7b, 0xec,
-	0xcd, 0x93, 0xbf, 0xfa, 0xcd, 0xa3, 0x76, 0xe1, 0x46, 0x4a, 0xde, 0xac, 0x4d, 0xfa, 0x03, 0xe2,
-	0x1f, 0x74, 0x46, 0xfa, 0x29, 0x19, 0x3a, 0x96, 0x9d, 0xc9, 0x5b, 0x2a, 0x81, 0xed, 0x34, 0x97,
-	0x4c, 0x17, 0x14, 0x4b, 0x24, 0xfa, 0x29, 0xd1, 0x5c, 0xc6, 0x43, 0x1a, 0xb0, 0x4c, 0x03, 0xa6,
-	0xea, 0x29, 0x34, 0x8f, 0x5d, 0x53, 0xf7, 0xc9, 0x0b, 0xca, 0xfb, 0xbc, 0x7d, 0x1c, 0xb8, 0xb9,
-	0x64, 0x9f, 0x4c, 0x1a, 0xdd, 0x87, 0x3a, 0xbb, 0xdb, 0x17, 0x76, 0x63, 0x37, 0x7e, 0xc8, 0x5b,
-	0xfd, 0x8d, 0x02, 0x1b, 0xa3, 0xb9, 0x6d, 0xbc, 0x40, 0xe8, 0xdd, 0x87, 0xa2, 0xe8, 0x25, 0xc8,
-	0x13, 0x93, 0x6a, 0x20, 0x08, 0x1c, 0x2f, 0x5d, 0x78, 0xa6, 0xb6, 0x6c, 0x93, 0x7c, 0x2d, 0x2f,
-	0x13, 0x91, 0xbc, 0x7b, 0x0c, 0x22, 0x7a, 0x8f, 0x31, 0x49, 0x5e, 0x72, 0xab, 0xef, 0xb9, 0xf2,
-	0x7c, 0xc9, 0x7b, 0x30, 0x03, 0x97, 0x78, 0xba, 0xef, 0x78, 0x3f, 0x7c, 0xaf, 0xf5, 0x6f, 0x0a,
-	0xef, 0xe8, 0x47, 0x1b, 0x64, 0x52, 0xf8, 0x99, 0xe7, 0x1e, 0x41, 0xde, 0x24, 0xd4, 0xe0, 0xca,
-	0x55, 0x31, 0xff, 0xcd, 0xd8, 0xb3, 0xfc, 0x35, 0xa3, 0xfc, 0x8c, 0xd7, 0x03, 0xf6, 0x81, 0x18,
-	0x23, 0x8e, 0xc3, 0x92, 0x86, 0xbf, 0x27, 0x2d, 0xdb, 0xe4, 0x37, 0x36, 0x7b, 0x4f, 0x5a, 0xb6,
-	0xf9, 0xe6, 0x77, 0x0a, 0x94, 0xc3, 0xa1, 0x2b, 0x2a, 0x42, 0x6e, 0xf0, 0xa4, 0xb1, 0x82, 0x2a,
-	0xb0, 0x76, 0xdc, 0x7f, 0xd2, 0x1f, 0x7c, 0xde, 0x6f, 0x28, 0x68, 0x0b, 0x1a, 0xfd, 0xc1, 0x58,
-	0xdb, 0x1b, 0x0c, 0xc6, 0xa3, 0x31, 0x6e, 0x0f, 0x87, 0xdd, 0xfd, 0x46, 0x0e, 0x6d, 0xc2, 0xfa,
-	0x68, 0x3c, 0xc0, 0x5d, 0x6d, 0x3c, 0x38, 0xda, 0x1b, 0x8d, 0x07, 0xfd, 0x6e, 0x63, 0x15, 0x35,
-	0x61, 0xab, 0x7d, 0x88, 0xbb, 0xed, 0xfd, 0x2f, 0x92, 0xe4, 0x79, 0x86, 0xe9, 0xf5, 0x3b, 0x83,
-	0xa3, 0x61, 0x7b, 0xdc, 0xdb, 0x3b, 0xec, 0x6a, 0x9f, 0x75, 0xf1, 0xa8, 0x37, 0xe8, 0x37, 0x0a,
-	0x8c, 0x3d, 0xee, 0x1e, 0xf4, 0x06, 0x7d, 0x8d, 0xed, 0xf2, 0x78, 0x70, 0xdc, 0xdf, 0x6f, 0x14,
-	0xdf, 0x7c, 0x04, 0x95, 0xd8, 0x93, 0x18, 0x95, 0x20, 0x3f, 0xea, 0xb4, 0xfb, 0x8d, 0x15, 0xb4,
-	0x0e, 0x95, 0xf6, 0x70, 0x88, 0x07, 0x3f, 0xed, 0x1d, 0xb5, 0xc7, 0xdd, 0x86, 0x82, 0x00, 0x8a,
-	0xc7, 0xa3, 0xee, 0x93, 0xee, 0x17, 0x8d, 0xdc, 0x9b, 0x43, 0xa8, 0x27, 0x75, 0x67, 0x9a, 0x8c,
-	0x8e, 0x3b, 0x9d, 0xee, 0x68, 0x24, 0xd4, 0x1a, 0xf7, 0x8e, 0xba, 0x83, 0xe3, 0xb1, 0x58, 0xd7,
-	0x69, 0xf7, 0x3b, 0xdd, 0xc3, 0x46, 0x8e, 0x21, 0x70, 0x77, 0x78, 0xd8, 0xee, 0x30, 0x25, 0xd8,
-	0xc7, 0x71, 0xbf, 0xdf, 0xeb, 0x1f, 0x34, 0xf2, 0x0f, 0xff, 0x52, 0x85, 0xdc, 0x70, 0x1f, 0xb5,
-	0x01, 0xa2, 0x1e, 0x1e, 0xda, 0x11, 0x66, 0x5e, 0x68, 0x0c, 0xb6, 0x9a, 0x8b, 0x08, 0xe1, 0x68,
-	0x75, 0x05, 0xbd, 0x0b, 0xab, 0x63, 0xea, 0x20, 0x79, 0x05, 0x45, 0xb3, 0xeb, 0xd6, 0x46, 0x0c,
-	0x12, 0x50, 0x3f, 0x50, 0xde, 0x55, 0xd0, 0x4f, 0xa0, 0x1c, 0x4e, 0x2c, 0xd1, 0xb6, 0xa0, 0x4a,
-	0xcf, 0x76, 0x5b, 0x3b, 0x0b, 0xf0, 0x70, 0xc7, 0x23, 0xa8, 0x27, 0x67, 0x9e, 0xe8, 0x96, 0x20,
-	0x5e, 0x3a, 0x4f, 0x6d, 0xbd, 0xb2, 0x1c, 0x19, 0xb2, 0xfb, 0x10, 0xd6, 0xe4, 0x5c, 0x12, 0xc9,
-	0x38, 0x4b, 0x4e, 0x39, 0x5b, 0x37, 0x52, 0xd0, 0x70, 0xe5, 0x8f, 0xa1, 0x14, 0x0c, 0x09, 0xd1,
-	0x8d, 0xd0, 0x44, 0xf1, 0x29, 0x5d, 0x6b, 0x3b, 0x0d, 0x8e, 0x2f, 0x0e, 0xa6, 0x6d, 0xc1, 0xe2,
-	0xd4, 0x88, 0x2f, 0x58, 0x9c, 0x1e, 0xca, 0xa9, 0x2b, 0xe8, 0x00, 0xaa, 0xf1, 0x51, 0x17, 0xba,
-	0x19, 0x6e, 0x93, 0x1e, 0xbe, 0xb5, 0x5a, 0xcb, 0x50, 0x71, 0x5b, 0x26, 0x0b, 0x84, 0xc0, 0x96,
-	0x4b, 0x8b, 0x94, 0xc0, 0x96, 0xcb, 0x6b, 0x0a, 0x75, 0x05, 0x8d, 0x61, 0x3d, 0xd5, 0xc5, 0x41,
-	0xaf, 0x04, 0xa9, 0x61, 0x59, 0x5b, 0xb4, 0x75, 0xfb, 0x0a, 0x6c, 0x3a, 0x60, 0xc2, 0x99, 0x12,
-	0x8a, 0x2c, 0x9a, 0xb8, 0x0e, 0x5a, 0x3b, 0x0b, 0xf0, 0x50, 0xaa, 0x3d, 0xa8, 0x1d, 0x10, 0x7f,
-	0xe8, 0x91, 0xcb, 0xec, 0x3c, 0x1e, 0x73, 0x1e, 0xd1, 0x5c, 0x0b, 0xb5, 0x52, 0xb4, 0xb1, 0x61,
-	0xd7, 0xb3, 0xf8, 0xec, 0x43, 0x25, 0x36, 0x2c, 0x42, 0xf2, 0x64, 0x2d, 0xce, 0xb3, 0x5a, 0x37,
-	0x97, 0x60, 0x42, 0x2e, 0x9f, 0x42, 0x2d, 0xf1, 0xe2, 0x0d, 0xa4, 0x59, 0xf6, 0xca, 0x6f, 0xdd,
-	0x5a, 0x8a, 0x0b, 0x79, 0x8d, 0xf8, 0x24, 0x33, 0x31, 0xf7, 0x40, 0xb7, 0x43, 0x05, 0x96, 0x8d,
-	0x60, 0x5a, 0x77, 0xae, 0x42, 0xc7, 0x99, 0xa6, 0x87, 0x29, 0x01, 0xd3, 0x2b, 0xe6, 0x3a, 0x01,
-	0xd3, 0xab, 0x66, 0x30, 0x42, 0xeb, 0x44, 0x51, 0x17, 0x68, 0xbd, 0xac, 0x32, 0x0d, 0xb4, 0x5e,
-	0x5a, 0x05, 0x8a, 0xc0, 0x4f, 0xd6, 0x64, 0x41, 0xe0, 0x2f, 0xad, 0xf7, 0x82, 0xc0, 0x5f, 0x5e,
-	0xc6, 0xa9, 0x2b, 0xe8, 0x33, 0xd8, 0x58, 0xa8, 0x89, 0x90, 0xd4, 0xe8, 0xaa, 0xa2, 0xac, 0xf5,
-	0xea, 0x95, 0xf8, 0x58, 0xd8, 0x55, 0xa2, 0x7a, 0x23, 0xcc, 0xd0, 0x0b, 0xc5, 0x50, 0x90, 0xa1,
-	0x17, 0x6b, 0x13, 0x79, 0x84, 0xf6, 0xa1, 0x12, 0xbb, 0xc7, 0x51, 0x94, 0xd0, 0x53, 0xb5, 0x43,
-	0xeb, 0xe6, 0x12, 0x4c, 0xc0, 0x69, 0x4f, 0xfd, 0xc7, 0xf7, 0x77, 0x94, 0x7f, 0x7e, 0x7f, 0x47,
-	0xf9, 0xd7, 0xf7, 0x77, 0x94, 0x3f, 0xfc, 0xfb, 0xce, 0x0a, 0x34, 0x1c, 0x6f, 0xb2, 0xeb, 0x5b,
-	0xe7, 0x97, 0xbb, 0xe7, 0x97, 0xfc, 0x9f, 0xb1, 0x4e, 0x8a, 0xfc, 0xcf, 0xfb, 0xff, 0x0d, 0x00,
-	0x00, 0xff, 0xff, 0xee, 0x5f, 0xea, 0xb3, 0xda, 0x25, 0x00, 0x00,
+func init() { proto.RegisterFile("pdpb.proto", fileDescriptor_pdpb_1c93cc7ef5a1ee29) }
+
+var fileDescriptor_pdpb_1c93cc7ef5a1ee29 = []byte{
+	// 3183 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3a, 0x4b, 0x6f, 0x23, 0xc7,
+	0xd1, 0x3b, 0x14, 0x49, 0x91, 0xc5, 0xa7, 0x5a, 0x5a, 0x89, 0xcb, 0x7d, 0x7a, 0x76, 0x3f, 0x7f,
+	0xeb, 0xb5, 0x2d, 0xdb, 0xeb, 0x85, 0xb1, 0x80, 0x3f, 0x7f, 0x31, 0x25, 0x71, 0x65, 0x7a, 0x25,
+	0x91, 0x68, 0x52, 0x76, 0x0c, 0x18, 0x9e, 0x8c, 0x86, 0x2d, 0xed, 0x44, 0xd4, 0xcc, 0x78, 0x7a,
+	0xa8, 0x35, 0x8d, 0x1c, 0x02, 0x04, 0x48, 0x0e, 0x71, 0x8e, 0x06, 0xe2, 0x9c, 0x02, 0xe4, 0x9c,
+	0xdc, 0x92, 0x6b, 0xae, 0x39, 0xe6, 0x07, 0xe4, 0x10, 0x38, 0xb7, 0x20, 0x3f, 0x22, 0xe8, 0xc7,
+	0x3c, 0x39, 0xd4, 0x6e, 0x66, 0x6d, 0x20, 0x27, 0xb2, 0xab, 0xaa, 0xab, 0xeb, 0xd5, 0xdd, 0x55,
+	0xd5, 0x03, 0xe0, 0x8c, 0x9d, 0xa3, 0x4d, 0xc7, 0xb5, 0x3d, 0x1b, 0xe5, 0xd9, 0xff, 0x76, 0xf5,
+	0x8c, 0x78, 0xba, 0x0f, 0x6b, 0xd7, 0x88, 0xab, 0x1f, 0x7b, 0xc1, 0x70, 0xed, 0xc4, 0x3e, 0xb1,
+	0xf9, 0xdf, 0x37, 0xd8, 0x3f, 0x01, 0x55, 0x37, 0xa1, 0x86, 0xc9, 0xe7, 0x53, 0x42, 0xbd, 0x0f,
+	0x88, 0x3e, 0x26, 0x2e, 0xba, 0x0e, 0x60, 0x4c, 0xa6, 0xd4, 0x23, 0xae, 0x66, 0x8e, 0x5b, 0xca,
+	0x2d, 0xe5, 0x6e, 0x1e, 0x97, 0x25, 0xa4, 0x37, 0x56, 0x31, 0xd4, 0x31, 0xa1, 0x8e, 0x6d, 0x51,
+	0xf2, 0x5c, 0x13, 0xd0, 0x4b, 0x50, 0x20, 0xae, 0x6b, 0xbb, 0xad, 0xdc, 0x2d, 0xe5, 0x6e, 0xe5,
+	0x7e, 0x65, 0x93, 0x4b, 0xdd, 0x65, 0x20, 0x2c, 0x30, 0xea, 0x23, 0x28, 0xf0, 0x31, 0xba, 0x0d,
+	0x79, 0x6f, 0xe6, 0x10, 0xce, 0xa4, 0x7e, 0xbf, 0x11, 0x21, 0x1d, 0xcd, 0x1c, 0x82, 0x39, 0x12,
+	0xb5, 0x60, 0xf9, 0x8c, 0x50, 0xaa, 0x9f, 0x10, 0xce, 0xb2, 0x8c, 0xfd, 0xa1, 0xda, 0x07, 0x18,
+	0x51, 0x5b, 0xaa, 0x83, 0x5e, 0x85, 0xe2, 0x13, 0x2e, 0x21, 0x67, 0x57, 0xb9, 0xbf, 0x2a, 0xd8,
+	0xc5, 0xb4, 0xc5, 0x92, 0x04, 0xad, 0x41, 0xc1, 0xb0, 0xa7, 0x96, 0xc7, 0x59, 0xd6, 0xb0, 0x18,
+	0xa8, 0x1d, 0x28, 0x8f, 0xcc, 0x33, 0x42, 0x3d, 0xfd, 0xcc, 0x41, 0x6d, 0x28, 0x39, 0x4f, 0x66,
+	0xd4, 0x34, 0xf4, 0x09, 0xe7, 0xb8, 0x84, 0x83, 0x31, 0x93, 0x69, 0x62, 0x9f, 0x70, 0x54, 0x8e,
+	0xa3, 0xfc, 0xa1, 0xfa, 0x53, 0x05, 0x2a, 0x5c, 0x28, 0x61, 0x33, 0xf4, 0x5a, 0x42, 0xaa, 0x35,
+	0x5f, 0xaa, 0xa8, 0x4d, 0x2f, 0x16, 0x0b, 0xbd, 0x0e, 0x65, 0xcf, 0x17, 0xab, 0xb5, 0xc4, 0xd9,
+	0x48, 0x5b, 0x05, 0xd2, 0xe2, 0x90, 0x42, 0xfd, 0x4a, 0x81, 0xe6, 0x96, 0x6d, 0x7b, 0xd4, 0x73,
+	0x75, 0x27, 0x93, 0x75, 0x6e, 0x43, 0x81, 0x7a, 0xb6, 0x4b, 0xa4, 0x0f, 0x6b, 0x9b, 0x32, 0xce,
+	0x86, 0x0c, 0x88, 0x05, 0x0e, 0xbd, 0x0c, 0x45, 0x97, 0x9c, 0x98, 0xb6, 0x25, 0x45, 0xaa, 0xfb,
+	0x54, 0x98, 0x43, 0xb1, 0xc4, 0xaa, 0x1d, 0x58, 0x89, 0x48, 0x93, 0xc5, 0x2c, 0xea, 0x0e, 0x5c,
+	0xee, 0xd1, 0x80, 0x89, 0x43, 0xc6, 0x59, 0xb4, 0x52, 0x7f, 0x0c, 0xeb, 0x49, 0x2e, 0x99, 0x9c,
+	0xa4, 0x42, 0xf5, 0x28, 0xc2, 0x85, 0x1b, 0xa9, 0x84, 0x63, 0x30, 0xf5, 0x3d, 0xa8, 0x77, 0x26,
+	0x13, 0xdb, 0xe8, 0xed, 0x64, 0x12, 0xb5, 0x0f, 0x8d, 0x60, 0x7a, 0x26, 0x19, 0xeb, 0x90, 0x33,
+	0x85, 0x64, 0x79, 0x9c, 0x33, 0xc7, 0xea, 0x27, 0xd0, 0xd8, 0x25, 0x9e, 0xf0, 0x5f, 0x96, 0x88,
+	0xb8, 0x02, 0x25, 0xee, 0x75, 0x2d, 0xe0, 0xba, 0xcc, 0xc7, 0xbd, 0xb1, 0xfa, 0x2b, 0x05, 0x9a,
+	0x21, 0xef, 0x4c, 0xd2, 0x3e, 0x67, 0xbc, 0x15, 0xa8, 0xa7, 0x7b, 0x54, 0x86, 0x5b, 0x53, 0x70,
+	0xe4, 0x24, 0x43, 0x06, 0xc7, 0x02, 0xad, 0x1a, 0xd0, 0x18, 0x4c, 0x5f, 0x40, 0xd5, 0xe7, 0x11,
+	0x46, 0x7d, 0x1f, 0x9a, 0xe1, 0x22, 0x99, 0x62, 0xfa, 0x27, 0xb0, 0xba, 0x4b, 0xbc, 0xce, 0x64,
+	0xc2, 0x99, 0xd0, 0x4c, 0xa2, 0x3e, 0x84, 0x16, 0xf9, 0xc2, 0x98, 0x4c, 0xc7, 0x44, 0xf3, 0xec,
+	0xb3, 0x23, 0xea, 0xd9, 0x16, 0xd1, 0xb8, 0x80, 0x54, 0x46, 0xe5, 0xba, 0xc4, 0x8f, 0x7c, 0xb4,
+	0x58, 0x4d, 0x3d, 0x85, 0xb5, 0xf8, 0xea, 0x99, 0xfc, 0xf6, 0x3f, 0x50, 0x0c, 0x56, 0x5b, 0x9a,
+	0xb7, 0x95, 0x44, 0xaa, 0x9f, 0xf1, 0x00, 0x91, 0xc7, 0x42, 0x16, 0x3d, 0xaf, 0x03, 0x88, 0xc3,
+	0x44, 0x3b, 0x25, 0x33, 0xae, 0x59, 0x15, 0x97, 0x05, 0xe4, 0x31, 0x99, 0xa9, 0x7f, 0x54, 0x60,
+	0x25, 0xb2, 0x40, 0x26, 0x55, 0xc2, 0xd3, 0x2c, 0x77, 0xd1, 0x69, 0x86, 0xee, 0x40, 0x71, 0x22,
+	0xb8, 0x8a, 0x30, 0xac, 0xfa, 0x74, 0x03, 0xc2, 0xb8, 0x09, 0x1c, 0xa3, 0xa2, 0x13, 0xfd, 0x9c,
+	0xd0, 0x56, 0xfe, 0xd6, 0xd2, 0x3c, 0x95, 0xc0, 0xa9, 0x3f, 0xe2, 0x4e, 0x10, 0x0b, 0x6c, 0xcd,
+	0xb2, 0x1d, 0x15, 0xe8, 0x2a, 0x48, 0x4b, 0x84, 0x5b, 0xb3, 0x24, 0x00, 0x62, 0x6f, 0xa2, 0xa1,
+	0xa1, 0x5b, 0x62, 0x0d, 0x9a, 0x75, 0x01, 0xea, 0xe9, 0xae, 0x17, 0xb1, 0x7d, 0x89, 0x03, 0x1e,
+	0x93, 0x19, 0xbb, 0xb0, 0x26, 0xe6, 0x99, 0xe9, 0x71, 0x6b, 0x14, 0xb0, 0x18, 0xa0, 0x0d, 0x58,
+	0x26, 0xd6, 0x98, 0x4f, 0xc8, 0xf3, 0x09, 0x45, 0x62, 0x8d, 0x99, 0xa7, 0xbe, 0x56, 0x60, 0x35,
+	0x26, 0x4f, 0x26, 0x5f, 0xdd, 0x85, 0x65, 0xa1, 0xa1, 0x1f, 0x77, 0x49, 0x67, 0xf9, 0x68, 0xf4,
+	0x32, 0x2c, 0x0b, 0x8f, 0xb0, 0x53, 0x63, 0xde, 0x11, 0x3e, 0x52, 0x3d, 0x86, 0xf5, 0x2d, 0xdd,
+	0x33, 0x9e, 0x04, 0xee, 0xc8, 0x66, 0xaa, 0x9b, 0x50, 0x09, 0xe3, 0x54, 0x08, 0x57, 0xc5, 0x10,
+	0x04, 0x2a, 0x55, 0xbf, 0x51, 0x60, 0x63, 0x6e, 0xa1, 0xff, 0x12, 0x1b, 0x3c, 0x82, 0x8d, 0x5d,
+	0xe2, 0x6d, 0x8b, 0x44, 0x6e, 0xdb, 0xb6, 0x8e, 0xcd, 0x93, 0x4c, 0x77, 0x17, 0x85, 0xd6, 0x3c,
+	0x9f, 0x4c, 0x3a, 0xbe, 0x02, 0xcb, 0x32, 0xaf, 0x94, 0x9b, 0xb2, 0xe1, 0x4b, 0x2e, 0xb9, 0x63,
+	0x1f, 0xaf, 0x7e, 0x0e, 0x1b, 0x83, 0xe9, 0x8b, 0x0b, 0xff, 0x9f, 0x2c, 0xf9, 0x01, 0xb4, 0xe6,
+	0x97, 0xcc, 0x74, 0x15, 0xfc, 0x56, 0x81, 0xe2, 0x3e, 0x39, 0x3b, 0x22, 0x2e, 0x42, 0x90, 0xb7,
+	0xf4, 0x33, 0x91, 0x11, 0x97, 0x31, 0xff, 0xcf, 0x36, 0xe0, 0x19, 0xc7, 0x46, 0x76, 0xb8, 0x00,
+	0xf4, 0xc6, 0x0c, 0xe9, 0x10, 0xe2, 0x6a, 0x53, 0x77, 0x22, 0xfc, 0x5b, 0xc6, 0x25, 0x06, 0x38,
+	0x74, 0x27, 0x94, 0xc5, 0xa3, 0x31, 0x31, 0x89, 0xe5, 0x09, 0x74, 0x9e, 0xa3, 0x41, 0x80, 0x38,
+	0xc1, 0xff, 0x42, 0x43, 0xb8, 0x5f, 0x73, 0x5c, 0xd3, 0x76, 0x4d, 0x6f, 0xd6, 0x2a, 0xf0, 0x8d,
+	0x5c, 0x17, 0xe0, 0x81, 0x84, 0xaa, 0xef, 0xf3, 0x13, 0x56, 0x08, 0x99, 0x69, 0x6f, 0xa8, 0x7f,
+	0x56, 0x00, 0x45, 0x59, 0x64, 0x3c, 0xa5, 0x97, 0x85, 0xe6, 0x7e, 0xd4, 0x57, 0x05, 0xb9, 0xe0,
+	0x8a, 0x7d, 0x64, 0xca, 0x29, 0x1d, 0x25, 0x93, 0x38, 0xf4, 0x3a, 0x54, 0x88, 0x67, 0x8c, 0x35,
+	0x49, 0x9a, 0x4f, 0x21, 0x05, 0x46, 0xb0, 0x27, 0x34, 0x18, 0x40, 0x99, 0xed, 0x18, 0x9e, 0x6c,
+	0xa0, 0x5b, 0x90, 0x77, 0x48, 0x20, 0x75, 0x7c, 0x4b, 0x71, 0x0c, 0x7a, 0x09, 0xaa, 0x63, 0xfb,
+	0xa9, 0xa5, 0x51, 0x62, 0xd8, 0xd6, 0x98, 0x4a, 0xcf, 0x55, 0x18, 0x6c, 0x28, 0x40, 0xea, 0x6f,
+	0xf2, 0xb0, 0x2e, 0xb6, 0xeb, 0x07, 0x44, 0x77, 0xbd, 0x23, 0xa2, 0x7b, 0x99, 0xa2, 0xf6, 0xbb,
+	0xbd, 0xbc, 0x36, 0x01, 0xb8, 0xe0, 0x4c, 0x0b, 0xff, 0x02, 0x93, 0xf5, 0x46, 0xa0, 0x3f, 0x2e,
+	0x33, 0x12, 0x36, 0xa4, 0xe8, 0x2d, 0xa8, 0x39, 0xc4, 0x1a, 0x9b, 0xd6, 0x89, 0x9c, 0x52, 0x48,
+	0x39, 0x66, 0xaa, 0x92, 0x44, 0x4c, 0xb9, 0x0d, 0xb5, 0xa3, 0x99, 0x47, 0xa8, 0xf6, 0xd4, 0x35,
+	0x3d, 0x8f, 0x58, 0xad, 0x22, 0x37, 0x4e, 0x95, 0x03, 0x3f, 0x16, 0x30, 0x76, 0xeb, 0x0b, 0x22,
+	0x97, 0xe8, 0xe3, 0xd6, 0xb2, 0x28, 0x34, 0x39, 0x04, 0x13, 0x9d, 0x15, 0x9a, 0x55, 0x76, 0xca,
+	0x06, 0x2c, 0x4a, 0xc2, 0xbe, 0x0c, 0xe6, 0x73, 0xb8, 0x0a, 0x65, 0x4e, 0xc2, 0x19, 0x94, 0xc5,
+	0xce, 0x61, 0x00, 0x3e, 0xff, 0x15, 0x68, 0xea, 0x8e, 0xe3, 0xda, 0x5f, 0x98, 0x67, 0xba, 0x47,
+	0x34, 0x6a, 0x7e, 0x49, 0x5a, 0xc0, 0x69, 0x1a, 0x11, 0xf8, 0xd0, 0xfc, 0x92, 0xa0, 0x4d, 0x28,
+	0x99, 0x96, 0x47, 0xdc, 0x73, 0x7d, 0xd2, 0xaa, 0x72, 0xcb, 0xa1, 0xb0, 0xfe, 0xea, 0x49, 0x0c,
+	0x0e, 0x68, 0x92, 0xac, 0xf9, 0x65, 0x50, 0x9b, 0x63, 0xcd, 0x6e, 0x04, 0xb6, 0xe1, 0x3d, 0xe2,
+	0x9e, 0xb5, 0xea, 0x1c, 0xcd, 0xff, 0x7f, 0x98, 0x2f, 0x55, 0x9a, 0x55, 0x56, 0x49, 0xc2, 0xf6,
+	0x13, 0xdd, 0x3a, 0x21, 0xcc, 0x66, 0xcf, 0x11, 0x70, 0x0f, 0xa1, 0x62, 0x70, 0x7a, 0x8d, 0x17,
+	0xd5, 0x39, 0x5e, 0x54, 0x6f, 0x6c, 0xfa, 0x5d, 0x01, 0x76, 0x44, 0x09, 0x7e, 0xbc, 0xb8, 0x06,
+	0x23, 0xf8, 0x8f, 0xd6, 0x59, 0xfc, 0x38, 0xba, 0x29, 0xe2, 0xa2, 0x84, 0xe5, 0x48, 0xbd, 0x0f,
+	0xf5, 0x91, 0xab, 0x5b, 0xf4, 0x98, 0xb8, 0x62, 0x0f, 0x3c, 0x5b, 0x0a, 0xf5, 0x0d, 0x28, 0xec,
+	0x13, 0xf7, 0x84, 0xd7, 0x87, 0x9e, 0xee, 0x9e, 0x10, 0xaf, 0xa5, 0xa4, 0x07, 0xa5, 0xc0, 0xaa,
+	0x7b, 0x50, 0x19, 0x3a, 0x13, 0x53, 0xde, 0x87, 0xe8, 0x15, 0x28, 0x3a, 0xf6, 0xc4, 0x34, 0x66,
+	0xb2, 0x2b, 0xb0, 0x22, 0x2c, 0xbd, 0xfd, 0x84, 0x18, 0xa7, 0x03, 0x8e, 0xc0, 0x92, 0x80, 0xd9,
+	0x2e, 0x72, 0xcf, 0xf2, 0xff, 0xea, 0xdf, 0x96, 0x60, 0x63, 0x6e, 0x4b, 0x65, 0x3a, 0x6b, 0xde,
+	0x0a, 0xcc, 0xc9, 0x35, 0xce, 0x45, 0xab, 0x8e, 0xd0, 0x2f, 0xbe, 0x1d, 0xd9, 0x7f, 0xf4, 0x1e,
+	0x34, 0x3c, 0x69, 0x2f, 0x2d, 0xb6, 0xd1, 0xe4, 0x4a, 0x71, 0x63, 0xe2, 0xba, 0x17, 0x37, 0x6e,
+	0x2c, 0x95, 0xcb, 0xc7, 0x53, 0x39, 0xf4, 0x0e, 0x54, 0x25, 0x92, 0x38, 0xb6, 0xf1, 0xa4, 0x55,
+	0x90, 0xc7, 0x42, 0xcc, 0xa8, 0x5d, 0x86, 0xc2, 0x15, 0x37, 0x1c, 0xb0, 0x43, 0x4e, 0x18, 0x5a,
+	0xa8, 0x51, 0x4c, 0x71, 0x1c, 0x08, 0x82, 0x81, 0x38, 0xb5, 0x0a, 0x67, 0xcc, 0x7d, 0xad, 0xe5,
+	0x68, 0xfb, 0x86, 0x7b, 0x14, 0x0b, 0x0c, 0x7a, 0x00, 0x55, 0xca, 0x1c, 0xa6, 0xc9, 0x33, 0xa7,
+	0xc4, 0x29, 0xa5, 0x9f, 0x22, 0xae, 0xc4, 0x15, 0x1a, 0x0e, 0xd8, 0x5d, 0x64, 0x3b, 0xc4, 0xd5,
+	0x3d, 0x9b, 0xdf, 0x63, 0x62, 0x37, 0x82, 0x0f, 0xea, 0x8d, 0xd9, 0x99, 0x10, 0x10, 0x50, 0x8f,
+	0x38, 0x7c, 0x33, 0xd6, 0x70, 0xd5, 0x07, 0x0e, 0x3d, 0xe2, 0xa8, 0xc7, 0xd0, 0xe8, 0xd0, 0x53,
+	0xb9, 0xc8, 0xf7, 0x77, 0x52, 0xaa, 0x3f, 0x57, 0xa0, 0x19, 0x2e, 0x94, 0xb1, 0x4d, 0x50, 0xb3,
+	0xc8, 0x53, 0x2d, 0x99, 0x9c, 0x57, 0x2c, 0xf2, 0x14, 0xfb, 0x4e, 0xbd, 0x05, 0x55, 0x46, 0xc3,
+	0x6f, 0x70, 0x73, 0x2c, 0x2e, 0xf0, 0x3c, 0x06, 0x8b, 0x3c, 0x65, 0xce, 0xe8, 0x8d, 0xa9, 0xfa,
+	0x4b, 0x05, 0x10, 0x26, 0x8e, 0xed, 0x7a, 0xd9, 0x95, 0x56, 0x21, 0x3f, 0x21, 0xc7, 0xde, 0x02,
+	0x95, 0x39, 0x0e, 0xdd, 0x81, 0x82, 0x6b, 0x9e, 0x3c, 0xf1, 0x16, 0x34, 0x73, 0x04, 0x52, 0xdd,
+	0x86, 0xd5, 0x98, 0x30, 0x99, 0xd2, 0x9d, 0xaf, 0x14, 0x58, 0xeb, 0xd0, 0x53, 0x9e, 0x07, 0x7f,
+	0xef, 0x9e, 0x64, 0x71, 0x27, 0xa2, 0x55, 0x34, 0xd6, 0x96, 0x78, 0x50, 0x01, 0x07, 0x6d, 0x33,
+	0x88, 0xda, 0x87, 0x65, 0x2e, 0x45, 0x6f, 0x67, 0xde, 0x65, 0xca, 0xb3, 0x5d, 0x96, 0x9b, 0x73,
+	0xd9, 0x31, 0x5c, 0x4e, 0xa8, 0x97, 0x29, 0x7e, 0x6e, 0xc2, 0x92, 0x39, 0x0e, 0x2b, 0xeb, 0x70,
+	0x77, 0xf5, 0x76, 0x30, 0xc3, 0xa8, 0x0e, 0x6c, 0x08, 0x67, 0xbc, 0xa0, 0x25, 0x9f, 0xbb, 0x94,
+	0x60, 0x29, 0xef, 0xfc, 0x8a, 0x99, 0x62, 0xe0, 0x53, 0xa8, 0x46, 0xef, 0x4e, 0x96, 0x88, 0x8a,
+	0x22, 0x33, 0x6c, 0x74, 0x0a, 0xdb, 0xd7, 0x39, 0x38, 0xec, 0xca, 0xde, 0x86, 0x1a, 0x2b, 0x2d,
+	0x43, 0x32, 0xb1, 0xab, 0xaa, 0xc4, 0x1a, 0x07, 0x44, 0xea, 0x03, 0x00, 0x4c, 0x0c, 0xdb, 0x1d,
+	0x0f, 0x74, 0xd3, 0x45, 0x4d, 0x58, 0x62, 0x95, 0xa8, 0x48, 0xa9, 0x97, 0x4e, 0x45, 0xd5, 0x7a,
+	0xae, 0x4f, 0xa6, 0x44, 0x4e, 0x16, 0x03, 0xf5, 0x9b, 0x22, 0x40, 0xd8, 0x4e, 0x8a, 0xb5, 0xbc,
+	0x94, 0x58, 0xcb, 0x8b, 0xb5, 0x86, 0x0d, 0xdd, 0xd1, 0x0d, 0x96, 0x2f, 0xcb, 0x84, 0xdc, 0x1f,
+	0xa3, 0x6b, 0x50, 0xd6, 0xcf, 0x75, 0x73, 0xa2, 0x1f, 0x4d, 0x08, 0x8f, 0xb6, 0x3c, 0x0e, 0x01,
+	0x2c, 0x69, 0x91, 0xd1, 0x25, 0xc2, 0x31, 0xcf, 0xc3, 0x51, 0x1e, 0xd8, 0x3c, 0x1e, 0xd1, 0x6b,
+	0x80, 0xa8, 0x4c, 0xa7, 0xa8, 0xa5, 0x3b, 0x92, 0xb0, 0xc0, 0x09, 0x9b, 0x12, 0x33, 0xb4, 0x74,
+	0x47, 0x50, 0xbf, 0x09, 0x6b, 0x2e, 0x31, 0x88, 0x79, 0x9e, 0xa0, 0x2f, 0x72, 0x7a, 0x14, 0xe0,
+	0xc2, 0x19, 0xd7, 0x01, 0x42, 0x53, 0xf3, 0x63, 0xbe, 0x86, 0xcb, 0x81, 0x95, 0xd1, 0x26, 0xac,
+	0xea, 0x8e, 0x33, 0x99, 0x25, 0xf8, 0x95, 0x38, 0xdd, 0x8a, 0x8f, 0x0a, 0xd9, 0x6d, 0xc0, 0xb2,
+	0x49, 0xb5, 0xa3, 0x29, 0x9d, 0xf1, 0x33, 0xbd, 0x84, 0x8b, 0x26, 0xdd, 0x9a, 0xd2, 0x19, 0xbb,
+	0xcd, 0xa6, 0x94, 0x8c, 0xa3, 0x89, 0x55, 0x89, 0x01, 0x78, 0x46, 0x35, 0x97, 0x00, 0x56, 0x52,
+	0x12, 0xc0, 0x64, 0x86, 0x57, 0x9d, 0xcf, 0xf0, 0xe2, 0x39, 0x62, 0x2d, 0x99, 0x23, 0xc6, 0x12,
+	0xc0, 0x7a, 0x22, 0x01, 0x8c, 0x66, 0x75, 0x8d, 0xe7, 0xc8, 0xea, 0xde, 0x00, 0x30, 0x9c, 0xa9,
+	0x36, 0x65, 0x6f, 0x0f, 0xb4, 0xd5, 0xbc, 0xb5, 0x14, 0xe6, 0x03, 0x61, 0xb4, 0xe1, 0xb2, 0xe1,
+	0x4c, 0x0f, 0x39, 0x09, 0x7a, 0x00, 0x35, 0xb6, 0xb0, 0x66, 0xda, 0x9a, 0xab, 0x7b, 0x84, 0xb6,
+	0x56, 0x16, 0xcc, 0xa9, 0x30, 0xb2, 0x9e, 0x8d, 0x19, 0x11, 0x7a, 0x07, 0xea, 0x4c, 0x61, 0x12,
+	0x4e, 0x43, 0x0b, 0xa6, 0x55, 0x39, 0x9d, 0x3f, 0xef, 0x6d, 0xa8, 0xda, 0x8e, 0x36, 0xd1, 0x3d,
+	0x62, 0x19, 0x26, 0xa1, 0xad, 0xd5, 0x45, 0x8b, 0xd9, 0xce, 0x9e, 0x4f, 0x84, 0x5e, 0x86, 0x06,
+	0x73, 0x9d, 0x76, 0xa4, 0x1b, 0xa7, 0xf6, 0xf1, 0xb1, 0x76, 0x46, 0x5b, 0x6b, 0xdc, 0x4c, 0x35,
+	0x06, 0xde, 0x12, 0xd0, 0x7d, 0xaa, 0xfe, 0x4e, 0x81, 0x7a, 0x5f, 0x5e, 0xc4, 0x98, 0xd0, 0xe9,
+	0xc4, 0x8b, 0x67, 0x2b, 0x4a, 0x22, 0x5b, 0x49, 0xdc, 0xf6, 0xb9, 0x67, 0xdf, 0xf6, 0x4b, 0xf3,
+	0xb7, 0x3d, 0x7b, 0x66, 0xa1, 0x53, 0xc3, 0x20, 0x94, 0xf2, 0x8d, 0x52, 0xc2, 0xfe, 0x90, 0xed,
+	0x60, 0xf1, 0xca, 0x54, 0xe0, 0xbb, 0x5a, 0x0c, 0xd4, 0xdf, 0x2b, 0x70, 0x99, 0xef, 0xe0, 0x17,
+	0x2d, 0xa7, 0x64, 0xa7, 0x39, 0x77, 0x61, 0xa7, 0x19, 0xfd, 0x00, 0x9a, 0x81, 0x0e, 0x2e, 0x37,
+	0x8a, 0xdf, 0x62, 0x91, 0x87, 0x5f, 0xdc, 0x62, 0xb8, 0x61, 0xc7, 0xc6, 0x54, 0xfd, 0xa7, 0x02,
+	0xeb, 0x49, 0x79, 0x33, 0xdd, 0x15, 0xf7, 0x60, 0xc5, 0x7f, 0x93, 0xe3, 0x11, 0x67, 0x5b, 0x93,
+	0x99, 0xec, 0x00, 0x37, 0x24, 0x82, 0x85, 0x7c, 0xdf, 0x9a, 0xcc, 0xd0, 0x43, 0x68, 0x06, 0x34,
+	0x9a, 0xcb, 0x72, 0x57, 0x5f, 0xea, 0xba, 0x58, 0xe3, 0x31, 0x99, 0x61, 0x06, 0xc6, 0x75, 0x57,
+	0xce, 0xe1, 0x43, 0x8a, 0xfe, 0x0f, 0x1a, 0x2e, 0xa1, 0xf6, 0xd4, 0x35, 0x88, 0x76, 0xe2, 0xda,
+	0x53, 0xc7, 0xaf, 0x0e, 0x03, 0x6b, 0x0a, 0xe4, 0x2e, 0xc3, 0xb1, 0xd9, 0x91, 0x21, 0x55, 0xff,
+	0xa0, 0xc0, 0xda, 0xd0, 0xd0, 0x3d, 0x2e, 0x4b, 0xe6, 0x56, 0xf0, 0x45, 0xed, 0xce, 0xe7, 0x7d,
+	0x92, 0x8a, 0xd4, 0xc1, 0xf9, 0xc5, 0x75, 0xb0, 0xda, 0x85, 0xcb, 0x09, 0x79, 0x33, 0x5d, 0x75,
+	0x1f, 0xc1, 0xea, 0x90, 0x88, 0x84, 0xe9, 0x31, 0x3f, 0x7a, 0x32, 0x68, 0x9d, 0x56, 0xe9, 0xec,
+	0xc0, 0x5a, 0x9c, 0x6f, 0x26, 0xe9, 0xde, 0x87, 0x92, 0xef, 0xef, 0x78, 0xa7, 0x57, 0x49, 0x74,
+	0x7a, 0x23, 0x3d, 0xdd, 0x5c, 0xac, 0xa7, 0xfb, 0x33, 0xd6, 0x63, 0x26, 0x9e, 0x1f, 0x5f, 0x99,
+	0xf4, 0x6b, 0xc5, 0xdb, 0x6e, 0xa5, 0xa0, 0xcb, 0xc6, 0x5d, 0x7a, 0x51, 0x8c, 0x4a, 0x2c, 0xcb,
+	0x4c, 0x63, 0x42, 0x64, 0x32, 0xc6, 0xbb, 0xec, 0x71, 0x3c, 0x12, 0xb4, 0xa9, 0xed, 0xb8, 0x75,
+	0x28, 0x3e, 0x25, 0x3c, 0x55, 0x16, 0xe1, 0x27, 0x47, 0xaa, 0x07, 0x2d, 0x2e, 0x41, 0x34, 0xe8,
+	0x33, 0x19, 0xe3, 0x55, 0x28, 0xca, 0xdd, 0x95, 0x5b, 0xbc, 0xbb, 0x24, 0x89, 0xda, 0x83, 0x2b,
+	0x29, 0xab, 0x66, 0x7d, 0x65, 0xdd, 0x25, 0xde, 0xee, 0xf6, 0x50, 0x3f, 0x26, 0x03, 0xdb, 0xb4,
+	0x32, 0x1d, 0x9e, 0x2a, 0x81, 0xf5, 0x24, 0x97, 0x4c, 0x47, 0x1a, 0x4b, 0x53, 0xf4, 0x63, 0xa2,
+	0x39, 0x8c, 0x87, 0x34, 0x75, 0x99, 0xfa, 0x4c, 0xd5, 0x63, 0x68, 0x1d, 0x3a, 0x63, 0xdd, 0x23,
+	0x2f, 0x28, 0xef, 0xb3, 0xd6, 0xb1, 0xe1, 0x4a, 0xca, 0x3a, 0x99, 0x34, 0xba, 0x03, 0x75, 0x56,
+	0x39, 0xcc, 0xad, 0xc6, 0xea, 0x89, 0x80, 0xb7, 0xfa, 0x0b, 0x05, 0x56, 0x86, 0x33, 0xcb, 0x78,
+	0x81, 0x33, 0xf2, 0x0e, 0x14, 0x45, 0x23, 0xb4, 0x95, 0x4b, 0x69, 0x69, 0x4a, 0x1c, 0x2f, 0x8c,
+	0xf8, 0x6e, 0x37, 0xad, 0x31, 0xf9, 0x42, 0xa6, 0xaa, 0x22, 0x35, 0xec, 0x31, 0x88, 0x78, 0x3c,
+	0x8a, 0x48, 0xf2, 0x3d, 0xbf, 0x53, 0x3c, 0x53, 0x9e, 0xcf, 0x78, 0x03, 0x39, 0xbc, 0x53, 0xbf,
+	0xeb, 0xc7, 0xb2, 0x3f, 0x29, 0xb0, 0x1a, 0x5b, 0x20, 0x93, 0xc2, 0x17, 0x5e, 0x50, 0x08, 0xf2,
+	0x63, 0x42, 0x0d, 0xae, 0x5c, 0x15, 0xf3, 0xff, 0x8c, 0x3d, 0x4b, 0x27, 0xa6, 0x22, 0xc7, 0xa9,
+	0x27, 0x73, 0x87, 0x21, 0xc7, 0x61, 0x49, 0xc3, 0x6f, 0x02, 0xd3, 0x1a, 0xf3, 0xbc, 0x87, 0xdd,
+	0x04, 0xa6, 0x35, 0xbe, 0xf7, 0xb5, 0x02, 0xe5, 0xe0, 0xab, 0x19, 0x54, 0x84, 0x5c, 0xff, 0x71,
+	0xf3, 0x12, 0xaa, 0xc0, 0xf2, 0xe1, 0xc1, 0xe3, 0x83, 0xfe, 0xc7, 0x07, 0x4d, 0x05, 0xad, 0x41,
+	0xf3, 0xa0, 0x3f, 0xd2, 0xb6, 0xfa, 0xfd, 0xd1, 0x70, 0x84, 0x3b, 0x83, 0x41, 0x77, 0xa7, 0x99,
+	0x43, 0xab, 0xd0, 0x18, 0x8e, 0xfa, 0xb8, 0xab, 0x8d, 0xfa, 0xfb, 0x5b, 0xc3, 0x51, 0xff, 0xa0,
+	0xdb, 0x5c, 0x42, 0x2d, 0x58, 0xeb, 0xec, 0xe1, 0x6e, 0x67, 0xe7, 0x93, 0x38, 0x79, 0x9e, 0x61,
+	0x7a, 0x07, 0xdb, 0xfd, 0xfd, 0x41, 0x67, 0xd4, 0xdb, 0xda, 0xeb, 0x6a, 0x1f, 0x75, 0xf1, 0xb0,
+	0xd7, 0x3f, 0x68, 0x16, 0x18, 0x7b, 0xdc, 0xdd, 0xed, 0xf5, 0x0f, 0x34, 0xb6, 0xca, 0xa3, 0xfe,
+	0xe1, 0xc1, 0x4e, 0xb3, 0x78, 0xef, 0x01, 0x54, 0x22, 0x6d, 0x3b, 0x54, 0x82, 0xfc, 0x70, 0xbb,
+	0x73, 0xd0, 0xbc, 0x84, 0x1a, 0x50, 0xe9, 0x0c, 0x06, 0xb8, 0xff, 0xc3, 0xde, 0x7e, 0x67, 0xd4,
+	0x6d, 0x2a, 0x08, 0xa0, 0x78, 0x38, 0xec, 0x3e, 0xee, 0x7e, 0xd2, 0xcc, 0xdd, 0xfb, 0x14, 0xea,
+	0x71, 0xdd, 0x99, 0x26, 0xc3, 0xc3, 0xed, 0xed, 0xee, 0x70, 0x28, 0xd4, 0x1a, 0xf5, 0xf6, 0xbb,
+	0xfd, 0xc3, 0x91, 0x98, 0xb7, 0xdd, 0x39, 0xd8, 0xee, 0xee, 0x35, 0x73, 0x0c, 0x81, 0xbb, 0x83,
+	0xbd, 0xce, 0x36, 0x53, 0x82, 0x0d, 0x0e, 0x0f, 0x0e, 0x7a, 0x07, 0xbb, 0xcd, 0x3c, 0xa3, 0x7a,
+	0xd4, 0xe9, 0xed, 0x75, 0x77, 0x9a, 0x85, 0xfb, 0xff, 0xaa, 0x43, 0x6e, 0xb0, 0x83, 0x3a, 0x00,
+	0xe1, 0x63, 0x04, 0xda, 0x10, 0x26, 0x9f, 0x7b, 0xe1, 0x68, 0xb7, 0xe6, 0x11, 0xc2, 0xe9, 0xea,
+	0x25, 0xf4, 0x26, 0x2c, 0x8d, 0xa8, 0x8d, 0x64, 0x76, 0x18, 0x7e, 0x88, 0xd4, 0x5e, 0x89, 0x40,
+	0x7c, 0xea, 0xbb, 0xca, 0x9b, 0x0a, 0xfa, 0x7f, 0x28, 0x07, 0x9f, 0x9f, 0xa0, 0x75, 0x41, 0x95,
+	0xfc, 0x50, 0xa7, 0xbd, 0x31, 0x07, 0x0f, 0x56, 0xdc, 0x87, 0x7a, 0xfc, 0x03, 0x16, 0x74, 0x55,
+	0x10, 0xa7, 0x7e, 0x1c, 0xd3, 0xbe, 0x96, 0x8e, 0x0c, 0xd8, 0x3d, 0x84, 0x65, 0xf9, 0x91, 0x09,
+	0x92, 0x31, 0x17, 0xff, 0x64, 0xa5, 0x7d, 0x39, 0x01, 0x0d, 0x66, 0xbe, 0x0b, 0x25, 0xff, 0x8b,
+	0x0f, 0x74, 0x39, 0x30, 0x51, 0xf4, 0x93, 0x8b, 0xf6, 0x7a, 0x12, 0x1c, 0x9d, 0x3c, 0x98, 0xc6,
+	0x27, 0x0f, 0xa6, 0xa9, 0x93, 0x93, 0x5f, 0x58, 0xa8, 0x97, 0xd0, 0x2e, 0x54, 0xa3, 0xdf, 0x2d,
+	0xa0, 0x2b, 0xc1, 0x32, 0xc9, 0x2f, 0x29, 0xda, 0xed, 0x34, 0x54, 0xd4, 0x96, 0xf1, 0xcc, 0xdb,
+	0xb7, 0x65, 0x6a, 0xfd, 0xd0, 0xbe, 0x96, 0x8e, 0x0c, 0xd8, 0x8d, 0xa0, 0x91, 0xe8, 0x3a, 0xa3,
+	0x6b, 0xfe, 0x31, 0x91, 0xf6, 0xbe, 0xd3, 0xbe, 0xbe, 0x00, 0x9b, 0x0c, 0x98, 0xe0, 0xa1, 0x18,
+	0x85, 0x16, 0x8d, 0x5d, 0x0d, 0xed, 0x8d, 0x39, 0x78, 0x20, 0xd5, 0x16, 0xd4, 0x76, 0x89, 0x37,
+	0x70, 0xc9, 0x79, 0x76, 0x1e, 0x8f, 0xa0, 0x16, 0x80, 0xd9, 0x47, 0x0a, 0xa8, 0x9d, 0xa0, 0x8d,
+	0x7c, 0xb9, 0x70, 0x11, 0x9f, 0x1d, 0xa8, 0x44, 0x5e, 0xfe, 0x91, 0xdc, 0x59, 0xf3, 0x1f, 0x27,
+	0xb4, 0xaf, 0xa4, 0x60, 0x02, 0x2e, 0x03, 0x68, 0x24, 0xde, 0xcf, 0x7d, 0x3b, 0xa7, 0xbf, 0xdf,
+	0xb7, 0xaf, 0x2f, 0xc0, 0x06, 0x1c, 0x3f, 0x84, 0x5a, 0xac, 0x5b, 0xe7, 0xeb, 0x97, 0xd6, 0xa1,
+	0x6c, 0x5f, 0x4d, 0xc5, 0x05, 0xbc, 0x86, 0xfc, 0x43, 0x97, 0xd8, 0x93, 0x30, 0xba, 0x1e, 0x98,
+	0x24, 0xed, 0x75, 0xba, 0x7d, 0x63, 0x11, 0x3a, 0xca, 0x74, 0x30, 0x4d, 0x67, 0x3a, 0x98, 0x5e,
+	0xc8, 0x74, 0xd1, 0xf3, 0xb4, 0xd0, 0x3a, 0x56, 0xdb, 0xf8, 0x5a, 0xa7, 0x15, 0x68, 0xed, 0xab,
+	0xa9, 0xb8, 0xe8, 0x56, 0x8a, 0x67, 0x7c, 0xfe, 0x56, 0x4a, 0xcd, 0x26, 0xdb, 0xd7, 0xd2, 0x91,
+	0x01, 0xbb, 0x8f, 0x60, 0x65, 0x2e, 0xe3, 0x42, 0x52, 0xa3, 0x45, 0x29, 0x5f, 0xfb, 0xe6, 0x42,
+	0x7c, 0x24, 0x90, 0x2b, 0x61, 0x36, 0x13, 0x9c, 0xf9, 0x73, 0xa9, 0x56, 0xbb, 0x35, 0x8f, 0x88,
+	0x6d, 0xca, 0x1d, 0xa8, 0x44, 0xb2, 0x04, 0x14, 0x5e, 0x11, 0x89, 0xcc, 0xa4, 0x7d, 0x25, 0x05,
+	0x13, 0x3d, 0xc8, 0xa2, 0xd5, 0x9b, 0x7f, 0x90, 0xa5, 0x54, 0x8a, 0xed, 0x76, 0x1a, 0x2a, 0xb6,
+	0xaf, 0xc2, 0xc2, 0x27, 0xd8, 0x57, 0x73, 0x05, 0x59, 0xfb, 0x4a, 0x0a, 0x26, 0x6a, 0xf4, 0xb9,
+	0x32, 0xc2, 0x37, 0xfa, 0xa2, 0xaa, 0xa6, 0x7d, 0x73, 0x21, 0xde, 0xe7, 0xbb, 0xa5, 0xfe, 0xe5,
+	0xdb, 0x1b, 0xca, 0x5f, 0xbf, 0xbd, 0xa1, 0xfc, 0xfd, 0xdb, 0x1b, 0xca, 0xaf, 0xff, 0x71, 0xe3,
+	0x12, 0x34, 0x6d, 0xf7, 0x64, 0xd3, 0x33, 0x4f, 0xcf, 0x37, 0x4f, 0xcf, 0xf9, 0x27, 0xc9, 0x47,
+	0x45, 0xfe, 0xf3, 0xf6, 0xbf, 0x07, 0x00, 0x02, 0x55, 0xcd, 0x86, 0xe0, 0x2c, 0x00, 0x00,
 }
@@ -56,7 +56,7 @@ func (x CmdType) String() string {
 	return proto.EnumName(CmdType_name, int32(x))
 }
 func (CmdType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{0}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{0}
 }
 
 type AdminCmdType int32
@@ -67,6 +67,11 @@ const (
 	AdminCmdType_CompactLog     AdminCmdType = 3
 	AdminCmdType_TransferLeader AdminCmdType = 4
 	AdminCmdType_BatchSplit     AdminCmdType = 10
+	AdminCmdType_DeletePrefix   AdminCmdType = 11
+	AdminCmdType_DeleteRange    AdminCmdType = 12
+	AdminCmdType_PrepareMerge   AdminCmdType = 13
+	AdminCmdType_CommitMerge    AdminCmdType = 14
+	AdminCmdType_RollbackMerge  AdminCmdType = 15
 )
 
 var AdminCmdType_name = map[int32]string{
@@ -75,6 +80,11 @@ var AdminCmdType_name = map[int32]string{
 	3:  "CompactLog",
 	4:  "TransferLeader",
 	10: "BatchSplit",
+	11: "DeletePrefix",
+	12: "DeleteRange",
+	13: "PrepareMerge",
+	14: "CommitMerge",
+	15: "RollbackMerge",
 }
 var AdminCmdType_value = map[string]int32{
 	"InvalidAdmin":   0,
@@ -82,13 +92,18 @@ var AdminCmdType_value = map[string]int32{
 	"CompactLog":     3,
 	"TransferLeader": 4,
 	"BatchSplit":     10,
+	"DeletePrefix":   11,
+	"DeleteRange":    12,
+	"PrepareMerge":   13,
+	"CommitMerge":    14,
+	"RollbackMerge":  15,
 }
 
 func (x AdminCmdType) String() string {
 	return proto.EnumName(AdminCmdType_name, int32(x))
 }
 func (AdminCmdType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{1}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{1}
 }
 
 type StatusCmdType int32
@@ -97,24 +112,27 @@ const (
 	StatusCmdType_InvalidStatus StatusCmdType = 0
 	StatusCmdType_RegionLeader  StatusCmdType = 1
 	StatusCmdType_RegionDetail  StatusCmdType = 2
+	StatusCmdType_RaftLog       StatusCmdType = 3
 )
 
 var StatusCmdType_name = map[int32]string{
 	0: "InvalidStatus",
 	1: "RegionLeader",
 	2: "RegionDetail",
+	3: "RaftLog",
 }
 var StatusCmdType_value = map[string]int32{
 	"InvalidStatus": 0,
 	"RegionLeader":  1,
 	"RegionDetail":  2,
+	"RaftLog":       3,
 }
 
 func (x StatusCmdType) String() string {
 	return proto.EnumName(StatusCmdType_name, int32(x))
 }
 func (StatusCmdType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{2}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{2}
 }
 
 type GetRequest struct {
@@ -129,7 +147,7 @@ func (m *GetRequest) Reset()         { *m = GetRequest{} }
 func (m *GetRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()    {}
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{0}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{0}
 }
 func (m *GetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,7 +201,7 @@ func (m *GetResponse) Reset()         { *m = GetResponse{} }
 func (m *GetResponse) String() string { return proto.CompactTextString(m) }
 func (*GetResponse) ProtoMessage()    {}
 func (*GetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{1}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{1}
 }
 func (m *GetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,7 +250,7 @@ func (m *PutRequest) Reset()         { *m = PutRequest{} }
 func (m *PutRequest) String() string { return proto.CompactTextString(m) }
 func (*PutRequest) ProtoMessage()    {}
 func (*PutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{2}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{2}
 }
 func (m *PutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -292,7 +310,7 @@ func (m *PutResponse) Reset()         { *m = PutResponse{} }
 func (m *PutResponse) String() string { return proto.CompactTextString(m) }
 func (*PutResponse) ProtoMessage()    {}
 func (*PutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{3}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{3}
 }
 func (m *PutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -333,7 +351,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{4}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{4}
 }
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +404,7 @@ func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()    {}
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{5}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{5}
 }
 func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,7 +443,7 @@ func (m *SnapRequest) Reset()         { *m = SnapRequest{} }
 func (m *SnapRequest) String() string { return proto.CompactTextString(m) }
 func (*SnapRequest) ProtoMessage()    {}
 func (*SnapRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{6}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{6}
 }
 func (m *SnapRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -465,7 +483,7 @@ func (m *SnapResponse) Reset()         { *m = SnapResponse{} }
 func (m *SnapResponse) String() string { return proto.CompactTextString(m) }
 func (*SnapResponse) ProtoMessage()    {}
 func (*SnapResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{7}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{7}
 }
 func (m *SnapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -516,7 +534,7 @@ func (m *Request) Reset()         { *m = Request{} }
 func (m *Request) String() string { return proto.CompactTextString(m) }
 func (*Request) ProtoMessage()    {}
 func (*Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{8}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{8}
 }
 func (m *Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -595,7 +613,7 @@ func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{9}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{9}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -661,18 +679,20 @@ func (m *Response) GetSnap() *SnapResponse {
 
 type ChangePeerRequest struct {
 	// This can be only called in internal RaftStore now.
-	ChangeType           eraftpb.ConfChangeType `protobuf:"varint,1,opt,name=change_type,json=changeType,proto3,enum=eraftpb.ConfChangeType" json:"change_type,omitempty"`
-	Peer                 *metapb.Peer           `protobuf:"bytes,2,opt,name=peer" json:"peer,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	ChangeType eraftpb.ConfChangeType `protobuf:"varint,1,opt,name=change_type,json=changeType,proto3,enum=eraftpb.ConfChangeType" json:"change_type,omitempty"`
+	Peer       *metapb.Peer           `protobuf:"bytes,2,opt,name=peer" json:"peer,omitempty"`
+	// true means the peer is added to repair an under-replicated region.
+	Repair               bool     `protobuf:"varint,3,opt,name=repair,proto3" json:"repair,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ChangePeerRequest) Reset()         { *m = ChangePeerRequest{} }
 func (m *ChangePeerRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangePeerRequest) ProtoMessage()    {}
 func (*ChangePeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{10}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{10}
 }
 func (m *ChangePeerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -715,6 +735,13 @@ func (m *ChangePeerRequest) GetPeer() *metapb.Peer {
 	return nil
 }
 
+func (m *ChangePeerRequest) GetRepair() bool {
+	if m != nil {
+		return m.Repair
+	}
+	return false
+}
+
 type ChangePeerResponse struct {
 	Region               *metapb.Region `protobuf:"bytes,1,opt,name=region" json:"region,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -726,7 +753,7 @@ func (m *ChangePeerResponse) Reset()         { *m = ChangePeerResponse{} }
 func (m *ChangePeerResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangePeerResponse) ProtoMessage()    {}
 func (*ChangePeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{11}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{11}
 }
 func (m *ChangePeerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -781,7 +808,7 @@ func (m *SplitRequest) Reset()         { *m = SplitRequest{} }
 func (m *SplitRequest) String() string { return proto.CompactTextString(m) }
 func (*SplitRequest) ProtoMessage()    {}
 func (*SplitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{12}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{12}
 }
 func (m *SplitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -832,17 +859,21 @@ func (m *SplitRequest) GetNewPeerIds() []uint64 {
 }
 
 type BatchSplitRequest struct {
-	Requests             []*SplitRequest `protobuf:"bytes,1,rep,name=requests" json:"requests,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Requests []*SplitRequest `protobuf:"bytes,1,rep,name=requests" json:"requests,omitempty"`
+	// If true, the first region derives the origin region_id, other regions use
+	// new ids. Otherwise the last region derives it, which is the default so
+	// that the splits proposed before the option existed apply the same way.
+	LeftDerive           bool     `protobuf:"varint,2,opt,name=left_derive,json=leftDerive,proto3" json:"left_derive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *BatchSplitRequest) Reset()         { *m = BatchSplitRequest{} }
 func (m *BatchSplitRequest) String() string { return proto.CompactTextString(m) }
 func (*BatchSplitRequest) ProtoMessage()    {}
 func (*BatchSplitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{13}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{13}
 }
 func (m *BatchSplitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,6 +909,13 @@ func (m *BatchSplitRequest) GetRequests() []*SplitRequest {
 	return nil
 }
 
+func (m *BatchSplitRequest) GetLeftDerive() bool {
+	if m != nil {
+		return m.LeftDerive
+	}
+	return false
+}
+
 type BatchSplitResponse struct {
 	Regions              []*metapb.Region `protobuf:"bytes,1,rep,name=regions" json:"regions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
@@ -889,7 +927,7 @@ func (m *BatchSplitResponse) Reset()         { *m = BatchSplitResponse{} }
 func (m *BatchSplitResponse) String() string { return proto.CompactTextString(m) }
 func (*BatchSplitResponse) ProtoMessage()    {}
 func (*BatchSplitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{14}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{14}
 }
 func (m *BatchSplitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -937,7 +975,7 @@ func (m *CompactLogRequest) Reset()         { *m = CompactLogRequest{} }
 func (m *CompactLogRequest) String() string { return proto.CompactTextString(m) }
 func (*CompactLogRequest) ProtoMessage()    {}
 func (*CompactLogRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{15}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{15}
 }
 func (m *CompactLogRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -990,7 +1028,7 @@ func (m *CompactLogResponse) Reset()         { *m = CompactLogResponse{} }
 func (m *CompactLogResponse) String() string { return proto.CompactTextString(m) }
 func (*CompactLogResponse) ProtoMessage()    {}
 func (*CompactLogResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{16}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{16}
 }
 func (m *CompactLogResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1030,7 +1068,7 @@ func (m *TransferLeaderRequest) Reset()         { *m = TransferLeaderRequest{} }
 func (m *TransferLeaderRequest) String() string { return proto.CompactTextString(m) }
 func (*TransferLeaderRequest) ProtoMessage()    {}
 func (*TransferLeaderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{17}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{17}
 }
 func (m *TransferLeaderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1076,7 +1114,7 @@ func (m *TransferLeaderResponse) Reset()         { *m = TransferLeaderResponse{}
 func (m *TransferLeaderResponse) String() string { return proto.CompactTextString(m) }
 func (*TransferLeaderResponse) ProtoMessage()    {}
 func (*TransferLeaderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{18}
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{18}
 }
 func (m *TransferLeaderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1105,29 +1143,26 @@ func (m *TransferLeaderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TransferLeaderResponse proto.InternalMessageInfo
 
-type AdminRequest struct {
-	CmdType              AdminCmdType           `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.AdminCmdType" json:"cmd_type,omitempty"`
-	ChangePeer           *ChangePeerRequest     `protobuf:"bytes,2,opt,name=change_peer,json=changePeer" json:"change_peer,omitempty"`
-	CompactLog           *CompactLogRequest     `protobuf:"bytes,4,opt,name=compact_log,json=compactLog" json:"compact_log,omitempty"`
-	TransferLeader       *TransferLeaderRequest `protobuf:"bytes,5,opt,name=transfer_leader,json=transferLeader" json:"transfer_leader,omitempty"`
-	Splits               *BatchSplitRequest     `protobuf:"bytes,10,opt,name=splits" json:"splits,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+type DeletePrefixRequest struct {
+	// All the data keys starting with the prefix inside the region are deleted.
+	Prefix               []byte   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AdminRequest) Reset()         { *m = AdminRequest{} }
-func (m *AdminRequest) String() string { return proto.CompactTextString(m) }
-func (*AdminRequest) ProtoMessage()    {}
-func (*AdminRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{19}
+func (m *DeletePrefixRequest) Reset()         { *m = DeletePrefixRequest{} }
+func (m *DeletePrefixRequest) String() string { return proto.CompactTextString(m) }
+func (*DeletePrefixRequest) ProtoMessage()    {}
+func (*DeletePrefixRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{19}
 }
-func (m *AdminRequest) XXX_Unmarshal(b []byte) error {
+func (m *DeletePrefixRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeletePrefixRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AdminRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeletePrefixRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1137,76 +1172,43 @@ func (m *AdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (dst *AdminRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AdminRequest.Merge(dst, src)
+func (dst *DeletePrefixRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePrefixRequest.Merge(dst, src)
 }
-func (m *AdminRequest) XXX_Size() int {
+func (m *DeletePrefixRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AdminRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AdminRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_AdminRequest proto.InternalMessageInfo
-
-func (m *AdminRequest) GetCmdType() AdminCmdType {
-	if m != nil {
-		return m.CmdType
-	}
-	return AdminCmdType_InvalidAdmin
-}
-
-func (m *AdminRequest) GetChangePeer() *ChangePeerRequest {
-	if m != nil {
-		return m.ChangePeer
-	}
-	return nil
-}
-
-func (m *AdminRequest) GetCompactLog() *CompactLogRequest {
-	if m != nil {
-		return m.CompactLog
-	}
-	return nil
+func (m *DeletePrefixRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePrefixRequest.DiscardUnknown(m)
 }
 
-func (m *AdminRequest) GetTransferLeader() *TransferLeaderRequest {
-	if m != nil {
-		return m.TransferLeader
-	}
-	return nil
-}
+var xxx_messageInfo_DeletePrefixRequest proto.InternalMessageInfo
 
-func (m *AdminRequest) GetSplits() *BatchSplitRequest {
+func (m *DeletePrefixRequest) GetPrefix() []byte {
 	if m != nil {
-		return m.Splits
+		return m.Prefix
 	}
 	return nil
 }
 
-type AdminResponse struct {
-	CmdType              AdminCmdType            `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.AdminCmdType" json:"cmd_type,omitempty"`
-	ChangePeer           *ChangePeerResponse     `protobuf:"bytes,2,opt,name=change_peer,json=changePeer" json:"change_peer,omitempty"`
-	CompactLog           *CompactLogResponse     `protobuf:"bytes,4,opt,name=compact_log,json=compactLog" json:"compact_log,omitempty"`
-	TransferLeader       *TransferLeaderResponse `protobuf:"bytes,5,opt,name=transfer_leader,json=transferLeader" json:"transfer_leader,omitempty"`
-	Splits               *BatchSplitResponse     `protobuf:"bytes,10,opt,name=splits" json:"splits,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+type DeletePrefixResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AdminResponse) Reset()         { *m = AdminResponse{} }
-func (m *AdminResponse) String() string { return proto.CompactTextString(m) }
-func (*AdminResponse) ProtoMessage()    {}
-func (*AdminResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{20}
+func (m *DeletePrefixResponse) Reset()         { *m = DeletePrefixResponse{} }
+func (m *DeletePrefixResponse) String() string { return proto.CompactTextString(m) }
+func (*DeletePrefixResponse) ProtoMessage()    {}
+func (*DeletePrefixResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{20}
 }
-func (m *AdminResponse) XXX_Unmarshal(b []byte) error {
+func (m *DeletePrefixResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeletePrefixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AdminResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeletePrefixResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1216,72 +1218,93 @@ func (m *AdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (dst *AdminResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AdminResponse.Merge(dst, src)
+func (dst *DeletePrefixResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePrefixResponse.Merge(dst, src)
 }
-func (m *AdminResponse) XXX_Size() int {
+func (m *DeletePrefixResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *AdminResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AdminResponse.DiscardUnknown(m)
+func (m *DeletePrefixResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePrefixResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AdminResponse proto.InternalMessageInfo
+var xxx_messageInfo_DeletePrefixResponse proto.InternalMessageInfo
 
-func (m *AdminResponse) GetCmdType() AdminCmdType {
-	if m != nil {
-		return m.CmdType
-	}
-	return AdminCmdType_InvalidAdmin
+type DeleteRangeRequest struct {
+	// All the data keys of [start_key, end_key) inside the region are deleted, an empty
+	// end_key means the end of the region.
+	StartKey             []byte   `protobuf:"bytes,1,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	EndKey               []byte   `protobuf:"bytes,2,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AdminResponse) GetChangePeer() *ChangePeerResponse {
-	if m != nil {
-		return m.ChangePeer
-	}
-	return nil
+func (m *DeleteRangeRequest) Reset()         { *m = DeleteRangeRequest{} }
+func (m *DeleteRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRangeRequest) ProtoMessage()    {}
+func (*DeleteRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{21}
 }
-
-func (m *AdminResponse) GetCompactLog() *CompactLogResponse {
-	if m != nil {
-		return m.CompactLog
+func (m *DeleteRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (dst *DeleteRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRangeRequest.Merge(dst, src)
+}
+func (m *DeleteRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRangeRequest.DiscardUnknown(m)
 }
 
-func (m *AdminResponse) GetTransferLeader() *TransferLeaderResponse {
+var xxx_messageInfo_DeleteRangeRequest proto.InternalMessageInfo
+
+func (m *DeleteRangeRequest) GetStartKey() []byte {
 	if m != nil {
-		return m.TransferLeader
+		return m.StartKey
 	}
 	return nil
 }
 
-func (m *AdminResponse) GetSplits() *BatchSplitResponse {
+func (m *DeleteRangeRequest) GetEndKey() []byte {
 	if m != nil {
-		return m.Splits
+		return m.EndKey
 	}
 	return nil
 }
 
-// For get the leader of the region.
-type RegionLeaderRequest struct {
+type DeleteRangeResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RegionLeaderRequest) Reset()         { *m = RegionLeaderRequest{} }
-func (m *RegionLeaderRequest) String() string { return proto.CompactTextString(m) }
-func (*RegionLeaderRequest) ProtoMessage()    {}
-func (*RegionLeaderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{21}
+func (m *DeleteRangeResponse) Reset()         { *m = DeleteRangeResponse{} }
+func (m *DeleteRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteRangeResponse) ProtoMessage()    {}
+func (*DeleteRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{22}
 }
-func (m *RegionLeaderRequest) XXX_Unmarshal(b []byte) error {
+func (m *DeleteRangeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RegionLeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RegionLeaderRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteRangeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1291,37 +1314,39 @@ func (m *RegionLeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (dst *RegionLeaderRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegionLeaderRequest.Merge(dst, src)
+func (dst *DeleteRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRangeResponse.Merge(dst, src)
 }
-func (m *RegionLeaderRequest) XXX_Size() int {
+func (m *DeleteRangeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RegionLeaderRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegionLeaderRequest.DiscardUnknown(m)
+func (m *DeleteRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRangeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegionLeaderRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteRangeResponse proto.InternalMessageInfo
 
-type RegionLeaderResponse struct {
-	Leader               *metapb.Peer `protobuf:"bytes,1,opt,name=leader" json:"leader,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type PrepareMergeRequest struct {
+	// The adjacent region the region is merged into, the region rejects the
+	// writes until it's merged or the merge is rolled back.
+	Target               *metapb.Region `protobuf:"bytes,1,opt,name=target" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *RegionLeaderResponse) Reset()         { *m = RegionLeaderResponse{} }
-func (m *RegionLeaderResponse) String() string { return proto.CompactTextString(m) }
-func (*RegionLeaderResponse) ProtoMessage()    {}
-func (*RegionLeaderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{22}
+func (m *PrepareMergeRequest) Reset()         { *m = PrepareMergeRequest{} }
+func (m *PrepareMergeRequest) String() string { return proto.CompactTextString(m) }
+func (*PrepareMergeRequest) ProtoMessage()    {}
+func (*PrepareMergeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{23}
 }
-func (m *RegionLeaderResponse) XXX_Unmarshal(b []byte) error {
+func (m *PrepareMergeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RegionLeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PrepareMergeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RegionLeaderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PrepareMergeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1331,52 +1356,43 @@ func (m *RegionLeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (dst *RegionLeaderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegionLeaderResponse.Merge(dst, src)
+func (dst *PrepareMergeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrepareMergeRequest.Merge(dst, src)
 }
-func (m *RegionLeaderResponse) XXX_Size() int {
+func (m *PrepareMergeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RegionLeaderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegionLeaderResponse.DiscardUnknown(m)
+func (m *PrepareMergeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrepareMergeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegionLeaderResponse proto.InternalMessageInfo
+var xxx_messageInfo_PrepareMergeRequest proto.InternalMessageInfo
 
-func (m *RegionLeaderResponse) GetLeader() *metapb.Peer {
+func (m *PrepareMergeRequest) GetTarget() *metapb.Region {
 	if m != nil {
-		return m.Leader
+		return m.Target
 	}
 	return nil
 }
 
-// For getting more information of the region.
-// We add some admin operations (ChangePeer, Split...) into the pb job list,
-// then pd server will peek the first one, handle it and then pop it from the job lib.
-// But sometimes, the pd server may crash before popping. When another pd server
-// starts and finds the job is running but not finished, it will first check whether
-// the raft server already has handled this job.
-// E,g, for ChangePeer, if we add Peer10 into region1 and find region1 has already had
-// Peer10, we can think this ChangePeer is finished, and can pop this job from job list
-// directly.
-type RegionDetailRequest struct {
+type PrepareMergeResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RegionDetailRequest) Reset()         { *m = RegionDetailRequest{} }
-func (m *RegionDetailRequest) String() string { return proto.CompactTextString(m) }
-func (*RegionDetailRequest) ProtoMessage()    {}
-func (*RegionDetailRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{23}
+func (m *PrepareMergeResponse) Reset()         { *m = PrepareMergeResponse{} }
+func (m *PrepareMergeResponse) String() string { return proto.CompactTextString(m) }
+func (*PrepareMergeResponse) ProtoMessage()    {}
+func (*PrepareMergeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{24}
 }
-func (m *RegionDetailRequest) XXX_Unmarshal(b []byte) error {
+func (m *PrepareMergeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RegionDetailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PrepareMergeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RegionDetailRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PrepareMergeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1386,38 +1402,39 @@ func (m *RegionDetailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (dst *RegionDetailRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegionDetailRequest.Merge(dst, src)
+func (dst *PrepareMergeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrepareMergeResponse.Merge(dst, src)
 }
-func (m *RegionDetailRequest) XXX_Size() int {
+func (m *PrepareMergeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RegionDetailRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegionDetailRequest.DiscardUnknown(m)
+func (m *PrepareMergeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrepareMergeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegionDetailRequest proto.InternalMessageInfo
+var xxx_messageInfo_PrepareMergeResponse proto.InternalMessageInfo
 
-type RegionDetailResponse struct {
-	Region               *metapb.Region `protobuf:"bytes,1,opt,name=region" json:"region,omitempty"`
-	Leader               *metapb.Peer   `protobuf:"bytes,2,opt,name=leader" json:"leader,omitempty"`
+type CommitMergeRequest struct {
+	// The source region as of the applied PrepareMerge, and the index of it.
+	Source               *metapb.Region `protobuf:"bytes,1,opt,name=source" json:"source,omitempty"`
+	Commit               uint64         `protobuf:"varint,2,opt,name=commit,proto3" json:"commit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *RegionDetailResponse) Reset()         { *m = RegionDetailResponse{} }
-func (m *RegionDetailResponse) String() string { return proto.CompactTextString(m) }
-func (*RegionDetailResponse) ProtoMessage()    {}
-func (*RegionDetailResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{24}
+func (m *CommitMergeRequest) Reset()         { *m = CommitMergeRequest{} }
+func (m *CommitMergeRequest) String() string { return proto.CompactTextString(m) }
+func (*CommitMergeRequest) ProtoMessage()    {}
+func (*CommitMergeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{25}
 }
-func (m *RegionDetailResponse) XXX_Unmarshal(b []byte) error {
+func (m *CommitMergeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RegionDetailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitMergeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RegionDetailResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitMergeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1427,53 +1444,50 @@ func (m *RegionDetailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (dst *RegionDetailResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegionDetailResponse.Merge(dst, src)
+func (dst *CommitMergeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitMergeRequest.Merge(dst, src)
 }
-func (m *RegionDetailResponse) XXX_Size() int {
+func (m *CommitMergeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RegionDetailResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegionDetailResponse.DiscardUnknown(m)
+func (m *CommitMergeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitMergeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegionDetailResponse proto.InternalMessageInfo
+var xxx_messageInfo_CommitMergeRequest proto.InternalMessageInfo
 
-func (m *RegionDetailResponse) GetRegion() *metapb.Region {
+func (m *CommitMergeRequest) GetSource() *metapb.Region {
 	if m != nil {
-		return m.Region
+		return m.Source
 	}
 	return nil
 }
 
-func (m *RegionDetailResponse) GetLeader() *metapb.Peer {
+func (m *CommitMergeRequest) GetCommit() uint64 {
 	if m != nil {
-		return m.Leader
+		return m.Commit
 	}
-	return nil
+	return 0
 }
 
-type StatusRequest struct {
-	CmdType              StatusCmdType        `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.StatusCmdType" json:"cmd_type,omitempty"`
-	RegionLeader         *RegionLeaderRequest `protobuf:"bytes,2,opt,name=region_leader,json=regionLeader" json:"region_leader,omitempty"`
-	RegionDetail         *RegionDetailRequest `protobuf:"bytes,3,opt,name=region_detail,json=regionDetail" json:"region_detail,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type CommitMergeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StatusRequest) Reset()         { *m = StatusRequest{} }
-func (m *StatusRequest) String() string { return proto.CompactTextString(m) }
-func (*StatusRequest) ProtoMessage()    {}
-func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{25}
+func (m *CommitMergeResponse) Reset()         { *m = CommitMergeResponse{} }
+func (m *CommitMergeResponse) String() string { return proto.CompactTextString(m) }
+func (*CommitMergeResponse) ProtoMessage()    {}
+func (*CommitMergeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{26}
 }
-func (m *StatusRequest) XXX_Unmarshal(b []byte) error {
+func (m *CommitMergeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CommitMergeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StatusRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CommitMergeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1483,60 +1497,38 @@ func (m *StatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (dst *StatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StatusRequest.Merge(dst, src)
+func (dst *CommitMergeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitMergeResponse.Merge(dst, src)
 }
-func (m *StatusRequest) XXX_Size() int {
+func (m *CommitMergeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *StatusRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StatusRequest.DiscardUnknown(m)
+func (m *CommitMergeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitMergeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StatusRequest proto.InternalMessageInfo
+var xxx_messageInfo_CommitMergeResponse proto.InternalMessageInfo
 
-func (m *StatusRequest) GetCmdType() StatusCmdType {
-	if m != nil {
-		return m.CmdType
-	}
-	return StatusCmdType_InvalidStatus
+type RollbackMergeRequest struct {
+	// The index of the PrepareMerge rolled back.
+	Commit               uint64   `protobuf:"varint,1,opt,name=commit,proto3" json:"commit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StatusRequest) GetRegionLeader() *RegionLeaderRequest {
-	if m != nil {
-		return m.RegionLeader
-	}
-	return nil
-}
-
-func (m *StatusRequest) GetRegionDetail() *RegionDetailRequest {
-	if m != nil {
-		return m.RegionDetail
-	}
-	return nil
-}
-
-type StatusResponse struct {
-	CmdType              StatusCmdType         `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.StatusCmdType" json:"cmd_type,omitempty"`
-	RegionLeader         *RegionLeaderResponse `protobuf:"bytes,2,opt,name=region_leader,json=regionLeader" json:"region_leader,omitempty"`
-	RegionDetail         *RegionDetailResponse `protobuf:"bytes,3,opt,name=region_detail,json=regionDetail" json:"region_detail,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
-}
-
-func (m *StatusResponse) Reset()         { *m = StatusResponse{} }
-func (m *StatusResponse) String() string { return proto.CompactTextString(m) }
-func (*StatusResponse) ProtoMessage()    {}
-func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{26}
+func (m *RollbackMergeRequest) Reset()         { *m = RollbackMergeRequest{} }
+func (m *RollbackMergeRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackMergeRequest) ProtoMessage()    {}
+func (*RollbackMergeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{27}
 }
-func (m *StatusResponse) XXX_Unmarshal(b []byte) error {
+func (m *RollbackMergeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RollbackMergeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StatusResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RollbackMergeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1546,69 +1538,92 @@ func (m *StatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (dst *StatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StatusResponse.Merge(dst, src)
+func (dst *RollbackMergeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackMergeRequest.Merge(dst, src)
 }
-func (m *StatusResponse) XXX_Size() int {
+func (m *RollbackMergeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *StatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StatusResponse.DiscardUnknown(m)
+func (m *RollbackMergeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackMergeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StatusResponse proto.InternalMessageInfo
+var xxx_messageInfo_RollbackMergeRequest proto.InternalMessageInfo
 
-func (m *StatusResponse) GetCmdType() StatusCmdType {
+func (m *RollbackMergeRequest) GetCommit() uint64 {
 	if m != nil {
-		return m.CmdType
+		return m.Commit
 	}
-	return StatusCmdType_InvalidStatus
+	return 0
 }
 
-func (m *StatusResponse) GetRegionLeader() *RegionLeaderResponse {
-	if m != nil {
-		return m.RegionLeader
-	}
-	return nil
+type RollbackMergeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StatusResponse) GetRegionDetail() *RegionDetailResponse {
-	if m != nil {
-		return m.RegionDetail
+func (m *RollbackMergeResponse) Reset()         { *m = RollbackMergeResponse{} }
+func (m *RollbackMergeResponse) String() string { return proto.CompactTextString(m) }
+func (*RollbackMergeResponse) ProtoMessage()    {}
+func (*RollbackMergeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{28}
+}
+func (m *RollbackMergeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackMergeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackMergeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (dst *RollbackMergeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackMergeResponse.Merge(dst, src)
+}
+func (m *RollbackMergeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackMergeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackMergeResponse.DiscardUnknown(m)
 }
 
-type RaftRequestHeader struct {
-	RegionId uint64       `protobuf:"varint,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
-	Peer     *metapb.Peer `protobuf:"bytes,2,opt,name=peer" json:"peer,omitempty"`
-	// true for read linearization
-	ReadQuorum bool `protobuf:"varint,3,opt,name=read_quorum,json=readQuorum,proto3" json:"read_quorum,omitempty"`
-	// 16 bytes, to distinguish request.
-	Uuid        []byte              `protobuf:"bytes,4,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	RegionEpoch *metapb.RegionEpoch `protobuf:"bytes,5,opt,name=region_epoch,json=regionEpoch" json:"region_epoch,omitempty"`
-	Term        uint64              `protobuf:"varint,6,opt,name=term,proto3" json:"term,omitempty"`
-	SyncLog     bool                `protobuf:"varint,7,opt,name=sync_log,json=syncLog,proto3" json:"sync_log,omitempty"`
-	ReplicaRead bool                `protobuf:"varint,8,opt,name=replica_read,json=replicaRead,proto3" json:"replica_read,omitempty"`
-	// Read requests can be responsed directly after the Raft applys to `applied_index`.
-	AppliedIndex         uint64   `protobuf:"varint,9,opt,name=applied_index,json=appliedIndex,proto3" json:"applied_index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+var xxx_messageInfo_RollbackMergeResponse proto.InternalMessageInfo
+
+type AdminRequest struct {
+	CmdType              AdminCmdType           `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.AdminCmdType" json:"cmd_type,omitempty"`
+	ChangePeer           *ChangePeerRequest     `protobuf:"bytes,2,opt,name=change_peer,json=changePeer" json:"change_peer,omitempty"`
+	CompactLog           *CompactLogRequest     `protobuf:"bytes,4,opt,name=compact_log,json=compactLog" json:"compact_log,omitempty"`
+	TransferLeader       *TransferLeaderRequest `protobuf:"bytes,5,opt,name=transfer_leader,json=transferLeader" json:"transfer_leader,omitempty"`
+	Splits               *BatchSplitRequest     `protobuf:"bytes,10,opt,name=splits" json:"splits,omitempty"`
+	DeletePrefix         *DeletePrefixRequest   `protobuf:"bytes,11,opt,name=delete_prefix,json=deletePrefix" json:"delete_prefix,omitempty"`
+	DeleteRange          *DeleteRangeRequest    `protobuf:"bytes,12,opt,name=delete_range,json=deleteRange" json:"delete_range,omitempty"`
+	PrepareMerge         *PrepareMergeRequest   `protobuf:"bytes,13,opt,name=prepare_merge,json=prepareMerge" json:"prepare_merge,omitempty"`
+	CommitMerge          *CommitMergeRequest    `protobuf:"bytes,14,opt,name=commit_merge,json=commitMerge" json:"commit_merge,omitempty"`
+	RollbackMerge        *RollbackMergeRequest  `protobuf:"bytes,15,opt,name=rollback_merge,json=rollbackMerge" json:"rollback_merge,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *RaftRequestHeader) Reset()         { *m = RaftRequestHeader{} }
-func (m *RaftRequestHeader) String() string { return proto.CompactTextString(m) }
-func (*RaftRequestHeader) ProtoMessage()    {}
-func (*RaftRequestHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{27}
+func (m *AdminRequest) Reset()         { *m = AdminRequest{} }
+func (m *AdminRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminRequest) ProtoMessage()    {}
+func (*AdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{29}
 }
-func (m *RaftRequestHeader) XXX_Unmarshal(b []byte) error {
+func (m *AdminRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RaftRequestHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RaftRequestHeader.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AdminRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1618,102 +1633,116 @@ func (m *RaftRequestHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (dst *RaftRequestHeader) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RaftRequestHeader.Merge(dst, src)
+func (dst *AdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminRequest.Merge(dst, src)
 }
-func (m *RaftRequestHeader) XXX_Size() int {
+func (m *AdminRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RaftRequestHeader) XXX_DiscardUnknown() {
-	xxx_messageInfo_RaftRequestHeader.DiscardUnknown(m)
+func (m *AdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RaftRequestHeader proto.InternalMessageInfo
+var xxx_messageInfo_AdminRequest proto.InternalMessageInfo
 
-func (m *RaftRequestHeader) GetRegionId() uint64 {
+func (m *AdminRequest) GetCmdType() AdminCmdType {
 	if m != nil {
-		return m.RegionId
+		return m.CmdType
 	}
-	return 0
+	return AdminCmdType_InvalidAdmin
 }
 
-func (m *RaftRequestHeader) GetPeer() *metapb.Peer {
+func (m *AdminRequest) GetChangePeer() *ChangePeerRequest {
 	if m != nil {
-		return m.Peer
+		return m.ChangePeer
 	}
 	return nil
 }
 
-func (m *RaftRequestHeader) GetReadQuorum() bool {
+func (m *AdminRequest) GetCompactLog() *CompactLogRequest {
 	if m != nil {
-		return m.ReadQuorum
+		return m.CompactLog
 	}
-	return false
+	return nil
 }
 
-func (m *RaftRequestHeader) GetUuid() []byte {
+func (m *AdminRequest) GetTransferLeader() *TransferLeaderRequest {
 	if m != nil {
-		return m.Uuid
+		return m.TransferLeader
 	}
 	return nil
 }
 
-func (m *RaftRequestHeader) GetRegionEpoch() *metapb.RegionEpoch {
+func (m *AdminRequest) GetSplits() *BatchSplitRequest {
 	if m != nil {
-		return m.RegionEpoch
+		return m.Splits
 	}
 	return nil
 }
 
-func (m *RaftRequestHeader) GetTerm() uint64 {
+func (m *AdminRequest) GetDeletePrefix() *DeletePrefixRequest {
 	if m != nil {
-		return m.Term
+		return m.DeletePrefix
 	}
-	return 0
+	return nil
 }
 
-func (m *RaftRequestHeader) GetSyncLog() bool {
+func (m *AdminRequest) GetDeleteRange() *DeleteRangeRequest {
 	if m != nil {
-		return m.SyncLog
+		return m.DeleteRange
 	}
-	return false
+	return nil
 }
 
-func (m *RaftRequestHeader) GetReplicaRead() bool {
+func (m *AdminRequest) GetPrepareMerge() *PrepareMergeRequest {
 	if m != nil {
-		return m.ReplicaRead
+		return m.PrepareMerge
 	}
-	return false
+	return nil
 }
 
-func (m *RaftRequestHeader) GetAppliedIndex() uint64 {
+func (m *AdminRequest) GetCommitMerge() *CommitMergeRequest {
 	if m != nil {
-		return m.AppliedIndex
+		return m.CommitMerge
 	}
-	return 0
+	return nil
 }
 
-type RaftResponseHeader struct {
-	Error                *errorpb.Error `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
-	Uuid                 []byte         `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	CurrentTerm          uint64         `protobuf:"varint,3,opt,name=current_term,json=currentTerm,proto3" json:"current_term,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *AdminRequest) GetRollbackMerge() *RollbackMergeRequest {
+	if m != nil {
+		return m.RollbackMerge
+	}
+	return nil
 }
 
-func (m *RaftResponseHeader) Reset()         { *m = RaftResponseHeader{} }
-func (m *RaftResponseHeader) String() string { return proto.CompactTextString(m) }
-func (*RaftResponseHeader) ProtoMessage()    {}
-func (*RaftResponseHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{28}
+type AdminResponse struct {
+	CmdType              AdminCmdType            `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.AdminCmdType" json:"cmd_type,omitempty"`
+	ChangePeer           *ChangePeerResponse     `protobuf:"bytes,2,opt,name=change_peer,json=changePeer" json:"change_peer,omitempty"`
+	CompactLog           *CompactLogResponse     `protobuf:"bytes,4,opt,name=compact_log,json=compactLog" json:"compact_log,omitempty"`
+	TransferLeader       *TransferLeaderResponse `protobuf:"bytes,5,opt,name=transfer_leader,json=transferLeader" json:"transfer_leader,omitempty"`
+	Splits               *BatchSplitResponse     `protobuf:"bytes,10,opt,name=splits" json:"splits,omitempty"`
+	DeletePrefix         *DeletePrefixResponse   `protobuf:"bytes,11,opt,name=delete_prefix,json=deletePrefix" json:"delete_prefix,omitempty"`
+	DeleteRange          *DeleteRangeResponse    `protobuf:"bytes,12,opt,name=delete_range,json=deleteRange" json:"delete_range,omitempty"`
+	PrepareMerge         *PrepareMergeResponse   `protobuf:"bytes,13,opt,name=prepare_merge,json=prepareMerge" json:"prepare_merge,omitempty"`
+	CommitMerge          *CommitMergeResponse    `protobuf:"bytes,14,opt,name=commit_merge,json=commitMerge" json:"commit_merge,omitempty"`
+	RollbackMerge        *RollbackMergeResponse  `protobuf:"bytes,15,opt,name=rollback_merge,json=rollbackMerge" json:"rollback_merge,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
-func (m *RaftResponseHeader) XXX_Unmarshal(b []byte) error {
+
+func (m *AdminResponse) Reset()         { *m = AdminResponse{} }
+func (m *AdminResponse) String() string { return proto.CompactTextString(m) }
+func (*AdminResponse) ProtoMessage()    {}
+func (*AdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{30}
+}
+func (m *AdminResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RaftResponseHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RaftResponseHeader.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AdminResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1723,65 +1752,109 @@ func (m *RaftResponseHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (dst *RaftResponseHeader) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RaftResponseHeader.Merge(dst, src)
+func (dst *AdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminResponse.Merge(dst, src)
 }
-func (m *RaftResponseHeader) XXX_Size() int {
+func (m *AdminResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *RaftResponseHeader) XXX_DiscardUnknown() {
-	xxx_messageInfo_RaftResponseHeader.DiscardUnknown(m)
+func (m *AdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RaftResponseHeader proto.InternalMessageInfo
+var xxx_messageInfo_AdminResponse proto.InternalMessageInfo
 
-func (m *RaftResponseHeader) GetError() *errorpb.Error {
+func (m *AdminResponse) GetCmdType() AdminCmdType {
 	if m != nil {
-		return m.Error
+		return m.CmdType
+	}
+	return AdminCmdType_InvalidAdmin
+}
+
+func (m *AdminResponse) GetChangePeer() *ChangePeerResponse {
+	if m != nil {
+		return m.ChangePeer
 	}
 	return nil
 }
 
-func (m *RaftResponseHeader) GetUuid() []byte {
+func (m *AdminResponse) GetCompactLog() *CompactLogResponse {
 	if m != nil {
-		return m.Uuid
+		return m.CompactLog
 	}
 	return nil
 }
 
-func (m *RaftResponseHeader) GetCurrentTerm() uint64 {
+func (m *AdminResponse) GetTransferLeader() *TransferLeaderResponse {
 	if m != nil {
-		return m.CurrentTerm
+		return m.TransferLeader
 	}
-	return 0
+	return nil
 }
 
-type RaftCmdRequest struct {
-	Header *RaftRequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
-	// We can't enclose normal requests and administrator request
-	// at same time.
-	Requests             []*Request     `protobuf:"bytes,2,rep,name=requests" json:"requests,omitempty"`
-	AdminRequest         *AdminRequest  `protobuf:"bytes,3,opt,name=admin_request,json=adminRequest" json:"admin_request,omitempty"`
-	StatusRequest        *StatusRequest `protobuf:"bytes,4,opt,name=status_request,json=statusRequest" json:"status_request,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *AdminResponse) GetSplits() *BatchSplitResponse {
+	if m != nil {
+		return m.Splits
+	}
+	return nil
 }
 
-func (m *RaftCmdRequest) Reset()         { *m = RaftCmdRequest{} }
-func (m *RaftCmdRequest) String() string { return proto.CompactTextString(m) }
-func (*RaftCmdRequest) ProtoMessage()    {}
-func (*RaftCmdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{29}
+func (m *AdminResponse) GetDeletePrefix() *DeletePrefixResponse {
+	if m != nil {
+		return m.DeletePrefix
+	}
+	return nil
 }
-func (m *RaftCmdRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *AdminResponse) GetDeleteRange() *DeleteRangeResponse {
+	if m != nil {
+		return m.DeleteRange
+	}
+	return nil
 }
-func (m *RaftCmdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_RaftCmdRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
+
+func (m *AdminResponse) GetPrepareMerge() *PrepareMergeResponse {
+	if m != nil {
+		return m.PrepareMerge
+	}
+	return nil
+}
+
+func (m *AdminResponse) GetCommitMerge() *CommitMergeResponse {
+	if m != nil {
+		return m.CommitMerge
+	}
+	return nil
+}
+
+func (m *AdminResponse) GetRollbackMerge() *RollbackMergeResponse {
+	if m != nil {
+		return m.RollbackMerge
+	}
+	return nil
+}
+
+// For get the leader of the region.
+type RegionLeaderRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegionLeaderRequest) Reset()         { *m = RegionLeaderRequest{} }
+func (m *RegionLeaderRequest) String() string { return proto.CompactTextString(m) }
+func (*RegionLeaderRequest) ProtoMessage()    {}
+func (*RegionLeaderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{31}
+}
+func (m *RegionLeaderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegionLeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegionLeaderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
 		if err != nil {
 			return nil, err
@@ -1789,68 +1862,188 @@ func (m *RaftCmdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (dst *RaftCmdRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RaftCmdRequest.Merge(dst, src)
+func (dst *RegionLeaderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegionLeaderRequest.Merge(dst, src)
 }
-func (m *RaftCmdRequest) XXX_Size() int {
+func (m *RegionLeaderRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RaftCmdRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RaftCmdRequest.DiscardUnknown(m)
+func (m *RegionLeaderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegionLeaderRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RaftCmdRequest proto.InternalMessageInfo
+var xxx_messageInfo_RegionLeaderRequest proto.InternalMessageInfo
 
-func (m *RaftCmdRequest) GetHeader() *RaftRequestHeader {
-	if m != nil {
-		return m.Header
+type RegionLeaderResponse struct {
+	Leader               *metapb.Peer `protobuf:"bytes,1,opt,name=leader" json:"leader,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *RegionLeaderResponse) Reset()         { *m = RegionLeaderResponse{} }
+func (m *RegionLeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*RegionLeaderResponse) ProtoMessage()    {}
+func (*RegionLeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{32}
+}
+func (m *RegionLeaderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegionLeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegionLeaderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (dst *RegionLeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegionLeaderResponse.Merge(dst, src)
+}
+func (m *RegionLeaderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegionLeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegionLeaderResponse.DiscardUnknown(m)
 }
 
-func (m *RaftCmdRequest) GetRequests() []*Request {
+var xxx_messageInfo_RegionLeaderResponse proto.InternalMessageInfo
+
+func (m *RegionLeaderResponse) GetLeader() *metapb.Peer {
 	if m != nil {
-		return m.Requests
+		return m.Leader
 	}
 	return nil
 }
 
-func (m *RaftCmdRequest) GetAdminRequest() *AdminRequest {
+// For getting more information of the region.
+// We add some admin operations (ChangePeer, Split...) into the pb job list,
+// then pd server will peek the first one, handle it and then pop it from the job lib.
+// But sometimes, the pd server may crash before popping. When another pd server
+// starts and finds the job is running but not finished, it will first check whether
+// the raft server already has handled this job.
+// E,g, for ChangePeer, if we add Peer10 into region1 and find region1 has already had
+// Peer10, we can think this ChangePeer is finished, and can pop this job from job list
+// directly.
+type RegionDetailRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegionDetailRequest) Reset()         { *m = RegionDetailRequest{} }
+func (m *RegionDetailRequest) String() string { return proto.CompactTextString(m) }
+func (*RegionDetailRequest) ProtoMessage()    {}
+func (*RegionDetailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{33}
+}
+func (m *RegionDetailRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegionDetailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegionDetailRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RegionDetailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegionDetailRequest.Merge(dst, src)
+}
+func (m *RegionDetailRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegionDetailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegionDetailRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegionDetailRequest proto.InternalMessageInfo
+
+type RegionDetailResponse struct {
+	Region               *metapb.Region `protobuf:"bytes,1,opt,name=region" json:"region,omitempty"`
+	Leader               *metapb.Peer   `protobuf:"bytes,2,opt,name=leader" json:"leader,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RegionDetailResponse) Reset()         { *m = RegionDetailResponse{} }
+func (m *RegionDetailResponse) String() string { return proto.CompactTextString(m) }
+func (*RegionDetailResponse) ProtoMessage()    {}
+func (*RegionDetailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{34}
+}
+func (m *RegionDetailResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegionDetailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegionDetailResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RegionDetailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegionDetailResponse.Merge(dst, src)
+}
+func (m *RegionDetailResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegionDetailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegionDetailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegionDetailResponse proto.InternalMessageInfo
+
+func (m *RegionDetailResponse) GetRegion() *metapb.Region {
 	if m != nil {
-		return m.AdminRequest
+		return m.Region
 	}
 	return nil
 }
 
-func (m *RaftCmdRequest) GetStatusRequest() *StatusRequest {
+func (m *RegionDetailResponse) GetLeader() *metapb.Peer {
 	if m != nil {
-		return m.StatusRequest
+		return m.Leader
 	}
 	return nil
 }
 
-type RaftCmdResponse struct {
-	Header               *RaftResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
-	Responses            []*Response         `protobuf:"bytes,2,rep,name=responses" json:"responses,omitempty"`
-	AdminResponse        *AdminResponse      `protobuf:"bytes,3,opt,name=admin_response,json=adminResponse" json:"admin_response,omitempty"`
-	StatusResponse       *StatusResponse     `protobuf:"bytes,4,opt,name=status_response,json=statusResponse" json:"status_response,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+// For getting the progress of the raft log of a peer, the store coordinating
+// a quorum read asks a majority of the peers of the region for it.
+type RaftLogRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RaftCmdResponse) Reset()         { *m = RaftCmdResponse{} }
-func (m *RaftCmdResponse) String() string { return proto.CompactTextString(m) }
-func (*RaftCmdResponse) ProtoMessage()    {}
-func (*RaftCmdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_cmdpb_67f5c556ea657888, []int{30}
+func (m *RaftLogRequest) Reset()         { *m = RaftLogRequest{} }
+func (m *RaftLogRequest) String() string { return proto.CompactTextString(m) }
+func (*RaftLogRequest) ProtoMessage()    {}
+func (*RaftLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{35}
 }
-func (m *RaftCmdResponse) XXX_Unmarshal(b []byte) error {
+func (m *RaftLogRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RaftCmdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RaftLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RaftCmdResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RaftLogRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1860,441 +2053,620 @@ func (m *RaftCmdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (dst *RaftCmdResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RaftCmdResponse.Merge(dst, src)
+func (dst *RaftLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftLogRequest.Merge(dst, src)
 }
-func (m *RaftCmdResponse) XXX_Size() int {
+func (m *RaftLogRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RaftCmdResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RaftCmdResponse.DiscardUnknown(m)
+func (m *RaftLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftLogRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RaftCmdResponse proto.InternalMessageInfo
+var xxx_messageInfo_RaftLogRequest proto.InternalMessageInfo
 
-func (m *RaftCmdResponse) GetHeader() *RaftResponseHeader {
+type RaftLogResponse struct {
+	Region *metapb.Region `protobuf:"bytes,1,opt,name=region" json:"region,omitempty"`
+	// The index and term of the last entry persisted in the log of the peer.
+	LastIndex            uint64   `protobuf:"varint,2,opt,name=last_index,json=lastIndex,proto3" json:"last_index,omitempty"`
+	LastTerm             uint64   `protobuf:"varint,3,opt,name=last_term,json=lastTerm,proto3" json:"last_term,omitempty"`
+	CommitIndex          uint64   `protobuf:"varint,4,opt,name=commit_index,json=commitIndex,proto3" json:"commit_index,omitempty"`
+	AppliedIndex         uint64   `protobuf:"varint,5,opt,name=applied_index,json=appliedIndex,proto3" json:"applied_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RaftLogResponse) Reset()         { *m = RaftLogResponse{} }
+func (m *RaftLogResponse) String() string { return proto.CompactTextString(m) }
+func (*RaftLogResponse) ProtoMessage()    {}
+func (*RaftLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{36}
+}
+func (m *RaftLogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RaftLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RaftLogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RaftLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftLogResponse.Merge(dst, src)
+}
+func (m *RaftLogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RaftLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RaftLogResponse proto.InternalMessageInfo
+
+func (m *RaftLogResponse) GetRegion() *metapb.Region {
 	if m != nil {
-		return m.Header
+		return m.Region
 	}
 	return nil
 }
 
-func (m *RaftCmdResponse) GetResponses() []*Response {
+func (m *RaftLogResponse) GetLastIndex() uint64 {
 	if m != nil {
-		return m.Responses
+		return m.LastIndex
 	}
-	return nil
+	return 0
 }
 
-func (m *RaftCmdResponse) GetAdminResponse() *AdminResponse {
+func (m *RaftLogResponse) GetLastTerm() uint64 {
 	if m != nil {
-		return m.AdminResponse
+		return m.LastTerm
 	}
-	return nil
+	return 0
 }
 
-func (m *RaftCmdResponse) GetStatusResponse() *StatusResponse {
+func (m *RaftLogResponse) GetCommitIndex() uint64 {
 	if m != nil {
-		return m.StatusResponse
+		return m.CommitIndex
 	}
-	return nil
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*GetRequest)(nil), "raft_cmdpb.GetRequest")
-	proto.RegisterType((*GetResponse)(nil), "raft_cmdpb.GetResponse")
-	proto.RegisterType((*PutRequest)(nil), "raft_cmdpb.PutRequest")
-	proto.RegisterType((*PutResponse)(nil), "raft_cmdpb.PutResponse")
-	proto.RegisterType((*DeleteRequest)(nil), "raft_cmdpb.DeleteRequest")
-	proto.RegisterType((*DeleteResponse)(nil), "raft_cmdpb.DeleteResponse")
-	proto.RegisterType((*SnapRequest)(nil), "raft_cmdpb.SnapRequest")
-	proto.RegisterType((*SnapResponse)(nil), "raft_cmdpb.SnapResponse")
-	proto.RegisterType((*Request)(nil), "raft_cmdpb.Request")
-	proto.RegisterType((*Response)(nil), "raft_cmdpb.Response")
-	proto.RegisterType((*ChangePeerRequest)(nil), "raft_cmdpb.ChangePeerRequest")
-	proto.RegisterType((*ChangePeerResponse)(nil), "raft_cmdpb.ChangePeerResponse")
-	proto.RegisterType((*SplitRequest)(nil), "raft_cmdpb.SplitRequest")
-	proto.RegisterType((*BatchSplitRequest)(nil), "raft_cmdpb.BatchSplitRequest")
-	proto.RegisterType((*BatchSplitResponse)(nil), "raft_cmdpb.BatchSplitResponse")
-	proto.RegisterType((*CompactLogRequest)(nil), "raft_cmdpb.CompactLogRequest")
-	proto.RegisterType((*CompactLogResponse)(nil), "raft_cmdpb.CompactLogResponse")
-	proto.RegisterType((*TransferLeaderRequest)(nil), "raft_cmdpb.TransferLeaderRequest")
-	proto.RegisterType((*TransferLeaderResponse)(nil), "raft_cmdpb.TransferLeaderResponse")
-	proto.RegisterType((*AdminRequest)(nil), "raft_cmdpb.AdminRequest")
-	proto.RegisterType((*AdminResponse)(nil), "raft_cmdpb.AdminResponse")
-	proto.RegisterType((*RegionLeaderRequest)(nil), "raft_cmdpb.RegionLeaderRequest")
-	proto.RegisterType((*RegionLeaderResponse)(nil), "raft_cmdpb.RegionLeaderResponse")
-	proto.RegisterType((*RegionDetailRequest)(nil), "raft_cmdpb.RegionDetailRequest")
-	proto.RegisterType((*RegionDetailResponse)(nil), "raft_cmdpb.RegionDetailResponse")
-	proto.RegisterType((*StatusRequest)(nil), "raft_cmdpb.StatusRequest")
-	proto.RegisterType((*StatusResponse)(nil), "raft_cmdpb.StatusResponse")
-	proto.RegisterType((*RaftRequestHeader)(nil), "raft_cmdpb.RaftRequestHeader")
-	proto.RegisterType((*RaftResponseHeader)(nil), "raft_cmdpb.RaftResponseHeader")
-	proto.RegisterType((*RaftCmdRequest)(nil), "raft_cmdpb.RaftCmdRequest")
-	proto.RegisterType((*RaftCmdResponse)(nil), "raft_cmdpb.RaftCmdResponse")
-	proto.RegisterEnum("raft_cmdpb.CmdType", CmdType_name, CmdType_value)
-	proto.RegisterEnum("raft_cmdpb.AdminCmdType", AdminCmdType_name, AdminCmdType_value)
-	proto.RegisterEnum("raft_cmdpb.StatusCmdType", StatusCmdType_name, StatusCmdType_value)
-}
-func (m *GetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *RaftLogResponse) GetAppliedIndex() uint64 {
+	if m != nil {
+		return m.AppliedIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Cf) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Cf)))
-		i += copy(dAtA[i:], m.Cf)
-	}
-	if len(m.Key) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type StatusRequest struct {
+	CmdType              StatusCmdType        `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.StatusCmdType" json:"cmd_type,omitempty"`
+	RegionLeader         *RegionLeaderRequest `protobuf:"bytes,2,opt,name=region_leader,json=regionLeader" json:"region_leader,omitempty"`
+	RegionDetail         *RegionDetailRequest `protobuf:"bytes,3,opt,name=region_detail,json=regionDetail" json:"region_detail,omitempty"`
+	RaftLog              *RaftLogRequest      `protobuf:"bytes,4,opt,name=raft_log,json=raftLog" json:"raft_log,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *GetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *StatusRequest) Reset()         { *m = StatusRequest{} }
+func (m *StatusRequest) String() string { return proto.CompactTextString(m) }
+func (*StatusRequest) ProtoMessage()    {}
+func (*StatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{37}
 }
-
-func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *StatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *StatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusRequest.Merge(dst, src)
+}
+func (m *StatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusRequest.DiscardUnknown(m)
 }
 
-func (m *PutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StatusRequest proto.InternalMessageInfo
+
+func (m *StatusRequest) GetCmdType() StatusCmdType {
+	if m != nil {
+		return m.CmdType
 	}
-	return dAtA[:n], nil
+	return StatusCmdType_InvalidStatus
 }
 
-func (m *PutRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Cf) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Cf)))
-		i += copy(dAtA[i:], m.Cf)
-	}
-	if len(m.Key) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
-	}
-	if len(m.Value) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *StatusRequest) GetRegionLeader() *RegionLeaderRequest {
+	if m != nil {
+		return m.RegionLeader
 	}
-	return i, nil
+	return nil
 }
 
-func (m *PutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *StatusRequest) GetRegionDetail() *RegionDetailRequest {
+	if m != nil {
+		return m.RegionDetail
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PutResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *StatusRequest) GetRaftLog() *RaftLogRequest {
+	if m != nil {
+		return m.RaftLog
 	}
-	return i, nil
+	return nil
 }
 
-func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type StatusResponse struct {
+	CmdType              StatusCmdType         `protobuf:"varint,1,opt,name=cmd_type,json=cmdType,proto3,enum=raft_cmdpb.StatusCmdType" json:"cmd_type,omitempty"`
+	RegionLeader         *RegionLeaderResponse `protobuf:"bytes,2,opt,name=region_leader,json=regionLeader" json:"region_leader,omitempty"`
+	RegionDetail         *RegionDetailResponse `protobuf:"bytes,3,opt,name=region_detail,json=regionDetail" json:"region_detail,omitempty"`
+	RaftLog              *RaftLogResponse      `protobuf:"bytes,4,opt,name=raft_log,json=raftLog" json:"raft_log,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Cf) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Cf)))
-		i += copy(dAtA[i:], m.Cf)
-	}
-	if len(m.Key) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *StatusResponse) Reset()         { *m = StatusResponse{} }
+func (m *StatusResponse) String() string { return proto.CompactTextString(m) }
+func (*StatusResponse) ProtoMessage()    {}
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{38}
+}
+func (m *StatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (dst *StatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusResponse.Merge(dst, src)
+}
+func (m *StatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusResponse.DiscardUnknown(m)
 }
 
-func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StatusResponse proto.InternalMessageInfo
+
+func (m *StatusResponse) GetCmdType() StatusCmdType {
+	if m != nil {
+		return m.CmdType
 	}
-	return dAtA[:n], nil
+	return StatusCmdType_InvalidStatus
 }
 
-func (m *DeleteResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *StatusResponse) GetRegionLeader() *RegionLeaderResponse {
+	if m != nil {
+		return m.RegionLeader
 	}
-	return i, nil
+	return nil
 }
 
-func (m *SnapRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *StatusResponse) GetRegionDetail() *RegionDetailResponse {
+	if m != nil {
+		return m.RegionDetail
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SnapRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (m *StatusResponse) GetRaftLog() *RaftLogResponse {
+	if m != nil {
+		return m.RaftLog
 	}
-	return i, nil
+	return nil
 }
 
-func (m *SnapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type RaftRequestHeader struct {
+	RegionId uint64       `protobuf:"varint,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	Peer     *metapb.Peer `protobuf:"bytes,2,opt,name=peer" json:"peer,omitempty"`
+	// true for read linearization
+	ReadQuorum bool `protobuf:"varint,3,opt,name=read_quorum,json=readQuorum,proto3" json:"read_quorum,omitempty"`
+	// 16 bytes, to distinguish request.
+	Uuid        []byte              `protobuf:"bytes,4,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	RegionEpoch *metapb.RegionEpoch `protobuf:"bytes,5,opt,name=region_epoch,json=regionEpoch" json:"region_epoch,omitempty"`
+	Term        uint64              `protobuf:"varint,6,opt,name=term,proto3" json:"term,omitempty"`
+	SyncLog     bool                `protobuf:"varint,7,opt,name=sync_log,json=syncLog,proto3" json:"sync_log,omitempty"`
+	ReplicaRead bool                `protobuf:"varint,8,opt,name=replica_read,json=replicaRead,proto3" json:"replica_read,omitempty"`
+	// Read requests can be responsed directly after the Raft applys to `applied_index`.
+	AppliedIndex uint64 `protobuf:"varint,9,opt,name=applied_index,json=appliedIndex,proto3" json:"applied_index,omitempty"`
+	// Unix time in milliseconds after which a read request is dropped instead
+	// of executed, 0 means no deadline.
+	DeadlineMs           uint64   `protobuf:"varint,10,opt,name=deadline_ms,json=deadlineMs,proto3" json:"deadline_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SnapResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Region != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Region.Size()))
-		n1, err := m.Region.MarshalTo(dAtA[i:])
+func (m *RaftRequestHeader) Reset()         { *m = RaftRequestHeader{} }
+func (m *RaftRequestHeader) String() string { return proto.CompactTextString(m) }
+func (*RaftRequestHeader) ProtoMessage()    {}
+func (*RaftRequestHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{39}
+}
+func (m *RaftRequestHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RaftRequestHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RaftRequestHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n1
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+}
+func (dst *RaftRequestHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftRequestHeader.Merge(dst, src)
+}
+func (m *RaftRequestHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *RaftRequestHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftRequestHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RaftRequestHeader proto.InternalMessageInfo
+
+func (m *RaftRequestHeader) GetRegionId() uint64 {
+	if m != nil {
+		return m.RegionId
 	}
-	return i, nil
+	return 0
 }
 
-func (m *Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *RaftRequestHeader) GetPeer() *metapb.Peer {
+	if m != nil {
+		return m.Peer
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Request) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.CmdType != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CmdType))
+func (m *RaftRequestHeader) GetReadQuorum() bool {
+	if m != nil {
+		return m.ReadQuorum
 	}
-	if m.Get != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Get.Size()))
-		n2, err := m.Get.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+	return false
+}
+
+func (m *RaftRequestHeader) GetUuid() []byte {
+	if m != nil {
+		return m.Uuid
 	}
-	if m.Put != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Put.Size()))
-		n3, err := m.Put.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+	return nil
+}
+
+func (m *RaftRequestHeader) GetRegionEpoch() *metapb.RegionEpoch {
+	if m != nil {
+		return m.RegionEpoch
 	}
-	if m.Delete != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Delete.Size()))
-		n4, err := m.Delete.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+	return nil
+}
+
+func (m *RaftRequestHeader) GetTerm() uint64 {
+	if m != nil {
+		return m.Term
 	}
-	if m.Snap != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Snap.Size()))
-		n5, err := m.Snap.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
+	return 0
+}
+
+func (m *RaftRequestHeader) GetSyncLog() bool {
+	if m != nil {
+		return m.SyncLog
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return false
+}
+
+func (m *RaftRequestHeader) GetReplicaRead() bool {
+	if m != nil {
+		return m.ReplicaRead
 	}
-	return i, nil
+	return false
 }
 
-func (m *Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *RaftRequestHeader) GetAppliedIndex() uint64 {
+	if m != nil {
+		return m.AppliedIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Response) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.CmdType != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CmdType))
+func (m *RaftRequestHeader) GetDeadlineMs() uint64 {
+	if m != nil {
+		return m.DeadlineMs
 	}
-	if m.Get != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Get.Size()))
-		n6, err := m.Get.MarshalTo(dAtA[i:])
+	return 0
+}
+
+type RaftResponseHeader struct {
+	Error                *errorpb.Error `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	Uuid                 []byte         `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	CurrentTerm          uint64         `protobuf:"varint,3,opt,name=current_term,json=currentTerm,proto3" json:"current_term,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RaftResponseHeader) Reset()         { *m = RaftResponseHeader{} }
+func (m *RaftResponseHeader) String() string { return proto.CompactTextString(m) }
+func (*RaftResponseHeader) ProtoMessage()    {}
+func (*RaftResponseHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{40}
+}
+func (m *RaftResponseHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RaftResponseHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RaftResponseHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n6
+		return b[:n], nil
 	}
-	if m.Put != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Put.Size()))
-		n7, err := m.Put.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
+}
+func (dst *RaftResponseHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftResponseHeader.Merge(dst, src)
+}
+func (m *RaftResponseHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *RaftResponseHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftResponseHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RaftResponseHeader proto.InternalMessageInfo
+
+func (m *RaftResponseHeader) GetError() *errorpb.Error {
+	if m != nil {
+		return m.Error
 	}
-	if m.Delete != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Delete.Size()))
-		n8, err := m.Delete.MarshalTo(dAtA[i:])
+	return nil
+}
+
+func (m *RaftResponseHeader) GetUuid() []byte {
+	if m != nil {
+		return m.Uuid
+	}
+	return nil
+}
+
+func (m *RaftResponseHeader) GetCurrentTerm() uint64 {
+	if m != nil {
+		return m.CurrentTerm
+	}
+	return 0
+}
+
+type RaftCmdRequest struct {
+	Header *RaftRequestHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	// We can't enclose normal requests and administrator request
+	// at same time.
+	Requests             []*Request     `protobuf:"bytes,2,rep,name=requests" json:"requests,omitempty"`
+	AdminRequest         *AdminRequest  `protobuf:"bytes,3,opt,name=admin_request,json=adminRequest" json:"admin_request,omitempty"`
+	StatusRequest        *StatusRequest `protobuf:"bytes,4,opt,name=status_request,json=statusRequest" json:"status_request,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RaftCmdRequest) Reset()         { *m = RaftCmdRequest{} }
+func (m *RaftCmdRequest) String() string { return proto.CompactTextString(m) }
+func (*RaftCmdRequest) ProtoMessage()    {}
+func (*RaftCmdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{41}
+}
+func (m *RaftCmdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RaftCmdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RaftCmdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n8
+		return b[:n], nil
 	}
-	if m.Snap != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Snap.Size()))
-		n9, err := m.Snap.MarshalTo(dAtA[i:])
+}
+func (dst *RaftCmdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftCmdRequest.Merge(dst, src)
+}
+func (m *RaftCmdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RaftCmdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftCmdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RaftCmdRequest proto.InternalMessageInfo
+
+func (m *RaftCmdRequest) GetHeader() *RaftRequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *RaftCmdRequest) GetRequests() []*Request {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *RaftCmdRequest) GetAdminRequest() *AdminRequest {
+	if m != nil {
+		return m.AdminRequest
+	}
+	return nil
+}
+
+func (m *RaftCmdRequest) GetStatusRequest() *StatusRequest {
+	if m != nil {
+		return m.StatusRequest
+	}
+	return nil
+}
+
+type RaftCmdResponse struct {
+	Header               *RaftResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	Responses            []*Response         `protobuf:"bytes,2,rep,name=responses" json:"responses,omitempty"`
+	AdminResponse        *AdminResponse      `protobuf:"bytes,3,opt,name=admin_response,json=adminResponse" json:"admin_response,omitempty"`
+	StatusResponse       *StatusResponse     `protobuf:"bytes,4,opt,name=status_response,json=statusResponse" json:"status_response,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *RaftCmdResponse) Reset()         { *m = RaftCmdResponse{} }
+func (m *RaftCmdResponse) String() string { return proto.CompactTextString(m) }
+func (*RaftCmdResponse) ProtoMessage()    {}
+func (*RaftCmdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_cmdpb_e2be47922dfa613a, []int{42}
+}
+func (m *RaftCmdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RaftCmdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RaftCmdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n9
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+}
+func (dst *RaftCmdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftCmdResponse.Merge(dst, src)
+}
+func (m *RaftCmdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RaftCmdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftCmdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RaftCmdResponse proto.InternalMessageInfo
+
+func (m *RaftCmdResponse) GetHeader() *RaftResponseHeader {
+	if m != nil {
+		return m.Header
 	}
-	return i, nil
+	return nil
 }
 
-func (m *ChangePeerRequest) Marshal() (dAtA []byte, err error) {
+func (m *RaftCmdResponse) GetResponses() []*Response {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *RaftCmdResponse) GetAdminResponse() *AdminResponse {
+	if m != nil {
+		return m.AdminResponse
+	}
+	return nil
+}
+
+func (m *RaftCmdResponse) GetStatusResponse() *StatusResponse {
+	if m != nil {
+		return m.StatusResponse
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetRequest)(nil), "raft_cmdpb.GetRequest")
+	proto.RegisterType((*GetResponse)(nil), "raft_cmdpb.GetResponse")
+	proto.RegisterType((*PutRequest)(nil), "raft_cmdpb.PutRequest")
+	proto.RegisterType((*PutResponse)(nil), "raft_cmdpb.PutResponse")
+	proto.RegisterType((*DeleteRequest)(nil), "raft_cmdpb.DeleteRequest")
+	proto.RegisterType((*DeleteResponse)(nil), "raft_cmdpb.DeleteResponse")
+	proto.RegisterType((*SnapRequest)(nil), "raft_cmdpb.SnapRequest")
+	proto.RegisterType((*SnapResponse)(nil), "raft_cmdpb.SnapResponse")
+	proto.RegisterType((*Request)(nil), "raft_cmdpb.Request")
+	proto.RegisterType((*Response)(nil), "raft_cmdpb.Response")
+	proto.RegisterType((*ChangePeerRequest)(nil), "raft_cmdpb.ChangePeerRequest")
+	proto.RegisterType((*ChangePeerResponse)(nil), "raft_cmdpb.ChangePeerResponse")
+	proto.RegisterType((*SplitRequest)(nil), "raft_cmdpb.SplitRequest")
+	proto.RegisterType((*BatchSplitRequest)(nil), "raft_cmdpb.BatchSplitRequest")
+	proto.RegisterType((*BatchSplitResponse)(nil), "raft_cmdpb.BatchSplitResponse")
+	proto.RegisterType((*CompactLogRequest)(nil), "raft_cmdpb.CompactLogRequest")
+	proto.RegisterType((*CompactLogResponse)(nil), "raft_cmdpb.CompactLogResponse")
+	proto.RegisterType((*TransferLeaderRequest)(nil), "raft_cmdpb.TransferLeaderRequest")
+	proto.RegisterType((*TransferLeaderResponse)(nil), "raft_cmdpb.TransferLeaderResponse")
+	proto.RegisterType((*DeletePrefixRequest)(nil), "raft_cmdpb.DeletePrefixRequest")
+	proto.RegisterType((*DeletePrefixResponse)(nil), "raft_cmdpb.DeletePrefixResponse")
+	proto.RegisterType((*DeleteRangeRequest)(nil), "raft_cmdpb.DeleteRangeRequest")
+	proto.RegisterType((*DeleteRangeResponse)(nil), "raft_cmdpb.DeleteRangeResponse")
+	proto.RegisterType((*PrepareMergeRequest)(nil), "raft_cmdpb.PrepareMergeRequest")
+	proto.RegisterType((*PrepareMergeResponse)(nil), "raft_cmdpb.PrepareMergeResponse")
+	proto.RegisterType((*CommitMergeRequest)(nil), "raft_cmdpb.CommitMergeRequest")
+	proto.RegisterType((*CommitMergeResponse)(nil), "raft_cmdpb.CommitMergeResponse")
+	proto.RegisterType((*RollbackMergeRequest)(nil), "raft_cmdpb.RollbackMergeRequest")
+	proto.RegisterType((*RollbackMergeResponse)(nil), "raft_cmdpb.RollbackMergeResponse")
+	proto.RegisterType((*AdminRequest)(nil), "raft_cmdpb.AdminRequest")
+	proto.RegisterType((*AdminResponse)(nil), "raft_cmdpb.AdminResponse")
+	proto.RegisterType((*RegionLeaderRequest)(nil), "raft_cmdpb.RegionLeaderRequest")
+	proto.RegisterType((*RegionLeaderResponse)(nil), "raft_cmdpb.RegionLeaderResponse")
+	proto.RegisterType((*RegionDetailRequest)(nil), "raft_cmdpb.RegionDetailRequest")
+	proto.RegisterType((*RegionDetailResponse)(nil), "raft_cmdpb.RegionDetailResponse")
+	proto.RegisterType((*RaftLogRequest)(nil), "raft_cmdpb.RaftLogRequest")
+	proto.RegisterType((*RaftLogResponse)(nil), "raft_cmdpb.RaftLogResponse")
+	proto.RegisterType((*StatusRequest)(nil), "raft_cmdpb.StatusRequest")
+	proto.RegisterType((*StatusResponse)(nil), "raft_cmdpb.StatusResponse")
+	proto.RegisterType((*RaftRequestHeader)(nil), "raft_cmdpb.RaftRequestHeader")
+	proto.RegisterType((*RaftResponseHeader)(nil), "raft_cmdpb.RaftResponseHeader")
+	proto.RegisterType((*RaftCmdRequest)(nil), "raft_cmdpb.RaftCmdRequest")
+	proto.RegisterType((*RaftCmdResponse)(nil), "raft_cmdpb.RaftCmdResponse")
+	proto.RegisterEnum("raft_cmdpb.CmdType", CmdType_name, CmdType_value)
+	proto.RegisterEnum("raft_cmdpb.AdminCmdType", AdminCmdType_name, AdminCmdType_value)
+	proto.RegisterEnum("raft_cmdpb.StatusCmdType", StatusCmdType_name, StatusCmdType_value)
+}
+func (m *GetRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2304,25 +2676,22 @@ func (m *ChangePeerRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChangePeerRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.ChangeType != 0 {
-		dAtA[i] = 0x8
+	if len(m.Cf) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ChangeType))
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Cf)))
+		i += copy(dAtA[i:], m.Cf)
 	}
-	if m.Peer != nil {
+	if len(m.Key) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Peer.Size()))
-		n10, err := m.Peer.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2330,7 +2699,7 @@ func (m *ChangePeerRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ChangePeerResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2340,20 +2709,16 @@ func (m *ChangePeerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChangePeerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Region != nil {
+	if len(m.Value) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Region.Size()))
-		n11, err := m.Region.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2361,7 +2726,7 @@ func (m *ChangePeerResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *SplitRequest) Marshal() (dAtA []byte, err error) {
+func (m *PutRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2371,38 +2736,28 @@ func (m *SplitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SplitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.SplitKey) > 0 {
+	if len(m.Cf) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.SplitKey)))
-		i += copy(dAtA[i:], m.SplitKey)
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Cf)))
+		i += copy(dAtA[i:], m.Cf)
 	}
-	if m.NewRegionId != 0 {
-		dAtA[i] = 0x10
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.NewRegionId))
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
 	}
-	if len(m.NewPeerIds) > 0 {
-		dAtA13 := make([]byte, len(m.NewPeerIds)*10)
-		var j12 int
-		for _, num := range m.NewPeerIds {
-			for num >= 1<<7 {
-				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j12++
-			}
-			dAtA13[j12] = uint8(num)
-			j12++
-		}
+	if len(m.Value) > 0 {
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(j12))
-		i += copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2410,7 +2765,7 @@ func (m *SplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *BatchSplitRequest) Marshal() (dAtA []byte, err error) {
+func (m *PutResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2420,30 +2775,18 @@ func (m *BatchSplitRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BatchSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Requests) > 0 {
-		for _, msg := range m.Requests {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRaftCmdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *BatchSplitResponse) Marshal() (dAtA []byte, err error) {
+func (m *DeleteRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2453,22 +2796,22 @@ func (m *BatchSplitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BatchSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Regions) > 0 {
-		for _, msg := range m.Regions {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRaftCmdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if len(m.Cf) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Cf)))
+		i += copy(dAtA[i:], m.Cf)
+	}
+	if len(m.Key) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2476,7 +2819,7 @@ func (m *BatchSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *CompactLogRequest) Marshal() (dAtA []byte, err error) {
+func (m *DeleteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2486,28 +2829,18 @@ func (m *CompactLogRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CompactLogRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.CompactIndex != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CompactIndex))
-	}
-	if m.CompactTerm != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CompactTerm))
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *CompactLogResponse) Marshal() (dAtA []byte, err error) {
+func (m *SnapRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2517,7 +2850,7 @@ func (m *CompactLogResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CompactLogResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SnapRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2528,7 +2861,7 @@ func (m *CompactLogResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *TransferLeaderRequest) Marshal() (dAtA []byte, err error) {
+func (m *SnapResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2538,49 +2871,28 @@ func (m *TransferLeaderRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TransferLeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SnapResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Peer != nil {
+	if m.Region != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Peer.Size()))
-		n14, err := m.Peer.MarshalTo(dAtA[i:])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Region.Size()))
+		n1, err := m.Region.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
-}
-
-func (m *TransferLeaderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+		i += n1
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TransferLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *AdminRequest) Marshal() (dAtA []byte, err error) {
+func (m *Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2590,7 +2902,7 @@ func (m *AdminRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AdminRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2600,45 +2912,45 @@ func (m *AdminRequest) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CmdType))
 	}
-	if m.ChangePeer != nil {
+	if m.Get != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ChangePeer.Size()))
-		n15, err := m.ChangePeer.MarshalTo(dAtA[i:])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Get.Size()))
+		n2, err := m.Get.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n2
 	}
-	if m.CompactLog != nil {
+	if m.Put != nil {
 		dAtA[i] = 0x22
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CompactLog.Size()))
-		n16, err := m.CompactLog.MarshalTo(dAtA[i:])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Put.Size()))
+		n3, err := m.Put.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n3
 	}
-	if m.TransferLeader != nil {
+	if m.Delete != nil {
 		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.TransferLeader.Size()))
-		n17, err := m.TransferLeader.MarshalTo(dAtA[i:])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Delete.Size()))
+		n4, err := m.Delete.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n4
 	}
-	if m.Splits != nil {
-		dAtA[i] = 0x52
+	if m.Snap != nil {
+		dAtA[i] = 0x32
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Splits.Size()))
-		n18, err := m.Splits.MarshalTo(dAtA[i:])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Snap.Size()))
+		n5, err := m.Snap.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n5
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2646,7 +2958,7 @@ func (m *AdminRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *AdminResponse) Marshal() (dAtA []byte, err error) {
+func (m *Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2656,7 +2968,7 @@ func (m *AdminResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AdminResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Response) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2666,45 +2978,45 @@ func (m *AdminResponse) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CmdType))
 	}
-	if m.ChangePeer != nil {
+	if m.Get != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ChangePeer.Size()))
-		n19, err := m.ChangePeer.MarshalTo(dAtA[i:])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Get.Size()))
+		n6, err := m.Get.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n6
 	}
-	if m.CompactLog != nil {
+	if m.Put != nil {
 		dAtA[i] = 0x22
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CompactLog.Size()))
-		n20, err := m.CompactLog.MarshalTo(dAtA[i:])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Put.Size()))
+		n7, err := m.Put.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n7
 	}
-	if m.TransferLeader != nil {
+	if m.Delete != nil {
 		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.TransferLeader.Size()))
-		n21, err := m.TransferLeader.MarshalTo(dAtA[i:])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Delete.Size()))
+		n8, err := m.Delete.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n8
 	}
-	if m.Splits != nil {
-		dAtA[i] = 0x52
+	if m.Snap != nil {
+		dAtA[i] = 0x32
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Splits.Size()))
-		n22, err := m.Splits.MarshalTo(dAtA[i:])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Snap.Size()))
+		n9, err := m.Snap.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n9
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2712,7 +3024,7 @@ func (m *AdminResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RegionLeaderRequest) Marshal() (dAtA []byte, err error) {
+func (m *ChangePeerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2722,18 +3034,43 @@ func (m *RegionLeaderRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegionLeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChangePeerRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.ChangeType != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ChangeType))
+	}
+	if m.Peer != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Peer.Size()))
+		n10, err := m.Peer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.Repair {
+		dAtA[i] = 0x18
+		i++
+		if m.Repair {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *RegionLeaderResponse) Marshal() (dAtA []byte, err error) {
+func (m *ChangePeerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2743,20 +3080,20 @@ func (m *RegionLeaderResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegionLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChangePeerResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Leader != nil {
+	if m.Region != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Leader.Size()))
-		n23, err := m.Leader.MarshalTo(dAtA[i:])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Region.Size()))
+		n11, err := m.Region.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n11
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2764,7 +3101,7 @@ func (m *RegionLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RegionDetailRequest) Marshal() (dAtA []byte, err error) {
+func (m *SplitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2774,51 +3111,38 @@ func (m *RegionDetailRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegionDetailRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.SplitKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.SplitKey)))
+		i += copy(dAtA[i:], m.SplitKey)
 	}
-	return i, nil
-}
-
-func (m *RegionDetailResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RegionDetailResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Region != nil {
-		dAtA[i] = 0xa
+	if m.NewRegionId != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Region.Size()))
-		n24, err := m.Region.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n24
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.NewRegionId))
 	}
-	if m.Leader != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Leader.Size()))
-		n25, err := m.Leader.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.NewPeerIds) > 0 {
+		dAtA13 := make([]byte, len(m.NewPeerIds)*10)
+		var j12 int
+		for _, num := range m.NewPeerIds {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
 		}
-		i += n25
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(j12))
+		i += copy(dAtA[i:], dAtA13[:j12])
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2826,7 +3150,7 @@ func (m *RegionDetailResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *StatusRequest) Marshal() (dAtA []byte, err error) {
+func (m *BatchSplitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2836,35 +3160,32 @@ func (m *StatusRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StatusRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *BatchSplitRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.CmdType != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CmdType))
-	}
-	if m.RegionLeader != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RegionLeader.Size()))
-		n26, err := m.RegionLeader.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.Requests) > 0 {
+		for _, msg := range m.Requests {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRaftCmdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i += n26
 	}
-	if m.RegionDetail != nil {
-		dAtA[i] = 0x1a
+	if m.LeftDerive {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RegionDetail.Size()))
-		n27, err := m.RegionDetail.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		if m.LeftDerive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i += n27
+		i++
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2872,7 +3193,7 @@ func (m *StatusRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *StatusResponse) Marshal() (dAtA []byte, err error) {
+func (m *BatchSplitResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2882,35 +3203,22 @@ func (m *StatusResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StatusResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *BatchSplitResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.CmdType != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CmdType))
-	}
-	if m.RegionLeader != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RegionLeader.Size()))
-		n28, err := m.RegionLeader.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n28
-	}
-	if m.RegionDetail != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RegionDetail.Size()))
-		n29, err := m.RegionDetail.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.Regions) > 0 {
+		for _, msg := range m.Regions {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRaftCmdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i += n29
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2918,7 +3226,7 @@ func (m *StatusResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RaftRequestHeader) Marshal() (dAtA []byte, err error) {
+func (m *CompactLogRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2928,81 +3236,20 @@ func (m *RaftRequestHeader) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RaftRequestHeader) MarshalTo(dAtA []byte) (int, error) {
+func (m *CompactLogRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.RegionId != 0 {
+	if m.CompactIndex != 0 {
 		dAtA[i] = 0x8
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RegionId))
-	}
-	if m.Peer != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Peer.Size()))
-		n30, err := m.Peer.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n30
-	}
-	if m.ReadQuorum {
-		dAtA[i] = 0x18
-		i++
-		if m.ReadQuorum {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if len(m.Uuid) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Uuid)))
-		i += copy(dAtA[i:], m.Uuid)
-	}
-	if m.RegionEpoch != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RegionEpoch.Size()))
-		n31, err := m.RegionEpoch.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n31
-	}
-	if m.Term != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Term))
-	}
-	if m.SyncLog {
-		dAtA[i] = 0x38
-		i++
-		if m.SyncLog {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.ReplicaRead {
-		dAtA[i] = 0x40
-		i++
-		if m.ReplicaRead {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CompactIndex))
 	}
-	if m.AppliedIndex != 0 {
-		dAtA[i] = 0x48
+	if m.CompactTerm != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.AppliedIndex))
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CompactTerm))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -3010,7 +3257,7 @@ func (m *RaftRequestHeader) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RaftResponseHeader) Marshal() (dAtA []byte, err error) {
+func (m *CompactLogResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3020,39 +3267,18 @@ func (m *RaftResponseHeader) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RaftResponseHeader) MarshalTo(dAtA []byte) (int, error) {
+func (m *CompactLogResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Error != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Error.Size()))
-		n32, err := m.Error.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n32
-	}
-	if len(m.Uuid) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Uuid)))
-		i += copy(dAtA[i:], m.Uuid)
-	}
-	if m.CurrentTerm != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CurrentTerm))
-	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *RaftCmdRequest) Marshal() (dAtA []byte, err error) {
+func (m *TransferLeaderRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3062,52 +3288,20 @@ func (m *RaftCmdRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RaftCmdRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TransferLeaderRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
+	if m.Peer != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Header.Size()))
-		n33, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n33
-	}
-	if len(m.Requests) > 0 {
-		for _, msg := range m.Requests {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintRaftCmdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.AdminRequest != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.AdminRequest.Size()))
-		n34, err := m.AdminRequest.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n34
-	}
-	if m.StatusRequest != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.StatusRequest.Size()))
-		n35, err := m.StatusRequest.MarshalTo(dAtA[i:])
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Peer.Size()))
+		n14, err := m.Peer.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n14
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -3115,7 +3309,7 @@ func (m *RaftCmdRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *RaftCmdResponse) Marshal() (dAtA []byte, err error) {
+func (m *TransferLeaderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3125,52 +3319,37 @@ func (m *RaftCmdResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RaftCmdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TransferLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Header.Size()))
-		n36, err := m.Header.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n36
-	}
-	if len(m.Responses) > 0 {
-		for _, msg := range m.Responses {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintRaftCmdpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.AdminResponse != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.AdminResponse.Size()))
-		n37, err := m.AdminResponse.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n37
+	return i, nil
+}
+
+func (m *DeletePrefixRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.StatusResponse != nil {
-		dAtA[i] = 0x22
+	return dAtA[:n], nil
+}
+
+func (m *DeletePrefixRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prefix) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.StatusResponse.Size()))
-		n38, err := m.StatusResponse.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n38
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Prefix)))
+		i += copy(dAtA[i:], m.Prefix)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -3178,556 +3357,1050 @@ func (m *RaftCmdResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintRaftCmdpb(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DeletePrefixResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *GetRequest) Size() (n int) {
+
+func (m *DeletePrefixResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Cf)
-	if l > 0 {
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetResponse) Size() (n int) {
+func (m *DeleteRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if len(m.StartKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.StartKey)))
+		i += copy(dAtA[i:], m.StartKey)
+	}
+	if len(m.EndKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.EndKey)))
+		i += copy(dAtA[i:], m.EndKey)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *PutRequest) Size() (n int) {
+func (m *DeleteRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Cf)
-	if l > 0 {
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	return i, nil
+}
+
+func (m *PrepareMergeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *PrepareMergeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Target != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Target.Size()))
+		n15, err := m.Target.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *PutResponse) Size() (n int) {
+func (m *PrepareMergeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrepareMergeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *DeleteRequest) Size() (n int) {
+func (m *CommitMergeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitMergeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Cf)
-	if l > 0 {
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.Source != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Source.Size()))
+		n16, err := m.Source.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.Commit != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Commit))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *DeleteResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *CommitMergeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SnapRequest) Size() (n int) {
+func (m *CommitMergeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *SnapResponse) Size() (n int) {
+func (m *RollbackMergeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackMergeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Region != nil {
-		l = m.Region.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.Commit != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Commit))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Request) Size() (n int) {
+func (m *RollbackMergeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackMergeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.CmdType != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Get != nil {
-		l = m.Get.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	if m.Put != nil {
-		l = m.Put.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	if m.Delete != nil {
-		l = m.Delete.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	if m.Snap != nil {
-		l = m.Snap.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return i, nil
+}
+
+func (m *AdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Response) Size() (n int) {
+func (m *AdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.CmdType != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CmdType))
 	}
-	if m.Get != nil {
-		l = m.Get.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.ChangePeer != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ChangePeer.Size()))
+		n17, err := m.ChangePeer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
 	}
-	if m.Put != nil {
-		l = m.Put.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.CompactLog != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CompactLog.Size()))
+		n18, err := m.CompactLog.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
 	}
-	if m.Delete != nil {
-		l = m.Delete.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.TransferLeader != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.TransferLeader.Size()))
+		n19, err := m.TransferLeader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
 	}
-	if m.Snap != nil {
-		l = m.Snap.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.Splits != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Splits.Size()))
+		n20, err := m.Splits.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.DeletePrefix != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.DeletePrefix.Size()))
+		n21, err := m.DeletePrefix.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
 	}
-	return n
-}
-
-func (m *ChangePeerRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.ChangeType != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.ChangeType))
+	if m.DeleteRange != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.DeleteRange.Size()))
+		n22, err := m.DeleteRange.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
 	}
-	if m.Peer != nil {
-		l = m.Peer.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.PrepareMerge != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.PrepareMerge.Size()))
+		n23, err := m.PrepareMerge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	if m.CommitMerge != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CommitMerge.Size()))
+		n24, err := m.CommitMerge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	if m.RollbackMerge != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RollbackMerge.Size()))
+		n25, err := m.RollbackMerge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ChangePeerResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.Region != nil {
-		l = m.Region.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *AdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SplitRequest) Size() (n int) {
+func (m *AdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SplitKey)
-	if l > 0 {
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.CmdType != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CmdType))
 	}
-	if m.NewRegionId != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.NewRegionId))
+	if m.ChangePeer != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.ChangePeer.Size()))
+		n26, err := m.ChangePeer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
 	}
-	if len(m.NewPeerIds) > 0 {
-		l = 0
-		for _, e := range m.NewPeerIds {
-			l += sovRaftCmdpb(uint64(e))
+	if m.CompactLog != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CompactLog.Size()))
+		n27, err := m.CompactLog.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovRaftCmdpb(uint64(l)) + l
+		i += n27
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.TransferLeader != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.TransferLeader.Size()))
+		n28, err := m.TransferLeader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
 	}
-	return n
-}
-
-func (m *BatchSplitRequest) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.Splits != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Splits.Size()))
+		n29, err := m.Splits.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n29
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.DeletePrefix != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.DeletePrefix.Size()))
+		n30, err := m.DeletePrefix.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
 	}
-	return n
-}
-
-func (m *BatchSplitResponse) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Regions) > 0 {
-		for _, e := range m.Regions {
-			l = e.Size()
-			n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.DeleteRange != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.DeleteRange.Size()))
+		n31, err := m.DeleteRange.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n31
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.PrepareMerge != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.PrepareMerge.Size()))
+		n32, err := m.PrepareMerge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
 	}
-	return n
-}
-
-func (m *CompactLogRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.CompactIndex != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.CompactIndex))
+	if m.CommitMerge != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CommitMerge.Size()))
+		n33, err := m.CommitMerge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
 	}
-	if m.CompactTerm != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.CompactTerm))
+	if m.RollbackMerge != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RollbackMerge.Size()))
+		n34, err := m.RollbackMerge.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CompactLogResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RegionLeaderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TransferLeaderRequest) Size() (n int) {
+func (m *RegionLeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Peer != nil {
-		l = m.Peer.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *TransferLeaderResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RegionLeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AdminRequest) Size() (n int) {
+func (m *RegionLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.CmdType != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
-	}
-	if m.ChangePeer != nil {
-		l = m.ChangePeer.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	if m.CompactLog != nil {
-		l = m.CompactLog.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	if m.TransferLeader != nil {
-		l = m.TransferLeader.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	if m.Splits != nil {
-		l = m.Splits.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.Leader != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Leader.Size()))
+		n35, err := m.Leader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AdminResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.CmdType != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
-	}
-	if m.ChangePeer != nil {
-		l = m.ChangePeer.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	if m.CompactLog != nil {
-		l = m.CompactLog.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	if m.TransferLeader != nil {
-		l = m.TransferLeader.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	if m.Splits != nil {
-		l = m.Splits.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RegionDetailRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RegionLeaderRequest) Size() (n int) {
+func (m *RegionDetailRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RegionLeaderResponse) Size() (n int) {
+func (m *RegionDetailResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegionDetailResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
+	if m.Region != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Region.Size()))
+		n36, err := m.Region.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
 	if m.Leader != nil {
-		l = m.Leader.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Leader.Size()))
+		n37, err := m.Leader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RegionDetailRequest) Size() (n int) {
+func (m *RaftLogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftLogRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RegionDetailResponse) Size() (n int) {
+func (m *RaftLogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftLogResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Region != nil {
-		l = m.Region.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Region.Size()))
+		n38, err := m.Region.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
 	}
-	if m.Leader != nil {
-		l = m.Leader.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if m.LastIndex != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.LastIndex))
+	}
+	if m.LastTerm != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.LastTerm))
+	}
+	if m.CommitIndex != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CommitIndex))
+	}
+	if m.AppliedIndex != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.AppliedIndex))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *StatusRequest) Size() (n int) {
+func (m *StatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.CmdType != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CmdType))
 	}
 	if m.RegionLeader != nil {
-		l = m.RegionLeader.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RegionLeader.Size()))
+		n39, err := m.RegionLeader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
 	}
 	if m.RegionDetail != nil {
-		l = m.RegionDetail.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RegionDetail.Size()))
+		n40, err := m.RegionDetail.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	if m.RaftLog != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RaftLog.Size()))
+		n41, err := m.RaftLog.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *StatusResponse) Size() (n int) {
-	var l int
-	_ = l
-	if m.CmdType != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
+func (m *StatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.CmdType != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CmdType))
 	}
 	if m.RegionLeader != nil {
-		l = m.RegionLeader.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RegionLeader.Size()))
+		n42, err := m.RegionLeader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n42
 	}
 	if m.RegionDetail != nil {
-		l = m.RegionDetail.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RegionDetail.Size()))
+		n43, err := m.RegionDetail.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n43
+	}
+	if m.RaftLog != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RaftLog.Size()))
+		n44, err := m.RaftLog.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n44
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RaftRequestHeader) Size() (n int) {
+func (m *RaftRequestHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftRequestHeader) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.RegionId != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.RegionId))
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RegionId))
 	}
 	if m.Peer != nil {
-		l = m.Peer.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Peer.Size()))
+		n45, err := m.Peer.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
 	}
 	if m.ReadQuorum {
-		n += 2
+		dAtA[i] = 0x18
+		i++
+		if m.ReadQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	l = len(m.Uuid)
-	if l > 0 {
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if len(m.Uuid) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Uuid)))
+		i += copy(dAtA[i:], m.Uuid)
 	}
 	if m.RegionEpoch != nil {
-		l = m.RegionEpoch.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.RegionEpoch.Size()))
+		n46, err := m.RegionEpoch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n46
 	}
 	if m.Term != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.Term))
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Term))
 	}
 	if m.SyncLog {
-		n += 2
+		dAtA[i] = 0x38
+		i++
+		if m.SyncLog {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.ReplicaRead {
-		n += 2
+		dAtA[i] = 0x40
+		i++
+		if m.ReplicaRead {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	if m.AppliedIndex != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.AppliedIndex))
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.AppliedIndex))
+	}
+	if m.DeadlineMs != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.DeadlineMs))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RaftResponseHeader) Size() (n int) {
+func (m *RaftResponseHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftResponseHeader) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Error != nil {
-		l = m.Error.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Error.Size()))
+		n47, err := m.Error.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n47
 	}
-	l = len(m.Uuid)
-	if l > 0 {
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+	if len(m.Uuid) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(len(m.Uuid)))
+		i += copy(dAtA[i:], m.Uuid)
 	}
 	if m.CurrentTerm != 0 {
-		n += 1 + sovRaftCmdpb(uint64(m.CurrentTerm))
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.CurrentTerm))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RaftCmdRequest) Size() (n int) {
+func (m *RaftCmdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftCmdRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Header.Size()))
+		n48, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n48
 	}
 	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovRaftCmdpb(uint64(l))
+		for _, msg := range m.Requests {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintRaftCmdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if m.AdminRequest != nil {
-		l = m.AdminRequest.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.AdminRequest.Size()))
+		n49, err := m.AdminRequest.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n49
 	}
 	if m.StatusRequest != nil {
-		l = m.StatusRequest.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.StatusRequest.Size()))
+		n50, err := m.StatusRequest.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n50
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *RaftCmdResponse) Size() (n int) {
+func (m *RaftCmdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftCmdResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.Header.Size()))
+		n51, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n51
 	}
 	if len(m.Responses) > 0 {
-		for _, e := range m.Responses {
-			l = e.Size()
-			n += 1 + l + sovRaftCmdpb(uint64(l))
+		for _, msg := range m.Responses {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintRaftCmdpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if m.AdminResponse != nil {
-		l = m.AdminResponse.Size()
-		n += 1 + l + sovRaftCmdpb(uint64(l))
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.AdminResponse.Size()))
+		n52, err := m.AdminResponse.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n52
 	}
 	if m.StatusResponse != nil {
-		l = m.StatusResponse.Size()
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRaftCmdpb(dAtA, i, uint64(m.StatusResponse.Size()))
+		n53, err := m.StatusResponse.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n53
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintRaftCmdpb(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *GetRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Cf)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
 		n += 1 + l + sovRaftCmdpb(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -3736,20 +4409,1442 @@ func (m *RaftCmdResponse) Size() (n int) {
 	return n
 }
 
-func sovRaftCmdpb(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *GetResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Cf)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PutResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Cf)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SnapRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SnapResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Region != nil {
+		l = m.Region.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Request) Size() (n int) {
+	var l int
+	_ = l
+	if m.CmdType != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
+	}
+	if m.Get != nil {
+		l = m.Get.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Put != nil {
+		l = m.Put.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Delete != nil {
+		l = m.Delete.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Snap != nil {
+		l = m.Snap.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Response) Size() (n int) {
+	var l int
+	_ = l
+	if m.CmdType != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
+	}
+	if m.Get != nil {
+		l = m.Get.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Put != nil {
+		l = m.Put.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Delete != nil {
+		l = m.Delete.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Snap != nil {
+		l = m.Snap.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangePeerRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.ChangeType != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.ChangeType))
+	}
+	if m.Peer != nil {
+		l = m.Peer.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Repair {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangePeerResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Region != nil {
+		l = m.Region.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SplitRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.SplitKey)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.NewRegionId != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.NewRegionId))
+	}
+	if len(m.NewPeerIds) > 0 {
+		l = 0
+		for _, e := range m.NewPeerIds {
+			l += sovRaftCmdpb(uint64(e))
 		}
+		n += 1 + sovRaftCmdpb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
-func sozRaftCmdpb(x uint64) (n int) {
-	return sovRaftCmdpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *BatchSplitRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovRaftCmdpb(uint64(l))
+		}
+	}
+	if m.LeftDerive {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *GetRequest) Unmarshal(dAtA []byte) error {
+
+func (m *BatchSplitResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Regions) > 0 {
+		for _, e := range m.Regions {
+			l = e.Size()
+			n += 1 + l + sovRaftCmdpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompactLogRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.CompactIndex != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.CompactIndex))
+	}
+	if m.CompactTerm != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.CompactTerm))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompactLogResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TransferLeaderRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Peer != nil {
+		l = m.Peer.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TransferLeaderResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeletePrefixRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeletePrefixResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteRangeRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	l = len(m.EndKey)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeleteRangeResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrepareMergeRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Target != nil {
+		l = m.Target.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrepareMergeResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitMergeRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Source != nil {
+		l = m.Source.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Commit != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.Commit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitMergeResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RollbackMergeRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.Commit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RollbackMergeResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AdminRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.CmdType != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
+	}
+	if m.ChangePeer != nil {
+		l = m.ChangePeer.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.CompactLog != nil {
+		l = m.CompactLog.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.TransferLeader != nil {
+		l = m.TransferLeader.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Splits != nil {
+		l = m.Splits.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.DeletePrefix != nil {
+		l = m.DeletePrefix.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.DeleteRange != nil {
+		l = m.DeleteRange.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.PrepareMerge != nil {
+		l = m.PrepareMerge.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.CommitMerge != nil {
+		l = m.CommitMerge.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.RollbackMerge != nil {
+		l = m.RollbackMerge.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AdminResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.CmdType != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
+	}
+	if m.ChangePeer != nil {
+		l = m.ChangePeer.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.CompactLog != nil {
+		l = m.CompactLog.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.TransferLeader != nil {
+		l = m.TransferLeader.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Splits != nil {
+		l = m.Splits.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.DeletePrefix != nil {
+		l = m.DeletePrefix.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.DeleteRange != nil {
+		l = m.DeleteRange.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.PrepareMerge != nil {
+		l = m.PrepareMerge.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.CommitMerge != nil {
+		l = m.CommitMerge.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.RollbackMerge != nil {
+		l = m.RollbackMerge.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RegionLeaderRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RegionLeaderResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Leader != nil {
+		l = m.Leader.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RegionDetailRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RegionDetailResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Region != nil {
+		l = m.Region.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Leader != nil {
+		l = m.Leader.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RaftLogRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RaftLogResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Region != nil {
+		l = m.Region.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.LastIndex != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.LastIndex))
+	}
+	if m.LastTerm != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.LastTerm))
+	}
+	if m.CommitIndex != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.CommitIndex))
+	}
+	if m.AppliedIndex != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.AppliedIndex))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.CmdType != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
+	}
+	if m.RegionLeader != nil {
+		l = m.RegionLeader.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.RegionDetail != nil {
+		l = m.RegionDetail.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.RaftLog != nil {
+		l = m.RaftLog.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.CmdType != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.CmdType))
+	}
+	if m.RegionLeader != nil {
+		l = m.RegionLeader.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.RegionDetail != nil {
+		l = m.RegionDetail.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.RaftLog != nil {
+		l = m.RaftLog.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RaftRequestHeader) Size() (n int) {
+	var l int
+	_ = l
+	if m.RegionId != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.RegionId))
+	}
+	if m.Peer != nil {
+		l = m.Peer.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.ReadQuorum {
+		n += 2
+	}
+	l = len(m.Uuid)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.RegionEpoch != nil {
+		l = m.RegionEpoch.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.Term != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.Term))
+	}
+	if m.SyncLog {
+		n += 2
+	}
+	if m.ReplicaRead {
+		n += 2
+	}
+	if m.AppliedIndex != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.AppliedIndex))
+	}
+	if m.DeadlineMs != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.DeadlineMs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RaftResponseHeader) Size() (n int) {
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	l = len(m.Uuid)
+	if l > 0 {
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.CurrentTerm != 0 {
+		n += 1 + sovRaftCmdpb(uint64(m.CurrentTerm))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RaftCmdRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovRaftCmdpb(uint64(l))
+		}
+	}
+	if m.AdminRequest != nil {
+		l = m.AdminRequest.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.StatusRequest != nil {
+		l = m.StatusRequest.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RaftCmdResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovRaftCmdpb(uint64(l))
+		}
+	}
+	if m.AdminResponse != nil {
+		l = m.AdminResponse.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.StatusResponse != nil {
+		l = m.StatusResponse.Size()
+		n += 1 + l + sovRaftCmdpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovRaftCmdpb(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozRaftCmdpb(x uint64) (n int) {
+	return sovRaftCmdpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Region == nil {
+				m.Region = &metapb.Region{}
+			}
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3772,17 +5867,36 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CmdType", wireType)
+			}
+			m.CmdType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CmdType |= (CmdType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cf", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -3792,26 +5906,30 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cf = string(dAtA[iNdEx:postIndex])
+			if m.Get == nil {
+				m.Get = &GetRequest{}
+			}
+			if err := m.Get.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -3821,21 +5939,89 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			if m.Put == nil {
+				m.Put = &PutRequest{}
+			}
+			if err := m.Put.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delete == nil {
+				m.Delete = &DeleteRequest{}
+			}
+			if err := m.Delete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Snap == nil {
+				m.Snap = &SnapRequest{}
+			}
+			if err := m.Snap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3860,7 +6046,7 @@ func (m *GetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetResponse) Unmarshal(dAtA []byte) error {
+func (m *Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3879,21 +6065,139 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CmdType", wireType)
+			}
+			m.CmdType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CmdType |= (CmdType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Get == nil {
+				m.Get = &GetResponse{}
+			}
+			if err := m.Get.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Put == nil {
+				m.Put = &PutResponse{}
+			}
+			if err := m.Put.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delete == nil {
+				m.Delete = &DeleteResponse{}
+			}
+			if err := m.Delete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snap", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -3903,21 +6207,23 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			if m.Snap == nil {
+				m.Snap = &SnapResponse{}
+			}
+			if err := m.Snap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3942,7 +6248,7 @@ func (m *GetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutRequest) Unmarshal(dAtA []byte) error {
+func (m *ChangePeerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3965,17 +6271,17 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangePeerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangePeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cf", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
 			}
-			var stringLen uint64
+			m.ChangeType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -3985,26 +6291,16 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.ChangeType |= (eraftpb.ConfChangeType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRaftCmdpb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Cf = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -4014,28 +6310,30 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
+			if m.Peer == nil {
+				m.Peer = &metapb.Peer{}
+			}
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repair", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -4045,23 +6343,12 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRaftCmdpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
-			iNdEx = postIndex
+			m.Repair = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -4084,7 +6371,7 @@ func (m *PutRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutResponse) Unmarshal(dAtA []byte) error {
+func (m *ChangePeerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4107,12 +6394,45 @@ func (m *PutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangePeerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangePeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Region == nil {
+				m.Region = &metapb.Region{}
+			}
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -4135,7 +6455,7 @@ func (m *PutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *SplitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4158,17 +6478,17 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SplitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cf", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -4178,26 +6498,28 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cf = string(dAtA[iNdEx:postIndex])
+			m.SplitKey = append(m.SplitKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SplitKey == nil {
+				m.SplitKey = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRegionId", wireType)
 			}
-			var byteLen int
+			m.NewRegionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -4207,74 +6529,73 @@ func (m *DeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.NewRegionId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRaftCmdpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRaftCmdpb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRaftCmdpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRaftCmdpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.NewPeerIds = append(m.NewPeerIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRaftCmdpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRaftCmdpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRaftCmdpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.NewPeerIds = append(m.NewPeerIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIds", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -4297,7 +6618,7 @@ func (m *DeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SnapRequest) Unmarshal(dAtA []byte) error {
+func (m *BatchSplitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4320,12 +6641,63 @@ func (m *SnapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SnapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchSplitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SnapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &SplitRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeftDerive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LeftDerive = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -4348,7 +6720,7 @@ func (m *SnapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SnapResponse) Unmarshal(dAtA []byte) error {
+func (m *BatchSplitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4371,15 +6743,15 @@ func (m *SnapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SnapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchSplitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SnapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4403,10 +6775,8 @@ func (m *SnapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Region == nil {
-				m.Region = &metapb.Region{}
-			}
-			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Regions = append(m.Regions, &metapb.Region{})
+			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4432,7 +6802,7 @@ func (m *SnapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Request) Unmarshal(dAtA []byte) error {
+func (m *CompactLogRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4455,17 +6825,17 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompactLogRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompactLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CmdType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactIndex", wireType)
 			}
-			m.CmdType = 0
+			m.CompactIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -4475,16 +6845,16 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CmdType |= (CmdType(b) & 0x7F) << shift
+				m.CompactIndex |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactTerm", wireType)
 			}
-			var msglen int
+			m.CompactTerm = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -4494,94 +6864,116 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.CompactTerm |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftCmdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Get == nil {
-				m.Get = &GetRequest{}
-			}
-			if err := m.Get.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftCmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Put == nil {
-				m.Put = &PutRequest{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactLogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
 			}
-			if err := m.Put.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftCmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactLogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Delete == nil {
-				m.Delete = &DeleteRequest{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferLeaderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
 			}
-			if err := m.Delete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferLeaderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferLeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4605,10 +6997,10 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Snap == nil {
-				m.Snap = &SnapRequest{}
+			if m.Peer == nil {
+				m.Peer = &metapb.Peer{}
 			}
-			if err := m.Snap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4634,7 +7026,7 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Response) Unmarshal(dAtA []byte) error {
+func (m *TransferLeaderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4657,102 +7049,68 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferLeaderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferLeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CmdType", wireType)
-			}
-			m.CmdType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftCmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CmdType |= (CmdType(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftCmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Get == nil {
-				m.Get = &GetResponse{}
-			}
-			if err := m.Get.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftCmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftCmdpb
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeletePrefixRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Put == nil {
-				m.Put = &PutResponse{}
-			}
-			if err := m.Put.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeletePrefixRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeletePrefixRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -4762,58 +7120,74 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Delete == nil {
-				m.Delete = &DeleteResponse{}
-			}
-			if err := m.Delete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Prefix = append(m.Prefix[:0], dAtA[iNdEx:postIndex]...)
+			if m.Prefix == nil {
+				m.Prefix = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snap", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftCmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Snap == nil {
-				m.Snap = &SnapResponse{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeletePrefixResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
 			}
-			if err := m.Snap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeletePrefixResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeletePrefixResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -4836,7 +7210,7 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangePeerRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4859,17 +7233,17 @@ func (m *ChangePeerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangePeerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangePeerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
 			}
-			m.ChangeType = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -4879,16 +7253,28 @@ func (m *ChangePeerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChangeType |= (eraftpb.ConfChangeType(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartKey == nil {
+				m.StartKey = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -4898,25 +7284,74 @@ func (m *ChangePeerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EndKey == nil {
+				m.EndKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Peer == nil {
-				m.Peer = &metapb.Peer{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
 			}
-			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -4939,7 +7374,7 @@ func (m *ChangePeerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangePeerResponse) Unmarshal(dAtA []byte) error {
+func (m *PrepareMergeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4962,15 +7397,15 @@ func (m *ChangePeerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangePeerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrepareMergeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangePeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrepareMergeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4994,10 +7429,10 @@ func (m *ChangePeerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Region == nil {
-				m.Region = &metapb.Region{}
+			if m.Target == nil {
+				m.Target = &metapb.Region{}
 			}
-			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5023,7 +7458,7 @@ func (m *ChangePeerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SplitRequest) Unmarshal(dAtA []byte) error {
+func (m *PrepareMergeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5046,124 +7481,12 @@ func (m *SplitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SplitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrepareMergeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrepareMergeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SplitKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftCmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRaftCmdpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SplitKey = append(m.SplitKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.SplitKey == nil {
-				m.SplitKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRegionId", wireType)
-			}
-			m.NewRegionId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftCmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewRegionId |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRaftCmdpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.NewPeerIds = append(m.NewPeerIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRaftCmdpb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRaftCmdpb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRaftCmdpb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.NewPeerIds = append(m.NewPeerIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPeerIds", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -5186,7 +7509,7 @@ func (m *SplitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchSplitRequest) Unmarshal(dAtA []byte) error {
+func (m *CommitMergeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5209,15 +7532,15 @@ func (m *BatchSplitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchSplitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitMergeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitMergeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5241,11 +7564,32 @@ func (m *BatchSplitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, &SplitRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Source == nil {
+				m.Source = &metapb.Region{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			m.Commit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Commit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -5268,7 +7612,7 @@ func (m *BatchSplitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchSplitResponse) Unmarshal(dAtA []byte) error {
+func (m *CommitMergeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5291,43 +7635,12 @@ func (m *BatchSplitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchSplitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitMergeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitMergeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftCmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftCmdpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Regions = append(m.Regions, &metapb.Region{})
-			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -5350,7 +7663,7 @@ func (m *BatchSplitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CompactLogRequest) Unmarshal(dAtA []byte) error {
+func (m *RollbackMergeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5373,36 +7686,17 @@ func (m *CompactLogRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CompactLogRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RollbackMergeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CompactLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompactIndex", wireType)
-			}
-			m.CompactIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRaftCmdpb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CompactIndex |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+			return fmt.Errorf("proto: RollbackMergeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompactTerm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			m.CompactTerm = 0
+			m.Commit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -5412,7 +7706,7 @@ func (m *CompactLogRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompactTerm |= (uint64(b) & 0x7F) << shift
+				m.Commit |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5439,7 +7733,7 @@ func (m *CompactLogRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CompactLogResponse) Unmarshal(dAtA []byte) error {
+func (m *RollbackMergeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5462,10 +7756,10 @@ func (m *CompactLogResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CompactLogResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RollbackMergeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CompactLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RollbackMergeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5490,7 +7784,7 @@ func (m *CompactLogResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransferLeaderRequest) Unmarshal(dAtA []byte) error {
+func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5503,25 +7797,275 @@ func (m *TransferLeaderRequest) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CmdType", wireType)
+			}
+			m.CmdType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CmdType |= (AdminCmdType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePeer == nil {
+				m.ChangePeer = &ChangePeerRequest{}
+			}
+			if err := m.ChangePeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompactLog == nil {
+				m.CompactLog = &CompactLogRequest{}
+			}
+			if err := m.CompactLog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TransferLeader == nil {
+				m.TransferLeader = &TransferLeaderRequest{}
+			}
+			if err := m.TransferLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Splits == nil {
+				m.Splits = &BatchSplitRequest{}
+			}
+			if err := m.Splits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletePrefix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeletePrefix == nil {
+				m.DeletePrefix = &DeletePrefixRequest{}
+			}
+			if err := m.DeletePrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteRange == nil {
+				m.DeleteRange = &DeleteRangeRequest{}
+			}
+			if err := m.DeleteRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareMerge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrepareMerge == nil {
+				m.PrepareMerge = &PrepareMergeRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransferLeaderRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferLeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.PrepareMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Peer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitMerge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5545,64 +8089,46 @@ func (m *TransferLeaderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Peer == nil {
-				m.Peer = &metapb.Peer{}
+			if m.CommitMerge == nil {
+				m.CommitMerge = &CommitMergeRequest{}
 			}
-			if err := m.Peer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CommitMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackMerge", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TransferLeaderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRaftCmdpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.RollbackMerge == nil {
+				m.RollbackMerge = &RollbackMergeRequest{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.RollbackMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransferLeaderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransferLeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -5625,7 +8151,7 @@ func (m *TransferLeaderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AdminRequest) Unmarshal(dAtA []byte) error {
+func (m *AdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5648,10 +8174,10 @@ func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AdminRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5700,7 +8226,7 @@ func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.ChangePeer == nil {
-				m.ChangePeer = &ChangePeerRequest{}
+				m.ChangePeer = &ChangePeerResponse{}
 			}
 			if err := m.ChangePeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5733,7 +8259,7 @@ func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.CompactLog == nil {
-				m.CompactLog = &CompactLogRequest{}
+				m.CompactLog = &CompactLogResponse{}
 			}
 			if err := m.CompactLog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5766,7 +8292,7 @@ func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.TransferLeader == nil {
-				m.TransferLeader = &TransferLeaderRequest{}
+				m.TransferLeader = &TransferLeaderResponse{}
 			}
 			if err := m.TransferLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5799,68 +8325,50 @@ func (m *AdminRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Splits == nil {
-				m.Splits = &BatchSplitRequest{}
+				m.Splits = &BatchSplitResponse{}
 			}
 			if err := m.Splits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletePrefix", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AdminResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRaftCmdpb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.DeletePrefix == nil {
+				m.DeletePrefix = &DeletePrefixResponse{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.DeletePrefix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AdminResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CmdType", wireType)
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRange", wireType)
 			}
-			m.CmdType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -5870,14 +8378,28 @@ func (m *AdminResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CmdType |= (AdminCmdType(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteRange == nil {
+				m.DeleteRange = &DeleteRangeResponse{}
+			}
+			if err := m.DeleteRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePeer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareMerge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5901,16 +8423,16 @@ func (m *AdminResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePeer == nil {
-				m.ChangePeer = &ChangePeerResponse{}
+			if m.PrepareMerge == nil {
+				m.PrepareMerge = &PrepareMergeResponse{}
 			}
-			if err := m.ChangePeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PrepareMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompactLog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitMerge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5934,16 +8456,16 @@ func (m *AdminResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompactLog == nil {
-				m.CompactLog = &CompactLogResponse{}
+			if m.CommitMerge == nil {
+				m.CommitMerge = &CommitMergeResponse{}
 			}
-			if err := m.CompactLog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CommitMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferLeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackMerge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5960,23 +8482,125 @@ func (m *AdminResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RollbackMerge == nil {
+				m.RollbackMerge = &RollbackMergeResponse{}
+			}
+			if err := m.RollbackMerge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegionLeaderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegionLeaderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegionLeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRaftCmdpb
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TransferLeader == nil {
-				m.TransferLeader = &TransferLeaderResponse{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegionLeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftCmdpb
 			}
-			if err := m.TransferLeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 10:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegionLeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegionLeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6000,10 +8624,10 @@ func (m *AdminResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Splits == nil {
-				m.Splits = &BatchSplitResponse{}
+			if m.Leader == nil {
+				m.Leader = &metapb.Peer{}
 			}
-			if err := m.Splits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6029,7 +8653,7 @@ func (m *AdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegionLeaderRequest) Unmarshal(dAtA []byte) error {
+func (m *RegionDetailRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6052,10 +8676,10 @@ func (m *RegionLeaderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegionLeaderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegionDetailRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegionLeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegionDetailRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6080,7 +8704,7 @@ func (m *RegionLeaderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegionLeaderResponse) Unmarshal(dAtA []byte) error {
+func (m *RegionDetailResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6103,13 +8727,46 @@ func (m *RegionLeaderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegionLeaderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegionDetailResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegionLeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegionDetailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Region == nil {
+				m.Region = &metapb.Region{}
+			}
+			if err := m.Region.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
 			}
@@ -6164,7 +8821,7 @@ func (m *RegionLeaderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegionDetailRequest) Unmarshal(dAtA []byte) error {
+func (m *RaftLogRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6187,10 +8844,10 @@ func (m *RegionDetailRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegionDetailRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RaftLogRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegionDetailRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RaftLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6215,7 +8872,7 @@ func (m *RegionDetailRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegionDetailResponse) Unmarshal(dAtA []byte) error {
+func (m *RaftLogResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6238,10 +8895,10 @@ func (m *RegionDetailResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegionDetailResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RaftLogResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegionDetailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RaftLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6278,10 +8935,10 @@ func (m *RegionDetailResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastIndex", wireType)
 			}
-			var msglen int
+			m.LastIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftCmdpb
@@ -6291,25 +8948,68 @@ func (m *RegionDetailResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.LastIndex |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftCmdpb
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTerm", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.LastTerm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTerm |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Leader == nil {
-				m.Leader = &metapb.Peer{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitIndex", wireType)
 			}
-			if err := m.Leader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CommitIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitIndex |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedIndex", wireType)
+			}
+			m.AppliedIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppliedIndex |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -6446,6 +9146,39 @@ func (m *StatusRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RaftLog == nil {
+				m.RaftLog = &RaftLogRequest{}
+			}
+			if err := m.RaftLog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -6582,6 +9315,39 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaftLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftCmdpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RaftLog == nil {
+				m.RaftLog = &RaftLogResponse{}
+			}
+			if err := m.RaftLog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -6847,6 +9613,25 @@ func (m *RaftRequestHeader) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineMs", wireType)
+			}
+			m.DeadlineMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftCmdpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineMs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftCmdpb(dAtA[iNdEx:])
@@ -7470,94 +10255,124 @@ var (
 	ErrIntOverflowRaftCmdpb   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("raft_cmdpb.proto", fileDescriptor_raft_cmdpb_67f5c556ea657888) }
-
-var fileDescriptor_raft_cmdpb_67f5c556ea657888 = []byte{
-	// 1370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4f, 0x73, 0xd3, 0xc6,
-	0x1b, 0x8e, 0x6c, 0xc7, 0x76, 0x5e, 0x5b, 0x46, 0xd9, 0x04, 0x62, 0x60, 0x7e, 0xc6, 0x08, 0xe6,
-	0x37, 0x81, 0x76, 0xdc, 0x21, 0x94, 0x4c, 0x3b, 0x43, 0xa1, 0x25, 0x49, 0x69, 0x0a, 0x07, 0xba,
-	0x70, 0xeb, 0x41, 0x23, 0xa4, 0x75, 0xa2, 0xc1, 0x96, 0x14, 0x49, 0x0e, 0xcd, 0xbd, 0x1f, 0xa2,
-	0x9f, 0xa4, 0x1f, 0xa1, 0xd3, 0x99, 0x5e, 0xda, 0x99, 0x7e, 0x80, 0x0e, 0x3d, 0xf7, 0xd2, 0x5b,
-	0x6f, 0x9d, 0xdd, 0x7d, 0x57, 0x5a, 0x59, 0x36, 0x25, 0x9c, 0xac, 0x7d, 0xf7, 0xdd, 0x67, 0xf7,
-	0x79, 0xf6, 0xfd, 0xb3, 0x06, 0x2b, 0x71, 0xc7, 0x99, 0xe3, 0x4d, 0xfd, 0xf8, 0xe5, 0x28, 0x4e,
-	0xa2, 0x2c, 0x22, 0x50, 0x58, 0xae, 0x74, 0xa7, 0x2c, 0x73, 0xd5, 0xcc, 0x15, 0x93, 0x25, 0x49,
-	0x94, 0xe8, 0x43, 0x77, 0x9c, 0xa9, 0xa1, 0x3d, 0x02, 0x78, 0xcc, 0x32, 0xca, 0x4e, 0x66, 0x2c,
-	0xcd, 0x48, 0x0f, 0x6a, 0xde, 0xb8, 0x6f, 0x0c, 0x8d, 0xed, 0x35, 0x5a, 0xf3, 0xc6, 0xc4, 0x82,
-	0xfa, 0x2b, 0x76, 0xd6, 0xaf, 0x0d, 0x8d, 0xed, 0x2e, 0xe5, 0x9f, 0xf6, 0x0d, 0xe8, 0x08, 0xff,
-	0x34, 0x8e, 0xc2, 0x94, 0x91, 0x4d, 0x58, 0x3d, 0x75, 0x27, 0x33, 0x26, 0xd6, 0x74, 0xa9, 0x1c,
-	0xd8, 0xfb, 0x00, 0xcf, 0x66, 0xef, 0x0e, 0x5a, 0xa0, 0xd4, 0x75, 0x14, 0x13, 0x3a, 0x02, 0x45,
-	0x6e, 0x65, 0xdf, 0x01, 0x73, 0x9f, 0x4d, 0x58, 0xc6, 0xde, 0xfd, 0xb0, 0x16, 0xf4, 0xd4, 0x12,
-	0x04, 0x31, 0xa1, 0xf3, 0x3c, 0x74, 0x63, 0x84, 0xb0, 0x77, 0xa1, 0x2b, 0x87, 0x48, 0xe7, 0xff,
-	0xd0, 0x4c, 0xd8, 0x51, 0x10, 0x85, 0x02, 0xb6, 0xb3, 0xd3, 0x1b, 0xa1, 0x94, 0x54, 0x58, 0x29,
-	0xce, 0xda, 0x7f, 0x19, 0xd0, 0x52, 0xc7, 0x18, 0x41, 0xdb, 0x9b, 0xfa, 0x4e, 0x76, 0x16, 0x4b,
-	0x15, 0x7a, 0x3b, 0x1b, 0x23, 0xed, 0x7a, 0xf6, 0xa6, 0xfe, 0x8b, 0xb3, 0x98, 0xd1, 0x96, 0x27,
-	0x3f, 0xc8, 0x36, 0xd4, 0x8f, 0x58, 0x26, 0x8e, 0xd9, 0xd9, 0xb9, 0xa4, 0xbb, 0x16, 0x17, 0x41,
-	0xb9, 0x0b, 0xf7, 0x8c, 0x67, 0x59, 0xbf, 0x51, 0xf5, 0x2c, 0xd4, 0xa5, 0xdc, 0x85, 0xdc, 0x81,
-	0xa6, 0x2f, 0x88, 0xf6, 0x57, 0x85, 0xf3, 0x65, 0xdd, 0xb9, 0xa4, 0x1a, 0x45, 0x47, 0xf2, 0x01,
-	0x34, 0xd2, 0xd0, 0x8d, 0xfb, 0x4d, 0xb1, 0x60, 0x4b, 0x5f, 0xa0, 0x29, 0x44, 0x85, 0x93, 0xfd,
-	0xb7, 0x01, 0xed, 0x5c, 0xa4, 0xf3, 0x12, 0xbe, 0xa5, 0x13, 0xde, 0xaa, 0x10, 0x96, 0xa8, 0x92,
-	0xf1, 0x2d, 0x9d, 0xf1, 0x56, 0x85, 0xb1, 0x72, 0xe5, 0x94, 0x77, 0xe6, 0x28, 0x5f, 0x59, 0x44,
-	0x19, 0x17, 0x28, 0xce, 0x1f, 0x96, 0x38, 0xf7, 0xab, 0x9c, 0xd1, 0x5f, 0x92, 0x8e, 0x60, 0x7d,
-	0xef, 0xd8, 0x0d, 0x8f, 0xd8, 0x33, 0xc6, 0x12, 0x75, 0xdb, 0x9f, 0x40, 0xc7, 0x13, 0x46, 0x9d,
-	0xff, 0xd6, 0x48, 0x25, 0xd5, 0x5e, 0x14, 0x8e, 0xe5, 0x22, 0xa1, 0x01, 0x78, 0xf9, 0x37, 0x19,
-	0x42, 0x23, 0x66, 0x2c, 0x41, 0x1d, 0xba, 0x2a, 0xb2, 0x04, 0xb8, 0x98, 0xb1, 0xef, 0x03, 0xd1,
-	0x37, 0x3c, 0x67, 0x4c, 0x9e, 0x40, 0xf7, 0x79, 0x3c, 0x09, 0xf2, 0xb4, 0xbb, 0x0a, 0x6b, 0x29,
-	0x1f, 0x3b, 0x3c, 0x29, 0x64, 0x7a, 0xb6, 0x85, 0xe1, 0x09, 0x3b, 0x23, 0x36, 0x98, 0x21, 0x7b,
-	0xed, 0xc8, 0xa5, 0x4e, 0xe0, 0x8b, 0x53, 0x35, 0x68, 0x27, 0x64, 0xaf, 0x25, 0xec, 0xa1, 0x4f,
-	0x86, 0xd0, 0xe5, 0x3e, 0xfc, 0x68, 0x4e, 0xe0, 0xa7, 0xfd, 0xfa, 0xb0, 0xbe, 0xdd, 0xa0, 0x10,
-	0xb2, 0xd7, 0xfc, 0x7c, 0x87, 0x7e, 0x6a, 0x1f, 0xc2, 0xfa, 0x23, 0x37, 0xf3, 0x8e, 0x4b, 0xfb,
-	0x7e, 0x0c, 0xed, 0x44, 0x7e, 0xa6, 0x7d, 0x63, 0x58, 0xaf, 0x08, 0xad, 0xf9, 0xd2, 0xdc, 0xd3,
-	0x7e, 0x00, 0x44, 0x87, 0x42, 0xee, 0xdb, 0xd0, 0x92, 0x47, 0x54, 0x50, 0xf3, 0xe4, 0xd5, 0xb4,
-	0xfd, 0x2d, 0xac, 0xef, 0x45, 0xd3, 0xd8, 0xf5, 0xb2, 0xa7, 0xd1, 0x91, 0x3a, 0xca, 0x0d, 0x30,
-	0x3d, 0x69, 0x74, 0x82, 0xd0, 0x67, 0xdf, 0x09, 0x19, 0x1a, 0xb4, 0x8b, 0xc6, 0x43, 0x6e, 0x23,
-	0xd7, 0x41, 0x8d, 0x9d, 0x8c, 0x25, 0x53, 0xa5, 0x04, 0xda, 0x5e, 0xb0, 0x64, 0x6a, 0x6f, 0x02,
-	0xd1, 0xc1, 0xb1, 0x96, 0x7c, 0x0a, 0x17, 0x5f, 0x24, 0x6e, 0x98, 0x8e, 0x59, 0xf2, 0x94, 0xb9,
-	0x7e, 0x11, 0x23, 0xea, 0xa6, 0x8d, 0xa5, 0x37, 0xdd, 0x87, 0x4b, 0xf3, 0x4b, 0x11, 0xf4, 0xa7,
-	0x1a, 0x74, 0xbf, 0xf0, 0xa7, 0x41, 0xa8, 0xc0, 0xee, 0x56, 0xb2, 0xad, 0x24, 0xa7, 0xf0, 0xad,
-	0xa4, 0xdc, 0x83, 0x3c, 0x4a, 0xb5, 0x90, 0xfb, 0x5f, 0x29, 0x4b, 0xe7, 0x23, 0x5b, 0xc5, 0x2a,
-	0x37, 0x89, 0xf5, 0xa8, 0xc9, 0x24, 0x3a, 0xc2, 0x7c, 0x2c, 0xaf, 0x9f, 0x17, 0x9b, 0x82, 0x97,
-	0x9b, 0xc8, 0xd7, 0x70, 0x21, 0x43, 0x7e, 0xce, 0x44, 0x10, 0xc4, 0x2c, 0xbd, 0xae, 0x63, 0x2c,
-	0x54, 0x8f, 0xf6, 0xb2, 0x92, 0x99, 0xdc, 0x83, 0xa6, 0x08, 0xdb, 0xb4, 0x0f, 0xd5, 0x63, 0x54,
-	0xc2, 0x8f, 0xa2, 0xb3, 0xfd, 0x4b, 0x0d, 0x4c, 0x14, 0x12, 0x83, 0xe9, 0xbd, 0x94, 0x7c, 0xb8,
-	0x48, 0xc9, 0xc1, 0x32, 0x25, 0xb1, 0x7e, 0xe8, 0x52, 0x3e, 0x5c, 0x24, 0xe5, 0x60, 0x99, 0x94,
-	0x39, 0x40, 0xa1, 0xe5, 0x93, 0x65, 0x5a, 0xda, 0x6f, 0xd3, 0x12, 0x81, 0xe6, 0xc5, 0xdc, 0x9d,
-	0x13, 0x73, 0xb0, 0x4c, 0x4c, 0x55, 0x39, 0x51, 0xcd, 0x8b, 0xb0, 0x21, 0x33, 0xae, 0x74, 0x57,
-	0xf6, 0x7d, 0xd8, 0x2c, 0x9b, 0x51, 0xea, 0x9b, 0xd0, 0xc4, 0xa3, 0x2e, 0xca, 0x01, 0x9c, 0x2b,
-	0x40, 0xf7, 0x59, 0xe6, 0x06, 0x13, 0x05, 0xea, 0x2b, 0x50, 0x65, 0x3e, 0x5f, 0x21, 0xd4, 0x36,
-	0xaf, 0xbd, 0x65, 0xf3, 0xdf, 0x0c, 0x30, 0x9f, 0x67, 0x6e, 0x36, 0x4b, 0xb5, 0xc2, 0x35, 0x17,
-	0x1f, 0xa5, 0x36, 0x2a, 0x9d, 0x2b, 0x01, 0xb2, 0x0f, 0x26, 0x56, 0xd1, 0xd2, 0xa6, 0xd7, 0xf4,
-	0xa5, 0x0b, 0xa4, 0xa3, 0xdd, 0x44, 0x33, 0x6a, 0x28, 0xbe, 0x20, 0x2d, 0x5e, 0x42, 0x0b, 0x51,
-	0x4a, 0x5a, 0x29, 0x14, 0x69, 0xb4, 0x7f, 0x37, 0xa0, 0xa7, 0x38, 0xa1, 0x68, 0xef, 0x47, 0xea,
-	0x60, 0x31, 0xa9, 0xe1, 0x72, 0x52, 0x18, 0x2f, 0x65, 0x56, 0x07, 0x8b, 0x59, 0x0d, 0x97, 0xb3,
-	0x2a, 0xc3, 0x20, 0xad, 0x1f, 0x6b, 0xb0, 0x4e, 0xdd, 0xb1, 0x4a, 0xf1, 0xaf, 0x24, 0xf8, 0x55,
-	0x58, 0x2b, 0xda, 0x97, 0x2c, 0xec, 0xed, 0xa4, 0xe8, 0x5d, 0xff, 0xd1, 0x6c, 0xc9, 0x35, 0xe8,
-	0x24, 0xcc, 0xf5, 0x9d, 0x93, 0x59, 0x94, 0xcc, 0xa6, 0xe2, 0x64, 0x6d, 0x0a, 0xdc, 0xf4, 0x8d,
-	0xb0, 0x10, 0x02, 0x8d, 0xd9, 0x2c, 0xf0, 0x45, 0xc6, 0x76, 0xa9, 0xf8, 0x26, 0xbb, 0x80, 0x27,
-	0x73, 0x58, 0x1c, 0x79, 0xc7, 0x98, 0x88, 0x1b, 0xe5, 0x40, 0x3c, 0xe0, 0x53, 0xb4, 0x93, 0x14,
-	0x03, 0x8e, 0x25, 0x7a, 0x4b, 0x53, 0x1c, 0x53, 0x7c, 0x93, 0xcb, 0xd0, 0x4e, 0xcf, 0x42, 0x4f,
-	0x54, 0x85, 0x96, 0xd8, 0xbd, 0xc5, 0xc7, 0x3c, 0xe5, 0xaf, 0xf3, 0x6d, 0xe2, 0x49, 0xe0, 0xb9,
-	0x0e, 0x3f, 0x50, 0xbf, 0x2d, 0xa6, 0x3b, 0x68, 0xa3, 0xcc, 0xf5, 0x79, 0x6b, 0x73, 0xe3, 0x78,
-	0x12, 0x30, 0x1f, 0x5b, 0xdb, 0x9a, 0x6c, 0x6d, 0x68, 0x14, 0xad, 0xcd, 0x3e, 0x01, 0x22, 0x75,
-	0x93, 0xb2, 0xa2, 0x70, 0x37, 0x61, 0x55, 0xfc, 0x25, 0xc8, 0xd3, 0x48, 0xfd, 0x41, 0x38, 0xe0,
-	0xbf, 0x54, 0x4e, 0xe6, 0xf4, 0x6b, 0x1a, 0x7d, 0xde, 0x2a, 0x67, 0x49, 0xc2, 0x42, 0x6c, 0x95,
-	0x75, 0x6c, 0x95, 0xd2, 0x26, 0x5a, 0xe5, 0x3f, 0x06, 0xf4, 0xf8, 0x9e, 0x7b, 0x53, 0x5f, 0xe5,
-	0xd5, 0x3d, 0x68, 0x1e, 0xeb, 0xc5, 0xa0, 0x54, 0xc0, 0x2b, 0xf7, 0x4a, 0xd1, 0x99, 0x7c, 0xa4,
-	0xbd, 0x23, 0x6a, 0xa2, 0xf9, 0x6f, 0x94, 0xe3, 0x66, 0xee, 0x09, 0x41, 0x3e, 0x03, 0xd3, 0xe5,
-	0x35, 0xdc, 0x41, 0x0b, 0x46, 0x5b, 0xb5, 0xc8, 0xe7, 0xc9, 0xe3, 0xea, 0x8d, 0xf6, 0x73, 0xe8,
-	0xa5, 0x22, 0x1b, 0xf2, 0xf5, 0x8d, 0xea, 0x5b, 0xba, 0x54, 0x31, 0xa8, 0x99, 0xea, 0x43, 0xfb,
-	0xfb, 0x1a, 0x5c, 0xc8, 0xb9, 0x63, 0xfe, 0xed, 0xce, 0x91, 0x1f, 0x54, 0xc9, 0xeb, 0x97, 0x93,
-	0xb3, 0xdf, 0xe1, 0xd1, 0x2d, 0x67, 0x14, 0xfd, 0xcd, 0x32, 0x7d, 0x4c, 0x95, 0xc2, 0x8d, 0x33,
-	0x50, 0x02, 0x48, 0x13, 0x2a, 0x70, 0x79, 0x81, 0x02, 0xb8, 0xda, 0x74, 0x4b, 0x2d, 0x72, 0x0f,
-	0x2e, 0xe4, 0x1a, 0x20, 0x44, 0xa3, 0xfa, 0xba, 0x2e, 0x97, 0x18, 0xda, 0x4b, 0x4b, 0xe3, 0xdb,
-	0x0f, 0xa0, 0x85, 0x05, 0x85, 0x74, 0xa0, 0x75, 0x18, 0x9e, 0xba, 0x93, 0xc0, 0xb7, 0x56, 0x48,
-	0x0b, 0xea, 0x8f, 0x59, 0x66, 0x19, 0xfc, 0xe3, 0xd9, 0x2c, 0xb3, 0xea, 0x04, 0xa0, 0x29, 0x5f,
-	0xea, 0x56, 0x83, 0xb4, 0xa1, 0xc1, 0xdf, 0xe0, 0xd6, 0xea, 0x6d, 0x1f, 0x5f, 0x40, 0x0a, 0xc4,
-	0x82, 0x2e, 0x82, 0x08, 0xb3, 0xb5, 0x42, 0x7a, 0x00, 0x45, 0xd7, 0xb5, 0x0c, 0x31, 0xce, 0x1b,
-	0xa6, 0x55, 0x27, 0x04, 0x7a, 0xe5, 0x7e, 0x68, 0x35, 0xb8, 0x4f, 0xd1, 0xdf, 0x2c, 0xb8, 0xfd,
-	0xa5, 0x2a, 0xff, 0x6a, 0x9b, 0x75, 0x30, 0x71, 0x1b, 0x69, 0xb7, 0x56, 0xf8, 0xce, 0x7a, 0x95,
-	0xb3, 0x8c, 0xc2, 0x22, 0x4b, 0x93, 0x55, 0x7b, 0x64, 0xff, 0xfc, 0x66, 0x60, 0xfc, 0xfa, 0x66,
-	0x60, 0xfc, 0xf1, 0x66, 0x60, 0xfc, 0xf0, 0xe7, 0x60, 0x05, 0xac, 0x28, 0x39, 0x1a, 0x65, 0xc1,
-	0xab, 0xd3, 0xd1, 0xab, 0x53, 0xf1, 0x27, 0xfb, 0x65, 0x53, 0xfc, 0xdc, 0xfd, 0x37, 0x00, 0x00,
-	0xff, 0xff, 0x8d, 0x33, 0x0e, 0x20, 0xb7, 0x0f, 0x00, 0x00,
+func init() { proto.RegisterFile("raft_cmdpb.proto", fileDescriptor_raft_cmdpb_e2be47922dfa613a) }
+
+var fileDescriptor_raft_cmdpb_e2be47922dfa613a = []byte{
+	// 1847 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x41, 0x73, 0xdb, 0xc6,
+	0x15, 0x36, 0x48, 0x8a, 0xa4, 0x1e, 0x09, 0x0a, 0x5a, 0xc9, 0x12, 0x63, 0x8f, 0x29, 0x0a, 0xce,
+	0x64, 0x14, 0xb7, 0x65, 0x27, 0x4a, 0xad, 0x69, 0x67, 0x12, 0xa7, 0xb1, 0xa4, 0x71, 0x1c, 0x27,
+	0x33, 0xea, 0xda, 0xb7, 0x1e, 0x30, 0x30, 0xb0, 0x94, 0x51, 0x93, 0x20, 0x0c, 0x80, 0x72, 0x74,
+	0xef, 0x4c, 0x7b, 0xe8, 0x0f, 0xe8, 0xb5, 0xe7, 0xfe, 0x86, 0xdc, 0x7b, 0xec, 0xad, 0xd7, 0x8e,
+	0x7b, 0xee, 0xa5, 0xb7, 0xde, 0x3a, 0xbb, 0xfb, 0x16, 0xd8, 0x25, 0x40, 0x37, 0xca, 0x89, 0xd8,
+	0x6f, 0xdf, 0x7e, 0xfb, 0xde, 0xdb, 0x6f, 0x1f, 0x1e, 0x08, 0x4e, 0xea, 0x4f, 0x73, 0x2f, 0x98,
+	0x87, 0xc9, 0xcb, 0x49, 0x92, 0x2e, 0xf2, 0x05, 0x81, 0x12, 0xb9, 0xd3, 0x9f, 0xb3, 0xdc, 0x57,
+	0x33, 0x77, 0x6c, 0x96, 0xa6, 0x8b, 0x54, 0x1f, 0xfa, 0xd3, 0x5c, 0x0d, 0xdd, 0x09, 0xc0, 0x13,
+	0x96, 0x53, 0xf6, 0x66, 0xc9, 0xb2, 0x9c, 0x0c, 0xa0, 0x11, 0x4c, 0x87, 0xd6, 0xd8, 0x3a, 0xda,
+	0xa4, 0x8d, 0x60, 0x4a, 0x1c, 0x68, 0xbe, 0x66, 0xd7, 0xc3, 0xc6, 0xd8, 0x3a, 0xea, 0x53, 0xfe,
+	0xe8, 0xde, 0x87, 0x9e, 0xb0, 0xcf, 0x92, 0x45, 0x9c, 0x31, 0xb2, 0x0b, 0x1b, 0x57, 0xfe, 0x6c,
+	0xc9, 0xc4, 0x9a, 0x3e, 0x95, 0x03, 0xf7, 0x0c, 0xe0, 0x62, 0xf9, 0xc3, 0x49, 0x4b, 0x96, 0xa6,
+	0xce, 0x62, 0x43, 0xef, 0x62, 0x59, 0x6c, 0xe5, 0x7e, 0x02, 0xf6, 0x19, 0x9b, 0xb1, 0x9c, 0xfd,
+	0x70, 0x67, 0x1d, 0x18, 0xa8, 0x25, 0x48, 0x62, 0x43, 0xef, 0x79, 0xec, 0x27, 0x48, 0xe1, 0x9e,
+	0x40, 0x5f, 0x0e, 0x31, 0x9c, 0x8f, 0xa0, 0x9d, 0xb2, 0xcb, 0x68, 0x11, 0x0b, 0xda, 0xde, 0xf1,
+	0x60, 0x82, 0xa9, 0xa4, 0x02, 0xa5, 0x38, 0xeb, 0xfe, 0xdb, 0x82, 0x8e, 0x72, 0x63, 0x02, 0xdd,
+	0x60, 0x1e, 0x7a, 0xf9, 0x75, 0x22, 0xb3, 0x30, 0x38, 0xde, 0x99, 0x68, 0xc7, 0x73, 0x3a, 0x0f,
+	0x5f, 0x5c, 0x27, 0x8c, 0x76, 0x02, 0xf9, 0x40, 0x8e, 0xa0, 0x79, 0xc9, 0x72, 0xe1, 0x66, 0xef,
+	0x78, 0x4f, 0x37, 0x2d, 0x0f, 0x82, 0x72, 0x13, 0x6e, 0x99, 0x2c, 0xf3, 0x61, 0xab, 0x6a, 0x59,
+	0x66, 0x97, 0x72, 0x13, 0xf2, 0x09, 0xb4, 0x43, 0x11, 0xe8, 0x70, 0x43, 0x18, 0x7f, 0xa0, 0x1b,
+	0x1b, 0x59, 0xa3, 0x68, 0x48, 0x7e, 0x02, 0xad, 0x2c, 0xf6, 0x93, 0x61, 0x5b, 0x2c, 0xd8, 0xd7,
+	0x17, 0x68, 0x19, 0xa2, 0xc2, 0xc8, 0xfd, 0x8f, 0x05, 0xdd, 0x22, 0x49, 0x37, 0x0d, 0xf8, 0x63,
+	0x3d, 0xe0, 0xfd, 0x4a, 0xc0, 0x92, 0x55, 0x46, 0xfc, 0xb1, 0x1e, 0xf1, 0x7e, 0x25, 0x62, 0x65,
+	0xca, 0x43, 0x3e, 0x5e, 0x09, 0xf9, 0x4e, 0x5d, 0xc8, 0xb8, 0x40, 0xc5, 0xfc, 0x53, 0x23, 0xe6,
+	0x61, 0x35, 0x66, 0xb4, 0x97, 0x41, 0xff, 0xc1, 0x82, 0xed, 0xd3, 0x57, 0x7e, 0x7c, 0xc9, 0x2e,
+	0x18, 0x4b, 0xd5, 0x71, 0xff, 0x12, 0x7a, 0x81, 0x00, 0xf5, 0x04, 0xec, 0x4f, 0xd4, 0xad, 0x3a,
+	0x5d, 0xc4, 0x53, 0xb9, 0x48, 0x24, 0x01, 0x82, 0xe2, 0x99, 0x8c, 0xa1, 0x95, 0x30, 0x96, 0x62,
+	0x22, 0xfa, 0x4a, 0x5a, 0x82, 0x5c, 0xcc, 0x90, 0x3d, 0x2e, 0xbf, 0xc4, 0x8f, 0x52, 0x71, 0x11,
+	0xba, 0x14, 0x47, 0xee, 0x67, 0x40, 0x74, 0x47, 0x6e, 0x28, 0xd6, 0x37, 0xd0, 0x7f, 0x9e, 0xcc,
+	0xa2, 0xe2, 0x3e, 0xde, 0x85, 0xcd, 0x8c, 0x8f, 0x3d, 0x7e, 0x5b, 0xe4, 0xbd, 0xed, 0x0a, 0xe0,
+	0x19, 0xbb, 0x26, 0x2e, 0xd8, 0x31, 0x7b, 0xeb, 0xc9, 0xa5, 0x5e, 0x14, 0x0a, 0x6f, 0x5b, 0xb4,
+	0x17, 0xb3, 0xb7, 0x92, 0xf6, 0x69, 0x48, 0xc6, 0xd0, 0xe7, 0x36, 0xdc, 0x65, 0x2f, 0x0a, 0xb3,
+	0x61, 0x73, 0xdc, 0x3c, 0x6a, 0x51, 0x88, 0xd9, 0x5b, 0xee, 0xdf, 0xd3, 0x30, 0x73, 0x7f, 0x07,
+	0xdb, 0x8f, 0xfd, 0x3c, 0x78, 0x65, 0xec, 0xfb, 0x0b, 0xe8, 0xa6, 0xf2, 0x31, 0x1b, 0x5a, 0xe3,
+	0x66, 0xe5, 0x04, 0x34, 0x5b, 0x5a, 0x58, 0x92, 0x03, 0xe8, 0xcd, 0xd8, 0x34, 0xf7, 0x42, 0x96,
+	0x46, 0x57, 0x4c, 0xb8, 0xd3, 0xa5, 0xc0, 0xa1, 0x33, 0x81, 0xb8, 0x8f, 0x80, 0xe8, 0x7b, 0x61,
+	0x72, 0x8e, 0xa0, 0x23, 0x63, 0x50, 0x7b, 0xad, 0x66, 0x47, 0x4d, 0xbb, 0xbf, 0x85, 0xed, 0xd3,
+	0xc5, 0x3c, 0xf1, 0x83, 0xfc, 0x9b, 0xc5, 0xa5, 0xf2, 0xf5, 0x3e, 0xd8, 0x81, 0x04, 0xbd, 0x28,
+	0x0e, 0xd9, 0x77, 0x22, 0x4f, 0x2d, 0xda, 0x47, 0xf0, 0x29, 0xc7, 0xc8, 0x21, 0xa8, 0xb1, 0x97,
+	0xb3, 0x74, 0xae, 0x52, 0x85, 0xd8, 0x0b, 0x96, 0xce, 0xdd, 0x5d, 0x20, 0x3a, 0x39, 0x56, 0xa1,
+	0x5f, 0xc1, 0xed, 0x17, 0xa9, 0x1f, 0x67, 0x53, 0x96, 0x7e, 0xc3, 0xfc, 0xb0, 0x14, 0x97, 0x92,
+	0x88, 0xb5, 0x4e, 0x22, 0xee, 0x10, 0xf6, 0x56, 0x97, 0x22, 0xe9, 0xcf, 0x60, 0x47, 0xca, 0xfe,
+	0x22, 0x65, 0xd3, 0xe8, 0x3b, 0x45, 0xb9, 0x07, 0xed, 0x44, 0x00, 0x78, 0xd4, 0x38, 0x72, 0xf7,
+	0x60, 0xd7, 0x34, 0x47, 0x9a, 0xaf, 0x81, 0x48, 0x9c, 0x72, 0xc1, 0xe9, 0x9a, 0xc9, 0xfd, 0xd4,
+	0xd4, 0x0c, 0x07, 0xb8, 0x66, 0xf6, 0xa1, 0xc3, 0xe2, 0xd0, 0x2b, 0x8b, 0x6f, 0x9b, 0xc5, 0xe1,
+	0x33, 0x76, 0xed, 0xde, 0x86, 0x1d, 0x83, 0x0b, 0xb7, 0xf8, 0x1c, 0x76, 0x2e, 0xb8, 0xb2, 0x53,
+	0xf6, 0x2d, 0x4b, 0xcb, 0x3d, 0x3e, 0x82, 0x76, 0xee, 0xa7, 0xbc, 0x54, 0xac, 0xd1, 0xb3, 0x9c,
+	0xe5, 0x9e, 0x9b, 0xcb, 0x91, 0xf6, 0x85, 0xc8, 0xf5, 0x3c, 0xca, 0x57, 0x59, 0xb3, 0xc5, 0x32,
+	0x0d, 0xd8, 0x3a, 0x56, 0x39, 0xcb, 0xf3, 0x14, 0x88, 0xd5, 0x78, 0x8c, 0x38, 0xe2, 0x31, 0x18,
+	0xac, 0xb8, 0xd9, 0x04, 0x76, 0xe9, 0x62, 0x36, 0x7b, 0xe9, 0x07, 0xaf, 0x8d, 0xed, 0x4a, 0x1a,
+	0xcb, 0xa0, 0xd9, 0x87, 0xdb, 0x2b, 0xf6, 0x48, 0xf4, 0x97, 0x0d, 0xe8, 0x7f, 0x19, 0xce, 0xa3,
+	0x58, 0x31, 0x7c, 0x5a, 0x29, 0xaf, 0xc6, 0x35, 0x11, 0xb6, 0x95, 0x1a, 0xfb, 0xa8, 0xa8, 0x4a,
+	0x5a, 0x89, 0xb9, 0x67, 0x94, 0xe5, 0xd5, 0x4a, 0xa6, 0x6a, 0x13, 0x87, 0xc4, 0x7a, 0x94, 0xf2,
+	0x6c, 0x71, 0x39, 0x6c, 0xd5, 0xac, 0x5f, 0xbd, 0x23, 0x14, 0x82, 0x02, 0x22, 0x5f, 0xc3, 0x56,
+	0x8e, 0xb2, 0xf4, 0x66, 0x42, 0x97, 0x58, 0x96, 0x0f, 0x75, 0x8e, 0x5a, 0xd1, 0xd3, 0x41, 0x6e,
+	0xc0, 0xe4, 0x21, 0xb4, 0x45, 0x39, 0xca, 0x86, 0x50, 0x75, 0xa3, 0x52, 0x56, 0x28, 0x1a, 0x93,
+	0x33, 0xb0, 0x65, 0x99, 0xf7, 0x50, 0xef, 0x3d, 0xb1, 0xfa, 0xa0, 0xfa, 0x5e, 0x30, 0x2e, 0x08,
+	0xed, 0x87, 0x1a, 0x48, 0xbe, 0x04, 0x1c, 0x7b, 0x29, 0x4f, 0xce, 0xb0, 0x2f, 0x48, 0x46, 0x55,
+	0x12, 0xfd, 0x7a, 0xd0, 0x5e, 0x58, 0x62, 0xdc, 0x91, 0x44, 0xea, 0xd3, 0x9b, 0xf3, 0xa3, 0x1e,
+	0xda, 0x55, 0x47, 0x6a, 0xf4, 0x4f, 0xfb, 0x89, 0x06, 0x72, 0x47, 0xa4, 0x74, 0x90, 0x64, 0x50,
+	0x75, 0xa4, 0xaa, 0x76, 0x51, 0x7c, 0x14, 0x46, 0x9e, 0xc0, 0x20, 0x45, 0xcd, 0x21, 0xc9, 0x96,
+	0x20, 0x19, 0xeb, 0x24, 0x75, 0x2a, 0xa6, 0x76, 0xaa, 0xa3, 0xee, 0x5f, 0x37, 0xc0, 0x46, 0x8d,
+	0x62, 0x79, 0xfd, 0x51, 0x22, 0xfd, 0xa2, 0x4e, 0xa4, 0xa3, 0x75, 0x22, 0xc5, 0x77, 0xb1, 0xae,
+	0xd2, 0x2f, 0xea, 0x54, 0x3a, 0x5a, 0xa7, 0xd2, 0x82, 0xa0, 0x94, 0xe9, 0xb3, 0x75, 0x32, 0x75,
+	0xdf, 0x27, 0x53, 0x24, 0x5a, 0xd5, 0xe9, 0xc9, 0x8a, 0x4e, 0x47, 0xeb, 0x74, 0xaa, 0xba, 0x10,
+	0x14, 0xea, 0x79, 0xbd, 0x50, 0xc7, 0xeb, 0x85, 0x8a, 0x04, 0xa6, 0x52, 0x1f, 0xd7, 0x2a, 0xf5,
+	0x60, 0xad, 0x52, 0x91, 0xc4, 0x90, 0xea, 0x79, 0xbd, 0x54, 0xc7, 0xeb, 0xa5, 0xaa, 0x5c, 0x31,
+	0xb4, 0xfa, 0xb8, 0x56, 0xab, 0x07, 0x6b, 0xb5, 0xaa, 0x5c, 0xd1, 0xc5, 0xfa, 0xd5, 0x1a, 0xb1,
+	0x1e, 0xbe, 0x47, 0xac, 0xc8, 0xb3, 0xa2, 0xd6, 0xdb, 0xb0, 0x23, 0x6b, 0xbb, 0x51, 0x66, 0xdc,
+	0xcf, 0x60, 0xd7, 0x84, 0x51, 0xca, 0x1f, 0x42, 0x1b, 0xa5, 0x50, 0xf7, 0xd6, 0xc5, 0xb9, 0x92,
+	0xf4, 0x8c, 0xe5, 0x7e, 0x34, 0x53, 0xa4, 0x21, 0xec, 0x9a, 0xf0, 0xcd, 0x7a, 0x33, 0x6d, 0xf3,
+	0xc6, 0x7b, 0x36, 0x77, 0x60, 0x40, 0xfd, 0xa9, 0x56, 0x7b, 0xdd, 0xef, 0x2d, 0xd8, 0x2a, 0xa0,
+	0x1b, 0xee, 0x79, 0x0f, 0x60, 0xe6, 0x67, 0xaa, 0xb1, 0x91, 0x6f, 0xbb, 0x4d, 0x8e, 0xc8, 0xae,
+	0xe6, 0x2e, 0x88, 0x81, 0x6c, 0x69, 0x9a, 0x62, 0xb6, 0xcb, 0x01, 0xde, 0xcf, 0x90, 0xc3, 0xe2,
+	0xa4, 0xe5, 0xea, 0x56, 0xd1, 0xf2, 0xcc, 0x23, 0x5c, 0x7f, 0x1f, 0x6c, 0x3f, 0x49, 0x66, 0x11,
+	0x0b, 0xd1, 0x66, 0x43, 0xb6, 0x4e, 0x08, 0x0a, 0x23, 0xf7, 0x8f, 0x0d, 0xb0, 0x9f, 0xe7, 0x7e,
+	0xbe, 0xcc, 0xb4, 0xee, 0x70, 0xa5, 0xa2, 0x18, 0x1f, 0x31, 0xd2, 0xb8, 0x52, 0x52, 0xce, 0xc0,
+	0xc6, 0x56, 0xd5, 0x48, 0xa3, 0x21, 0xbd, 0x1a, 0x31, 0xd0, 0x7e, 0xaa, 0x81, 0x1a, 0x4b, 0x28,
+	0x8e, 0x71, 0xd8, 0x5c, 0xc7, 0x62, 0x9c, 0xbe, 0x62, 0x91, 0x20, 0x79, 0x08, 0x5d, 0x61, 0x5f,
+	0x96, 0x26, 0xe3, 0x9b, 0xc4, 0x3c, 0x41, 0xda, 0x49, 0xe5, 0xd8, 0xfd, 0x53, 0x03, 0x06, 0x2a,
+	0x15, 0x78, 0x92, 0x3f, 0x2e, 0x17, 0xe7, 0xf5, 0xb9, 0x18, 0xaf, 0xcf, 0x85, 0xba, 0xcc, 0x46,
+	0x32, 0xce, 0xeb, 0x93, 0x31, 0x5e, 0x9f, 0x0c, 0x93, 0x06, 0xb3, 0x71, 0x52, 0xc9, 0xc6, 0xdd,
+	0xda, 0x6c, 0xe0, 0xe2, 0x22, 0x1d, 0xff, 0x68, 0xc0, 0x36, 0x9f, 0xc4, 0x3c, 0x7d, 0x25, 0x9d,
+	0xba, 0x0b, 0x9b, 0xe5, 0x27, 0x89, 0xec, 0xac, 0xba, 0x69, 0xf9, 0x3d, 0xf2, 0xff, 0x3e, 0xac,
+	0x0e, 0xa0, 0x97, 0x32, 0x3f, 0xf4, 0xde, 0x2c, 0x17, 0xe9, 0x72, 0x8e, 0x5f, 0x57, 0xc0, 0xa1,
+	0xdf, 0x08, 0x84, 0x10, 0x68, 0x2d, 0x97, 0x51, 0x28, 0x3c, 0xed, 0x53, 0xf1, 0x4c, 0x4e, 0x00,
+	0x23, 0xf2, 0x58, 0xb2, 0x08, 0x5e, 0xe1, 0x9b, 0x62, 0xc7, 0xbc, 0x55, 0xe7, 0x7c, 0x8a, 0xf6,
+	0xd2, 0x72, 0xc0, 0xb9, 0xc4, 0xdd, 0x69, 0x0b, 0x37, 0xc5, 0x33, 0xf9, 0x00, 0xba, 0xd9, 0x75,
+	0x1c, 0x88, 0x6c, 0x74, 0xc4, 0xee, 0x1d, 0x3e, 0xe6, 0xef, 0xa4, 0x43, 0xbe, 0x4d, 0x32, 0x8b,
+	0x02, 0xdf, 0xe3, 0x0e, 0x0d, 0xbb, 0x62, 0xba, 0x87, 0x18, 0x65, 0x7e, 0x58, 0xbd, 0x52, 0x9b,
+	0xd5, 0x2b, 0xc5, 0x63, 0x0c, 0x99, 0x1f, 0xce, 0xa2, 0x98, 0x79, 0x73, 0xf9, 0x4e, 0x6a, 0x51,
+	0x50, 0xd0, 0xb7, 0x99, 0xfb, 0x06, 0x88, 0x4c, 0xac, 0x4c, 0x39, 0x66, 0xf6, 0x43, 0xd8, 0x10,
+	0x7f, 0x10, 0x15, 0x45, 0x43, 0xfd, 0x5d, 0x74, 0xce, 0x7f, 0xa9, 0x9c, 0x2c, 0xf2, 0xd3, 0xd0,
+	0xf2, 0xc3, 0x6b, 0xc1, 0x32, 0x4d, 0x59, 0x6c, 0xd4, 0x8a, 0x1e, 0x62, 0xe2, 0xf3, 0xe7, 0xbf,
+	0x96, 0xac, 0x5c, 0xa7, 0xf3, 0x50, 0xdd, 0xf3, 0x87, 0xd0, 0x7e, 0xa5, 0x97, 0xdb, 0x7b, 0xab,
+	0xaa, 0x30, 0x0e, 0x9e, 0xa2, 0x31, 0xf9, 0xb9, 0xf6, 0xf1, 0xd8, 0x10, 0x1f, 0x74, 0xc6, 0x9f,
+	0x0e, 0xd5, 0xef, 0xc6, 0xcf, 0xc1, 0xf6, 0x79, 0x17, 0xe2, 0x21, 0x82, 0x32, 0xae, 0xb6, 0x29,
+	0xc5, 0x65, 0xf6, 0xb5, 0x11, 0xf9, 0x35, 0x0c, 0x32, 0x71, 0xcd, 0x8a, 0xf5, 0xad, 0xea, 0x3f,
+	0x2b, 0x46, 0x05, 0xa3, 0x76, 0xa6, 0x0f, 0xdd, 0xdf, 0x37, 0x60, 0xab, 0x88, 0x1d, 0x2f, 0xf6,
+	0xc9, 0x4a, 0xf0, 0xa3, 0x6a, 0xf0, 0xfa, 0xe1, 0x14, 0xd1, 0x1f, 0x73, 0xf9, 0xcb, 0x19, 0x15,
+	0xfe, 0xae, 0x19, 0xbe, 0x9c, 0xa4, 0xa5, 0x19, 0x8f, 0x40, 0x25, 0x40, 0x42, 0xc3, 0x66, 0x35,
+	0x02, 0xa3, 0xab, 0xa3, 0xb6, 0xaf, 0x0f, 0xc9, 0x29, 0x6c, 0x15, 0x39, 0x40, 0x8a, 0x9a, 0xba,
+	0x66, 0xd6, 0x2e, 0x3a, 0xc8, 0x8c, 0xf1, 0x83, 0x47, 0xd0, 0xc1, 0x4a, 0x45, 0x7a, 0xd0, 0x79,
+	0x1a, 0x5f, 0xf9, 0xb3, 0x28, 0x74, 0x6e, 0x91, 0x0e, 0x34, 0x9f, 0xb0, 0xdc, 0xb1, 0xf8, 0xc3,
+	0xc5, 0x32, 0x77, 0x9a, 0x04, 0xa0, 0x2d, 0x1b, 0x16, 0xa7, 0x45, 0xba, 0xd0, 0xe2, 0xff, 0xc8,
+	0x38, 0x1b, 0x0f, 0xbe, 0xb7, 0xf0, 0xfb, 0x48, 0xb1, 0x38, 0xd0, 0x47, 0x16, 0x01, 0x3b, 0xb7,
+	0xc8, 0x00, 0xa0, 0x6c, 0x1c, 0x1d, 0x4b, 0x8c, 0x8b, 0x9e, 0xcf, 0x69, 0x12, 0x02, 0x03, 0xb3,
+	0xa5, 0x73, 0x5a, 0xdc, 0xa6, 0x6c, 0xd1, 0x1c, 0xe0, 0xac, 0x7a, 0xcf, 0xe5, 0xf4, 0xc8, 0x16,
+	0xf4, 0xb4, 0xfe, 0xc9, 0xe9, 0x73, 0x13, 0xbd, 0x17, 0x72, 0x6c, 0x6e, 0xa2, 0xf5, 0x35, 0xce,
+	0x80, 0x6c, 0x83, 0x6d, 0xb4, 0x28, 0xce, 0xd6, 0x83, 0xe7, 0xea, 0x45, 0xa7, 0xfc, 0xdf, 0x06,
+	0x1b, 0xfd, 0x97, 0xb8, 0x73, 0x8b, 0x33, 0xeb, 0x85, 0xd9, 0xb1, 0x4a, 0x44, 0x56, 0x53, 0xa7,
+	0xc1, 0x93, 0x87, 0x35, 0xd3, 0x69, 0x3e, 0x76, 0xff, 0xf6, 0x6e, 0x64, 0xfd, 0xfd, 0xdd, 0xc8,
+	0xfa, 0xe7, 0xbb, 0x91, 0xf5, 0xe7, 0x7f, 0x8d, 0x6e, 0x81, 0xb3, 0x48, 0x2f, 0x27, 0x79, 0xf4,
+	0xfa, 0x6a, 0xf2, 0xfa, 0x4a, 0xfc, 0xb3, 0xfb, 0xb2, 0x2d, 0x7e, 0x3e, 0xfd, 0xdf, 0x00, 0xab,
+	0x44, 0x34, 0x78, 0x2c, 0x16, 0x00, 0x00,
 }
@@ -31,24 +31,27 @@ const (
 	PeerState_Normal    PeerState = 0
 	PeerState_Applying  PeerState = 1
 	PeerState_Tombstone PeerState = 2
+	PeerState_Merging   PeerState = 3
 )
 
 var PeerState_name = map[int32]string{
 	0: "Normal",
 	1: "Applying",
 	2: "Tombstone",
+	3: "Merging",
 }
 var PeerState_value = map[string]int32{
 	"Normal":    0,
 	"Applying":  1,
 	"Tombstone": 2,
+	"Merging":   3,
 }
 
 func (x PeerState) String() string {
 	return proto.EnumName(PeerState_name, int32(x))
 }
 func (PeerState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_79f5c406f2324b20, []int{0}
+	return fileDescriptor_raft_serverpb_38c7b8e0ea68aa8f, []int{0}
 }
 
 type RaftMessage struct {
@@ -60,8 +63,17 @@ type RaftMessage struct {
 	// true means to_peer is a tombstone peer and it should remove itself.
 	IsTombstone bool `protobuf:"varint,6,opt,name=is_tombstone,json=isTombstone,proto3" json:"is_tombstone,omitempty"`
 	// Region key range [start_key, end_key).
-	StartKey             []byte   `protobuf:"bytes,7,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
-	EndKey               []byte   `protobuf:"bytes,8,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	StartKey []byte `protobuf:"bytes,7,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	EndKey   []byte `protobuf:"bytes,8,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	// true means the message carries a snapshot for a peer added to repair an
+	// under-replicated region, it's sent before the other snapshots.
+	Repair bool `protobuf:"varint,9,opt,name=repair,proto3" json:"repair,omitempty"`
+	// The content of the snapshot files, set when the snapshot is small enough
+	// to be sent inline with the message instead of over a Snapshot stream.
+	InlineSnapshot []byte `protobuf:"bytes,10,opt,name=inline_snapshot,json=inlineSnapshot,proto3" json:"inline_snapshot,omitempty"`
+	// Set when the sending store is busy, it asks the leader to slow down
+	// replicating to the store for this many milliseconds.
+	BusyBackoffMs        uint64   `protobuf:"varint,11,opt,name=busy_backoff_ms,json=busyBackoffMs,proto3" json:"busy_backoff_ms,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -71,7 +83,7 @@ func (m *RaftMessage) Reset()         { *m = RaftMessage{} }
 func (m *RaftMessage) String() string { return proto.CompactTextString(m) }
 func (*RaftMessage) ProtoMessage()    {}
 func (*RaftMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_79f5c406f2324b20, []int{0}
+	return fileDescriptor_raft_serverpb_38c7b8e0ea68aa8f, []int{0}
 }
 func (m *RaftMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -156,6 +168,236 @@ func (m *RaftMessage) GetEndKey() []byte {
 	return nil
 }
 
+func (m *RaftMessage) GetRepair() bool {
+	if m != nil {
+		return m.Repair
+	}
+	return false
+}
+
+func (m *RaftMessage) GetInlineSnapshot() []byte {
+	if m != nil {
+		return m.InlineSnapshot
+	}
+	return nil
+}
+
+func (m *RaftMessage) GetBusyBackoffMs() uint64 {
+	if m != nil {
+		return m.BusyBackoffMs
+	}
+	return 0
+}
+
+// BatchRaftMessage carries the raft messages of all the regions sent from one
+// store to another over a single stream.
+type BatchRaftMessage struct {
+	Msgs []*RaftMessage `protobuf:"bytes,1,rep,name=msgs" json:"msgs,omitempty"`
+	// The plain heartbeats and heartbeat responses of the batch, merged into
+	// the compact form. The peers of a heartbeat are on the stores below.
+	Heartbeats  []*RaftHeartbeat `protobuf:"bytes,2,rep,name=heartbeats" json:"heartbeats,omitempty"`
+	FromStoreId uint64           `protobuf:"varint,3,opt,name=from_store_id,json=fromStoreId,proto3" json:"from_store_id,omitempty"`
+	ToStoreId   uint64           `protobuf:"varint,4,opt,name=to_store_id,json=toStoreId,proto3" json:"to_store_id,omitempty"`
+	// The stream the batch is sent over, picked at random by the sender. The
+	// stream spans the reconnects of the gRPC streams to the same store.
+	StreamId uint64 `protobuf:"varint,5,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// The sequence of the batch in the stream starting at 1, a batch sent again
+	// after a reconnect keeps its sequence. 0 means the sender doesn't check.
+	Seq uint64 `protobuf:"varint,6,opt,name=seq,proto3" json:"seq,omitempty"`
+	// CRC32 (Castagnoli) of the batch marshaled with the checksum unset.
+	Checksum             uint32   `protobuf:"varint,7,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchRaftMessage) Reset()         { *m = BatchRaftMessage{} }
+func (m *BatchRaftMessage) String() string { return proto.CompactTextString(m) }
+func (*BatchRaftMessage) ProtoMessage()    {}
+func (*BatchRaftMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_serverpb_38c7b8e0ea68aa8f, []int{1}
+}
+func (m *BatchRaftMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchRaftMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchRaftMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *BatchRaftMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchRaftMessage.Merge(dst, src)
+}
+func (m *BatchRaftMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchRaftMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchRaftMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchRaftMessage proto.InternalMessageInfo
+
+func (m *BatchRaftMessage) GetMsgs() []*RaftMessage {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+func (m *BatchRaftMessage) GetHeartbeats() []*RaftHeartbeat {
+	if m != nil {
+		return m.Heartbeats
+	}
+	return nil
+}
+
+func (m *BatchRaftMessage) GetFromStoreId() uint64 {
+	if m != nil {
+		return m.FromStoreId
+	}
+	return 0
+}
+
+func (m *BatchRaftMessage) GetToStoreId() uint64 {
+	if m != nil {
+		return m.ToStoreId
+	}
+	return 0
+}
+
+func (m *BatchRaftMessage) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+func (m *BatchRaftMessage) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *BatchRaftMessage) GetChecksum() uint32 {
+	if m != nil {
+		return m.Checksum
+	}
+	return 0
+}
+
+// RaftHeartbeat is a MsgHeartbeat, or a MsgHeartbeatResponse, of a region
+// merged into a BatchRaftMessage, with the fields a plain heartbeat sets.
+type RaftHeartbeat struct {
+	RegionId             uint64   `protobuf:"varint,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	FromPeerId           uint64   `protobuf:"varint,2,opt,name=from_peer_id,json=fromPeerId,proto3" json:"from_peer_id,omitempty"`
+	ToPeerId             uint64   `protobuf:"varint,3,opt,name=to_peer_id,json=toPeerId,proto3" json:"to_peer_id,omitempty"`
+	ConfVer              uint64   `protobuf:"varint,4,opt,name=conf_ver,json=confVer,proto3" json:"conf_ver,omitempty"`
+	Version              uint64   `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	Response             bool     `protobuf:"varint,6,opt,name=response,proto3" json:"response,omitempty"`
+	Term                 uint64   `protobuf:"varint,7,opt,name=term,proto3" json:"term,omitempty"`
+	Commit               uint64   `protobuf:"varint,8,opt,name=commit,proto3" json:"commit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RaftHeartbeat) Reset()         { *m = RaftHeartbeat{} }
+func (m *RaftHeartbeat) String() string { return proto.CompactTextString(m) }
+func (*RaftHeartbeat) ProtoMessage()    {}
+func (*RaftHeartbeat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_serverpb_38c7b8e0ea68aa8f, []int{2}
+}
+func (m *RaftHeartbeat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RaftHeartbeat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RaftHeartbeat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *RaftHeartbeat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftHeartbeat.Merge(dst, src)
+}
+func (m *RaftHeartbeat) XXX_Size() int {
+	return m.Size()
+}
+func (m *RaftHeartbeat) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftHeartbeat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RaftHeartbeat proto.InternalMessageInfo
+
+func (m *RaftHeartbeat) GetRegionId() uint64 {
+	if m != nil {
+		return m.RegionId
+	}
+	return 0
+}
+
+func (m *RaftHeartbeat) GetFromPeerId() uint64 {
+	if m != nil {
+		return m.FromPeerId
+	}
+	return 0
+}
+
+func (m *RaftHeartbeat) GetToPeerId() uint64 {
+	if m != nil {
+		return m.ToPeerId
+	}
+	return 0
+}
+
+func (m *RaftHeartbeat) GetConfVer() uint64 {
+	if m != nil {
+		return m.ConfVer
+	}
+	return 0
+}
+
+func (m *RaftHeartbeat) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RaftHeartbeat) GetResponse() bool {
+	if m != nil {
+		return m.Response
+	}
+	return false
+}
+
+func (m *RaftHeartbeat) GetTerm() uint64 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *RaftHeartbeat) GetCommit() uint64 {
+	if m != nil {
+		return m.Commit
+	}
+	return 0
+}
+
 type RaftTruncatedState struct {
 	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
 	Term                 uint64   `protobuf:"varint,2,opt,name=term,proto3" json:"term,omitempty"`
@@ -168,7 +410,7 @@ func (m *RaftTruncatedState) Reset()         { *m = RaftTruncatedState{} }
 func (m *RaftTruncatedState) String() string { return proto.CompactTextString(m) }
 func (*RaftTruncatedState) ProtoMessage()    {}
 func (*RaftTruncatedState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_79f5c406f2324b20, []int{1}
+	return fileDescriptor_raft_serverpb_38c7b8e0ea68aa8f, []int{3}
 }
 func (m *RaftTruncatedState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,7 +466,7 @@ func (m *SnapshotCFFile) Reset()         { *m = SnapshotCFFile{} }
 func (m *SnapshotCFFile) String() string { return proto.CompactTextString(m) }
 func (*SnapshotCFFile) ProtoMessage()    {}
 func (*SnapshotCFFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_79f5c406f2324b20, []int{2}
+	return fileDescriptor_raft_serverpb_38c7b8e0ea68aa8f, []int{4}
 }
 func (m *SnapshotCFFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -285,7 +527,7 @@ func (m *SnapshotMeta) Reset()         { *m = SnapshotMeta{} }
 func (m *SnapshotMeta) String() string { return proto.CompactTextString(m) }
 func (*SnapshotMeta) ProtoMessage()    {}
 func (*SnapshotMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_79f5c406f2324b20, []int{3}
+	return fileDescriptor_raft_serverpb_38c7b8e0ea68aa8f, []int{5}
 }
 func (m *SnapshotMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -333,7 +575,7 @@ func (m *SnapshotChunk) Reset()         { *m = SnapshotChunk{} }
 func (m *SnapshotChunk) String() string { return proto.CompactTextString(m) }
 func (*SnapshotChunk) ProtoMessage()    {}
 func (*SnapshotChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_79f5c406f2324b20, []int{4}
+	return fileDescriptor_raft_serverpb_38c7b8e0ea68aa8f, []int{6}
 }
 func (m *SnapshotChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +628,7 @@ func (m *Done) Reset()         { *m = Done{} }
 func (m *Done) String() string { return proto.CompactTextString(m) }
 func (*Done) ProtoMessage()    {}
 func (*Done) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_79f5c406f2324b20, []int{5}
+	return fileDescriptor_raft_serverpb_38c7b8e0ea68aa8f, []int{7}
 }
 func (m *Done) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -427,7 +669,7 @@ func (m *KeyValue) Reset()         { *m = KeyValue{} }
 func (m *KeyValue) String() string { return proto.CompactTextString(m) }
 func (*KeyValue) ProtoMessage()    {}
 func (*KeyValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_79f5c406f2324b20, []int{6}
+	return fileDescriptor_raft_serverpb_38c7b8e0ea68aa8f, []int{8}
 }
 func (m *KeyValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -485,7 +727,7 @@ func (m *RaftSnapshotData) Reset()         { *m = RaftSnapshotData{} }
 func (m *RaftSnapshotData) String() string { return proto.CompactTextString(m) }
 func (*RaftSnapshotData) ProtoMessage()    {}
 func (*RaftSnapshotData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_79f5c406f2324b20, []int{7}
+	return fileDescriptor_raft_serverpb_38c7b8e0ea68aa8f, []int{9}
 }
 func (m *RaftSnapshotData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,7 +803,7 @@ func (m *StoreIdent) Reset()         { *m = StoreIdent{} }
 func (m *StoreIdent) String() string { return proto.CompactTextString(m) }
 func (*StoreIdent) ProtoMessage()    {}
 func (*StoreIdent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_79f5c406f2324b20, []int{8}
+	return fileDescriptor_raft_serverpb_38c7b8e0ea68aa8f, []int{10}
 }
 func (m *StoreIdent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -616,7 +858,7 @@ func (m *RaftLocalState) Reset()         { *m = RaftLocalState{} }
 func (m *RaftLocalState) String() string { return proto.CompactTextString(m) }
 func (*RaftLocalState) ProtoMessage()    {}
 func (*RaftLocalState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_79f5c406f2324b20, []int{9}
+	return fileDescriptor_raft_serverpb_38c7b8e0ea68aa8f, []int{11}
 }
 func (m *RaftLocalState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -671,7 +913,7 @@ func (m *RaftApplyState) Reset()         { *m = RaftApplyState{} }
 func (m *RaftApplyState) String() string { return proto.CompactTextString(m) }
 func (*RaftApplyState) ProtoMessage()    {}
 func (*RaftApplyState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_79f5c406f2324b20, []int{10}
+	return fileDescriptor_raft_serverpb_38c7b8e0ea68aa8f, []int{12}
 }
 func (m *RaftApplyState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -714,19 +956,81 @@ func (m *RaftApplyState) GetTruncatedState() *RaftTruncatedState {
 	return nil
 }
 
+type MergeState struct {
+	// The region the merging region is merged into.
+	Target *metapb.Region `protobuf:"bytes,1,opt,name=target" json:"target,omitempty"`
+	// The index of the PrepareMerge.
+	Commit               uint64   `protobuf:"varint,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MergeState) Reset()         { *m = MergeState{} }
+func (m *MergeState) String() string { return proto.CompactTextString(m) }
+func (*MergeState) ProtoMessage()    {}
+func (*MergeState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_raft_serverpb_38c7b8e0ea68aa8f, []int{13}
+}
+func (m *MergeState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MergeState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MergeState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *MergeState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeState.Merge(dst, src)
+}
+func (m *MergeState) XXX_Size() int {
+	return m.Size()
+}
+func (m *MergeState) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeState proto.InternalMessageInfo
+
+func (m *MergeState) GetTarget() *metapb.Region {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *MergeState) GetCommit() uint64 {
+	if m != nil {
+		return m.Commit
+	}
+	return 0
+}
+
 type RegionLocalState struct {
-	State                PeerState      `protobuf:"varint,1,opt,name=state,proto3,enum=raft_serverpb.PeerState" json:"state,omitempty"`
-	Region               *metapb.Region `protobuf:"bytes,2,opt,name=region" json:"region,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	State  PeerState      `protobuf:"varint,1,opt,name=state,proto3,enum=raft_serverpb.PeerState" json:"state,omitempty"`
+	Region *metapb.Region `protobuf:"bytes,2,opt,name=region" json:"region,omitempty"`
+	// For a tombstone, the largest region epoch the peer has seen before it
+	// was destroyed, messages with an older epoch can't recreate the peer.
+	MaxEpoch *metapb.RegionEpoch `protobuf:"bytes,3,opt,name=max_epoch,json=maxEpoch" json:"max_epoch,omitempty"`
+	// Set while the peer is merging.
+	MergeState           *MergeState `protobuf:"bytes,4,opt,name=merge_state,json=mergeState" json:"merge_state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *RegionLocalState) Reset()         { *m = RegionLocalState{} }
 func (m *RegionLocalState) String() string { return proto.CompactTextString(m) }
 func (*RegionLocalState) ProtoMessage()    {}
 func (*RegionLocalState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_raft_serverpb_79f5c406f2324b20, []int{11}
+	return fileDescriptor_raft_serverpb_38c7b8e0ea68aa8f, []int{14}
 }
 func (m *RegionLocalState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -769,8 +1073,24 @@ func (m *RegionLocalState) GetRegion() *metapb.Region {
 	return nil
 }
 
+func (m *RegionLocalState) GetMaxEpoch() *metapb.RegionEpoch {
+	if m != nil {
+		return m.MaxEpoch
+	}
+	return nil
+}
+
+func (m *RegionLocalState) GetMergeState() *MergeState {
+	if m != nil {
+		return m.MergeState
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RaftMessage)(nil), "raft_serverpb.RaftMessage")
+	proto.RegisterType((*BatchRaftMessage)(nil), "raft_serverpb.BatchRaftMessage")
+	proto.RegisterType((*RaftHeartbeat)(nil), "raft_serverpb.RaftHeartbeat")
 	proto.RegisterType((*RaftTruncatedState)(nil), "raft_serverpb.RaftTruncatedState")
 	proto.RegisterType((*SnapshotCFFile)(nil), "raft_serverpb.SnapshotCFFile")
 	proto.RegisterType((*SnapshotMeta)(nil), "raft_serverpb.SnapshotMeta")
@@ -781,6 +1101,7 @@ func init() {
 	proto.RegisterType((*StoreIdent)(nil), "raft_serverpb.StoreIdent")
 	proto.RegisterType((*RaftLocalState)(nil), "raft_serverpb.RaftLocalState")
 	proto.RegisterType((*RaftApplyState)(nil), "raft_serverpb.RaftApplyState")
+	proto.RegisterType((*MergeState)(nil), "raft_serverpb.MergeState")
 	proto.RegisterType((*RegionLocalState)(nil), "raft_serverpb.RegionLocalState")
 	proto.RegisterEnum("raft_serverpb.PeerState", PeerState_name, PeerState_value)
 }
@@ -866,13 +1187,34 @@ func (m *RaftMessage) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRaftServerpb(dAtA, i, uint64(len(m.EndKey)))
 		i += copy(dAtA[i:], m.EndKey)
 	}
+	if m.Repair {
+		dAtA[i] = 0x48
+		i++
+		if m.Repair {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.InlineSnapshot) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(len(m.InlineSnapshot)))
+		i += copy(dAtA[i:], m.InlineSnapshot)
+	}
+	if m.BusyBackoffMs != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.BusyBackoffMs))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *RaftTruncatedState) Marshal() (dAtA []byte, err error) {
+func (m *BatchRaftMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -882,20 +1224,59 @@ func (m *RaftTruncatedState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RaftTruncatedState) MarshalTo(dAtA []byte) (int, error) {
+func (m *BatchRaftMessage) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Index != 0 {
-		dAtA[i] = 0x8
+	if len(m.Msgs) > 0 {
+		for _, msg := range m.Msgs {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRaftServerpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Heartbeats) > 0 {
+		for _, msg := range m.Heartbeats {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintRaftServerpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.FromStoreId != 0 {
+		dAtA[i] = 0x18
 		i++
-		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Index))
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.FromStoreId))
 	}
-	if m.Term != 0 {
-		dAtA[i] = 0x10
+	if m.ToStoreId != 0 {
+		dAtA[i] = 0x20
 		i++
-		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Term))
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.ToStoreId))
+	}
+	if m.StreamId != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.StreamId))
+	}
+	if m.Seq != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Seq))
+	}
+	if m.Checksum != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Checksum))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -903,7 +1284,7 @@ func (m *RaftTruncatedState) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *SnapshotCFFile) Marshal() (dAtA []byte, err error) {
+func (m *RaftHeartbeat) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -913,31 +1294,128 @@ func (m *SnapshotCFFile) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SnapshotCFFile) MarshalTo(dAtA []byte) (int, error) {
+func (m *RaftHeartbeat) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Cf) > 0 {
-		dAtA[i] = 0xa
+	if m.RegionId != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintRaftServerpb(dAtA, i, uint64(len(m.Cf)))
-		i += copy(dAtA[i:], m.Cf)
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.RegionId))
 	}
-	if m.Size_ != 0 {
+	if m.FromPeerId != 0 {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Size_))
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.FromPeerId))
 	}
-	if m.Checksum != 0 {
+	if m.ToPeerId != 0 {
 		dAtA[i] = 0x18
 		i++
-		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Checksum))
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.ToPeerId))
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if m.ConfVer != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.ConfVer))
 	}
-	return i, nil
+	if m.Version != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Version))
+	}
+	if m.Response {
+		dAtA[i] = 0x30
+		i++
+		if m.Response {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Term != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Term))
+	}
+	if m.Commit != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Commit))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *RaftTruncatedState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftTruncatedState) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Index))
+	}
+	if m.Term != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Term))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SnapshotCFFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotCFFile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cf) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(len(m.Cf)))
+		i += copy(dAtA[i:], m.Cf)
+	}
+	if m.Size_ != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Size_))
+	}
+	if m.Checksum != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Checksum))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
 }
 
 func (m *SnapshotMeta) Marshal() (dAtA []byte, err error) {
@@ -1230,6 +1708,42 @@ func (m *RaftApplyState) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *MergeState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeState) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Target != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Target.Size()))
+		n10, err := m.Target.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.Commit != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Commit))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *RegionLocalState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1254,11 +1768,31 @@ func (m *RegionLocalState) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.Region.Size()))
-		n10, err := m.Region.MarshalTo(dAtA[i:])
+		n11, err := m.Region.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n11
+	}
+	if m.MaxEpoch != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.MaxEpoch.Size()))
+		n12, err := m.MaxEpoch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.MergeState != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRaftServerpb(dAtA, i, uint64(m.MergeState.Size()))
+		n13, err := m.MergeState.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1308,6 +1842,85 @@ func (m *RaftMessage) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRaftServerpb(uint64(l))
 	}
+	if m.Repair {
+		n += 2
+	}
+	l = len(m.InlineSnapshot)
+	if l > 0 {
+		n += 1 + l + sovRaftServerpb(uint64(l))
+	}
+	if m.BusyBackoffMs != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.BusyBackoffMs))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchRaftMessage) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovRaftServerpb(uint64(l))
+		}
+	}
+	if len(m.Heartbeats) > 0 {
+		for _, e := range m.Heartbeats {
+			l = e.Size()
+			n += 1 + l + sovRaftServerpb(uint64(l))
+		}
+	}
+	if m.FromStoreId != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.FromStoreId))
+	}
+	if m.ToStoreId != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.ToStoreId))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.StreamId))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.Seq))
+	}
+	if m.Checksum != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.Checksum))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RaftHeartbeat) Size() (n int) {
+	var l int
+	_ = l
+	if m.RegionId != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.RegionId))
+	}
+	if m.FromPeerId != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.FromPeerId))
+	}
+	if m.ToPeerId != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.ToPeerId))
+	}
+	if m.ConfVer != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.ConfVer))
+	}
+	if m.Version != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.Version))
+	}
+	if m.Response {
+		n += 2
+	}
+	if m.Term != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.Term))
+	}
+	if m.Commit != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.Commit))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1482,6 +2095,22 @@ func (m *RaftApplyState) Size() (n int) {
 	return n
 }
 
+func (m *MergeState) Size() (n int) {
+	var l int
+	_ = l
+	if m.Target != nil {
+		l = m.Target.Size()
+		n += 1 + l + sovRaftServerpb(uint64(l))
+	}
+	if m.Commit != 0 {
+		n += 1 + sovRaftServerpb(uint64(m.Commit))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *RegionLocalState) Size() (n int) {
 	var l int
 	_ = l
@@ -1492,6 +2121,14 @@ func (m *RegionLocalState) Size() (n int) {
 		l = m.Region.Size()
 		n += 1 + l + sovRaftServerpb(uint64(l))
 	}
+	if m.MaxEpoch != nil {
+		l = m.MaxEpoch.Size()
+		n += 1 + l + sovRaftServerpb(uint64(l))
+	}
+	if m.MergeState != nil {
+		l = m.MergeState.Size()
+		n += 1 + l + sovRaftServerpb(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1591,12 +2228,560 @@ func (m *RaftMessage) Unmarshal(dAtA []byte) error {
 			if err := m.FromPeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToPeer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftServerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ToPeer == nil {
+				m.ToPeer = &metapb.Peer{}
+			}
+			if err := m.ToPeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftServerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &eraftpb.Message{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftServerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RegionEpoch == nil {
+				m.RegionEpoch = &metapb.RegionEpoch{}
+			}
+			if err := m.RegionEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsTombstone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsTombstone = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftServerpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartKey == nil {
+				m.StartKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftServerpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EndKey == nil {
+				m.EndKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repair", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Repair = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InlineSnapshot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRaftServerpb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InlineSnapshot = append(m.InlineSnapshot[:0], dAtA[iNdEx:postIndex]...)
+			if m.InlineSnapshot == nil {
+				m.InlineSnapshot = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BusyBackoffMs", wireType)
+			}
+			m.BusyBackoffMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BusyBackoffMs |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftServerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftServerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchRaftMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftServerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchRaftMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchRaftMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftServerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &RaftMessage{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heartbeats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftServerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Heartbeats = append(m.Heartbeats, &RaftHeartbeat{})
+			if err := m.Heartbeats[len(m.Heartbeats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromStoreId", wireType)
+			}
+			m.FromStoreId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromStoreId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToStoreId", wireType)
+			}
+			m.ToStoreId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToStoreId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			m.Checksum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Checksum |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftServerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftServerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RaftHeartbeat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftServerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RaftHeartbeat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RaftHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
+			}
+			m.RegionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromPeerId", wireType)
+			}
+			m.FromPeerId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromPeerId |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToPeer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToPeerId", wireType)
 			}
-			var msglen int
+			m.ToPeerId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftServerpb
@@ -1606,30 +2791,16 @@ func (m *RaftMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ToPeerId |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftServerpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ToPeer == nil {
-				m.ToPeer = &metapb.Peer{}
-			}
-			if err := m.ToPeer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfVer", wireType)
 			}
-			var msglen int
+			m.ConfVer = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftServerpb
@@ -1639,30 +2810,16 @@ func (m *RaftMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ConfVer |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftServerpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Message == nil {
-				m.Message = &eraftpb.Message{}
-			}
-			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionEpoch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftServerpb
@@ -1672,28 +2829,14 @@ func (m *RaftMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Version |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRaftServerpb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RegionEpoch == nil {
-				m.RegionEpoch = &metapb.RegionEpoch{}
-			}
-			if err := m.RegionEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsTombstone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1710,12 +2853,12 @@ func (m *RaftMessage) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IsTombstone = bool(v != 0)
+			m.Response = bool(v != 0)
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
 			}
-			var byteLen int
+			m.Term = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftServerpb
@@ -1725,28 +2868,16 @@ func (m *RaftMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Term |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRaftServerpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.StartKey == nil {
-				m.StartKey = []byte{}
-			}
-			iNdEx = postIndex
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var byteLen int
+			m.Commit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRaftServerpb
@@ -1756,23 +2887,11 @@ func (m *RaftMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Commit |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRaftServerpb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EndKey = append(m.EndKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.EndKey == nil {
-				m.EndKey = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftServerpb(dAtA[iNdEx:])
@@ -2844,6 +3963,109 @@ func (m *RaftApplyState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MergeState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftServerpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftServerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &metapb.Region{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			m.Commit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Commit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftServerpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftServerpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RegionLocalState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2925,6 +4147,72 @@ func (m *RegionLocalState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftServerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxEpoch == nil {
+				m.MaxEpoch = &metapb.RegionEpoch{}
+			}
+			if err := m.MaxEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftServerpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftServerpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MergeState == nil {
+				m.MergeState = &MergeState{}
+			}
+			if err := m.MergeState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRaftServerpb(dAtA[iNdEx:])
@@ -3052,56 +4340,76 @@ var (
 	ErrIntOverflowRaftServerpb   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("raft_serverpb.proto", fileDescriptor_raft_serverpb_79f5c406f2324b20) }
-
-var fileDescriptor_raft_serverpb_79f5c406f2324b20 = []byte{
-	// 768 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0xae, 0x13, 0x6f, 0x62, 0x9f, 0x38, 0xc1, 0x9a, 0x22, 0xd5, 0xa4, 0x6a, 0x94, 0x1a, 0x81,
-	0x42, 0x91, 0x82, 0x08, 0x15, 0xe2, 0x0a, 0x09, 0x28, 0xab, 0x86, 0xa5, 0xa8, 0x9a, 0x54, 0x48,
-	0x5c, 0x59, 0x13, 0xfb, 0x38, 0x31, 0x71, 0x6c, 0x6b, 0x66, 0x12, 0x11, 0xee, 0x78, 0x0b, 0x5e,
-	0x85, 0x37, 0xe0, 0x0e, 0x1e, 0x01, 0x2d, 0x2f, 0x82, 0x66, 0xc6, 0xce, 0xcf, 0x6a, 0xe9, 0x95,
-	0xcf, 0xff, 0xcf, 0x77, 0x3e, 0x0f, 0x3c, 0xe4, 0x2c, 0x95, 0x91, 0x40, 0xbe, 0x47, 0x5e, 0x2d,
-	0xa7, 0x15, 0x2f, 0x65, 0x49, 0xfa, 0x17, 0xc6, 0x61, 0x1f, 0x95, 0xde, 0x78, 0x87, 0xde, 0x16,
-	0x25, 0x6b, 0xb4, 0xf0, 0x8f, 0x16, 0xf4, 0x28, 0x4b, 0xe5, 0x2b, 0x14, 0x82, 0xad, 0x90, 0x3c,
-	0x06, 0x97, 0xe3, 0x2a, 0x2b, 0x8b, 0x28, 0x4b, 0x02, 0x6b, 0x6c, 0x4d, 0x6c, 0xea, 0x18, 0xc3,
-	0x3c, 0x21, 0x1f, 0x81, 0x9b, 0xf2, 0x72, 0x1b, 0x55, 0x88, 0x3c, 0x68, 0x8d, 0xad, 0x49, 0x6f,
-	0xe6, 0x4d, 0xeb, 0x72, 0xaf, 0x11, 0x39, 0x75, 0x94, 0x5b, 0x49, 0xe4, 0x03, 0xe8, 0xca, 0xd2,
-	0x04, 0xb6, 0xef, 0x09, 0xec, 0xc8, 0x52, 0x87, 0x3d, 0x83, 0xee, 0xd6, 0x74, 0x0e, 0x6c, 0x1d,
-	0xe6, 0x4f, 0x9b, 0x69, 0xeb, 0x89, 0x68, 0x13, 0x40, 0x3e, 0x07, 0xaf, 0x1e, 0x0d, 0xab, 0x32,
-	0x5e, 0x07, 0x57, 0x3a, 0xe1, 0x61, 0x53, 0x97, 0x6a, 0xdf, 0xb7, 0xca, 0x45, 0x7b, 0xfc, 0xa4,
-	0x90, 0xa7, 0xe0, 0x65, 0x22, 0x92, 0xe5, 0x76, 0x29, 0x64, 0x59, 0x60, 0xd0, 0x19, 0x5b, 0x13,
-	0x87, 0xf6, 0x32, 0xf1, 0xa6, 0x31, 0xa9, 0xad, 0x85, 0x64, 0x5c, 0x46, 0x1b, 0x3c, 0x04, 0xdd,
-	0xb1, 0x35, 0xf1, 0xa8, 0xa3, 0x0d, 0x37, 0x78, 0x20, 0x8f, 0xa0, 0x8b, 0x45, 0xa2, 0x5d, 0x8e,
-	0x76, 0x75, 0xb0, 0x48, 0x6e, 0xf0, 0x10, 0x7e, 0x09, 0x44, 0x41, 0xf7, 0x86, 0xef, 0x8a, 0x98,
-	0x49, 0x4c, 0x16, 0x92, 0x49, 0x24, 0xef, 0xc2, 0x55, 0x56, 0x24, 0xf8, 0x4b, 0x8d, 0x9e, 0x51,
-	0x08, 0x01, 0x5b, 0x22, 0xdf, 0x6a, 0xd4, 0x6c, 0xaa, 0xe5, 0xf0, 0x35, 0x0c, 0x16, 0x05, 0xab,
-	0xc4, 0xba, 0x94, 0xdf, 0x5c, 0x5f, 0x67, 0x39, 0x92, 0x01, 0xb4, 0xe2, 0x54, 0x27, 0xba, 0xb4,
-	0x15, 0xa7, 0x2a, 0x4b, 0x64, 0xbf, 0x62, 0x93, 0xa5, 0x64, 0x32, 0x04, 0x27, 0x5e, 0x63, 0xbc,
-	0x11, 0xbb, 0xad, 0x86, 0xb6, 0x4f, 0x8f, 0x7a, 0xf8, 0x12, 0xbc, 0xa6, 0xe2, 0x2b, 0x94, 0x8c,
-	0x7c, 0x01, 0x4e, 0x9c, 0x46, 0x69, 0x96, 0xa3, 0x08, 0xac, 0x71, 0x7b, 0xd2, 0x9b, 0x3d, 0x99,
-	0x5e, 0x32, 0xe6, 0x72, 0x00, 0xda, 0x8d, 0x53, 0xf5, 0x15, 0xe1, 0x4f, 0xd0, 0x3f, 0xba, 0xd6,
-	0xbb, 0x62, 0x43, 0x9e, 0x9f, 0x2e, 0x65, 0x69, 0xe0, 0x87, 0x77, 0x2a, 0x9d, 0xb1, 0xe8, 0x74,
-	0x33, 0x02, 0x76, 0xc2, 0x24, 0xd3, 0x0b, 0x78, 0x54, 0xcb, 0x61, 0x07, 0xec, 0x17, 0x65, 0x81,
-	0xe1, 0x0c, 0x9c, 0x1b, 0x3c, 0xfc, 0xc8, 0xf2, 0x1d, 0x12, 0x1f, 0xda, 0x0a, 0x5f, 0x4b, 0x87,
-	0x29, 0x51, 0xc1, 0xb8, 0x57, 0xae, 0x3a, 0xd5, 0x28, 0xe1, 0x5f, 0x16, 0xf8, 0xaa, 0x51, 0x33,
-	0xdb, 0x0b, 0x26, 0x19, 0xf9, 0x10, 0x3a, 0xe6, 0xde, 0xf5, 0x64, 0x83, 0x4b, 0x4a, 0xd0, 0xda,
-	0xab, 0xae, 0xac, 0xa0, 0x88, 0xce, 0x20, 0x75, 0x94, 0x61, 0xa1, 0x60, 0xfd, 0xb8, 0x9e, 0xb4,
-	0xad, 0x61, 0x7a, 0x74, 0x67, 0xb9, 0x66, 0x50, 0xb3, 0x02, 0x09, 0xa0, 0xbb, 0x47, 0x2e, 0x54,
-	0x4b, 0x5b, 0xd7, 0x69, 0x54, 0xf2, 0x09, 0xd8, 0xaa, 0x79, 0x4d, 0xce, 0xc7, 0xff, 0x83, 0xb6,
-	0x3a, 0x0e, 0xd5, 0x81, 0xe1, 0x35, 0xc0, 0x42, 0x96, 0x1c, 0xe7, 0x09, 0x16, 0x92, 0x3c, 0x01,
-	0x88, 0xf3, 0x9d, 0x90, 0xc8, 0x4f, 0xff, 0x9f, 0x5b, 0x5b, 0xe6, 0x09, 0x79, 0x0f, 0x1c, 0xa1,
-	0x82, 0x95, 0xd3, 0x2c, 0xd0, 0x15, 0x26, 0x39, 0x5c, 0xc2, 0x40, 0x01, 0xf3, 0x7d, 0x19, 0xb3,
-	0xdc, 0x10, 0xf1, 0x53, 0x80, 0x35, 0xe3, 0x49, 0x24, 0x94, 0x56, 0x43, 0x43, 0x8e, 0xbf, 0xd7,
-	0x4b, 0xc6, 0x0d, 0x61, 0xa9, 0xbb, 0x6e, 0x44, 0xd5, 0x3e, 0x67, 0x42, 0x46, 0x86, 0xc0, 0xa6,
-	0x83, 0xab, 0x2c, 0x73, 0x65, 0x08, 0x7f, 0xb3, 0x4c, 0x93, 0xaf, 0xaa, 0x2a, 0x3f, 0x98, 0x8c,
-	0xf7, 0xa1, 0xcf, 0xaa, 0x2a, 0xcf, 0x30, 0x89, 0xce, 0x59, 0xef, 0xd5, 0x46, 0x9d, 0x47, 0xbe,
-	0x83, 0x77, 0x64, 0xf3, 0x93, 0xd4, 0xe3, 0x98, 0xd7, 0xe3, 0xe9, 0x3d, 0x1c, 0xba, 0xfc, 0x9d,
-	0xe8, 0x40, 0x5e, 0xe8, 0xe1, 0xcf, 0xe0, 0x9b, 0xb3, 0x9e, 0x6d, 0x3a, 0x85, 0xab, 0xd3, 0x92,
-	0x83, 0x59, 0x70, 0xa7, 0xaa, 0x7a, 0x69, 0x4c, 0x31, 0x13, 0x76, 0x46, 0x98, 0xd6, 0xdb, 0x08,
-	0xf3, 0xec, 0x39, 0xb8, 0xc7, 0x5c, 0x02, 0xd0, 0xf9, 0xa1, 0xe4, 0x5b, 0x96, 0xfb, 0x0f, 0x88,
-	0x07, 0x8e, 0xc6, 0x20, 0x2b, 0x56, 0xbe, 0x45, 0xfa, 0xe0, 0x1e, 0x9f, 0x12, 0xbf, 0xf5, 0x75,
-	0xf8, 0xe7, 0xed, 0xc8, 0xfa, 0xfb, 0x76, 0x64, 0xfd, 0x73, 0x3b, 0xb2, 0x7e, 0xff, 0x77, 0xf4,
-	0x00, 0xfc, 0x92, 0xaf, 0xa6, 0x32, 0xdb, 0xec, 0xa7, 0x9b, 0xbd, 0x7e, 0x76, 0x97, 0x1d, 0xfd,
-	0xf9, 0xec, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x25, 0x56, 0x20, 0x59, 0xc0, 0x05, 0x00, 0x00,
+func init() { proto.RegisterFile("raft_serverpb.proto", fileDescriptor_raft_serverpb_38c7b8e0ea68aa8f) }
+
+var fileDescriptor_raft_serverpb_38c7b8e0ea68aa8f = []byte{
+	// 1074 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xef, 0xda, 0x8e, 0xbd, 0x7e, 0xfe, 0x13, 0x6b, 0x8a, 0xe8, 0x36, 0x6d, 0x23, 0x77, 0x11,
+	0x21, 0x14, 0xc9, 0x40, 0x40, 0x08, 0x21, 0x84, 0xd4, 0x50, 0xa2, 0x98, 0x36, 0xa8, 0x9a, 0x54,
+	0x95, 0x38, 0xad, 0xc6, 0xbb, 0xcf, 0xf6, 0xca, 0xde, 0x1d, 0x33, 0x33, 0xb6, 0x12, 0x6e, 0x7c,
+	0x02, 0xae, 0x9c, 0xf9, 0x34, 0xdc, 0xe0, 0xc8, 0x11, 0x85, 0x13, 0xdf, 0x02, 0xcd, 0x9f, 0x5d,
+	0xdb, 0x51, 0xd2, 0x9e, 0xfc, 0xde, 0xfb, 0xbd, 0x37, 0xf3, 0x7b, 0x6f, 0x7e, 0xb3, 0x63, 0xb8,
+	0x2b, 0xd8, 0x58, 0x45, 0x12, 0xc5, 0x0a, 0xc5, 0x62, 0x34, 0x58, 0x08, 0xae, 0x38, 0xe9, 0x6c,
+	0x05, 0xf7, 0x3a, 0xa8, 0xfd, 0x02, 0xdd, 0x6b, 0x67, 0xa8, 0x58, 0xe1, 0x85, 0xbf, 0x57, 0xa1,
+	0x45, 0xd9, 0x58, 0x9d, 0xa1, 0x94, 0x6c, 0x82, 0xe4, 0x01, 0x34, 0x05, 0x4e, 0x52, 0x9e, 0x47,
+	0x69, 0x12, 0x78, 0x7d, 0xef, 0xb0, 0x46, 0x7d, 0x1b, 0x18, 0x26, 0xe4, 0x43, 0x68, 0x8e, 0x05,
+	0xcf, 0xa2, 0x05, 0xa2, 0x08, 0x2a, 0x7d, 0xef, 0xb0, 0x75, 0xd4, 0x1e, 0xb8, 0xe5, 0x5e, 0x22,
+	0x0a, 0xea, 0x6b, 0x58, 0x5b, 0xe4, 0x7d, 0x68, 0x28, 0x6e, 0x13, 0xab, 0x37, 0x24, 0xd6, 0x15,
+	0x37, 0x69, 0x4f, 0xa0, 0x91, 0xd9, 0x9d, 0x83, 0x9a, 0x49, 0xeb, 0x0d, 0x0a, 0xb6, 0x8e, 0x11,
+	0x2d, 0x12, 0xc8, 0x17, 0xd0, 0x76, 0xd4, 0x70, 0xc1, 0xe3, 0x69, 0xb0, 0x63, 0x0a, 0xee, 0x16,
+	0xeb, 0x52, 0x83, 0x7d, 0xa7, 0x21, 0xda, 0x12, 0x6b, 0x87, 0x3c, 0x86, 0x76, 0x2a, 0x23, 0xc5,
+	0xb3, 0x91, 0x54, 0x3c, 0xc7, 0xa0, 0xde, 0xf7, 0x0e, 0x7d, 0xda, 0x4a, 0xe5, 0xab, 0x22, 0xa4,
+	0xbb, 0x96, 0x8a, 0x09, 0x15, 0xcd, 0xf0, 0x32, 0x68, 0xf4, 0xbd, 0xc3, 0x36, 0xf5, 0x4d, 0xe0,
+	0x39, 0x5e, 0x92, 0x7b, 0xd0, 0xc0, 0x3c, 0x31, 0x90, 0x6f, 0xa0, 0x3a, 0xe6, 0x89, 0x06, 0xde,
+	0x85, 0xba, 0xc0, 0x05, 0x4b, 0x45, 0xd0, 0x34, 0x4b, 0x3a, 0x8f, 0x7c, 0x00, 0xbb, 0x69, 0x3e,
+	0x4f, 0x73, 0x8c, 0x64, 0xce, 0x16, 0x72, 0xca, 0x55, 0x00, 0xa6, 0xb0, 0x6b, 0xc3, 0xe7, 0x2e,
+	0x4a, 0x0e, 0x60, 0x77, 0xb4, 0x94, 0x97, 0xd1, 0x88, 0xc5, 0x33, 0x3e, 0x1e, 0x47, 0x99, 0x0c,
+	0x5a, 0x66, 0xe4, 0x1d, 0x1d, 0x3e, 0xb6, 0xd1, 0x33, 0x19, 0xfe, 0x5a, 0x81, 0xde, 0x31, 0x53,
+	0xf1, 0x74, 0xf3, 0xa4, 0x06, 0x50, 0xcb, 0xe4, 0x44, 0x06, 0x5e, 0xbf, 0x7a, 0xd8, 0x3a, 0xda,
+	0x1b, 0x6c, 0x2b, 0x61, 0x23, 0x93, 0x9a, 0x3c, 0xf2, 0x35, 0xc0, 0x14, 0x99, 0x50, 0x23, 0x64,
+	0x4a, 0x06, 0x15, 0x53, 0xf5, 0xf0, 0x86, 0xaa, 0xd3, 0x22, 0x89, 0x6e, 0xe4, 0x93, 0x10, 0x3a,
+	0xe6, 0xe8, 0xa5, 0xe2, 0x02, 0xb5, 0x36, 0xaa, 0x86, 0x68, 0x4b, 0x07, 0xcf, 0x75, 0x6c, 0x98,
+	0x90, 0x7d, 0x68, 0x29, 0xbe, 0xce, 0xa8, 0x99, 0x8c, 0xa6, 0xe2, 0x05, 0x6e, 0xa6, 0x2c, 0x90,
+	0x65, 0x1a, 0xdd, 0xb1, 0xda, 0xb2, 0x81, 0x61, 0x42, 0x7a, 0x50, 0x95, 0xf8, 0x93, 0x39, 0x9c,
+	0x1a, 0xd5, 0x26, 0xd9, 0x03, 0x3f, 0x9e, 0x62, 0x3c, 0x93, 0xcb, 0xcc, 0x9c, 0x49, 0x87, 0x96,
+	0x7e, 0xf8, 0x9f, 0x07, 0x9d, 0x2d, 0xb2, 0x6f, 0x16, 0x6e, 0x1f, 0xda, 0xa5, 0x70, 0x35, 0x5e,
+	0x31, 0x38, 0x14, 0x6a, 0x1d, 0x26, 0xe4, 0x21, 0x80, 0xe2, 0x25, 0x6e, 0x9b, 0xf3, 0xad, 0x48,
+	0x87, 0x09, 0xb9, 0x0f, 0x7e, 0xcc, 0xf3, 0x71, 0xb4, 0x42, 0xe1, 0xda, 0x6a, 0x68, 0xff, 0x35,
+	0x0a, 0x12, 0x40, 0x63, 0x85, 0x42, 0xa6, 0x3c, 0x77, 0x2d, 0x15, 0xae, 0xe6, 0x2f, 0x50, 0x2e,
+	0x78, 0x2e, 0x0b, 0xcd, 0x95, 0x3e, 0x21, 0x50, 0x53, 0x28, 0x6c, 0x5f, 0x35, 0x6a, 0x6c, 0x2d,
+	0xa7, 0x98, 0x67, 0x59, 0xaa, 0x8c, 0xcc, 0x6a, 0xd4, 0x79, 0xe1, 0x37, 0x40, 0x74, 0xab, 0xaf,
+	0xc4, 0x32, 0x8f, 0x99, 0xc2, 0xe4, 0x5c, 0x31, 0x85, 0xe4, 0x1d, 0xd8, 0x49, 0xf3, 0x04, 0x2f,
+	0x5c, 0xaf, 0xd6, 0x29, 0xd7, 0xad, 0xac, 0xd7, 0x0d, 0x5f, 0x42, 0xb7, 0x50, 0xdc, 0xb7, 0x27,
+	0x27, 0xe9, 0x1c, 0x49, 0x17, 0x2a, 0xf1, 0xd8, 0x14, 0x36, 0x69, 0x25, 0x1e, 0xeb, 0x2a, 0x99,
+	0xfe, 0x8c, 0x45, 0x95, 0xb6, 0xb7, 0xa6, 0x5f, 0xbd, 0x36, 0xfd, 0x53, 0x68, 0x17, 0x2b, 0x9e,
+	0xa1, 0x62, 0xe4, 0x4b, 0xf0, 0xe3, 0x71, 0x34, 0x4e, 0xe7, 0x58, 0xc8, 0xf1, 0xd1, 0x35, 0x61,
+	0x6d, 0x13, 0xa0, 0x8d, 0x78, 0xac, 0x7f, 0x65, 0xf8, 0x23, 0x74, 0x4a, 0x68, 0xba, 0xcc, 0x67,
+	0xe4, 0xf3, 0xf5, 0x07, 0xc1, 0xeb, 0x7b, 0x6f, 0x11, 0x76, 0x91, 0xaa, 0x1b, 0x48, 0x98, 0x62,
+	0xa6, 0x81, 0x36, 0x35, 0x76, 0x58, 0x87, 0xda, 0x33, 0x9e, 0x63, 0x78, 0x04, 0xfe, 0x73, 0xbc,
+	0x7c, 0xcd, 0xe6, 0x4b, 0xd4, 0x22, 0xd3, 0xd7, 0xd8, 0x33, 0x69, 0xda, 0xd4, 0x63, 0x5c, 0x69,
+	0xc8, 0x95, 0x5a, 0x27, 0xfc, 0xd3, 0x83, 0x9e, 0xde, 0xa8, 0xe0, 0xf6, 0x8c, 0x29, 0x46, 0x0e,
+	0xa0, 0x6e, 0x05, 0xe5, 0x98, 0x75, 0xb7, 0xbf, 0x3c, 0xd4, 0xa1, 0x5a, 0x89, 0x7a, 0x14, 0xd1,
+	0xc6, 0x48, 0x7d, 0x1d, 0x38, 0xd7, 0x63, 0xfd, 0xc8, 0x31, 0xad, 0x9a, 0x31, 0xdd, 0xbb, 0xd6,
+	0x5c, 0x41, 0xd4, 0xb6, 0xb0, 0xa9, 0xad, 0xda, 0xb6, 0xb6, 0x3e, 0x86, 0x9a, 0xde, 0xdc, 0x7d,
+	0x03, 0x1f, 0xdc, 0x32, 0x6d, 0x7d, 0x38, 0xd4, 0x24, 0x86, 0x27, 0x00, 0xee, 0x1a, 0x62, 0xae,
+	0xc8, 0x23, 0x80, 0x78, 0xbe, 0x94, 0xca, 0xaa, 0xdd, 0x2a, 0xa8, 0xe9, 0x22, 0x56, 0xee, 0xe5,
+	0x2d, 0xb6, 0x0d, 0x34, 0xa4, 0x2d, 0x0e, 0x47, 0xd0, 0xd5, 0x83, 0x79, 0xc1, 0x63, 0x36, 0xb7,
+	0x42, 0xfc, 0x14, 0x60, 0xca, 0x44, 0x12, 0x49, 0xed, 0xb9, 0xd1, 0x90, 0xf2, 0x2b, 0x7e, 0xca,
+	0x84, 0x15, 0x2c, 0x6d, 0x4e, 0x0b, 0x53, 0x6f, 0x3f, 0x67, 0x52, 0x45, 0x56, 0xc0, 0x76, 0x87,
+	0xa6, 0x8e, 0x0c, 0x75, 0x20, 0xfc, 0xc5, 0xb3, 0x9b, 0x3c, 0x5d, 0x2c, 0xe6, 0x97, 0xb6, 0xe2,
+	0x3d, 0xe8, 0xb0, 0xc5, 0x62, 0x9e, 0x62, 0x12, 0x6d, 0xaa, 0xbe, 0xed, 0x82, 0xa6, 0x8e, 0x7c,
+	0x0f, 0xbb, 0xaa, 0xb8, 0x24, 0x8e, 0x8e, 0x7d, 0xa4, 0x1e, 0xdf, 0xa0, 0xa1, 0xed, 0xeb, 0x44,
+	0xbb, 0x6a, 0xcb, 0x0f, 0x5f, 0x00, 0x9c, 0xa1, 0x98, 0xa0, 0xdd, 0xfe, 0x00, 0xea, 0x8a, 0x89,
+	0x09, 0xaa, 0xdb, 0x8e, 0xde, 0xa2, 0x1b, 0x57, 0xb8, 0xb2, 0x75, 0x85, 0xff, 0xd6, 0x7a, 0x32,
+	0xa9, 0x1b, 0x83, 0x1b, 0xc0, 0xce, 0x7a, 0x66, 0xdd, 0xa3, 0xe0, 0x1a, 0x49, 0xfd, 0xe9, 0xb1,
+	0xdc, 0x76, 0x64, 0x41, 0xc2, 0xe9, 0xaf, 0xf2, 0x46, 0xfd, 0x7d, 0x02, 0xcd, 0x8c, 0x5d, 0xb8,
+	0x47, 0xb2, 0x7a, 0xfb, 0x23, 0xe9, 0x67, 0xec, 0xc2, 0x58, 0xe4, 0x2b, 0x68, 0x65, 0xba, 0x59,
+	0x37, 0x34, 0xfb, 0x12, 0xdf, 0xbf, 0xc6, 0x67, 0x3d, 0x0e, 0x0a, 0x59, 0x69, 0x3f, 0x79, 0x0a,
+	0xcd, 0x92, 0x29, 0x01, 0xa8, 0xff, 0xc0, 0x45, 0xc6, 0xe6, 0xbd, 0x3b, 0xa4, 0x0d, 0xbe, 0x39,
+	0xc0, 0x34, 0x9f, 0xf4, 0x3c, 0xd2, 0x81, 0x66, 0xf9, 0xdc, 0xf6, 0x2a, 0xa4, 0x05, 0x0d, 0xbd,
+	0x9e, 0xc6, 0xaa, 0xc7, 0xe1, 0x1f, 0x57, 0xfb, 0xde, 0x5f, 0x57, 0xfb, 0xde, 0x3f, 0x57, 0xfb,
+	0xde, 0x6f, 0xff, 0xee, 0xdf, 0x81, 0x1e, 0x17, 0x93, 0x81, 0x4a, 0x67, 0xab, 0xc1, 0x6c, 0x65,
+	0xfe, 0xa7, 0x8c, 0xea, 0xe6, 0xe7, 0xb3, 0xff, 0x07, 0x00, 0x80, 0xdb, 0x91, 0x07, 0xf1, 0x08,
+	0x00, 0x00,
 }
@@ -12,8 +12,12 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 
+	coprocessor "github.com/pingcap-incubator/tinykv/proto/pkg/coprocessor"
+
 	kvrpcpb "github.com/pingcap-incubator/tinykv/proto/pkg/kvrpcpb"
 
+	raft_cmdpb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_cmdpb"
+
 	raft_serverpb "github.com/pingcap-incubator/tinykv/proto/pkg/raft_serverpb"
 
 	context "golang.org/x/net/context"
@@ -43,7 +47,7 @@ func (m *BatchRaftMessage) Reset()         { *m = BatchRaftMessage{} }
 func (m *BatchRaftMessage) String() string { return proto.CompactTextString(m) }
 func (*BatchRaftMessage) ProtoMessage()    {}
 func (*BatchRaftMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_tikvpb_78b381e194c522ab, []int{0}
+	return fileDescriptor_tikvpb_25b04ead2f1a5fb8, []int{0}
 }
 func (m *BatchRaftMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -79,754 +83,4862 @@ func (m *BatchRaftMessage) GetMsgs() []*raft_serverpb.RaftMessage {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*BatchRaftMessage)(nil), "tikvpb.BatchRaftMessage")
+// A batch of the requests of a BatchCommands stream. Each request is tagged with the id at the same index of
+// request_ids, its response carries the id back, as the responses of a batch may be sent in any order and in
+// different batches.
+type BatchCommandsRequest struct {
+	Requests             []*BatchCommand `protobuf:"bytes,1,rep,name=requests" json:"requests,omitempty"`
+	RequestIds           []uint64        `protobuf:"varint,2,rep,packed,name=request_ids,json=requestIds" json:"request_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *BatchCommandsRequest) Reset()         { *m = BatchCommandsRequest{} }
+func (m *BatchCommandsRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchCommandsRequest) ProtoMessage()    {}
+func (*BatchCommandsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tikvpb_25b04ead2f1a5fb8, []int{1}
+}
+func (m *BatchCommandsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchCommandsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchCommandsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (dst *BatchCommandsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCommandsRequest.Merge(dst, src)
+}
+func (m *BatchCommandsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchCommandsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCommandsRequest.DiscardUnknown(m)
+}
 
-// Client API for Tikv service
+var xxx_messageInfo_BatchCommandsRequest proto.InternalMessageInfo
 
-type TikvClient interface {
-	// KV commands with mvcc/txn supported.
-	KvGet(ctx context.Context, in *kvrpcpb.GetRequest, opts ...grpc.CallOption) (*kvrpcpb.GetResponse, error)
-	KvScan(ctx context.Context, in *kvrpcpb.ScanRequest, opts ...grpc.CallOption) (*kvrpcpb.ScanResponse, error)
-	KvPrewrite(ctx context.Context, in *kvrpcpb.PrewriteRequest, opts ...grpc.CallOption) (*kvrpcpb.PrewriteResponse, error)
-	KvCommit(ctx context.Context, in *kvrpcpb.CommitRequest, opts ...grpc.CallOption) (*kvrpcpb.CommitResponse, error)
-	KvCheckTxnStatus(ctx context.Context, in *kvrpcpb.CheckTxnStatusRequest, opts ...grpc.CallOption) (*kvrpcpb.CheckTxnStatusResponse, error)
-	KvCleanup(ctx context.Context, in *kvrpcpb.CleanupRequest, opts ...grpc.CallOption) (*kvrpcpb.CleanupResponse, error)
-	KvBatchGet(ctx context.Context, in *kvrpcpb.BatchGetRequest, opts ...grpc.CallOption) (*kvrpcpb.BatchGetResponse, error)
-	KvBatchRollback(ctx context.Context, in *kvrpcpb.BatchRollbackRequest, opts ...grpc.CallOption) (*kvrpcpb.BatchRollbackResponse, error)
-	KvScanLock(ctx context.Context, in *kvrpcpb.ScanLockRequest, opts ...grpc.CallOption) (*kvrpcpb.ScanLockResponse, error)
-	KvResolveLock(ctx context.Context, in *kvrpcpb.ResolveLockRequest, opts ...grpc.CallOption) (*kvrpcpb.ResolveLockResponse, error)
-	// RawKV commands.
-	RawGet(ctx context.Context, in *kvrpcpb.RawGetRequest, opts ...grpc.CallOption) (*kvrpcpb.RawGetResponse, error)
-	RawPut(ctx context.Context, in *kvrpcpb.RawPutRequest, opts ...grpc.CallOption) (*kvrpcpb.RawPutResponse, error)
-	RawDelete(ctx context.Context, in *kvrpcpb.RawDeleteRequest, opts ...grpc.CallOption) (*kvrpcpb.RawDeleteResponse, error)
-	RawScan(ctx context.Context, in *kvrpcpb.RawScanRequest, opts ...grpc.CallOption) (*kvrpcpb.RawScanResponse, error)
-	// Raft commands (tinykv <-> tinykv).
-	Raft(ctx context.Context, opts ...grpc.CallOption) (Tikv_RaftClient, error)
-	Snapshot(ctx context.Context, opts ...grpc.CallOption) (Tikv_SnapshotClient, error)
+func (m *BatchCommandsRequest) GetRequests() []*BatchCommand {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
 }
 
-type tikvClient struct {
-	cc *grpc.ClientConn
+func (m *BatchCommandsRequest) GetRequestIds() []uint64 {
+	if m != nil {
+		return m.RequestIds
+	}
+	return nil
 }
 
-func NewTikvClient(cc *grpc.ClientConn) TikvClient {
-	return &tikvClient{cc}
+type BatchCommandsResponse struct {
+	Responses            []*BatchCommandResponse `protobuf:"bytes,1,rep,name=responses" json:"responses,omitempty"`
+	RequestIds           []uint64                `protobuf:"varint,2,rep,packed,name=request_ids,json=requestIds" json:"request_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func (c *tikvClient) KvGet(ctx context.Context, in *kvrpcpb.GetRequest, opts ...grpc.CallOption) (*kvrpcpb.GetResponse, error) {
-	out := new(kvrpcpb.GetResponse)
-	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvGet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BatchCommandsResponse) Reset()         { *m = BatchCommandsResponse{} }
+func (m *BatchCommandsResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchCommandsResponse) ProtoMessage()    {}
+func (*BatchCommandsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tikvpb_25b04ead2f1a5fb8, []int{2}
+}
+func (m *BatchCommandsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchCommandsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchCommandsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *BatchCommandsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCommandsResponse.Merge(dst, src)
+}
+func (m *BatchCommandsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchCommandsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCommandsResponse.DiscardUnknown(m)
 }
 
-func (c *tikvClient) KvScan(ctx context.Context, in *kvrpcpb.ScanRequest, opts ...grpc.CallOption) (*kvrpcpb.ScanResponse, error) {
-	out := new(kvrpcpb.ScanResponse)
-	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvScan", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BatchCommandsResponse proto.InternalMessageInfo
+
+func (m *BatchCommandsResponse) GetResponses() []*BatchCommandResponse {
+	if m != nil {
+		return m.Responses
 	}
-	return out, nil
+	return nil
 }
 
-func (c *tikvClient) KvPrewrite(ctx context.Context, in *kvrpcpb.PrewriteRequest, opts ...grpc.CallOption) (*kvrpcpb.PrewriteResponse, error) {
-	out := new(kvrpcpb.PrewriteResponse)
-	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvPrewrite", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BatchCommandsResponse) GetRequestIds() []uint64 {
+	if m != nil {
+		return m.RequestIds
 	}
-	return out, nil
+	return nil
 }
 
-func (c *tikvClient) KvCommit(ctx context.Context, in *kvrpcpb.CommitRequest, opts ...grpc.CallOption) (*kvrpcpb.CommitResponse, error) {
-	out := new(kvrpcpb.CommitResponse)
-	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// A request of a BatchCommands stream, exactly one of the fields is set.
+type BatchCommand struct {
+	Get                  *kvrpcpb.GetRequest            `protobuf:"bytes,1,opt,name=get" json:"get,omitempty"`
+	Scan                 *kvrpcpb.ScanRequest           `protobuf:"bytes,2,opt,name=scan" json:"scan,omitempty"`
+	Prewrite             *kvrpcpb.PrewriteRequest       `protobuf:"bytes,3,opt,name=prewrite" json:"prewrite,omitempty"`
+	Commit               *kvrpcpb.CommitRequest         `protobuf:"bytes,4,opt,name=commit" json:"commit,omitempty"`
+	CheckTxnStatus       *kvrpcpb.CheckTxnStatusRequest `protobuf:"bytes,5,opt,name=check_txn_status,json=checkTxnStatus" json:"check_txn_status,omitempty"`
+	TxnHeartBeat         *kvrpcpb.TxnHeartBeatRequest   `protobuf:"bytes,6,opt,name=txn_heart_beat,json=txnHeartBeat" json:"txn_heart_beat,omitempty"`
+	Cleanup              *kvrpcpb.CleanupRequest        `protobuf:"bytes,7,opt,name=cleanup" json:"cleanup,omitempty"`
+	BatchGet             *kvrpcpb.BatchGetRequest       `protobuf:"bytes,8,opt,name=batch_get,json=batchGet" json:"batch_get,omitempty"`
+	CheckConflicts       *kvrpcpb.CheckConflictsRequest `protobuf:"bytes,9,opt,name=check_conflicts,json=checkConflicts" json:"check_conflicts,omitempty"`
+	GetCommitTs          *kvrpcpb.GetCommitTsRequest    `protobuf:"bytes,10,opt,name=get_commit_ts,json=getCommitTs" json:"get_commit_ts,omitempty"`
+	BatchRollback        *kvrpcpb.BatchRollbackRequest  `protobuf:"bytes,11,opt,name=batch_rollback,json=batchRollback" json:"batch_rollback,omitempty"`
+	ScanLock             *kvrpcpb.ScanLockRequest       `protobuf:"bytes,12,opt,name=scan_lock,json=scanLock" json:"scan_lock,omitempty"`
+	ResolveLock          *kvrpcpb.ResolveLockRequest    `protobuf:"bytes,13,opt,name=resolve_lock,json=resolveLock" json:"resolve_lock,omitempty"`
+	GC                   *kvrpcpb.GCRequest             `protobuf:"bytes,14,opt,name=g_c,json=gC" json:"g_c,omitempty"`
+	DeleteRange          *kvrpcpb.DeleteRangeRequest    `protobuf:"bytes,15,opt,name=delete_range,json=deleteRange" json:"delete_range,omitempty"`
+	RawGet               *kvrpcpb.RawGetRequest         `protobuf:"bytes,16,opt,name=raw_get,json=rawGet" json:"raw_get,omitempty"`
+	RawPut               *kvrpcpb.RawPutRequest         `protobuf:"bytes,17,opt,name=raw_put,json=rawPut" json:"raw_put,omitempty"`
+	RawDelete            *kvrpcpb.RawDeleteRequest      `protobuf:"bytes,18,opt,name=raw_delete,json=rawDelete" json:"raw_delete,omitempty"`
+	RawScan              *kvrpcpb.RawScanRequest        `protobuf:"bytes,19,opt,name=raw_scan,json=rawScan" json:"raw_scan,omitempty"`
+	RawBatchGet          *kvrpcpb.RawBatchGetRequest    `protobuf:"bytes,20,opt,name=raw_batch_get,json=rawBatchGet" json:"raw_batch_get,omitempty"`
+	RawBatchPut          *kvrpcpb.RawBatchPutRequest    `protobuf:"bytes,21,opt,name=raw_batch_put,json=rawBatchPut" json:"raw_batch_put,omitempty"`
+	RawBatchDelete       *kvrpcpb.RawBatchDeleteRequest `protobuf:"bytes,22,opt,name=raw_batch_delete,json=rawBatchDelete" json:"raw_batch_delete,omitempty"`
+	Coprocessor          *coprocessor.Request           `protobuf:"bytes,23,opt,name=coprocessor" json:"coprocessor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
-func (c *tikvClient) KvCheckTxnStatus(ctx context.Context, in *kvrpcpb.CheckTxnStatusRequest, opts ...grpc.CallOption) (*kvrpcpb.CheckTxnStatusResponse, error) {
-	out := new(kvrpcpb.CheckTxnStatusResponse)
-	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvCheckTxnStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BatchCommand) Reset()         { *m = BatchCommand{} }
+func (m *BatchCommand) String() string { return proto.CompactTextString(m) }
+func (*BatchCommand) ProtoMessage()    {}
+func (*BatchCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tikvpb_25b04ead2f1a5fb8, []int{3}
+}
+func (m *BatchCommand) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchCommand.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (dst *BatchCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCommand.Merge(dst, src)
+}
+func (m *BatchCommand) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCommand.DiscardUnknown(m)
 }
 
-func (c *tikvClient) KvCleanup(ctx context.Context, in *kvrpcpb.CleanupRequest, opts ...grpc.CallOption) (*kvrpcpb.CleanupResponse, error) {
-	out := new(kvrpcpb.CleanupResponse)
-	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvCleanup", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BatchCommand proto.InternalMessageInfo
+
+func (m *BatchCommand) GetGet() *kvrpcpb.GetRequest {
+	if m != nil {
+		return m.Get
 	}
-	return out, nil
+	return nil
 }
 
-func (c *tikvClient) KvBatchGet(ctx context.Context, in *kvrpcpb.BatchGetRequest, opts ...grpc.CallOption) (*kvrpcpb.BatchGetResponse, error) {
-	out := new(kvrpcpb.BatchGetResponse)
-	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvBatchGet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BatchCommand) GetScan() *kvrpcpb.ScanRequest {
+	if m != nil {
+		return m.Scan
 	}
-	return out, nil
+	return nil
 }
 
-func (c *tikvClient) KvBatchRollback(ctx context.Context, in *kvrpcpb.BatchRollbackRequest, opts ...grpc.CallOption) (*kvrpcpb.BatchRollbackResponse, error) {
-	out := new(kvrpcpb.BatchRollbackResponse)
-	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvBatchRollback", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BatchCommand) GetPrewrite() *kvrpcpb.PrewriteRequest {
+	if m != nil {
+		return m.Prewrite
 	}
-	return out, nil
+	return nil
 }
 
-func (c *tikvClient) KvScanLock(ctx context.Context, in *kvrpcpb.ScanLockRequest, opts ...grpc.CallOption) (*kvrpcpb.ScanLockResponse, error) {
-	out := new(kvrpcpb.ScanLockResponse)
-	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvScanLock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BatchCommand) GetCommit() *kvrpcpb.CommitRequest {
+	if m != nil {
+		return m.Commit
 	}
-	return out, nil
+	return nil
 }
 
-func (c *tikvClient) KvResolveLock(ctx context.Context, in *kvrpcpb.ResolveLockRequest, opts ...grpc.CallOption) (*kvrpcpb.ResolveLockResponse, error) {
-	out := new(kvrpcpb.ResolveLockResponse)
-	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvResolveLock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BatchCommand) GetCheckTxnStatus() *kvrpcpb.CheckTxnStatusRequest {
+	if m != nil {
+		return m.CheckTxnStatus
 	}
-	return out, nil
+	return nil
 }
 
-func (c *tikvClient) RawGet(ctx context.Context, in *kvrpcpb.RawGetRequest, opts ...grpc.CallOption) (*kvrpcpb.RawGetResponse, error) {
-	out := new(kvrpcpb.RawGetResponse)
-	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/RawGet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BatchCommand) GetTxnHeartBeat() *kvrpcpb.TxnHeartBeatRequest {
+	if m != nil {
+		return m.TxnHeartBeat
 	}
-	return out, nil
+	return nil
 }
 
-func (c *tikvClient) RawPut(ctx context.Context, in *kvrpcpb.RawPutRequest, opts ...grpc.CallOption) (*kvrpcpb.RawPutResponse, error) {
-	out := new(kvrpcpb.RawPutResponse)
-	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/RawPut", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BatchCommand) GetCleanup() *kvrpcpb.CleanupRequest {
+	if m != nil {
+		return m.Cleanup
 	}
-	return out, nil
+	return nil
 }
 
-func (c *tikvClient) RawDelete(ctx context.Context, in *kvrpcpb.RawDeleteRequest, opts ...grpc.CallOption) (*kvrpcpb.RawDeleteResponse, error) {
-	out := new(kvrpcpb.RawDeleteResponse)
-	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/RawDelete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BatchCommand) GetBatchGet() *kvrpcpb.BatchGetRequest {
+	if m != nil {
+		return m.BatchGet
 	}
-	return out, nil
+	return nil
 }
 
-func (c *tikvClient) RawScan(ctx context.Context, in *kvrpcpb.RawScanRequest, opts ...grpc.CallOption) (*kvrpcpb.RawScanResponse, error) {
-	out := new(kvrpcpb.RawScanResponse)
-	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/RawScan", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BatchCommand) GetCheckConflicts() *kvrpcpb.CheckConflictsRequest {
+	if m != nil {
+		return m.CheckConflicts
 	}
-	return out, nil
+	return nil
 }
 
-func (c *tikvClient) Raft(ctx context.Context, opts ...grpc.CallOption) (Tikv_RaftClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[0], "/tikvpb.Tikv/Raft", opts...)
-	if err != nil {
-		return nil, err
+func (m *BatchCommand) GetGetCommitTs() *kvrpcpb.GetCommitTsRequest {
+	if m != nil {
+		return m.GetCommitTs
 	}
-	x := &tikvRaftClient{stream}
-	return x, nil
+	return nil
 }
 
-type Tikv_RaftClient interface {
-	Send(*raft_serverpb.RaftMessage) error
-	CloseAndRecv() (*raft_serverpb.Done, error)
-	grpc.ClientStream
+func (m *BatchCommand) GetBatchRollback() *kvrpcpb.BatchRollbackRequest {
+	if m != nil {
+		return m.BatchRollback
+	}
+	return nil
 }
 
-type tikvRaftClient struct {
-	grpc.ClientStream
+func (m *BatchCommand) GetScanLock() *kvrpcpb.ScanLockRequest {
+	if m != nil {
+		return m.ScanLock
+	}
+	return nil
 }
 
-func (x *tikvRaftClient) Send(m *raft_serverpb.RaftMessage) error {
-	return x.ClientStream.SendMsg(m)
+func (m *BatchCommand) GetResolveLock() *kvrpcpb.ResolveLockRequest {
+	if m != nil {
+		return m.ResolveLock
+	}
+	return nil
 }
 
-func (x *tikvRaftClient) CloseAndRecv() (*raft_serverpb.Done, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(raft_serverpb.Done)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *BatchCommand) GetGC() *kvrpcpb.GCRequest {
+	if m != nil {
+		return m.GC
 	}
-	return m, nil
+	return nil
 }
 
-func (c *tikvClient) Snapshot(ctx context.Context, opts ...grpc.CallOption) (Tikv_SnapshotClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[1], "/tikvpb.Tikv/Snapshot", opts...)
-	if err != nil {
-		return nil, err
+func (m *BatchCommand) GetDeleteRange() *kvrpcpb.DeleteRangeRequest {
+	if m != nil {
+		return m.DeleteRange
 	}
-	x := &tikvSnapshotClient{stream}
-	return x, nil
+	return nil
 }
 
-type Tikv_SnapshotClient interface {
-	Send(*raft_serverpb.SnapshotChunk) error
-	CloseAndRecv() (*raft_serverpb.Done, error)
-	grpc.ClientStream
+func (m *BatchCommand) GetRawGet() *kvrpcpb.RawGetRequest {
+	if m != nil {
+		return m.RawGet
+	}
+	return nil
 }
 
-type tikvSnapshotClient struct {
-	grpc.ClientStream
+func (m *BatchCommand) GetRawPut() *kvrpcpb.RawPutRequest {
+	if m != nil {
+		return m.RawPut
+	}
+	return nil
 }
 
-func (x *tikvSnapshotClient) Send(m *raft_serverpb.SnapshotChunk) error {
-	return x.ClientStream.SendMsg(m)
+func (m *BatchCommand) GetRawDelete() *kvrpcpb.RawDeleteRequest {
+	if m != nil {
+		return m.RawDelete
+	}
+	return nil
 }
 
-func (x *tikvSnapshotClient) CloseAndRecv() (*raft_serverpb.Done, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(raft_serverpb.Done)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *BatchCommand) GetRawScan() *kvrpcpb.RawScanRequest {
+	if m != nil {
+		return m.RawScan
 	}
-	return m, nil
+	return nil
 }
 
-// Server API for Tikv service
-
-type TikvServer interface {
-	// KV commands with mvcc/txn supported.
-	KvGet(context.Context, *kvrpcpb.GetRequest) (*kvrpcpb.GetResponse, error)
-	KvScan(context.Context, *kvrpcpb.ScanRequest) (*kvrpcpb.ScanResponse, error)
-	KvPrewrite(context.Context, *kvrpcpb.PrewriteRequest) (*kvrpcpb.PrewriteResponse, error)
-	KvCommit(context.Context, *kvrpcpb.CommitRequest) (*kvrpcpb.CommitResponse, error)
-	KvCheckTxnStatus(context.Context, *kvrpcpb.CheckTxnStatusRequest) (*kvrpcpb.CheckTxnStatusResponse, error)
-	KvCleanup(context.Context, *kvrpcpb.CleanupRequest) (*kvrpcpb.CleanupResponse, error)
-	KvBatchGet(context.Context, *kvrpcpb.BatchGetRequest) (*kvrpcpb.BatchGetResponse, error)
-	KvBatchRollback(context.Context, *kvrpcpb.BatchRollbackRequest) (*kvrpcpb.BatchRollbackResponse, error)
-	KvScanLock(context.Context, *kvrpcpb.ScanLockRequest) (*kvrpcpb.ScanLockResponse, error)
-	KvResolveLock(context.Context, *kvrpcpb.ResolveLockRequest) (*kvrpcpb.ResolveLockResponse, error)
-	// RawKV commands.
-	RawGet(context.Context, *kvrpcpb.RawGetRequest) (*kvrpcpb.RawGetResponse, error)
-	RawPut(context.Context, *kvrpcpb.RawPutRequest) (*kvrpcpb.RawPutResponse, error)
-	RawDelete(context.Context, *kvrpcpb.RawDeleteRequest) (*kvrpcpb.RawDeleteResponse, error)
-	RawScan(context.Context, *kvrpcpb.RawScanRequest) (*kvrpcpb.RawScanResponse, error)
-	// Raft commands (tinykv <-> tinykv).
-	Raft(Tikv_RaftServer) error
-	Snapshot(Tikv_SnapshotServer) error
+func (m *BatchCommand) GetRawBatchGet() *kvrpcpb.RawBatchGetRequest {
+	if m != nil {
+		return m.RawBatchGet
+	}
+	return nil
 }
 
-func RegisterTikvServer(s *grpc.Server, srv TikvServer) {
-	s.RegisterService(&_Tikv_serviceDesc, srv)
+func (m *BatchCommand) GetRawBatchPut() *kvrpcpb.RawBatchPutRequest {
+	if m != nil {
+		return m.RawBatchPut
+	}
+	return nil
 }
 
-func _Tikv_KvGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(kvrpcpb.GetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TikvServer).KvGet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tikvpb.Tikv/KvGet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TikvServer).KvGet(ctx, req.(*kvrpcpb.GetRequest))
+func (m *BatchCommand) GetRawBatchDelete() *kvrpcpb.RawBatchDeleteRequest {
+	if m != nil {
+		return m.RawBatchDelete
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Tikv_KvScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(kvrpcpb.ScanRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TikvServer).KvScan(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tikvpb.Tikv/KvScan",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TikvServer).KvScan(ctx, req.(*kvrpcpb.ScanRequest))
+func (m *BatchCommand) GetCoprocessor() *coprocessor.Request {
+	if m != nil {
+		return m.Coprocessor
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Tikv_KvPrewrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(kvrpcpb.PrewriteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TikvServer).KvPrewrite(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tikvpb.Tikv/KvPrewrite",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TikvServer).KvPrewrite(ctx, req.(*kvrpcpb.PrewriteRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// The response to a BatchCommand, the field matching the request is set.
+type BatchCommandResponse struct {
+	Get                  *kvrpcpb.GetResponse            `protobuf:"bytes,1,opt,name=get" json:"get,omitempty"`
+	Scan                 *kvrpcpb.ScanResponse           `protobuf:"bytes,2,opt,name=scan" json:"scan,omitempty"`
+	Prewrite             *kvrpcpb.PrewriteResponse       `protobuf:"bytes,3,opt,name=prewrite" json:"prewrite,omitempty"`
+	Commit               *kvrpcpb.CommitResponse         `protobuf:"bytes,4,opt,name=commit" json:"commit,omitempty"`
+	CheckTxnStatus       *kvrpcpb.CheckTxnStatusResponse `protobuf:"bytes,5,opt,name=check_txn_status,json=checkTxnStatus" json:"check_txn_status,omitempty"`
+	TxnHeartBeat         *kvrpcpb.TxnHeartBeatResponse   `protobuf:"bytes,6,opt,name=txn_heart_beat,json=txnHeartBeat" json:"txn_heart_beat,omitempty"`
+	Cleanup              *kvrpcpb.CleanupResponse        `protobuf:"bytes,7,opt,name=cleanup" json:"cleanup,omitempty"`
+	BatchGet             *kvrpcpb.BatchGetResponse       `protobuf:"bytes,8,opt,name=batch_get,json=batchGet" json:"batch_get,omitempty"`
+	CheckConflicts       *kvrpcpb.CheckConflictsResponse `protobuf:"bytes,9,opt,name=check_conflicts,json=checkConflicts" json:"check_conflicts,omitempty"`
+	GetCommitTs          *kvrpcpb.GetCommitTsResponse    `protobuf:"bytes,10,opt,name=get_commit_ts,json=getCommitTs" json:"get_commit_ts,omitempty"`
+	BatchRollback        *kvrpcpb.BatchRollbackResponse  `protobuf:"bytes,11,opt,name=batch_rollback,json=batchRollback" json:"batch_rollback,omitempty"`
+	ScanLock             *kvrpcpb.ScanLockResponse       `protobuf:"bytes,12,opt,name=scan_lock,json=scanLock" json:"scan_lock,omitempty"`
+	ResolveLock          *kvrpcpb.ResolveLockResponse    `protobuf:"bytes,13,opt,name=resolve_lock,json=resolveLock" json:"resolve_lock,omitempty"`
+	GC                   *kvrpcpb.GCResponse             `protobuf:"bytes,14,opt,name=g_c,json=gC" json:"g_c,omitempty"`
+	DeleteRange          *kvrpcpb.DeleteRangeResponse    `protobuf:"bytes,15,opt,name=delete_range,json=deleteRange" json:"delete_range,omitempty"`
+	RawGet               *kvrpcpb.RawGetResponse         `protobuf:"bytes,16,opt,name=raw_get,json=rawGet" json:"raw_get,omitempty"`
+	RawPut               *kvrpcpb.RawPutResponse         `protobuf:"bytes,17,opt,name=raw_put,json=rawPut" json:"raw_put,omitempty"`
+	RawDelete            *kvrpcpb.RawDeleteResponse      `protobuf:"bytes,18,opt,name=raw_delete,json=rawDelete" json:"raw_delete,omitempty"`
+	RawScan              *kvrpcpb.RawScanResponse        `protobuf:"bytes,19,opt,name=raw_scan,json=rawScan" json:"raw_scan,omitempty"`
+	RawBatchGet          *kvrpcpb.RawBatchGetResponse    `protobuf:"bytes,20,opt,name=raw_batch_get,json=rawBatchGet" json:"raw_batch_get,omitempty"`
+	RawBatchPut          *kvrpcpb.RawBatchPutResponse    `protobuf:"bytes,21,opt,name=raw_batch_put,json=rawBatchPut" json:"raw_batch_put,omitempty"`
+	RawBatchDelete       *kvrpcpb.RawBatchDeleteResponse `protobuf:"bytes,22,opt,name=raw_batch_delete,json=rawBatchDelete" json:"raw_batch_delete,omitempty"`
+	Coprocessor          *coprocessor.Response           `protobuf:"bytes,23,opt,name=coprocessor" json:"coprocessor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
-func _Tikv_KvCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(kvrpcpb.CommitRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(TikvServer).KvCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tikvpb.Tikv/KvCommit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TikvServer).KvCommit(ctx, req.(*kvrpcpb.CommitRequest))
+func (m *BatchCommandResponse) Reset()         { *m = BatchCommandResponse{} }
+func (m *BatchCommandResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchCommandResponse) ProtoMessage()    {}
+func (*BatchCommandResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_tikvpb_25b04ead2f1a5fb8, []int{4}
+}
+func (m *BatchCommandResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchCommandResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchCommandResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (dst *BatchCommandResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCommandResponse.Merge(dst, src)
+}
+func (m *BatchCommandResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchCommandResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCommandResponse.DiscardUnknown(m)
 }
 
-func _Tikv_KvCheckTxnStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(kvrpcpb.CheckTxnStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_BatchCommandResponse proto.InternalMessageInfo
+
+func (m *BatchCommandResponse) GetGet() *kvrpcpb.GetResponse {
+	if m != nil {
+		return m.Get
 	}
-	if interceptor == nil {
-		return srv.(TikvServer).KvCheckTxnStatus(ctx, in)
+	return nil
+}
+
+func (m *BatchCommandResponse) GetScan() *kvrpcpb.ScanResponse {
+	if m != nil {
+		return m.Scan
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tikvpb.Tikv/KvCheckTxnStatus",
+	return nil
+}
+
+func (m *BatchCommandResponse) GetPrewrite() *kvrpcpb.PrewriteResponse {
+	if m != nil {
+		return m.Prewrite
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TikvServer).KvCheckTxnStatus(ctx, req.(*kvrpcpb.CheckTxnStatusRequest))
+	return nil
+}
+
+func (m *BatchCommandResponse) GetCommit() *kvrpcpb.CommitResponse {
+	if m != nil {
+		return m.Commit
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Tikv_KvCleanup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(kvrpcpb.CleanupRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BatchCommandResponse) GetCheckTxnStatus() *kvrpcpb.CheckTxnStatusResponse {
+	if m != nil {
+		return m.CheckTxnStatus
 	}
-	if interceptor == nil {
-		return srv.(TikvServer).KvCleanup(ctx, in)
+	return nil
+}
+
+func (m *BatchCommandResponse) GetTxnHeartBeat() *kvrpcpb.TxnHeartBeatResponse {
+	if m != nil {
+		return m.TxnHeartBeat
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tikvpb.Tikv/KvCleanup",
+	return nil
+}
+
+func (m *BatchCommandResponse) GetCleanup() *kvrpcpb.CleanupResponse {
+	if m != nil {
+		return m.Cleanup
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TikvServer).KvCleanup(ctx, req.(*kvrpcpb.CleanupRequest))
+	return nil
+}
+
+func (m *BatchCommandResponse) GetBatchGet() *kvrpcpb.BatchGetResponse {
+	if m != nil {
+		return m.BatchGet
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Tikv_KvBatchGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(kvrpcpb.BatchGetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BatchCommandResponse) GetCheckConflicts() *kvrpcpb.CheckConflictsResponse {
+	if m != nil {
+		return m.CheckConflicts
 	}
-	if interceptor == nil {
-		return srv.(TikvServer).KvBatchGet(ctx, in)
+	return nil
+}
+
+func (m *BatchCommandResponse) GetGetCommitTs() *kvrpcpb.GetCommitTsResponse {
+	if m != nil {
+		return m.GetCommitTs
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tikvpb.Tikv/KvBatchGet",
+	return nil
+}
+
+func (m *BatchCommandResponse) GetBatchRollback() *kvrpcpb.BatchRollbackResponse {
+	if m != nil {
+		return m.BatchRollback
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TikvServer).KvBatchGet(ctx, req.(*kvrpcpb.BatchGetRequest))
+	return nil
+}
+
+func (m *BatchCommandResponse) GetScanLock() *kvrpcpb.ScanLockResponse {
+	if m != nil {
+		return m.ScanLock
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Tikv_KvBatchRollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(kvrpcpb.BatchRollbackRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BatchCommandResponse) GetResolveLock() *kvrpcpb.ResolveLockResponse {
+	if m != nil {
+		return m.ResolveLock
 	}
-	if interceptor == nil {
-		return srv.(TikvServer).KvBatchRollback(ctx, in)
+	return nil
+}
+
+func (m *BatchCommandResponse) GetGC() *kvrpcpb.GCResponse {
+	if m != nil {
+		return m.GC
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tikvpb.Tikv/KvBatchRollback",
+	return nil
+}
+
+func (m *BatchCommandResponse) GetDeleteRange() *kvrpcpb.DeleteRangeResponse {
+	if m != nil {
+		return m.DeleteRange
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TikvServer).KvBatchRollback(ctx, req.(*kvrpcpb.BatchRollbackRequest))
+	return nil
+}
+
+func (m *BatchCommandResponse) GetRawGet() *kvrpcpb.RawGetResponse {
+	if m != nil {
+		return m.RawGet
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Tikv_KvScanLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(kvrpcpb.ScanLockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BatchCommandResponse) GetRawPut() *kvrpcpb.RawPutResponse {
+	if m != nil {
+		return m.RawPut
 	}
-	if interceptor == nil {
-		return srv.(TikvServer).KvScanLock(ctx, in)
+	return nil
+}
+
+func (m *BatchCommandResponse) GetRawDelete() *kvrpcpb.RawDeleteResponse {
+	if m != nil {
+		return m.RawDelete
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tikvpb.Tikv/KvScanLock",
+	return nil
+}
+
+func (m *BatchCommandResponse) GetRawScan() *kvrpcpb.RawScanResponse {
+	if m != nil {
+		return m.RawScan
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TikvServer).KvScanLock(ctx, req.(*kvrpcpb.ScanLockRequest))
+	return nil
+}
+
+func (m *BatchCommandResponse) GetRawBatchGet() *kvrpcpb.RawBatchGetResponse {
+	if m != nil {
+		return m.RawBatchGet
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Tikv_KvResolveLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(kvrpcpb.ResolveLockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *BatchCommandResponse) GetRawBatchPut() *kvrpcpb.RawBatchPutResponse {
+	if m != nil {
+		return m.RawBatchPut
 	}
-	if interceptor == nil {
-		return srv.(TikvServer).KvResolveLock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tikvpb.Tikv/KvResolveLock",
+	return nil
+}
+
+func (m *BatchCommandResponse) GetRawBatchDelete() *kvrpcpb.RawBatchDeleteResponse {
+	if m != nil {
+		return m.RawBatchDelete
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TikvServer).KvResolveLock(ctx, req.(*kvrpcpb.ResolveLockRequest))
+	return nil
+}
+
+func (m *BatchCommandResponse) GetCoprocessor() *coprocessor.Response {
+	if m != nil {
+		return m.Coprocessor
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Tikv_RawGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(kvrpcpb.RawGetRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*BatchRaftMessage)(nil), "tikvpb.BatchRaftMessage")
+	proto.RegisterType((*BatchCommandsRequest)(nil), "tikvpb.BatchCommandsRequest")
+	proto.RegisterType((*BatchCommandsResponse)(nil), "tikvpb.BatchCommandsResponse")
+	proto.RegisterType((*BatchCommand)(nil), "tikvpb.BatchCommand")
+	proto.RegisterType((*BatchCommandResponse)(nil), "tikvpb.BatchCommandResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Tikv service
+
+type TikvClient interface {
+	// KV commands with mvcc/txn supported.
+	KvGet(ctx context.Context, in *kvrpcpb.GetRequest, opts ...grpc.CallOption) (*kvrpcpb.GetResponse, error)
+	KvScan(ctx context.Context, in *kvrpcpb.ScanRequest, opts ...grpc.CallOption) (*kvrpcpb.ScanResponse, error)
+	KvPrewrite(ctx context.Context, in *kvrpcpb.PrewriteRequest, opts ...grpc.CallOption) (*kvrpcpb.PrewriteResponse, error)
+	KvCommit(ctx context.Context, in *kvrpcpb.CommitRequest, opts ...grpc.CallOption) (*kvrpcpb.CommitResponse, error)
+	KvCheckTxnStatus(ctx context.Context, in *kvrpcpb.CheckTxnStatusRequest, opts ...grpc.CallOption) (*kvrpcpb.CheckTxnStatusResponse, error)
+	KvTxnHeartBeat(ctx context.Context, in *kvrpcpb.TxnHeartBeatRequest, opts ...grpc.CallOption) (*kvrpcpb.TxnHeartBeatResponse, error)
+	KvCleanup(ctx context.Context, in *kvrpcpb.CleanupRequest, opts ...grpc.CallOption) (*kvrpcpb.CleanupResponse, error)
+	KvBatchGet(ctx context.Context, in *kvrpcpb.BatchGetRequest, opts ...grpc.CallOption) (*kvrpcpb.BatchGetResponse, error)
+	KvCheckConflicts(ctx context.Context, in *kvrpcpb.CheckConflictsRequest, opts ...grpc.CallOption) (*kvrpcpb.CheckConflictsResponse, error)
+	KvGetCommitTs(ctx context.Context, in *kvrpcpb.GetCommitTsRequest, opts ...grpc.CallOption) (*kvrpcpb.GetCommitTsResponse, error)
+	KvBatchRollback(ctx context.Context, in *kvrpcpb.BatchRollbackRequest, opts ...grpc.CallOption) (*kvrpcpb.BatchRollbackResponse, error)
+	KvScanLock(ctx context.Context, in *kvrpcpb.ScanLockRequest, opts ...grpc.CallOption) (*kvrpcpb.ScanLockResponse, error)
+	KvResolveLock(ctx context.Context, in *kvrpcpb.ResolveLockRequest, opts ...grpc.CallOption) (*kvrpcpb.ResolveLockResponse, error)
+	KvGC(ctx context.Context, in *kvrpcpb.GCRequest, opts ...grpc.CallOption) (*kvrpcpb.GCResponse, error)
+	KvDeleteRange(ctx context.Context, in *kvrpcpb.DeleteRangeRequest, opts ...grpc.CallOption) (*kvrpcpb.DeleteRangeResponse, error)
+	// RawKV commands.
+	RawGet(ctx context.Context, in *kvrpcpb.RawGetRequest, opts ...grpc.CallOption) (*kvrpcpb.RawGetResponse, error)
+	RawPut(ctx context.Context, in *kvrpcpb.RawPutRequest, opts ...grpc.CallOption) (*kvrpcpb.RawPutResponse, error)
+	RawDelete(ctx context.Context, in *kvrpcpb.RawDeleteRequest, opts ...grpc.CallOption) (*kvrpcpb.RawDeleteResponse, error)
+	RawScan(ctx context.Context, in *kvrpcpb.RawScanRequest, opts ...grpc.CallOption) (*kvrpcpb.RawScanResponse, error)
+	RawBatchGet(ctx context.Context, in *kvrpcpb.RawBatchGetRequest, opts ...grpc.CallOption) (*kvrpcpb.RawBatchGetResponse, error)
+	RawBatchPut(ctx context.Context, in *kvrpcpb.RawBatchPutRequest, opts ...grpc.CallOption) (*kvrpcpb.RawBatchPutResponse, error)
+	RawBatchDelete(ctx context.Context, in *kvrpcpb.RawBatchDeleteRequest, opts ...grpc.CallOption) (*kvrpcpb.RawBatchDeleteResponse, error)
+	// Region migration between clusters.
+	ExportRegion(ctx context.Context, in *kvrpcpb.ExportRegionRequest, opts ...grpc.CallOption) (Tikv_ExportRegionClient, error)
+	ImportRegion(ctx context.Context, in *kvrpcpb.ImportRegionRequest, opts ...grpc.CallOption) (*kvrpcpb.ImportRegionResponse, error)
+	// Consistent export of a key range at a timestamp, for offline analytics.
+	ExportSnapshot(ctx context.Context, in *kvrpcpb.ExportSnapshotRequest, opts ...grpc.CallOption) (Tikv_ExportSnapshotClient, error)
+	// Split points of a region along its data distribution, for external balancers.
+	GetRegionApproximateSplitKeys(ctx context.Context, in *kvrpcpb.GetRegionApproximateSplitKeysRequest, opts ...grpc.CallOption) (*kvrpcpb.GetRegionApproximateSplitKeysResponse, error)
+	// Manual split of a region, to pre-split the hot regions without waiting for the split checker.
+	SplitRegion(ctx context.Context, in *kvrpcpb.SplitRegionRequest, opts ...grpc.CallOption) (*kvrpcpb.SplitRegionResponse, error)
+	// Changes of the regions led by the store, for clients to keep their region caches up to date.
+	WatchRegions(ctx context.Context, in *kvrpcpb.WatchRegionsRequest, opts ...grpc.CallOption) (Tikv_WatchRegionsClient, error)
+	// Initial data seeding of an empty cluster.
+	SeedWrite(ctx context.Context, in *kvrpcpb.SeedWriteRequest, opts ...grpc.CallOption) (*kvrpcpb.SeedWriteResponse, error)
+	SeedChecksum(ctx context.Context, in *kvrpcpb.SeedChecksumRequest, opts ...grpc.CallOption) (*kvrpcpb.SeedChecksumResponse, error)
+	// Debugging.
+	Profile(ctx context.Context, in *kvrpcpb.ProfileRequest, opts ...grpc.CallOption) (Tikv_ProfileClient, error)
+	// All the locks, writes and values of a key, or of the keys of a transaction, to diagnose stuck transactions.
+	MvccGetByKey(ctx context.Context, in *kvrpcpb.MvccGetByKeyRequest, opts ...grpc.CallOption) (*kvrpcpb.MvccGetByKeyResponse, error)
+	MvccGetByStartTs(ctx context.Context, in *kvrpcpb.MvccGetByStartTsRequest, opts ...grpc.CallOption) (*kvrpcpb.MvccGetByStartTsResponse, error)
+	// SQL push down commands.
+	Coprocessor(ctx context.Context, in *coprocessor.Request, opts ...grpc.CallOption) (*coprocessor.Response, error)
+	// Many requests multiplexed over one stream, to save the per-RPC overhead of high-QPS clients.
+	BatchCommands(ctx context.Context, opts ...grpc.CallOption) (Tikv_BatchCommandsClient, error)
+	// Raft commands (tinykv <-> tinykv).
+	Raft(ctx context.Context, opts ...grpc.CallOption) (Tikv_RaftClient, error)
+	BatchRaft(ctx context.Context, opts ...grpc.CallOption) (Tikv_BatchRaftClient, error)
+	Snapshot(ctx context.Context, opts ...grpc.CallOption) (Tikv_SnapshotClient, error)
+	// Status of the raft log of a peer, asked by the store coordinating a quorum read.
+	RaftLogStatus(ctx context.Context, in *raft_cmdpb.RaftCmdRequest, opts ...grpc.CallOption) (*raft_cmdpb.RaftCmdResponse, error)
+}
+
+type tikvClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTikvClient(cc *grpc.ClientConn) TikvClient {
+	return &tikvClient{cc}
+}
+
+func (c *tikvClient) KvGet(ctx context.Context, in *kvrpcpb.GetRequest, opts ...grpc.CallOption) (*kvrpcpb.GetResponse, error) {
+	out := new(kvrpcpb.GetResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvGet", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(TikvServer).RawGet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tikvpb.Tikv/RawGet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TikvServer).RawGet(ctx, req.(*kvrpcpb.RawGetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Tikv_RawPut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(kvrpcpb.RawPutRequest)
-	if err := dec(in); err != nil {
+func (c *tikvClient) KvScan(ctx context.Context, in *kvrpcpb.ScanRequest, opts ...grpc.CallOption) (*kvrpcpb.ScanResponse, error) {
+	out := new(kvrpcpb.ScanResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvScan", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(TikvServer).RawPut(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tikvpb.Tikv/RawPut",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TikvServer).RawPut(ctx, req.(*kvrpcpb.RawPutRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Tikv_RawDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(kvrpcpb.RawDeleteRequest)
-	if err := dec(in); err != nil {
+func (c *tikvClient) KvPrewrite(ctx context.Context, in *kvrpcpb.PrewriteRequest, opts ...grpc.CallOption) (*kvrpcpb.PrewriteResponse, error) {
+	out := new(kvrpcpb.PrewriteResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvPrewrite", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(TikvServer).RawDelete(ctx, in)
+	return out, nil
+}
+
+func (c *tikvClient) KvCommit(ctx context.Context, in *kvrpcpb.CommitRequest, opts ...grpc.CallOption) (*kvrpcpb.CommitResponse, error) {
+	out := new(kvrpcpb.CommitResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tikvpb.Tikv/RawDelete",
+	return out, nil
+}
+
+func (c *tikvClient) KvCheckTxnStatus(ctx context.Context, in *kvrpcpb.CheckTxnStatusRequest, opts ...grpc.CallOption) (*kvrpcpb.CheckTxnStatusResponse, error) {
+	out := new(kvrpcpb.CheckTxnStatusResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvCheckTxnStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TikvServer).RawDelete(ctx, req.(*kvrpcpb.RawDeleteRequest))
+	return out, nil
+}
+
+func (c *tikvClient) KvTxnHeartBeat(ctx context.Context, in *kvrpcpb.TxnHeartBeatRequest, opts ...grpc.CallOption) (*kvrpcpb.TxnHeartBeatResponse, error) {
+	out := new(kvrpcpb.TxnHeartBeatResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvTxnHeartBeat", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Tikv_RawScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(kvrpcpb.RawScanRequest)
-	if err := dec(in); err != nil {
+func (c *tikvClient) KvCleanup(ctx context.Context, in *kvrpcpb.CleanupRequest, opts ...grpc.CallOption) (*kvrpcpb.CleanupResponse, error) {
+	out := new(kvrpcpb.CleanupResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvCleanup", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(TikvServer).RawScan(ctx, in)
+	return out, nil
+}
+
+func (c *tikvClient) KvBatchGet(ctx context.Context, in *kvrpcpb.BatchGetRequest, opts ...grpc.CallOption) (*kvrpcpb.BatchGetResponse, error) {
+	out := new(kvrpcpb.BatchGetResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvBatchGet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tikvpb.Tikv/RawScan",
+	return out, nil
+}
+
+func (c *tikvClient) KvCheckConflicts(ctx context.Context, in *kvrpcpb.CheckConflictsRequest, opts ...grpc.CallOption) (*kvrpcpb.CheckConflictsResponse, error) {
+	out := new(kvrpcpb.CheckConflictsResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvCheckConflicts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TikvServer).RawScan(ctx, req.(*kvrpcpb.RawScanRequest))
+	return out, nil
+}
+
+func (c *tikvClient) KvGetCommitTs(ctx context.Context, in *kvrpcpb.GetCommitTsRequest, opts ...grpc.CallOption) (*kvrpcpb.GetCommitTsResponse, error) {
+	out := new(kvrpcpb.GetCommitTsResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvGetCommitTs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Tikv_Raft_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(TikvServer).Raft(&tikvRaftServer{stream})
+func (c *tikvClient) KvBatchRollback(ctx context.Context, in *kvrpcpb.BatchRollbackRequest, opts ...grpc.CallOption) (*kvrpcpb.BatchRollbackResponse, error) {
+	out := new(kvrpcpb.BatchRollbackResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvBatchRollback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type Tikv_RaftServer interface {
-	SendAndClose(*raft_serverpb.Done) error
-	Recv() (*raft_serverpb.RaftMessage, error)
-	grpc.ServerStream
+func (c *tikvClient) KvScanLock(ctx context.Context, in *kvrpcpb.ScanLockRequest, opts ...grpc.CallOption) (*kvrpcpb.ScanLockResponse, error) {
+	out := new(kvrpcpb.ScanLockResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvScanLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type tikvRaftServer struct {
-	grpc.ServerStream
+func (c *tikvClient) KvResolveLock(ctx context.Context, in *kvrpcpb.ResolveLockRequest, opts ...grpc.CallOption) (*kvrpcpb.ResolveLockResponse, error) {
+	out := new(kvrpcpb.ResolveLockResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvResolveLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *tikvRaftServer) SendAndClose(m *raft_serverpb.Done) error {
-	return x.ServerStream.SendMsg(m)
+func (c *tikvClient) KvGC(ctx context.Context, in *kvrpcpb.GCRequest, opts ...grpc.CallOption) (*kvrpcpb.GCResponse, error) {
+	out := new(kvrpcpb.GCResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvGC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *tikvRaftServer) Recv() (*raft_serverpb.RaftMessage, error) {
-	m := new(raft_serverpb.RaftMessage)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (c *tikvClient) KvDeleteRange(ctx context.Context, in *kvrpcpb.DeleteRangeRequest, opts ...grpc.CallOption) (*kvrpcpb.DeleteRangeResponse, error) {
+	out := new(kvrpcpb.DeleteRangeResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/KvDeleteRange", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func _Tikv_Snapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(TikvServer).Snapshot(&tikvSnapshotServer{stream})
+func (c *tikvClient) RawGet(ctx context.Context, in *kvrpcpb.RawGetRequest, opts ...grpc.CallOption) (*kvrpcpb.RawGetResponse, error) {
+	out := new(kvrpcpb.RawGetResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/RawGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type Tikv_SnapshotServer interface {
-	SendAndClose(*raft_serverpb.Done) error
-	Recv() (*raft_serverpb.SnapshotChunk, error)
-	grpc.ServerStream
+func (c *tikvClient) RawPut(ctx context.Context, in *kvrpcpb.RawPutRequest, opts ...grpc.CallOption) (*kvrpcpb.RawPutResponse, error) {
+	out := new(kvrpcpb.RawPutResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/RawPut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type tikvSnapshotServer struct {
-	grpc.ServerStream
+func (c *tikvClient) RawDelete(ctx context.Context, in *kvrpcpb.RawDeleteRequest, opts ...grpc.CallOption) (*kvrpcpb.RawDeleteResponse, error) {
+	out := new(kvrpcpb.RawDeleteResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/RawDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *tikvSnapshotServer) SendAndClose(m *raft_serverpb.Done) error {
-	return x.ServerStream.SendMsg(m)
+func (c *tikvClient) RawScan(ctx context.Context, in *kvrpcpb.RawScanRequest, opts ...grpc.CallOption) (*kvrpcpb.RawScanResponse, error) {
+	out := new(kvrpcpb.RawScanResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/RawScan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *tikvSnapshotServer) Recv() (*raft_serverpb.SnapshotChunk, error) {
-	m := new(raft_serverpb.SnapshotChunk)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (c *tikvClient) RawBatchGet(ctx context.Context, in *kvrpcpb.RawBatchGetRequest, opts ...grpc.CallOption) (*kvrpcpb.RawBatchGetResponse, error) {
+	out := new(kvrpcpb.RawBatchGetResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/RawBatchGet", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-var _Tikv_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "tikvpb.Tikv",
-	HandlerType: (*TikvServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "KvGet",
-			Handler:    _Tikv_KvGet_Handler,
-		},
-		{
-			MethodName: "KvScan",
-			Handler:    _Tikv_KvScan_Handler,
-		},
-		{
-			MethodName: "KvPrewrite",
-			Handler:    _Tikv_KvPrewrite_Handler,
-		},
-		{
-			MethodName: "KvCommit",
-			Handler:    _Tikv_KvCommit_Handler,
-		},
-		{
-			MethodName: "KvCheckTxnStatus",
-			Handler:    _Tikv_KvCheckTxnStatus_Handler,
-		},
-		{
-			MethodName: "KvCleanup",
-			Handler:    _Tikv_KvCleanup_Handler,
-		},
-		{
-			MethodName: "KvBatchGet",
-			Handler:    _Tikv_KvBatchGet_Handler,
-		},
-		{
-			MethodName: "KvBatchRollback",
-			Handler:    _Tikv_KvBatchRollback_Handler,
-		},
-		{
-			MethodName: "KvScanLock",
-			Handler:    _Tikv_KvScanLock_Handler,
-		},
-		{
-			MethodName: "KvResolveLock",
-			Handler:    _Tikv_KvResolveLock_Handler,
-		},
-		{
-			MethodName: "RawGet",
-			Handler:    _Tikv_RawGet_Handler,
-		},
-		{
-			MethodName: "RawPut",
-			Handler:    _Tikv_RawPut_Handler,
-		},
-		{
-			MethodName: "RawDelete",
-			Handler:    _Tikv_RawDelete_Handler,
-		},
-		{
-			MethodName: "RawScan",
-			Handler:    _Tikv_RawScan_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Raft",
-			Handler:       _Tikv_Raft_Handler,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "Snapshot",
-			Handler:       _Tikv_Snapshot_Handler,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "tikvpb.proto",
+func (c *tikvClient) RawBatchPut(ctx context.Context, in *kvrpcpb.RawBatchPutRequest, opts ...grpc.CallOption) (*kvrpcpb.RawBatchPutResponse, error) {
+	out := new(kvrpcpb.RawBatchPutResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/RawBatchPut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *BatchRaftMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *tikvClient) RawBatchDelete(ctx context.Context, in *kvrpcpb.RawBatchDeleteRequest, opts ...grpc.CallOption) (*kvrpcpb.RawBatchDeleteResponse, error) {
+	out := new(kvrpcpb.RawBatchDeleteResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/RawBatchDelete", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *BatchRaftMessage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msgs) > 0 {
-		for _, msg := range m.Msgs {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintTikvpb(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
+func (c *tikvClient) ExportRegion(ctx context.Context, in *kvrpcpb.ExportRegionRequest, opts ...grpc.CallOption) (Tikv_ExportRegionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[0], "/tikvpb.Tikv/ExportRegion", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tikvExportRegionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Tikv_ExportRegionClient interface {
+	Recv() (*kvrpcpb.ExportRegionResponse, error)
+	grpc.ClientStream
+}
+
+type tikvExportRegionClient struct {
+	grpc.ClientStream
+}
+
+func (x *tikvExportRegionClient) Recv() (*kvrpcpb.ExportRegionResponse, error) {
+	m := new(kvrpcpb.ExportRegionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tikvClient) ImportRegion(ctx context.Context, in *kvrpcpb.ImportRegionRequest, opts ...grpc.CallOption) (*kvrpcpb.ImportRegionResponse, error) {
+	out := new(kvrpcpb.ImportRegionResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/ImportRegion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tikvClient) ExportSnapshot(ctx context.Context, in *kvrpcpb.ExportSnapshotRequest, opts ...grpc.CallOption) (Tikv_ExportSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[1], "/tikvpb.Tikv/ExportSnapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tikvExportSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Tikv_ExportSnapshotClient interface {
+	Recv() (*kvrpcpb.ExportSnapshotResponse, error)
+	grpc.ClientStream
+}
+
+type tikvExportSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *tikvExportSnapshotClient) Recv() (*kvrpcpb.ExportSnapshotResponse, error) {
+	m := new(kvrpcpb.ExportSnapshotResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tikvClient) GetRegionApproximateSplitKeys(ctx context.Context, in *kvrpcpb.GetRegionApproximateSplitKeysRequest, opts ...grpc.CallOption) (*kvrpcpb.GetRegionApproximateSplitKeysResponse, error) {
+	out := new(kvrpcpb.GetRegionApproximateSplitKeysResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/GetRegionApproximateSplitKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tikvClient) SplitRegion(ctx context.Context, in *kvrpcpb.SplitRegionRequest, opts ...grpc.CallOption) (*kvrpcpb.SplitRegionResponse, error) {
+	out := new(kvrpcpb.SplitRegionResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/SplitRegion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tikvClient) WatchRegions(ctx context.Context, in *kvrpcpb.WatchRegionsRequest, opts ...grpc.CallOption) (Tikv_WatchRegionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[2], "/tikvpb.Tikv/WatchRegions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tikvWatchRegionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Tikv_WatchRegionsClient interface {
+	Recv() (*kvrpcpb.WatchRegionsResponse, error)
+	grpc.ClientStream
+}
+
+type tikvWatchRegionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *tikvWatchRegionsClient) Recv() (*kvrpcpb.WatchRegionsResponse, error) {
+	m := new(kvrpcpb.WatchRegionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tikvClient) SeedWrite(ctx context.Context, in *kvrpcpb.SeedWriteRequest, opts ...grpc.CallOption) (*kvrpcpb.SeedWriteResponse, error) {
+	out := new(kvrpcpb.SeedWriteResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/SeedWrite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tikvClient) SeedChecksum(ctx context.Context, in *kvrpcpb.SeedChecksumRequest, opts ...grpc.CallOption) (*kvrpcpb.SeedChecksumResponse, error) {
+	out := new(kvrpcpb.SeedChecksumResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/SeedChecksum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tikvClient) Profile(ctx context.Context, in *kvrpcpb.ProfileRequest, opts ...grpc.CallOption) (Tikv_ProfileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[3], "/tikvpb.Tikv/Profile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tikvProfileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Tikv_ProfileClient interface {
+	Recv() (*kvrpcpb.ProfileResponse, error)
+	grpc.ClientStream
+}
+
+type tikvProfileClient struct {
+	grpc.ClientStream
+}
+
+func (x *tikvProfileClient) Recv() (*kvrpcpb.ProfileResponse, error) {
+	m := new(kvrpcpb.ProfileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tikvClient) MvccGetByKey(ctx context.Context, in *kvrpcpb.MvccGetByKeyRequest, opts ...grpc.CallOption) (*kvrpcpb.MvccGetByKeyResponse, error) {
+	out := new(kvrpcpb.MvccGetByKeyResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/MvccGetByKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tikvClient) MvccGetByStartTs(ctx context.Context, in *kvrpcpb.MvccGetByStartTsRequest, opts ...grpc.CallOption) (*kvrpcpb.MvccGetByStartTsResponse, error) {
+	out := new(kvrpcpb.MvccGetByStartTsResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/MvccGetByStartTs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tikvClient) Coprocessor(ctx context.Context, in *coprocessor.Request, opts ...grpc.CallOption) (*coprocessor.Response, error) {
+	out := new(coprocessor.Response)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/Coprocessor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tikvClient) BatchCommands(ctx context.Context, opts ...grpc.CallOption) (Tikv_BatchCommandsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[4], "/tikvpb.Tikv/BatchCommands", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tikvBatchCommandsClient{stream}
+	return x, nil
+}
+
+type Tikv_BatchCommandsClient interface {
+	Send(*BatchCommandsRequest) error
+	Recv() (*BatchCommandsResponse, error)
+	grpc.ClientStream
+}
+
+type tikvBatchCommandsClient struct {
+	grpc.ClientStream
+}
+
+func (x *tikvBatchCommandsClient) Send(m *BatchCommandsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *tikvBatchCommandsClient) Recv() (*BatchCommandsResponse, error) {
+	m := new(BatchCommandsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tikvClient) Raft(ctx context.Context, opts ...grpc.CallOption) (Tikv_RaftClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[5], "/tikvpb.Tikv/Raft", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tikvRaftClient{stream}
+	return x, nil
+}
+
+type Tikv_RaftClient interface {
+	Send(*raft_serverpb.RaftMessage) error
+	CloseAndRecv() (*raft_serverpb.Done, error)
+	grpc.ClientStream
+}
+
+type tikvRaftClient struct {
+	grpc.ClientStream
+}
+
+func (x *tikvRaftClient) Send(m *raft_serverpb.RaftMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *tikvRaftClient) CloseAndRecv() (*raft_serverpb.Done, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(raft_serverpb.Done)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tikvClient) BatchRaft(ctx context.Context, opts ...grpc.CallOption) (Tikv_BatchRaftClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[6], "/tikvpb.Tikv/BatchRaft", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tikvBatchRaftClient{stream}
+	return x, nil
+}
+
+type Tikv_BatchRaftClient interface {
+	Send(*raft_serverpb.BatchRaftMessage) error
+	CloseAndRecv() (*raft_serverpb.Done, error)
+	grpc.ClientStream
+}
+
+type tikvBatchRaftClient struct {
+	grpc.ClientStream
+}
+
+func (x *tikvBatchRaftClient) Send(m *raft_serverpb.BatchRaftMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *tikvBatchRaftClient) CloseAndRecv() (*raft_serverpb.Done, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(raft_serverpb.Done)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tikvClient) Snapshot(ctx context.Context, opts ...grpc.CallOption) (Tikv_SnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Tikv_serviceDesc.Streams[7], "/tikvpb.Tikv/Snapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tikvSnapshotClient{stream}
+	return x, nil
+}
+
+type Tikv_SnapshotClient interface {
+	Send(*raft_serverpb.SnapshotChunk) error
+	CloseAndRecv() (*raft_serverpb.Done, error)
+	grpc.ClientStream
+}
+
+type tikvSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *tikvSnapshotClient) Send(m *raft_serverpb.SnapshotChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *tikvSnapshotClient) CloseAndRecv() (*raft_serverpb.Done, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(raft_serverpb.Done)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tikvClient) RaftLogStatus(ctx context.Context, in *raft_cmdpb.RaftCmdRequest, opts ...grpc.CallOption) (*raft_cmdpb.RaftCmdResponse, error) {
+	out := new(raft_cmdpb.RaftCmdResponse)
+	err := c.cc.Invoke(ctx, "/tikvpb.Tikv/RaftLogStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Tikv service
+
+type TikvServer interface {
+	// KV commands with mvcc/txn supported.
+	KvGet(context.Context, *kvrpcpb.GetRequest) (*kvrpcpb.GetResponse, error)
+	KvScan(context.Context, *kvrpcpb.ScanRequest) (*kvrpcpb.ScanResponse, error)
+	KvPrewrite(context.Context, *kvrpcpb.PrewriteRequest) (*kvrpcpb.PrewriteResponse, error)
+	KvCommit(context.Context, *kvrpcpb.CommitRequest) (*kvrpcpb.CommitResponse, error)
+	KvCheckTxnStatus(context.Context, *kvrpcpb.CheckTxnStatusRequest) (*kvrpcpb.CheckTxnStatusResponse, error)
+	KvTxnHeartBeat(context.Context, *kvrpcpb.TxnHeartBeatRequest) (*kvrpcpb.TxnHeartBeatResponse, error)
+	KvCleanup(context.Context, *kvrpcpb.CleanupRequest) (*kvrpcpb.CleanupResponse, error)
+	KvBatchGet(context.Context, *kvrpcpb.BatchGetRequest) (*kvrpcpb.BatchGetResponse, error)
+	KvCheckConflicts(context.Context, *kvrpcpb.CheckConflictsRequest) (*kvrpcpb.CheckConflictsResponse, error)
+	KvGetCommitTs(context.Context, *kvrpcpb.GetCommitTsRequest) (*kvrpcpb.GetCommitTsResponse, error)
+	KvBatchRollback(context.Context, *kvrpcpb.BatchRollbackRequest) (*kvrpcpb.BatchRollbackResponse, error)
+	KvScanLock(context.Context, *kvrpcpb.ScanLockRequest) (*kvrpcpb.ScanLockResponse, error)
+	KvResolveLock(context.Context, *kvrpcpb.ResolveLockRequest) (*kvrpcpb.ResolveLockResponse, error)
+	KvGC(context.Context, *kvrpcpb.GCRequest) (*kvrpcpb.GCResponse, error)
+	KvDeleteRange(context.Context, *kvrpcpb.DeleteRangeRequest) (*kvrpcpb.DeleteRangeResponse, error)
+	// RawKV commands.
+	RawGet(context.Context, *kvrpcpb.RawGetRequest) (*kvrpcpb.RawGetResponse, error)
+	RawPut(context.Context, *kvrpcpb.RawPutRequest) (*kvrpcpb.RawPutResponse, error)
+	RawDelete(context.Context, *kvrpcpb.RawDeleteRequest) (*kvrpcpb.RawDeleteResponse, error)
+	RawScan(context.Context, *kvrpcpb.RawScanRequest) (*kvrpcpb.RawScanResponse, error)
+	RawBatchGet(context.Context, *kvrpcpb.RawBatchGetRequest) (*kvrpcpb.RawBatchGetResponse, error)
+	RawBatchPut(context.Context, *kvrpcpb.RawBatchPutRequest) (*kvrpcpb.RawBatchPutResponse, error)
+	RawBatchDelete(context.Context, *kvrpcpb.RawBatchDeleteRequest) (*kvrpcpb.RawBatchDeleteResponse, error)
+	// Region migration between clusters.
+	ExportRegion(*kvrpcpb.ExportRegionRequest, Tikv_ExportRegionServer) error
+	ImportRegion(context.Context, *kvrpcpb.ImportRegionRequest) (*kvrpcpb.ImportRegionResponse, error)
+	// Consistent export of a key range at a timestamp, for offline analytics.
+	ExportSnapshot(*kvrpcpb.ExportSnapshotRequest, Tikv_ExportSnapshotServer) error
+	// Split points of a region along its data distribution, for external balancers.
+	GetRegionApproximateSplitKeys(context.Context, *kvrpcpb.GetRegionApproximateSplitKeysRequest) (*kvrpcpb.GetRegionApproximateSplitKeysResponse, error)
+	// Manual split of a region, to pre-split the hot regions without waiting for the split checker.
+	SplitRegion(context.Context, *kvrpcpb.SplitRegionRequest) (*kvrpcpb.SplitRegionResponse, error)
+	// Changes of the regions led by the store, for clients to keep their region caches up to date.
+	WatchRegions(*kvrpcpb.WatchRegionsRequest, Tikv_WatchRegionsServer) error
+	// Initial data seeding of an empty cluster.
+	SeedWrite(context.Context, *kvrpcpb.SeedWriteRequest) (*kvrpcpb.SeedWriteResponse, error)
+	SeedChecksum(context.Context, *kvrpcpb.SeedChecksumRequest) (*kvrpcpb.SeedChecksumResponse, error)
+	// Debugging.
+	Profile(*kvrpcpb.ProfileRequest, Tikv_ProfileServer) error
+	// All the locks, writes and values of a key, or of the keys of a transaction, to diagnose stuck transactions.
+	MvccGetByKey(context.Context, *kvrpcpb.MvccGetByKeyRequest) (*kvrpcpb.MvccGetByKeyResponse, error)
+	MvccGetByStartTs(context.Context, *kvrpcpb.MvccGetByStartTsRequest) (*kvrpcpb.MvccGetByStartTsResponse, error)
+	// SQL push down commands.
+	Coprocessor(context.Context, *coprocessor.Request) (*coprocessor.Response, error)
+	// Many requests multiplexed over one stream, to save the per-RPC overhead of high-QPS clients.
+	BatchCommands(Tikv_BatchCommandsServer) error
+	// Raft commands (tinykv <-> tinykv).
+	Raft(Tikv_RaftServer) error
+	BatchRaft(Tikv_BatchRaftServer) error
+	Snapshot(Tikv_SnapshotServer) error
+	// Status of the raft log of a peer, asked by the store coordinating a quorum read.
+	RaftLogStatus(context.Context, *raft_cmdpb.RaftCmdRequest) (*raft_cmdpb.RaftCmdResponse, error)
+}
+
+func RegisterTikvServer(s *grpc.Server, srv TikvServer) {
+	s.RegisterService(&_Tikv_serviceDesc, srv)
+}
+
+func _Tikv_KvGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).KvGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/KvGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).KvGet(ctx, req.(*kvrpcpb.GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_KvScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.ScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).KvScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/KvScan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).KvScan(ctx, req.(*kvrpcpb.ScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_KvPrewrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.PrewriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).KvPrewrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/KvPrewrite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).KvPrewrite(ctx, req.(*kvrpcpb.PrewriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_KvCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.CommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).KvCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/KvCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).KvCommit(ctx, req.(*kvrpcpb.CommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_KvCheckTxnStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.CheckTxnStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).KvCheckTxnStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/KvCheckTxnStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).KvCheckTxnStatus(ctx, req.(*kvrpcpb.CheckTxnStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_KvTxnHeartBeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.TxnHeartBeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).KvTxnHeartBeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/KvTxnHeartBeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).KvTxnHeartBeat(ctx, req.(*kvrpcpb.TxnHeartBeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_KvCleanup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.CleanupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).KvCleanup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/KvCleanup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).KvCleanup(ctx, req.(*kvrpcpb.CleanupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_KvBatchGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.BatchGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).KvBatchGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/KvBatchGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).KvBatchGet(ctx, req.(*kvrpcpb.BatchGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_KvCheckConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.CheckConflictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).KvCheckConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/KvCheckConflicts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).KvCheckConflicts(ctx, req.(*kvrpcpb.CheckConflictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_KvGetCommitTs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.GetCommitTsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).KvGetCommitTs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/KvGetCommitTs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).KvGetCommitTs(ctx, req.(*kvrpcpb.GetCommitTsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_KvBatchRollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.BatchRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).KvBatchRollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/KvBatchRollback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).KvBatchRollback(ctx, req.(*kvrpcpb.BatchRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_KvScanLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.ScanLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).KvScanLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/KvScanLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).KvScanLock(ctx, req.(*kvrpcpb.ScanLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_KvResolveLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.ResolveLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).KvResolveLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/KvResolveLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).KvResolveLock(ctx, req.(*kvrpcpb.ResolveLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_KvGC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.GCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).KvGC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/KvGC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).KvGC(ctx, req.(*kvrpcpb.GCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_KvDeleteRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.DeleteRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).KvDeleteRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/KvDeleteRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).KvDeleteRange(ctx, req.(*kvrpcpb.DeleteRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_RawGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RawGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).RawGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/RawGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).RawGet(ctx, req.(*kvrpcpb.RawGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_RawPut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RawPutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).RawPut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/RawPut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).RawPut(ctx, req.(*kvrpcpb.RawPutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_RawDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RawDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).RawDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/RawDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).RawDelete(ctx, req.(*kvrpcpb.RawDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_RawScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RawScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).RawScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/RawScan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).RawScan(ctx, req.(*kvrpcpb.RawScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_RawBatchGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RawBatchGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).RawBatchGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/RawBatchGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).RawBatchGet(ctx, req.(*kvrpcpb.RawBatchGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_RawBatchPut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RawBatchPutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).RawBatchPut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/RawBatchPut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).RawBatchPut(ctx, req.(*kvrpcpb.RawBatchPutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_RawBatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.RawBatchDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).RawBatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/RawBatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).RawBatchDelete(ctx, req.(*kvrpcpb.RawBatchDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_ExportRegion_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(kvrpcpb.ExportRegionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TikvServer).ExportRegion(m, &tikvExportRegionServer{stream})
+}
+
+type Tikv_ExportRegionServer interface {
+	Send(*kvrpcpb.ExportRegionResponse) error
+	grpc.ServerStream
+}
+
+type tikvExportRegionServer struct {
+	grpc.ServerStream
+}
+
+func (x *tikvExportRegionServer) Send(m *kvrpcpb.ExportRegionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Tikv_ImportRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.ImportRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).ImportRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/ImportRegion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).ImportRegion(ctx, req.(*kvrpcpb.ImportRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_ExportSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(kvrpcpb.ExportSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TikvServer).ExportSnapshot(m, &tikvExportSnapshotServer{stream})
+}
+
+type Tikv_ExportSnapshotServer interface {
+	Send(*kvrpcpb.ExportSnapshotResponse) error
+	grpc.ServerStream
+}
+
+type tikvExportSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *tikvExportSnapshotServer) Send(m *kvrpcpb.ExportSnapshotResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Tikv_GetRegionApproximateSplitKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.GetRegionApproximateSplitKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).GetRegionApproximateSplitKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/GetRegionApproximateSplitKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).GetRegionApproximateSplitKeys(ctx, req.(*kvrpcpb.GetRegionApproximateSplitKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_SplitRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.SplitRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).SplitRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/SplitRegion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).SplitRegion(ctx, req.(*kvrpcpb.SplitRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_WatchRegions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(kvrpcpb.WatchRegionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TikvServer).WatchRegions(m, &tikvWatchRegionsServer{stream})
+}
+
+type Tikv_WatchRegionsServer interface {
+	Send(*kvrpcpb.WatchRegionsResponse) error
+	grpc.ServerStream
+}
+
+type tikvWatchRegionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *tikvWatchRegionsServer) Send(m *kvrpcpb.WatchRegionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Tikv_SeedWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.SeedWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).SeedWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/SeedWrite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).SeedWrite(ctx, req.(*kvrpcpb.SeedWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_SeedChecksum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.SeedChecksumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).SeedChecksum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/SeedChecksum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).SeedChecksum(ctx, req.(*kvrpcpb.SeedChecksumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_Profile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(kvrpcpb.ProfileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TikvServer).Profile(m, &tikvProfileServer{stream})
+}
+
+type Tikv_ProfileServer interface {
+	Send(*kvrpcpb.ProfileResponse) error
+	grpc.ServerStream
+}
+
+type tikvProfileServer struct {
+	grpc.ServerStream
+}
+
+func (x *tikvProfileServer) Send(m *kvrpcpb.ProfileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Tikv_MvccGetByKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.MvccGetByKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).MvccGetByKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/MvccGetByKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).MvccGetByKey(ctx, req.(*kvrpcpb.MvccGetByKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_MvccGetByStartTs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(kvrpcpb.MvccGetByStartTsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).MvccGetByStartTs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/MvccGetByStartTs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).MvccGetByStartTs(ctx, req.(*kvrpcpb.MvccGetByStartTsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_Coprocessor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(coprocessor.Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).Coprocessor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/Coprocessor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).Coprocessor(ctx, req.(*coprocessor.Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tikv_BatchCommands_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TikvServer).BatchCommands(&tikvBatchCommandsServer{stream})
+}
+
+type Tikv_BatchCommandsServer interface {
+	Send(*BatchCommandsResponse) error
+	Recv() (*BatchCommandsRequest, error)
+	grpc.ServerStream
+}
+
+type tikvBatchCommandsServer struct {
+	grpc.ServerStream
+}
+
+func (x *tikvBatchCommandsServer) Send(m *BatchCommandsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *tikvBatchCommandsServer) Recv() (*BatchCommandsRequest, error) {
+	m := new(BatchCommandsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Tikv_Raft_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TikvServer).Raft(&tikvRaftServer{stream})
+}
+
+type Tikv_RaftServer interface {
+	SendAndClose(*raft_serverpb.Done) error
+	Recv() (*raft_serverpb.RaftMessage, error)
+	grpc.ServerStream
+}
+
+type tikvRaftServer struct {
+	grpc.ServerStream
+}
+
+func (x *tikvRaftServer) SendAndClose(m *raft_serverpb.Done) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *tikvRaftServer) Recv() (*raft_serverpb.RaftMessage, error) {
+	m := new(raft_serverpb.RaftMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Tikv_BatchRaft_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TikvServer).BatchRaft(&tikvBatchRaftServer{stream})
+}
+
+type Tikv_BatchRaftServer interface {
+	SendAndClose(*raft_serverpb.Done) error
+	Recv() (*raft_serverpb.BatchRaftMessage, error)
+	grpc.ServerStream
+}
+
+type tikvBatchRaftServer struct {
+	grpc.ServerStream
+}
+
+func (x *tikvBatchRaftServer) SendAndClose(m *raft_serverpb.Done) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *tikvBatchRaftServer) Recv() (*raft_serverpb.BatchRaftMessage, error) {
+	m := new(raft_serverpb.BatchRaftMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Tikv_Snapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TikvServer).Snapshot(&tikvSnapshotServer{stream})
+}
+
+type Tikv_SnapshotServer interface {
+	SendAndClose(*raft_serverpb.Done) error
+	Recv() (*raft_serverpb.SnapshotChunk, error)
+	grpc.ServerStream
+}
+
+type tikvSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *tikvSnapshotServer) SendAndClose(m *raft_serverpb.Done) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *tikvSnapshotServer) Recv() (*raft_serverpb.SnapshotChunk, error) {
+	m := new(raft_serverpb.SnapshotChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Tikv_RaftLogStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(raft_cmdpb.RaftCmdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TikvServer).RaftLogStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tikvpb.Tikv/RaftLogStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TikvServer).RaftLogStatus(ctx, req.(*raft_cmdpb.RaftCmdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Tikv_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tikvpb.Tikv",
+	HandlerType: (*TikvServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "KvGet",
+			Handler:    _Tikv_KvGet_Handler,
+		},
+		{
+			MethodName: "KvScan",
+			Handler:    _Tikv_KvScan_Handler,
+		},
+		{
+			MethodName: "KvPrewrite",
+			Handler:    _Tikv_KvPrewrite_Handler,
+		},
+		{
+			MethodName: "KvCommit",
+			Handler:    _Tikv_KvCommit_Handler,
+		},
+		{
+			MethodName: "KvCheckTxnStatus",
+			Handler:    _Tikv_KvCheckTxnStatus_Handler,
+		},
+		{
+			MethodName: "KvTxnHeartBeat",
+			Handler:    _Tikv_KvTxnHeartBeat_Handler,
+		},
+		{
+			MethodName: "KvCleanup",
+			Handler:    _Tikv_KvCleanup_Handler,
+		},
+		{
+			MethodName: "KvBatchGet",
+			Handler:    _Tikv_KvBatchGet_Handler,
+		},
+		{
+			MethodName: "KvCheckConflicts",
+			Handler:    _Tikv_KvCheckConflicts_Handler,
+		},
+		{
+			MethodName: "KvGetCommitTs",
+			Handler:    _Tikv_KvGetCommitTs_Handler,
+		},
+		{
+			MethodName: "KvBatchRollback",
+			Handler:    _Tikv_KvBatchRollback_Handler,
+		},
+		{
+			MethodName: "KvScanLock",
+			Handler:    _Tikv_KvScanLock_Handler,
+		},
+		{
+			MethodName: "KvResolveLock",
+			Handler:    _Tikv_KvResolveLock_Handler,
+		},
+		{
+			MethodName: "KvGC",
+			Handler:    _Tikv_KvGC_Handler,
+		},
+		{
+			MethodName: "KvDeleteRange",
+			Handler:    _Tikv_KvDeleteRange_Handler,
+		},
+		{
+			MethodName: "RawGet",
+			Handler:    _Tikv_RawGet_Handler,
+		},
+		{
+			MethodName: "RawPut",
+			Handler:    _Tikv_RawPut_Handler,
+		},
+		{
+			MethodName: "RawDelete",
+			Handler:    _Tikv_RawDelete_Handler,
+		},
+		{
+			MethodName: "RawScan",
+			Handler:    _Tikv_RawScan_Handler,
+		},
+		{
+			MethodName: "RawBatchGet",
+			Handler:    _Tikv_RawBatchGet_Handler,
+		},
+		{
+			MethodName: "RawBatchPut",
+			Handler:    _Tikv_RawBatchPut_Handler,
+		},
+		{
+			MethodName: "RawBatchDelete",
+			Handler:    _Tikv_RawBatchDelete_Handler,
+		},
+		{
+			MethodName: "ImportRegion",
+			Handler:    _Tikv_ImportRegion_Handler,
+		},
+		{
+			MethodName: "GetRegionApproximateSplitKeys",
+			Handler:    _Tikv_GetRegionApproximateSplitKeys_Handler,
+		},
+		{
+			MethodName: "SplitRegion",
+			Handler:    _Tikv_SplitRegion_Handler,
+		},
+		{
+			MethodName: "SeedWrite",
+			Handler:    _Tikv_SeedWrite_Handler,
+		},
+		{
+			MethodName: "SeedChecksum",
+			Handler:    _Tikv_SeedChecksum_Handler,
+		},
+		{
+			MethodName: "MvccGetByKey",
+			Handler:    _Tikv_MvccGetByKey_Handler,
+		},
+		{
+			MethodName: "MvccGetByStartTs",
+			Handler:    _Tikv_MvccGetByStartTs_Handler,
+		},
+		{
+			MethodName: "Coprocessor",
+			Handler:    _Tikv_Coprocessor_Handler,
+		},
+		{
+			MethodName: "RaftLogStatus",
+			Handler:    _Tikv_RaftLogStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportRegion",
+			Handler:       _Tikv_ExportRegion_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportSnapshot",
+			Handler:       _Tikv_ExportSnapshot_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchRegions",
+			Handler:       _Tikv_WatchRegions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Profile",
+			Handler:       _Tikv_Profile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BatchCommands",
+			Handler:       _Tikv_BatchCommands_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Raft",
+			Handler:       _Tikv_Raft_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "BatchRaft",
+			Handler:       _Tikv_BatchRaft_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Snapshot",
+			Handler:       _Tikv_Snapshot_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "tikvpb.proto",
+}
+
+func (m *BatchRaftMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchRaftMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for _, msg := range m.Msgs {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTikvpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *BatchCommandsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchCommandsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, msg := range m.Requests {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTikvpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.RequestIds) > 0 {
+		dAtA2 := make([]byte, len(m.RequestIds)*10)
+		var j1 int
+		for _, num := range m.RequestIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(j1))
+		i += copy(dAtA[i:], dAtA2[:j1])
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *BatchCommandsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchCommandsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for _, msg := range m.Responses {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintTikvpb(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.RequestIds) > 0 {
+		dAtA4 := make([]byte, len(m.RequestIds)*10)
+		var j3 int
+		for _, num := range m.RequestIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(j3))
+		i += copy(dAtA[i:], dAtA4[:j3])
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *BatchCommand) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchCommand) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Get != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.Get.Size()))
+		n5, err := m.Get.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.Scan != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.Scan.Size()))
+		n6, err := m.Scan.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.Prewrite != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.Prewrite.Size()))
+		n7, err := m.Prewrite.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.Commit != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.Commit.Size()))
+		n8, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.CheckTxnStatus != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.CheckTxnStatus.Size()))
+		n9, err := m.CheckTxnStatus.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.TxnHeartBeat != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.TxnHeartBeat.Size()))
+		n10, err := m.TxnHeartBeat.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.Cleanup != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.Cleanup.Size()))
+		n11, err := m.Cleanup.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.BatchGet != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.BatchGet.Size()))
+		n12, err := m.BatchGet.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.CheckConflicts != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.CheckConflicts.Size()))
+		n13, err := m.CheckConflicts.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if m.GetCommitTs != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.GetCommitTs.Size()))
+		n14, err := m.GetCommitTs.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if m.BatchRollback != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.BatchRollback.Size()))
+		n15, err := m.BatchRollback.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if m.ScanLock != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.ScanLock.Size()))
+		n16, err := m.ScanLock.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if m.ResolveLock != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.ResolveLock.Size()))
+		n17, err := m.ResolveLock.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if m.GC != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.GC.Size()))
+		n18, err := m.GC.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if m.DeleteRange != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.DeleteRange.Size()))
+		n19, err := m.DeleteRange.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	if m.RawGet != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawGet.Size()))
+		n20, err := m.RawGet.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	if m.RawPut != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawPut.Size()))
+		n21, err := m.RawPut.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if m.RawDelete != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawDelete.Size()))
+		n22, err := m.RawDelete.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	if m.RawScan != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawScan.Size()))
+		n23, err := m.RawScan.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	if m.RawBatchGet != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawBatchGet.Size()))
+		n24, err := m.RawBatchGet.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	if m.RawBatchPut != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawBatchPut.Size()))
+		n25, err := m.RawBatchPut.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	if m.RawBatchDelete != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawBatchDelete.Size()))
+		n26, err := m.RawBatchDelete.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	if m.Coprocessor != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.Coprocessor.Size()))
+		n27, err := m.Coprocessor.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *BatchCommandResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchCommandResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Get != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.Get.Size()))
+		n28, err := m.Get.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	if m.Scan != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.Scan.Size()))
+		n29, err := m.Scan.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	if m.Prewrite != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.Prewrite.Size()))
+		n30, err := m.Prewrite.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	if m.Commit != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.Commit.Size()))
+		n31, err := m.Commit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
+	if m.CheckTxnStatus != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.CheckTxnStatus.Size()))
+		n32, err := m.CheckTxnStatus.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
+	}
+	if m.TxnHeartBeat != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.TxnHeartBeat.Size()))
+		n33, err := m.TxnHeartBeat.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
+	if m.Cleanup != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.Cleanup.Size()))
+		n34, err := m.Cleanup.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
+	}
+	if m.BatchGet != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.BatchGet.Size()))
+		n35, err := m.BatchGet.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
+	}
+	if m.CheckConflicts != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.CheckConflicts.Size()))
+		n36, err := m.CheckConflicts.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	if m.GetCommitTs != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.GetCommitTs.Size()))
+		n37, err := m.GetCommitTs.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
+	}
+	if m.BatchRollback != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.BatchRollback.Size()))
+		n38, err := m.BatchRollback.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
+	}
+	if m.ScanLock != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.ScanLock.Size()))
+		n39, err := m.ScanLock.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
+	if m.ResolveLock != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.ResolveLock.Size()))
+		n40, err := m.ResolveLock.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	if m.GC != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.GC.Size()))
+		n41, err := m.GC.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
+	if m.DeleteRange != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.DeleteRange.Size()))
+		n42, err := m.DeleteRange.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n42
+	}
+	if m.RawGet != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawGet.Size()))
+		n43, err := m.RawGet.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n43
+	}
+	if m.RawPut != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawPut.Size()))
+		n44, err := m.RawPut.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n44
+	}
+	if m.RawDelete != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawDelete.Size()))
+		n45, err := m.RawDelete.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
+	}
+	if m.RawScan != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawScan.Size()))
+		n46, err := m.RawScan.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n46
+	}
+	if m.RawBatchGet != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawBatchGet.Size()))
+		n47, err := m.RawBatchGet.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n47
+	}
+	if m.RawBatchPut != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawBatchPut.Size()))
+		n48, err := m.RawBatchPut.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n48
+	}
+	if m.RawBatchDelete != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.RawBatchDelete.Size()))
+		n49, err := m.RawBatchDelete.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n49
+	}
+	if m.Coprocessor != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintTikvpb(dAtA, i, uint64(m.Coprocessor.Size()))
+		n50, err := m.Coprocessor.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n50
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintTikvpb(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *BatchRaftMessage) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTikvpb(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchCommandsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovTikvpb(uint64(l))
+		}
+	}
+	if len(m.RequestIds) > 0 {
+		l = 0
+		for _, e := range m.RequestIds {
+			l += sovTikvpb(uint64(e))
+		}
+		n += 1 + sovTikvpb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchCommandsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
+			l = e.Size()
+			n += 1 + l + sovTikvpb(uint64(l))
+		}
+	}
+	if len(m.RequestIds) > 0 {
+		l = 0
+		for _, e := range m.RequestIds {
+			l += sovTikvpb(uint64(e))
+		}
+		n += 1 + sovTikvpb(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchCommand) Size() (n int) {
+	var l int
+	_ = l
+	if m.Get != nil {
+		l = m.Get.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.Scan != nil {
+		l = m.Scan.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.Prewrite != nil {
+		l = m.Prewrite.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.CheckTxnStatus != nil {
+		l = m.CheckTxnStatus.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.TxnHeartBeat != nil {
+		l = m.TxnHeartBeat.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.Cleanup != nil {
+		l = m.Cleanup.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.BatchGet != nil {
+		l = m.BatchGet.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.CheckConflicts != nil {
+		l = m.CheckConflicts.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.GetCommitTs != nil {
+		l = m.GetCommitTs.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.BatchRollback != nil {
+		l = m.BatchRollback.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.ScanLock != nil {
+		l = m.ScanLock.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.ResolveLock != nil {
+		l = m.ResolveLock.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.GC != nil {
+		l = m.GC.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.DeleteRange != nil {
+		l = m.DeleteRange.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.RawGet != nil {
+		l = m.RawGet.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	if m.RawPut != nil {
+		l = m.RawPut.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	if m.RawDelete != nil {
+		l = m.RawDelete.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	if m.RawScan != nil {
+		l = m.RawScan.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	if m.RawBatchGet != nil {
+		l = m.RawBatchGet.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	if m.RawBatchPut != nil {
+		l = m.RawBatchPut.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	if m.RawBatchDelete != nil {
+		l = m.RawBatchDelete.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	if m.Coprocessor != nil {
+		l = m.Coprocessor.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchCommandResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Get != nil {
+		l = m.Get.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.Scan != nil {
+		l = m.Scan.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.Prewrite != nil {
+		l = m.Prewrite.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.CheckTxnStatus != nil {
+		l = m.CheckTxnStatus.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.TxnHeartBeat != nil {
+		l = m.TxnHeartBeat.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.Cleanup != nil {
+		l = m.Cleanup.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.BatchGet != nil {
+		l = m.BatchGet.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.CheckConflicts != nil {
+		l = m.CheckConflicts.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.GetCommitTs != nil {
+		l = m.GetCommitTs.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.BatchRollback != nil {
+		l = m.BatchRollback.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.ScanLock != nil {
+		l = m.ScanLock.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.ResolveLock != nil {
+		l = m.ResolveLock.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.GC != nil {
+		l = m.GC.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.DeleteRange != nil {
+		l = m.DeleteRange.Size()
+		n += 1 + l + sovTikvpb(uint64(l))
+	}
+	if m.RawGet != nil {
+		l = m.RawGet.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	if m.RawPut != nil {
+		l = m.RawPut.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	if m.RawDelete != nil {
+		l = m.RawDelete.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	if m.RawScan != nil {
+		l = m.RawScan.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	if m.RawBatchGet != nil {
+		l = m.RawBatchGet.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	if m.RawBatchPut != nil {
+		l = m.RawBatchPut.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	if m.RawBatchDelete != nil {
+		l = m.RawBatchDelete.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	if m.Coprocessor != nil {
+		l = m.Coprocessor.Size()
+		n += 2 + l + sovTikvpb(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovTikvpb(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozTikvpb(x uint64) (n int) {
+	return sovTikvpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BatchRaftMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTikvpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchRaftMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchRaftMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &raft_serverpb.RaftMessage{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTikvpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchCommandsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTikvpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchCommandsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchCommandsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &BatchCommand{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTikvpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RequestIds = append(m.RequestIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTikvpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTikvpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTikvpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RequestIds = append(m.RequestIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTikvpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchCommandsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTikvpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchCommandsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchCommandsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, &BatchCommandResponse{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTikvpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RequestIds = append(m.RequestIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTikvpb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTikvpb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTikvpb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RequestIds = append(m.RequestIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTikvpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchCommand) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTikvpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchCommand: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchCommand: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Get == nil {
+				m.Get = &kvrpcpb.GetRequest{}
+			}
+			if err := m.Get.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scan == nil {
+				m.Scan = &kvrpcpb.ScanRequest{}
+			}
+			if err := m.Scan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prewrite", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prewrite == nil {
+				m.Prewrite = &kvrpcpb.PrewriteRequest{}
+			}
+			if err := m.Prewrite.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &kvrpcpb.CommitRequest{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckTxnStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CheckTxnStatus == nil {
+				m.CheckTxnStatus = &kvrpcpb.CheckTxnStatusRequest{}
+			}
+			if err := m.CheckTxnStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnHeartBeat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxnHeartBeat == nil {
+				m.TxnHeartBeat = &kvrpcpb.TxnHeartBeatRequest{}
+			}
+			if err := m.TxnHeartBeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cleanup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cleanup == nil {
+				m.Cleanup = &kvrpcpb.CleanupRequest{}
+			}
+			if err := m.Cleanup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchGet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BatchGet == nil {
+				m.BatchGet = &kvrpcpb.BatchGetRequest{}
+			}
+			if err := m.BatchGet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckConflicts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CheckConflicts == nil {
+				m.CheckConflicts = &kvrpcpb.CheckConflictsRequest{}
+			}
+			if err := m.CheckConflicts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetCommitTs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GetCommitTs == nil {
+				m.GetCommitTs = &kvrpcpb.GetCommitTsRequest{}
+			}
+			if err := m.GetCommitTs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchRollback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BatchRollback == nil {
+				m.BatchRollback = &kvrpcpb.BatchRollbackRequest{}
+			}
+			if err := m.BatchRollback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScanLock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScanLock == nil {
+				m.ScanLock = &kvrpcpb.ScanLockRequest{}
+			}
+			if err := m.ScanLock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveLock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResolveLock == nil {
+				m.ResolveLock = &kvrpcpb.ResolveLockRequest{}
+			}
+			if err := m.ResolveLock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GC == nil {
+				m.GC = &kvrpcpb.GCRequest{}
+			}
+			if err := m.GC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteRange == nil {
+				m.DeleteRange = &kvrpcpb.DeleteRangeRequest{}
+			}
+			if err := m.DeleteRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawGet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawGet == nil {
+				m.RawGet = &kvrpcpb.RawGetRequest{}
+			}
+			if err := m.RawGet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawPut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawPut == nil {
+				m.RawPut = &kvrpcpb.RawPutRequest{}
+			}
+			if err := m.RawPut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawDelete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawDelete == nil {
+				m.RawDelete = &kvrpcpb.RawDeleteRequest{}
+			}
+			if err := m.RawDelete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawScan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawScan == nil {
+				m.RawScan = &kvrpcpb.RawScanRequest{}
+			}
+			if err := m.RawScan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawBatchGet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawBatchGet == nil {
+				m.RawBatchGet = &kvrpcpb.RawBatchGetRequest{}
+			}
+			if err := m.RawBatchGet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawBatchPut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawBatchPut == nil {
+				m.RawBatchPut = &kvrpcpb.RawBatchPutRequest{}
+			}
+			if err := m.RawBatchPut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawBatchDelete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawBatchDelete == nil {
+				m.RawBatchDelete = &kvrpcpb.RawBatchDeleteRequest{}
+			}
+			if err := m.RawBatchDelete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coprocessor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Coprocessor == nil {
+				m.Coprocessor = &coprocessor.Request{}
+			}
+			if err := m.Coprocessor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTikvpb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchCommandResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTikvpb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchCommandResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchCommandResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Get == nil {
+				m.Get = &kvrpcpb.GetResponse{}
+			}
+			if err := m.Get.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scan == nil {
+				m.Scan = &kvrpcpb.ScanResponse{}
+			}
+			if err := m.Scan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prewrite", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prewrite == nil {
+				m.Prewrite = &kvrpcpb.PrewriteResponse{}
+			}
+			if err := m.Prewrite.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &kvrpcpb.CommitResponse{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckTxnStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CheckTxnStatus == nil {
+				m.CheckTxnStatus = &kvrpcpb.CheckTxnStatusResponse{}
+			}
+			if err := m.CheckTxnStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnHeartBeat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxnHeartBeat == nil {
+				m.TxnHeartBeat = &kvrpcpb.TxnHeartBeatResponse{}
+			}
+			if err := m.TxnHeartBeat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cleanup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cleanup == nil {
+				m.Cleanup = &kvrpcpb.CleanupResponse{}
+			}
+			if err := m.Cleanup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchGet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BatchGet == nil {
+				m.BatchGet = &kvrpcpb.BatchGetResponse{}
+			}
+			if err := m.BatchGet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckConflicts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CheckConflicts == nil {
+				m.CheckConflicts = &kvrpcpb.CheckConflictsResponse{}
+			}
+			if err := m.CheckConflicts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetCommitTs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GetCommitTs == nil {
+				m.GetCommitTs = &kvrpcpb.GetCommitTsResponse{}
+			}
+			if err := m.GetCommitTs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchRollback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BatchRollback == nil {
+				m.BatchRollback = &kvrpcpb.BatchRollbackResponse{}
+			}
+			if err := m.BatchRollback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScanLock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScanLock == nil {
+				m.ScanLock = &kvrpcpb.ScanLockResponse{}
+			}
+			if err := m.ScanLock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolveLock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResolveLock == nil {
+				m.ResolveLock = &kvrpcpb.ResolveLockResponse{}
+			}
+			if err := m.ResolveLock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GC == nil {
+				m.GC = &kvrpcpb.GCResponse{}
+			}
+			if err := m.GC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteRange == nil {
+				m.DeleteRange = &kvrpcpb.DeleteRangeResponse{}
+			}
+			if err := m.DeleteRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawGet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
-}
-
-func encodeVarintTikvpb(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *BatchRaftMessage) Size() (n int) {
-	var l int
-	_ = l
-	if len(m.Msgs) > 0 {
-		for _, e := range m.Msgs {
-			l = e.Size()
-			n += 1 + l + sovTikvpb(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func sovTikvpb(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
-	}
-	return n
-}
-func sozTikvpb(x uint64) (n int) {
-	return sovTikvpb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *BatchRaftMessage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTikvpb
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.RawGet == nil {
+				m.RawGet = &kvrpcpb.RawGetResponse{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BatchRaftMessage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchRaftMessage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.RawGet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RawPut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -850,8 +4962,208 @@ func (m *BatchRaftMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msgs = append(m.Msgs, &raft_serverpb.RaftMessage{})
-			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RawPut == nil {
+				m.RawPut = &kvrpcpb.RawPutResponse{}
+			}
+			if err := m.RawPut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawDelete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawDelete == nil {
+				m.RawDelete = &kvrpcpb.RawDeleteResponse{}
+			}
+			if err := m.RawDelete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawScan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawScan == nil {
+				m.RawScan = &kvrpcpb.RawScanResponse{}
+			}
+			if err := m.RawScan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawBatchGet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawBatchGet == nil {
+				m.RawBatchGet = &kvrpcpb.RawBatchGetResponse{}
+			}
+			if err := m.RawBatchGet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawBatchPut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawBatchPut == nil {
+				m.RawBatchPut = &kvrpcpb.RawBatchPutResponse{}
+			}
+			if err := m.RawBatchPut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawBatchDelete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RawBatchDelete == nil {
+				m.RawBatchDelete = &kvrpcpb.RawBatchDeleteResponse{}
+			}
+			if err := m.RawBatchDelete.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coprocessor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTikvpb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTikvpb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Coprocessor == nil {
+				m.Coprocessor = &coprocessor.Response{}
+			}
+			if err := m.Coprocessor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -982,40 +5294,113 @@ var (
 	ErrIntOverflowTikvpb   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("tikvpb.proto", fileDescriptor_tikvpb_78b381e194c522ab) }
-
-var fileDescriptor_tikvpb_78b381e194c522ab = []byte{
-	// 505 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0x6b, 0x11, 0x42, 0x3a, 0x50, 0x11, 0x6d, 0x0a, 0xa4, 0xa6, 0x18, 0x94, 0x53, 0x4f,
-	0x46, 0x2a, 0x48, 0x1c, 0x10, 0x08, 0xe2, 0x48, 0x3d, 0xb8, 0x48, 0x91, 0x53, 0xce, 0x68, 0x63,
-	0x4d, 0x9d, 0xc8, 0x8e, 0xd7, 0x78, 0xd7, 0x1b, 0x1e, 0x85, 0xa7, 0xe1, 0xcc, 0x91, 0x47, 0x40,
-	0xe1, 0x45, 0x90, 0x6d, 0x76, 0xfd, 0x2f, 0xe1, 0x14, 0xfb, 0xfb, 0xbe, 0xf9, 0x79, 0xb2, 0xbb,
-	0xb3, 0xf0, 0x40, 0xac, 0x43, 0x99, 0x2c, 0xed, 0x24, 0x65, 0x82, 0x91, 0x7e, 0xf9, 0x66, 0x9e,
-	0x84, 0x32, 0x4d, 0x7c, 0x25, 0x9b, 0xa3, 0x94, 0xde, 0x8a, 0x2f, 0x1c, 0x53, 0x89, 0xa9, 0x16,
-	0x4f, 0x03, 0x16, 0xb0, 0xe2, 0xf1, 0x65, 0xfe, 0x54, 0xaa, 0x93, 0x29, 0x0c, 0xa7, 0x54, 0xf8,
-	0x2b, 0x8f, 0xde, 0x8a, 0x4f, 0xc8, 0x39, 0x0d, 0x90, 0xd8, 0xd0, 0xdb, 0xf0, 0x80, 0x8f, 0x8d,
-	0x17, 0x77, 0x2e, 0xee, 0x5f, 0x9a, 0x76, 0x93, 0x56, 0x4b, 0x7a, 0x45, 0xee, 0xf2, 0xc7, 0x00,
-	0x7a, 0x37, 0xeb, 0x50, 0x92, 0xd7, 0x70, 0xd7, 0x95, 0x57, 0x28, 0xc8, 0xc8, 0x56, 0x0d, 0x5d,
-	0xa1, 0xf0, 0xf0, 0x6b, 0x86, 0x5c, 0x98, 0xa7, 0x4d, 0x91, 0x27, 0x2c, 0xe6, 0x38, 0x39, 0x22,
-	0x6f, 0xa0, 0xef, 0xca, 0x85, 0x4f, 0x63, 0x52, 0x25, 0xf2, 0x57, 0x55, 0xf7, 0xa8, 0xa5, 0xea,
-	0x42, 0x07, 0xc0, 0x95, 0xf3, 0x14, 0xb7, 0xe9, 0x5a, 0x20, 0x19, 0xeb, 0x98, 0x92, 0x14, 0xe0,
-	0x6c, 0x8f, 0xa3, 0x21, 0xef, 0x60, 0xe0, 0x4a, 0x87, 0x6d, 0x36, 0x6b, 0x41, 0x1e, 0xeb, 0x60,
-	0x29, 0x28, 0xc0, 0x93, 0x8e, 0xae, 0xcb, 0x3f, 0xc3, 0xd0, 0x95, 0xce, 0x0a, 0xfd, 0xf0, 0xe6,
-	0x5b, 0xbc, 0x10, 0x54, 0x64, 0x9c, 0x58, 0x55, 0xbc, 0x61, 0x28, 0xdc, 0xf3, 0x83, 0xbe, 0xc6,
-	0x7e, 0x80, 0x63, 0x57, 0x3a, 0x11, 0xd2, 0x38, 0x4b, 0x48, 0xed, 0xf3, 0xa5, 0xa2, 0x40, 0xe3,
-	0xae, 0xd1, 0x5c, 0x9c, 0x62, 0x6b, 0xf3, 0x0d, 0xa9, 0x92, 0x4a, 0xea, 0x2e, 0x4e, 0xe5, 0x68,
-	0x88, 0x07, 0x0f, 0xff, 0x41, 0x3c, 0x16, 0x45, 0x4b, 0xea, 0x87, 0xe4, 0x59, 0x33, 0xaf, 0x74,
-	0x85, 0xb3, 0x0e, 0xd9, 0xcd, 0xc6, 0xf2, 0x9d, 0xbc, 0x66, 0x7e, 0x58, 0x6b, 0x4c, 0x49, 0xdd,
-	0xc6, 0x2a, 0x47, 0x43, 0xae, 0xe1, 0xc4, 0x95, 0x1e, 0x72, 0x16, 0x49, 0x2c, 0x38, 0x4f, 0x75,
-	0xba, 0xa6, 0x2a, 0xd4, 0xf9, 0x7e, 0x53, 0xd3, 0xde, 0x42, 0xdf, 0xa3, 0xdb, 0x7c, 0x9d, 0xaa,
-	0x13, 0x50, 0x0a, 0xdd, 0x13, 0xa0, 0xf4, 0x56, 0xf1, 0x3c, 0x6b, 0x15, 0xcf, 0xb3, 0xfd, 0xc5,
-	0x85, 0xae, 0x8b, 0x67, 0x70, 0xec, 0xd1, 0xed, 0x0c, 0x23, 0x14, 0x48, 0xce, 0xea, 0xb9, 0x52,
-	0x53, 0x08, 0x73, 0x9f, 0xa5, 0x29, 0xef, 0xe1, 0x9e, 0x47, 0xb7, 0xc5, 0x08, 0x35, 0xbe, 0x55,
-	0x9f, 0xa2, 0x71, 0xd7, 0xa8, 0xfd, 0x85, 0x5e, 0x3e, 0xd5, 0xe4, 0x3f, 0xa3, 0x6e, 0x8e, 0x5a,
-	0xde, 0x8c, 0xc5, 0x38, 0x39, 0xba, 0x30, 0xc8, 0x47, 0x18, 0x2c, 0x62, 0x9a, 0xf0, 0x15, 0x13,
-	0xe4, 0xbc, 0x15, 0x52, 0x86, 0xb3, 0xca, 0xe2, 0xf0, 0x20, 0x62, 0x3a, 0xf9, 0xb9, 0xb3, 0x8c,
-	0x5f, 0x3b, 0xcb, 0xf8, 0xbd, 0xb3, 0x8c, 0xef, 0x7f, 0xac, 0x23, 0x18, 0xb2, 0x34, 0xb0, 0xf3,
-	0xcb, 0xcd, 0x0e, 0x65, 0x71, 0x51, 0x2d, 0xfb, 0xc5, 0xcf, 0xab, 0xbf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x8c, 0x38, 0xab, 0xfa, 0x01, 0x05, 0x00, 0x00,
+func init() { proto.RegisterFile("tikvpb.proto", fileDescriptor_tikvpb_25b04ead2f1a5fb8) }
+
+var fileDescriptor_tikvpb_25b04ead2f1a5fb8 = []byte{
+	// 1676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x98, 0x5d, 0x53, 0xdb, 0x46,
+	0x17, 0x80, 0xed, 0x40, 0xc0, 0x3e, 0x36, 0x8e, 0x73, 0x80, 0x20, 0x14, 0x07, 0x78, 0xf5, 0xbe,
+	0x6f, 0x87, 0x5e, 0xd4, 0x21, 0xe4, 0xab, 0x6d, 0xda, 0x7c, 0x60, 0x32, 0x40, 0x1c, 0xa6, 0x1e,
+	0x43, 0x26, 0xd3, 0x2b, 0x8f, 0x90, 0x17, 0xe3, 0xf1, 0x87, 0x5c, 0x49, 0x36, 0xe4, 0xae, 0x3f,
+	0xa3, 0x7f, 0xa6, 0xf7, 0xbd, 0xec, 0x0f, 0xe8, 0x4c, 0x3b, 0xe9, 0x1f, 0xe9, 0x68, 0xb5, 0xbb,
+	0x5a, 0x49, 0x2b, 0xb9, 0x57, 0x31, 0xe7, 0x6b, 0xf7, 0x1c, 0x1d, 0x3d, 0xe7, 0x44, 0x50, 0xf6,
+	0xfa, 0x83, 0xd9, 0xe4, 0xa2, 0x3e, 0x71, 0x6c, 0xcf, 0xc6, 0xa5, 0xe0, 0x2f, 0xfd, 0xae, 0x65,
+	0x4f, 0x1c, 0xdb, 0x22, 0xae, 0x6b, 0x3b, 0x81, 0x4a, 0x5f, 0x19, 0xcc, 0x9c, 0x89, 0xc5, 0x2d,
+	0xf5, 0xaa, 0x63, 0x5e, 0x7a, 0x1d, 0x6b, 0xd4, 0x15, 0x92, 0x55, 0x2a, 0x71, 0x89, 0x33, 0x23,
+	0x8e, 0x10, 0xae, 0xf5, 0xec, 0x9e, 0x4d, 0x7f, 0x3e, 0xf4, 0x7f, 0x05, 0x52, 0xe3, 0x00, 0xaa,
+	0x07, 0xa6, 0x67, 0x5d, 0xb5, 0xcd, 0x4b, 0xef, 0x94, 0xb8, 0xae, 0xd9, 0x23, 0x58, 0x87, 0xc5,
+	0x91, 0xdb, 0x73, 0xb5, 0xfc, 0xce, 0xc2, 0x6e, 0x69, 0x5f, 0xaf, 0x47, 0xa3, 0x49, 0x96, 0x6d,
+	0x6a, 0x67, 0xf4, 0x61, 0x8d, 0xc6, 0x68, 0xd8, 0xa3, 0x91, 0x39, 0xee, 0xba, 0x6d, 0xf2, 0xd3,
+	0x94, 0xb8, 0x1e, 0xee, 0x41, 0xc1, 0x09, 0x7e, 0xf2, 0x58, 0x6b, 0x75, 0x96, 0xa3, 0x6c, 0xdf,
+	0x16, 0x56, 0xb8, 0x0d, 0x25, 0xf6, 0xbb, 0xd3, 0xef, 0xba, 0xda, 0xad, 0x9d, 0x85, 0xdd, 0xc5,
+	0x36, 0x30, 0xd1, 0x49, 0xd7, 0x35, 0x3c, 0x58, 0x8f, 0x1d, 0xe5, 0x4e, 0xec, 0xb1, 0x4b, 0xf0,
+	0x5b, 0x28, 0x3a, 0xec, 0x37, 0x3f, 0xac, 0xa6, 0x3c, 0x8c, 0x19, 0xb5, 0x43, 0xf3, 0xf9, 0xa7,
+	0xfe, 0x0a, 0x50, 0x96, 0x83, 0xe0, 0xff, 0x61, 0xa1, 0x47, 0x3c, 0x2d, 0xbf, 0x93, 0xdf, 0x2d,
+	0xed, 0xaf, 0xd6, 0xf9, 0xf3, 0x38, 0x22, 0x1e, 0xcb, 0xbd, 0xed, 0xeb, 0x71, 0x17, 0x16, 0x5d,
+	0xcb, 0x1c, 0x6b, 0xb7, 0xa8, 0xdd, 0x9a, 0xb0, 0x3b, 0xb3, 0xcc, 0x31, 0x37, 0xa4, 0x16, 0xf8,
+	0x04, 0x0a, 0x13, 0x87, 0x5c, 0x3b, 0x7d, 0x8f, 0x68, 0x0b, 0xd4, 0x5a, 0x13, 0xd6, 0x2d, 0xa6,
+	0xe0, 0x1e, 0xc2, 0x12, 0xeb, 0xb0, 0x64, 0xd9, 0xa3, 0x51, 0xdf, 0xd3, 0x16, 0xa9, 0xcf, 0x3d,
+	0xe1, 0xd3, 0xa0, 0x62, 0xee, 0xc1, 0xac, 0xf0, 0x18, 0xaa, 0xd6, 0x15, 0xb1, 0x06, 0x1d, 0xef,
+	0x66, 0xdc, 0x71, 0x3d, 0xd3, 0x9b, 0xba, 0xda, 0x6d, 0xea, 0xb9, 0x15, 0x7a, 0xfa, 0x06, 0xe7,
+	0x37, 0xe3, 0x33, 0xaa, 0xe6, 0x11, 0x2a, 0x56, 0x44, 0x8c, 0x07, 0x50, 0xf1, 0x63, 0x5c, 0x11,
+	0xd3, 0xf1, 0x3a, 0x17, 0xc4, 0xf4, 0xb4, 0x25, 0x1a, 0xa7, 0x26, 0xe2, 0x9c, 0xdf, 0x8c, 0x8f,
+	0x7d, 0xed, 0x01, 0x31, 0xc5, 0x3d, 0xca, 0x9e, 0x24, 0xc4, 0x47, 0xb0, 0x6c, 0x0d, 0x89, 0x39,
+	0x9e, 0x4e, 0xb4, 0x65, 0xea, 0xbc, 0x11, 0x5e, 0x22, 0x90, 0x73, 0x3f, 0x6e, 0x87, 0x4f, 0xa1,
+	0x78, 0xe1, 0x3f, 0x87, 0x8e, 0x5f, 0xfd, 0x42, 0xac, 0x4e, 0xf4, 0x09, 0x49, 0x8f, 0xa0, 0x70,
+	0xc1, 0x04, 0x78, 0x04, 0x77, 0x82, 0xbc, 0x2d, 0x7b, 0x7c, 0x39, 0xec, 0x5b, 0x9e, 0xab, 0x15,
+	0x55, 0x69, 0x37, 0xb8, 0x3a, 0x9a, 0xb6, 0x10, 0xe3, 0x2b, 0x58, 0xe9, 0x11, 0xaf, 0x13, 0x94,
+	0xb3, 0xe3, 0xb9, 0x1a, 0xd0, 0x30, 0xf7, 0xe5, 0x0e, 0x08, 0x4a, 0x7f, 0x2e, 0x62, 0x94, 0x7a,
+	0xa1, 0x0c, 0x0f, 0xa1, 0x12, 0x24, 0xe0, 0xd8, 0xc3, 0xe1, 0x85, 0x69, 0x0d, 0xb4, 0x12, 0x8d,
+	0xf0, 0x20, 0x9a, 0x45, 0x9b, 0x69, 0x79, 0x8c, 0x95, 0x0b, 0x59, 0xea, 0x97, 0xc1, 0xef, 0x9a,
+	0xce, 0xd0, 0xb6, 0x06, 0x5a, 0x39, 0x56, 0x06, 0xbf, 0xb9, 0xde, 0xdb, 0xa1, 0x6f, 0xc1, 0x65,
+	0x02, 0x7c, 0x09, 0x65, 0x87, 0xb8, 0xf6, 0x70, 0x46, 0x02, 0xcf, 0x95, 0xd8, 0xe5, 0xdb, 0x81,
+	0x52, 0x76, 0x2e, 0x39, 0xa1, 0x0c, 0xff, 0x0b, 0x0b, 0xbd, 0x8e, 0xa5, 0x55, 0xa8, 0x1b, 0x86,
+	0x39, 0x37, 0xb8, 0xf5, 0xad, 0x5e, 0xc3, 0x3f, 0xa4, 0x4b, 0x86, 0xc4, 0x23, 0x1d, 0xc7, 0x1c,
+	0xf7, 0x88, 0x76, 0x27, 0x76, 0xc8, 0x21, 0x55, 0xb6, 0x7d, 0x9d, 0x38, 0xa4, 0x1b, 0xca, 0xf0,
+	0x21, 0x2c, 0x3b, 0xe6, 0x35, 0x7d, 0xc0, 0xd5, 0x58, 0x53, 0xb7, 0xcd, 0x6b, 0xe9, 0xf1, 0x2e,
+	0x39, 0xf4, 0x4f, 0xee, 0x30, 0x99, 0x7a, 0xda, 0xdd, 0xa4, 0x43, 0x6b, 0x1a, 0x71, 0x68, 0x4d,
+	0x3d, 0xfc, 0x1a, 0xc0, 0x77, 0x08, 0x0e, 0xd5, 0x90, 0xfa, 0x6c, 0xca, 0x3e, 0xec, 0x8a, 0xcc,
+	0xad, 0xe8, 0x70, 0x09, 0xee, 0x43, 0xc1, 0xf7, 0xa4, 0xef, 0xf4, 0x6a, 0xac, 0x65, 0xdb, 0xe6,
+	0xb5, 0xfc, 0x5a, 0x2f, 0x3b, 0xc1, 0xdf, 0x7e, 0xcb, 0xf8, 0x3e, 0x61, 0xdb, 0xae, 0xc5, 0xab,
+	0x6e, 0x5e, 0xc7, 0x3b, 0xb7, 0xe4, 0x84, 0xb2, 0x68, 0x00, 0x3f, 0xcb, 0xf5, 0x94, 0x00, 0xad,
+	0x69, 0x32, 0x80, 0x9f, 0xef, 0x31, 0x54, 0xc3, 0x00, 0x2c, 0xeb, 0x7b, 0xb1, 0xf6, 0xe7, 0x31,
+	0xa2, 0xa9, 0x57, 0x9c, 0x88, 0x18, 0x9f, 0x41, 0x49, 0x9a, 0x46, 0xda, 0x06, 0xc3, 0x9a, 0x24,
+	0xab, 0x8b, 0x1b, 0x48, 0x42, 0xe3, 0x0f, 0x80, 0x35, 0x15, 0x84, 0xf1, 0x0b, 0x99, 0xa3, 0x6b,
+	0x51, 0x8e, 0x06, 0x26, 0x01, 0x48, 0xbf, 0x8c, 0x80, 0x74, 0x3d, 0x06, 0x52, 0x66, 0x19, 0x90,
+	0xf4, 0x69, 0x82, 0xa4, 0x9b, 0x0a, 0x92, 0x32, 0x97, 0x10, 0xa5, 0x0f, 0x63, 0x28, 0xdd, 0x48,
+	0xa0, 0x94, 0xb9, 0x70, 0x96, 0x9e, 0xa4, 0xb2, 0x74, 0x3b, 0x95, 0xa5, 0x2c, 0x44, 0x1c, 0xa6,
+	0x8d, 0x14, 0x98, 0x3e, 0x48, 0x81, 0x29, 0x0b, 0x13, 0xa5, 0xe9, 0x7e, 0x9c, 0xa6, 0x5a, 0x92,
+	0xa6, 0xcc, 0x51, 0xe0, 0xf4, 0x59, 0x12, 0xa7, 0x9b, 0x0a, 0x9c, 0xf2, 0x62, 0x09, 0x9e, 0x1e,
+	0xa7, 0xf1, 0x74, 0x3b, 0x95, 0xa7, 0x91, 0xd4, 0x85, 0x1c, 0x5f, 0xab, 0x81, 0x5a, 0x53, 0x03,
+	0x95, 0x05, 0x89, 0x10, 0xf5, 0x6d, 0x0a, 0x51, 0xb7, 0xd2, 0x88, 0xca, 0x82, 0xc4, 0x90, 0xfa,
+	0x2c, 0x89, 0xd4, 0x4d, 0x05, 0x52, 0x79, 0x29, 0x04, 0x53, 0x5f, 0x29, 0x99, 0x5a, 0x53, 0x33,
+	0x95, 0xdf, 0x5f, 0x86, 0xea, 0xff, 0x64, 0xa8, 0xae, 0x46, 0xa0, 0xca, 0xcc, 0x7d, 0xaa, 0xbe,
+	0x52, 0x52, 0xb5, 0xa6, 0xa6, 0x2a, 0x3f, 0x46, 0xc6, 0xea, 0x5e, 0x1c, 0xab, 0x1b, 0x09, 0xac,
+	0xf2, 0x06, 0x67, 0x5c, 0xdd, 0x8b, 0x73, 0x75, 0x23, 0xc1, 0x55, 0xc9, 0xc3, 0x07, 0xcd, 0x37,
+	0x0a, 0xb0, 0xea, 0x2a, 0xb0, 0x8a, 0x15, 0x8c, 0x8b, 0xf0, 0x71, 0x82, 0xac, 0x5a, 0x92, 0xac,
+	0xbc, 0x7d, 0x39, 0x5a, 0x5f, 0xab, 0xd1, 0x5a, 0x53, 0xa3, 0x55, 0x14, 0x3f, 0x14, 0x46, 0x23,
+	0x84, 0x6c, 0xad, 0xa9, 0xd9, 0x1a, 0x8f, 0xe0, 0xe7, 0x7c, 0x92, 0x0a, 0xd7, 0xed, 0x54, 0xb8,
+	0xf2, 0x77, 0x21, 0x46, 0xd7, 0xe7, 0x2a, 0xba, 0xae, 0xc7, 0xe8, 0xca, 0xef, 0x20, 0x49, 0xf7,
+	0xff, 0xbc, 0x07, 0x8b, 0xe7, 0xfd, 0xc1, 0x0c, 0x9f, 0xc0, 0xed, 0xe6, 0xcc, 0xcf, 0x4b, 0xb5,
+	0x92, 0xea, 0x4a, 0xbe, 0x1a, 0x39, 0x7c, 0x0e, 0x4b, 0xcd, 0x19, 0x2d, 0xa8, 0x72, 0x43, 0xd5,
+	0xd5, 0xb8, 0x35, 0x72, 0xd8, 0x00, 0x68, 0xce, 0x38, 0x53, 0x31, 0x75, 0x61, 0xd5, 0xd3, 0x01,
+	0x6c, 0xe4, 0xf0, 0x7b, 0x28, 0x34, 0x67, 0xc1, 0xdb, 0x8c, 0x29, 0xfb, 0xab, 0x9e, 0x06, 0x63,
+	0x23, 0x87, 0x1f, 0xa0, 0xda, 0x9c, 0x45, 0x39, 0x8b, 0x73, 0x96, 0x59, 0x7d, 0x1e, 0xa0, 0x8d,
+	0x1c, 0xfe, 0x00, 0x95, 0xe6, 0x4c, 0xa6, 0x2e, 0x66, 0x6e, 0xb6, 0x7a, 0x36, 0xaa, 0x8d, 0x1c,
+	0xbe, 0x86, 0x62, 0x73, 0xc6, 0x40, 0x8c, 0x69, 0x8b, 0xae, 0x9e, 0xca, 0x6c, 0x5e, 0x6d, 0xd1,
+	0xb9, 0xa9, 0x6b, 0xaf, 0x9e, 0x4e, 0xf0, 0x48, 0xb9, 0x42, 0x06, 0xcf, 0x59, 0x82, 0xf5, 0x79,
+	0x50, 0x37, 0x72, 0xf8, 0x1e, 0x56, 0x68, 0xe3, 0x09, 0x2a, 0x67, 0x6d, 0xc4, 0x7a, 0x26, 0xdd,
+	0x8d, 0x1c, 0xb6, 0xe1, 0x0e, 0xcb, 0x54, 0xe0, 0x39, 0x7b, 0x3f, 0xd6, 0xe7, 0xc0, 0x9e, 0x57,
+	0x8f, 0x73, 0x1c, 0x53, 0xb7, 0x65, 0x3d, 0x1d, 0xfa, 0x3c, 0x4d, 0x89, 0xe8, 0x98, 0xb5, 0x3b,
+	0xeb, 0x99, 0x43, 0xc0, 0xc8, 0xe1, 0x23, 0x58, 0x6c, 0xce, 0x8e, 0x1a, 0xa8, 0xd8, 0xa4, 0x75,
+	0xd5, 0x20, 0xe0, 0x17, 0x90, 0x58, 0x8f, 0x59, 0x7b, 0xb5, 0x9e, 0x39, 0x1e, 0x8c, 0x1c, 0xbe,
+	0x80, 0xa5, 0x80, 0xfd, 0x98, 0xb2, 0x63, 0xeb, 0x69, 0x43, 0x42, 0x38, 0xb7, 0xa6, 0x31, 0xe7,
+	0xd6, 0x54, 0xed, 0x2c, 0x01, 0xd4, 0xc8, 0xe1, 0x21, 0x14, 0xc5, 0x38, 0xc0, 0xf4, 0xdd, 0x5b,
+	0xcf, 0x98, 0x1e, 0x46, 0x0e, 0x5f, 0xc2, 0x32, 0x9b, 0x0d, 0x98, 0xb6, 0x87, 0xeb, 0xa9, 0x63,
+	0xc4, 0xc8, 0xe1, 0x3b, 0x28, 0x49, 0x13, 0x02, 0xb3, 0x56, 0x72, 0x3d, 0x73, 0xa8, 0x44, 0x63,
+	0xb5, 0xa6, 0xaa, 0x58, 0xad, 0x69, 0x46, 0xac, 0x68, 0x75, 0xce, 0xa0, 0x12, 0x1d, 0x19, 0x38,
+	0x67, 0x51, 0xd7, 0xe7, 0xcd, 0x1a, 0x4a, 0xb4, 0xf2, 0xdb, 0x9b, 0x89, 0xed, 0x78, 0x6d, 0xd2,
+	0xeb, 0xdb, 0x63, 0x89, 0x67, 0xb2, 0x38, 0xc9, 0xb3, 0xa8, 0x96, 0x87, 0xdb, 0xcb, 0xe3, 0x29,
+	0x94, 0x4f, 0x46, 0xca, 0x80, 0x27, 0xa3, 0xac, 0x80, 0x27, 0x23, 0x55, 0x40, 0xfc, 0x00, 0x95,
+	0xe0, 0xa8, 0xb3, 0xb1, 0x39, 0x71, 0xaf, 0x6c, 0x4f, 0x4a, 0x3a, 0xaa, 0x48, 0x26, 0x1d, 0xd7,
+	0x4b, 0xb7, 0xfc, 0x39, 0x0f, 0x0f, 0x8e, 0x08, 0x3b, 0xee, 0xcd, 0x64, 0xe2, 0xd8, 0x37, 0xfd,
+	0x91, 0xe9, 0x91, 0xb3, 0xc9, 0xb0, 0xef, 0x35, 0xc9, 0x27, 0x17, 0xbf, 0x8a, 0x8e, 0xc5, 0x34,
+	0x3b, 0x7e, 0x6a, 0xfd, 0xdf, 0x9a, 0xcb, 0xad, 0x41, 0xc5, 0xac, 0x4e, 0x61, 0x6b, 0x48, 0xd2,
+	0x64, 0x6b, 0x44, 0x94, 0xf2, 0x53, 0xfc, 0x48, 0x09, 0x47, 0x15, 0xae, 0x54, 0x74, 0x59, 0x9c,
+	0x2c, 0x7a, 0x54, 0x2b, 0xd5, 0xe7, 0x10, 0x8a, 0x67, 0x84, 0x74, 0x3f, 0x3a, 0xfd, 0xc8, 0x9b,
+	0x28, 0x64, 0xc9, 0x37, 0x51, 0x52, 0x89, 0x6b, 0x9d, 0x42, 0xd9, 0x17, 0xd3, 0xf9, 0xe0, 0x4e,
+	0x47, 0xd2, 0xb5, 0x64, 0x71, 0xf2, 0x5a, 0x51, 0xad, 0x34, 0x2c, 0x97, 0x5b, 0x8e, 0x7d, 0xd9,
+	0x1f, 0x12, 0xe9, 0xc5, 0x66, 0x92, 0xe4, 0x8b, 0x2d, 0x14, 0xd1, 0xe6, 0x3c, 0x9d, 0x59, 0xd6,
+	0x11, 0xf1, 0x0e, 0x3e, 0x35, 0xc9, 0x27, 0xe9, 0x42, 0xb2, 0x38, 0x79, 0xa1, 0xa8, 0x56, 0x5c,
+	0xe8, 0x47, 0xa8, 0x0a, 0xcd, 0x99, 0x67, 0x3a, 0xfe, 0x88, 0xdb, 0x49, 0x3a, 0x31, 0x15, 0x0f,
+	0xfb, 0x9f, 0x0c, 0x0b, 0x11, 0xfa, 0x3b, 0x28, 0x35, 0xc2, 0x5d, 0x0e, 0x95, 0xff, 0x9b, 0xd6,
+	0xd5, 0x5b, 0x20, 0x1d, 0x95, 0x2b, 0x91, 0xef, 0xa1, 0xa8, 0xfc, 0xe8, 0x29, 0x35, 0x84, 0x5a,
+	0xcb, 0xe3, 0xed, 0xe6, 0xf7, 0xf2, 0xf8, 0x02, 0x16, 0xfd, 0x6f, 0xbc, 0x98, 0xf1, 0xe1, 0x57,
+	0x5f, 0x8d, 0xe9, 0x0e, 0xed, 0x31, 0x75, 0xc7, 0xb7, 0x50, 0x14, 0xdf, 0x93, 0x71, 0x3b, 0x66,
+	0x15, 0xff, 0xd2, 0x9c, 0x1e, 0xe6, 0x0d, 0x14, 0x04, 0x07, 0x6a, 0x31, 0x23, 0xae, 0x68, 0x5c,
+	0x4d, 0xc7, 0x83, 0xf4, 0x10, 0xef, 0x60, 0xc5, 0x3f, 0xea, 0xbd, 0xdd, 0x63, 0x6b, 0xa1, 0x5e,
+	0x97, 0x3e, 0x94, 0xfb, 0xaa, 0xc6, 0xa8, 0xcb, 0x0b, 0x73, 0x5f, 0xa9, 0xe3, 0x65, 0x39, 0x30,
+	0x7e, 0xfb, 0xbc, 0x95, 0xff, 0xfd, 0xf3, 0x56, 0xfe, 0xaf, 0xcf, 0x5b, 0xf9, 0x5f, 0xfe, 0xde,
+	0xca, 0x41, 0xd5, 0x76, 0x7a, 0xb4, 0x98, 0xf5, 0xc1, 0x8c, 0x7e, 0x49, 0xbf, 0x58, 0xa2, 0xff,
+	0x3c, 0xfe, 0x67, 0x00, 0x8a, 0xc7, 0xe9, 0x58, 0xc7, 0x17, 0x00, 0x00,
 }
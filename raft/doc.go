@@ -236,7 +236,11 @@ stale log entries:
 	'MessageType_MsgAppendResponse' is response to log replication request('MessageType_MsgAppend'). When
 	'MessageType_MsgAppend' is passed to candidate or follower's Step method, it responds by
 	calling 'handleAppendEntries' method, which sends 'MessageType_MsgAppendResponse' to raft
-	mailbox.
+	mailbox. A rejection carries a hint (RejectHint, LogTerm): the largest index at which
+	the follower's log may still match the leader's, and the term of the follower's entry
+	there. The leader skips its own entries after the hint whose term is larger than that
+	when it probes again, so a follower with a long divergent tail catches up in a few
+	round trips instead of one round trip per entry.
 
 	'MessageType_MsgRequestVote' requests votes for election. When a node is a follower or
 	candidate and 'MessageType_MsgHup' is passed to its Step method, then the node calls
@@ -290,5 +294,17 @@ stale log entries:
 	indicating 'MessageType_MsgAppend' is lost. When follower's progress state is replicate,
 	the leader sets it back to probe.
 
+	'MessageType_MsgReadIndex' requests a read index for a read only request, the request
+	context is carried by its only entry. A follower forwards it to the leader. With
+	ReadOnlySafe, the leader records the request with its committed index and attaches
+	the context to a round of heartbeats, the request is answered once a quorum has
+	responded to them. With ReadOnlyLeaseBased, the leader answers it with its committed
+	index immediately. The leader drops the request until it has committed an entry of
+	its term.
+
+	'MessageType_MsgReadIndexResponse' answers 'MessageType_MsgReadIndex' with the read index.
+	The read index and the request context are returned to the application as a ReadState
+	in Ready.
+
 */
 package raft
@@ -71,6 +71,7 @@ func newLogWithSize(storage Storage, logger Logger, maxNextEntsSize uint64) *Raf
 		panic(err) // TODO(bdarnell)
 	}
 	log.unstable.offset = lastIndex + 1
+	log.unstable.offsetInProgress = lastIndex + 1
 	log.unstable.logger = logger
 	// Initialize our committed and applied pointers to the time of the last compaction.
 	log.committed = firstIndex - 1
@@ -138,6 +139,36 @@ func (l *RaftLog) findConflict(ents []pb.Entry) uint64 {
 	return 0
 }
 
+// findConflictByTerm takes an (index, term) pair (indicating a conflicting log
+// entry on a leader/follower during an append) and finds the largest index in
+// log l with a term <= `term` and an index <= `index`. If no such index exists
+// in the log, the log's first index is returned.
+//
+// The index provided MUST be equal to or less than l.LastIndex(). Invalid
+// inputs log a warning and the input index is returned.
+func (l *RaftLog) findConflictByTerm(index uint64, term uint64) uint64 {
+	if li := l.LastIndex(); index > li {
+		// NB: such calls should not exist, but since there is a straightforward
+		// way to recover, do it.
+		//
+		// It is tempting to also check something about the first index, but
+		// there is odd behavior with peers that have no log, in which case
+		// LastIndex will return zero and FirstIndex will return one, which
+		// leads to calls with an index of zero into this method.
+		l.logger.Warningf("index(%d) is out of range [0, lastIndex(%d)] in findConflictByTerm",
+			index, li)
+		return index
+	}
+	for {
+		logTerm, err := l.Term(index)
+		if logTerm <= term || err != nil {
+			break
+		}
+		index--
+	}
+	return index
+}
+
 func (l *RaftLog) unstableEntries() []pb.Entry {
 	if len(l.unstable.entries) == 0 {
 		return nil
@@ -145,6 +176,35 @@ func (l *RaftLog) unstableEntries() []pb.Entry {
 	return l.unstable.entries
 }
 
+// nextUnstableEnts returns all entries that are available to be written to the
+// local stable log and are not already in-progress.
+func (l *RaftLog) nextUnstableEnts() []pb.Entry {
+	return l.unstable.nextEntries()
+}
+
+// hasNextUnstableEnts returns if there are any entries that are available to be
+// written to the local stable log and are not already in-progress.
+func (l *RaftLog) hasNextUnstableEnts() bool {
+	return len(l.nextUnstableEnts()) > 0
+}
+
+// nextUnstableSnapshot returns the unstable snapshot, if one exists that is
+// not already in-progress.
+func (l *RaftLog) nextUnstableSnapshot() *pb.Snapshot {
+	return l.unstable.nextSnapshot()
+}
+
+// hasNextUnstableSnapshot returns if there is a snapshot that is available to
+// be written to the local stable log and is not already in-progress.
+func (l *RaftLog) hasNextUnstableSnapshot() bool {
+	return l.nextUnstableSnapshot() != nil
+}
+
+// acceptUnstable indicates that the application has started persisting the
+// unstable entries and snapshot returned by nextUnstableEnts and
+// nextUnstableSnapshot.
+func (l *RaftLog) acceptUnstable() { l.unstable.acceptInProgress() }
+
 // nextEnts returns all the available entries for execution.
 // If applied is smaller than the index of snapshot, it returns all committed
 // entries after the index of snapshot.
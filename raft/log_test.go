@@ -55,6 +55,37 @@ func TestFindConflict(t *testing.T) {
 	}
 }
 
+func TestFindConflictByTerm(t *testing.T) {
+	ents := []pb.Entry{{Index: 1, Term: 1}, {Index: 2, Term: 2}, {Index: 3, Term: 2}, {Index: 4, Term: 4}, {Index: 5, Term: 4}}
+	tests := []struct {
+		index uint64
+		term  uint64
+		want  uint64
+	}{
+		// the entry at index has a term <= term
+		{5, 4, 5},
+		{5, 5, 5},
+		{3, 2, 3},
+		// skip the entries with larger terms
+		{5, 3, 3},
+		{5, 2, 3},
+		{5, 1, 1},
+		{3, 1, 1},
+		// no entry has a term <= term
+		{5, 0, 0},
+		// out of range
+		{6, 4, 6},
+	}
+	for i, tt := range tests {
+		raftLog := newLog(NewMemoryStorage(), raftLogger)
+		raftLog.append(ents...)
+
+		if got := raftLog.findConflictByTerm(tt.index, tt.term); got != tt.want {
+			t.Errorf("#%d: findConflictByTerm(%d, %d) = %d, want %d", i, tt.index, tt.term, got, tt.want)
+		}
+	}
+}
+
 func TestIsUpToDate(t *testing.T) {
 	previousEnts := []pb.Entry{{Index: 1, Term: 1}, {Index: 2, Term: 2}, {Index: 3, Term: 3}}
 	raftLog := newLog(NewMemoryStorage(), raftLogger)
@@ -27,9 +27,47 @@ type unstable struct {
 	entries []pb.Entry
 	offset  uint64
 
+	// entries[:offsetInProgress-offset] have been handed out in a Ready and
+	// are being written to storage. Like offset, offsetInProgress is exclusive.
+	offsetInProgress uint64
+	// snapshotInProgress is true if the snapshot has been handed out in a Ready
+	// and is being written to storage.
+	snapshotInProgress bool
+
 	logger Logger
 }
 
+// nextEntries returns the unstable entries that are not already in the
+// process of being written to storage.
+func (u *unstable) nextEntries() []pb.Entry {
+	inProgress := int(u.offsetInProgress - u.offset)
+	if len(u.entries) == inProgress {
+		return nil
+	}
+	return u.entries[inProgress:]
+}
+
+// nextSnapshot returns the unstable snapshot, if one exists that is not
+// already in the process of being written to storage.
+func (u *unstable) nextSnapshot() *pb.Snapshot {
+	if u.snapshot == nil || u.snapshotInProgress {
+		return nil
+	}
+	return u.snapshot
+}
+
+// acceptInProgress marks all entries and the snapshot, if any, in the unstable
+// as having begun the process of being written to storage. The entries/snapshot
+// will no longer be returned from nextEntries/nextSnapshot.
+func (u *unstable) acceptInProgress() {
+	if len(u.entries) > 0 {
+		u.offsetInProgress = u.entries[len(u.entries)-1].Index + 1
+	}
+	if u.snapshot != nil {
+		u.snapshotInProgress = true
+	}
+}
+
 // maybeFirstIndex returns the index of the first possible entry in entries
 // if it has a snapshot.
 func (u *unstable) maybeFirstIndex() (uint64, bool) {
@@ -85,6 +123,7 @@ func (u *unstable) stableTo(i, t uint64) {
 	if gt == t && i >= u.offset {
 		u.entries = u.entries[i+1-u.offset:]
 		u.offset = i + 1
+		u.offsetInProgress = max(u.offsetInProgress, u.offset)
 		u.shrinkEntriesArray()
 	}
 }
@@ -111,13 +150,16 @@ func (u *unstable) shrinkEntriesArray() {
 func (u *unstable) stableSnapTo(i uint64) {
 	if u.snapshot != nil && u.snapshot.Metadata.Index == i {
 		u.snapshot = nil
+		u.snapshotInProgress = false
 	}
 }
 
 func (u *unstable) restore(s pb.Snapshot) {
 	u.offset = s.Metadata.Index + 1
+	u.offsetInProgress = u.offset
 	u.entries = nil
 	u.snapshot = &s
+	u.snapshotInProgress = false
 }
 
 func (u *unstable) truncateAndAppend(ents []pb.Entry) {
@@ -132,6 +174,7 @@ func (u *unstable) truncateAndAppend(ents []pb.Entry) {
 		// The log is being truncated to before our current offset
 		// portion, so set the offset and replace the entries
 		u.offset = after
+		u.offsetInProgress = u.offset
 		u.entries = ents
 	default:
 		// truncate to after and copy to u.entries
@@ -139,6 +182,8 @@ func (u *unstable) truncateAndAppend(ents []pb.Entry) {
 		u.logger.Infof("truncate the unstable entries before index %d", after)
 		u.entries = append([]pb.Entry{}, u.slice(u.offset, after)...)
 		u.entries = append(u.entries, ents...)
+		// Only in-progress entries before after are still being written.
+		u.offsetInProgress = min(u.offsetInProgress, after)
 	}
 }
 
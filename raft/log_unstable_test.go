@@ -357,3 +357,112 @@ func TestUnstableTruncateAndAppend(t *testing.T) {
 		}
 	}
 }
+
+func TestUnstableAcceptInProgress(t *testing.T) {
+	tests := []struct {
+		entries            []pb.Entry
+		snapshot           *pb.Snapshot
+		offsetInProgress   uint64
+		snapshotInProgress bool
+
+		woffsetInProgress   uint64
+		wsnapshotInProgress bool
+	}{
+		{
+			[]pb.Entry{}, nil,
+			5, // no entries
+			false,
+			5, false,
+		},
+		{
+			[]pb.Entry{{Index: 5, Term: 1}}, nil,
+			5, // entries not in progress
+			false,
+			6, false,
+		},
+		{
+			[]pb.Entry{{Index: 5, Term: 1}, {Index: 6, Term: 1}}, nil,
+			6, // partially in progress
+			false,
+			7, false,
+		},
+		{
+			[]pb.Entry{{Index: 5, Term: 1}}, &pb.Snapshot{Metadata: &pb.SnapshotMetadata{Index: 4, Term: 1}},
+			5, // snapshot not in progress
+			false,
+			6, true,
+		},
+		{
+			[]pb.Entry{}, &pb.Snapshot{Metadata: &pb.SnapshotMetadata{Index: 4, Term: 1}},
+			5, // snapshot already in progress
+			true,
+			5, true,
+		},
+	}
+
+	for i, tt := range tests {
+		u := unstable{
+			entries:            tt.entries,
+			offset:             5,
+			snapshot:           tt.snapshot,
+			offsetInProgress:   tt.offsetInProgress,
+			snapshotInProgress: tt.snapshotInProgress,
+			logger:             raftLogger,
+		}
+		u.acceptInProgress()
+		if u.offsetInProgress != tt.woffsetInProgress {
+			t.Errorf("#%d: offsetInProgress = %d, want %d", i, u.offsetInProgress, tt.woffsetInProgress)
+		}
+		if u.snapshotInProgress != tt.wsnapshotInProgress {
+			t.Errorf("#%d: snapshotInProgress = %t, want %t", i, u.snapshotInProgress, tt.wsnapshotInProgress)
+		}
+		if ents := u.nextEntries(); len(ents) != 0 {
+			t.Errorf("#%d: nextEntries = %v, want none", i, ents)
+		}
+		if snap := u.nextSnapshot(); snap != nil {
+			t.Errorf("#%d: nextSnapshot = %v, want nil", i, snap)
+		}
+	}
+}
+
+func TestUnstableTruncateInProgress(t *testing.T) {
+	tests := []struct {
+		toappend []pb.Entry
+
+		woffsetInProgress uint64
+		wnext             []pb.Entry
+	}{
+		// append to the end
+		{
+			[]pb.Entry{{Index: 8, Term: 1}},
+			8, []pb.Entry{{Index: 8, Term: 1}},
+		},
+		// truncate some in-progress entries
+		{
+			[]pb.Entry{{Index: 6, Term: 2}},
+			6, []pb.Entry{{Index: 6, Term: 2}},
+		},
+		// replace all entries
+		{
+			[]pb.Entry{{Index: 4, Term: 2}},
+			4, []pb.Entry{{Index: 4, Term: 2}},
+		},
+	}
+
+	for i, tt := range tests {
+		u := unstable{
+			entries:          []pb.Entry{{Index: 5, Term: 1}, {Index: 6, Term: 1}, {Index: 7, Term: 1}},
+			offset:           5,
+			offsetInProgress: 5,
+			logger:           raftLogger,
+		}
+		u.acceptInProgress()
+		u.truncateAndAppend(tt.toappend)
+		if u.offsetInProgress != tt.woffsetInProgress {
+			t.Errorf("#%d: offsetInProgress = %d, want %d", i, u.offsetInProgress, tt.woffsetInProgress)
+		}
+		if next := u.nextEntries(); !reflect.DeepEqual(next, tt.wnext) {
+			t.Errorf("#%d: nextEntries = %v, want %v", i, next, tt.wnext)
+		}
+	}
+}
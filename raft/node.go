@@ -60,6 +60,12 @@ type Ready struct {
 	// HardState will be equal to empty state if there is no update.
 	pb.HardState
 
+	// ReadStates can be used for node to serve linearizable read requests locally
+	// when its applied index is greater than the index in ReadState.
+	// Note that the readState will be returned when raft receives msgReadIndex.
+	// The returned is only valid for the request that requested to read.
+	ReadStates []ReadState
+
 	// Entries specifies entries to be saved to stable storage BEFORE
 	// Messages are sent.
 	Entries []pb.Entry
@@ -103,7 +109,7 @@ func IsEmptySnap(sp *pb.Snapshot) bool {
 func (rd Ready) containsUpdates() bool {
 	return rd.SoftState != nil || !IsEmptyHardState(rd.HardState) ||
 		!IsEmptySnap(&rd.Snapshot) || len(rd.Entries) > 0 ||
-		len(rd.CommittedEntries) > 0 || len(rd.Messages) > 0
+		len(rd.CommittedEntries) > 0 || len(rd.Messages) > 0 || len(rd.ReadStates) != 0
 }
 
 // appliedCursor extracts from the Ready the highest index the client has
@@ -113,7 +119,7 @@ func (rd Ready) appliedCursor() uint64 {
 	if n := len(rd.CommittedEntries); n > 0 {
 		return rd.CommittedEntries[n-1].Index
 	}
-	if index := rd.Snapshot.Metadata.Index; index > 0 {
+	if index := rd.Snapshot.GetMetadata().GetIndex(); index > 0 {
 		return index
 	}
 	return 0
@@ -135,6 +141,11 @@ type Node interface {
 	ProposeConfChange(ctx context.Context, cc pb.ConfChange) error
 	// Step advances the state machine using the given message. ctx.Err() will be returned, if any.
 	Step(ctx context.Context, msg pb.Message) error
+	// ReadIndex request a read state. The read state will be set in the ready.
+	// Read state has a read index. Once the application advances further than the read
+	// index, any linearizable read requests issued before the read request can be
+	// processed safely. The read state will have the same rctx attached.
+	ReadIndex(ctx context.Context, rctx []byte) error
 
 	// Ready returns a channel that returns the current point-in-time state.
 	// Users of the Node must call Advance after retrieving the state returned by Ready.
@@ -396,6 +407,9 @@ func (n *node) run(r *Raft) {
 			}
 
 			r.msgs = nil
+			if len(rd.ReadStates) != 0 {
+				r.readStates = nil
+			}
 			r.reduceUncommittedSize(rd.CommittedEntries)
 			advancec = n.advancec
 		case <-advancec:
@@ -553,6 +567,10 @@ func (n *node) ReportSnapshot(id uint64, status SnapshotStatus) {
 	}
 }
 
+func (n *node) ReadIndex(ctx context.Context, rctx []byte) error {
+	return n.step(ctx, pb.Message{MsgType: pb.MessageType_MsgReadIndex, Entries: []*pb.Entry{{Data: rctx}}})
+}
+
 func (n *node) TransferLeadership(ctx context.Context, lead, transferee uint64) {
 	select {
 	// manually set 'from' and 'to', so that leader can voluntarily transfers its leadership
@@ -564,7 +582,7 @@ func (n *node) TransferLeadership(ctx context.Context, lead, transferee uint64)
 
 func newReady(r *Raft, prevSoftSt *SoftState, prevHardSt pb.HardState, sinceIdx *uint64) Ready {
 	rd := Ready{
-		Entries: r.RaftLog.unstableEntries(),
+		Entries: r.RaftLog.nextUnstableEnts(),
 	}
 	if len(r.msgs) != 0 {
 		rd.Messages = r.msgs
@@ -581,8 +599,11 @@ func newReady(r *Raft, prevSoftSt *SoftState, prevHardSt pb.HardState, sinceIdx
 	if hardSt := r.hardState(); !isHardStateEqual(hardSt, prevHardSt) {
 		rd.HardState = hardSt
 	}
-	if r.RaftLog.unstable.snapshot != nil {
-		rd.Snapshot = *r.RaftLog.unstable.snapshot
+	if snap := r.RaftLog.nextUnstableSnapshot(); snap != nil {
+		rd.Snapshot = *snap
+	}
+	if len(r.readStates) != 0 {
+		rd.ReadStates = r.readStates
 	}
 	rd.MustSync = MustSync(r.hardState(), prevHardSt, len(rd.Entries))
 	return rd
@@ -71,7 +71,15 @@ type Progress struct {
 	// When a leader receives a reply, the previous inflights should
 	// be freed by calling inflights.freeTo with the index of the last
 	// received entry.
+	// The window shrinks when the follower rejects or misses the messages of
+	// ProgressStateReplicate and grows back with its acknowledgements, see
+	// inflights.shrink and inflights.grow.
 	ins *inflights
+
+	// Busy is set when the store of the follower reports that it's busy. In
+	// ProgressStateReplicate the leader keeps at most one replication message in
+	// flight to a busy follower instead of filling the inflights window.
+	Busy bool
 }
 
 func (pr *Progress) resetState(state ProgressStateType) {
@@ -160,7 +168,7 @@ func (pr *Progress) IsPaused() bool {
 	case ProgressStateProbe:
 		return pr.Paused
 	case ProgressStateReplicate:
-		return pr.ins.full()
+		return pr.inflightsFull()
 	case ProgressStateSnapshot:
 		return true
 	default:
@@ -168,6 +176,12 @@ func (pr *Progress) IsPaused() bool {
 	}
 }
 
+// inflightsFull returns true if no more replication message can be sent in
+// ProgressStateReplicate. The window of a busy follower holds one message.
+func (pr *Progress) inflightsFull() bool {
+	return pr.ins.full() || (pr.Busy && pr.ins.count > 0)
+}
+
 func (pr *Progress) snapshotFailure() { pr.PendingSnapshot = 0 }
 
 // needSnapshotAbort returns true if snapshot progress's Match
@@ -188,6 +202,9 @@ type inflights struct {
 
 	// the size of the buffer
 	size int
+	// the number of slots the window is shrunk by, the window holds at most
+	// size - shrunk inflights.
+	shrunk int
 
 	// buffer contains the index of the last entry
 	// inside one message.
@@ -266,10 +283,36 @@ func (in *inflights) freeFirstOne() { in.freeTo(in.buffer[in.start]) }
 
 // full returns true if the inflights is full.
 func (in *inflights) full() bool {
-	return in.count == in.size
+	return in.count >= in.window()
+}
+
+// window returns the max number of inflights the window holds now.
+func (in *inflights) window() int {
+	return in.size - in.shrunk
+}
+
+// shrink halves the window, down to one inflight. The leader shrinks it when
+// the follower rejects or misses the replication messages, which is likely to
+// happen again with as many messages in flight.
+func (in *inflights) shrink() {
+	window := in.window() / 2
+	if window < 1 {
+		window = 1
+	}
+	in.shrunk = in.size - window
+}
+
+// grow widens the window by one inflight, up to the size. The leader grows it
+// for each replication message the follower acknowledges, so a follower which
+// caught up gets the whole window back gradually.
+func (in *inflights) grow() {
+	if in.shrunk > 0 {
+		in.shrunk--
+	}
 }
 
-// resets frees all inflights.
+// resets frees all inflights. The window is kept, it reflects the follower
+// rather than the state of the progress.
 func (in *inflights) reset() {
 	in.count = 0
 	in.start = 0
@@ -187,3 +187,36 @@ func TestInflightFreeFirstOne(t *testing.T) {
 		t.Fatalf("in = %+v, want %+v", in, wantIn)
 	}
 }
+
+func TestInflightShrinkGrow(t *testing.T) {
+	in := newInflights(10)
+	for i := 0; i < 6; i++ {
+		in.add(uint64(i))
+	}
+
+	in.shrink()
+	if w := in.window(); w != 5 {
+		t.Fatalf("window = %d, want 5", w)
+	}
+	// the inflights beyond the shrunk window stay, but no more can be added.
+	if !in.full() {
+		t.Fatalf("full = %t, want true", in.full())
+	}
+	for i := 0; i < 5; i++ {
+		in.shrink()
+	}
+	if w := in.window(); w != 1 {
+		t.Fatalf("window = %d, want 1", w)
+	}
+
+	in.reset()
+	if w := in.window(); w != 1 {
+		t.Fatalf("window = %d after reset, want 1", w)
+	}
+	for i := 0; i < 20; i++ {
+		in.grow()
+	}
+	if w := in.window(); w != 10 {
+		t.Fatalf("window = %d, want 10", w)
+	}
+}
@@ -15,6 +15,7 @@
 package raft
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"math"
@@ -158,6 +159,35 @@ type Config struct {
 	// logical clock from assigning the timestamp and then forwarding the data
 	// to the leader.
 	DisableProposalForwarding bool
+
+	// ReadOnlyOption specifies how the read only request is processed.
+	//
+	// ReadOnlySafe guarantees the linearizability of the read only request by
+	// communicating with the quorum. It is the default and suggested option.
+	//
+	// ReadOnlyLeaseBased ensures linearizability of the read only request by
+	// relying on the leader lease. It can be affected by clock drift.
+	// If the clock drift is unbounded, leader might keep the lease longer than it
+	// should (clock can move backward/pause without any bound). ReadIndex is not safe
+	// in that case.
+	ReadOnlyOption ReadOnlyOption
+
+	// LeaderStickiness makes a follower that has heard from its leader within
+	// the election timeout ignore the vote requests of normal campaigns, so a
+	// node with a flaky connection can't disrupt a healthy leader. Vote requests
+	// of leadership transfers (CampaignTransfer) are still honored.
+	LeaderStickiness bool
+
+	// CampaignHoldTicks is the number of ticks a leader that has just transferred
+	// its leadership away refrains from starting an election on its own, which
+	// stops the leadership from ping-ponging with tight election timeouts.
+	// 0 disables it.
+	CampaignHoldTicks int
+
+	// TraceHook, if set, is notified of the messages sent and received by the
+	// raft node and its role changes. It falls back to the hook registered by
+	// SetTraceHook when nil.
+	TraceHook TraceHook
 }
 
 func (c *Config) validate() error {
@@ -191,6 +221,10 @@ func (c *Config) validate() error {
 		return errors.New("max inflight messages must be greater than 0")
 	}
 
+	if c.CampaignHoldTicks < 0 {
+		return errors.New("campaign hold ticks must not be negative")
+	}
+
 	if c.Logger == nil {
 		c.Logger = raftLogger
 	}
@@ -219,6 +253,9 @@ type Raft struct {
 
 	msgs []pb.Message
 
+	// the read states of the read only requests which are ready to serve.
+	readStates []ReadState
+
 	// the leader id
 	Lead uint64
 	// leadTransferee is id of the leader transfer target when its value is not zero.
@@ -270,6 +307,16 @@ type Raft struct {
 	randomizedElectionTimeout int
 	disableProposalForwarding bool
 
+	leaderStickiness  bool
+	campaignHoldTicks int
+	// campaignHold is the number of remaining ticks during which the node
+	// doesn't start an election on its own after transferring its leadership.
+	campaignHold int
+
+	readOnly *readOnly
+
+	traceHook TraceHook
+
 	tick func()
 	step stepFunc
 
@@ -308,6 +355,13 @@ func newRaft(c *Config) *Raft {
 		logger:                    c.Logger,
 		skipBcastCommit:           c.skipBcastCommit,
 		disableProposalForwarding: c.DisableProposalForwarding,
+		readOnly:                  newReadOnly(c.ReadOnlyOption),
+		leaderStickiness:          c.LeaderStickiness,
+		campaignHoldTicks:         c.CampaignHoldTicks,
+		traceHook:                 c.TraceHook,
+	}
+	if r.traceHook == nil {
+		r.traceHook = getDefaultTraceHook()
 	}
 	for _, p := range peers {
 		r.Prs[p] = &Progress{Next: 1, ins: newInflights(r.maxInflight)}
@@ -388,6 +442,9 @@ func (r *Raft) send(m pb.Message) {
 		}
 	}
 	r.msgs = append(r.msgs, m)
+	if r.traceHook != nil {
+		r.traceHook.OnSend(m)
+	}
 }
 
 func (r *Raft) getProgress(id uint64) *Progress {
@@ -468,7 +525,7 @@ func (r *Raft) maybeSendAppend(to uint64, sendIfEmpty bool) bool {
 }
 
 // sendHeartbeat sends a heartbeat RPC to the given peer.
-func (r *Raft) sendHeartbeat(to uint64) {
+func (r *Raft) sendHeartbeat(to uint64, ctx []byte) {
 	// Attach the commit as min(to.matched, r.committed).
 	// When the leader sends out heartbeat message,
 	// the receiver(follower) might not be matched with the leader
@@ -480,6 +537,7 @@ func (r *Raft) sendHeartbeat(to uint64) {
 		To:      to,
 		MsgType: pb.MessageType_MsgHeartbeat,
 		Commit:  commit,
+		Context: ctx,
 	}
 
 	r.send(m)
@@ -505,11 +563,20 @@ func (r *Raft) bcastAppend() {
 
 // bcastHeartbeat sends RPC, without entries to all the peers.
 func (r *Raft) bcastHeartbeat() {
+	lastCtx := r.readOnly.lastPendingRequestCtx()
+	if len(lastCtx) == 0 {
+		r.bcastHeartbeatWithCtx(nil)
+	} else {
+		r.bcastHeartbeatWithCtx([]byte(lastCtx))
+	}
+}
+
+func (r *Raft) bcastHeartbeatWithCtx(ctx []byte) {
 	r.forEachProgress(func(id uint64, _ *Progress) {
 		if id == r.id {
 			return
 		}
-		r.sendHeartbeat(id)
+		r.sendHeartbeat(id, ctx)
 	})
 }
 
@@ -556,6 +623,7 @@ func (r *Raft) reset(term uint64) {
 
 	r.PendingConfIndex = 0
 	r.uncommittedSize = 0
+	r.readOnly = newReadOnly(r.readOnly.option)
 }
 
 func (r *Raft) appendEntry(es ...pb.Entry) (accepted bool) {
@@ -584,9 +652,17 @@ func (r *Raft) appendEntry(es ...pb.Entry) (accepted bool) {
 // tickElection is run by followers and candidates after r.electionTimeout.
 func (r *Raft) tickElection() {
 	r.electionElapsed++
+	if r.campaignHold > 0 {
+		r.campaignHold--
+	}
 
 	if r.promotable() && r.pastElectionTimeout() {
 		r.electionElapsed = 0
+		if r.campaignHold > 0 {
+			r.logger.Infof("%x [term: %d] refrains from campaigning for %d ticks after transferring leadership",
+				r.id, r.Term, r.campaignHold)
+			return
+		}
 		r.Step(pb.Message{From: r.id, MsgType: pb.MessageType_MsgHup})
 	}
 }
@@ -619,7 +695,7 @@ func (r *Raft) becomeFollower(term uint64, lead uint64) {
 	r.reset(term)
 	r.tick = r.tickElection
 	r.Lead = lead
-	r.State = StateFollower
+	r.setState(StateFollower)
 	r.logger.Infof("%x became follower at term %d", r.id, r.Term)
 }
 
@@ -632,7 +708,7 @@ func (r *Raft) becomeCandidate() {
 	r.reset(r.Term + 1)
 	r.tick = r.tickElection
 	r.Vote = r.id
-	r.State = StateCandidate
+	r.setState(StateCandidate)
 	r.logger.Infof("%x became candidate at term %d", r.id, r.Term)
 }
 
@@ -645,7 +721,7 @@ func (r *Raft) becomeLeader() {
 	r.reset(r.Term)
 	r.tick = r.tickHeartbeat
 	r.Lead = r.id
-	r.State = StateLeader
+	r.setState(StateLeader)
 	// Followers enter replicate mode when they've been successfully probed
 	// (perhaps after having received a snapshot as a result). The leader is
 	// trivially in this state. Note that r.reset() has initialized this
@@ -672,6 +748,14 @@ func (r *Raft) becomeLeader() {
 	r.logger.Infof("%x became leader at term %d", r.id, r.Term)
 }
 
+func (r *Raft) setState(st StateType) {
+	prev := r.State
+	r.State = st
+	if r.traceHook != nil {
+		r.traceHook.OnStateChange(r.id, r.Term, prev, st)
+	}
+}
+
 func (r *Raft) campaign(t CampaignType) {
 	r.becomeCandidate()
 	voteMsg := pb.MessageType_MsgRequestVote
@@ -716,18 +800,37 @@ func (r *Raft) poll(id uint64, t pb.MessageType, v bool) (granted int) {
 }
 
 func (r *Raft) Step(m pb.Message) error {
+	if r.traceHook != nil {
+		r.traceHook.OnReceive(r.id, m)
+	}
 	// Handle the message term, which may result in our stepping down to a follower.
 	switch {
 	case m.Term == 0:
 		// local message
 	case m.Term > r.Term:
+		if m.MsgType == pb.MessageType_MsgRequestVote {
+			force := bytes.Equal(m.Context, []byte(campaignTransfer))
+			inLease := r.leaderStickiness && r.Lead != None && r.electionElapsed < r.electionTimeout
+			if !force && inLease {
+				// If a server receives a RequestVote request within the minimum election timeout
+				// of hearing from a current leader, it does not update its term or grant its vote
+				r.logger.Infof("%x [logterm: %d, index: %d, vote: %x] ignored %s from %x [logterm: %d, index: %d] at term %d: lease is not expired (remaining ticks: %d)",
+					r.id, r.RaftLog.lastTerm(), r.RaftLog.LastIndex(), r.Vote, m.MsgType, m.From, m.LogTerm, m.Index, r.Term, r.electionTimeout-r.electionElapsed)
+				return nil
+			}
+		}
 		r.logger.Infof("%x [term: %d] received a %s message with higher term from %x [term: %d]",
 			r.id, r.Term, m.MsgType, m.From, m.Term)
+		// The leader steps down because the transferee has won the election.
+		transferred := r.State == StateLeader && r.leadTransferee != None && r.leadTransferee == m.From
 		if m.MsgType == pb.MessageType_MsgAppend || m.MsgType == pb.MessageType_MsgHeartbeat || m.MsgType == pb.MessageType_MsgSnapshot {
 			r.becomeFollower(m.Term, m.From)
 		} else {
 			r.becomeFollower(m.Term, None)
 		}
+		if transferred {
+			r.campaignHold = r.campaignHoldTicks
+		}
 	case m.Term < r.Term:
 		r.logger.Infof("%x [term: %d] ignored a %s message with lower term from %x [term: %d]", r.id, r.Term, m.MsgType, m.From, m.Term)
 		return nil
@@ -824,6 +927,27 @@ func stepLeader(r *Raft, m pb.Message) error {
 		}
 		r.bcastAppend()
 		return nil
+	case pb.MessageType_MsgReadIndex:
+		if r.quorum() > 1 {
+			if r.RaftLog.zeroTermOnErrCompacted(r.RaftLog.Term(r.RaftLog.committed)) != r.Term {
+				// Reject read only request when this leader has not committed any log entry at its term.
+				return nil
+			}
+
+			// thinking: use an interally defined context instead of the user given context.
+			// We can express this in terms of the term and index instead of a user-supplied value.
+			// This would allow multiple reads to piggyback on the same message.
+			switch r.readOnly.option {
+			case ReadOnlySafe:
+				r.readOnly.addRequest(r.RaftLog.committed, m)
+				r.bcastHeartbeatWithCtx(m.Entries[0].Data)
+			case ReadOnlyLeaseBased:
+				r.responseToReadIndex(m, r.RaftLog.committed)
+			}
+		} else {
+			r.readStates = append(r.readStates, ReadState{Index: r.RaftLog.committed, RequestCtx: m.Entries[0].Data})
+		}
+		return nil
 	}
 
 	// All other message types require a progress for m.From (pr).
@@ -835,11 +959,22 @@ func stepLeader(r *Raft, m pb.Message) error {
 	switch m.MsgType {
 	case pb.MessageType_MsgAppendResponse:
 		if m.Reject {
-			r.logger.Debugf("%x received MessageType_MsgAppend rejection(lastindex: %d) from %x for index %d",
-				r.id, m.RejectHint, m.From, m.Index)
-			if pr.maybeDecrTo(m.Index, m.RejectHint) {
+			r.logger.Debugf("%x received MessageType_MsgAppend rejection(hint: %d, logterm: %d) from %x for index %d",
+				r.id, m.RejectHint, m.LogTerm, m.From, m.Index)
+			// The follower hints the largest index at which its log may match
+			// ours, along with the term of the entry there. All of our entries
+			// after the hint whose term is larger than that can't match either,
+			// so skip them instead of probing them one by one. This matters when
+			// the follower has a long uncommitted tail of a stale term, e.g.
+			// after a long partition.
+			nextProbeIdx := m.RejectHint
+			if m.LogTerm > 0 {
+				nextProbeIdx = r.RaftLog.findConflictByTerm(m.RejectHint, m.LogTerm)
+			}
+			if pr.maybeDecrTo(m.Index, nextProbeIdx) {
 				r.logger.Debugf("%x decreased progress of %x to [%s]", r.id, m.From, pr)
 				if pr.State == ProgressStateReplicate {
+					pr.ins.shrink()
 					pr.becomeProbe()
 				}
 				r.sendAppend(m.From)
@@ -861,6 +996,7 @@ func stepLeader(r *Raft, m pb.Message) error {
 					pr.becomeReplicate()
 				case pr.State == ProgressStateReplicate:
 					pr.ins.freeTo(m.Index)
+					pr.ins.grow()
 				}
 
 				if r.maybeCommit() {
@@ -892,12 +1028,26 @@ func stepLeader(r *Raft, m pb.Message) error {
 		pr.resume()
 
 		// free one slot for the full inflights window to allow progress.
-		if pr.State == ProgressStateReplicate && pr.ins.full() {
+		if pr.State == ProgressStateReplicate && pr.inflightsFull() {
 			pr.ins.freeFirstOne()
 		}
 		if pr.Match < r.RaftLog.LastIndex() {
 			r.sendAppend(m.From)
 		}
+
+		if r.readOnly.option != ReadOnlySafe || len(m.Context) == 0 {
+			return nil
+		}
+
+		ackCount := r.readOnly.recvAck(m)
+		if ackCount < r.quorum() {
+			return nil
+		}
+
+		rss := r.readOnly.advance(m)
+		for _, rs := range rss {
+			r.responseToReadIndex(rs.req, rs.index)
+		}
 	case pb.MessageType_MsgSnapStatus:
 		if pr.State != ProgressStateSnapshot {
 			return nil
@@ -918,6 +1068,7 @@ func stepLeader(r *Raft, m pb.Message) error {
 		// During optimistic replication, if the remote becomes unreachable,
 		// there is huge probability that a MessageType_MsgAppend is lost.
 		if pr.State == ProgressStateReplicate {
+			pr.ins.shrink()
 			pr.becomeProbe()
 		}
 		r.logger.Debugf("%x failed to send message to %x because it is unreachable [%s]", r.id, m.From, pr)
@@ -1014,6 +1165,19 @@ func stepFollower(r *Raft, m pb.Message) error {
 		}
 		m.To = r.Lead
 		r.send(m)
+	case pb.MessageType_MsgReadIndex:
+		if r.Lead == None {
+			r.logger.Infof("%x no leader at term %d; dropping index reading msg", r.id, r.Term)
+			return nil
+		}
+		m.To = r.Lead
+		r.send(m)
+	case pb.MessageType_MsgReadIndexResponse:
+		if len(m.Entries) != 1 {
+			r.logger.Errorf("%x invalid format of MessageType_MsgReadIndexResponse from %x, entries count: %d", r.id, m.From, len(m.Entries))
+			return nil
+		}
+		r.readStates = append(r.readStates, ReadState{Index: m.Index, RequestCtx: m.Entries[0].Data})
 	case pb.MessageType_MsgTimeoutNow:
 		if r.promotable() {
 			r.logger.Infof("%x [term %d] received MessageType_MsgTimeoutNow from %x and starts an election to get leadership.", r.id, r.Term, m.From)
@@ -1025,6 +1189,16 @@ func stepFollower(r *Raft, m pb.Message) error {
 	return nil
 }
 
+// responseToReadIndex answers the read only request with the read index, the
+// request from the local node is answered by the read states in Ready directly.
+func (r *Raft) responseToReadIndex(req pb.Message, readIndex uint64) {
+	if req.From == None || req.From == r.id {
+		r.readStates = append(r.readStates, ReadState{Index: readIndex, RequestCtx: req.Entries[0].Data})
+		return
+	}
+	r.send(pb.Message{To: req.From, MsgType: pb.MessageType_MsgReadIndexResponse, Index: readIndex, Entries: req.Entries})
+}
+
 func (r *Raft) handleAppendEntries(m pb.Message) {
 	if m.Index < r.RaftLog.committed {
 		r.send(pb.Message{To: m.From, MsgType: pb.MessageType_MsgAppendResponse, Index: r.RaftLog.committed})
@@ -1040,7 +1214,17 @@ func (r *Raft) handleAppendEntries(m pb.Message) {
 	} else {
 		r.logger.Debugf("%x [logterm: %d, index: %d] rejected MessageType_MsgAppend [logterm: %d, index: %d] from %x",
 			r.id, r.RaftLog.zeroTermOnErrCompacted(r.RaftLog.Term(m.Index)), m.Index, m.LogTerm, m.Index, m.From)
-		r.send(pb.Message{To: m.From, MsgType: pb.MessageType_MsgAppendResponse, Index: m.Index, Reject: true, RejectHint: r.RaftLog.LastIndex()})
+		// Return a hint to the leader about the maximum index and term that the
+		// two logs could be divergent at. Entries after it whose term is larger
+		// than m.LogTerm can't match the leader's log, so the leader can skip
+		// all of them with a single probe.
+		hintIndex := min(m.Index, r.RaftLog.LastIndex())
+		hintIndex = r.RaftLog.findConflictByTerm(hintIndex, m.LogTerm)
+		hintTerm, err := r.RaftLog.Term(hintIndex)
+		if err != nil {
+			panic(fmt.Sprintf("term(%d) must be valid, but got %v", hintIndex, err))
+		}
+		r.send(pb.Message{To: m.From, MsgType: pb.MessageType_MsgAppendResponse, Index: m.Index, Reject: true, RejectHint: hintIndex, LogTerm: hintTerm})
 	}
 }
 
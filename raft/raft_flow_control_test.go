@@ -153,3 +153,97 @@ func TestMessageType_MsgAppendFlowControlRecvHeartbeat(t *testing.T) {
 		r.readMessages()
 	}
 }
+
+// TestMessageType_MsgAppendFlowControlBusy ensures the leader keeps at most one
+// MessageType_MsgAppend in flight to a busy follower, and fills the window again
+// once the follower is no longer busy.
+func TestMessageType_MsgAppendFlowControlBusy(t *testing.T) {
+	r := newTestRaft(1, []uint64{1, 2}, 5, 1, NewMemoryStorage())
+	r.becomeCandidate()
+	r.becomeLeader()
+
+	pr2 := r.Prs[2]
+	pr2.becomeReplicate()
+	pr2.Busy = true
+	propose := func() []pb.Message {
+		r.Step(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{Data: []byte("somedata")}}})
+		return r.readMessages()
+	}
+	if ms := propose(); len(ms) != 1 {
+		t.Fatalf("len(ms) = %d, want 1", len(ms))
+	}
+	for i := 0; i < 3; i++ {
+		if ms := propose(); len(ms) != 0 {
+			t.Fatalf("#%d: len(ms) = %d, want 0", i, len(ms))
+		}
+	}
+
+	// a heartbeat response frees the window for the next message.
+	r.Step(pb.Message{From: 2, To: 1, MsgType: pb.MessageType_MsgHeartbeatResponse})
+	if ms := r.readMessages(); len(ms) != 1 || ms[0].MsgType != pb.MessageType_MsgAppend {
+		t.Fatalf("ms = %v, want one MessageType_MsgAppend", ms)
+	}
+	if ms := propose(); len(ms) != 0 {
+		t.Fatalf("len(ms) = %d, want 0", len(ms))
+	}
+
+	pr2.Busy = false
+	for i := 0; i < r.maxInflight-1; i++ {
+		if ms := propose(); len(ms) != 1 {
+			t.Fatalf("#%d: len(ms) = %d, want 1", i, len(ms))
+		}
+	}
+	if !pr2.ins.full() {
+		t.Fatalf("inflights.full = %t, want %t", pr2.ins.full(), true)
+	}
+}
+
+// TestMessageType_MsgAppendFlowControlAdaptive ensures the leader halves the
+// sending window of a follower it fails to send messages to, and widens it
+// again by one message for each MessageType_MsgAppendResponse acknowledging
+// new entries.
+func TestMessageType_MsgAppendFlowControlAdaptive(t *testing.T) {
+	r := newTestRaft(1, []uint64{1, 2}, 5, 1, NewMemoryStorage())
+	r.becomeCandidate()
+	r.becomeLeader()
+
+	pr2 := r.Prs[2]
+	pr2.becomeReplicate()
+	r.Step(pb.Message{From: 2, To: 1, MsgType: pb.MessageType_MsgUnreachable})
+	if pr2.State != ProgressStateProbe {
+		t.Fatalf("state = %s, want %s", pr2.State, ProgressStateProbe)
+	}
+	window := r.maxInflight / 2
+	if w := pr2.ins.window(); w != window {
+		t.Fatalf("window = %d, want %d", w, window)
+	}
+
+	// the shrunk window is kept in ProgressStateReplicate.
+	pr2.becomeReplicate()
+	for i := 0; i < window; i++ {
+		r.Step(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{Data: []byte("somedata")}}})
+		if ms := r.readMessages(); len(ms) != 1 {
+			t.Fatalf("#%d: len(ms) = %d, want 1", i, len(ms))
+		}
+	}
+	r.Step(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{Data: []byte("somedata")}}})
+	if ms := r.readMessages(); len(ms) != 0 {
+		t.Fatalf("len(ms) = %d, want 0", len(ms))
+	}
+
+	r.Step(pb.Message{From: 2, To: 1, MsgType: pb.MessageType_MsgAppendResponse, Index: r.RaftLog.LastIndex() - 1})
+	if w := pr2.ins.window(); w != window+1 {
+		t.Fatalf("window = %d, want %d", w, window+1)
+	}
+	// a stale acknowledgement doesn't widen the window.
+	r.Step(pb.Message{From: 2, To: 1, MsgType: pb.MessageType_MsgAppendResponse, Index: r.RaftLog.LastIndex() - 1})
+	if w := pr2.ins.window(); w != window+1 {
+		t.Fatalf("window = %d, want %d", w, window+1)
+	}
+
+	// a rejection shrinks the window again.
+	r.Step(pb.Message{From: 2, To: 1, MsgType: pb.MessageType_MsgAppendResponse, Index: r.RaftLog.LastIndex(), Reject: true, RejectHint: pr2.Match})
+	if w := pr2.ins.window(); w != (window+1)/2 {
+		t.Fatalf("window = %d, want %d", w, (window+1)/2)
+	}
+}
@@ -610,17 +610,18 @@ func TestFollowerCheckMessageType_MsgAppend(t *testing.T) {
 		windex      uint64
 		wreject     bool
 		wrejectHint uint64
+		wlogterm    uint64
 	}{
 		// match with committed entries
-		{0, 0, 1, false, 0},
-		{ents[0].Term, ents[0].Index, 1, false, 0},
+		{0, 0, 1, false, 0, 0},
+		{ents[0].Term, ents[0].Index, 1, false, 0, 0},
 		// match with uncommitted entries
-		{ents[1].Term, ents[1].Index, 2, false, 0},
+		{ents[1].Term, ents[1].Index, 2, false, 0, 0},
 
 		// unmatch with existing entry
-		{ents[0].Term, ents[1].Index, ents[1].Index, true, 2},
+		{ents[0].Term, ents[1].Index, ents[1].Index, true, 1, 1},
 		// unexisting entry
-		{ents[1].Term + 1, ents[1].Index + 1, ents[1].Index + 1, true, 2},
+		{ents[1].Term + 1, ents[1].Index + 1, ents[1].Index + 1, true, 2, 2},
 	}
 	for i, tt := range tests {
 		storage := NewMemoryStorage()
@@ -633,7 +634,7 @@ func TestFollowerCheckMessageType_MsgAppend(t *testing.T) {
 
 		msgs := r.readMessages()
 		wmsgs := []pb.Message{
-			{From: 1, To: 2, MsgType: pb.MessageType_MsgAppendResponse, Term: 2, Index: tt.windex, Reject: tt.wreject, RejectHint: tt.wrejectHint},
+			{From: 1, To: 2, MsgType: pb.MessageType_MsgAppendResponse, Term: 2, Index: tt.windex, Reject: tt.wreject, RejectHint: tt.wrejectHint, LogTerm: tt.wlogterm},
 		}
 		if !reflect.DeepEqual(msgs, wmsgs) {
 			t.Errorf("#%d: msgs = %+v, want %+v", i, msgs, wmsgs)
@@ -1755,6 +1755,40 @@ func TestLeaderAppResp(t *testing.T) {
 
 // When the leader receives a heartbeat tick, it should
 // send a MessageType_MsgHeartbeat with m.Index = 0, m.LogTerm=0 and empty entries.
+// TestFastLogRejection ensures that a follower with a long divergent tail of
+// a stale term catches up with the leader after a few rejections, instead of
+// rejecting the probes of each of the divergent entries one by one.
+func TestFastLogRejection(t *testing.T) {
+	leaderTerms, followerTerms := []uint64{1}, []uint64{1}
+	for i := 0; i < 100; i++ {
+		leaderTerms = append(leaderTerms, 3)
+		followerTerms = append(followerTerms, 2)
+	}
+	nt := newNetwork(entsWithConfig(nil, leaderTerms...), entsWithConfig(nil, followerTerms...))
+	rejections := 0
+	nt.msgHook = func(m pb.Message) bool {
+		if m.MsgType == pb.MessageType_MsgAppendResponse && m.Reject {
+			rejections++
+		}
+		return true
+	}
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+
+	leader, follower := nt.peers[1].(*Raft), nt.peers[2].(*Raft)
+	if leader.State != StateLeader {
+		t.Fatalf("state = %v, want %v", leader.State, StateLeader)
+	}
+	if rejections > 1 {
+		t.Errorf("rejections = %d, want at most 1", rejections)
+	}
+	if g, w := follower.RaftLog.LastIndex(), leader.RaftLog.LastIndex(); g != w {
+		t.Fatalf("follower last index = %d, want %d", g, w)
+	}
+	if g, w := diffu(ltoa(leader.RaftLog), ltoa(follower.RaftLog)), ""; g != w {
+		t.Errorf("log diff:\n%s", g)
+	}
+}
+
 func TestBcastBeat(t *testing.T) {
 	offset := uint64(1000)
 	// make a state machine with log.offset = 1000
@@ -1852,6 +1886,194 @@ func TestRecvMessageType_MsgBeat(t *testing.T) {
 	}
 }
 
+func TestReadOnlyOptionSafe(t *testing.T) {
+	a := newTestRaft(1, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	b := newTestRaft(2, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	c := newTestRaft(3, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+
+	nt := newNetwork(a, b, c)
+	setRandomizedElectionTimeout(b, b.electionTimeout+1)
+
+	for i := 0; i < b.electionTimeout; i++ {
+		b.tick()
+	}
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+
+	if a.State != StateLeader {
+		t.Fatalf("state = %s, want %s", a.State, StateLeader)
+	}
+
+	tests := []struct {
+		sm        *Raft
+		proposals int
+		wri       uint64
+		wctx      []byte
+	}{
+		{a, 10, 11, []byte("ctx1")},
+		{b, 10, 21, []byte("ctx2")},
+		{c, 10, 31, []byte("ctx3")},
+		{a, 10, 41, []byte("ctx4")},
+		{b, 10, 51, []byte("ctx5")},
+		{c, 10, 61, []byte("ctx6")},
+	}
+
+	for i, tt := range tests {
+		for j := 0; j < tt.proposals; j++ {
+			nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{}}})
+		}
+
+		nt.send(pb.Message{From: tt.sm.id, To: tt.sm.id, MsgType: pb.MessageType_MsgReadIndex, Entries: []*pb.Entry{{Data: tt.wctx}}})
+
+		r := tt.sm
+		if len(r.readStates) == 0 {
+			t.Errorf("#%d: len(readStates) = 0, want non-zero", i)
+		}
+		rs := r.readStates[0]
+		if rs.Index != tt.wri {
+			t.Errorf("#%d: readIndex = %d, want %d", i, rs.Index, tt.wri)
+		}
+
+		if !bytes.Equal(rs.RequestCtx, tt.wctx) {
+			t.Errorf("#%d: requestCtx = %v, want %v", i, rs.RequestCtx, tt.wctx)
+		}
+		r.readStates = nil
+	}
+}
+
+func TestReadOnlyOptionLease(t *testing.T) {
+	a := newTestRaft(1, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	b := newTestRaft(2, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	c := newTestRaft(3, []uint64{1, 2, 3}, 10, 1, NewMemoryStorage())
+	a.readOnly.option = ReadOnlyLeaseBased
+	b.readOnly.option = ReadOnlyLeaseBased
+	c.readOnly.option = ReadOnlyLeaseBased
+
+	nt := newNetwork(a, b, c)
+	setRandomizedElectionTimeout(b, b.electionTimeout+1)
+
+	for i := 0; i < b.electionTimeout; i++ {
+		b.tick()
+	}
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+
+	if a.State != StateLeader {
+		t.Fatalf("state = %s, want %s", a.State, StateLeader)
+	}
+
+	// The leader answers the read only requests without a round of heartbeats.
+	nt.ignore(pb.MessageType_MsgHeartbeat)
+
+	tests := []struct {
+		sm        *Raft
+		proposals int
+		wri       uint64
+		wctx      []byte
+	}{
+		{a, 10, 11, []byte("ctx1")},
+		{b, 10, 21, []byte("ctx2")},
+		{c, 10, 31, []byte("ctx3")},
+		{a, 10, 41, []byte("ctx4")},
+		{b, 10, 51, []byte("ctx5")},
+		{c, 10, 61, []byte("ctx6")},
+	}
+
+	for i, tt := range tests {
+		for j := 0; j < tt.proposals; j++ {
+			nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{}}})
+		}
+
+		nt.send(pb.Message{From: tt.sm.id, To: tt.sm.id, MsgType: pb.MessageType_MsgReadIndex, Entries: []*pb.Entry{{Data: tt.wctx}}})
+
+		r := tt.sm
+		if len(r.readStates) == 0 {
+			t.Fatalf("#%d: len(readStates) = 0, want non-zero", i)
+		}
+		rs := r.readStates[0]
+		if rs.Index != tt.wri {
+			t.Errorf("#%d: readIndex = %d, want %d", i, rs.Index, tt.wri)
+		}
+		if !bytes.Equal(rs.RequestCtx, tt.wctx) {
+			t.Errorf("#%d: requestCtx = %v, want %v", i, rs.RequestCtx, tt.wctx)
+		}
+		r.readStates = nil
+	}
+}
+
+// TestReadOnlyForNewLeader ensures that a leader only accepts MessageType_MsgReadIndex message
+// when it commits at least one log entry at it term.
+func TestReadOnlyForNewLeader(t *testing.T) {
+	nodeConfigs := []struct {
+		id           uint64
+		committed    uint64
+		applied      uint64
+		compactIndex uint64
+	}{
+		{1, 1, 1, 0},
+		{2, 2, 2, 2},
+		{3, 2, 2, 2},
+	}
+	peers := make([]stateMachine, 0)
+	for _, c := range nodeConfigs {
+		storage := NewMemoryStorage()
+		storage.Append([]pb.Entry{{Index: 1, Term: 1}, {Index: 2, Term: 1}})
+		storage.SetHardState(pb.HardState{Term: 1, Commit: c.committed})
+		if c.compactIndex != 0 {
+			storage.Compact(c.compactIndex)
+		}
+		cfg := newTestConfig(c.id, []uint64{1, 2, 3}, 10, 1, storage)
+		cfg.Applied = c.applied
+		raft := newRaft(cfg)
+		peers = append(peers, raft)
+	}
+	nt := newNetwork(peers...)
+
+	// Drop MessageType_MsgAppend to forbid peer a to commit any log entry at its term after it becomes leader.
+	nt.ignore(pb.MessageType_MsgAppend)
+	// Force peer a to become leader.
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+
+	sm := nt.peers[1].(*Raft)
+	if sm.State != StateLeader {
+		t.Fatalf("state = %s, want %s", sm.State, StateLeader)
+	}
+
+	// Ensure peer a drops read only request.
+	var windex uint64 = 4
+	wctx := []byte("ctx")
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgReadIndex, Entries: []*pb.Entry{{Data: wctx}}})
+	if len(sm.readStates) != 0 {
+		t.Fatalf("len(readStates) = %d, want zero", len(sm.readStates))
+	}
+
+	nt.recover()
+
+	// Force peer a to commit a log entry at its term
+	for i := 0; i < sm.heartbeatTimeout; i++ {
+		sm.tick()
+	}
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgPropose, Entries: []*pb.Entry{{}}})
+	if sm.RaftLog.committed != 4 {
+		t.Fatalf("committed = %d, want 4", sm.RaftLog.committed)
+	}
+	lastLogTerm := sm.RaftLog.zeroTermOnErrCompacted(sm.RaftLog.Term(sm.RaftLog.committed))
+	if lastLogTerm != sm.Term {
+		t.Fatalf("last log term = %d, want %d", lastLogTerm, sm.Term)
+	}
+
+	// Ensure peer a accepts read only request after it commits a entry at its term.
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgReadIndex, Entries: []*pb.Entry{{Data: wctx}}})
+	if len(sm.readStates) != 1 {
+		t.Fatalf("len(readStates) = %d, want 1", len(sm.readStates))
+	}
+	rs := sm.readStates[0]
+	if rs.Index != windex {
+		t.Fatalf("readIndex = %d, want %d", rs.Index, windex)
+	}
+	if !bytes.Equal(rs.RequestCtx, wctx) {
+		t.Fatalf("requestCtx = %v, want %v", rs.RequestCtx, wctx)
+	}
+}
+
 func TestLeaderIncreaseNext(t *testing.T) {
 	previousEnts := []pb.Entry{{Term: 1, Index: 1}, {Term: 1, Index: 2}, {Term: 1, Index: 3}}
 	tests := []struct {
@@ -2654,6 +2876,76 @@ func TestLeaderTransferSecondTransferToSameNode(t *testing.T) {
 	checkLeaderTransferState(t, lead, StateLeader, 1)
 }
 
+// TestLeaderStickinessIgnoresNormalCampaign verifies that followers which
+// have heard from the leader within the election timeout ignore the vote
+// requests of a normal campaign, but grant the ones of a leadership transfer.
+func TestLeaderStickinessIgnoresNormalCampaign(t *testing.T) {
+	nt := newNetworkWithConfig(func(c *Config) { c.LeaderStickiness = true }, nil, nil, nil)
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+
+	lead := nt.peers[1].(*Raft)
+	if lead.State != StateLeader {
+		t.Fatalf("state = %v, want %v", lead.State, StateLeader)
+	}
+
+	nt.send(pb.Message{From: 3, To: 3, MsgType: pb.MessageType_MsgHup})
+	if lead.State != StateLeader || lead.Term != 1 {
+		t.Fatalf("leader state = %v term = %d, want %v term 1", lead.State, lead.Term, StateLeader)
+	}
+	if follower := nt.peers[2].(*Raft); follower.Term != 1 || follower.Lead != 1 {
+		t.Fatalf("follower term = %d lead = %x, want term 1 lead 1", follower.Term, follower.Lead)
+	}
+	if candidate := nt.peers[3].(*Raft); candidate.State != StateCandidate {
+		t.Fatalf("state = %v, want %v", candidate.State, StateCandidate)
+	}
+
+	// a leadership transfer is honored in spite of the lease.
+	nt = newNetworkWithConfig(func(c *Config) { c.LeaderStickiness = true }, nil, nil, nil)
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+	nt.send(pb.Message{From: 3, To: 1, MsgType: pb.MessageType_MsgTransferLeader})
+	if r := nt.peers[3].(*Raft); r.State != StateLeader {
+		t.Fatalf("state = %v, want %v", r.State, StateLeader)
+	}
+	checkLeaderTransferState(t, nt.peers[1].(*Raft), StateFollower, 3)
+}
+
+// TestCampaignHoldAfterLeaderTransfer verifies that a node which has just
+// transferred its leadership away doesn't start an election on its own until
+// CampaignHoldTicks ticks have passed.
+func TestCampaignHoldAfterLeaderTransfer(t *testing.T) {
+	holdTicks := 30
+	nt := newNetworkWithConfig(func(c *Config) { c.CampaignHoldTicks = holdTicks }, nil, nil, nil)
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+	nt.send(pb.Message{From: 2, To: 1, MsgType: pb.MessageType_MsgTransferLeader})
+
+	old := nt.peers[1].(*Raft)
+	checkLeaderTransferState(t, old, StateFollower, 2)
+
+	nt.isolate(1)
+	for i := 0; i < holdTicks-1; i++ {
+		old.tick()
+		if old.State != StateFollower {
+			t.Fatalf("tick %d: state = %v, want %v", i, old.State, StateFollower)
+		}
+	}
+	for i := 0; i < 2*old.electionTimeout; i++ {
+		old.tick()
+	}
+	if old.State != StateCandidate {
+		t.Fatalf("state = %v, want %v", old.State, StateCandidate)
+	}
+
+	// the node that didn't transfer its leadership is not held.
+	follower := nt.peers[3].(*Raft)
+	nt.isolate(3)
+	for i := 0; i < 2*follower.electionTimeout; i++ {
+		follower.tick()
+	}
+	if follower.State != StateCandidate {
+		t.Fatalf("state = %v, want %v", follower.State, StateCandidate)
+	}
+}
+
 func checkLeaderTransferState(t *testing.T, r *Raft, state StateType, lead uint64) {
 	if r.State != state || r.Lead != lead {
 		t.Fatalf("after transferring, node has state %v lead %v, want state %v lead %v", r.State, r.Lead, state, lead)
@@ -34,29 +34,36 @@ type RawNode struct {
 	Raft       *Raft
 	prevSoftSt *SoftState
 	prevHardSt pb.HardState
+	// applying is the index of the last committed entry (or snapshot) handed
+	// out in a Ready. It may be ahead of RaftLog.applied while the application
+	// is still applying them.
+	applying uint64
 }
 
 func (rn *RawNode) newReady() Ready {
-	return newReady(rn.Raft, rn.prevSoftSt, rn.prevHardSt, nil)
+	since := max(rn.Raft.RaftLog.applied, rn.applying)
+	return newReady(rn.Raft, rn.prevSoftSt, rn.prevHardSt, &since)
 }
 
-func (rn *RawNode) commitReady(rd Ready) {
+// acceptReady is called when the application has taken the Ready. Everything
+// in it is considered in progress, so the next Ready only returns new work.
+func (rn *RawNode) acceptReady(rd Ready) {
 	if rd.SoftState != nil {
 		rn.prevSoftSt = rd.SoftState
 	}
 	if !IsEmptyHardState(rd.HardState) {
 		rn.prevHardSt = rd.HardState
 	}
+	if index := rd.appliedCursor(); index > rn.applying {
+		rn.applying = index
+	}
+	rn.Raft.msgs = nil
+	rn.Raft.readStates = nil
+	rn.Raft.RaftLog.acceptUnstable()
+	rn.Raft.reduceUncommittedSize(rd.CommittedEntries)
+}
 
-	// If entries were applied (or a snapshot), update our cursor for
-	// the next Ready. Note that if the current HardState contains a
-	// new Commit index, this does not mean that we're also applying
-	// all of the new entries due to commit pagination by size.
-	// Note: Not compatible with tikv implementation.
-	// if index := rd.appliedCursor(); index > 0 {
-	//	rn.Raft.RaftLog.appliedTo(index)
-	//}
-
+func (rn *RawNode) commitReady(rd Ready) {
 	if len(rd.Entries) > 0 {
 		e := rd.Entries[len(rd.Entries)-1]
 		rn.Raft.RaftLog.stableTo(e.Index, e.Term)
@@ -192,10 +199,11 @@ func (rn *RawNode) Step(m pb.Message) error {
 }
 
 // Ready returns the current point-in-time state of this RawNode.
+// The entries, snapshot and committed entries in it are marked as in progress,
+// so calling Ready again before Advance only returns the work produced since.
 func (rn *RawNode) Ready() Ready {
 	rd := rn.newReady()
-	rn.Raft.msgs = nil
-	rn.Raft.reduceUncommittedSize(rd.CommittedEntries)
+	rn.acceptReady(rd)
 	return rd
 }
 
@@ -209,10 +217,13 @@ func (rn *RawNode) HasReady() bool {
 	if hardSt := r.hardState(); !IsEmptyHardState(hardSt) && !isHardStateEqual(hardSt, rn.prevHardSt) {
 		return true
 	}
-	if r.RaftLog.unstable.snapshot != nil && !IsEmptySnap(r.RaftLog.unstable.snapshot) {
+	if snap := r.RaftLog.nextUnstableSnapshot(); snap != nil && !IsEmptySnap(snap) {
+		return true
+	}
+	if len(r.msgs) > 0 || r.RaftLog.hasNextUnstableEnts() || r.RaftLog.hasNextEntsSince(max(r.RaftLog.applied, rn.applying)) {
 		return true
 	}
-	if len(r.msgs) > 0 || len(r.RaftLog.unstableEntries()) > 0 || r.RaftLog.hasNextEnts() {
+	if len(r.readStates) != 0 {
 		return true
 	}
 	return false
@@ -221,9 +232,25 @@ func (rn *RawNode) HasReady() bool {
 // Advance notifies the RawNode that the application has applied and saved progress in the
 // last Ready results.
 func (rn *RawNode) Advance(rd Ready) {
+	rn.AdvanceAppend(rd)
+	// If entries were applied (or a snapshot), update our cursor for
+	// the next Ready. Note that if the current HardState contains a
+	// new Commit index, this does not mean that we're also applying
+	// all of the new entries due to commit pagination by size.
+	if index := rd.appliedCursor(); index > rn.Raft.RaftLog.applied {
+		rn.AdvanceApply(index)
+	}
+}
+
+// AdvanceAppend notifies the RawNode that the entries, hard state and snapshot
+// in the given Ready have been persisted. The committed entries in it may still
+// be being applied, the application acknowledges them with AdvanceApply.
+func (rn *RawNode) AdvanceAppend(rd Ready) {
 	rn.commitReady(rd)
 }
 
+// AdvanceApply notifies the RawNode that the committed entries up to applied
+// have been applied to the state machine.
 func (rn *RawNode) AdvanceApply(applied uint64) {
 	rn.commitApply(applied)
 }
@@ -257,6 +284,14 @@ func (rn *RawNode) ReportUnreachable(id uint64) {
 	_ = rn.Raft.Step(pb.Message{MsgType: pb.MessageType_MsgUnreachable, From: id})
 }
 
+// ReportBusy reports whether the store of the given node is busy. The leader
+// slows down replicating to a busy node, see Progress.Busy.
+func (rn *RawNode) ReportBusy(id uint64, busy bool) {
+	if pr := rn.Raft.getProgress(id); pr != nil {
+		pr.Busy = busy
+	}
+}
+
 // ReportSnapshot reports the status of the sent snapshot.
 func (rn *RawNode) ReportSnapshot(id uint64, status SnapshotStatus) {
 	rej := status == SnapshotFailure
@@ -264,6 +299,14 @@ func (rn *RawNode) ReportSnapshot(id uint64, status SnapshotStatus) {
 	_ = rn.Raft.Step(pb.Message{MsgType: pb.MessageType_MsgSnapStatus, From: id, Reject: rej})
 }
 
+// ReadIndex requests a read state. The read state will be set in ready.
+// Read State has a read index. Once the application advances further than the read
+// index, any linearizable read requests issued before the read request can be
+// processed safely. The read state will have the same rctx attached.
+func (rn *RawNode) ReadIndex(rctx []byte) {
+	_ = rn.Raft.Step(pb.Message{MsgType: pb.MessageType_MsgReadIndex, Entries: []*pb.Entry{{Data: rctx}}})
+}
+
 // TransferLeader tries to transfer leadership to the given transferee.
 func (rn *RawNode) TransferLeader(transferee uint64) {
 	_ = rn.Raft.Step(pb.Message{MsgType: pb.MessageType_MsgTransferLeader, From: transferee})
@@ -273,8 +316,12 @@ func (rn *RawNode) GetSnap() *pb.Snapshot {
 	return rn.Raft.GetSnap()
 }
 
+// ReadySince is like Ready, but the committed entries in it start after
+// appliedIdx, which is maintained by the application instead of the RawNode.
 func (rn *RawNode) ReadySince(appliedIdx uint64) Ready {
-	return newReady(rn.Raft, rn.prevSoftSt, rn.prevHardSt, &appliedIdx)
+	rd := newReady(rn.Raft, rn.prevSoftSt, rn.prevHardSt, &appliedIdx)
+	rn.acceptReady(rd)
+	return rd
 }
 
 func hardStateIsEmpty(hs *pb.HardState) bool {
@@ -286,10 +333,10 @@ func hardStateEqual(l, r *pb.HardState) bool {
 }
 
 func (rn *RawNode) HasReadySince(appliedIdx *uint64) bool {
-	if len(rn.Raft.msgs) != 0 || rn.Raft.RaftLog.unstableEntries() != nil {
+	if len(rn.Raft.msgs) != 0 || rn.Raft.RaftLog.hasNextUnstableEnts() || len(rn.Raft.readStates) != 0 {
 		return true
 	}
-	if snap := rn.GetSnap(); snap != nil && !IsEmptySnap(snap) {
+	if snap := rn.Raft.RaftLog.nextUnstableSnapshot(); snap != nil && !IsEmptySnap(snap) {
 		return true
 	}
 	hasUnappliedEntries := false
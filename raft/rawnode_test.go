@@ -213,6 +213,140 @@ func TestRawNodeProposeAddDuplicateNode(t *testing.T) {
 	}
 }
 
+// TestRawNodeReadIndex ensures that RawNode.ReadIndex sends the MessageType_MsgReadIndex message
+// to the underlying raft, and the read state is returned in Ready.
+func TestRawNodeReadIndex(t *testing.T) {
+	s := NewMemoryStorage()
+	rawNode, err := NewRawNode(newTestConfig(1, nil, 10, 1, s), []Peer{{ID: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rd := rawNode.Ready()
+	s.Append(rd.Entries)
+	rawNode.Advance(rd)
+	rawNode.AdvanceApply(rd.appliedCursor())
+
+	rawNode.Campaign()
+	rd = rawNode.Ready()
+	if rd.SoftState == nil || rd.SoftState.Lead != rawNode.Raft.id {
+		t.Fatalf("soft state = %+v, want leader %d", rd.SoftState, rawNode.Raft.id)
+	}
+	s.Append(rd.Entries)
+	rawNode.Advance(rd)
+	rawNode.AdvanceApply(rd.appliedCursor())
+
+	wrs := []ReadState{{Index: rawNode.Raft.RaftLog.committed, RequestCtx: []byte("somedata")}}
+	rawNode.ReadIndex(wrs[0].RequestCtx)
+	if !rawNode.HasReady() {
+		t.Fatalf("HasReady() returns false, want true")
+	}
+	rd = rawNode.Ready()
+	if !reflect.DeepEqual(rd.ReadStates, wrs) {
+		t.Errorf("ReadStates = %v, want %v", rd.ReadStates, wrs)
+	}
+	rawNode.Advance(rd)
+	// ensure raft.readStates is reset after Ready
+	if rawNode.Raft.readStates != nil {
+		t.Errorf("readStates = %v, want nil", rawNode.Raft.readStates)
+	}
+	if rawNode.HasReady() {
+		t.Errorf("unexpected Ready: %+v", rawNode.Ready())
+	}
+}
+
+// TestRawNodeReadyBatching ensures that a Ready taken before the previous one
+// is advanced only contains the work produced since, so the application can
+// persist and apply several Readys concurrently.
+func TestRawNodeReadyBatching(t *testing.T) {
+	s := NewMemoryStorage()
+	rawNode, err := NewRawNode(newTestConfig(1, nil, 10, 1, s), []Peer{{ID: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rd := rawNode.Ready()
+	s.Append(rd.Entries)
+	rawNode.Advance(rd)
+
+	rawNode.Campaign()
+	rd = rawNode.Ready()
+	s.Append(rd.Entries)
+	rawNode.Advance(rd)
+
+	rawNode.Propose(nil, []byte("foo"))
+	rd1 := rawNode.Ready()
+	if len(rd1.Entries) != 1 || !bytes.Equal(rd1.Entries[0].Data, []byte("foo")) {
+		t.Fatalf("entries = %+v, want the proposal foo", rd1.Entries)
+	}
+	if rawNode.HasReady() {
+		t.Fatalf("unexpected Ready: %+v", rawNode.Ready())
+	}
+
+	rawNode.Propose(nil, []byte("bar"))
+	if !rawNode.HasReady() {
+		t.Fatalf("HasReady() returns false, want true")
+	}
+	rd2 := rawNode.Ready()
+	if len(rd2.Entries) != 1 || !bytes.Equal(rd2.Entries[0].Data, []byte("bar")) {
+		t.Fatalf("entries = %+v, want the proposal bar", rd2.Entries)
+	}
+	// the committed entries of rd1 are still being applied, they must not be
+	// handed out again.
+	for _, ent := range rd2.CommittedEntries {
+		if ent.Index <= rd1.appliedCursor() {
+			t.Fatalf("committed entry %d is returned twice", ent.Index)
+		}
+	}
+
+	s.Append(rd1.Entries)
+	rawNode.AdvanceAppend(rd1)
+	s.Append(rd2.Entries)
+	rawNode.AdvanceAppend(rd2)
+	if rd2.appliedCursor() > 0 {
+		rawNode.AdvanceApply(rd2.appliedCursor())
+	}
+	if rawNode.HasReady() {
+		t.Errorf("unexpected Ready: %+v", rawNode.Ready())
+	}
+	if last := rawNode.Raft.RaftLog.LastIndex(); rawNode.Raft.RaftLog.applied != last {
+		t.Errorf("applied = %d, want %d", rawNode.Raft.RaftLog.applied, last)
+	}
+}
+
+// TestRawNodeAdvanceAppendAndApply ensures that AdvanceAppend doesn't advance
+// the applied index, and that the committed entries it handed out are not
+// returned again while they are being applied.
+func TestRawNodeAdvanceAppendAndApply(t *testing.T) {
+	entries := []pb.Entry{
+		{Term: 1, Index: 1},
+		{Term: 1, Index: 2, Data: []byte("foo")},
+	}
+	storage := NewMemoryStorage()
+	storage.SetHardState(pb.HardState{Term: 1, Commit: 2})
+	storage.Append(entries)
+	rawNode, err := NewRawNode(newTestConfig(1, nil, 10, 1, storage), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rd := rawNode.Ready()
+	if !reflect.DeepEqual(rd.CommittedEntries, entries) {
+		t.Fatalf("committed = %+v, want %+v", rd.CommittedEntries, entries)
+	}
+	rawNode.AdvanceAppend(rd)
+	if applied := rawNode.Raft.RaftLog.applied; applied != 0 {
+		t.Errorf("applied = %d, want 0", applied)
+	}
+	if rawNode.HasReady() {
+		t.Errorf("unexpected Ready: %+v", rawNode.Ready())
+	}
+	rawNode.AdvanceApply(rd.appliedCursor())
+	if applied := rawNode.Raft.RaftLog.applied; applied != 2 {
+		t.Errorf("applied = %d, want 2", applied)
+	}
+	if rawNode.HasReady() {
+		t.Errorf("unexpected Ready: %+v", rawNode.Ready())
+	}
+}
+
 func TestRawNodeRestart(t *testing.T) {
 	entries := []pb.Entry{
 		{Term: 1, Index: 1},
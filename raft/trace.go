@@ -0,0 +1,170 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raft
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+
+	pb "github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+)
+
+// TraceHook is notified of the message flow and the role changes of a raft
+// node. It is meant for tests and debugging. The hook is called synchronously
+// by the raft state machine, so it must be cheap and must not call back into it.
+type TraceHook interface {
+	// OnSend is called when the node queues m to be sent.
+	OnSend(m pb.Message)
+	// OnReceive is called when m is stepped into the node, local messages included.
+	OnReceive(id uint64, m pb.Message)
+	// OnStateChange is called when the node becomes follower, candidate or leader.
+	OnStateChange(id uint64, term uint64, from, to StateType)
+}
+
+// SetTraceHook sets the hook used by the raft nodes whose Config.TraceHook is nil,
+// which can be used to turn on tracing for every raft group of a process.
+func SetTraceHook(h TraceHook) {
+	traceHookMu.Lock()
+	defer traceHookMu.Unlock()
+	defaultTraceHook = h
+}
+
+var (
+	traceHookMu      sync.Mutex
+	defaultTraceHook TraceHook
+)
+
+func getDefaultTraceHook() TraceHook {
+	traceHookMu.Lock()
+	defer traceHookMu.Unlock()
+	return defaultTraceHook
+}
+
+type traceEventType int
+
+const (
+	traceEventSend traceEventType = iota
+	traceEventReceive
+	traceEventStateChange
+)
+
+type traceEvent struct {
+	tp   traceEventType
+	id   uint64
+	msg  pb.Message
+	term uint64
+	from StateType
+	to   StateType
+}
+
+// SequenceRecorder is a TraceHook which records the events of one or more raft
+// nodes, and renders them as a sequence diagram that can be attached to bug
+// reports. It is safe for concurrent use.
+type SequenceRecorder struct {
+	mu     sync.Mutex
+	events []traceEvent
+}
+
+// NewSequenceRecorder creates an empty SequenceRecorder.
+func NewSequenceRecorder() *SequenceRecorder {
+	return &SequenceRecorder{}
+}
+
+func (sr *SequenceRecorder) record(e traceEvent) {
+	sr.mu.Lock()
+	sr.events = append(sr.events, e)
+	sr.mu.Unlock()
+}
+
+func (sr *SequenceRecorder) OnSend(m pb.Message) {
+	sr.record(traceEvent{tp: traceEventSend, id: m.From, msg: m})
+}
+
+func (sr *SequenceRecorder) OnReceive(id uint64, m pb.Message) {
+	sr.record(traceEvent{tp: traceEventReceive, id: id, msg: m})
+}
+
+func (sr *SequenceRecorder) OnStateChange(id uint64, term uint64, from, to StateType) {
+	sr.record(traceEvent{tp: traceEventStateChange, id: id, term: term, from: from, to: to})
+}
+
+// Reset drops all the recorded events.
+func (sr *SequenceRecorder) Reset() {
+	sr.mu.Lock()
+	sr.events = nil
+	sr.mu.Unlock()
+}
+
+// Diagram renders the recorded events as a mermaid sequence diagram. Sent
+// messages are drawn as arrows, local messages stepped into a node and role
+// changes are drawn as notes over the node.
+func (sr *SequenceRecorder) Diagram() string {
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+
+	nodes := make(map[uint64]struct{})
+	for _, e := range sr.events {
+		nodes[e.id] = struct{}{}
+		if e.tp == traceEventSend {
+			nodes[e.msg.To] = struct{}{}
+		}
+	}
+	ids := make([]uint64, 0, len(nodes))
+	for id := range nodes {
+		ids = append(ids, id)
+	}
+	sort.Sort(uint64Slice(ids))
+
+	var buf bytes.Buffer
+	buf.WriteString("sequenceDiagram\n")
+	for _, id := range ids {
+		fmt.Fprintf(&buf, "    participant %x\n", id)
+	}
+	for _, e := range sr.events {
+		switch e.tp {
+		case traceEventSend:
+			fmt.Fprintf(&buf, "    %x->>%x: %s\n", e.msg.From, e.msg.To, describeTraceMessage(e.msg))
+		case traceEventReceive:
+			if IsLocalMsg(e.msg.MsgType) || e.msg.MsgType == pb.MessageType_MsgPropose && e.msg.From == None {
+				fmt.Fprintf(&buf, "    Note over %x: %s\n", e.id, describeTraceMessage(e.msg))
+			}
+		case traceEventStateChange:
+			fmt.Fprintf(&buf, "    Note over %x: %v -> %v at term %d\n", e.id, e.from, e.to, e.term)
+		}
+	}
+	return buf.String()
+}
+
+// describeTraceMessage is like DescribeMessage, but leaves out the entry data
+// to keep the diagram readable.
+func describeTraceMessage(m pb.Message) string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%v Term:%d Log:%d/%d", m.MsgType, m.Term, m.LogTerm, m.Index)
+	if m.Reject {
+		fmt.Fprintf(&buf, " Rejected (Hint: %d)", m.RejectHint)
+	}
+	if m.Commit != 0 {
+		fmt.Fprintf(&buf, " Commit:%d", m.Commit)
+	}
+	if len(m.Entries) > 0 {
+		fmt.Fprintf(&buf, " Entries:%d", len(m.Entries))
+	}
+	if !IsEmptySnap(m.Snapshot) {
+		fmt.Fprintf(&buf, " Snapshot:%d/%d", m.Snapshot.Metadata.Term, m.Snapshot.Metadata.Index)
+	}
+	return buf.String()
+}
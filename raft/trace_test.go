@@ -0,0 +1,72 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raft
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/pingcap-incubator/tinykv/proto/pkg/eraftpb"
+)
+
+func TestSequenceRecorderElection(t *testing.T) {
+	sr := NewSequenceRecorder()
+	SetTraceHook(sr)
+	defer SetTraceHook(nil)
+
+	nt := newNetwork(nil, nil, nil)
+	sr.Reset()
+	nt.send(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+
+	diagram := sr.Diagram()
+	wants := []string{
+		"sequenceDiagram\n",
+		"participant 1\n",
+		"participant 3\n",
+		"Note over 1: MsgHup",
+		"Note over 1: StateFollower -> StateCandidate at term 1\n",
+		"1->>2: MsgRequestVote Term:1 Log:0/0\n",
+		"2->>1: MsgRequestVoteResponse Term:1 Log:0/0\n",
+		"Note over 1: StateCandidate -> StateLeader at term 1\n",
+		"1->>3: MsgAppend Term:1 Log:0/0 Entries:1\n",
+	}
+	for _, w := range wants {
+		if !strings.Contains(diagram, w) {
+			t.Errorf("diagram doesn't contain %q:\n%s", w, diagram)
+		}
+	}
+	if i, j := strings.Index(diagram, "StateCandidate -> StateLeader"), strings.Index(diagram, "1->>2: MsgRequestVote "); i < j {
+		t.Errorf("leader elected before requesting votes:\n%s", diagram)
+	}
+}
+
+func TestTraceHookConfig(t *testing.T) {
+	sr := NewSequenceRecorder()
+	SetTraceHook(NewSequenceRecorder())
+	defer SetTraceHook(nil)
+
+	c := newTestConfig(1, []uint64{1, 2}, 10, 1, NewMemoryStorage())
+	c.TraceHook = sr
+	r := newRaft(c)
+	r.Step(pb.Message{From: 1, To: 1, MsgType: pb.MessageType_MsgHup})
+	if len(r.msgs) != 1 {
+		t.Fatalf("len(msgs) = %d, want 1", len(r.msgs))
+	}
+
+	diagram := sr.Diagram()
+	if !strings.Contains(diagram, "1->>2: MsgRequestVote Term:1") {
+		t.Errorf("diagram doesn't contain the vote request:\n%s", diagram)
+	}
+}
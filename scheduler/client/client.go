@@ -53,6 +53,12 @@ type Client interface {
 	// If a region has no leader, corresponding leader will be placed by a peer
 	// with empty value (PeerID is 0).
 	ScanRegions(ctx context.Context, key, endKey []byte, limit int) ([]*metapb.Region, []*metapb.Peer, error)
+	// BatchGetRegions gets the regions containing the given keys in one call,
+	// which can be used to warm up the region cache before a bulk job.
+	// Regions are deduplicated and keys not covered by any region are skipped.
+	// If a region has no leader, corresponding leader will be placed by a peer
+	// with empty value (PeerID is 0).
+	BatchGetRegions(ctx context.Context, keys [][]byte) ([]*metapb.Region, []*metapb.Peer, error)
 	// GetStore gets a store from PD by store id.
 	// The store may expire later. Caller is responsible for caching and taking care
 	// of store change.
@@ -70,6 +76,17 @@ type Client interface {
 	ScatterRegion(ctx context.Context, regionID uint64) error
 	// GetOperator gets the status of operator of the specified region.
 	GetOperator(ctx context.Context, regionID uint64) (*pdpb.GetOperatorResponse, error)
+	// SetSplitKeys sets the keys no region should span, e.g. the prefix of every table.
+	// PD splits the regions containing them proactively. The keys are in the same
+	// encoding as region keys and replace the ones set before.
+	SetSplitKeys(ctx context.Context, keys [][]byte) error
+	// SetReadOnly makes the stores reject the writes to the whole cluster if cluster is
+	// true, and to the regions overlapping the ranges. The ranges are in the same encoding
+	// as region keys and replace the ones set before, unset both to leave the read-only mode.
+	SetReadOnly(ctx context.Context, cluster bool, ranges []*pdpb.KeyRange) error
+	// SetResourceGroups sets the resource groups the requests to the stores are accounted
+	// to and scheduled by. The groups replace the ones set before.
+	SetResourceGroups(ctx context.Context, groups []*pdpb.ResourceGroup) error
 	// Close closes the client.
 	Close()
 }
@@ -674,6 +691,29 @@ func (c *client) ScanRegions(ctx context.Context, key, endKey []byte, limit int)
 	return resp.GetRegions(), resp.GetLeaders(), nil
 }
 
+func (c *client) BatchGetRegions(ctx context.Context, keys [][]byte) ([]*metapb.Region, []*metapb.Peer, error) {
+	if span := opentracing.SpanFromContext(ctx); span != nil {
+		span = opentracing.StartSpan("pdclient.BatchGetRegions", opentracing.ChildOf(span.Context()))
+		defer span.Finish()
+	}
+	start := time.Now()
+	defer func() { cmdDurationBatchGetRegions.Observe(time.Since(start).Seconds()) }()
+
+	ctx, cancel := context.WithTimeout(ctx, pdTimeout)
+	resp, err := c.leaderClient().BatchGetRegions(ctx, &pdpb.BatchGetRegionsRequest{
+		Header:     c.requestHeader(),
+		RegionKeys: keys,
+	})
+	cancel()
+
+	if err != nil {
+		cmdFailedDurationBatchGetRegions.Observe(time.Since(start).Seconds())
+		c.ScheduleCheckLeader()
+		return nil, nil, errors.WithStack(err)
+	}
+	return resp.GetRegions(), resp.GetLeaders(), nil
+}
+
 func (c *client) GetStore(ctx context.Context, storeID uint64) (*metapb.Store, error) {
 	if span := opentracing.SpanFromContext(ctx); span != nil {
 		span = opentracing.StartSpan("pdclient.GetStore", opentracing.ChildOf(span.Context()))
@@ -796,6 +836,82 @@ func (c *client) GetOperator(ctx context.Context, regionID uint64) (*pdpb.GetOpe
 	})
 }
 
+func (c *client) SetSplitKeys(ctx context.Context, keys [][]byte) error {
+	if span := opentracing.SpanFromContext(ctx); span != nil {
+		span = opentracing.StartSpan("pdclient.SetSplitKeys", opentracing.ChildOf(span.Context()))
+		defer span.Finish()
+	}
+	start := time.Now()
+	defer func() { cmdDurationSetSplitKeys.Observe(time.Since(start).Seconds()) }()
+
+	ctx, cancel := context.WithTimeout(ctx, pdTimeout)
+	resp, err := c.leaderClient().SetSplitKeys(ctx, &pdpb.SetSplitKeysRequest{
+		Header: c.requestHeader(),
+		Keys:   keys,
+	})
+	cancel()
+	if err != nil {
+		cmdFailedDurationSetSplitKeys.Observe(time.Since(start).Seconds())
+		c.ScheduleCheckLeader()
+		return errors.WithStack(err)
+	}
+	if resp.Header.GetError() != nil {
+		return errors.Errorf("set split keys failed: %s", resp.Header.GetError().String())
+	}
+	return nil
+}
+
+func (c *client) SetReadOnly(ctx context.Context, cluster bool, ranges []*pdpb.KeyRange) error {
+	if span := opentracing.SpanFromContext(ctx); span != nil {
+		span = opentracing.StartSpan("pdclient.SetReadOnly", opentracing.ChildOf(span.Context()))
+		defer span.Finish()
+	}
+	start := time.Now()
+	defer func() { cmdDurationSetReadOnly.Observe(time.Since(start).Seconds()) }()
+
+	ctx, cancel := context.WithTimeout(ctx, pdTimeout)
+	resp, err := c.leaderClient().SetReadOnly(ctx, &pdpb.SetReadOnlyRequest{
+		Header:  c.requestHeader(),
+		Cluster: cluster,
+		Ranges:  ranges,
+	})
+	cancel()
+	if err != nil {
+		cmdFailedDurationSetReadOnly.Observe(time.Since(start).Seconds())
+		c.ScheduleCheckLeader()
+		return errors.WithStack(err)
+	}
+	if resp.Header.GetError() != nil {
+		return errors.Errorf("set read only failed: %s", resp.Header.GetError().String())
+	}
+	return nil
+}
+
+func (c *client) SetResourceGroups(ctx context.Context, groups []*pdpb.ResourceGroup) error {
+	if span := opentracing.SpanFromContext(ctx); span != nil {
+		span = opentracing.StartSpan("pdclient.SetResourceGroups", opentracing.ChildOf(span.Context()))
+		defer span.Finish()
+	}
+	start := time.Now()
+	defer func() { cmdDurationSetResourceGroups.Observe(time.Since(start).Seconds()) }()
+
+	ctx, cancel := context.WithTimeout(ctx, pdTimeout)
+	resp, err := c.leaderClient().SetResourceGroups(ctx, &pdpb.SetResourceGroupsRequest{
+		Header: c.requestHeader(),
+		Groups: groups,
+	})
+	cancel()
+	if err != nil {
+		cmdFailedDurationSetResourceGroups.Observe(time.Since(start).Seconds())
+		c.ScheduleCheckLeader()
+		return errors.WithStack(err)
+	}
+	if resp.Header.GetError() != nil {
+		return errors.Errorf("set resource groups failed: %s", resp.Header.GetError().String())
+	}
+	return nil
+}
+
 func (c *client) requestHeader() *pdpb.RequestHeader {
 	return &pdpb.RequestHeader{
 		ClusterId: c.clusterID,
@@ -310,6 +310,59 @@ func (s *testClientSuite) TestScanRegions(c *C) {
 	check([]byte{1}, []byte{6}, 2, regions[1:3])
 }
 
+func (s *testClientSuite) TestBatchGetRegions(c *C) {
+	regionLen := 5
+	regions := make([]*metapb.Region, 0, regionLen)
+	for i := 0; i < regionLen; i++ {
+		regionID := regionIDAllocator.alloc()
+		r := &metapb.Region{
+			Id: regionID,
+			RegionEpoch: &metapb.RegionEpoch{
+				ConfVer: 1,
+				Version: 1,
+			},
+			StartKey: []byte{byte(i*2 + 200)},
+			EndKey:   []byte{byte(i*2 + 202)},
+			Peers:    peers,
+		}
+		regions = append(regions, r)
+		req := &pdpb.RegionHeartbeatRequest{
+			Header: newHeader(s.srv),
+			Region: r,
+			Leader: peers[0],
+		}
+		err := s.regionHeartbeat.Send(req)
+		c.Assert(err, IsNil)
+	}
+
+	// Wait for region heartbeats.
+	testutil.WaitUntil(c, func(c *C) bool {
+		r, _, err := s.client.BatchGetRegions(context.Background(), [][]byte{{208}})
+		return err == nil && len(r) == 1 && r[0].GetId() == regions[4].GetId()
+	})
+
+	// Set leader of region1 to nil.
+	region1 := core.NewRegionInfo(regions[1], nil)
+	s.srv.GetRaftCluster().HandleRegionHeartbeat(region1)
+
+	// Keys hitting the same region are deduplicated, and the key past the last
+	// region is skipped.
+	keys := [][]byte{{203}, {202}, {200}, {201}, {206}, {250}}
+	batchRegions, leaders, err := s.client.BatchGetRegions(context.Background(), keys)
+	c.Assert(err, IsNil)
+	expect := []*metapb.Region{regions[1], regions[0], regions[3]}
+	c.Assert(batchRegions, HasLen, len(expect))
+	c.Assert(leaders, HasLen, len(expect))
+	for i := range expect {
+		c.Assert(batchRegions[i], DeepEquals, expect[i])
+		if batchRegions[i].GetId() == region1.GetID() {
+			c.Assert(leaders[i], DeepEquals, &metapb.Peer{})
+		} else {
+			c.Assert(leaders[i], DeepEquals, expect[i].Peers[0])
+		}
+	}
+}
+
 func (s *testClientSuite) TestGetRegionByID(c *C) {
 	regionID := regionIDAllocator.alloc()
 	region := &metapb.Region{
@@ -62,20 +62,28 @@ var (
 	cmdDurationGetPrevRegion     = cmdDuration.WithLabelValues("get_prev_region")
 	cmdDurationGetRegionByID     = cmdDuration.WithLabelValues("get_region_byid")
 	cmdDurationScanRegions       = cmdDuration.WithLabelValues("scan_regions")
+	cmdDurationBatchGetRegions   = cmdDuration.WithLabelValues("batch_get_regions")
 	cmdDurationGetStore          = cmdDuration.WithLabelValues("get_store")
 	cmdDurationGetAllStores      = cmdDuration.WithLabelValues("get_all_stores")
 	cmdDurationUpdateGCSafePoint = cmdDuration.WithLabelValues("update_gc_safe_point")
 	cmdDurationScatterRegion     = cmdDuration.WithLabelValues("scatter_region")
 	cmdDurationGetOperator       = cmdDuration.WithLabelValues("get_operator")
+	cmdDurationSetSplitKeys      = cmdDuration.WithLabelValues("set_split_keys")
+	cmdDurationSetReadOnly       = cmdDuration.WithLabelValues("set_read_only")
+	cmdDurationSetResourceGroups = cmdDuration.WithLabelValues("set_resource_groups")
 
 	cmdFailDurationGetRegion           = cmdFailedDuration.WithLabelValues("get_region")
 	cmdFailDurationTSO                 = cmdFailedDuration.WithLabelValues("tso")
 	cmdFailDurationGetPrevRegion       = cmdFailedDuration.WithLabelValues("get_prev_region")
 	cmdFailedDurationGetRegionByID     = cmdFailedDuration.WithLabelValues("get_region_byid")
 	cmdFailedDurationScanRegions       = cmdFailedDuration.WithLabelValues("scan_regions")
+	cmdFailedDurationBatchGetRegions   = cmdFailedDuration.WithLabelValues("batch_get_regions")
 	cmdFailedDurationGetStore          = cmdFailedDuration.WithLabelValues("get_store")
 	cmdFailedDurationGetAllStores      = cmdFailedDuration.WithLabelValues("get_all_stores")
 	cmdFailedDurationUpdateGCSafePoint = cmdFailedDuration.WithLabelValues("update_gc_safe_point")
+	cmdFailedDurationSetSplitKeys      = cmdFailedDuration.WithLabelValues("set_split_keys")
+	cmdFailedDurationSetReadOnly       = cmdFailedDuration.WithLabelValues("set_read_only")
+	cmdFailedDurationSetResourceGroups = cmdFailedDuration.WithLabelValues("set_resource_groups")
 	requestDurationTSO                 = requestDuration.WithLabelValues("tso")
 )
 
@@ -18,6 +18,7 @@ import (
 
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/placement"
 )
 
 const (
@@ -56,6 +57,7 @@ type ScheduleOptions struct {
 	StoreBalanceRate             float64
 	MaxSnapshotCount             uint64
 	MaxPendingPeerCount          uint64
+	MaxLeaderTransferPerMinute   uint64
 	MaxMergeRegionSize           uint64
 	MaxMergeRegionKeys           uint64
 	SchedulerMaxWaitingOperator  uint64
@@ -84,6 +86,8 @@ type ScheduleOptions struct {
 	DisableLocationReplacement   bool
 	LeaderScheduleStrategy       string
 	LabelProperties              map[string][]*metapb.StoreLabel
+	LeaderConstraints            []placement.LabelConstraint
+	ReplicaConstraints           []placement.LabelConstraint
 }
 
 // NewScheduleOptions creates a mock schedule option.
@@ -159,6 +163,11 @@ func (mso *ScheduleOptions) GetMaxPendingPeerCount() uint64 {
 	return mso.MaxPendingPeerCount
 }
 
+// GetMaxLeaderTransferPerMinute mocks method
+func (mso *ScheduleOptions) GetMaxLeaderTransferPerMinute() uint64 {
+	return mso.MaxLeaderTransferPerMinute
+}
+
 // GetMaxMergeRegionSize mocks method
 func (mso *ScheduleOptions) GetMaxMergeRegionSize() uint64 {
 	return mso.MaxMergeRegionSize
@@ -209,6 +218,16 @@ func (mso *ScheduleOptions) IsPlacementRulesEnabled() bool {
 	return mso.EnablePlacementRules
 }
 
+// GetLeaderConstraints mocks method
+func (mso *ScheduleOptions) GetLeaderConstraints() []placement.LabelConstraint {
+	return mso.LeaderConstraints
+}
+
+// GetReplicaConstraints mocks method
+func (mso *ScheduleOptions) GetReplicaConstraints() []placement.LabelConstraint {
+	return mso.ReplicaConstraints
+}
+
 // GetHotRegionCacheHitsThreshold mocks method
 func (mso *ScheduleOptions) GetHotRegionCacheHitsThreshold() int {
 	return mso.HotRegionCacheHitsThreshold
@@ -31,6 +31,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/scheduler/server/id"
 	syncer "github.com/pingcap-incubator/tinykv/scheduler/server/region_syncer"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/placement"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/statistics"
 	"github.com/pingcap/errcode"
 	"github.com/pingcap/log"
@@ -41,6 +42,8 @@ import (
 var (
 	backgroundJobInterval      = time.Minute
 	defaultChangedRegionsLimit = 10000
+	// regionFlowHistorySize is the number of the latest heartbeats of a region whose flow is recorded.
+	regionFlowHistorySize = 120
 )
 
 // RaftCluster is used for cluster config management.
@@ -75,9 +78,14 @@ type RaftCluster struct {
 	regionStats     *statistics.RegionStatistics
 	storesStats     *statistics.StoresStats
 	hotSpotCache    *statistics.HotCache
+	regionFlows     *statistics.RegionFlowRecorder
 
 	coordinator *coordinator
 
+	// readOnly and resourceGroups are sent to the stores in the store heartbeat responses.
+	readOnly       *core.ReadOnly
+	resourceGroups []*pdpb.ResourceGroup
+
 	wg           sync.WaitGroup
 	quit         chan struct{}
 	regionSyncer *syncer.RegionSyncer
@@ -150,6 +158,8 @@ func (c *RaftCluster) initCluster(id id.Allocator, opt *config.ScheduleOption, s
 	c.prepareChecker = newPrepareChecker()
 	c.changedRegions = make(chan *core.RegionInfo, defaultChangedRegionsLimit)
 	c.hotSpotCache = statistics.NewHotCache()
+	c.regionFlows = statistics.NewRegionFlowRecorder(regionFlowHistorySize)
+	c.readOnly = new(core.ReadOnly)
 }
 
 func (c *RaftCluster) start() error {
@@ -171,6 +181,17 @@ func (c *RaftCluster) start() error {
 	}
 
 	c.coordinator = newCoordinator(c.ctx, cluster, c.s.hbStreams)
+	splitKeys, err := c.storage.LoadSplitKeys()
+	if err != nil {
+		return err
+	}
+	c.coordinator.checkers.SetSplitKeys(splitKeys)
+	if c.readOnly, err = c.storage.LoadReadOnly(); err != nil {
+		return err
+	}
+	if c.resourceGroups, err = c.storage.LoadResourceGroups(); err != nil {
+		return err
+	}
 	c.regionStats = statistics.NewRegionStatistics(c.s.scheduleOpt)
 	c.quit = make(chan struct{})
 
@@ -311,13 +332,29 @@ func (c *RaftCluster) handleStoreHeartbeat(stats *pdpb.StoreStats) error {
 	if store == nil {
 		return core.NewStoreNotFoundErr(storeID)
 	}
-	newStore := store.Clone(core.SetStoreStats(stats), core.SetLastHeartbeatTS(time.Now()))
+	now := time.Now()
+	opts := []core.StoreCreateOption{core.SetStoreStats(stats), core.SetLastHeartbeatTS(now)}
+	if stats.GetIsBusy() && stats.GetBusyBackoffMs() > 0 {
+		opts = append(opts, core.SetBusyUntil(now.Add(time.Duration(stats.GetBusyBackoffMs())*time.Millisecond)))
+	}
+	newStore := store.Clone(opts...)
 	c.core.PutStore(newStore)
 	c.storesStats.Observe(newStore.GetID(), newStore.GetStoreStats())
 	c.storesStats.UpdateTotalBytesRate(c.core.GetStores)
 	return nil
 }
 
+// handleOperatorResults handles the results of the operator commands reported in a store heartbeat.
+func (c *RaftCluster) handleOperatorResults(results []*pdpb.OperatorResult) {
+	if len(results) == 0 {
+		return
+	}
+	opController := c.GetOperatorController()
+	for _, result := range results {
+		opController.HandleOperatorResult(result)
+	}
+}
+
 // processRegionHeartbeat updates the region information.
 func (c *RaftCluster) processRegionHeartbeat(region *core.RegionInfo) error {
 	c.RLock()
@@ -419,6 +456,7 @@ func (c *RaftCluster) processRegionHeartbeat(region *core.RegionInfo) error {
 		default:
 		}
 	}
+	c.regionFlows.Record(region, time.Now())
 	if len(writeItems) == 0 && len(readItems) == 0 && !saveCache && !isNew {
 		return nil
 	}
@@ -446,6 +484,7 @@ func (c *RaftCluster) processRegionHeartbeat(region *core.RegionInfo) error {
 				c.regionStats.ClearDefunctRegion(item.GetID())
 			}
 			c.labelLevelStats.ClearDefunctRegion(item.GetID(), c.GetLocationLabels())
+			c.regionFlows.Remove(item.GetID())
 		}
 
 		// Update related stores.
@@ -588,6 +627,63 @@ func (c *RaftCluster) GetRegionByID(regionID uint64) (*metapb.Region, *metapb.Pe
 	return region.GetMeta(), region.GetLeader()
 }
 
+// SetSplitKeys persists the keys no region should span and lets the checkers split regions at them.
+func (c *RaftCluster) SetSplitKeys(keys [][]byte) error {
+	if err := c.storage.SaveSplitKeys(keys); err != nil {
+		return err
+	}
+	c.coordinator.checkers.SetSplitKeys(keys)
+	return nil
+}
+
+// SetReadOnly persists the read-only mode, the stores reject the writes to the whole cluster or to the regions
+// overlapping the ranges once they receive it in the store heartbeat responses.
+func (c *RaftCluster) SetReadOnly(cluster bool, ranges []*pdpb.KeyRange) error {
+	readOnly := &core.ReadOnly{Cluster: cluster, Ranges: ranges}
+	if err := c.storage.SaveReadOnly(readOnly); err != nil {
+		return err
+	}
+	c.Lock()
+	c.readOnly = readOnly
+	c.Unlock()
+	return nil
+}
+
+// GetReadOnly returns the read-only mode.
+func (c *RaftCluster) GetReadOnly() *core.ReadOnly {
+	c.RLock()
+	defer c.RUnlock()
+	return c.readOnly
+}
+
+// SetResourceGroups persists the resource groups, they replace the ones set before.
+func (c *RaftCluster) SetResourceGroups(groups []*pdpb.ResourceGroup) error {
+	names := make(map[string]struct{}, len(groups))
+	for _, group := range groups {
+		if group.GetWeight() == 0 {
+			return errors.Errorf("resource group %q has no weight", group.GetName())
+		}
+		if _, ok := names[group.GetName()]; ok {
+			return errors.Errorf("resource group %q is duplicated", group.GetName())
+		}
+		names[group.GetName()] = struct{}{}
+	}
+	if err := c.storage.SaveResourceGroups(groups); err != nil {
+		return err
+	}
+	c.Lock()
+	c.resourceGroups = groups
+	c.Unlock()
+	return nil
+}
+
+// GetResourceGroups returns the resource groups.
+func (c *RaftCluster) GetResourceGroups() []*pdpb.ResourceGroup {
+	c.RLock()
+	defer c.RUnlock()
+	return c.resourceGroups
+}
+
 // GetRegion searches for a region by ID.
 func (c *RaftCluster) GetRegion(regionID uint64) *core.RegionInfo {
 	return c.core.GetRegion(regionID)
@@ -759,9 +855,21 @@ func (c *RaftCluster) putStore(store *metapb.Store) error {
 		}
 	}
 
+	for _, l := range store.GetLabels() {
+		if l.GetKey() != placement.DiskClassLabel {
+			continue
+		}
+		if err := placement.ValidateDiskClass(l.GetValue()); err != nil {
+			return errors.Errorf("invalid put store %v, error: %s", store, err)
+		}
+	}
+
 	s := c.GetStore(store.GetId())
 	if s == nil {
-		// Add a new store.
+		// Add a new store, with the labels of the cluster spec.
+		if c.s != nil {
+			store = withSpecLabels(c.s.cfg.ClusterSpec, store)
+		}
 		s = core.NewStoreInfo(store)
 	} else {
 		// Update an existed store.
@@ -1241,6 +1349,11 @@ func (c *RaftCluster) GetMaxPendingPeerCount() uint64 {
 	return c.opt.GetMaxPendingPeerCount()
 }
 
+// GetMaxLeaderTransferPerMinute returns the max leader transfers of a store in a minute.
+func (c *RaftCluster) GetMaxLeaderTransferPerMinute() uint64 {
+	return c.opt.GetMaxLeaderTransferPerMinute()
+}
+
 // GetMaxMergeRegionSize returns the max region size.
 func (c *RaftCluster) GetMaxMergeRegionSize() uint64 {
 	return c.opt.GetMaxMergeRegionSize()
@@ -1296,6 +1409,16 @@ func (c *RaftCluster) IsPlacementRulesEnabled() bool {
 	return c.opt.IsPlacementRulesEnabled()
 }
 
+// GetLeaderConstraints returns the constraints of the stores the leaders may be on.
+func (c *RaftCluster) GetLeaderConstraints() []placement.LabelConstraint {
+	return c.opt.GetLeaderConstraints()
+}
+
+// GetReplicaConstraints returns the constraints of the stores the replicas may be added to.
+func (c *RaftCluster) GetReplicaConstraints() []placement.LabelConstraint {
+	return c.opt.GetReplicaConstraints()
+}
+
 // GetHotRegionCacheHitsThreshold gets the threshold of hitting hot region cache.
 func (c *RaftCluster) GetHotRegionCacheHitsThreshold() int {
 	return c.opt.GetHotRegionCacheHitsThreshold()
@@ -1384,6 +1507,19 @@ func (c *RaftCluster) RegionWriteStats() map[uint64][]*statistics.HotPeerStat {
 	return c.hotSpotCache.RegionStats(statistics.WriteFlow)
 }
 
+// TopFlowRegions returns at most limit regions with the most bytes of the kind of flow in the latest window, from
+// the hottest.
+func (c *RaftCluster) TopFlowRegions(kind statistics.FlowKind, window time.Duration, limit int) []statistics.RegionFlow {
+	// TopRegions is a thread-safe method
+	return c.regionFlows.TopRegions(kind, time.Now(), window, limit)
+}
+
+// RegionFlowHistory returns the flow reported by the latest heartbeats of a region, from the oldest.
+func (c *RaftCluster) RegionFlowHistory(regionID uint64) []statistics.RegionFlowSample {
+	// History is a thread-safe method
+	return c.regionFlows.History(regionID)
+}
+
 // CheckWriteStatus checks the write status, returns whether need update statistics and item.
 func (c *RaftCluster) CheckWriteStatus(region *core.RegionInfo) []*statistics.HotPeerStat {
 	return c.hotSpotCache.CheckWrite(region, c.storesStats)
@@ -16,15 +16,22 @@ package server
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/codec"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockid"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/testutil"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/config"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/kv"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/statistics"
 	. "github.com/pingcap/check"
 	"github.com/pkg/errors"
 )
@@ -407,6 +414,111 @@ func (s *baseCluster) testRemoveStore(c *C, clusterID uint64, store *metapb.Stor
 	}
 }
 
+func (s *testClusterSuite) TestBootstrapClusterSpec(c *C) {
+	dir, err := ioutil.TempDir("", "test_cluster_spec")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	writeSpec := func(spec string) string {
+		path := filepath.Join(dir, "spec.toml")
+		c.Assert(ioutil.WriteFile(path, []byte(spec), 0644), IsNil)
+		return path
+	}
+	// The location labels must be set on every store, and the replicas must fit in the stores.
+	_, err = config.LoadClusterSpec(writeSpec(`
+max-replicas = 2
+location-labels = ["zone"]
+[[stores]]
+address = "127.0.0.1:0"
+`))
+	c.Assert(err, NotNil)
+	_, err = config.LoadClusterSpec(writeSpec(`
+max-replicas = 2
+[[stores]]
+address = "127.0.0.1:0"
+`))
+	c.Assert(err, NotNil)
+	_, err = config.LoadClusterSpec(writeSpec(`split-keys = ["62", "61"]`))
+	c.Assert(err, NotNil)
+
+	spec, err := config.LoadClusterSpec(writeSpec(`
+max-replicas = 2
+location-labels = ["zone"]
+split-keys = ["61", "62"]
+[[stores]]
+address = "127.0.0.1:0"
+labels = { zone = "z1" }
+[[stores]]
+address = "127.0.0.1:1"
+labels = { zone = "z2" }
+`))
+	c.Assert(err, IsNil)
+
+	var cleanup func()
+	s.svr, cleanup, err = NewTestServer(c)
+	defer cleanup()
+	c.Assert(err, IsNil)
+	mustWaitLeader(c, []*Server{s.svr})
+	s.svr.cfg.ClusterSpec = spec
+	_, err = s.svr.bootstrapCluster(s.newBootstrapRequest(c, s.svr.clusterID, "127.0.0.1:0"))
+	c.Assert(err, IsNil)
+
+	replication := s.svr.GetReplicationConfig()
+	c.Assert(replication.MaxReplicas, Equals, uint64(2))
+	c.Assert([]string(replication.LocationLabels), DeepEquals, []string{"zone"})
+	keys, err := s.svr.storage.LoadSplitKeys()
+	c.Assert(err, IsNil)
+	c.Assert(keys, DeepEquals, [][]byte{[]byte("a"), []byte("b")})
+
+	// Both the bootstrap store and the stores joining later get the labels of their addresses.
+	cluster := s.svr.GetRaftCluster()
+	for _, store := range cluster.GetStores() {
+		c.Assert(store.GetLabelValue("zone"), Equals, "z1")
+	}
+	store := s.newStore(c, 0, "127.0.0.1:1", "2.0.0")
+	c.Assert(cluster.putStore(store), IsNil)
+	c.Assert(cluster.GetStore(store.GetId()).GetLabelValue("zone"), Equals, "z2")
+}
+
+func (s *testClusterSuite) TestClusterSpecSplitPreset(c *C) {
+	dir, err := ioutil.TempDir("", "test_cluster_spec")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+	loadSpec := func(spec string) (*config.ClusterSpec, error) {
+		path := filepath.Join(dir, "spec.toml")
+		c.Assert(ioutil.WriteFile(path, []byte(spec), 0644), IsNil)
+		return config.LoadClusterSpec(path)
+	}
+	for _, spec := range []string{
+		"[split-preset]\nlayout = \"range\"",
+		"[split-preset]\nlayout = \"hash\"\nregions = 1",
+		"[split-preset]\nlayout = \"table\"",
+		"[split-preset]\nlayout = \"table\"\ntables = [0]",
+	} {
+		_, err = loadSpec(spec)
+		c.Assert(err, NotNil)
+	}
+
+	spec, err := loadSpec("[split-preset]\nlayout = \"hash\"\nregions = 256")
+	c.Assert(err, IsNil)
+	keys := spec.GetSplitKeys()
+	c.Assert(keys, HasLen, 255)
+	c.Assert(keys[0], DeepEquals, []byte(codec.EncodeBytes([]byte{0x01})))
+	c.Assert(keys[254], DeepEquals, []byte(codec.EncodeBytes([]byte{0xff})))
+	spec, err = loadSpec("[split-preset]\nlayout = \"hash\"\nregions = 512")
+	c.Assert(err, IsNil)
+	c.Assert(spec.GetSplitKeys()[:2], DeepEquals, [][]byte{codec.EncodeBytes([]byte{0x00, 0x80}), codec.EncodeBytes([]byte{0x01})})
+
+	// The tables are split from each other and from the rest of the key space, along with the split keys.
+	spec, err = loadSpec("split-keys = [\"61\"]\n[split-preset]\nlayout = \"table\"\ntables = [3, 2, 3]")
+	c.Assert(err, IsNil)
+	c.Assert(spec.GetSplitKeys(), DeepEquals, [][]byte{
+		[]byte("a"),
+		codec.EncodeBytes(codec.GenerateTableKey(2)),
+		codec.EncodeBytes(codec.GenerateTableKey(3)),
+		codec.EncodeBytes(codec.GenerateTableKey(4)),
+	})
+}
+
 // Make sure PD will not panic if it start and stop again and again.
 func (s *testClusterSuite) TestRaftClusterRestart(c *C) {
 	var err error
@@ -946,6 +1058,48 @@ func (s *testClusterInfoSuite) TestStoreHeartbeat(c *C) {
 	}
 }
 
+func (s *testClusterInfoSuite) TestStoreHeartbeatBusy(c *C) {
+	_, opt, err := newTestScheduleConfig()
+	c.Assert(err, IsNil)
+	cluster := createTestRaftCluster(mockid.NewIDAllocator(), opt, core.NewStorage(kv.NewMemoryKV()))
+	store := newTestStores(1)[0]
+	c.Assert(cluster.putStoreLocked(store), IsNil)
+
+	busyStats := &pdpb.StoreStats{StoreId: store.GetID(), IsBusy: true, BusyBackoffMs: 200}
+	c.Assert(cluster.handleStoreHeartbeat(busyStats), IsNil)
+	c.Assert(cluster.GetStore(store.GetID()).IsBusy(), IsTrue)
+
+	// The store stays busy until the backoff ends, even if it's not busy in the next heartbeat.
+	c.Assert(cluster.handleStoreHeartbeat(&pdpb.StoreStats{StoreId: store.GetID()}), IsNil)
+	c.Assert(cluster.GetStore(store.GetID()).IsBusy(), IsTrue)
+	time.Sleep(200 * time.Millisecond)
+	c.Assert(cluster.GetStore(store.GetID()).IsBusy(), IsFalse)
+}
+
+func (s *testClusterInfoSuite) TestRegionFlowHistory(c *C) {
+	_, opt, err := newTestScheduleConfig()
+	c.Assert(err, IsNil)
+	cluster := createTestRaftCluster(mockid.NewIDAllocator(), opt, core.NewStorage(kv.NewMemoryKV()))
+
+	regions := newTestRegions(2, 3)
+	for i, region := range regions {
+		region = region.Clone(core.SetWrittenBytes(uint64(i+1)*100), core.SetReadBytes(10))
+		c.Assert(cluster.processRegionHeartbeat(region), IsNil)
+		c.Assert(cluster.processRegionHeartbeat(region), IsNil)
+	}
+	c.Assert(cluster.RegionFlowHistory(0), HasLen, 2)
+	top := cluster.TopFlowRegions(statistics.WriteFlow, time.Minute, 10)
+	c.Assert(top, HasLen, 2)
+	c.Assert(top[0].RegionID, Equals, uint64(1))
+	c.Assert(top[0].Bytes, Equals, uint64(400))
+
+	// The history of a region replaced by a merge is dropped.
+	merged := regions[0].Clone(core.WithEndKey(regions[1].GetEndKey()), core.WithIncVersion())
+	c.Assert(cluster.processRegionHeartbeat(merged), IsNil)
+	c.Assert(cluster.RegionFlowHistory(1), IsNil)
+	c.Assert(cluster.RegionFlowHistory(0), HasLen, 3)
+}
+
 func (s *testClusterInfoSuite) TestRegionHeartbeat(c *C) {
 	_, opt, err := newTestScheduleConfig()
 	c.Assert(err, IsNil)
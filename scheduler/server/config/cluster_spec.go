@@ -0,0 +1,223 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+
+	"github.com/BurntSushi/toml"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/codec"
+	"github.com/pkg/errors"
+)
+
+// ClusterSpec declares the initial layout of a cluster. It's applied when the cluster is bootstrapped, so a new
+// cluster starts with its replication settings and pre-split ranges in one step instead of a series of API calls.
+type ClusterSpec struct {
+	// MaxReplicas and LocationLabels replace the replication config, unless they are empty.
+	MaxReplicas    uint64   `toml:"max-replicas"`
+	LocationLabels []string `toml:"location-labels"`
+	// The stores expected to join, a store gets the labels declared for its address when it joins.
+	Stores []StoreSpec `toml:"stores"`
+	// The hex of the keys the regions are pre-split at, encoded like region keys.
+	SplitKeys []string `toml:"split-keys"`
+	// SplitPreset pre-splits the regions in a common layout, along with SplitKeys.
+	SplitPreset *SplitPresetSpec `toml:"split-preset"`
+
+	splitKeys [][]byte
+}
+
+// The layouts of SplitPresetSpec.
+const (
+	// SplitLayoutHash splits the key space evenly by the leading bytes of the keys, for raw workloads whose keys are
+	// hashed. The leading bytes are memcomparable encoded like all the region keys.
+	SplitLayoutHash = "hash"
+	// SplitLayoutTable splits at the prefixes of the tables, which are memcomparable encoded like the region keys of
+	// transactional workloads.
+	SplitLayoutTable = "table"
+)
+
+// maxHashRegions is the max number of regions of the hash layout, its keys are 2 bytes at most.
+const maxHashRegions = 1 << 16
+
+// SplitPresetSpec declares the layout of the regions of a new cluster, so that the traffic isn't funneled through one
+// region until the regions split as they grow.
+type SplitPresetSpec struct {
+	Layout string `toml:"layout"`
+	// Regions is the number of regions of the hash layout.
+	Regions uint64 `toml:"regions"`
+	// Tables are the IDs of the tables of the table layout, each gets its own region.
+	Tables []int64 `toml:"tables"`
+}
+
+// StoreSpec declares the labels of the store at an address.
+type StoreSpec struct {
+	Address string            `toml:"address"`
+	Labels  map[string]string `toml:"labels"`
+}
+
+// LoadClusterSpec reads and validates a cluster spec in TOML.
+func LoadClusterSpec(path string) (*ClusterSpec, error) {
+	spec := new(ClusterSpec)
+	if _, err := toml.DecodeFile(path, spec); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid cluster spec %s", path)
+	}
+	return spec, nil
+}
+
+// Validate checks that the stores can hold the replicas and have all the location labels, and that the split keys
+// are ascending.
+func (s *ClusterSpec) Validate() error {
+	for _, label := range s.LocationLabels {
+		if err := ValidateLabelString(label); err != nil {
+			return err
+		}
+	}
+	addrs := make(map[string]struct{}, len(s.Stores))
+	for _, store := range s.Stores {
+		if store.Address == "" {
+			return errors.New("store without address")
+		}
+		if _, ok := addrs[store.Address]; ok {
+			return errors.Errorf("duplicated store address %s", store.Address)
+		}
+		addrs[store.Address] = struct{}{}
+		if err := ValidateLabels(store.labels()); err != nil {
+			return err
+		}
+		for _, key := range s.LocationLabels {
+			if store.Labels[key] == "" {
+				return errors.Errorf("store %s misses location label %s", store.Address, key)
+			}
+		}
+	}
+	if len(s.Stores) > 0 && s.MaxReplicas > uint64(len(s.Stores)) {
+		return errors.Errorf("%d replicas don't fit in %d stores", s.MaxReplicas, len(s.Stores))
+	}
+	s.splitKeys = make([][]byte, 0, len(s.SplitKeys))
+	for _, h := range s.SplitKeys {
+		key, err := hex.DecodeString(h)
+		if err != nil || len(key) == 0 {
+			return errors.Errorf("invalid split key %q", h)
+		}
+		if n := len(s.splitKeys); n > 0 && bytes.Compare(s.splitKeys[n-1], key) >= 0 {
+			return errors.Errorf("split key %s is not greater than the one before", h)
+		}
+		s.splitKeys = append(s.splitKeys, key)
+	}
+	if s.SplitPreset != nil {
+		keys, err := s.SplitPreset.splitKeys()
+		if err != nil {
+			return err
+		}
+		s.splitKeys = mergeSplitKeys(s.splitKeys, keys)
+	}
+	return nil
+}
+
+// splitKeys returns the ascending keys the regions of the layout are split at.
+func (p *SplitPresetSpec) splitKeys() ([][]byte, error) {
+	switch p.Layout {
+	case SplitLayoutHash:
+		if p.Regions < 2 || p.Regions > maxHashRegions {
+			return nil, errors.Errorf("split preset regions must be in [2, %d], not %d", maxHashRegions, p.Regions)
+		}
+		keys := make([][]byte, 0, p.Regions-1)
+		for i := uint64(1); i < p.Regions; i++ {
+			prefix := i * maxHashRegions / p.Regions
+			key := []byte{byte(prefix >> 8), byte(prefix)}
+			if key[1] == 0 {
+				key = key[:1]
+			}
+			keys = append(keys, codec.EncodeBytes(key))
+		}
+		return keys, nil
+	case SplitLayoutTable:
+		if len(p.Tables) == 0 {
+			return nil, errors.New("split preset has no tables")
+		}
+		tables := append([]int64{}, p.Tables...)
+		sort.Slice(tables, func(i, j int) bool { return tables[i] < tables[j] })
+		keys := make([][]byte, 0, len(tables)+1)
+		for i, id := range tables {
+			if id <= 0 {
+				return nil, errors.Errorf("invalid table id %d", id)
+			}
+			if i > 0 && tables[i-1] == id {
+				continue
+			}
+			keys = append(keys, codec.EncodeBytes(codec.GenerateTableKey(id)))
+		}
+		// The last table doesn't span the rest of the key space either.
+		keys = append(keys, codec.EncodeBytes(codec.GenerateTableKey(tables[len(tables)-1]+1)))
+		return keys, nil
+	default:
+		return nil, errors.Errorf("unknown split layout %q", p.Layout)
+	}
+}
+
+// mergeSplitKeys merges two lists of ascending keys into one, without duplicates.
+func mergeSplitKeys(a, b [][]byte) [][]byte {
+	keys := make([][]byte, 0, len(a)+len(b))
+	for len(a) > 0 || len(b) > 0 {
+		switch {
+		case len(b) == 0 || (len(a) > 0 && bytes.Compare(a[0], b[0]) < 0):
+			keys, a = append(keys, a[0]), a[1:]
+		case len(a) == 0 || bytes.Compare(b[0], a[0]) < 0:
+			keys, b = append(keys, b[0]), b[1:]
+		default:
+			keys, a, b = append(keys, a[0]), a[1:], b[1:]
+		}
+	}
+	return keys
+}
+
+// ApplyReplication overrides the replication config with the settings of the spec.
+func (s *ClusterSpec) ApplyReplication(cfg *ReplicationConfig) {
+	if s.MaxReplicas > 0 {
+		cfg.MaxReplicas = s.MaxReplicas
+	}
+	if len(s.LocationLabels) > 0 {
+		cfg.LocationLabels = append([]string{}, s.LocationLabels...)
+	}
+}
+
+// GetSplitKeys returns the decoded split keys of a validated spec.
+func (s *ClusterSpec) GetSplitKeys() [][]byte {
+	return s.splitKeys
+}
+
+// StoreLabels returns the labels declared for the store at the address, nil if it's not in the spec.
+func (s *ClusterSpec) StoreLabels(address string) []*metapb.StoreLabel {
+	for _, store := range s.Stores {
+		if store.Address == address {
+			return store.labels()
+		}
+	}
+	return nil
+}
+
+func (s StoreSpec) labels() []*metapb.StoreLabel {
+	labels := make([]*metapb.StoreLabel, 0, len(s.Labels))
+	for k, v := range s.Labels {
+		labels = append(labels, &metapb.StoreLabel{Key: k, Value: v})
+	}
+	sort.Slice(labels, func(i, j int) bool { return labels[i].Key < labels[j].Key })
+	return labels
+}
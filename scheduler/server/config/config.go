@@ -29,6 +29,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/metricutil"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/typeutil"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/placement"
 	"github.com/pingcap/log"
 	"github.com/pkg/errors"
 	"go.etcd.io/etcd/embed"
@@ -109,6 +110,11 @@ type Config struct {
 
 	LabelProperty LabelPropertyConfig `toml:"label-property" json:"label-property"`
 
+	// BootstrapSpec is the path of the cluster spec applied when the cluster is bootstrapped.
+	BootstrapSpec string `toml:"bootstrap-spec" json:"bootstrap-spec"`
+	// ClusterSpec is loaded from BootstrapSpec.
+	ClusterSpec *ClusterSpec `toml:"-" json:"-"`
+
 	configFile string
 
 	// For all warnings during parsing.
@@ -417,6 +423,14 @@ func (c *Config) Adjust(meta *toml.MetaData) error {
 		return err
 	}
 
+	if c.BootstrapSpec != "" {
+		spec, err := LoadClusterSpec(c.BootstrapSpec)
+		if err != nil {
+			return err
+		}
+		c.ClusterSpec = spec
+	}
+
 	adjustDuration(&c.HeartbeatStreamBindInterval, defaultHeartbeatStreamRebindInterval)
 
 	adjustDuration(&c.LeaderPriorityCheckInterval, defaultLeaderPriorityCheckInterval)
@@ -454,6 +468,8 @@ type ScheduleConfig struct {
 	// it will never be used as a source or target store.
 	MaxSnapshotCount    uint64 `toml:"max-snapshot-count,omitempty" json:"max-snapshot-count"`
 	MaxPendingPeerCount uint64 `toml:"max-pending-peer-count,omitempty" json:"max-pending-peer-count"`
+	// MaxLeaderTransferPerMinute is the max leader transfers in or out of a store started in a minute, 0 means no limit.
+	MaxLeaderTransferPerMinute uint64 `toml:"max-leader-transfer-per-minute,omitempty" json:"max-leader-transfer-per-minute"`
 	// If both the size of region is smaller than MaxMergeRegionSize
 	// and the number of rows in region is smaller than MaxMergeRegionKeys,
 	// it will try to merge with adjacent regions.
@@ -553,6 +569,7 @@ func (c *ScheduleConfig) Clone() *ScheduleConfig {
 	return &ScheduleConfig{
 		MaxSnapshotCount:             c.MaxSnapshotCount,
 		MaxPendingPeerCount:          c.MaxPendingPeerCount,
+		MaxLeaderTransferPerMinute:   c.MaxLeaderTransferPerMinute,
 		MaxMergeRegionSize:           c.MaxMergeRegionSize,
 		MaxMergeRegionKeys:           c.MaxMergeRegionKeys,
 		SplitMergeInterval:           c.SplitMergeInterval,
@@ -591,6 +608,7 @@ const (
 	defaultMaxReplicas            = 3
 	defaultMaxSnapshotCount       = 3
 	defaultMaxPendingPeerCount    = 16
+	defaultMaxLeaderTransfer      = 120
 	defaultMaxMergeRegionSize     = 20
 	defaultMaxMergeRegionKeys     = 200000
 	defaultSplitMergeInterval     = 1 * time.Hour
@@ -619,6 +637,9 @@ func (c *ScheduleConfig) adjust(meta *configMetaData) error {
 	if !meta.IsDefined("max-pending-peer-count") {
 		adjustUint64(&c.MaxPendingPeerCount, defaultMaxPendingPeerCount)
 	}
+	if !meta.IsDefined("max-leader-transfer-per-minute") {
+		adjustUint64(&c.MaxLeaderTransferPerMinute, defaultMaxLeaderTransfer)
+	}
 	if !meta.IsDefined("max-merge-region-size") {
 		adjustUint64(&c.MaxMergeRegionSize, defaultMaxMergeRegionSize)
 	}
@@ -798,6 +819,12 @@ type ReplicationConfig struct {
 
 	// When PlacementRules feature is enabled. MaxReplicas and LocationLabels are not uesd any more.
 	EnablePlacementRules bool // Keep it false before full feature get merged. `toml:"enable-placement-rules" json:"enable-placement-rules,string"`
+
+	// LeaderConstraints select the stores the leaders may be on, e.g. only the stores with nvme disks. The leaders
+	// on the other stores are moved away by the label scheduler.
+	LeaderConstraints []placement.LabelConstraint `toml:"leader-constraints,omitempty" json:"leader-constraints"`
+	// ReplicaConstraints select the stores the replicas may be added to.
+	ReplicaConstraints []placement.LabelConstraint `toml:"replica-constraints,omitempty" json:"replica-constraints"`
 }
 
 func (c *ReplicationConfig) clone() *ReplicationConfig {
@@ -808,9 +835,23 @@ func (c *ReplicationConfig) clone() *ReplicationConfig {
 		LocationLabels:       locationLabels,
 		StrictlyMatchLabel:   c.StrictlyMatchLabel,
 		EnablePlacementRules: c.EnablePlacementRules,
+		LeaderConstraints:    cloneLabelConstraints(c.LeaderConstraints),
+		ReplicaConstraints:   cloneLabelConstraints(c.ReplicaConstraints),
 	}
 }
 
+func cloneLabelConstraints(constraints []placement.LabelConstraint) []placement.LabelConstraint {
+	if constraints == nil {
+		return nil
+	}
+	cloned := make([]placement.LabelConstraint, 0, len(constraints))
+	for _, c := range constraints {
+		c.Values = append([]string(nil), c.Values...)
+		cloned = append(cloned, c)
+	}
+	return cloned
+}
+
 // Validate is used to validate if some replication configurations are right.
 func (c *ReplicationConfig) Validate() error {
 	for _, label := range c.LocationLabels {
@@ -819,6 +860,13 @@ func (c *ReplicationConfig) Validate() error {
 			return err
 		}
 	}
+	for _, constraints := range [][]placement.LabelConstraint{c.LeaderConstraints, c.ReplicaConstraints} {
+		for i := range constraints {
+			if err := constraints[i].Validate(); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -26,6 +26,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/kv"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/placement"
 )
 
 // ScheduleOption is a wrapper to access the configuration safely.
@@ -88,6 +89,16 @@ func (o *ScheduleOption) IsPlacementRulesEnabled() bool {
 	return o.replication.IsPlacementRulesEnabled()
 }
 
+// GetLeaderConstraints returns the constraints of the stores the leaders may be on.
+func (o *ScheduleOption) GetLeaderConstraints() []placement.LabelConstraint {
+	return o.replication.Load().LeaderConstraints
+}
+
+// GetReplicaConstraints returns the constraints of the stores the replicas may be added to.
+func (o *ScheduleOption) GetReplicaConstraints() []placement.LabelConstraint {
+	return o.replication.Load().ReplicaConstraints
+}
+
 // GetMaxSnapshotCount returns the number of the max snapshot which is allowed to send.
 func (o *ScheduleOption) GetMaxSnapshotCount() uint64 {
 	return o.Load().MaxSnapshotCount
@@ -98,6 +109,11 @@ func (o *ScheduleOption) GetMaxPendingPeerCount() uint64 {
 	return o.Load().MaxPendingPeerCount
 }
 
+// GetMaxLeaderTransferPerMinute returns the max leader transfers of a store in a minute.
+func (o *ScheduleOption) GetMaxLeaderTransferPerMinute() uint64 {
+	return o.Load().MaxLeaderTransferPerMinute
+}
+
 // GetMaxMergeRegionSize returns the max region size.
 func (o *ScheduleOption) GetMaxMergeRegionSize() uint64 {
 	return o.Load().MaxMergeRegionSize
@@ -24,17 +24,22 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/kv"
 	"github.com/pkg/errors"
 	"go.etcd.io/etcd/clientv3"
 )
 
 const (
-	clusterPath  = "raft"
-	configPath   = "config"
-	schedulePath = "schedule"
-	gcPath       = "gc"
-	rulesPath    = "rules"
+	clusterPath   = "raft"
+	configPath    = "config"
+	schedulePath  = "schedule"
+	gcPath        = "gc"
+	splitKeysPath = "split_keys"
+	readOnlyPath  = "read_only"
+	rulesPath     = "rules"
+
+	resourceGroupsPath = "resource_groups"
 
 	customScheduleConfigPath = "scheduler_config"
 )
@@ -324,6 +329,78 @@ func (s *Storage) LoadGCSafePoint() (uint64, error) {
 	return safePoint, nil
 }
 
+// SaveSplitKeys saves the keys regions are split at.
+func (s *Storage) SaveSplitKeys(keys [][]byte) error {
+	value, err := json.Marshal(keys)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return s.Save(splitKeysPath, string(value))
+}
+
+// LoadSplitKeys loads the keys regions are split at.
+func (s *Storage) LoadSplitKeys() ([][]byte, error) {
+	value, err := s.Load(splitKeysPath)
+	if err != nil || value == "" {
+		return nil, err
+	}
+	var keys [][]byte
+	if err := json.Unmarshal([]byte(value), &keys); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return keys, nil
+}
+
+// ReadOnly is the read-only mode of the cluster, the stores reject the writes to the whole cluster or to the ranges.
+type ReadOnly struct {
+	Cluster bool             `json:"cluster"`
+	Ranges  []*pdpb.KeyRange `json:"ranges"`
+}
+
+// SaveReadOnly saves the read-only mode.
+func (s *Storage) SaveReadOnly(readOnly *ReadOnly) error {
+	value, err := json.Marshal(readOnly)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return s.Save(readOnlyPath, string(value))
+}
+
+// LoadReadOnly loads the read-only mode.
+func (s *Storage) LoadReadOnly() (*ReadOnly, error) {
+	readOnly := new(ReadOnly)
+	value, err := s.Load(readOnlyPath)
+	if err != nil || value == "" {
+		return readOnly, err
+	}
+	if err := json.Unmarshal([]byte(value), readOnly); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return readOnly, nil
+}
+
+// SaveResourceGroups saves the resource groups.
+func (s *Storage) SaveResourceGroups(groups []*pdpb.ResourceGroup) error {
+	value, err := json.Marshal(groups)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return s.Save(resourceGroupsPath, string(value))
+}
+
+// LoadResourceGroups loads the resource groups.
+func (s *Storage) LoadResourceGroups() ([]*pdpb.ResourceGroup, error) {
+	value, err := s.Load(resourceGroupsPath)
+	if err != nil || value == "" {
+		return nil, err
+	}
+	var groups []*pdpb.ResourceGroup
+	if err := json.Unmarshal([]byte(value), &groups); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return groups, nil
+}
+
 // LoadAllScheduleConfig loads all schedulers' config.
 func (s *Storage) LoadAllScheduleConfig() ([]string, []string, error) {
 	keys, values, err := s.LoadRange(customScheduleConfigPath, clientv3.GetPrefixRangeEnd(customScheduleConfigPath), 1000)
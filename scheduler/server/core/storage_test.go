@@ -18,6 +18,7 @@ import (
 	"math"
 
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/proto/pkg/pdpb"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/kv"
 	. "github.com/pingcap/check"
 	"github.com/pkg/errors"
@@ -175,6 +176,37 @@ func (s *testKVSuite) TestLoadGCSafePoint(c *C) {
 	}
 }
 
+func (s *testKVSuite) TestLoadReadOnly(c *C) {
+	storage := NewStorage(kv.NewMemoryKV())
+
+	readOnly, err := storage.LoadReadOnly()
+	c.Assert(err, IsNil)
+	c.Assert(readOnly, DeepEquals, &ReadOnly{})
+
+	readOnly = &ReadOnly{
+		Cluster: true,
+		Ranges:  []*pdpb.KeyRange{{StartKey: []byte("a"), EndKey: []byte("b")}, {StartKey: []byte("c")}},
+	}
+	c.Assert(storage.SaveReadOnly(readOnly), IsNil)
+	readOnly1, err := storage.LoadReadOnly()
+	c.Assert(err, IsNil)
+	c.Assert(readOnly1, DeepEquals, readOnly)
+}
+
+func (s *testKVSuite) TestLoadResourceGroups(c *C) {
+	storage := NewStorage(kv.NewMemoryKV())
+
+	groups, err := storage.LoadResourceGroups()
+	c.Assert(err, IsNil)
+	c.Assert(groups, HasLen, 0)
+
+	groups = []*pdpb.ResourceGroup{{Name: "a", Weight: 1}, {Name: "b", Weight: 4}}
+	c.Assert(storage.SaveResourceGroups(groups), IsNil)
+	groups1, err := storage.LoadResourceGroups()
+	c.Assert(err, IsNil)
+	c.Assert(groups1, DeepEquals, groups)
+}
+
 type KVWithMaxRangeLimit struct {
 	kv.Base
 	rangeLimit int
@@ -39,6 +39,7 @@ type StoreInfo struct {
 	regionSize       int64
 	pendingPeerCount int
 	lastHeartbeatTS  time.Time
+	busyUntil        time.Time
 	leaderWeight     float64
 	regionWeight     float64
 	available        func() bool
@@ -71,6 +72,7 @@ func (s *StoreInfo) Clone(opts ...StoreCreateOption) *StoreInfo {
 		regionSize:       s.regionSize,
 		pendingPeerCount: s.pendingPeerCount,
 		lastHeartbeatTS:  s.lastHeartbeatTS,
+		busyUntil:        s.busyUntil,
 		leaderWeight:     s.leaderWeight,
 		regionWeight:     s.regionWeight,
 		available:        s.available,
@@ -185,9 +187,9 @@ func (s *StoreInfo) GetKeysRead() uint64 {
 	return s.stats.GetKeysRead()
 }
 
-// IsBusy returns if the store is busy.
+// IsBusy returns if the store is busy, a store stays busy until the backoff it suggests ends.
 func (s *StoreInfo) IsBusy() bool {
-	return s.stats.GetIsBusy()
+	return s.stats.GetIsBusy() || time.Now().Before(s.busyUntil)
 }
 
 // GetSendingSnapCount returns the current sending snapshot count of the store.
@@ -133,6 +133,13 @@ func SetLastHeartbeatTS(lastHeartbeatTS time.Time) StoreCreateOption {
 	}
 }
 
+// SetBusyUntil sets the time until which the store is regarded as busy.
+func SetBusyUntil(busyUntil time.Time) StoreCreateOption {
+	return func(store *StoreInfo) {
+		store.busyUntil = busyUntil
+	}
+}
+
 // SetStoreStats sets the statistics information for the store.
 func SetStoreStats(stats *pdpb.StoreStats) StoreCreateOption {
 	return func(store *StoreInfo) {
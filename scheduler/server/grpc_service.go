@@ -282,9 +282,14 @@ func (s *Server) StoreHeartbeat(ctx context.Context, request *pdpb.StoreHeartbea
 	if err != nil {
 		return nil, status.Errorf(codes.Unknown, err.Error())
 	}
+	cluster.handleOperatorResults(request.GetOperatorResults())
 
+	readOnly := cluster.GetReadOnly()
 	return &pdpb.StoreHeartbeatResponse{
-		Header: s.header(),
+		Header:          s.header(),
+		ClusterReadOnly: readOnly.Cluster,
+		ReadOnlyRanges:  readOnly.Ranges,
+		ResourceGroups:  cluster.GetResourceGroups(),
 	}, nil
 }
 
@@ -474,6 +479,36 @@ func (s *Server) ScanRegions(ctx context.Context, request *pdpb.ScanRegionsReque
 	return resp, nil
 }
 
+// BatchGetRegions implements gRPC PDServer.
+func (s *Server) BatchGetRegions(ctx context.Context, request *pdpb.BatchGetRegionsRequest) (*pdpb.BatchGetRegionsResponse, error) {
+	if err := s.validateRequest(request.GetHeader()); err != nil {
+		return nil, err
+	}
+
+	cluster := s.GetRaftCluster()
+	if cluster == nil {
+		return &pdpb.BatchGetRegionsResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+	resp := &pdpb.BatchGetRegionsResponse{Header: s.header()}
+	seen := make(map[uint64]struct{})
+	for _, key := range request.GetRegionKeys() {
+		region, leader := cluster.GetRegionByKey(key)
+		if region == nil {
+			continue
+		}
+		if _, ok := seen[region.GetId()]; ok {
+			continue
+		}
+		seen[region.GetId()] = struct{}{}
+		if leader == nil {
+			leader = &metapb.Peer{}
+		}
+		resp.Regions = append(resp.Regions, region)
+		resp.Leaders = append(resp.Leaders, leader)
+	}
+	return resp, nil
+}
+
 // AskSplit implements gRPC PDServer.
 func (s *Server) AskSplit(ctx context.Context, request *pdpb.AskSplitRequest) (*pdpb.AskSplitResponse, error) {
 	if err := s.validateRequest(request.GetHeader()); err != nil {
@@ -650,6 +685,57 @@ func (s *Server) ScatterRegion(ctx context.Context, request *pdpb.ScatterRegionR
 	}, nil
 }
 
+// SetSplitKeys implements gRPC PDServer.
+func (s *Server) SetSplitKeys(ctx context.Context, request *pdpb.SetSplitKeysRequest) (*pdpb.SetSplitKeysResponse, error) {
+	if err := s.validateRequest(request.GetHeader()); err != nil {
+		return nil, err
+	}
+
+	cluster := s.GetRaftCluster()
+	if cluster == nil {
+		return &pdpb.SetSplitKeysResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+	if err := cluster.SetSplitKeys(request.GetKeys()); err != nil {
+		return nil, err
+	}
+	return &pdpb.SetSplitKeysResponse{Header: s.header()}, nil
+}
+
+// SetReadOnly implements gRPC PDServer.
+func (s *Server) SetReadOnly(ctx context.Context, request *pdpb.SetReadOnlyRequest) (*pdpb.SetReadOnlyResponse, error) {
+	if err := s.validateRequest(request.GetHeader()); err != nil {
+		return nil, err
+	}
+
+	cluster := s.GetRaftCluster()
+	if cluster == nil {
+		return &pdpb.SetReadOnlyResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+	if err := cluster.SetReadOnly(request.GetCluster(), request.GetRanges()); err != nil {
+		return nil, err
+	}
+	return &pdpb.SetReadOnlyResponse{Header: s.header()}, nil
+}
+
+// SetResourceGroups implements gRPC PDServer.
+func (s *Server) SetResourceGroups(ctx context.Context, request *pdpb.SetResourceGroupsRequest) (*pdpb.SetResourceGroupsResponse, error) {
+	if err := s.validateRequest(request.GetHeader()); err != nil {
+		return nil, err
+	}
+
+	cluster := s.GetRaftCluster()
+	if cluster == nil {
+		return &pdpb.SetResourceGroupsResponse{Header: s.notBootstrappedHeader()}, nil
+	}
+	if err := cluster.SetResourceGroups(request.GetGroups()); err != nil {
+		return &pdpb.SetResourceGroupsResponse{Header: s.errorHeader(&pdpb.Error{
+			Type:    pdpb.ErrorType_UNKNOWN,
+			Message: err.Error(),
+		})}, nil
+	}
+	return &pdpb.SetResourceGroupsResponse{Header: s.header()}, nil
+}
+
 // GetGCSafePoint implements gRPC PDServer.
 func (s *Server) GetGCSafePoint(ctx context.Context, request *pdpb.GetGCSafePointRequest) (*pdpb.GetGCSafePointResponse, error) {
 	if err := s.validateRequest(request.GetHeader()); err != nil {
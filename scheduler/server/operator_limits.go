@@ -0,0 +1,83 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule"
+)
+
+const operatorLimitsURL = "/pd/operator_limits"
+
+// operatorLimitsStatus is the JSON served by a GET of operatorLimitsURL.
+type operatorLimitsStatus struct {
+	schedule.OperatorLimits
+	// When the raised limits fall back to the configured ones, absent if they aren't raised.
+	RaisedUntil *time.Time `json:"raised-until,omitempty"`
+}
+
+// raiseOperatorLimitsRequest is the JSON posted to operatorLimitsURL.
+type raiseOperatorLimitsRequest struct {
+	schedule.OperatorLimits
+	// How long the limits are raised for, like "30m", "0s" restores the configured limits.
+	TTL string `json:"ttl"`
+}
+
+// newOperatorLimitsHandlers returns the handler of the operator limits on each store:
+//
+//	GET /pd/operator_limits returns the limits in effect.
+//	POST /pd/operator_limits {"max-snapshot-count": 8, "ttl": "30m"} raises the limits for a while, like during a
+//	maintenance.
+//
+// The limits are only enforced by the leader, the followers answer 503.
+func newOperatorLimitsHandlers(s *Server) map[string]http.Handler {
+	return map[string]http.Handler{
+		operatorLimitsURL: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			cluster := s.GetRaftCluster()
+			if cluster == nil {
+				http.Error(w, "cluster is not running", http.StatusServiceUnavailable)
+				return
+			}
+			oc := cluster.GetOperatorController()
+			switch r.Method {
+			case http.MethodGet:
+			case http.MethodPost:
+				var req raiseOperatorLimitsRequest
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					http.Error(w, fmt.Sprintf("invalid request: %v", err), http.StatusBadRequest)
+					return
+				}
+				ttl, err := time.ParseDuration(req.TTL)
+				if err != nil {
+					http.Error(w, fmt.Sprintf("invalid ttl %q", req.TTL), http.StatusBadRequest)
+					return
+				}
+				oc.RaiseOperatorLimits(req.OperatorLimits, ttl)
+			default:
+				http.Error(w, fmt.Sprintf("method %s not allowed", r.Method), http.StatusMethodNotAllowed)
+				return
+			}
+			limits, until := oc.GetOperatorLimits()
+			status := operatorLimitsStatus{OperatorLimits: limits}
+			if !until.IsZero() {
+				status.RaisedUntil = &until
+			}
+			writeJSON(w, status)
+		}),
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/server/statistics"
+)
+
+const (
+	regionFlowTopURL     = "/pd/region_flow/top"
+	regionFlowHistoryURL = "/pd/region_flow/history"
+
+	defaultRegionFlowWindow = 5 * time.Minute
+	defaultRegionFlowLimit  = 10
+)
+
+// newRegionFlowHandlers returns the handlers querying the flow history recorded from the region heartbeats:
+//
+//	/pd/region_flow/top?kind=write&window=5m&limit=10 returns the hottest regions of the window.
+//	/pd/region_flow/history?region_id=2 returns the flow reported by the latest heartbeats of a region.
+//
+// The history is only recorded by the leader, the followers answer 503.
+func newRegionFlowHandlers(s *Server) map[string]http.Handler {
+	return map[string]http.Handler{
+		regionFlowTopURL: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			cluster := s.GetRaftCluster()
+			if cluster == nil {
+				http.Error(w, "cluster is not running", http.StatusServiceUnavailable)
+				return
+			}
+			kind, window, limit, err := parseRegionFlowTopQuery(r)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			flows := cluster.TopFlowRegions(kind, window, limit)
+			if flows == nil {
+				flows = []statistics.RegionFlow{}
+			}
+			writeJSON(w, flows)
+		}),
+		regionFlowHistoryURL: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			cluster := s.GetRaftCluster()
+			if cluster == nil {
+				http.Error(w, "cluster is not running", http.StatusServiceUnavailable)
+				return
+			}
+			regionID, err := strconv.ParseUint(r.URL.Query().Get("region_id"), 10, 64)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("invalid region_id: %v", err), http.StatusBadRequest)
+				return
+			}
+			history := cluster.RegionFlowHistory(regionID)
+			if history == nil {
+				http.Error(w, fmt.Sprintf("region %d has no flow history", regionID), http.StatusNotFound)
+				return
+			}
+			writeJSON(w, history)
+		}),
+	}
+}
+
+func parseRegionFlowTopQuery(r *http.Request) (kind statistics.FlowKind, window time.Duration, limit int, err error) {
+	query := r.URL.Query()
+	switch query.Get("kind") {
+	case "", statistics.WriteFlow.String():
+		kind = statistics.WriteFlow
+	case statistics.ReadFlow.String():
+		kind = statistics.ReadFlow
+	default:
+		return 0, 0, 0, fmt.Errorf("invalid kind %q, it's read or write", query.Get("kind"))
+	}
+	window, limit = defaultRegionFlowWindow, defaultRegionFlowLimit
+	if v := query.Get("window"); v != "" {
+		if window, err = time.ParseDuration(v); err != nil || window <= 0 {
+			return 0, 0, 0, fmt.Errorf("invalid window %q", v)
+		}
+	}
+	if v := query.Get("limit"); v != "" {
+		if limit, err = strconv.Atoi(v); err != nil || limit <= 0 {
+			return 0, 0, 0, fmt.Errorf("invalid limit %q", v)
+		}
+	}
+	return kind, window, limit, nil
+}
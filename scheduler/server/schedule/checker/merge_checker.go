@@ -0,0 +1,111 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/opt"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// MergeChecker merges small regions into an adjacent region. The peers of the source are moved to the stores of the
+// target first, TiKV merges only regions whose peers are on the same stores.
+type MergeChecker struct {
+	cluster      opt.Cluster
+	splitChecker *SplitChecker
+	startTime    time.Time // the regions reported right after the scheduler starts may be stale
+}
+
+// NewMergeChecker creates a merge checker. The regions are not merged across the keys of the split checker.
+func NewMergeChecker(cluster opt.Cluster, splitChecker *SplitChecker) *MergeChecker {
+	return &MergeChecker{
+		cluster:      cluster,
+		splitChecker: splitChecker,
+		startTime:    time.Now(),
+	}
+}
+
+// Check creates the operators of the source and the target to merge the region into one of its neighbors.
+func (m *MergeChecker) Check(region *core.RegionInfo) []*operator.Operator {
+	checkerCounter.WithLabelValues("merge_checker", "check").Inc()
+	if m.cluster.GetMaxMergeRegionSize() == 0 {
+		checkerCounter.WithLabelValues("merge_checker", "disabled").Inc()
+		return nil
+	}
+	if time.Since(m.startTime) < m.cluster.GetSplitMergeInterval() {
+		checkerCounter.WithLabelValues("merge_checker", "recently-started").Inc()
+		return nil
+	}
+	if !m.isSmall(region) {
+		checkerCounter.WithLabelValues("merge_checker", "no-need").Inc()
+		return nil
+	}
+	if !m.isHealthy(region) {
+		checkerCounter.WithLabelValues("merge_checker", "abnormal-replica").Inc()
+		return nil
+	}
+
+	prev, next := m.cluster.GetAdjacentRegions(region)
+	var target *core.RegionInfo
+	if m.allowMerge(region, next) {
+		target = next
+	}
+	if !m.cluster.IsOneWayMergeEnabled() && m.allowMerge(prev, region) &&
+		(target == nil || prev.GetApproximateSize() < target.GetApproximateSize()) {
+		target = prev
+	}
+	if target == nil {
+		checkerCounter.WithLabelValues("merge_checker", "no-target").Inc()
+		return nil
+	}
+
+	log.Debug("try to merge region", zap.Stringer("from", core.RegionToHexMeta(region.GetMeta())),
+		zap.Stringer("to", core.RegionToHexMeta(target.GetMeta())))
+	ops, err := operator.CreateMergeRegionOperator("merge-region", m.cluster, region, target, operator.OpMerge)
+	if err != nil {
+		log.Warn("create merge region operator failed", zap.Error(err))
+		return nil
+	}
+	checkerCounter.WithLabelValues("merge_checker", "new-operator").Inc()
+	return ops
+}
+
+// isSmall returns whether the region is small enough to be merged.
+func (m *MergeChecker) isSmall(region *core.RegionInfo) bool {
+	return region.GetApproximateSize() <= int64(m.cluster.GetMaxMergeRegionSize()) &&
+		region.GetApproximateKeys() <= int64(m.cluster.GetMaxMergeRegionKeys())
+}
+
+// isHealthy returns whether the region has a leader and all of its replicas are up.
+func (m *MergeChecker) isHealthy(region *core.RegionInfo) bool {
+	return region.GetLeader() != nil && len(region.GetDownPeers()) == 0 && len(region.GetPendingPeers()) == 0 &&
+		len(region.GetPeers()) == m.cluster.GetMaxReplicas()
+}
+
+// allowMerge returns whether the left region may be merged with the right one next to it, the one that is not checked
+// is checked here too.
+func (m *MergeChecker) allowMerge(left, right *core.RegionInfo) bool {
+	if left == nil || right == nil || !m.isHealthy(left) || !m.isHealthy(right) {
+		return false
+	}
+	if m.cluster.IsRegionHot(left) || m.cluster.IsRegionHot(right) {
+		return false
+	}
+	// The boundary of the regions is a split key, the merged region would be split again.
+	return len(m.splitChecker.splitKeys(left.GetStartKey(), right.GetEndKey())) == 0
+}
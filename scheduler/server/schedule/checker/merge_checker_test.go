@@ -0,0 +1,106 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockcluster"
+	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockoption"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
+	. "github.com/pingcap/check"
+)
+
+var _ = Suite(&testMergeCheckerSuite{})
+
+type testMergeCheckerSuite struct {
+	cluster *mockcluster.Cluster
+	mc      *MergeChecker
+	sc      *SplitChecker
+	regions []*core.RegionInfo
+}
+
+func (s *testMergeCheckerSuite) SetUpTest(c *C) {
+	opt := mockoption.NewScheduleOptions()
+	opt.MaxMergeRegionSize = 20
+	opt.MaxMergeRegionKeys = 200000
+	s.cluster = mockcluster.NewCluster(opt)
+	for storeID := uint64(1); storeID <= 3; storeID++ {
+		s.cluster.AddRegionStore(storeID, 3)
+	}
+	s.regions = []*core.RegionInfo{
+		s.newRegion(1, "", "b", 5),
+		s.newRegion(2, "b", "c", 15),
+		s.newRegion(3, "c", "", 30),
+	}
+	for _, region := range s.regions {
+		s.cluster.PutRegion(region)
+	}
+	s.sc = NewSplitChecker()
+	s.mc = NewMergeChecker(s.cluster, s.sc)
+}
+
+func (s *testMergeCheckerSuite) newRegion(id uint64, startKey, endKey string, size int64) *core.RegionInfo {
+	var peers []*metapb.Peer
+	for storeID := uint64(1); storeID <= 3; storeID++ {
+		peers = append(peers, &metapb.Peer{Id: id*10 + storeID, StoreId: storeID})
+	}
+	return core.NewRegionInfo(&metapb.Region{
+		Id:          id,
+		StartKey:    []byte(startKey),
+		EndKey:      []byte(endKey),
+		Peers:       peers,
+		RegionEpoch: &metapb.RegionEpoch{ConfVer: 1, Version: 1},
+	}, peers[0], core.SetApproximateSize(size), core.SetApproximateKeys(size))
+}
+
+func (s *testMergeCheckerSuite) checkMerge(c *C, ops []*operator.Operator, source, target uint64) {
+	c.Assert(ops, HasLen, 2)
+	c.Assert(ops[0].RegionID(), Equals, source)
+	c.Assert(ops[0].Kind()&operator.OpMerge, Not(Equals), operator.OpKind(0))
+	merge := ops[0].Step(ops[0].Len() - 1).(operator.MergeRegion)
+	c.Assert(merge.FromRegion.GetId(), Equals, source)
+	c.Assert(merge.ToRegion.GetId(), Equals, target)
+	c.Assert(merge.IsPassive, IsFalse)
+	c.Assert(ops[1].RegionID(), Equals, target)
+	c.Assert(ops[1].Step(0).(operator.MergeRegion).IsPassive, IsTrue)
+}
+
+func (s *testMergeCheckerSuite) TestMergeChecker(c *C) {
+	// The large region is not merged, the small ones are merged into their smaller neighbor.
+	c.Assert(s.mc.Check(s.regions[2]), IsNil)
+	s.checkMerge(c, s.mc.Check(s.regions[0]), 1, 2)
+	s.checkMerge(c, s.mc.Check(s.regions[1]), 2, 1)
+
+	// A region is only merged into the next one.
+	s.cluster.EnableOneWayMerge = true
+	s.checkMerge(c, s.mc.Check(s.regions[1]), 2, 3)
+	s.cluster.EnableOneWayMerge = false
+
+	// The regions are not merged across split keys.
+	s.sc.SetSplitKeys([][]byte{[]byte("b")})
+	c.Assert(s.mc.Check(s.regions[0]), IsNil)
+	s.checkMerge(c, s.mc.Check(s.regions[1]), 2, 3)
+
+	s.cluster.MaxMergeRegionSize = 0
+	c.Assert(s.mc.Check(s.regions[1]), IsNil)
+}
+
+func (s *testMergeCheckerSuite) TestUnhealthyRegion(c *C) {
+	pending := s.regions[1].Clone(core.WithPendingPeers(s.regions[1].GetPeers()[1:2]))
+	s.cluster.PutRegion(pending)
+	c.Assert(s.mc.Check(pending), IsNil)
+	// Nor is a small region merged into an unhealthy one.
+	c.Assert(s.mc.Check(s.regions[0]), IsNil)
+}
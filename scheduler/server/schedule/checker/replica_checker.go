@@ -86,7 +86,9 @@ func (r *ReplicaChecker) Check(region *core.RegionInfo) *operator.Operator {
 			return nil
 		}
 		checkerCounter.WithLabelValues("replica_checker", "new-operator").Inc()
-		return operator.CreateAddPeerOperator("make-up-replica", region, newPeer.GetId(), newPeer.GetStoreId(), operator.OpReplica)
+		op := operator.CreateAddPeerOperator("make-up-replica", region, newPeer.GetId(), newPeer.GetStoreId(), operator.OpReplica)
+		op.SetPriorityLevel(core.HighPriority)
+		return op
 	}
 
 	// when add learner peer, the number of peer will exceed max replicas for a while,
@@ -137,6 +139,7 @@ func (r *ReplicaChecker) selectBestStoreToAddReplica(region *core.RegionInfo, fi
 	newFilters := []filter.Filter{
 		filter.NewStateFilter(r.name),
 		filter.NewExcludedFilter(r.name, nil, region.GetStoreIds()),
+		filter.NewReplicaConstraintFilter(r.name),
 	}
 	filters = append(filters, r.filters...)
 	filters = append(filters, newFilters...)
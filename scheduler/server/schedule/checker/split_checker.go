@@ -0,0 +1,86 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
+)
+
+// maxSplitKeys is the max number of keys a region is split at by one operator. The rest are split at when the
+// remaining region is checked again.
+const maxSplitKeys = 64
+
+// SplitChecker splits regions at the keys supplied by an upper layer, e.g. the prefix of every table, so that no
+// region spans two tables and per-table operations like dropping or backing up a table only touch its own regions.
+type SplitChecker struct {
+	sync.RWMutex
+	keys [][]byte // sorted, without duplicates
+}
+
+// NewSplitChecker creates a split checker without any split keys.
+func NewSplitChecker() *SplitChecker {
+	return &SplitChecker{}
+}
+
+// SetSplitKeys replaces the keys regions are split at.
+func (s *SplitChecker) SetSplitKeys(keys [][]byte) {
+	sorted := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		if len(key) != 0 {
+			sorted = append(sorted, key)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i], sorted[j]) < 0 })
+	n := 0
+	for i := range sorted {
+		if n == 0 || !bytes.Equal(sorted[i], sorted[n-1]) {
+			sorted[n] = sorted[i]
+			n++
+		}
+	}
+	s.Lock()
+	defer s.Unlock()
+	s.keys = sorted[:n]
+}
+
+// Check creates an operator to split the region if any split key falls inside it.
+func (s *SplitChecker) Check(region *core.RegionInfo) *operator.Operator {
+	checkerCounter.WithLabelValues("split_checker", "check").Inc()
+	keys := s.splitKeys(region.GetStartKey(), region.GetEndKey())
+	if len(keys) == 0 {
+		return nil
+	}
+	checkerCounter.WithLabelValues("split_checker", "new-operator").Inc()
+	return operator.CreateSplitRegionOperator("split-region", region, 0, keys)
+}
+
+// splitKeys returns the split keys inside (startKey, endKey), an empty endKey means no upper bound.
+func (s *SplitChecker) splitKeys(startKey, endKey []byte) [][]byte {
+	s.RLock()
+	defer s.RUnlock()
+	i := sort.Search(len(s.keys), func(i int) bool { return bytes.Compare(s.keys[i], startKey) > 0 })
+	var keys [][]byte
+	for ; i < len(s.keys) && len(keys) < maxSplitKeys; i++ {
+		if len(endKey) != 0 && bytes.Compare(s.keys[i], endKey) >= 0 {
+			break
+		}
+		keys = append(keys, s.keys[i])
+	}
+	return keys
+}
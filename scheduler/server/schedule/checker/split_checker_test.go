@@ -0,0 +1,68 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"testing"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
+	. "github.com/pingcap/check"
+)
+
+func Test(t *testing.T) {
+	TestingT(t)
+}
+
+var _ = Suite(&testSplitCheckerSuite{})
+
+type testSplitCheckerSuite struct{}
+
+func (s *testSplitCheckerSuite) newRegion(startKey, endKey string) *core.RegionInfo {
+	peer := &metapb.Peer{Id: 2, StoreId: 1}
+	return core.NewRegionInfo(&metapb.Region{
+		Id:          1,
+		StartKey:    []byte(startKey),
+		EndKey:      []byte(endKey),
+		Peers:       []*metapb.Peer{peer},
+		RegionEpoch: &metapb.RegionEpoch{ConfVer: 1, Version: 1},
+	}, peer)
+}
+
+func (s *testSplitCheckerSuite) TestSplitChecker(c *C) {
+	sc := NewSplitChecker()
+	c.Assert(sc.Check(s.newRegion("", "")), IsNil)
+
+	sc.SetSplitKeys([][]byte{[]byte("t3"), []byte("t1"), []byte("t2"), []byte("t1"), nil})
+
+	// The keys on the boundaries of a region don't split it.
+	c.Assert(sc.Check(s.newRegion("t1", "t2")), IsNil)
+	c.Assert(sc.Check(s.newRegion("t3", "")), IsNil)
+
+	op := sc.Check(s.newRegion("", "t3"))
+	c.Assert(op, NotNil)
+	c.Assert(op.Kind()&operator.OpSplit, Not(Equals), operator.OpKind(0))
+	c.Assert(op.Len(), Equals, 1)
+	split := op.Step(0).(operator.SplitRegion)
+	c.Assert(split.SplitKeys, DeepEquals, [][]byte{[]byte("t1"), []byte("t2")})
+
+	// The operator finishes once the region is split.
+	c.Assert(split.IsFinish(s.newRegion("", "t3")), IsFalse)
+	c.Assert(split.IsFinish(s.newRegion("", "t1")), IsTrue)
+
+	op = sc.Check(s.newRegion("t2", ""))
+	c.Assert(op, NotNil)
+	c.Assert(op.Step(0).(operator.SplitRegion).SplitKeys, DeepEquals, [][]byte{[]byte("t3")})
+}
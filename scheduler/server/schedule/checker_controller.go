@@ -27,15 +27,20 @@ type CheckerController struct {
 	cluster        opt.Cluster
 	opController   *OperatorController
 	replicaChecker *checker.ReplicaChecker
+	splitChecker   *checker.SplitChecker
+	mergeChecker   *checker.MergeChecker
 }
 
 // NewCheckerController create a new CheckerController.
 // TODO: isSupportMerge should be removed.
 func NewCheckerController(ctx context.Context, cluster opt.Cluster, opController *OperatorController) *CheckerController {
+	splitChecker := checker.NewSplitChecker()
 	return &CheckerController{
 		cluster:        cluster,
 		opController:   opController,
 		replicaChecker: checker.NewReplicaChecker(cluster),
+		splitChecker:   splitChecker,
+		mergeChecker:   checker.NewMergeChecker(cluster, splitChecker),
 	}
 }
 
@@ -51,5 +56,23 @@ func (c *CheckerController) CheckRegion(region *core.RegionInfo) (bool, []*opera
 			return checkerIsBusy, []*operator.Operator{op}
 		}
 	}
+	if opController.OperatorCount(operator.OpSplit) < c.cluster.GetRegionScheduleLimit() {
+		checkerIsBusy = false
+		if op := c.splitChecker.Check(region); op != nil {
+			return checkerIsBusy, []*operator.Operator{op}
+		}
+	}
+	if opController.OperatorCount(operator.OpMerge) < c.cluster.GetMergeScheduleLimit() {
+		checkerIsBusy = false
+		if ops := c.mergeChecker.Check(region); ops != nil {
+			// The operators of the source and the target are added together.
+			return checkerIsBusy, ops
+		}
+	}
 	return checkerIsBusy, nil
 }
+
+// SetSplitKeys replaces the keys the split checker splits regions at.
+func (c *CheckerController) SetSplitKeys(keys [][]byte) {
+	c.splitChecker.SetSplitKeys(keys)
+}
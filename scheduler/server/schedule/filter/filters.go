@@ -20,6 +20,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/slice"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/opt"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/placement"
 )
 
 //revive:disable:unused-parameter
@@ -304,6 +305,58 @@ func (f *storageThresholdFilter) Target(opt opt.Options, store *core.StoreInfo)
 	return store.IsLowSpace(opt.GetLowSpaceRatio())
 }
 
+type replicaConstraintFilter struct{ scope string }
+
+// NewReplicaConstraintFilter creates a Filter that filters all stores that
+// don't match the replica constraints.
+func NewReplicaConstraintFilter(scope string) Filter {
+	return &replicaConstraintFilter{scope: scope}
+}
+
+func (f *replicaConstraintFilter) Scope() string {
+	return f.scope
+}
+
+func (f *replicaConstraintFilter) Type() string {
+	return "replica-constraint-filter"
+}
+
+func (f *replicaConstraintFilter) Source(opt opt.Options, store *core.StoreInfo) bool {
+	return false
+}
+
+func (f *replicaConstraintFilter) Target(opt opt.Options, store *core.StoreInfo) bool {
+	return !placement.MatchOptionalConstraints(store, opt.GetReplicaConstraints())
+}
+
+// diskClassFilter keeps a moved replica in the capacity tier of its source.
+type diskClassFilter struct {
+	scope  string
+	source *core.StoreInfo
+}
+
+// NewDiskClassFilter creates a Filter that filters all stores whose disk class
+// differs from the source store.
+func NewDiskClassFilter(scope string, source *core.StoreInfo) Filter {
+	return &diskClassFilter{scope: scope, source: source}
+}
+
+func (f *diskClassFilter) Scope() string {
+	return f.scope
+}
+
+func (f *diskClassFilter) Type() string {
+	return "disk-class-filter"
+}
+
+func (f *diskClassFilter) Source(opt opt.Options, store *core.StoreInfo) bool {
+	return false
+}
+
+func (f *diskClassFilter) Target(opt opt.Options, store *core.StoreInfo) bool {
+	return !placement.SameDiskClass(f.source, store)
+}
+
 // distinctScoreFilter ensures that distinct score will not decrease.
 type distinctScoreFilter struct {
 	scope     string
@@ -396,7 +449,8 @@ func (f StoreStateFilter) Target(opts opt.Options, store *core.StoreInfo) bool {
 		(store.IsDisconnected() ||
 			store.IsBlocked() ||
 			store.IsBusy() ||
-			opts.CheckLabelProperty(opt.RejectLeader, store.GetLabels())) {
+			opts.CheckLabelProperty(opt.RejectLeader, store.GetLabels()) ||
+			!placement.MatchOptionalConstraints(store, opts.GetLeaderConstraints())) {
 		return true
 	}
 
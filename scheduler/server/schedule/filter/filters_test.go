@@ -14,11 +14,13 @@ package filter
 
 import (
 	"testing"
+	"time"
 
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockcluster"
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/mock/mockoption"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/placement"
 	. "github.com/pingcap/check"
 )
 
@@ -44,3 +46,39 @@ func (s *testFiltersSuite) TestPendingPeerFilter(c *C) {
 	c.Assert(filter.Source(tc, newStore), IsFalse)
 	c.Assert(filter.Target(tc, newStore), IsFalse)
 }
+
+func (s *testFiltersSuite) TestConstraintFilters(c *C) {
+	opt := mockoption.NewScheduleOptions()
+	tc := mockcluster.NewCluster(opt)
+	heartbeat := core.SetLastHeartbeatTS(time.Now())
+	nvme := core.NewStoreInfoWithLabel(1, 0, map[string]string{placement.DiskClassLabel: placement.DiskClassNVMe}).Clone(heartbeat)
+	hdd := core.NewStoreInfoWithLabel(2, 0, map[string]string{placement.DiskClassLabel: placement.DiskClassHDD}).Clone(heartbeat)
+	unknown := core.NewStoreInfoWithLabel(3, 0, nil).Clone(heartbeat)
+
+	// Leaders only on nvme stores.
+	leaderFilter := StoreStateFilter{TransferLeader: true}
+	c.Assert(leaderFilter.Target(tc, hdd), IsFalse)
+	opt.LeaderConstraints = []placement.LabelConstraint{
+		{Key: placement.DiskClassLabel, Op: placement.In, Values: []string{placement.DiskClassNVMe}},
+	}
+	c.Assert(leaderFilter.Target(tc, nvme), IsFalse)
+	c.Assert(leaderFilter.Target(tc, hdd), IsTrue)
+	c.Assert(leaderFilter.Target(tc, unknown), IsTrue)
+
+	// No replicas on hdd stores.
+	replicaFilter := NewReplicaConstraintFilter("")
+	c.Assert(replicaFilter.Target(tc, hdd), IsFalse)
+	opt.ReplicaConstraints = []placement.LabelConstraint{
+		{Key: placement.DiskClassLabel, Op: placement.NotIn, Values: []string{placement.DiskClassHDD}},
+	}
+	c.Assert(replicaFilter.Source(tc, hdd), IsFalse)
+	c.Assert(replicaFilter.Target(tc, nvme), IsFalse)
+	c.Assert(replicaFilter.Target(tc, hdd), IsTrue)
+	c.Assert(replicaFilter.Target(tc, unknown), IsFalse)
+
+	// Replicas stay in the capacity tier of the source store.
+	diskClassFilter := NewDiskClassFilter("", nvme)
+	c.Assert(diskClassFilter.Target(tc, nvme), IsFalse)
+	c.Assert(diskClassFilter.Target(tc, hdd), IsTrue)
+	c.Assert(diskClassFilter.Target(tc, unknown), IsFalse)
+}
@@ -271,6 +271,31 @@ func (mr MergeRegion) Influence(opInfluence OpInfluence, region *core.RegionInfo
 	}
 }
 
+// SplitRegion is an OpStep that splits a region at the given keys.
+type SplitRegion struct {
+	StartKey, EndKey []byte
+	SplitKeys        [][]byte
+}
+
+// ConfVerChanged returns true if the conf version has been changed by this step
+func (sr SplitRegion) ConfVerChanged(region *core.RegionInfo) bool {
+	return false
+}
+
+func (sr SplitRegion) String() string {
+	return fmt.Sprintf("split region with %d keys", len(sr.SplitKeys))
+}
+
+// IsFinish checks if current step is finished.
+func (sr SplitRegion) IsFinish(region *core.RegionInfo) bool {
+	return !bytes.Equal(region.GetStartKey(), sr.StartKey) || !bytes.Equal(region.GetEndKey(), sr.EndKey)
+}
+
+// Influence calculates the store difference that current step makes.
+func (sr SplitRegion) Influence(opInfluence OpInfluence, region *core.RegionInfo) {
+	// Splitting doesn't move any data between stores.
+}
+
 // AddLightPeer is an OpStep that adds a region peer without considering the influence.
 type AddLightPeer struct {
 	ToStore, PeerID uint64
@@ -345,8 +370,13 @@ func (al AddLightLearner) Influence(opInfluence OpInfluence, region *core.Region
 	to.RegionCount++
 }
 
+// nextOperatorID starts from the time PD starts, so that the results of the operators created by a previous PD
+// leader, which stores may still report, don't match the new ones.
+var nextOperatorID = uint64(time.Now().UnixNano())
+
 // Operator contains execution steps generated by scheduler.
 type Operator struct {
+	id          uint64
 	desc        string
 	brief       string
 	regionID    uint64
@@ -368,6 +398,7 @@ func NewOperator(desc, brief string, regionID uint64, regionEpoch *metapb.Region
 		level = core.HighPriority
 	}
 	return &Operator{
+		id:          atomic.AddUint64(&nextOperatorID, 1),
 		desc:        desc,
 		brief:       brief,
 		regionID:    regionID,
@@ -400,6 +431,11 @@ func (o *Operator) MarshalJSON() ([]byte, error) {
 	return []byte(`"` + o.String() + `"`), nil
 }
 
+// ID returns the operator's unique id, stores report the results of its commands with it.
+func (o *Operator) ID() uint64 {
+	return o.id
+}
+
 // Desc returns the operator's short description.
 func (o *Operator) Desc() string {
 	return o.desc
@@ -479,6 +515,26 @@ func (o *Operator) Check(region *core.RegionInfo) OpStep {
 	return nil
 }
 
+// CurrentStep returns the index of the step being executed.
+func (o *Operator) CurrentStep() int {
+	return int(atomic.LoadInt32(&o.currentStep))
+}
+
+// FinishStep marks the i-th step finished if it's the step being executed. It's used when the store reports the
+// step is done before the region heartbeat shows it.
+func (o *Operator) FinishStep(i int) bool {
+	if i < 0 || i >= len(o.steps) {
+		return false
+	}
+	if !atomic.CompareAndSwapInt32(&o.currentStep, int32(i), int32(i+1)) {
+		return false
+	}
+	operatorStepDuration.WithLabelValues(reflect.TypeOf(o.steps[i]).Name()).
+		Observe(time.Since(time.Unix(0, atomic.LoadInt64(&o.stepTime))).Seconds())
+	atomic.StoreInt64(&o.stepTime, time.Now().UnixNano())
+	return true
+}
+
 // ConfVerChanged returns the number of confver has consumed by steps
 func (o *Operator) ConfVerChanged(region *core.RegionInfo) int {
 	total := 0
@@ -843,6 +899,17 @@ func transferLeaderToSuitableSteps(cluster Cluster, leaderID uint64, storeIDs []
 	return 0, nil, errors.New("no suitable store to become region leader")
 }
 
+// CreateSplitRegionOperator creates an operator that splits a region at the keys.
+func CreateSplitRegionOperator(desc string, region *core.RegionInfo, kind OpKind, splitKeys [][]byte) *Operator {
+	step := SplitRegion{
+		StartKey:  region.GetStartKey(),
+		EndKey:    region.GetEndKey(),
+		SplitKeys: splitKeys,
+	}
+	brief := fmt.Sprintf("split: %d keys", len(splitKeys))
+	return NewOperator(desc, brief, region.GetID(), region.GetRegionEpoch(), kind|OpSplit, step)
+}
+
 // CreateMergeRegionOperator creates an operator that merge two region into one.
 func CreateMergeRegionOperator(desc string, cluster Cluster, source *core.RegionInfo, target *core.RegionInfo, kind OpKind) ([]*Operator, error) {
 	kinds, steps, err := matchPeerSteps(cluster, source, target)
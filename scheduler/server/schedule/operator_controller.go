@@ -68,6 +68,9 @@ type OperatorController struct {
 	opRecords *OperatorRecords
 	// TODO: Need to clean up the unused store ID.
 	storesLimit     map[uint64]*ratelimit.Bucket
+	leaderTransfers map[uint64][]time.Time
+	raisedLimits    OperatorLimits
+	raisedUntil     time.Time
 	wop             WaitingOperator
 	wopStatus       *WaitingOperatorStatus
 	opNotifierQueue operatorQueue
@@ -84,6 +87,7 @@ func NewOperatorController(ctx context.Context, cluster opt.Cluster, hbStreams H
 		counts:          make(map[operator.OpKind]uint64),
 		opRecords:       NewOperatorRecords(ctx),
 		storesLimit:     make(map[uint64]*ratelimit.Bucket),
+		leaderTransfers: make(map[uint64][]time.Time),
 		wop:             NewRandBuckets(),
 		wopStatus:       NewWaitingOperatorStatus(),
 		opNotifierQueue: make(operatorQueue, 0),
@@ -125,7 +129,7 @@ func (oc *OperatorController) Dispatch(region *core.RegionInfo, source string) {
 				return
 			}
 
-			oc.SendScheduleCommand(region, step, source)
+			oc.SendScheduleCommand(region, op, step, source)
 			return
 		}
 		if op.IsFinish() && oc.RemoveOperator(op) {
@@ -246,7 +250,7 @@ func (oc *OperatorController) AddOperator(ops ...*operator.Operator) bool {
 	oc.Lock()
 	defer oc.Unlock()
 
-	if oc.exceedStoreLimit(ops...) || !oc.checkAddOperator(ops...) {
+	if oc.exceedStoreLimit(ops...) || oc.exceedOperatorLimits(ops...) || !oc.checkAddOperator(ops...) {
 		for _, op := range ops {
 			operatorCounter.WithLabelValues(op.Desc(), "cancel").Inc()
 			oc.opRecords.Put(op, pdpb.OperatorStatus_CANCEL)
@@ -271,7 +275,7 @@ func (oc *OperatorController) PromoteWaitingOperator() {
 		}
 		operatorWaitCounter.WithLabelValues(ops[0].Desc(), "get").Inc()
 
-		if oc.exceedStoreLimit(ops...) || !oc.checkAddOperator(ops...) {
+		if oc.exceedStoreLimit(ops...) || oc.exceedOperatorLimits(ops...) || !oc.checkAddOperator(ops...) {
 			for _, op := range ops {
 				operatorWaitCounter.WithLabelValues(op.Desc(), "promote_canceled").Inc()
 				oc.opRecords.Put(op, pdpb.OperatorStatus_CANCEL)
@@ -332,6 +336,7 @@ func (oc *OperatorController) addOperatorLocked(op *operator.Operator) bool {
 
 	oc.operators[regionID] = op
 	op.SetStartTime(time.Now())
+	oc.recordLeaderTransfersLocked(op, time.Now())
 	operatorCounter.WithLabelValues(op.Desc(), "start").Inc()
 	operatorWaitDuration.WithLabelValues(op.Desc()).Observe(op.ElapsedTime().Seconds())
 	opInfluence := NewTotalOpInfluence([]*operator.Operator{op}, oc.cluster)
@@ -348,7 +353,7 @@ func (oc *OperatorController) addOperatorLocked(op *operator.Operator) bool {
 	var step operator.OpStep
 	if region := oc.cluster.GetRegion(op.RegionID()); region != nil {
 		if step = op.Check(region); step != nil {
-			oc.SendScheduleCommand(region, step, DispatchFromCreate)
+			oc.SendScheduleCommand(region, op, step, DispatchFromCreate)
 		}
 	}
 
@@ -415,9 +420,11 @@ func (oc *OperatorController) GetWaitingOperators() []*operator.Operator {
 	return oc.wop.ListOperator()
 }
 
-// SendScheduleCommand sends a command to the region.
-func (oc *OperatorController) SendScheduleCommand(region *core.RegionInfo, step operator.OpStep, source string) {
+// SendScheduleCommand sends a command of the operator's step to the region.
+func (oc *OperatorController) SendScheduleCommand(region *core.RegionInfo, op *operator.Operator, step operator.OpStep, source string) {
 	log.Info("send schedule command", zap.Uint64("region-id", region.GetID()), zap.Stringer("step", step), zap.String("source", source))
+	// The peers added by high priority operators repair under-replicated regions, TiKV sends their snapshots first.
+	repair := op.GetPriorityLevel() >= core.HighPriority
 	switch st := step.(type) {
 	case operator.TransferLeader:
 		cmd := &pdpb.RegionHeartbeatResponse{
@@ -425,7 +432,7 @@ func (oc *OperatorController) SendScheduleCommand(region *core.RegionInfo, step
 				Peer: region.GetStorePeer(st.ToStore),
 			},
 		}
-		oc.hbStreams.SendMsg(region, cmd)
+		oc.sendOperatorCommand(region, op, cmd)
 	case operator.AddPeer:
 		if region.GetStorePeer(st.ToStore) != nil {
 			// The newly added peer is pending.
@@ -438,9 +445,10 @@ func (oc *OperatorController) SendScheduleCommand(region *core.RegionInfo, step
 					Id:      st.PeerID,
 					StoreId: st.ToStore,
 				},
+				Repair: repair,
 			},
 		}
-		oc.hbStreams.SendMsg(region, cmd)
+		oc.sendOperatorCommand(region, op, cmd)
 	case operator.AddLightPeer:
 		if region.GetStorePeer(st.ToStore) != nil {
 			// The newly added peer is pending.
@@ -453,9 +461,10 @@ func (oc *OperatorController) SendScheduleCommand(region *core.RegionInfo, step
 					Id:      st.PeerID,
 					StoreId: st.ToStore,
 				},
+				Repair: repair,
 			},
 		}
-		oc.hbStreams.SendMsg(region, cmd)
+		oc.sendOperatorCommand(region, op, cmd)
 	case operator.RemovePeer:
 		cmd := &pdpb.RegionHeartbeatResponse{
 			ChangePeer: &pdpb.ChangePeer{
@@ -463,12 +472,87 @@ func (oc *OperatorController) SendScheduleCommand(region *core.RegionInfo, step
 				Peer:       region.GetStorePeer(st.FromStore),
 			},
 		}
-		oc.hbStreams.SendMsg(region, cmd)
+		oc.sendOperatorCommand(region, op, cmd)
+	case operator.SplitRegion:
+		cmd := &pdpb.RegionHeartbeatResponse{
+			SplitRegion: &pdpb.SplitRegion{
+				Policy: pdpb.CheckPolicy_USEKEY,
+				Keys:   st.SplitKeys,
+			},
+		}
+		oc.sendOperatorCommand(region, op, cmd)
+	case operator.MergeRegion:
+		if st.IsPassive {
+			// Only the source region is asked to merge, the target waits for it.
+			return
+		}
+		cmd := &pdpb.RegionHeartbeatResponse{
+			Merge: &pdpb.Merge{
+				Target: st.ToRegion,
+			},
+		}
+		oc.sendOperatorCommand(region, op, cmd)
 	default:
 		log.Error("unknown operator step", zap.Reflect("step", step))
 	}
 }
 
+// sendOperatorCommand tags the command with the operator and its current step, so the store can report its result.
+func (oc *OperatorController) sendOperatorCommand(region *core.RegionInfo, op *operator.Operator, cmd *pdpb.RegionHeartbeatResponse) {
+	cmd.OperatorId = op.ID()
+	cmd.OperatorStep = uint32(op.CurrentStep())
+	oc.hbStreams.SendMsg(region, cmd)
+}
+
+// HandleOperatorResult handles the result of an operator command reported by a store. A failed command cancels the
+// operator at once instead of waiting for it to time out, and a finished last step finishes the operator before the
+// region heartbeat shows it. Only the change peer and split steps are finished by a result, they are done once their
+// admin command is applied. A transfer leader step is done once a region heartbeat shows the new leader.
+func (oc *OperatorController) HandleOperatorResult(result *pdpb.OperatorResult) {
+	op := oc.GetOperator(result.GetRegionId())
+	if op == nil || op.ID() != result.GetOperatorId() {
+		// The operator has been finished or replaced.
+		return
+	}
+	step := int(result.GetOperatorStep())
+	if !result.GetSuccess() {
+		if oc.RemoveOperator(op) {
+			log.Info("operator failed", zap.Uint64("region-id", op.RegionID()), zap.Int("step", step),
+				zap.String("error", result.GetError()), zap.Reflect("operator", op))
+			operatorCounter.WithLabelValues(op.Desc(), "failed").Inc()
+			oc.opRecords.Put(op, pdpb.OperatorStatus_FAILED)
+			oc.PromoteWaitingOperator()
+		}
+		return
+	}
+	if !finishedByResult(op.Step(step)) {
+		return
+	}
+	operatorCounter.WithLabelValues(op.Desc(), "step-success").Inc()
+	// The following steps are sent with the region from the next heartbeat, which has the epoch changed by this
+	// step, so only the last step is finished by the report.
+	if step != op.Len()-1 || !op.FinishStep(step) {
+		return
+	}
+	if oc.RemoveOperator(op) {
+		log.Info("operator finish", zap.Uint64("region-id", op.RegionID()), zap.Duration("takes", op.RunningTime()), zap.Reflect("operator", op))
+		operatorCounter.WithLabelValues(op.Desc(), "finish").Inc()
+		operatorDuration.WithLabelValues(op.Desc()).Observe(op.RunningTime().Seconds())
+		oc.pushHistory(op)
+		oc.opRecords.Put(op, pdpb.OperatorStatus_SUCCESS)
+		oc.PromoteWaitingOperator()
+	}
+}
+
+// finishedByResult returns whether the step is done once its admin command succeeds.
+func finishedByResult(step operator.OpStep) bool {
+	switch step.(type) {
+	case operator.AddPeer, operator.RemovePeer, operator.AddLightPeer, operator.AddLightLearner, operator.SplitRegion:
+		return true
+	}
+	return false
+}
+
 func (oc *OperatorController) pushHistory(op *operator.Operator) {
 	oc.Lock()
 	defer oc.Unlock()
@@ -226,6 +226,67 @@ func (t *testOperatorControllerSuite) TestStoreLimit(c *C) {
 	c.Assert(oc.RemoveOperator(op), IsFalse)
 }
 
+func (t *testOperatorControllerSuite) TestOperatorLimits(c *C) {
+	opt := mockoption.NewScheduleOptions()
+	opt.MaxSnapshotCount = 2
+	opt.MaxPendingPeerCount = 3
+	opt.MaxLeaderTransferPerMinute = 2
+	tc := mockcluster.NewCluster(opt)
+	oc := NewOperatorController(t.ctx, tc, mockhbstream.NewHeartbeatStream())
+	tc.AddLeaderStore(1, 0)
+	tc.AddLeaderStore(2, 0)
+	tc.AddLeaderStore(3, 0)
+	for i := uint64(1); i <= 10; i++ {
+		tc.AddLeaderRegion(i, 1, 3)
+	}
+	addPeer := func(regionID uint64, kind operator.OpKind) *operator.Operator {
+		return operator.NewOperator("test", "test", regionID, &metapb.RegionEpoch{}, kind, operator.AddPeer{ToStore: 2, PeerID: 10 + regionID})
+	}
+	transferLeader := func(regionID uint64) *operator.Operator {
+		return operator.NewOperator("test", "test", regionID, &metapb.RegionEpoch{}, operator.OpLeader, operator.TransferLeader{FromStore: 1, ToStore: 3})
+	}
+
+	// The peers being added count as the snapshots the store receives.
+	tc.UpdateSnapshotCount(2, 1)
+	c.Assert(oc.AddOperator(addPeer(1, operator.OpRegion)), IsTrue)
+	c.Assert(oc.AddOperator(addPeer(2, operator.OpRegion)), IsFalse)
+	// The admin operators aren't limited.
+	c.Assert(oc.AddOperator(addPeer(3, operator.OpRegion|operator.OpAdmin)), IsTrue)
+	c.Assert(oc.RemoveOperator(oc.GetOperator(1)), IsTrue)
+	c.Assert(oc.RemoveOperator(oc.GetOperator(3)), IsTrue)
+	tc.UpdateSnapshotCount(2, 0)
+	tc.UpdatePendingPeerCount(2, 3)
+	c.Assert(oc.AddOperator(addPeer(2, operator.OpRegion)), IsFalse)
+	tc.UpdatePendingPeerCount(2, 0)
+
+	// The leader transfers are limited in or out of a store in a minute.
+	c.Assert(oc.AddOperator(transferLeader(4)), IsTrue)
+	c.Assert(oc.AddOperator(transferLeader(5)), IsTrue)
+	c.Assert(oc.AddOperator(transferLeader(6)), IsFalse)
+
+	// The limits are raised until the ttl passes, a lower limit is ignored.
+	oc.RaiseOperatorLimits(OperatorLimits{MaxSnapshotCount: 1, MaxLeaderTransferPerMinute: 3}, time.Minute)
+	limits, until := oc.GetOperatorLimits()
+	c.Assert(limits, DeepEquals, OperatorLimits{MaxSnapshotCount: 2, MaxPendingPeerCount: 3, MaxLeaderTransferPerMinute: 3})
+	c.Assert(until.IsZero(), IsFalse)
+	c.Assert(oc.AddOperator(transferLeader(6)), IsTrue)
+	c.Assert(oc.AddOperator(transferLeader(7)), IsFalse)
+	oc.RaiseOperatorLimits(OperatorLimits{MaxLeaderTransferPerMinute: 10}, time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+	limits, until = oc.GetOperatorLimits()
+	c.Assert(limits.MaxLeaderTransferPerMinute, Equals, uint64(2))
+	c.Assert(until.IsZero(), IsTrue)
+
+	// The transfers started before the window don't count.
+	for storeID, transfers := range oc.leaderTransfers {
+		for i := range transfers {
+			transfers[i] = transfers[i].Add(-leaderTransferWindow)
+		}
+		oc.leaderTransfers[storeID] = transfers
+	}
+	c.Assert(oc.AddOperator(transferLeader(7)), IsTrue)
+}
+
 // #1652
 func (t *testOperatorControllerSuite) TestDispatchOutdatedRegion(c *C) {
 	cluster := mockcluster.NewCluster(mockoption.NewScheduleOptions())
@@ -279,6 +340,83 @@ func (t *testOperatorControllerSuite) TestDispatchOutdatedRegion(c *C) {
 	c.Assert(len(stream.MsgCh()), Equals, 3)
 }
 
+func (t *testOperatorControllerSuite) TestRepairPeer(c *C) {
+	cluster := mockcluster.NewCluster(mockoption.NewScheduleOptions())
+	stream := mockhbstream.NewHeartbeatStreams(cluster.ID)
+	controller := NewOperatorController(t.ctx, cluster, stream)
+
+	cluster.AddLeaderStore(1, 1)
+	cluster.AddLeaderStore(2, 0)
+	cluster.AddLeaderStore(3, 0)
+	cluster.AddLeaderRegion(1, 1)
+	cluster.AddLeaderRegion(2, 1)
+
+	// Only the peers added by high priority operators are marked as repairing the region.
+	op := operator.CreateAddPeerOperator("rebalance", cluster.GetRegion(1), 10, 2, operator.OpBalance)
+	c.Assert(controller.AddOperator(op), IsTrue)
+	c.Assert((<-stream.MsgCh()).GetChangePeer().GetRepair(), IsFalse)
+
+	op = operator.CreateAddPeerOperator("make-up-replica", cluster.GetRegion(2), 11, 3, operator.OpReplica)
+	op.SetPriorityLevel(core.HighPriority)
+	c.Assert(controller.AddOperator(op), IsTrue)
+	c.Assert((<-stream.MsgCh()).GetChangePeer().GetRepair(), IsTrue)
+}
+
+func (t *testOperatorControllerSuite) TestHandleOperatorResult(c *C) {
+	cluster := mockcluster.NewCluster(mockoption.NewScheduleOptions())
+	stream := mockhbstream.NewHeartbeatStreams(cluster.ID)
+	controller := NewOperatorController(t.ctx, cluster, stream)
+
+	cluster.AddLeaderStore(1, 1)
+	cluster.AddLeaderStore(2, 0)
+	cluster.AddLeaderRegion(1, 1)
+	cluster.AddLeaderRegion(2, 1)
+
+	op1 := operator.CreateAddPeerOperator("add-peer", cluster.GetRegion(1), 10, 2, operator.OpBalance)
+	c.Assert(controller.AddOperator(op1), IsTrue)
+	msg := <-stream.MsgCh()
+	c.Assert(msg.GetOperatorId(), Equals, op1.ID())
+	c.Assert(msg.GetOperatorStep(), Equals, uint32(0))
+
+	// Results of other operators are ignored.
+	controller.HandleOperatorResult(&pdpb.OperatorResult{RegionId: 1, OperatorId: op1.ID() + 1000})
+	c.Assert(controller.GetOperator(1), Equals, op1)
+
+	// A failed command cancels the operator.
+	controller.HandleOperatorResult(&pdpb.OperatorResult{RegionId: 1, OperatorId: op1.ID(), Error: "failed"})
+	c.Assert(controller.GetOperator(1), IsNil)
+	c.Assert(controller.GetOperatorStatus(1).Status, Equals, pdpb.OperatorStatus_FAILED)
+
+	// The operator is finished once its last step succeeds.
+	op2 := operator.CreateAddPeerOperator("add-peer", cluster.GetRegion(2), 11, 2, operator.OpBalance)
+	c.Assert(controller.AddOperator(op2), IsTrue)
+	<-stream.MsgCh()
+	c.Assert(op2.Len(), Equals, 1)
+	controller.HandleOperatorResult(&pdpb.OperatorResult{RegionId: 2, OperatorId: op2.ID(), Success: true})
+	c.Assert(controller.GetOperator(2), IsNil)
+	c.Assert(controller.GetOperatorStatus(2).Status, Equals, pdpb.OperatorStatus_SUCCESS)
+
+	// A transfer leader step isn't finished by its result, only by the heartbeat showing the new leader.
+	cluster.AddLeaderRegion(3, 1, 2)
+	op3 := operator.CreateTransferLeaderOperator("transfer-leader", cluster.GetRegion(3), 1, 2, operator.OpLeader)
+	c.Assert(controller.AddOperator(op3), IsTrue)
+	<-stream.MsgCh()
+	controller.HandleOperatorResult(&pdpb.OperatorResult{RegionId: 3, OperatorId: op3.ID(), Success: true})
+	c.Assert(controller.GetOperator(3), Equals, op3)
+	cluster.AddLeaderRegion(3, 2, 1)
+	controller.Dispatch(cluster.GetRegion(3), DispatchFromHeartBeat)
+	c.Assert(controller.GetOperator(3), IsNil)
+	c.Assert(controller.GetOperatorStatus(3).Status, Equals, pdpb.OperatorStatus_SUCCESS)
+
+	// But its failure still cancels the operator.
+	op4 := operator.CreateTransferLeaderOperator("transfer-leader", cluster.GetRegion(3), 2, 1, operator.OpLeader)
+	c.Assert(controller.AddOperator(op4), IsTrue)
+	<-stream.MsgCh()
+	controller.HandleOperatorResult(&pdpb.OperatorResult{RegionId: 3, OperatorId: op4.ID(), Error: "peer is not ready"})
+	c.Assert(controller.GetOperator(3), IsNil)
+	c.Assert(controller.GetOperatorStatus(3).Status, Equals, pdpb.OperatorStatus_FAILED)
+}
+
 func newRegionInfo(id uint64, startKey, endKey string, size, keys int64, leader []uint64, peers ...[]uint64) *core.RegionInfo {
 	var prs []*metapb.Peer
 	for _, peer := range peers {
@@ -0,0 +1,189 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+// leaderTransferWindow is the window MaxLeaderTransferPerMinute counts the leader transfers in.
+const leaderTransferWindow = time.Minute
+
+// OperatorLimits are the limits of the operators on each store, 0 means no limit.
+type OperatorLimits struct {
+	// MaxSnapshotCount is the max snapshots a store receives and applies, the peers being added to it by the running
+	// operators count as the snapshots too.
+	MaxSnapshotCount uint64 `json:"max-snapshot-count"`
+	// MaxPendingPeerCount is the max pending peers of a store, with the peers being added to it.
+	MaxPendingPeerCount uint64 `json:"max-pending-peer-count"`
+	// MaxLeaderTransferPerMinute is the max leader transfers in or out of a store started in a minute.
+	MaxLeaderTransferPerMinute uint64 `json:"max-leader-transfer-per-minute"`
+}
+
+// raise returns the limits with each one raised to the one of other if it's larger, no limit stays no limit.
+func (l OperatorLimits) raise(other OperatorLimits) OperatorLimits {
+	raise := func(limit, other uint64) uint64 {
+		if limit != 0 && other > limit {
+			return other
+		}
+		return limit
+	}
+	l.MaxSnapshotCount = raise(l.MaxSnapshotCount, other.MaxSnapshotCount)
+	l.MaxPendingPeerCount = raise(l.MaxPendingPeerCount, other.MaxPendingPeerCount)
+	l.MaxLeaderTransferPerMinute = raise(l.MaxLeaderTransferPerMinute, other.MaxLeaderTransferPerMinute)
+	return l
+}
+
+// RaiseOperatorLimits raises the operator limits of the configuration to the ones given until ttl passes, like during
+// a maintenance which needs to move the regions faster. The limits lower than the configured ones are ignored, a ttl
+// not larger than 0 drops the raised limits.
+func (oc *OperatorController) RaiseOperatorLimits(limits OperatorLimits, ttl time.Duration) {
+	oc.Lock()
+	defer oc.Unlock()
+	if ttl <= 0 {
+		oc.raisedLimits, oc.raisedUntil = OperatorLimits{}, time.Time{}
+		log.Info("operator limits restored")
+		return
+	}
+	oc.raisedLimits, oc.raisedUntil = limits, time.Now().Add(ttl)
+	log.Info("operator limits raised", zap.Reflect("limits", limits), zap.Time("until", oc.raisedUntil))
+}
+
+// GetOperatorLimits returns the operator limits in effect, and until when they are raised, which is zero if they
+// aren't.
+func (oc *OperatorController) GetOperatorLimits() (OperatorLimits, time.Time) {
+	oc.RLock()
+	defer oc.RUnlock()
+	return oc.operatorLimitsLocked(time.Now())
+}
+
+func (oc *OperatorController) operatorLimitsLocked(now time.Time) (OperatorLimits, time.Time) {
+	limits := OperatorLimits{
+		MaxSnapshotCount:           oc.cluster.GetMaxSnapshotCount(),
+		MaxPendingPeerCount:        oc.cluster.GetMaxPendingPeerCount(),
+		MaxLeaderTransferPerMinute: oc.cluster.GetMaxLeaderTransferPerMinute(),
+	}
+	if now.After(oc.raisedUntil) {
+		return limits, time.Time{}
+	}
+	return limits.raise(oc.raisedLimits), oc.raisedUntil
+}
+
+// addedPeerStore returns the store the step adds a peer to.
+func addedPeerStore(step operator.OpStep) (uint64, bool) {
+	switch s := step.(type) {
+	case operator.AddPeer:
+		return s.ToStore, true
+	case operator.AddLightPeer:
+		return s.ToStore, true
+	case operator.AddLightLearner:
+		return s.ToStore, true
+	}
+	return 0, false
+}
+
+// exceedOperatorLimits returns true if adding the operators exceeds the operator limits of a store. The operators
+// initiated by the admin aren't limited.
+func (oc *OperatorController) exceedOperatorLimits(ops ...*operator.Operator) bool {
+	now := time.Now()
+	limits, _ := oc.operatorLimitsLocked(now)
+	var addingPeers map[uint64]uint64
+	for _, op := range ops {
+		if op.Kind()&operator.OpAdmin != 0 {
+			continue
+		}
+		for i := 0; i < op.Len(); i++ {
+			step := op.Step(i)
+			if tl, ok := step.(operator.TransferLeader); ok {
+				if oc.exceedLeaderTransferLocked(limits, now, tl.FromStore) || oc.exceedLeaderTransferLocked(limits, now, tl.ToStore) {
+					log.Debug("leader transfer limit exceeded, cancel add operator", zap.Uint64("region-id", op.RegionID()))
+					return true
+				}
+			}
+			storeID, ok := addedPeerStore(step)
+			if !ok {
+				continue
+			}
+			store := oc.cluster.GetStore(storeID)
+			if store == nil {
+				continue
+			}
+			if addingPeers == nil {
+				addingPeers = oc.addingPeersLocked()
+			}
+			adding := addingPeers[storeID]
+			snapshots := uint64(store.GetReceivingSnapCount()) + uint64(store.GetApplyingSnapCount()) + adding
+			if limits.MaxSnapshotCount > 0 && snapshots >= limits.MaxSnapshotCount {
+				log.Debug("snapshot limit exceeded, cancel add operator", zap.Uint64("region-id", op.RegionID()), zap.Uint64("store-id", storeID))
+				return true
+			}
+			if limits.MaxPendingPeerCount > 0 && uint64(store.GetPendingPeerCount())+adding >= limits.MaxPendingPeerCount {
+				log.Debug("pending peer limit exceeded, cancel add operator", zap.Uint64("region-id", op.RegionID()), zap.Uint64("store-id", storeID))
+				return true
+			}
+			addingPeers[storeID]++
+		}
+	}
+	return false
+}
+
+// addingPeersLocked counts the peers the unfinished steps of the running operators add to each store.
+func (oc *OperatorController) addingPeersLocked() map[uint64]uint64 {
+	adding := make(map[uint64]uint64)
+	for _, op := range oc.operators {
+		for i := op.CurrentStep(); i < op.Len(); i++ {
+			if storeID, ok := addedPeerStore(op.Step(i)); ok {
+				adding[storeID]++
+			}
+		}
+	}
+	return adding
+}
+
+func (oc *OperatorController) exceedLeaderTransferLocked(limits OperatorLimits, now time.Time, storeID uint64) bool {
+	if limits.MaxLeaderTransferPerMinute == 0 {
+		return false
+	}
+	return uint64(len(oc.recentLeaderTransfersLocked(now, storeID))) >= limits.MaxLeaderTransferPerMinute
+}
+
+// recentLeaderTransfersLocked drops the leader transfers of the store started before the window and returns the rest.
+func (oc *OperatorController) recentLeaderTransfersLocked(now time.Time, storeID uint64) []time.Time {
+	transfers := oc.leaderTransfers[storeID]
+	i := 0
+	for i < len(transfers) && now.Sub(transfers[i]) >= leaderTransferWindow {
+		i++
+	}
+	transfers = transfers[i:]
+	if len(transfers) == 0 {
+		delete(oc.leaderTransfers, storeID)
+		return nil
+	}
+	oc.leaderTransfers[storeID] = transfers
+	return transfers
+}
+
+// recordLeaderTransfersLocked counts the leader transfers of the operator against their stores.
+func (oc *OperatorController) recordLeaderTransfersLocked(op *operator.Operator, now time.Time) {
+	for i := 0; i < op.Len(); i++ {
+		if tl, ok := op.Step(i).(operator.TransferLeader); ok {
+			oc.leaderTransfers[tl.FromStore] = append(oc.recentLeaderTransfersLocked(now, tl.FromStore), now)
+			oc.leaderTransfers[tl.ToStore] = append(oc.recentLeaderTransfersLocked(now, tl.ToStore), now)
+		}
+	}
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/placement"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/statistics"
 )
 
@@ -34,6 +35,7 @@ type Options interface {
 
 	GetMaxSnapshotCount() uint64
 	GetMaxPendingPeerCount() uint64
+	GetMaxLeaderTransferPerMinute() uint64
 	GetMaxStoreDownTime() time.Duration
 	GetMaxMergeRegionSize() uint64
 	GetMaxMergeRegionKeys() uint64
@@ -45,6 +47,8 @@ type Options interface {
 	GetLocationLabels() []string
 	GetStrictlyMatchLabel() bool
 	IsPlacementRulesEnabled() bool
+	GetLeaderConstraints() []placement.LabelConstraint
+	GetReplicaConstraints() []placement.LabelConstraint
 
 	GetHotRegionCacheHitsThreshold() int
 	GetTolerantSizeRatio() float64
@@ -0,0 +1,46 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package placement
+
+import (
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pkg/errors"
+)
+
+// DiskClassLabel is the label a store registers the class of its disk with. The stores of a class form a capacity
+// tier, label constraints on it can e.g. keep the leaders on the fastest disks.
+const DiskClassLabel = "disk-class"
+
+// The disk classes a store may register.
+const (
+	DiskClassNVMe = "nvme"
+	DiskClassSSD  = "ssd"
+	DiskClassHDD  = "hdd"
+)
+
+// ValidateDiskClass checks the disk class a store registers, an empty class is unknown.
+func ValidateDiskClass(class string) error {
+	switch class {
+	case "", DiskClassNVMe, DiskClassSSD, DiskClassHDD:
+		return nil
+	}
+	return errors.Errorf("invalid disk class %q, expect one of nvme, ssd and hdd", class)
+}
+
+// SameDiskClass checks if the stores are in the same capacity tier. The stores of unknown classes are in the same
+// tier as any store.
+func SameDiskClass(a, b *core.StoreInfo) bool {
+	classA, classB := a.GetLabelValue(DiskClassLabel), b.GetLabelValue(DiskClassLabel)
+	return classA == "" || classB == "" || classA == classB
+}
@@ -16,6 +16,7 @@ package placement
 import (
 	"github.com/pingcap-incubator/tinykv/scheduler/pkg/slice"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	"github.com/pkg/errors"
 )
 
 // LabelConstraintOp defines how a LabelConstraint matches a store. It can be one of
@@ -41,9 +42,23 @@ func validateOp(op LabelConstraintOp) bool {
 
 // LabelConstraint is used to filter store when trying to place peer of a region.
 type LabelConstraint struct {
-	Key    string            `json:"key,omitempty"`
-	Op     LabelConstraintOp `json:"op,omitempty"`
-	Values []string          `json:"values,omitempty"`
+	Key    string            `toml:"key" json:"key,omitempty"`
+	Op     LabelConstraintOp `toml:"op" json:"op,omitempty"`
+	Values []string          `toml:"values" json:"values,omitempty"`
+}
+
+// Validate checks the op of the constraint, and that the ops comparing values have some.
+func (c *LabelConstraint) Validate() error {
+	if c.Key == "" {
+		return errors.New("label constraint without key")
+	}
+	if !validateOp(c.Op) {
+		return errors.Errorf("invalid label constraint op %q of %s", c.Op, c.Key)
+	}
+	if (c.Op == In || c.Op == NotIn) && len(c.Values) == 0 {
+		return errors.Errorf("label constraint %s %s without values", c.Key, c.Op)
+	}
+	return nil
 }
 
 // MatchStore checks if a store matches the constraint.
@@ -68,6 +83,11 @@ func (c *LabelConstraint) MatchStore(store *core.StoreInfo) bool {
 // TODO: move it to config.
 var exclusiveLabels = []string{"engine"}
 
+// MatchOptionalConstraints checks if a store matches the constraints, any store matches no constraints.
+func MatchOptionalConstraints(store *core.StoreInfo, constraints []LabelConstraint) bool {
+	return len(constraints) == 0 || MatchLabelConstraints(store, constraints)
+}
+
 // MatchLabelConstraints checks if a store matches label constraints list.
 func MatchLabelConstraints(store *core.StoreInfo, constraints []LabelConstraint) bool {
 	if store == nil {
@@ -99,3 +99,15 @@ func (s *testLabelConstraintsSuite) TestLabelConstraints(c *C) {
 		c.Assert(matched, DeepEquals, expect[i])
 	}
 }
+
+func (s *testLabelConstraintsSuite) TestValidate(c *C) {
+	c.Assert((&LabelConstraint{Key: DiskClassLabel, Op: In, Values: []string{DiskClassNVMe}}).Validate(), IsNil)
+	c.Assert((&LabelConstraint{Key: DiskClassLabel, Op: Exists}).Validate(), IsNil)
+	c.Assert((&LabelConstraint{Op: Exists}).Validate(), NotNil)
+	c.Assert((&LabelConstraint{Key: DiskClassLabel, Op: "eq"}).Validate(), NotNil)
+	c.Assert((&LabelConstraint{Key: DiskClassLabel, Op: NotIn}).Validate(), NotNil)
+
+	c.Assert(ValidateDiskClass(""), IsNil)
+	c.Assert(ValidateDiskClass(DiskClassSSD), IsNil)
+	c.Assert(ValidateDiskClass("tape"), NotNil)
+}
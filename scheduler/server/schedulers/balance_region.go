@@ -164,11 +164,13 @@ func (s *balanceRegionScheduler) transferPeer(cluster opt.Cluster, region *core.
 		log.Error("failed to get the source store", zap.Uint64("store-id", sourceStoreID))
 	}
 	scoreGuard := filter.NewDistinctScoreFilter(s.GetName(), cluster.GetLocationLabels(), stores, source)
+	// Balance the stores within a capacity tier, never move a replica to another tier.
+	tierGuard := filter.NewDiskClassFilter(s.GetName(), source)
 	checker := checker.NewReplicaChecker(cluster, s.GetName())
 	exclude := make(map[uint64]struct{})
 	excludeFilter := filter.NewExcludedFilter(s.name, nil, exclude)
 	for {
-		storeID, _ := checker.SelectBestReplacementStore(region, oldPeer, scoreGuard, excludeFilter)
+		storeID, _ := checker.SelectBestReplacementStore(region, oldPeer, scoreGuard, tierGuard, excludeFilter)
 		if storeID == 0 {
 			schedulerCounter.WithLabelValues(s.GetName(), "no-replacement").Inc()
 			return nil
@@ -19,6 +19,7 @@ import (
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/filter"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/operator"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/opt"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/placement"
 	"github.com/pingcap-incubator/tinykv/scheduler/server/schedule/selector"
 	"github.com/pingcap/log"
 	"go.uber.org/zap"
@@ -46,7 +47,8 @@ type labelScheduler struct {
 
 // LabelScheduler is mainly based on the store's label information for scheduling.
 // Now only used for reject leader schedule, that will move the leader out of
-// the store with the specific label.
+// the store with the specific label, or the store not matching the leader
+// constraints.
 func newLabelScheduler(opController *schedule.OperatorController) schedule.Scheduler {
 	filters := []filter.Filter{
 		filter.StoreStateFilter{ActionScope: labelSchedulerName, TransferLeader: true},
@@ -76,7 +78,8 @@ func (s *labelScheduler) Schedule(cluster opt.Cluster) []*operator.Operator {
 	stores := cluster.GetStores()
 	rejectLeaderStores := make(map[uint64]struct{})
 	for _, s := range stores {
-		if cluster.CheckLabelProperty(opt.RejectLeader, s.GetLabels()) {
+		if cluster.CheckLabelProperty(opt.RejectLeader, s.GetLabels()) ||
+			!placement.MatchOptionalConstraints(s, cluster.GetLeaderConstraints()) {
 			rejectLeaderStores[s.GetID()] = struct{}{}
 		}
 	}
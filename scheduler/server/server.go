@@ -100,6 +100,7 @@ type Server struct {
 	cfg         *config.Config
 	etcdCfg     *embed.Config
 	scheduleOpt *config.ScheduleOption
+	startTime   time.Time
 
 	serverLoopCtx    context.Context
 	serverLoopCancel func()
@@ -137,6 +138,7 @@ func CreateServer(cfg *config.Config) (*Server, error) {
 		cfg:         cfg,
 		scheduleOpt: config.NewScheduleOption(cfg),
 		member:      &member.Member{},
+		startTime:   time.Now(),
 	}
 
 	// Adjust etcd config.
@@ -145,6 +147,7 @@ func CreateServer(cfg *config.Config) (*Server, error) {
 		return nil, err
 	}
 	etcdCfg.ServiceRegister = func(gs *grpc.Server) { pdpb.RegisterPDServer(gs, s) }
+	etcdCfg.UserHandlers = newStatusHandlers(s)
 	s.etcdCfg = etcdCfg
 	if EnableZap {
 		// The etcd master version has removed embed.Config.SetupLogging.
@@ -379,9 +382,13 @@ func (s *Server) bootstrapCluster(req *pdpb.BootstrapRequest) (*pdpb.BootstrapRe
 		return nil, err
 	}
 
+	replication := s.GetReplicationConfig()
+	if spec := s.cfg.ClusterSpec; spec != nil {
+		spec.ApplyReplication(replication)
+	}
 	clusterMeta := metapb.Cluster{
 		Id:           clusterID,
-		MaxPeerCount: uint32(s.scheduleOpt.GetReplication().GetMaxReplicas()),
+		MaxPeerCount: uint32(replication.MaxReplicas),
 	}
 
 	// Set cluster meta
@@ -402,7 +409,7 @@ func (s *Server) bootstrapCluster(req *pdpb.BootstrapRequest) (*pdpb.BootstrapRe
 	ops = append(ops, clientv3.OpPut(bootstrapKey, string(timeData)))
 
 	// Set store meta
-	storeMeta := req.GetStore()
+	storeMeta := withSpecLabels(s.cfg.ClusterSpec, req.GetStore())
 	storePath := makeStoreKey(clusterRootPath, storeMeta.GetId())
 	storeValue, err := storeMeta.Marshal()
 	if err != nil {
@@ -442,10 +449,42 @@ func (s *Server) bootstrapCluster(req *pdpb.BootstrapRequest) (*pdpb.BootstrapRe
 	if err := s.cluster.start(); err != nil {
 		return nil, err
 	}
+	if spec := s.cfg.ClusterSpec; spec != nil {
+		s.applyClusterSpec(spec, replication)
+	}
 
 	return &pdpb.BootstrapResponse{}, nil
 }
 
+// applyClusterSpec applies the replication config and the split keys of the spec to the just bootstrapped cluster.
+// The cluster is bootstrapped already, so a failure is logged to be fixed through the API.
+func (s *Server) applyClusterSpec(spec *config.ClusterSpec, replication *config.ReplicationConfig) {
+	if err := s.SetReplicationConfig(*replication); err != nil {
+		log.Error("apply the replication config of the cluster spec failed", zap.Error(err))
+	}
+	if keys := spec.GetSplitKeys(); len(keys) > 0 {
+		if err := s.cluster.SetSplitKeys(keys); err != nil {
+			log.Error("apply the split keys of the cluster spec failed", zap.Error(err))
+		}
+	}
+	log.Info("cluster spec is applied", zap.Reflect("spec", spec))
+}
+
+// withSpecLabels returns the store with the labels the cluster spec declares for its address, which replace the
+// labels of the store with the same keys.
+func withSpecLabels(spec *config.ClusterSpec, store *metapb.Store) *metapb.Store {
+	if spec == nil {
+		return store
+	}
+	labels := spec.StoreLabels(store.GetAddress())
+	if len(labels) == 0 {
+		return store
+	}
+	store = proto.Clone(store).(*metapb.Store)
+	store.Labels = core.NewStoreInfo(store).MergeLabels(labels)
+	return store
+}
+
 func (s *Server) createRaftCluster() error {
 	if s.cluster.isRunning() {
 		return nil
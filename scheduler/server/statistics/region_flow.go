@@ -0,0 +1,159 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+)
+
+// RegionFlowSample is the flow of a region reported by a region heartbeat received at Time.
+type RegionFlowSample struct {
+	Time         time.Time `json:"time"`
+	BytesRead    uint64    `json:"bytes_read"`
+	KeysRead     uint64    `json:"keys_read"`
+	BytesWritten uint64    `json:"bytes_written"`
+	KeysWritten  uint64    `json:"keys_written"`
+}
+
+// RegionFlow is the flow of a region summed over a window.
+type RegionFlow struct {
+	RegionID    uint64  `json:"region_id"`
+	Bytes       uint64  `json:"bytes"`
+	Keys        uint64  `json:"keys"`
+	BytesPerSec float64 `json:"bytes_per_sec"`
+	KeysPerSec  float64 `json:"keys_per_sec"`
+}
+
+// regionFlowRing is a ring buffer of the latest samples of a region.
+type regionFlowRing struct {
+	samples []RegionFlowSample
+	next    int
+}
+
+func (r *regionFlowRing) add(sample RegionFlowSample) {
+	if len(r.samples) < cap(r.samples) {
+		r.samples = append(r.samples, sample)
+		return
+	}
+	r.samples[r.next] = sample
+	r.next = (r.next + 1) % len(r.samples)
+}
+
+// each calls f with the samples from the oldest.
+func (r *regionFlowRing) each(f func(sample *RegionFlowSample)) {
+	for i := range r.samples {
+		f(&r.samples[(r.next+i)%len(r.samples)])
+	}
+}
+
+// RegionFlowRecorder records the latest flow samples of every region, so the hot regions of a recent window can be
+// found without an external metrics stack.
+type RegionFlowRecorder struct {
+	sync.RWMutex
+	size    int
+	regions map[uint64]*regionFlowRing
+}
+
+// NewRegionFlowRecorder creates a RegionFlowRecorder keeping the latest size samples of every region.
+func NewRegionFlowRecorder(size int) *RegionFlowRecorder {
+	return &RegionFlowRecorder{
+		size:    size,
+		regions: make(map[uint64]*regionFlowRing),
+	}
+}
+
+// Record records the flow reported by the heartbeat of the region received at now.
+func (r *RegionFlowRecorder) Record(region *core.RegionInfo, now time.Time) {
+	r.Lock()
+	defer r.Unlock()
+	ring, ok := r.regions[region.GetID()]
+	if !ok {
+		ring = &regionFlowRing{samples: make([]RegionFlowSample, 0, r.size)}
+		r.regions[region.GetID()] = ring
+	}
+	ring.add(RegionFlowSample{
+		Time:         now,
+		BytesRead:    region.GetBytesRead(),
+		KeysRead:     region.GetKeysRead(),
+		BytesWritten: region.GetBytesWritten(),
+		KeysWritten:  region.GetKeysWritten(),
+	})
+}
+
+// Remove drops the samples of a region which doesn't exist anymore.
+func (r *RegionFlowRecorder) Remove(regionID uint64) {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.regions, regionID)
+}
+
+// History returns the recorded samples of a region from the oldest.
+func (r *RegionFlowRecorder) History(regionID uint64) []RegionFlowSample {
+	r.RLock()
+	defer r.RUnlock()
+	ring, ok := r.regions[regionID]
+	if !ok {
+		return nil
+	}
+	history := make([]RegionFlowSample, 0, len(ring.samples))
+	ring.each(func(sample *RegionFlowSample) {
+		history = append(history, *sample)
+	})
+	return history
+}
+
+// TopRegions returns at most limit regions with the most bytes of the kind of flow reported in the window ending at
+// now, from the hottest. The regions without any flow in the window are left out.
+func (r *RegionFlowRecorder) TopRegions(kind FlowKind, now time.Time, window time.Duration, limit int) []RegionFlow {
+	r.RLock()
+	defer r.RUnlock()
+	since := now.Add(-window)
+	var flows []RegionFlow
+	for regionID, ring := range r.regions {
+		flow := RegionFlow{RegionID: regionID}
+		ring.each(func(sample *RegionFlowSample) {
+			if !sample.Time.After(since) || sample.Time.After(now) {
+				return
+			}
+			switch kind {
+			case WriteFlow:
+				flow.Bytes += sample.BytesWritten
+				flow.Keys += sample.KeysWritten
+			case ReadFlow:
+				flow.Bytes += sample.BytesRead
+				flow.Keys += sample.KeysRead
+			}
+		})
+		if flow.Bytes == 0 && flow.Keys == 0 {
+			continue
+		}
+		flow.BytesPerSec = float64(flow.Bytes) / window.Seconds()
+		flow.KeysPerSec = float64(flow.Keys) / window.Seconds()
+		flows = append(flows, flow)
+	}
+	sort.Slice(flows, func(i, j int) bool {
+		if flows[i].Bytes != flows[j].Bytes {
+			return flows[i].Bytes > flows[j].Bytes
+		}
+		return flows[i].RegionID < flows[j].RegionID
+	})
+	if limit > 0 && len(flows) > limit {
+		flows = flows[:limit]
+	}
+	return flows
+}
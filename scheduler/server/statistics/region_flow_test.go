@@ -0,0 +1,76 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"time"
+
+	"github.com/pingcap-incubator/tinykv/proto/pkg/metapb"
+	"github.com/pingcap-incubator/tinykv/scheduler/server/core"
+	. "github.com/pingcap/check"
+)
+
+var _ = Suite(&testRegionFlowSuite{})
+
+type testRegionFlowSuite struct{}
+
+func (t *testRegionFlowSuite) TestRegionFlowRecorder(c *C) {
+	recorder := NewRegionFlowRecorder(3)
+	start := time.Unix(1000, 0)
+	heartbeat := func(regionID uint64, at time.Duration, written, read uint64) {
+		region := core.NewRegionInfo(&metapb.Region{Id: regionID}, nil,
+			core.SetWrittenBytes(written), core.SetWrittenKeys(written/10),
+			core.SetReadBytes(read), core.SetReadKeys(read/10))
+		recorder.Record(region, start.Add(at))
+	}
+
+	// The ring keeps the latest 3 samples of region 1.
+	for i := 1; i <= 4; i++ {
+		heartbeat(1, time.Duration(i)*time.Minute, uint64(i)*100, 0)
+	}
+	history := recorder.History(1)
+	c.Assert(history, HasLen, 3)
+	for i, sample := range history {
+		c.Assert(sample.Time, Equals, start.Add(time.Duration(i+2)*time.Minute))
+		c.Assert(sample.BytesWritten, Equals, uint64(i+2)*100)
+	}
+	c.Assert(recorder.History(2), IsNil)
+
+	heartbeat(2, 3*time.Minute, 250, 1000)
+	heartbeat(2, 4*time.Minute, 250, 1000)
+	heartbeat(3, 4*time.Minute, 0, 10)
+
+	// Only the samples of the window are summed, region 2 wrote less than region 1 in the last 2 minutes.
+	now := start.Add(4 * time.Minute)
+	top := recorder.TopRegions(WriteFlow, now, 2*time.Minute, 10)
+	c.Assert(top, DeepEquals, []RegionFlow{
+		{RegionID: 1, Bytes: 700, Keys: 70, BytesPerSec: 700.0 / 120, KeysPerSec: 70.0 / 120},
+		{RegionID: 2, Bytes: 500, Keys: 50, BytesPerSec: 500.0 / 120, KeysPerSec: 50.0 / 120},
+	})
+	top = recorder.TopRegions(WriteFlow, now, time.Minute, 10)
+	c.Assert(top[0].RegionID, Equals, uint64(1))
+	c.Assert(top[0].Bytes, Equals, uint64(400))
+
+	// The read flow, and the limit.
+	top = recorder.TopRegions(ReadFlow, now, 2*time.Minute, 1)
+	c.Assert(top, HasLen, 1)
+	c.Assert(top[0].RegionID, Equals, uint64(2))
+	c.Assert(top[0].Bytes, Equals, uint64(2000))
+
+	recorder.Remove(2)
+	c.Assert(recorder.History(2), IsNil)
+	top = recorder.TopRegions(ReadFlow, now, 2*time.Minute, 0)
+	c.Assert(top, HasLen, 1)
+	c.Assert(top[0].RegionID, Equals, uint64(3))
+}
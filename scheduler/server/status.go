@@ -0,0 +1,116 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/pingcap/log"
+	"go.uber.org/zap"
+)
+
+const (
+	statusURL = "/pd/status"
+	schemaURL = "/pd/schema"
+)
+
+// StatusSchemaVersion is the version of the JSON served at statusURL. Within a version, fields are only ever added,
+// renaming or removing a field, or changing its type, bumps the version, so dashboards and scripts can rely on it.
+const StatusSchemaVersion = 1
+
+// Status is the JSON served at statusURL, described by StatusSchema.
+type Status struct {
+	SchemaVersion int    `json:"schema_version"`
+	Name          string `json:"name"`
+	ClusterID     uint64 `json:"cluster_id"`
+	// The name of the leader, empty if there is none.
+	Leader       string `json:"leader"`
+	IsLeader     bool   `json:"is_leader"`
+	Bootstrapped bool   `json:"bootstrapped"`
+	// The cluster stats are only known by the leader, they're 0 on the followers.
+	StoreCount  int `json:"store_count"`
+	RegionCount int `json:"region_count"`
+	// Seconds since the server started.
+	UptimeSeconds int64 `json:"uptime_seconds"`
+}
+
+// StatusSchema is the JSON schema of Status, served at schemaURL.
+const StatusSchema = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "$id": "tinykv/scheduler-status/v1",
+  "type": "object",
+  "properties": {
+    "schema_version": {"type": "integer", "const": 1},
+    "name": {"type": "string"},
+    "cluster_id": {"type": "integer"},
+    "leader": {"type": "string"},
+    "is_leader": {"type": "boolean"},
+    "bootstrapped": {"type": "boolean"},
+    "store_count": {"type": "integer"},
+    "region_count": {"type": "integer"},
+    "uptime_seconds": {"type": "integer"}
+  },
+  "required": ["schema_version", "name", "cluster_id", "leader", "is_leader", "bootstrapped", "store_count",
+    "region_count", "uptime_seconds"]
+}`
+
+// GetStatus returns the status of the server.
+func (s *Server) GetStatus() *Status {
+	status := &Status{
+		SchemaVersion: StatusSchemaVersion,
+		Name:          s.Name(),
+		ClusterID:     s.ClusterID(),
+		Leader:        s.GetLeader().GetName(),
+		IsLeader:      s.GetMember().IsLeader(),
+		UptimeSeconds: int64(time.Since(s.startTime) / time.Second),
+	}
+	if cluster := s.GetRaftCluster(); cluster != nil {
+		status.Bootstrapped = true
+		status.StoreCount = len(cluster.GetStores())
+		status.RegionCount = cluster.GetRegionCount()
+	}
+	return status
+}
+
+func newStatusHandlers(s *Server) map[string]http.Handler {
+	handlers := map[string]http.Handler{
+		statusURL: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, s.GetStatus())
+		}),
+		schemaURL: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/schema+json")
+			w.Write([]byte(StatusSchema))
+		}),
+	}
+	for url, handler := range newRegionFlowHandlers(s) {
+		handlers[url] = handler
+	}
+	for url, handler := range newOperatorLimitsHandlers(s) {
+		handlers[url] = handler
+	}
+	return handlers
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		log.Error("marshal status failed", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
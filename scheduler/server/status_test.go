@@ -0,0 +1,78 @@
+// Copyright 2016 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"sort"
+
+	. "github.com/pingcap/check"
+)
+
+var _ = Suite(&testStatusSuite{})
+
+type testStatusSuite struct{}
+
+// TestStatusCompatibility guards the fields of the current schema version, a change failing it needs a new version.
+func (s *testStatusSuite) TestStatusCompatibility(c *C) {
+	var schema struct {
+		ID         string                     `json:"$id"`
+		Properties map[string]json.RawMessage `json:"properties"`
+		Required   []string                   `json:"required"`
+	}
+	c.Assert(json.Unmarshal([]byte(StatusSchema), &schema), IsNil)
+	c.Assert(schema.ID, Equals, "tinykv/scheduler-status/v1")
+	c.Assert(StatusSchemaVersion, Equals, 1)
+
+	data, err := json.Marshal(&Status{})
+	c.Assert(err, IsNil)
+	var fields map[string]interface{}
+	c.Assert(json.Unmarshal(data, &fields), IsNil)
+	var keys []string
+	for key := range fields {
+		keys = append(keys, key)
+		c.Assert(schema.Properties[key], NotNil, Commentf("field %s is not in the schema", key))
+	}
+	sort.Strings(keys)
+	sort.Strings(schema.Required)
+	c.Assert(keys, DeepEquals, schema.Required)
+	c.Assert(keys, DeepEquals, []string{"bootstrapped", "cluster_id", "is_leader", "leader", "name", "region_count",
+		"schema_version", "store_count", "uptime_seconds"})
+}
+
+func (s *testStatusSuite) TestStatusEndpoints(c *C) {
+	svr, cleanup := mustRunTestServer(c)
+	defer cleanup()
+	addr := svr.GetEndpoints()[0]
+
+	resp, err := dialClient.Get(addr + statusURL)
+	c.Assert(err, IsNil)
+	defer resp.Body.Close()
+	c.Assert(resp.Header.Get("Content-Type"), Equals, "application/json")
+	var status Status
+	c.Assert(json.NewDecoder(resp.Body).Decode(&status), IsNil)
+	c.Assert(status.SchemaVersion, Equals, StatusSchemaVersion)
+	c.Assert(status.Name, Equals, svr.Name())
+	c.Assert(status.ClusterID, Equals, svr.ClusterID())
+	c.Assert(status.IsLeader, IsTrue)
+	c.Assert(status.Leader, Equals, svr.Name())
+
+	resp, err = dialClient.Get(addr + schemaURL)
+	c.Assert(err, IsNil)
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, StatusSchema)
+}